@@ -0,0 +1,527 @@
+package api
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/types"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+)
+
+// Administrative operations, exposed as a separate RPC service.
+type adminInterface struct {
+	srv *Server
+}
+
+// CreateAPIKey registers a new API key for a backend integration. This method
+// requires authentication.
+func (ai *adminInterface) CreateAPIKey(ctx context.Context,
+	req *protov1.CreateAPIKeyRequest) (*protov1.APIKeyResponse, error) {
+	// Authentication
+	token, err := ai.srv.authenticate(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	if !ai.srv.authorize(token, "/api_key", "create") {
+		return nil, errUnauthorized
+	}
+
+	// Validate request
+	if !ai.srv.isRoleValid(req.Role) || req.Role == "admin" {
+		return nil, invalidArgument("role", "unsupported role")
+	}
+	if !validScope(req.Scope) {
+		return nil, invalidArgument("scope", "permissions must be in the form 'resource:action'")
+	}
+	if req.Name == "" {
+		return nil, invalidArgument("name", "a name is required")
+	}
+
+	return ai.srv.CreateAPIKey(req)
+}
+
+// ListAPIKeys returns the registered API keys. This method requires
+// authentication.
+func (ai *adminInterface) ListAPIKeys(ctx context.Context,
+	_ *types.Empty) (*protov1.ListAPIKeysResponse, error) {
+	// Authentication
+	token, err := ai.srv.authenticate(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	if !ai.srv.authorize(token, "/api_key", "read") {
+		return nil, errUnauthorized
+	}
+
+	return ai.srv.ListAPIKeys()
+}
+
+// RotateAPIKey issues a new secret for an existing API key. This method
+// requires authentication.
+func (ai *adminInterface) RotateAPIKey(ctx context.Context,
+	req *protov1.APIKeyRequest) (*protov1.APIKeyResponse, error) {
+	// Authentication
+	token, err := ai.srv.authenticate(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	if !ai.srv.authorize(token, "/api_key", "update") {
+		return nil, errUnauthorized
+	}
+
+	return ai.srv.RotateAPIKey(req)
+}
+
+// RevokeAPIKey permanently removes an API key. This method requires
+// authentication.
+func (ai *adminInterface) RevokeAPIKey(ctx context.Context,
+	req *protov1.APIKeyRequest) (*types.Empty, error) {
+	// Authentication
+	token, err := ai.srv.authenticate(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	if !ai.srv.authorize(token, "/api_key", "delete") {
+		return nil, errUnauthorized
+	}
+
+	if err := ai.srv.RevokeAPIKey(req); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// CreateOrganization registers a new organization. This method requires
+// authentication.
+func (ai *adminInterface) CreateOrganization(ctx context.Context,
+	req *protov1.Organization) (*protov1.Organization, error) {
+	// Authentication
+	token, err := ai.srv.authenticate(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	if !ai.srv.authorize(token, "/organization", "create") {
+		return nil, errUnauthorized
+	}
+
+	return ai.srv.CreateOrganization(req)
+}
+
+// ListOrganizations returns the registered organizations. This method
+// requires authentication.
+func (ai *adminInterface) ListOrganizations(ctx context.Context,
+	_ *types.Empty) (*protov1.ListOrganizationsResponse, error) {
+	// Authentication
+	token, err := ai.srv.authenticate(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	if !ai.srv.authorize(token, "/organization", "read") {
+		return nil, errUnauthorized
+	}
+
+	return ai.srv.ListOrganizations()
+}
+
+// AddMember adds an agent to an organization. This method requires
+// authentication.
+func (ai *adminInterface) AddMember(ctx context.Context,
+	req *protov1.MembershipRequest) (*types.Empty, error) {
+	// Authentication
+	token, err := ai.srv.authenticate(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	if !ai.srv.authorize(token, "/organization", "update") {
+		return nil, errUnauthorized
+	}
+
+	if err := ai.srv.AddMember(req); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// RemoveMember removes an agent from an organization. This method requires
+// authentication.
+func (ai *adminInterface) RemoveMember(ctx context.Context,
+	req *protov1.MembershipRequest) (*types.Empty, error) {
+	// Authentication
+	token, err := ai.srv.authenticate(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	if !ai.srv.authorize(token, "/organization", "update") {
+		return nil, errUnauthorized
+	}
+
+	if err := ai.srv.RemoveMember(req); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// GetMaintenance returns the current maintenance mode settings. This method
+// requires authentication.
+func (ai *adminInterface) GetMaintenance(ctx context.Context,
+	_ *types.Empty) (*protov1.MaintenanceStatus, error) {
+	// Authentication
+	token, err := ai.srv.authenticate(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	if !ai.srv.authorize(token, "/maintenance", "read") {
+		return nil, errUnauthorized
+	}
+
+	return ai.srv.GetMaintenance()
+}
+
+// SetMaintenance enables or disables maintenance mode. This method requires
+// authentication.
+func (ai *adminInterface) SetMaintenance(ctx context.Context,
+	req *protov1.MaintenanceStatus) (*protov1.MaintenanceStatus, error) {
+	// Authentication
+	token, err := ai.srv.authenticate(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	if !ai.srv.authorize(token, "/maintenance", "update") {
+		return nil, errUnauthorized
+	}
+
+	return ai.srv.SetMaintenance(req)
+}
+
+// SubmitJob registers a new long-running job to be executed by workers. This
+// method requires authentication.
+func (ai *adminInterface) SubmitJob(ctx context.Context, req *protov1.JobRequest) (*protov1.Job, error) {
+	// Authentication
+	token, err := ai.srv.authenticate(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	if !ai.srv.authorize(token, "/job", "create") {
+		return nil, errUnauthorized
+	}
+
+	data := &credentialsData{}
+	if err := token.Decode(&data); err != nil {
+		return nil, errUnauthenticated
+	}
+	return ai.srv.SubmitJob(ctx, data.DID, req)
+}
+
+// GetJob returns the current state of a job. This method requires
+// authentication.
+func (ai *adminInterface) GetJob(ctx context.Context, req *protov1.JobQuery) (*protov1.Job, error) {
+	// Authentication
+	token, err := ai.srv.authenticate(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	if !ai.srv.authorize(token, "/job", "read") {
+		return nil, errUnauthorized
+	}
+
+	return ai.srv.GetJob(req)
+}
+
+// ListJobs returns the most recent jobs. This method requires
+// authentication.
+func (ai *adminInterface) ListJobs(ctx context.Context,
+	req *protov1.ListJobsRequest) (*protov1.ListJobsResponse, error) {
+	// Authentication
+	token, err := ai.srv.authenticate(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	if !ai.srv.authorize(token, "/job", "read") {
+		return nil, errUnauthorized
+	}
+
+	return ai.srv.ListJobs(req)
+}
+
+// CancelJob stops a pending or running job. This method requires
+// authentication.
+func (ai *adminInterface) CancelJob(ctx context.Context, req *protov1.JobQuery) (*protov1.Job, error) {
+	// Authentication
+	token, err := ai.srv.authenticate(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	if !ai.srv.authorize(token, "/job", "update") {
+		return nil, errUnauthorized
+	}
+
+	return ai.srv.CancelJob(req)
+}
+
+// GetQueueStats returns the depth and consumer lag of the broker queues, as
+// last reported by the workers. This method requires authentication.
+func (ai *adminInterface) GetQueueStats(ctx context.Context,
+	_ *types.Empty) (*protov1.QueueStatsResponse, error) {
+	// Authentication
+	token, err := ai.srv.authenticate(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	if !ai.srv.authorize(token, "/queues", "read") {
+		return nil, errUnauthorized
+	}
+
+	return ai.srv.GetQueueStats()
+}
+
+// ListWorkers returns the status reported by the workers, to detect stuck
+// consumers. This method requires authentication.
+func (ai *adminInterface) ListWorkers(ctx context.Context,
+	_ *types.Empty) (*protov1.ListWorkersResponse, error) {
+	// Authentication
+	token, err := ai.srv.authenticate(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	if !ai.srv.authorize(token, "/workers", "read") {
+		return nil, errUnauthorized
+	}
+
+	return ai.srv.ListWorkers()
+}
+
+// SetNotificationTemplate registers, or replaces, a notification template.
+// This method requires authentication.
+func (ai *adminInterface) SetNotificationTemplate(ctx context.Context,
+	req *protov1.NotificationTemplate) (*protov1.NotificationTemplate, error) {
+	// Authentication
+	token, err := ai.srv.authenticate(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	if !ai.srv.authorize(token, "/template", "update") {
+		return nil, errUnauthorized
+	}
+
+	return ai.srv.SetNotificationTemplate(req)
+}
+
+// ListNotificationTemplates returns the registered notification templates.
+// This method requires authentication.
+func (ai *adminInterface) ListNotificationTemplates(ctx context.Context,
+	req *protov1.ListTemplatesRequest) (*protov1.ListTemplatesResponse, error) {
+	// Authentication
+	token, err := ai.srv.authenticate(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	if !ai.srv.authorize(token, "/template", "read") {
+		return nil, errUnauthorized
+	}
+
+	return ai.srv.ListNotificationTemplates(req)
+}
+
+// DeleteNotificationTemplate removes a notification template. This method
+// requires authentication.
+func (ai *adminInterface) DeleteNotificationTemplate(ctx context.Context,
+	req *protov1.TemplateQuery) (*types.Empty, error) {
+	// Authentication
+	token, err := ai.srv.authenticate(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	if !ai.srv.authorize(token, "/template", "delete") {
+		return nil, errUnauthorized
+	}
+
+	if err := ai.srv.DeleteNotificationTemplate(req); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// ExportRecords streams the location records matching a filter. This method
+// requires authentication.
+func (ai *adminInterface) ExportRecords(req *protov1.ExportRecordsRequest,
+	stream protov1.AdminAPI_ExportRecordsServer) error {
+	// Authentication
+	token, err := ai.srv.authenticate(stream.Context(), true)
+	if err != nil {
+		return err
+	}
+
+	// Authorization
+	attrs := &resourceAttrs{owner: req.Did, namespace: req.Cell}
+	if !ai.srv.authorizeFor(token, "/record", "export", attrs) {
+		return errUnauthorized
+	}
+
+	return ai.srv.ExportRecords(stream.Context(), token, req, stream.Send)
+}
+
+// ExportAudit streams the audit log entries registered during a period of
+// time. This method requires authentication.
+func (ai *adminInterface) ExportAudit(req *protov1.ExportAuditRequest,
+	stream protov1.AdminAPI_ExportAuditServer) error {
+	// Authentication
+	token, err := ai.srv.authenticate(stream.Context(), true)
+	if err != nil {
+		return err
+	}
+
+	// Authorization
+	if !ai.srv.authorize(token, "/audit", "export") {
+		return errUnauthorized
+	}
+
+	return ai.srv.ExportAudit(stream.Context(), token, req, stream.Send)
+}
+
+// PlaceLegalHold exempts the data of a user from the retention policy. This
+// method requires authentication.
+func (ai *adminInterface) PlaceLegalHold(ctx context.Context,
+	req *protov1.LegalHoldRequest) (*protov1.LegalHold, error) {
+	// Authentication
+	token, err := ai.srv.authenticate(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	if !ai.srv.authorize(token, "/legal_hold", "create") {
+		return nil, errUnauthorized
+	}
+
+	return ai.srv.PlaceLegalHold(ctx, token, req)
+}
+
+// ReleaseLegalHold removes the hold placed on the data of a user. This method
+// requires authentication.
+func (ai *adminInterface) ReleaseLegalHold(ctx context.Context,
+	req *protov1.LegalHoldRequest) (*types.Empty, error) {
+	// Authentication
+	token, err := ai.srv.authenticate(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	if !ai.srv.authorize(token, "/legal_hold", "delete") {
+		return nil, errUnauthorized
+	}
+
+	if err := ai.srv.ReleaseLegalHold(ctx, token, req); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// SubjectAccess compiles all the data stored for a user. This method requires
+// authentication.
+func (ai *adminInterface) SubjectAccess(ctx context.Context,
+	req *protov1.SubjectAccessRequest) (*protov1.SubjectAccessResponse, error) {
+	// Authentication
+	token, err := ai.srv.authenticate(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	if !ai.srv.authorizeFor(token, "/subject", "read", &resourceAttrs{owner: req.Did}) {
+		return nil, errUnauthorized
+	}
+
+	return ai.srv.SubjectAccess(ctx, token, req)
+}
+
+// ChangeRole assigns a new role to a DID, revoking its existing credentials.
+// This method requires authentication.
+func (ai *adminInterface) ChangeRole(ctx context.Context,
+	req *protov1.ChangeRoleRequest) (*protov1.ChangeRoleResponse, error) {
+	// Authentication
+	token, err := ai.srv.authenticate(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	if !ai.srv.authorize(token, "/role", "update") {
+		return nil, errUnauthorized
+	}
+
+	return ai.srv.ChangeRole(ctx, token, req)
+}
+
+// ExplainPolicy evaluates an authorization request against the access policy
+// in use. This method requires authentication.
+func (ai *adminInterface) ExplainPolicy(ctx context.Context,
+	req *protov1.PolicyRequest) (*protov1.PolicyDecision, error) {
+	// Authentication
+	token, err := ai.srv.authenticate(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	if !ai.srv.authorize(token, "/policy", "read") {
+		return nil, errUnauthorized
+	}
+
+	return ai.srv.ExplainPolicy(ctx, req)
+}
+
+// ClientVersions returns the location records, and distinct users, submitted
+// by each client application version. This method requires authentication.
+func (ai *adminInterface) ClientVersions(ctx context.Context,
+	req *protov1.ClientVersionsRequest) (*protov1.ClientVersionsResponse, error) {
+	// Authentication
+	token, err := ai.srv.authenticate(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	if !ai.srv.authorize(token, "/client_version", "read") {
+		return nil, errUnauthorized
+	}
+
+	return ai.srv.ClientVersions(req)
+}
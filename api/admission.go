@@ -0,0 +1,188 @@
+package api
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/prometheus/client_golang/prometheus"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+// Admission control settings.
+const (
+	// Default latency thresholds for the broker and storage.
+	defaultBrokerLatency  = 500 * time.Millisecond
+	defaultStorageLatency = 250 * time.Millisecond
+
+	// Default maximum number of ingestion requests processed at once.
+	defaultMaxInFlight = 1000
+
+	// How often the storage latency is probed.
+	admissionProbe = 5 * time.Second
+
+	// Latency samples older than this are ignored, so ingestion resumes
+	// once the components stop reporting high latencies.
+	admissionWindow = 15 * time.Second
+
+	// Suggested delay before retrying rejected requests.
+	admissionRetry = 10 * time.Second
+)
+
+// Admission control metrics.
+var (
+	admissionLatency = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "ct19_admission_latency_seconds",
+		Help: "Average latency observed by the admission controller, by component.",
+	}, []string{"component"})
+	admissionRejected = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "ct19_admission_rejected_total",
+		Help: "Ingestion requests rejected by the admission controller, by reason.",
+	}, []string{"reason"})
+)
+
+func init() {
+	prometheus.MustRegister(admissionLatency, admissionRejected)
+}
+
+// Returned when ingestion requests are rejected to protect saturated
+// components.
+var errOverloaded = newError(codes.ResourceExhausted,
+	protov1.ErrorCode_ERROR_CODE_OVERLOADED, "service overloaded",
+	&errdetails.RetryInfo{RetryDelay: ptypes.DurationProto(admissionRetry)})
+
+// AdmissionConfig adjusts the thresholds used to reject ingestion requests
+// when the storage or broker are saturated. Zero values use the defaults.
+type AdmissionConfig struct {
+	// Maximum average latency for messages published to the broker.
+	BrokerLatency time.Duration
+
+	// Maximum average latency for storage operations.
+	StorageLatency time.Duration
+
+	// Maximum number of ingestion requests processed at once.
+	MaxInFlight int
+}
+
+// Moving average of the latency reported for a component.
+type latencyTracker struct {
+	avg  time.Duration
+	last time.Time
+}
+
+func (lt *latencyTracker) observe(d time.Duration, now time.Time) {
+	if lt.avg == 0 || now.Sub(lt.last) > admissionWindow {
+		lt.avg = d
+	} else {
+		lt.avg = (lt.avg*7 + d) / 8
+	}
+	lt.last = now
+}
+
+func (lt *latencyTracker) value(now time.Time) time.Duration {
+	if now.Sub(lt.last) > admissionWindow {
+		return 0
+	}
+	return lt.avg
+}
+
+// Admission controller for ingestion requests. Requests are rejected while
+// the average latency of the broker or storage is above its threshold, or
+// too many requests are being processed.
+type admissionController struct {
+	conf     AdmissionConfig
+	broker   latencyTracker
+	storage  latencyTracker
+	inflight int
+	mu       sync.Mutex
+}
+
+func newAdmissionController(conf *AdmissionConfig) *admissionController {
+	ac := &admissionController{
+		conf: AdmissionConfig{
+			BrokerLatency:  defaultBrokerLatency,
+			StorageLatency: defaultStorageLatency,
+			MaxInFlight:    defaultMaxInFlight,
+		},
+	}
+	if conf == nil {
+		return ac
+	}
+	if conf.BrokerLatency > 0 {
+		ac.conf.BrokerLatency = conf.BrokerLatency
+	}
+	if conf.StorageLatency > 0 {
+		ac.conf.StorageLatency = conf.StorageLatency
+	}
+	if conf.MaxInFlight > 0 {
+		ac.conf.MaxInFlight = conf.MaxInFlight
+	}
+	return ac
+}
+
+// Register the latency of a broker or storage operation.
+func (ac *admissionController) observe(component string, d time.Duration) {
+	now := time.Now()
+	ac.mu.Lock()
+	defer ac.mu.Unlock()
+	lt := &ac.storage
+	if component == "broker" {
+		lt = &ac.broker
+	}
+	lt.observe(d, now)
+	admissionLatency.WithLabelValues(component).Set(lt.avg.Seconds())
+}
+
+// Verify a new request can be processed. If admitted, 'done' must be called
+// once the request is completed.
+func (ac *admissionController) admit() (done func(), reason string) {
+	now := time.Now()
+	ac.mu.Lock()
+	defer ac.mu.Unlock()
+	switch {
+	case ac.inflight >= ac.conf.MaxInFlight:
+		reason = "inflight"
+	case ac.broker.value(now) > ac.conf.BrokerLatency:
+		reason = "broker"
+	case ac.storage.value(now) > ac.conf.StorageLatency:
+		reason = "storage"
+	}
+	if reason != "" {
+		return nil, reason
+	}
+	ac.inflight++
+	return func() {
+		ac.mu.Lock()
+		ac.inflight--
+		ac.mu.Unlock()
+	}, ""
+}
+
+// Admit an ingestion request or reject it with a retryable error. The
+// suggested delay is also returned on the "retry-after" response header.
+func (srv *Server) admit(ctx context.Context) (func(), error) {
+	done, reason := srv.admission.admit()
+	if done != nil {
+		return done, nil
+	}
+	admissionRejected.WithLabelValues(reason).Inc()
+	_ = grpc.SetHeader(ctx, metadata.Pairs("retry-after", strconv.Itoa(int(admissionRetry.Seconds()))))
+	return nil, errOverloaded
+}
+
+// Measure the storage latency.
+func (srv *Server) probeStorage() {
+	start := time.Now()
+	if err := srv.repos.Ping(); err != nil {
+		// Unreachable storage is reported as saturated
+		srv.admission.observe("storage", admissionWindow)
+		return
+	}
+	srv.admission.observe("storage", time.Since(start))
+}
@@ -0,0 +1,45 @@
+package api
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAdmissionController(t *testing.T) {
+	ac := newAdmissionController(&AdmissionConfig{MaxInFlight: 2})
+	done, reason := ac.admit()
+	if done == nil || reason != "" {
+		t.Fatal("request should be admitted")
+	}
+	if d, _ := ac.admit(); d == nil {
+		t.Fatal("request should be admitted")
+	}
+	if _, reason := ac.admit(); reason != "inflight" {
+		t.Error("too many requests in flight")
+	}
+	done()
+	if d, _ := ac.admit(); d == nil {
+		t.Error("request should be admitted after completing another")
+	}
+
+	// Saturated components
+	ac = newAdmissionController(nil)
+	ac.observe("broker", 2*defaultBrokerLatency)
+	if _, reason := ac.admit(); reason != "broker" {
+		t.Error("broker should be reported as saturated")
+	}
+	ac.broker.last = time.Now().Add(-2 * admissionWindow)
+	if d, _ := ac.admit(); d == nil {
+		t.Error("stale samples should be ignored")
+	}
+	ac.observe("storage", 2*defaultStorageLatency)
+	if _, reason := ac.admit(); reason != "storage" {
+		t.Error("storage should be reported as saturated")
+	}
+	for i := 0; i < 20; i++ {
+		ac.observe("storage", time.Millisecond)
+	}
+	if d, _ := ac.admit(); d == nil {
+		t.Error("storage latency should recover")
+	}
+}
@@ -0,0 +1,216 @@
+package api
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/x/jwx"
+	"golang.org/x/time/rate"
+)
+
+const (
+	apiKeyPrefix       = "ct19_"         // Prefix for all API key values
+	apiKeyGracePeriod  = 24 * time.Hour  // Validity of secrets replaced during a rotation
+	apiKeyTokenTTL     = 5 * time.Minute // Validity of the internal tokens issued for API keys
+	defaultAPIKeyLimit = 10              // Requests per second allowed by default
+)
+
+// Active API key sessions, used to enforce rate limits and to avoid issuing
+// a new internal token on every request.
+type apiKeySessions struct {
+	list map[string]*apiKeySession
+	mu   sync.Mutex
+}
+
+type apiKeySession struct {
+	limiter *rate.Limiter
+	token   *jwx.Token
+	expires time.Time
+}
+
+// Return the session for the provided key, adjusting its rate limit if
+// required.
+func (aks *apiKeySessions) get(key *protov1.APIKey) *apiKeySession {
+	aks.mu.Lock()
+	defer aks.mu.Unlock()
+	limit := rate.Limit(key.RateLimit)
+	if limit == 0 {
+		limit = defaultAPIKeyLimit
+	}
+	s, ok := aks.list[key.Id]
+	if !ok {
+		s = &apiKeySession{limiter: rate.NewLimiter(limit, int(limit))}
+		aks.list[key.Id] = s
+	}
+	if s.limiter.Limit() != limit {
+		s.limiter.SetLimit(limit)
+		s.limiter.SetBurst(int(limit))
+	}
+	return s
+}
+
+// Remove the session for the provided key.
+func (aks *apiKeySessions) remove(id string) {
+	aks.mu.Lock()
+	delete(aks.list, id)
+	aks.mu.Unlock()
+}
+
+// CreateAPIKey registers a new API key and returns its complete value.
+func (srv *Server) CreateAPIKey(req *protov1.CreateAPIKeyRequest) (*protov1.APIKeyResponse, error) {
+	key := &protov1.APIKey{
+		Id:        uuid.New().String(),
+		Name:      req.Name,
+		Role:      req.Role,
+		Scope:     req.Scope,
+		RateLimit: req.RateLimit,
+		Created:   time.Now().Unix(),
+	}
+	key.Rotated = key.Created
+	secret, err := newAPIKeySecret()
+	if err != nil {
+		return nil, errInternalError
+	}
+	if err := srv.repos.APIKeyRegistry().RegisterAPIKey(key, apiKeyHash(secret)); err != nil {
+		return nil, errInternalError
+	}
+	srv.log.WithField("api_key", key.Id).Info("API key created")
+	return &protov1.APIKeyResponse{
+		Key:    key,
+		Secret: apiKeyValue(key.Id, secret),
+	}, nil
+}
+
+// ListAPIKeys returns the details of all registered API keys.
+func (srv *Server) ListAPIKeys() (*protov1.ListAPIKeysResponse, error) {
+	list, err := srv.repos.APIKeyRegistry().APIKeys()
+	if err != nil {
+		return nil, errInternalError
+	}
+	return &protov1.ListAPIKeysResponse{Keys: list}, nil
+}
+
+// RotateAPIKey issues a new secret for an existing API key. The previous
+// secret remains valid during a grace period to allow integrations to be
+// updated without downtime.
+func (srv *Server) RotateAPIKey(req *protov1.APIKeyRequest) (*protov1.APIKeyResponse, error) {
+	secret, err := newAPIKeySecret()
+	if err != nil {
+		return nil, errInternalError
+	}
+	key, err := srv.repos.APIKeyRegistry().RotateAPIKey(req.Id, apiKeyHash(secret), apiKeyGracePeriod)
+	if err != nil {
+		return nil, notFound("API key")
+	}
+	srv.log.WithField("api_key", key.Id).Info("API key rotated")
+	return &protov1.APIKeyResponse{
+		Key:    key,
+		Secret: apiKeyValue(key.Id, secret),
+	}, nil
+}
+
+// RevokeAPIKey permanently removes an API key.
+func (srv *Server) RevokeAPIKey(req *protov1.APIKeyRequest) error {
+	if err := srv.repos.APIKeyRegistry().RevokeAPIKey(req.Id); err != nil {
+		return notFound("API key")
+	}
+	srv.apiKeys.remove(req.Id)
+	srv.log.WithField("api_key", req.Id).Info("API key revoked")
+	return nil
+}
+
+// Authenticate a request using an API key. On success, an internal access
+// token with the role and scope of the key is returned so requests can be
+// authorized as usual.
+func (srv *Server) authenticateAPIKey(value string) (*jwx.Token, error) {
+	id, secret, ok := parseAPIKey(value)
+	if !ok {
+		return nil, errUnauthenticated
+	}
+	rec, err := srv.repos.APIKeyRegistry().APIKey(id)
+	if err != nil {
+		return nil, errUnauthenticated
+	}
+	hash := []byte(apiKeyHash(secret))
+	valid := subtle.ConstantTimeCompare(hash, []byte(rec.Hash)) == 1
+	if !valid && rec.PreviousHash != "" && time.Now().Before(rec.PreviousExpires) {
+		valid = subtle.ConstantTimeCompare(hash, []byte(rec.PreviousHash)) == 1
+	}
+	if !valid {
+		return nil, errUnauthenticated
+	}
+
+	// Enforce rate limit
+	session := srv.apiKeys.get(rec.Key)
+	if !session.limiter.Allow() {
+		return nil, errRateLimited
+	}
+
+	// Reuse the internal token while it's valid
+	srv.apiKeys.mu.Lock()
+	defer srv.apiKeys.mu.Unlock()
+	if session.token != nil && time.Now().Add(time.Minute).Before(session.expires) {
+		return session.token, nil
+	}
+	token, err := srv.keys.generator().NewToken("master", &jwx.TokenParameters{
+		Audience:   []string{srv.name},
+		Subject:    fmt.Sprintf("apikey:%s", id),
+		Method:     srv.keys.method(),
+		NotBefore:  "0ms",
+		Expiration: apiKeyTokenTTL.String(),
+		CustomPayloadClaims: &credentialsData{
+			Role:  rec.Key.Role,
+			Scope: rec.Key.Scope,
+		},
+	})
+	if err != nil {
+		return nil, errInternalError
+	}
+	session.token = token
+	session.expires = time.Now().Add(apiKeyTokenTTL)
+	return token, nil
+}
+
+// Generate a random API key secret.
+func newAPIKeySecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// Only the hash of API key secrets is stored.
+func apiKeyHash(secret string) string {
+	h := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(h[:])
+}
+
+// API key values have the form "ct19_<id>.<secret>".
+func apiKeyValue(id, secret string) string {
+	return fmt.Sprintf("%s%s.%s", apiKeyPrefix, id, secret)
+}
+
+// Return the identifier and secret of an API key value.
+func parseAPIKey(value string) (string, string, bool) {
+	if !strings.HasPrefix(value, apiKeyPrefix) {
+		return "", "", false
+	}
+	segments := strings.Split(strings.TrimPrefix(value, apiKeyPrefix), ".")
+	if len(segments) != 2 || segments[1] == "" {
+		return "", "", false
+	}
+	if _, err := uuid.Parse(segments[0]); err != nil {
+		return "", "", false
+	}
+	return segments[0], segments[1], true
+}
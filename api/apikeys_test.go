@@ -0,0 +1,43 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+)
+
+func TestAPIKeyValue(t *testing.T) {
+	id := uuid.New().String()
+	secret, err := newAPIKeySecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pid, ps, ok := parseAPIKey(apiKeyValue(id, secret))
+	if !ok || pid != id || ps != secret {
+		t.Error("invalid API key value")
+	}
+	for _, v := range []string{"", id + "." + secret, apiKeyPrefix + id, apiKeyPrefix + "invalid." + secret} {
+		if _, _, ok := parseAPIKey(v); ok {
+			t.Errorf("invalid value accepted: %s", v)
+		}
+	}
+}
+
+func TestAPIKeyRateLimit(t *testing.T) {
+	sessions := &apiKeySessions{list: make(map[string]*apiKeySession)}
+	key := &protov1.APIKey{Id: uuid.New().String(), RateLimit: 2}
+	s := sessions.get(key)
+	if !s.limiter.Allow() || !s.limiter.Allow() {
+		t.Error("request rejected")
+	}
+	if s.limiter.Allow() {
+		t.Error("rate limit not enforced")
+	}
+
+	// Limit changes are applied to existing sessions
+	key.RateLimit = 0
+	if sessions.get(key) != s || s.limiter.Burst() != defaultAPIKeyLimit {
+		t.Error("rate limit not updated")
+	}
+}
@@ -0,0 +1,218 @@
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/covid-tracking/storage"
+	"go.bryk.io/x/jwx"
+	xlog "go.bryk.io/x/log"
+	"google.golang.org/grpc/status"
+)
+
+// Audit log event types.
+const (
+	// Failed credentials request due to an invalid proof, activation code
+	// or device attestation.
+	auditCredentialsFailure = "credentials.failure"
+
+	// Credential requests temporarily blocked for a DID or network address.
+	auditCredentialsLockout = "credentials.lockout"
+
+	// Identifiers of the users present in an area disclosed to an agent.
+	auditExposureIdentifiers = "exposure.identifiers"
+
+	// Anonymized presence counts or analytics queried, including the
+	// processing purpose declared.
+	auditExposureQuery  = "exposure.query"
+	auditAnalyticsQuery = "analytics.query"
+
+	// Location records exported through the admin API.
+	auditRecordsExport = "records.export"
+
+	// Audit log exported through the admin API.
+	auditLogExport = "audit.export"
+
+	// Legal hold placed on, or released from, the data of a user.
+	auditLegalHoldPlaced   = "legal_hold.placed"
+	auditLegalHoldReleased = "legal_hold.released"
+
+	// Role assigned to a DID changed through the admin API.
+	auditRoleChanged = "role.changed"
+
+	// All the data stored for a user compiled for a subject access request.
+	auditSubjectAccess = "subject.access"
+)
+
+// Number of lines per chunk on audit log exports.
+const auditExportChunk = 500
+
+// Register an entry on the audit log. Failures are reported but don't
+// interrupt the operation being audited.
+func (srv *Server) audit(entry *storage.AuditEntry) {
+	if err := srv.repos.AuditLog().Audit(entry); err != nil {
+		srv.log.WithFields(xlog.Fields{
+			"event": entry.Event,
+			"error": err.Error(),
+		}).Error("failed to register audit entry")
+	}
+}
+
+// Audit entry as included on exports. The 'entry' field is always the last
+// one on each line, so its serialized value can be extracted to verify the
+// hash.
+type auditLine struct {
+	Seq   int          `json:"seq"`
+	Prev  string       `json:"prev"`
+	Hash  string       `json:"hash"`
+	Entry *auditRecord `json:"entry"`
+}
+
+// Exported audit entry contents.
+type auditRecord struct {
+	Timestamp string            `json:"timestamp"`
+	Event     string            `json:"event"`
+	Actor     string            `json:"actor,omitempty"`
+	Address   string            `json:"address,omitempty"`
+	Details   map[string]string `json:"details,omitempty"`
+}
+
+// Claims included on the signed seal closing an audit log export.
+type auditSeal struct {
+	Head     string `json:"head"`
+	Previous string `json:"previous,omitempty"`
+	Entries  int    `json:"entries"`
+	From     int64  `json:"from"`
+	To       int64  `json:"to"`
+}
+
+// Hash chain over exported audit entries. The hash of each entry is the
+// hex-encoded SHA-256 digest of the previous hash followed by the entry's
+// serialized contents; altering, removing or reordering any entry breaks
+// the chain from that point on.
+type auditChain struct {
+	head  string
+	count int
+}
+
+// Add an entry to the chain and return its serialized line.
+func (ac *auditChain) add(entry *storage.AuditEntry) (string, error) {
+	rec, err := json.Marshal(&auditRecord{
+		Timestamp: entry.Timestamp.UTC().Format(time.RFC3339Nano),
+		Event:     entry.Event,
+		Actor:     entry.Actor,
+		Address:   entry.Address,
+		Details:   entry.Details,
+	})
+	if err != nil {
+		return "", err
+	}
+	ac.count++
+	line := &auditLine{
+		Seq:  ac.count,
+		Prev: ac.head,
+		Hash: chainHash(ac.head, rec),
+	}
+	ac.head = line.Hash
+	res, err := json.Marshal(line)
+	if err != nil {
+		return "", err
+	}
+	// Entry contents are appended as serialized for the hash
+	res = append(res[:len(res)-len("null}")], rec...)
+	return string(append(res, '}')), nil
+}
+
+func chainHash(prev string, contents []byte) string {
+	h := sha256.New()
+	_, _ = h.Write([]byte(prev))
+	_, _ = h.Write(contents)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ExportAudit streams the audit entries matching the request filter as
+// hash-chained JSON lines, using 'send' to deliver them in chunks. The last
+// line contains a seal, signed with the server's credentials key, covering
+// the hash of the last entry. Exports are registered on the audit log.
+func (srv *Server) ExportAudit(ctx context.Context, token *jwx.Token, req *protov1.ExportAuditRequest,
+	send func(*protov1.AuditChunk) error) error {
+	if req.From == 0 || req.To < req.From {
+		return invalidArgument("from", "invalid time range")
+	}
+	if req.Previous != "" {
+		if h, err := hex.DecodeString(req.Previous); err != nil || len(h) != sha256.Size {
+			return invalidArgument("previous", "invalid hash value")
+		}
+	}
+	data := &credentialsData{}
+	if err := token.Decode(&data); err != nil {
+		return errUnauthenticated
+	}
+
+	// Stream entries
+	chain := &auditChain{head: req.Previous}
+	chunk := &protov1.AuditChunk{}
+	filter := storage.AuditFilter{
+		From:  time.Unix(req.From, 0),
+		To:    time.Unix(req.To, 0),
+		Event: req.Event,
+	}
+	err := srv.repos.AuditLog().ExportAudit(ctx, filter, func(entry *storage.AuditEntry) error {
+		line, err := chain.add(entry)
+		if err != nil {
+			return err
+		}
+		chunk.Lines = append(chunk.Lines, line)
+		if len(chunk.Lines) < auditExportChunk {
+			return nil
+		}
+		defer func() {
+			chunk = &protov1.AuditChunk{}
+		}()
+		return send(chunk)
+	})
+	srv.audit(&storage.AuditEntry{
+		Event:   auditLogExport,
+		Actor:   data.DID,
+		Address: clientAddress(ctx),
+		Details: map[string]string{
+			"from":    strconv.FormatInt(req.From, 10),
+			"to":      strconv.FormatInt(req.To, 10),
+			"event":   req.Event,
+			"entries": strconv.Itoa(chain.count),
+		},
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); !ok {
+			// Storage errors
+			return errInternalError
+		}
+		return err
+	}
+
+	// Seal
+	seal, err := srv.keys.generator().NewToken("master", &jwx.TokenParameters{
+		Audience:  []string{srv.name},
+		Subject:   data.DID,
+		Method:    srv.keys.method(),
+		NotBefore: "0ms",
+		CustomPayloadClaims: &auditSeal{
+			Head:     chain.head,
+			Previous: req.Previous,
+			Entries:  chain.count,
+			From:     req.From,
+			To:       req.To,
+		},
+	})
+	if err != nil {
+		return errInternalError
+	}
+	line, _ := json.Marshal(map[string]string{"seal": seal.String()})
+	chunk.Lines = append(chunk.Lines, string(line))
+	return send(chunk)
+}
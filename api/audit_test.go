@@ -0,0 +1,76 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.bryk.io/covid-tracking/storage"
+)
+
+// Verify the lines of an export, starting at 'prev', and return the hash
+// of the last entry.
+func verifyAuditChain(prev string, lines []string) (string, error) {
+	marker := []byte(`,"entry":`)
+	for i, l := range lines {
+		line := &auditLine{}
+		if err := json.Unmarshal([]byte(l), line); err != nil {
+			return "", errors.Wrapf(err, "invalid line %d", i+1)
+		}
+		pos := bytes.Index([]byte(l), marker)
+		if pos < 0 || line.Prev != prev {
+			return "", errors.Errorf("invalid line %d", i+1)
+		}
+		rec := []byte(l)[pos+len(marker) : len(l)-1]
+		if chainHash(prev, rec) != line.Hash {
+			return "", errors.Errorf("invalid hash on line %d", i+1)
+		}
+		prev = line.Hash
+	}
+	return prev, nil
+}
+
+func TestAuditChain(t *testing.T) {
+	chain := &auditChain{}
+	var lines []string
+	for i := 0; i < 5; i++ {
+		line, err := chain.add(&storage.AuditEntry{
+			Timestamp: time.Now(),
+			Event:     auditCredentialsFailure,
+			Actor:     "did:bryk:7889c965-4644-44ff-b760-f396f1d11444",
+			Details:   map[string]string{"reason": "invalid proof", "attempt": "1"},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		lines = append(lines, line)
+	}
+	head, err := verifyAuditChain("", lines)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head != chain.head {
+		t.Error("invalid chain head")
+	}
+
+	// Continue the chain on a new export
+	next := &auditChain{head: head}
+	line, _ := next.add(&storage.AuditEntry{Timestamp: time.Now(), Event: auditLogExport})
+	if _, err := verifyAuditChain(head, []string{line}); err != nil {
+		t.Error(err)
+	}
+
+	// Tampering
+	modified := append([]string{}, lines...)
+	modified[2] = strings.Replace(modified[2], "invalid proof", "valid proof", 1)
+	if _, err := verifyAuditChain("", modified); err == nil {
+		t.Error("modified entry not detected")
+	}
+	removed := append(append([]string{}, lines[:2]...), lines[3:]...)
+	if _, err := verifyAuditChain("", removed); err == nil {
+		t.Error("removed entry not detected")
+	}
+}
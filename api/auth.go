@@ -1,14 +1,49 @@
 package api
 
-// Support user roles on the platform.
-var supportedRoles = []string{
-	"user",
-	"agent",
-	"admin",
-}
+import "strings"
 
 // Custom claims included in access credentials.
 type credentialsData struct {
-	DID  string `json:"did"`
-	Role string `json:"role"`
+	DID   string   `json:"did"`
+	Role  string   `json:"role"`
+	Lang  string   `json:"lang,omitempty"`
+	Scope []string `json:"scope,omitempty"`
+}
+
+// Verify the credentials scope allows the requested action. Credentials
+// without an explicit scope are granted all the permissions of their role.
+func (cd *credentialsData) allows(resource string, action string) bool {
+	return len(cd.Scope) == 0 || permitted(cd.Scope, resource, action)
+}
+
+// Verify the requested action is included in a list of permissions in the
+// form "resource:action".
+func permitted(permissions []string, resource string, action string) bool {
+	resource = strings.TrimPrefix(resource, "/")
+	for _, s := range permissions {
+		res, act := splitScope(s)
+		if res == resource && (act == action || act == "*") {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate a list of permissions in the form "resource:action".
+func validScope(scope []string) bool {
+	for _, s := range scope {
+		if res, act := splitScope(s); res == "" || act == "" {
+			return false
+		}
+	}
+	return true
+}
+
+// Split a permission entry in its resource and action components.
+func splitScope(s string) (string, string) {
+	i := strings.LastIndex(s, ":")
+	if i < 0 {
+		return "", ""
+	}
+	return strings.TrimPrefix(strings.TrimSpace(s[:i]), "/"), strings.TrimSpace(s[i+1:])
 }
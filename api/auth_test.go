@@ -0,0 +1,99 @@
+package api
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"errors"
+	"testing"
+
+	"go.bryk.io/x/jwx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCredentialsScope(t *testing.T) {
+	// Credentials without scope are not restricted
+	cd := &credentialsData{Role: "agent"}
+	if !cd.allows("/venue/outbreak", "create") {
+		t.Error("unscoped credentials restricted")
+	}
+
+	// Scoped credentials
+	cd.Scope = []string{"record:create", "venue:*"}
+	checks := []struct {
+		resource string
+		action   string
+		allowed  bool
+	}{
+		{"/record", "create", true},
+		{"/check_in", "create", false},
+		{"/venue", "create", true},
+		{"/venue/outbreak", "create", false},
+	}
+	for _, c := range checks {
+		if cd.allows(c.resource, c.action) != c.allowed {
+			t.Errorf("%s %s: expected allowed=%v", c.action, c.resource, c.allowed)
+		}
+	}
+
+	// Scope validation
+	if !validScope([]string{"record:create", "/venue/outbreak:create"}) {
+		t.Error("valid scope rejected")
+	}
+	if validScope([]string{"record"}) || validScope([]string{":create"}) {
+		t.Error("invalid scope accepted")
+	}
+}
+
+func TestAuthChecks(t *testing.T) {
+	key, _ := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	tg, err := newSignerGenerator("ct19.test", key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := tg.NewToken("master", &jwx.TokenParameters{
+		Audience:            []string{"ct19.test"},
+		Subject:             "did:bryk:agent",
+		NotBefore:           "0ms",
+		Expiration:          "1h",
+		CustomPayloadClaims: &credentialsData{DID: "did:bryk:agent", Role: "agent"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// No checks registered
+	srv := &Server{}
+	if err := srv.runAuthChecks(context.Background(), token); err != nil {
+		t.Error(err)
+	}
+
+	// Custom claims validation
+	srv.checks = []AuthCheck{
+		func(_ context.Context, info *AuthInfo) error {
+			if info.Role != "agent" || info.DID != "did:bryk:agent" || info.Issuer != "ct19.test" {
+				return errors.New("invalid auth info")
+			}
+			return nil
+		},
+		func(_ context.Context, _ *AuthInfo) error {
+			return errors.New("tenant header required")
+		},
+	}
+	err = srv.runAuthChecks(context.Background(), token)
+	if status.Code(err) != codes.PermissionDenied || status.Convert(err).Message() != "tenant header required" {
+		t.Errorf("unexpected result: %v", err)
+	}
+
+	// Status errors are returned as-is
+	srv.checks = []AuthCheck{
+		func(_ context.Context, _ *AuthInfo) error {
+			return status.Error(codes.FailedPrecondition, "region not available")
+		},
+	}
+	if status.Code(srv.runAuthChecks(context.Background(), token)) != codes.FailedPrecondition {
+		t.Error("status error not preserved")
+	}
+}
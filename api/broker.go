@@ -0,0 +1,114 @@
+package api
+
+import (
+	"context"
+	"crypto/tls"
+	"math/rand"
+	"time"
+
+	"go.bryk.io/covid-tracking/utils"
+	"go.bryk.io/x/amqp"
+	xlog "go.bryk.io/x/log"
+)
+
+// Delays used when reconnecting to the message broker. The delay doubles
+// on every failed attempt, up to the maximum value.
+const (
+	reconnectBase = time.Second
+	reconnectMax  = time.Minute
+)
+
+// Message publisher. Implemented by the broker client, and by in-memory test
+// doubles.
+type publisher interface {
+	Push(msg amqp.Message, opts amqp.MessageOptions) (bool, error)
+	MessageReturns() <-chan amqp.Return
+	Close() error
+}
+
+// Connection options for the message broker. 'conf' is required for
+// "amqps" endpoints; 'shards' is the number of task partitions.
+func brokerOptions(log xlog.Logger, conf *tls.Config, shards int, extra ...amqp.Option) []amqp.Option {
+	opts := []amqp.Option{
+		amqp.WithTopology(utils.BrokerTopology(shards)),
+		amqp.WithLogger(log),
+	}
+	if conf != nil {
+		opts = append(opts, amqp.WithTLS(conf))
+	}
+	return append(opts, extra...)
+}
+
+// Delay before the reconnection attempt 'n', using exponential backoff with
+// jitter. The jitter prevents all instances from reconnecting at the same
+// time after a broker failure.
+func reconnectDelay(attempt int) time.Duration {
+	d := reconnectMax
+	if attempt < 6 {
+		d = reconnectBase << uint(attempt)
+	}
+	half := int64(d / 2)
+	return time.Duration(half + rand.Int63n(half+1)) // nolint: gosec
+}
+
+// Run 'connect' until it succeeds or the context is done. Returns false if
+// the context was done before a connection was established.
+func reconnect(ctx context.Context, log xlog.Logger, connect func() error) bool {
+	for attempt := 0; ; attempt++ {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(reconnectDelay(attempt)):
+		}
+		if err := connect(); err != nil {
+			log.WithFields(xlog.Fields{
+				"attempt": attempt + 1,
+				"error":   err.Error(),
+			}).Warning("failed to reconnect to the broker")
+			continue
+		}
+		log.Info("broker connection restored")
+		return true
+	}
+}
+
+// Get the current message publisher.
+func (srv *Server) publisher() publisher {
+	srv.pubMu.RLock()
+	defer srv.pubMu.RUnlock()
+	return srv.pub
+}
+
+// Replace the message publisher after the broker connection is lost.
+func (srv *Server) reconnectPublisher() bool {
+	srv.log.Warning("broker connection lost")
+	return reconnect(srv.ctx, srv.log, func() error {
+		pub, err := srv.dial()
+		if err != nil {
+			return err
+		}
+		srv.pubMu.Lock()
+		prev := srv.pub
+		srv.pub = pub
+		srv.pubMu.Unlock()
+		_ = prev.Close()
+		return nil
+	})
+}
+
+// Replace the message consumer after the broker connection is lost.
+func (w *Worker) reconnectConsumer() bool {
+	w.log.Warning("broker connection lost")
+	return reconnect(w.ctx, w.log, func() error {
+		sub, err := w.dial()
+		if err != nil {
+			return err
+		}
+		w.mu.Lock()
+		prev := w.sub
+		w.sub = sub
+		w.mu.Unlock()
+		_ = prev.Close()
+		return nil
+	})
+}
@@ -0,0 +1,18 @@
+package api
+
+import "testing"
+
+func TestReconnectDelay(t *testing.T) {
+	for attempt := 0; attempt < 10; attempt++ {
+		max := reconnectMax
+		if attempt < 6 {
+			max = reconnectBase << uint(attempt)
+		}
+		for i := 0; i < 100; i++ {
+			d := reconnectDelay(attempt)
+			if d < max/2 || d > max {
+				t.Fatalf("attempt %d: invalid delay %s", attempt, d)
+			}
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package api
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// CacheRule sets how long clients and intermediary caches can reuse the
+// responses of read-only endpoints exposed through the HTTP gateway.
+type CacheRule struct {
+	// Path prefix of the endpoints covered, i.e. "/v1/api/ping". When
+	// several rules match a request the longest prefix is used.
+	Path string `mapstructure:"path"`
+
+	// Number of seconds a response can be reused without revalidation.
+	MaxAge int `mapstructure:"max_age"`
+}
+
+// Adds validators, and handles conditional requests, for the responses of
+// read-only requests received through the HTTP gateway. All successful "GET"
+// responses include an ETag, so polling clients can revalidate them with
+// "If-None-Match" and get an empty "304 Not Modified" response when the
+// contents haven't changed. Responses are only reused without revalidation
+// for the paths covered by a cache rule; responses to authenticated requests
+// are never stored by shared caches.
+type httpCache struct {
+	rules []*CacheRule
+}
+
+// Validate the provided cache rules.
+func newHTTPCache(rules []*CacheRule) (*httpCache, error) {
+	for i, r := range rules {
+		if !strings.HasPrefix(r.Path, "/") {
+			return nil, errors.Errorf("cache rule #%d: invalid path: %s", i+1, r.Path)
+		}
+		if r.MaxAge < 0 {
+			return nil, errors.Errorf("cache rule #%d: invalid max age: %d", i+1, r.MaxAge)
+		}
+	}
+	return &httpCache{rules: rules}, nil
+}
+
+// Return the rule covering 'path', if any.
+func (hc *httpCache) rule(path string) *CacheRule {
+	var match *CacheRule
+	for _, r := range hc.rules {
+		if strings.HasPrefix(path, r.Path) && (match == nil || len(r.Path) > len(match.Path)) {
+			match = r
+		}
+	}
+	return match
+}
+
+// Value for the "Cache-Control" header of a response to 'r'.
+func (hc *httpCache) control(r *http.Request) string {
+	rule := hc.rule(r.URL.Path)
+	if rule == nil || rule.MaxAge == 0 {
+		return "no-cache"
+	}
+	scope := "public"
+	if r.Header.Get("Authorization") != "" {
+		scope = "private"
+	}
+	return fmt.Sprintf("%s, max-age=%d", scope, rule.MaxAge)
+}
+
+// HTTP middleware for the gateway.
+func (hc *httpCache) handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		// Buffer the response to calculate its validator
+		rec := &responseRecorder{header: make(http.Header), status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+		for k, v := range rec.header {
+			w.Header()[k] = v
+		}
+		if rec.status != http.StatusOK {
+			w.WriteHeader(rec.status)
+			_, _ = w.Write(rec.body.Bytes())
+			return
+		}
+		digest := sha256.Sum256(rec.body.Bytes())
+		etag := fmt.Sprintf("\"%s\"", hex.EncodeToString(digest[:16]))
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Cache-Control", hc.control(r))
+		if etagMatch(r.Header.Get("If-None-Match"), etag) {
+			w.Header().Del("Content-Length")
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(rec.body.Bytes())
+	})
+}
+
+// Verify if the value of an "If-None-Match" header matches 'etag', using
+// the weak comparison function described on RFC 7232.
+func etagMatch(header, etag string) bool {
+	if header == "" {
+		return false
+	}
+	for _, v := range strings.Split(header, ",") {
+		v = strings.TrimSpace(v)
+		if v == "*" || strings.TrimPrefix(v, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// Captures the response produced by a handler.
+type responseRecorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (rr *responseRecorder) Header() http.Header {
+	return rr.header
+}
+
+func (rr *responseRecorder) WriteHeader(status int) {
+	rr.status = status
+}
+
+func (rr *responseRecorder) Write(b []byte) (int, error) {
+	return rr.body.Write(b)
+}
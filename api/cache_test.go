@@ -0,0 +1,80 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHTTPCache(t *testing.T) {
+	hc, err := newHTTPCache([]*CacheRule{
+		{Path: "/v1/api", MaxAge: 30},
+		{Path: "/v1/api/session", MaxAge: 0},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	calls := 0
+	h := hc.handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{"ok":true}`)
+	}))
+	get := func(path, etag string, auth bool) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		if etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+		if auth {
+			req.Header.Set("Authorization", "Bearer token")
+		}
+		res := httptest.NewRecorder()
+		h.ServeHTTP(res, req)
+		return res
+	}
+
+	// Initial request
+	res := get("/v1/api/ping", "", false)
+	etag := res.Header().Get("ETag")
+	if res.Code != http.StatusOK || etag == "" || res.Body.String() != `{"ok":true}` {
+		t.Fatal("invalid response")
+	}
+	if cc := res.Header().Get("Cache-Control"); cc != "public, max-age=30" {
+		t.Errorf("invalid cache control: %s", cc)
+	}
+
+	// Conditional requests
+	if res = get("/v1/api/ping", etag, false); res.Code != http.StatusNotModified || res.Body.Len() != 0 {
+		t.Error("expected not modified response")
+	}
+	if res = get("/v1/api/ping", "W/"+etag+", \"other\"", false); res.Code != http.StatusNotModified {
+		t.Error("expected not modified response for weak validator")
+	}
+	if res = get("/v1/api/ping", "\"other\"", false); res.Code != http.StatusOK {
+		t.Error("expected full response")
+	}
+
+	// Rules
+	if cc := get("/v1/api/ping", "", true).Header().Get("Cache-Control"); cc != "private, max-age=30" {
+		t.Errorf("invalid cache control for authenticated request: %s", cc)
+	}
+	if cc := get("/v1/api/session", "", true).Header().Get("Cache-Control"); cc != "no-cache" {
+		t.Errorf("invalid cache control: %s", cc)
+	}
+	if cc := get("/v1/admin/queues", "", true).Header().Get("Cache-Control"); cc != "no-cache" {
+		t.Errorf("invalid cache control: %s", cc)
+	}
+
+	// Other methods are not handled
+	res = httptest.NewRecorder()
+	h.ServeHTTP(res, httptest.NewRequest(http.MethodPost, "/v1/api/ping", nil))
+	if res.Header().Get("ETag") != "" || calls != 8 {
+		t.Error("only GET requests should be handled")
+	}
+
+	// Invalid rules
+	if _, err := newHTTPCache([]*CacheRule{{Path: "v1/api"}}); err == nil {
+		t.Error("invalid path should be rejected")
+	}
+}
@@ -0,0 +1,64 @@
+package api
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+)
+
+// Maximum length of the client version and platform values.
+const maxClientField = 32
+
+// Maximum length of the period covered by client version stats.
+const maxClientVersionsWindow = 31 * 24 * time.Hour
+
+// Allowed characters on client version and platform values.
+var clientFieldRE = regexp.MustCompile(`^[A-Za-z0-9._+-]*$`)
+
+// Verify the client application details reported on a records request.
+func validateClient(req *protov1.RecordRequest) error {
+	fields := map[string]string{
+		"app_version": req.AppVersion,
+		"sdk_version": req.SdkVersion,
+		"platform":    req.Platform,
+	}
+	for name, value := range fields {
+		if len(value) > maxClientField || !clientFieldRE.MatchString(value) {
+			return invalidArgument(name,
+				fmt.Sprintf("up to %d letters, digits, '.', '_', '+' or '-' are supported", maxClientField))
+		}
+	}
+	return nil
+}
+
+// Client application details reported on a records request, if any.
+func clientInfo(req *protov1.RecordRequest) *protov1.ClientInfo {
+	if req.AppVersion == "" && req.SdkVersion == "" && req.Platform == "" {
+		return nil
+	}
+	return &protov1.ClientInfo{
+		AppVersion: req.AppVersion,
+		SdkVersion: req.SdkVersion,
+		Platform:   req.Platform,
+	}
+}
+
+// ClientVersions returns the number of location records, and distinct users,
+// submitted by each client application version during a period of time.
+// Helps operators correlate bad data with specific client releases.
+func (srv *Server) ClientVersions(req *protov1.ClientVersionsRequest) (*protov1.ClientVersionsResponse, error) {
+	if req.From == 0 || req.To < req.From {
+		return nil, invalidArgument("from", "invalid time range")
+	}
+	from, to := time.Unix(req.From, 0), time.Unix(req.To, 0)
+	if to.Sub(from) > maxClientVersionsWindow {
+		return nil, invalidArgument("to", fmt.Sprintf("periods of up to %s are supported", maxClientVersionsWindow))
+	}
+	list, err := srv.repos.Records().ClientVersions(from, to)
+	if err != nil {
+		return nil, errInternalError
+	}
+	return &protov1.ClientVersionsResponse{Versions: list}, nil
+}
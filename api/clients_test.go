@@ -0,0 +1,32 @@
+package api
+
+import (
+	"strings"
+	"testing"
+
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+)
+
+func TestValidateClient(t *testing.T) {
+	req := &protov1.RecordRequest{AppVersion: "1.4.2-rc.1", SdkVersion: "0.9.0", Platform: "android"}
+	if err := validateClient(req); err != nil {
+		t.Fatal(err)
+	}
+	if c := clientInfo(req); c == nil || c.AppVersion != req.AppVersion || c.Platform != "android" {
+		t.Error("invalid client details")
+	}
+	if clientInfo(&protov1.RecordRequest{}) != nil {
+		t.Error("client details should be omitted when not reported")
+	}
+
+	invalid := []*protov1.RecordRequest{
+		{AppVersion: "1.4.2; drop"},
+		{SdkVersion: strings.Repeat("1", maxClientField+1)},
+		{Platform: "<ios>"},
+	}
+	for i, r := range invalid {
+		if err := validateClient(r); err == nil {
+			t.Errorf("%d: invalid client details should be rejected", i)
+		}
+	}
+}
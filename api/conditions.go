@@ -0,0 +1,133 @@
+package api
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Condition restricts a permission granted to a role by the access policy,
+// based on the attributes of the request. A request is only allowed if it
+// satisfies all the conditions defined for the role and permission.
+type Condition struct {
+	// Role the condition applies to.
+	Role string `mapstructure:"role"`
+
+	// Permission restricted, in the form "resource:action", i.e. "record:read".
+	// Use "*" as action to restrict any action on the resource.
+	Permission string `mapstructure:"permission"`
+
+	// Time of day the permission can be used, as "HH:MM-HH:MM". Periods
+	// crossing midnight are supported, i.e. "22:00-06:00".
+	Hours string `mapstructure:"hours"`
+
+	// Time zone used for 'hours', as an IANA name, i.e. "America/Mexico_City".
+	// UTC is used by default.
+	TimeZone string `mapstructure:"timezone"`
+
+	// Only allow the permission on resources owned by the credentials holder,
+	// i.e. a user may only query its own records.
+	Owner bool `mapstructure:"owner"`
+
+	// Only allow the permission on resources within one of the namespaces,
+	// i.e. geohash prefixes for location records.
+	Namespaces []string `mapstructure:"namespaces"`
+}
+
+// Attributes of the resource an authorization request refers to.
+type resourceAttrs struct {
+	// DID of the resource owner, if any.
+	owner string
+
+	// Namespace the resource belongs to, if any.
+	namespace string
+}
+
+// Parsed access condition.
+type accessCondition struct {
+	role       string
+	resource   string
+	action     string
+	from       int // minutes after midnight
+	to         int
+	hours      bool
+	loc        *time.Location
+	owner      bool
+	namespaces []string
+}
+
+// Validate the access conditions settings.
+func loadConditions(list []*Condition, roles map[string]bool) ([]*accessCondition, error) {
+	var res []*accessCondition
+	for _, c := range list {
+		if !roles[c.Role] {
+			return nil, errors.Errorf("invalid condition, unknown role: '%s'", c.Role)
+		}
+		if !validScope([]string{c.Permission}) {
+			return nil, errors.Errorf("invalid condition permission: '%s'", c.Permission)
+		}
+		ac := &accessCondition{
+			role:       c.Role,
+			owner:      c.Owner,
+			namespaces: c.Namespaces,
+			loc:        time.UTC,
+		}
+		ac.resource, ac.action = splitScope(c.Permission)
+		if c.Hours != "" {
+			var h1, m1, h2, m2 int
+			if _, err := fmt.Sscanf(c.Hours, "%d:%d-%d:%d", &h1, &m1, &h2, &m2); err != nil ||
+				h1 > 23 || h2 > 23 || m1 > 59 || m2 > 59 || h1 < 0 || h2 < 0 || m1 < 0 || m2 < 0 {
+				return nil, errors.Errorf("invalid condition hours: '%s'", c.Hours)
+			}
+			ac.hours = true
+			ac.from, ac.to = h1*60+m1, h2*60+m2
+		}
+		if c.TimeZone != "" {
+			loc, err := time.LoadLocation(c.TimeZone)
+			if err != nil {
+				return nil, errors.Errorf("invalid condition time zone: '%s'", c.TimeZone)
+			}
+			ac.loc = loc
+		}
+		res = append(res, ac)
+	}
+	return res, nil
+}
+
+// Verify the condition applies to the provided role and permission.
+func (ac *accessCondition) applies(role, resource, action string) bool {
+	return ac.role == role &&
+		ac.resource == strings.TrimPrefix(resource, "/") &&
+		(ac.action == "*" || ac.action == action)
+}
+
+// Evaluate the condition for a request performed by 'did' at 'now'.
+func (ac *accessCondition) eval(did string, attrs *resourceAttrs, now time.Time) bool {
+	if ac.hours {
+		local := now.In(ac.loc)
+		m := local.Hour()*60 + local.Minute()
+		if ac.from <= ac.to && (m < ac.from || m >= ac.to) {
+			return false
+		}
+		if ac.from > ac.to && m < ac.from && m >= ac.to {
+			return false
+		}
+	}
+	if ac.owner && (attrs == nil || attrs.owner == "" || attrs.owner != did) {
+		return false
+	}
+	if len(ac.namespaces) > 0 {
+		if attrs == nil || attrs.namespace == "" {
+			return false
+		}
+		for _, ns := range ac.namespaces {
+			if strings.HasPrefix(attrs.namespace, ns) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
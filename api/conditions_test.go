@@ -0,0 +1,67 @@
+package api
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAccessConditions(t *testing.T) {
+	roles, _ := loadRoles([]*Role{{Name: "auditor", Permissions: []string{"record:export"}}})
+	conds, err := loadConditions([]*Condition{
+		{Role: "auditor", Permission: "record:*", Hours: "08:00-18:00", Namespaces: []string{"9g3"}},
+		{Role: "user", Permission: "subject:read", Owner: true},
+		{Role: "agent", Permission: "analytics:read", Hours: "22:00-06:00"},
+	}, roles)
+	if err != nil {
+		t.Fatal(err)
+	}
+	at := func(h, m int) time.Time {
+		return time.Date(2020, 5, 1, h, m, 0, 0, time.UTC)
+	}
+
+	// Applicable conditions
+	if !conds[0].applies("auditor", "/record", "export") || conds[0].applies("agent", "/record", "export") {
+		t.Error("invalid condition match for role")
+	}
+	if conds[1].applies("user", "/subject", "delete") {
+		t.Error("invalid condition match for action")
+	}
+
+	// Time of day and namespace
+	ns := &resourceAttrs{namespace: "9g3qx"}
+	if !conds[0].eval("", ns, at(9, 30)) {
+		t.Error("request should be allowed")
+	}
+	if conds[0].eval("", ns, at(18, 0)) || conds[0].eval("", &resourceAttrs{namespace: "9g4"}, at(9, 30)) {
+		t.Error("request should be rejected")
+	}
+	if conds[0].eval("", nil, at(9, 30)) {
+		t.Error("request without namespace should be rejected")
+	}
+	if !conds[2].eval("", nil, at(23, 0)) || !conds[2].eval("", nil, at(5, 59)) || conds[2].eval("", nil, at(12, 0)) {
+		t.Error("invalid evaluation for period crossing midnight")
+	}
+
+	// Ownership
+	if !conds[1].eval("did:bryk:a", &resourceAttrs{owner: "did:bryk:a"}, at(0, 0)) {
+		t.Error("owner should be allowed")
+	}
+	other := &resourceAttrs{owner: "did:bryk:b"}
+	if conds[1].eval("did:bryk:a", other, at(0, 0)) || conds[1].eval("did:bryk:a", nil, at(0, 0)) {
+		t.Error("non-owner should be rejected")
+	}
+
+	// Invalid settings
+	invalid := []*Condition{
+		{Role: "lab", Permission: "record:read"},
+		{Role: "user", Permission: "record"},
+		{Role: "user", Permission: "record:read", Hours: "25:00-06:00"},
+		{Role: "user", Permission: "record:read", Hours: "morning"},
+		{Role: "user", Permission: "record:read", TimeZone: "Mars/Olympus"},
+	}
+	for i, c := range invalid {
+		if _, err := loadConditions([]*Condition{c}, roles); err == nil {
+			t.Errorf("%d: invalid condition should be rejected", i)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package api
+
+import (
+	"embed"
+	"io/fs"
+	"net/http"
+	"strings"
+)
+
+// Path prefix for the dashboard on the HTTP gateway.
+const dashboardPath = "/dashboard/"
+
+// Static assets for the agents web dashboard. The dashboard is a client-side
+// application using the HTTP gateway endpoints with the agent's credentials,
+// no additional server-side session handling is required.
+//
+//go:embed dashboard
+var dashboardAssets embed.FS
+
+// Serve the dashboard assets, all other requests are passed to 'next'.
+func dashboardHandler(next http.Handler) http.Handler {
+	assets, _ := fs.Sub(dashboardAssets, "dashboard") // static and valid path
+	files := http.StripPrefix(dashboardPath, http.FileServer(http.FS(assets)))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == strings.TrimSuffix(dashboardPath, "/") {
+			http.Redirect(w, r, dashboardPath, http.StatusMovedPermanently)
+			return
+		}
+		if !strings.HasPrefix(r.URL.Path, dashboardPath) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		// Credentials are kept by the browser, prevent the dashboard from
+		// being framed or loading external resources
+		h := w.Header()
+		h.Set("Cache-Control", "no-cache")
+		h.Set("Content-Security-Policy", "default-src 'self'; img-src 'self' data:; frame-ancestors 'none'")
+		h.Set("X-Content-Type-Options", "nosniff")
+		h.Set("X-Frame-Options", "DENY")
+		h.Set("Referrer-Policy", "no-referrer")
+		files.ServeHTTP(w, r)
+	})
+}
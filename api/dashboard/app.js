@@ -0,0 +1,245 @@
+// Agents dashboard. All operations are performed using the HTTP gateway
+// endpoints with the agent's credentials, kept on the browser session storage
+// and discarded when the tab is closed.
+(function () {
+  'use strict';
+
+  var storageKey = 'ct19.credentials';
+  var $ = function (sel) { return document.querySelector(sel); };
+
+  function credentials() {
+    try {
+      return JSON.parse(sessionStorage.getItem(storageKey));
+    } catch (e) {
+      return null;
+    }
+  }
+
+  function notify(text, isError) {
+    var el = $('#message');
+    el.textContent = text;
+    el.className = isError ? 'error' : 'info';
+  }
+
+  function clearMessage() {
+    $('#message').className = 'hidden';
+  }
+
+  // Exchange the refresh code for a new access token.
+  function renew(creds) {
+    return fetch('../v1/api/credentials_renew', {
+      method: 'POST',
+      headers: { 'Content-Type': 'application/json' },
+      body: JSON.stringify({ refresh_code: creds.refresh_code })
+    }).then(function (res) {
+      if (!res.ok) {
+        throw new Error('credentials expired, please sign in again');
+      }
+      return res.json();
+    }).then(function (renewed) {
+      sessionStorage.setItem(storageKey, JSON.stringify(renewed));
+      return renewed;
+    });
+  }
+
+  // Invoke an API endpoint, renewing expired credentials once.
+  function call(path, body, retried) {
+    var creds = credentials();
+    if (!creds) {
+      return Promise.reject(new Error('not signed in'));
+    }
+    return fetch('../v1/api/' + path, {
+      method: 'POST',
+      headers: {
+        'Content-Type': 'application/json',
+        'Accept-Language': navigator.language || 'en',
+        'Authorization': 'Bearer ' + creds.access_token
+      },
+      body: JSON.stringify(body || {})
+    }).then(function (res) {
+      if (res.status === 401 && !retried && creds.refresh_code) {
+        return renew(creds).then(function () { return call(path, body, true); });
+      }
+      return res.json().then(function (data) {
+        if (!res.ok) {
+          throw new Error(data.message || data.error || res.statusText);
+        }
+        return data;
+      });
+    });
+  }
+
+  function showApp(info) {
+    $('#login').className = 'hidden';
+    $('#app').className = '';
+    $('#logout').className = '';
+    $('#identity').textContent = info.role + (info.sub ? ' · ' + info.sub : '');
+  }
+
+  function showLogin() {
+    $('#login').className = '';
+    $('#app').className = 'hidden';
+    $('#logout').className = 'hidden';
+    $('#identity').textContent = '';
+  }
+
+  // Verify the stored credentials belong to an agent.
+  function start() {
+    var creds = credentials();
+    if (!creds) {
+      showLogin();
+      return;
+    }
+    call('introspect', { token: creds.access_token }).then(function (info) {
+      if (!info.active) {
+        throw new Error('invalid or expired credentials');
+      }
+      showApp(info);
+    }).catch(function (err) {
+      sessionStorage.removeItem(storageKey);
+      showLogin();
+      notify(err.message, true);
+    });
+  }
+
+  function toUnix(value) {
+    return Math.floor(new Date(value).getTime() / 1000);
+  }
+
+  function fromUnix(value) {
+    return new Date(Number(value) * 1000).toLocaleString();
+  }
+
+  // Base64 encoding for UTF-8 contents.
+  function encode(text) {
+    return btoa(unescape(encodeURIComponent(text)));
+  }
+
+  $('#login-form').addEventListener('submit', function (ev) {
+    ev.preventDefault();
+    clearMessage();
+    try {
+      var creds = JSON.parse(ev.target.credentials.value);
+      if (!creds.access_token) {
+        throw new Error();
+      }
+      sessionStorage.setItem(storageKey, JSON.stringify(creds));
+      ev.target.reset();
+      start();
+    } catch (e) {
+      notify('invalid credentials file', true);
+    }
+  });
+
+  $('#logout').addEventListener('click', function () {
+    sessionStorage.removeItem(storageKey);
+    clearMessage();
+    showLogin();
+  });
+
+  document.querySelectorAll('nav button').forEach(function (btn) {
+    btn.addEventListener('click', function () {
+      document.querySelectorAll('nav button').forEach(function (b) { b.classList.remove('active'); });
+      document.querySelectorAll('.tab').forEach(function (t) { t.classList.add('hidden'); });
+      btn.classList.add('active');
+      $('#' + btn.dataset.tab).classList.remove('hidden');
+      clearMessage();
+    });
+  });
+
+  $('#codes-form').addEventListener('submit', function (ev) {
+    ev.preventDefault();
+    clearMessage();
+    var out = $('#codes-result');
+    out.textContent = '';
+    call('activation_code/bulk', {
+      count: Number(ev.target.count.value),
+      campaign: ev.target.campaign.value,
+      qr_images: true
+    }).then(function (res) {
+      (res.codes || []).forEach(function (c) {
+        var card = document.createElement('figure');
+        var img = document.createElement('img');
+        img.src = 'data:image/png;base64,' + c.qr_image;
+        img.alt = c.activation_code;
+        var caption = document.createElement('figcaption');
+        caption.textContent = c.activation_code + ' (expires ' + fromUnix(c.expires) + ')';
+        card.appendChild(img);
+        card.appendChild(caption);
+        out.appendChild(card);
+      });
+      notify((res.codes || []).length + ' activation codes generated');
+    }).catch(function (err) { notify(err.message, true); });
+  });
+
+  $('#diagnosis-form [name=file]').addEventListener('change', function (ev) {
+    var file = ev.target.files[0];
+    if (!file) {
+      return;
+    }
+    file.text().then(function (text) { $('#diagnosis-form [name=resource]').value = text; });
+  });
+
+  $('#diagnosis-form').addEventListener('submit', function (ev) {
+    ev.preventDefault();
+    clearMessage();
+    var resource = ev.target.resource.value;
+    try {
+      JSON.parse(resource);
+    } catch (e) {
+      notify('the resource is not a valid JSON document', true);
+      return;
+    }
+    call('lab_result', { resource: encode(resource) }).then(function () {
+      ev.target.reset();
+      notify('lab result submitted');
+    }).catch(function (err) { notify(err.message, true); });
+  });
+
+  $('#exposure-form').addEventListener('submit', function (ev) {
+    ev.preventDefault();
+    clearMessage();
+    var area = ev.target.area.value.trim().split('\n').map(function (line) {
+      var p = line.split(',');
+      return { lat: parseFloat(p[0]), lng: parseFloat(p[1]) };
+    });
+    call('exposure_query', {
+      area: area,
+      from: toUnix(ev.target.from.value),
+      to: toUnix(ev.target.to.value),
+      purpose: ev.target.purpose.value
+    }).then(function (res) {
+      var table = $('#exposure-result');
+      var body = table.querySelector('tbody');
+      body.textContent = '';
+      (res.presence || []).forEach(function (p) {
+        var row = body.insertRow();
+        [p.cell, p.users, fromUnix(p.from), fromUnix(p.to)].forEach(function (v) {
+          row.insertCell().textContent = v;
+        });
+      });
+      table.className = '';
+      notify((res.users || 0) + ' distinct users present in the area');
+    }).catch(function (err) { notify(err.message, true); });
+  });
+
+  $('#notifications-form').addEventListener('submit', function (ev) {
+    ev.preventDefault();
+    clearMessage();
+    call('notification/status', { source: ev.target.source.value }).then(function (res) {
+      var out = $('#notifications-result');
+      out.textContent = '';
+      ['total', 'pending', 'dispatched', 'failed', 'delivered', 'read'].forEach(function (k) {
+        var dt = document.createElement('dt');
+        var dd = document.createElement('dd');
+        dt.textContent = k;
+        dd.textContent = res[k] || 0;
+        out.appendChild(dt);
+        out.appendChild(dd);
+      });
+      out.className = '';
+    }).catch(function (err) { notify(err.message, true); });
+  });
+
+  start();
+})();
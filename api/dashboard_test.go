@@ -0,0 +1,37 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestDashboardHandler(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+	h := dashboardHandler(next)
+	get := func(path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec
+	}
+
+	rec := get("/dashboard/")
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), "app.js") {
+		t.Errorf("invalid index response: %d", rec.Code)
+	}
+	if rec.Header().Get("Content-Security-Policy") == "" {
+		t.Error("missing security headers")
+	}
+	if rec = get("/dashboard/app.js"); rec.Code != http.StatusOK {
+		t.Errorf("invalid asset response: %d", rec.Code)
+	}
+	if rec = get("/dashboard"); rec.Code != http.StatusMovedPermanently {
+		t.Errorf("expected a redirect: %d", rec.Code)
+	}
+	if rec = get("/v1/api/ping"); rec.Code != http.StatusTeapot {
+		t.Errorf("gateway request not forwarded: %d", rec.Code)
+	}
+}
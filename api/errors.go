@@ -0,0 +1,78 @@
+package api
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Suggested delay before retrying requests that failed due to a temporary
+// condition.
+const retryDelay = 5 * time.Second
+
+// Common error codes
+var (
+	errUnauthorized = newError(codes.PermissionDenied,
+		protov1.ErrorCode_ERROR_CODE_UNAUTHORIZED, "unauthorized request")
+	errUnauthenticated = newError(codes.Unauthenticated,
+		protov1.ErrorCode_ERROR_CODE_UNAUTHENTICATED, "invalid credentials")
+	errInvalidDID = newError(codes.InvalidArgument,
+		protov1.ErrorCode_ERROR_CODE_INVALID_DID, "invalid or unresolvable DID")
+	errInvalidSignature = newError(codes.InvalidArgument,
+		protov1.ErrorCode_ERROR_CODE_INVALID_SIGNATURE, "invalid signature")
+	errReplayedProof = newError(codes.InvalidArgument,
+		protov1.ErrorCode_ERROR_CODE_INVALID_SIGNATURE, "signature proof already used")
+	errInvalidActivationCode = newError(codes.InvalidArgument,
+		protov1.ErrorCode_ERROR_CODE_INVALID_ACTIVATION_CODE, "invalid or expired activation code")
+	errInvalidRefreshCode = newError(codes.InvalidArgument,
+		protov1.ErrorCode_ERROR_CODE_INVALID_REFRESH_CODE, "invalid refresh code")
+	errInvalidAttestation = newError(codes.InvalidArgument,
+		protov1.ErrorCode_ERROR_CODE_INVALID_ATTESTATION, "invalid device attestation")
+	errPurposeNotAllowed = newError(codes.PermissionDenied,
+		protov1.ErrorCode_ERROR_CODE_UNAUTHORIZED, "processing purpose not allowed for the requested data")
+	errInternalError = newError(codes.Internal,
+		protov1.ErrorCode_ERROR_CODE_INTERNAL, "internal error")
+	errNotEnabled = newError(codes.Unimplemented,
+		protov1.ErrorCode_ERROR_CODE_NOT_ENABLED, "feature not enabled")
+	errFailedToPublish = newError(codes.Unavailable,
+		protov1.ErrorCode_ERROR_CODE_UNAVAILABLE, "failed to publish message",
+		&errdetails.RetryInfo{RetryDelay: ptypes.DurationProto(retryDelay)})
+	errRateLimited = newError(codes.ResourceExhausted,
+		protov1.ErrorCode_ERROR_CODE_RATE_LIMITED, "rate limit exceeded",
+		&errdetails.RetryInfo{RetryDelay: ptypes.DurationProto(time.Second)})
+)
+
+// Returns a status error including an error detail entry with the
+// provided catalog code, along with any additional details. The status
+// package encodes and resolves details using the golang/protobuf registry,
+// so 'ErrorDetail' is registered there as well; see 'goproto_registration'
+// on "errors.proto".
+func newError(c codes.Code, code protov1.ErrorCode, msg string, details ...proto.Message) error {
+	st := status.New(c, msg)
+	details = append([]proto.Message{&protov1.ErrorDetail{Code: code, Message: msg}}, details...)
+	if rich, err := st.WithDetails(details...); err == nil {
+		st = rich
+	}
+	return st.Err()
+}
+
+// Returns an "invalid argument" error describing the offending request
+// field.
+func invalidArgument(field, description string) error {
+	return newError(codes.InvalidArgument, protov1.ErrorCode_ERROR_CODE_INVALID_ARGUMENT, "invalid request argument",
+		&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: field, Description: description},
+			},
+		})
+}
+
+// Returns a "not found" error for the provided entity kind.
+func notFound(kind string) error {
+	return newError(codes.NotFound, protov1.ErrorCode_ERROR_CODE_NOT_FOUND, kind+" not found")
+}
@@ -0,0 +1,48 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestErrorDetails(t *testing.T) {
+	// Encode the status as sent on the wire, and decode it back
+	data, err := proto.Marshal(status.Convert(invalidArgument("records", "empty request")).Proto())
+	if err != nil {
+		t.Fatal(err)
+	}
+	sp := &spb.Status{}
+	if err := proto.Unmarshal(data, sp); err != nil {
+		t.Fatal(err)
+	}
+	st := status.FromProto(sp)
+	if st.Code() != codes.InvalidArgument {
+		t.Errorf("invalid status code: %s", st.Code())
+	}
+
+	// Catalog code and additional details are resolved
+	var code protov1.ErrorCode
+	var violations int
+	for _, d := range st.Details() {
+		switch detail := d.(type) {
+		case *protov1.ErrorDetail:
+			code = detail.Code
+		case *errdetails.BadRequest:
+			violations = len(detail.FieldViolations)
+		case error:
+			t.Errorf("failed to decode detail: %s", detail)
+		}
+	}
+	if code != protov1.ErrorCode_ERROR_CODE_INVALID_ARGUMENT {
+		t.Errorf("missing error detail: %v", st.Details())
+	}
+	if violations != 1 {
+		t.Error("missing field violations")
+	}
+}
@@ -0,0 +1,84 @@
+package api
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/x/amqp"
+)
+
+// Platform events published to the "events" exchange. Downstream systems
+// can bind their own queues to the exchange to receive them.
+const (
+	// Location records stored for a user.
+	// Attributes: "records".
+	eventRecordStored = "record_stored"
+
+	// Test result received for a user.
+	// Attributes: "diagnosis", "result", "source".
+	eventDiagnosisStored = "diagnosis_stored"
+
+	// Potential contagion risk detected for a user.
+	// Attributes: "exposure", "diagnosis".
+	eventExposureDetected = "exposure_detected"
+
+	// Access credentials issued for a user.
+	// Attributes: "role".
+	eventCredentialIssued = "credential_issued"
+
+	// Health certificate issued for a user.
+	// Attributes: "diagnosis", "format".
+	eventCertificateIssued = "certificate_issued"
+)
+
+// Prepare a new platform event.
+func newEvent(kind, subject string, attrs map[string]string) *protov1.Event {
+	return &protov1.Event{
+		Id:         uuid.New().String(),
+		Kind:       kind,
+		Timestamp:  time.Now().Unix(),
+		Did:        subject,
+		Attributes: attrs,
+	}
+}
+
+// Publish a new platform event. Events are delivered on a best-effort basis;
+// failing to publish an event won't interrupt the operation that generated it.
+func publishEvent(pub publisher, ev *protov1.Event) error {
+	contents, err := ev.Marshal()
+	if err != nil {
+		return err
+	}
+	msg := amqp.Message{
+		Type:        "ct19.event." + ev.Kind,
+		Timestamp:   time.Now().UTC(),
+		MessageId:   ev.Id,
+		ContentType: "application/protobuf",
+		Body:        contents,
+	}
+	_, err = pub.Push(msg, amqp.MessageOptions{
+		Exchange:   "events",
+		Persistent: true,
+	})
+	return err
+}
+
+// Publish a platform event from the API server, and notify the registered
+// hooks.
+func (srv *Server) event(kind, subject string, attrs map[string]string) {
+	ev := newEvent(kind, subject, attrs)
+	srv.hooks.dispatch(ev, srv.log)
+	if err := publishEvent(srv.publisher(), ev); err != nil {
+		srv.log.WithField("kind", kind).Warning("failed to publish event")
+	}
+}
+
+// Publish a platform event from the worker, and notify the registered hooks.
+func (w *Worker) event(kind, subject string, attrs map[string]string) {
+	ev := newEvent(kind, subject, attrs)
+	w.hooks.dispatch(ev, w.log)
+	if err := publishEvent(w.pub, ev); err != nil {
+		w.log.WithField("kind", kind).Warning("failed to publish event")
+	}
+}
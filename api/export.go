@@ -0,0 +1,85 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/covid-tracking/storage"
+	"go.bryk.io/covid-tracking/utils"
+	"go.bryk.io/x/jwx"
+	"google.golang.org/grpc/status"
+)
+
+// Number of records per chunk on exports.
+const (
+	defaultExportChunk = 500
+	maxExportChunk     = 5000
+)
+
+// ExportRecords streams the location records matching the request filter, and
+// tagged with the processing purpose declared, in chunks, using 'send' to
+// deliver each one. All exports are registered on the audit log.
+func (srv *Server) ExportRecords(ctx context.Context, token *jwx.Token, req *protov1.ExportRecordsRequest,
+	send func(*protov1.RecordsChunk) error) error {
+	if req.From == 0 || req.To < req.From {
+		return invalidArgument("from", "invalid time range")
+	}
+	if req.Cell != "" && !utils.ValidGeoHash(req.Cell) {
+		return invalidArgument("cell", "invalid geohash prefix")
+	}
+	size := int(req.ChunkSize)
+	if size == 0 {
+		size = defaultExportChunk
+	}
+	if size > maxExportChunk {
+		return invalidArgument("chunk_size", fmt.Sprintf("up to %d records per chunk are supported", maxExportChunk))
+	}
+	if err := declaredPurpose(req.Purpose, recordPurposes); err != nil {
+		return err
+	}
+	fields, err := parseFieldMask(req.Fields, &protov1.LocationRecord{})
+	if err != nil {
+		return invalidArgument("fields", err.Error())
+	}
+	data := &credentialsData{}
+	if err := token.Decode(&data); err != nil {
+		return errUnauthenticated
+	}
+
+	// Stream records
+	count := 0
+	filter := storage.RecordsFilter{
+		From:    time.Unix(req.From, 0),
+		To:      time.Unix(req.To, 0),
+		DID:     req.Did,
+		Cell:    req.Cell,
+		Purpose: req.Purpose,
+	}
+	err = srv.repos.Records().ExportRecords(ctx, filter, size, func(records []*protov1.LocationRecord) error {
+		chunk := &protov1.RecordsChunk{Records: records}
+		fields.apply(chunk.Records)
+		count += len(records)
+		return send(chunk)
+	})
+	srv.audit(&storage.AuditEntry{
+		Event:   auditRecordsExport,
+		Actor:   data.DID,
+		Address: clientAddress(ctx),
+		Details: map[string]string{
+			"from":    strconv.FormatInt(req.From, 10),
+			"to":      strconv.FormatInt(req.To, 10),
+			"did":     req.Did,
+			"cell":    req.Cell,
+			"purpose": req.Purpose,
+			"records": strconv.Itoa(count),
+		},
+	})
+	if _, ok := status.FromError(err); !ok {
+		// Storage errors
+		return errInternalError
+	}
+	return err
+}
@@ -0,0 +1,150 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/covid-tracking/storage"
+	"go.bryk.io/covid-tracking/utils"
+	"go.bryk.io/x/jwx"
+)
+
+// Exposure query limits.
+const (
+	// Maximum number of vertices on the queried area.
+	maxExposureVertices = 100
+
+	// Maximum length of the queried period.
+	maxExposureWindow = 31 * 24 * time.Hour
+)
+
+// Verify and convert the vertices of a queried area to a closed ring of
+// [lng, lat] pairs, as expected by GeoJSON polygons.
+func exposureArea(points []*protov1.GeoPoint) ([][2]float64, error) {
+	if len(points) < 3 || len(points) > maxExposureVertices {
+		return nil, invalidArgument("area",
+			fmt.Sprintf("between 3 and %d vertices are supported", maxExposureVertices))
+	}
+	ring := make([][2]float64, 0, len(points)+1)
+	for i, p := range points {
+		if p == nil || !validCoordinates(p.Lat, p.Lng) {
+			return nil, invalidArgument(fmt.Sprintf("area[%d]", i), "latitude or longitude out of range")
+		}
+		ring = append(ring, [2]float64{float64(p.Lng), float64(p.Lat)})
+	}
+	if ring[0] != ring[len(ring)-1] {
+		ring = append(ring, ring[0])
+	}
+	if len(ring) < 4 {
+		return nil, invalidArgument("area", "at least 3 distinct vertices are required")
+	}
+	return ring, nil
+}
+
+// ExposureQuery returns the anonymized presence counts inside an area during
+// a period of time, based on the records tagged with the processing purpose
+// declared. When requested, and authorized, the identifiers of the users
+// present are included. All queries are registered on the audit log.
+func (srv *Server) ExposureQuery(ctx context.Context, token *jwx.Token,
+	req *protov1.ExposureQueryRequest) (*protov1.ExposureQueryResponse, error) {
+	area, err := exposureArea(req.Area)
+	if err != nil {
+		return nil, err
+	}
+	if req.From == 0 || req.To < req.From {
+		return nil, invalidArgument("from", "invalid time range")
+	}
+	from, to := time.Unix(req.From, 0), time.Unix(req.To, 0)
+	if to.Sub(from) > maxExposureWindow {
+		return nil, invalidArgument("to", fmt.Sprintf("periods of up to %s are supported", maxExposureWindow))
+	}
+	if req.Identifiers && strings.TrimSpace(req.Reason) == "" {
+		return nil, invalidArgument("reason", "a justification is required to retrieve identifiers")
+	}
+	if err := declaredPurpose(req.Purpose, recordPurposes); err != nil {
+		return nil, err
+	}
+	data := &credentialsData{}
+	if err := token.Decode(&data); err != nil {
+		return nil, errUnauthenticated
+	}
+	list, err := srv.repos.Records().PresenceWithin(area, from, to, req.Purpose)
+	if err != nil {
+		return nil, errInternalError
+	}
+
+	// Aggregate results within the agent's jurisdiction
+	org := srv.organization(data)
+	res := &protov1.ExposureQueryResponse{}
+	users := make(map[string]bool)
+	for _, p := range list {
+		if !inJurisdiction(org, p.Cell) {
+			continue
+		}
+		for _, did := range p.Users {
+			users[did] = true
+		}
+		if srv.privacy.check(int64(len(p.Users))) == nil {
+			res.Presence = append(res.Presence, presence(p))
+		}
+	}
+	sort.Slice(res.Presence, func(i, j int) bool {
+		if res.Presence[i].From != res.Presence[j].From {
+			return res.Presence[i].From < res.Presence[j].From
+		}
+		return res.Presence[i].Cell < res.Presence[j].Cell
+	})
+	res.Users = int64(len(users))
+	if !req.Identifiers {
+		if err := srv.privacy.check(res.Users); err != nil {
+			return nil, err
+		}
+		srv.audit(&storage.AuditEntry{
+			Event:   auditExposureQuery,
+			Actor:   data.DID,
+			Address: clientAddress(ctx),
+			Details: map[string]string{
+				"from":    strconv.FormatInt(req.From, 10),
+				"to":      strconv.FormatInt(req.To, 10),
+				"purpose": req.Purpose,
+			},
+		})
+		return res, nil
+	}
+
+	// Identifiers disclosure is always audited
+	for did := range users {
+		res.Identifiers = append(res.Identifiers, did)
+	}
+	sort.Strings(res.Identifiers)
+	srv.audit(&storage.AuditEntry{
+		Event:   auditExposureIdentifiers,
+		Actor:   data.DID,
+		Address: clientAddress(ctx),
+		Details: map[string]string{
+			"from":    strconv.FormatInt(req.From, 10),
+			"to":      strconv.FormatInt(req.To, 10),
+			"area":    fmt.Sprintf("%v", area),
+			"reason":  req.Reason,
+			"purpose": req.Purpose,
+			"users":   strconv.Itoa(len(res.Identifiers)),
+		},
+	})
+	return res, nil
+}
+
+// Convert a storage presence entry to its public representation.
+func presence(p *storage.Presence) *protov1.Presence {
+	size := int64(utils.BucketSize.Seconds())
+	return &protov1.Presence{
+		Cell:  p.Cell,
+		Users: int64(len(p.Users)),
+		From:  p.Bucket * size,
+		To:    (p.Bucket + 1) * size,
+	}
+}
@@ -0,0 +1,120 @@
+package api
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/covid-tracking/storage"
+	"go.bryk.io/covid-tracking/storage/memtest"
+	"go.bryk.io/x/jwx"
+)
+
+func TestExposureArea(t *testing.T) {
+	square := []*protov1.GeoPoint{
+		{Lat: 19.43, Lng: -99.13},
+		{Lat: 19.43, Lng: -99.12},
+		{Lat: 19.42, Lng: -99.12},
+		{Lat: 19.42, Lng: -99.13},
+	}
+	ring, err := exposureArea(square)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ring) != 5 || ring[0] != ring[4] {
+		t.Error("ring should be closed")
+	}
+	if ring[0] != [2]float64{float64(square[0].Lng), float64(square[0].Lat)} {
+		t.Error("invalid coordinates order")
+	}
+
+	// Already closed
+	closed := append(square, square[0])
+	if ring, _ = exposureArea(closed); len(ring) != 5 {
+		t.Error("closed rings should be preserved")
+	}
+
+	// Invalid areas
+	if _, err := exposureArea(square[:2]); err == nil {
+		t.Error("at least 3 vertices are required")
+	}
+	if _, err := exposureArea([]*protov1.GeoPoint{square[0], square[1], square[0]}); err == nil {
+		t.Error("at least 3 distinct vertices are required")
+	}
+	if _, err := exposureArea(append(square[:3:3], &protov1.GeoPoint{Lat: 91})); err == nil {
+		t.Error("invalid coordinates should be rejected")
+	}
+}
+
+func TestExposureQuery(t *testing.T) {
+	key, _ := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	tg, err := newSignerGenerator("ct19.test", key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := tg.NewToken("master", &jwx.TokenParameters{
+		Audience:   []string{"ct19.test"},
+		Subject:    "did:bryk:epidemiologist",
+		NotBefore:  "0ms",
+		Expiration: "1h",
+		CustomPayloadClaims: &credentialsData{
+			DID:  "did:bryk:epidemiologist",
+			Role: "epidemiologist",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Three users inside the area and one outside
+	now := time.Now()
+	store := memtest.New()
+	_ = store.Records().LocationRecords([]*protov1.LocationRecord{
+		{Did: "did:bryk:a", Lat: 19.425, Lng: -99.125, Timestamp: now.Unix()},
+		{Did: "did:bryk:b", Lat: 19.425, Lng: -99.125, Timestamp: now.Unix()},
+		{Did: "did:bryk:c", Lat: 19.425, Lng: -99.125, Timestamp: now.Unix()},
+		{Did: "did:bryk:d", Lat: 20.5, Lng: -100.5, Timestamp: now.Unix()},
+	})
+	srv := &Server{repos: store, privacy: &anonymityPolicy{k: 3}}
+	req := &protov1.ExposureQueryRequest{
+		Area: []*protov1.GeoPoint{
+			{Lat: 19.43, Lng: -99.13},
+			{Lat: 19.43, Lng: -99.12},
+			{Lat: 19.42, Lng: -99.12},
+			{Lat: 19.42, Lng: -99.13},
+		},
+		From:    now.Add(-1 * time.Hour).Unix(),
+		To:      now.Add(time.Hour).Unix(),
+		Purpose: storage.PurposeContactTracing,
+	}
+	res, err := srv.ExposureQuery(context.Background(), token, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Users != 3 || len(res.Presence) != 1 || len(res.Identifiers) != 0 {
+		t.Errorf("invalid results: %+v", res)
+	}
+	audit := store.AuditEntries()
+	if len(audit) != 1 || audit[0].Details["purpose"] != storage.PurposeContactTracing {
+		t.Errorf("query should be registered on the audit log: %+v", audit)
+	}
+
+	// Purpose declarations
+	for _, p := range []string{"", "marketing", storage.PurposeResearch} {
+		req.Purpose = p
+		if _, err := srv.ExposureQuery(context.Background(), token, req); err == nil {
+			t.Errorf("purpose should be rejected: '%s'", p)
+		}
+	}
+	req.Purpose = storage.PurposeContactTracing
+
+	// Results below the anonymity threshold
+	srv.privacy.k = 4
+	if _, err := srv.ExposureQuery(context.Background(), token, req); err == nil {
+		t.Error("results below the anonymity threshold returned")
+	}
+}
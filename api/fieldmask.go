@@ -0,0 +1,123 @@
+package api
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+)
+
+// Selected fields on a message, by name. Nested selections are used for
+// message fields; an empty selection includes the complete field.
+type fieldSelection map[string]fieldSelection
+
+// Parse the paths on a field mask and verify they are valid for messages of
+// type 'msg'. A nil selection is returned for empty masks.
+func parseFieldMask(mask *types.FieldMask, msg interface{}) (fieldSelection, error) {
+	if mask == nil || len(mask.Paths) == 0 {
+		return nil, nil
+	}
+	sel := make(fieldSelection)
+	for _, path := range mask.Paths {
+		cur := sel
+		names := strings.Split(path, ".")
+		for i, name := range names {
+			next, ok := cur[name]
+			if ok && len(next) == 0 {
+				// Field already included completely
+				break
+			}
+			if i == len(names)-1 {
+				cur[name] = make(fieldSelection)
+				break
+			}
+			if !ok {
+				next = make(fieldSelection)
+				cur[name] = next
+			}
+			cur = next
+		}
+	}
+	return sel, sel.validate(reflect.TypeOf(msg))
+}
+
+// Verify all selected fields exist on the message type 't'.
+func (sel fieldSelection) validate(t reflect.Type) error {
+	t = messageType(t)
+	if t == nil {
+		return errors.New("field selection on a non-message field")
+	}
+	fields := messageFields(t)
+	for name, sub := range sel {
+		i, ok := fields[name]
+		if !ok {
+			return errors.Errorf("unknown field: %s", name)
+		}
+		if len(sub) == 0 {
+			continue
+		}
+		if err := sub.validate(t.Field(i).Type); err != nil {
+			return errors.Wrap(err, name)
+		}
+	}
+	return nil
+}
+
+// Clear all the fields on 'msg' not included in the selection.
+func (sel fieldSelection) apply(msg interface{}) {
+	if sel == nil {
+		return
+	}
+	sel.prune(reflect.ValueOf(msg))
+}
+
+func (sel fieldSelection) prune(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if !v.IsNil() {
+			sel.prune(v.Elem())
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			sel.prune(v.Index(i))
+		}
+	case reflect.Struct:
+		for name, i := range messageFields(v.Type()) {
+			sub, ok := sel[name]
+			if !ok {
+				f := v.Field(i)
+				f.Set(reflect.Zero(f.Type()))
+				continue
+			}
+			if len(sub) > 0 {
+				sub.prune(v.Field(i))
+			}
+		}
+	}
+}
+
+// Return the message type for 't', including pointers to and lists of
+// messages. Returns nil if 't' is not a message type.
+func messageType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+	return t
+}
+
+// Index of the fields on a generated message type, by protobuf name.
+func messageFields(t reflect.Type) map[string]int {
+	fields := make(map[string]int)
+	for i := 0; i < t.NumField(); i++ {
+		for _, opt := range strings.Split(t.Field(i).Tag.Get("protobuf"), ",") {
+			if strings.HasPrefix(opt, "name=") {
+				fields[strings.TrimPrefix(opt, "name=")] = i
+			}
+		}
+	}
+	return fields
+}
@@ -0,0 +1,57 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+)
+
+func TestFieldMask(t *testing.T) {
+	res := func() *protov1.AnalyticsResponse {
+		return &protov1.AnalyticsResponse{
+			Hotspots: []*protov1.Hotspot{{Cell: "9g3w81", Lat: 19.43, Lng: -99.13, Users: 12}},
+			Flows:    []*protov1.Flow{{Origin: "9g3w81", Destination: "9g3w82", Users: 10}},
+		}
+	}
+
+	// Invalid paths
+	for _, path := range []string{"unknown", "hotspots.unknown", "hotspots.cell.value", ""} {
+		if _, err := parseFieldMask(&types.FieldMask{Paths: []string{path}}, res()); err == nil {
+			t.Errorf("expected error for path: %s", path)
+		}
+	}
+
+	// Empty mask
+	sel, err := parseFieldMask(nil, res())
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := res()
+	sel.apply(r)
+	if len(r.Flows) != 1 || r.Hotspots[0].Lat == 0 {
+		t.Error("empty mask must return all fields")
+	}
+
+	// Nested fields
+	sel, err = parseFieldMask(&types.FieldMask{Paths: []string{"hotspots.cell", "hotspots.users"}}, res())
+	if err != nil {
+		t.Fatal(err)
+	}
+	r = res()
+	sel.apply(r)
+	if r.Flows != nil || r.Hotspots[0].Lat != 0 || r.Hotspots[0].Cell == "" || r.Hotspots[0].Users == 0 {
+		t.Errorf("invalid result: %+v", r.Hotspots[0])
+	}
+
+	// Complete field takes precedence over nested selections
+	sel, err = parseFieldMask(&types.FieldMask{Paths: []string{"flows.users", "flows"}}, res())
+	if err != nil {
+		t.Fatal(err)
+	}
+	r = res()
+	sel.apply(r)
+	if r.Hotspots != nil || r.Flows[0].Origin == "" {
+		t.Error("invalid result")
+	}
+}
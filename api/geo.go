@@ -0,0 +1,242 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"io"
+	"io/ioutil"
+	"net"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/x/jwx"
+	xlog "go.bryk.io/x/log"
+	"google.golang.org/grpc/codes"
+)
+
+// Requests rejected by the geographic restrictions.
+var geoRejected = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "ct19_geo_rejected_requests_total",
+	Help: "Requests rejected due to the client location, by country.",
+}, []string{"country"})
+
+func init() {
+	prometheus.MustRegister(geoRejected)
+}
+
+// GeoLocator returns the country, as an ISO 3166-1 alpha-2 code, where an
+// IP address is located; an empty value is returned for unknown addresses.
+type GeoLocator interface {
+	Country(ip net.IP) (string, error)
+}
+
+// GeoRestriction limits credential issuance and record submission to
+// clients located, by IP address, within the jurisdiction of the
+// deployment.
+type GeoRestriction struct {
+	// CSV file used to locate the IP addresses, with rows in the form
+	// "first,last,country" (i.e. the DB-IP "IP to Country Lite" database)
+	// or "network,country", using CIDR notation. IPv4 and IPv6 are supported.
+	Database string `mapstructure:"database"`
+
+	// Countries allowed, all countries if not provided.
+	Allow []string `mapstructure:"allow"`
+
+	// Countries rejected.
+	Deny []string `mapstructure:"deny"`
+
+	// Roles not subject to the restrictions, i.e. "agent" for health
+	// personnel working abroad.
+	Bypass []string `mapstructure:"bypass"`
+
+	// Reject clients that can't be located, i.e. private networks. By
+	// default they are allowed.
+	DenyUnknown bool `mapstructure:"deny_unknown"`
+
+	// Custom locator, i.e. a commercial geolocation service. Takes
+	// precedence over the database file.
+	Locator GeoLocator `mapstructure:"-"`
+}
+
+// Parsed geographic restrictions.
+type geoPolicy struct {
+	locator GeoLocator
+	allow   map[string]bool
+	deny    map[string]bool
+	bypass  map[string]bool
+	unknown bool // allow unknown locations
+}
+
+// Validate the geographic restrictions settings.
+func newGeoPolicy(conf *GeoRestriction) (*geoPolicy, error) {
+	gp := &geoPolicy{
+		locator: conf.Locator,
+		allow:   countrySet(conf.Allow),
+		deny:    countrySet(conf.Deny),
+		bypass:  make(map[string]bool),
+		unknown: !conf.DenyUnknown,
+	}
+	for _, r := range conf.Bypass {
+		gp.bypass[r] = true
+	}
+	if gp.locator != nil {
+		return gp, nil
+	}
+	if conf.Database == "" {
+		return nil, errors.New("geo restrictions require a locator or database")
+	}
+	data, err := ioutil.ReadFile(filepath.Clean(conf.Database))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read geolocation database")
+	}
+	if gp.locator, err = loadGeoDatabase(data); err != nil {
+		return nil, err
+	}
+	return gp, nil
+}
+
+func countrySet(list []string) map[string]bool {
+	set := make(map[string]bool)
+	for _, c := range list {
+		set[strings.ToUpper(strings.TrimSpace(c))] = true
+	}
+	return set
+}
+
+// Verify if a client with 'role' is allowed to access the service from
+// 'address'. Locator failures are reported and handled as unknown
+// locations.
+func (gp *geoPolicy) allowed(address, role string, log xlog.Logger) (string, bool) {
+	if gp.bypass[role] {
+		return "", true
+	}
+	country := ""
+	if ip := net.ParseIP(address); ip != nil {
+		var err error
+		if country, err = gp.locator.Country(ip); err != nil {
+			log.WithField("error", err.Error()).Warning("failed to locate client address")
+		}
+	}
+	country = strings.ToUpper(country)
+	if country == "" {
+		return country, gp.unknown
+	}
+	if gp.deny[country] {
+		return country, false
+	}
+	return country, len(gp.allow) == 0 || gp.allow[country]
+}
+
+// Reject requests from clients located outside the deployment jurisdiction.
+func (srv *Server) geoCheck(ctx context.Context, role string) error {
+	if srv.geo == nil {
+		return nil
+	}
+	country, ok := srv.geo.allowed(clientAddress(ctx), role, srv.log)
+	if ok {
+		return nil
+	}
+	label := country
+	if label == "" {
+		label = "unknown"
+	}
+	geoRejected.WithLabelValues(label).Inc()
+	return newError(codes.PermissionDenied, protov1.ErrorCode_ERROR_CODE_GEO_RESTRICTED,
+		"service not available in your location")
+}
+
+// Reject requests from clients located outside the deployment jurisdiction,
+// based on the role of the credentials used.
+func (srv *Server) geoCheckToken(ctx context.Context, token *jwx.Token) error {
+	if srv.geo == nil {
+		return nil
+	}
+	claims := &tokenClaims{}
+	if err := token.Decode(claims); err != nil {
+		return errUnauthenticated
+	}
+	return srv.geoCheck(ctx, claims.Role)
+}
+
+// Range of IP addresses located in a country.
+type ipRange struct {
+	first   net.IP
+	last    net.IP
+	country string
+}
+
+// Geolocation database loaded from a CSV file.
+type geoDatabase struct {
+	ranges []*ipRange
+}
+
+// Parse a CSV geolocation database.
+func loadGeoDatabase(data []byte) (*geoDatabase, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	r.ReuseRecord = true
+	db := &geoDatabase{}
+	for line := 1; ; line++ {
+		row, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid geolocation database")
+		}
+		var entry *ipRange
+		switch len(row) {
+		case 2:
+			_, network, err := net.ParseCIDR(strings.TrimSpace(row[0]))
+			if err != nil {
+				return nil, errors.Errorf("invalid geolocation database, line %d: invalid network", line)
+			}
+			last := make(net.IP, len(network.IP))
+			for i := range network.IP {
+				last[i] = network.IP[i] | ^network.Mask[i]
+			}
+			entry = &ipRange{first: network.IP.To16(), last: last.To16(), country: row[1]}
+		case 3:
+			entry = &ipRange{
+				first:   net.ParseIP(strings.TrimSpace(row[0])).To16(),
+				last:    net.ParseIP(strings.TrimSpace(row[1])).To16(),
+				country: row[2],
+			}
+			if entry.first == nil || entry.last == nil || bytes.Compare(entry.first, entry.last) > 0 {
+				return nil, errors.Errorf("invalid geolocation database, line %d: invalid range", line)
+			}
+		default:
+			return nil, errors.Errorf("invalid geolocation database, line %d: invalid number of fields", line)
+		}
+		entry.country = strings.ToUpper(strings.TrimSpace(entry.country))
+		db.ranges = append(db.ranges, entry)
+	}
+	sort.Slice(db.ranges, func(i, j int) bool {
+		return bytes.Compare(db.ranges[i].first, db.ranges[j].first) < 0
+	})
+	return db, nil
+}
+
+// Country returns the country of the last range starting at or before 'ip'
+// and covering it.
+func (db *geoDatabase) Country(ip net.IP) (string, error) {
+	ip = ip.To16()
+	if ip == nil {
+		return "", errors.New("invalid IP address")
+	}
+	i := sort.Search(len(db.ranges), func(i int) bool {
+		return bytes.Compare(db.ranges[i].first, ip) > 0
+	})
+	if i == 0 {
+		return "", nil
+	}
+	if r := db.ranges[i-1]; bytes.Compare(ip, r.last) <= 0 {
+		return r.country, nil
+	}
+	return "", nil
+}
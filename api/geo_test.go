@@ -0,0 +1,61 @@
+package api
+
+import (
+	"net"
+	"testing"
+
+	xlog "go.bryk.io/x/log"
+)
+
+func TestGeoPolicy(t *testing.T) {
+	db, err := loadGeoDatabase([]byte("1.0.0.0,1.0.0.255,co\n2.0.0.0/8,MX\n2001:db8::,2001:db8::ffff,PA\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	locations := map[string]string{
+		"1.0.0.7":     "CO",
+		"2.3.4.5":     "MX",
+		"2001:db8::1": "PA",
+		"1.0.1.0":     "",
+		"0.0.0.1":     "",
+	}
+	for addr, country := range locations {
+		if c, _ := db.Country(net.ParseIP(addr)); c != country {
+			t.Errorf("%s: expected '%s', got '%s'", addr, country, c)
+		}
+	}
+	if _, err := loadGeoDatabase([]byte("1.0.0.255,1.0.0.0,CO\n")); err == nil {
+		t.Error("invalid range accepted")
+	}
+
+	gp, err := newGeoPolicy(&GeoRestriction{
+		Allow:   []string{"co", "PA"},
+		Deny:    []string{"PA"},
+		Bypass:  []string{"agent"},
+		Locator: db,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	log := xlog.WithZero(false)
+	checks := []struct {
+		addr    string
+		role    string
+		allowed bool
+	}{
+		{"1.0.0.7", "user", true},
+		{"2.3.4.5", "user", false},
+		{"2.3.4.5", "agent", true},
+		{"2001:db8::1", "user", false},
+		{"10.0.0.1", "user", true},
+	}
+	for _, c := range checks {
+		if _, ok := gp.allowed(c.addr, c.role, log); ok != c.allowed {
+			t.Errorf("%s (%s): expected %v", c.addr, c.role, c.allowed)
+		}
+	}
+	gp.unknown = false
+	if _, ok := gp.allowed("10.0.0.1", "user", log); ok {
+		t.Error("unknown location allowed")
+	}
+}
@@ -0,0 +1,105 @@
+package api
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/covid-tracking/storage"
+	"go.bryk.io/x/ccg/did"
+	"go.bryk.io/x/jwx"
+)
+
+// PlaceLegalHold exempts the data of a user from the retention policy until
+// the hold is released. Holds are registered on the audit log.
+func (srv *Server) PlaceLegalHold(ctx context.Context, token *jwx.Token,
+	req *protov1.LegalHoldRequest) (*protov1.LegalHold, error) {
+	if _, err := did.Parse(req.Did); err != nil {
+		return nil, errInvalidDID
+	}
+	if strings.TrimSpace(req.Reason) == "" {
+		return nil, invalidArgument("reason", "a justification is required to place a hold")
+	}
+	data := &credentialsData{}
+	if err := token.Decode(&data); err != nil {
+		return nil, errUnauthenticated
+	}
+	hold := &protov1.LegalHold{
+		Did:     req.Did,
+		Reason:  req.Reason,
+		Actor:   data.DID,
+		Created: time.Now().Unix(),
+	}
+	if err := srv.repos.LegalHolds().PlaceLegalHold(hold); err != nil {
+		return nil, errInternalError
+	}
+	srv.audit(&storage.AuditEntry{
+		Event:   auditLegalHoldPlaced,
+		Actor:   data.DID,
+		Address: clientAddress(ctx),
+		Details: map[string]string{
+			"did":    req.Did,
+			"reason": req.Reason,
+		},
+	})
+	return hold, nil
+}
+
+// ReleaseLegalHold removes the hold placed on the data of a user. Releases
+// are registered on the audit log.
+func (srv *Server) ReleaseLegalHold(ctx context.Context, token *jwx.Token, req *protov1.LegalHoldRequest) error {
+	data := &credentialsData{}
+	if err := token.Decode(&data); err != nil {
+		return errUnauthenticated
+	}
+	ok, err := srv.repos.LegalHolds().ReleaseLegalHold(req.Did)
+	if err != nil {
+		return errInternalError
+	}
+	if !ok {
+		return notFound("legal hold")
+	}
+	srv.audit(&storage.AuditEntry{
+		Event:   auditLegalHoldReleased,
+		Actor:   data.DID,
+		Address: clientAddress(ctx),
+		Details: map[string]string{
+			"did":    req.Did,
+			"reason": req.Reason,
+		},
+	})
+	return nil
+}
+
+// SubjectAccess compiles all the data stored for a user. Requests are
+// registered on the audit log.
+func (srv *Server) SubjectAccess(ctx context.Context, token *jwx.Token,
+	req *protov1.SubjectAccessRequest) (*protov1.SubjectAccessResponse, error) {
+	if _, err := did.Parse(req.Did); err != nil {
+		return nil, errInvalidDID
+	}
+	if strings.TrimSpace(req.Reason) == "" {
+		return nil, invalidArgument("reason", "a justification is required")
+	}
+	data := &credentialsData{}
+	if err := token.Decode(&data); err != nil {
+		return nil, errUnauthenticated
+	}
+	res, err := srv.repos.LegalHolds().SubjectData(req.Did)
+	if err != nil {
+		return nil, errInternalError
+	}
+	srv.audit(&storage.AuditEntry{
+		Event:   auditSubjectAccess,
+		Actor:   data.DID,
+		Address: clientAddress(ctx),
+		Details: map[string]string{
+			"did":     req.Did,
+			"reason":  req.Reason,
+			"records": strconv.Itoa(len(res.Records)),
+		},
+	})
+	return res, nil
+}
@@ -0,0 +1,103 @@
+package api
+
+import (
+	"fmt"
+	"sync"
+
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	xlog "go.bryk.io/x/log"
+)
+
+// Hook receives the platform events generated by a server or worker
+// instance. The event must not be modified.
+type Hook func(ev *protov1.Event)
+
+// Hooks allow deployments to compile in custom extensions, notified of the
+// platform events as they happen, without modifying the request handlers.
+// Hooks are invoked asynchronously, on its own goroutine, once the operation
+// generating the event is completed; the same events are also published to
+// the "events" exchange. Panics on a hook are recovered and logged.
+//
+//	srv, _ := api.NewServer(opts)
+//	srv.Hooks().OnCredentialIssued(func(ev *protov1.Event) {
+//		log.Printf("%s credentials issued", ev.Attributes["role"])
+//	})
+type Hooks struct {
+	list map[string][]Hook
+	mu   sync.RWMutex
+}
+
+func newHooks() *Hooks {
+	return &Hooks{list: make(map[string][]Hook)}
+}
+
+// OnRecordStored registers a hook for location records stored.
+// Attributes: "records".
+func (h *Hooks) OnRecordStored(fn Hook) {
+	h.register(eventRecordStored, fn)
+}
+
+// OnDiagnosisStored registers a hook for test results received.
+// Attributes: "diagnosis", "result", "source".
+func (h *Hooks) OnDiagnosisStored(fn Hook) {
+	h.register(eventDiagnosisStored, fn)
+}
+
+// OnExposureDetected registers a hook for potential contagion risks detected.
+// Attributes: "exposure", "diagnosis".
+func (h *Hooks) OnExposureDetected(fn Hook) {
+	h.register(eventExposureDetected, fn)
+}
+
+// OnCredentialIssued registers a hook for access credentials issued.
+// Attributes: "role".
+func (h *Hooks) OnCredentialIssued(fn Hook) {
+	h.register(eventCredentialIssued, fn)
+}
+
+// OnCertificateIssued registers a hook for health certificates issued.
+// Attributes: "diagnosis", "format".
+func (h *Hooks) OnCertificateIssued(fn Hook) {
+	h.register(eventCertificateIssued, fn)
+}
+
+func (h *Hooks) register(kind string, fn Hook) {
+	h.mu.Lock()
+	h.list[kind] = append(h.list[kind], fn)
+	h.mu.Unlock()
+}
+
+// Invoke the hooks registered for the event.
+func (h *Hooks) dispatch(ev *protov1.Event, log xlog.Logger) {
+	if h == nil {
+		return
+	}
+	h.mu.RLock()
+	list := h.list[ev.Kind]
+	h.mu.RUnlock()
+	for _, fn := range list {
+		go func(fn Hook) {
+			defer func() {
+				if r := recover(); r != nil {
+					log.WithFields(xlog.Fields{
+						"kind":  ev.Kind,
+						"error": fmt.Sprintf("%v", r),
+					}).Error("event hook failed")
+				}
+			}()
+			fn(ev)
+		}(fn)
+	}
+}
+
+// Hooks returns the registry of extensions notified of the events generated
+// by the server.
+func (srv *Server) Hooks() *Hooks {
+	return srv.hooks
+}
+
+// Hooks returns the registry of extensions notified of the events generated
+// by the worker.
+func (w *Worker) Hooks() *Hooks {
+	return w.hooks
+}
@@ -0,0 +1,51 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"go.bryk.io/covid-tracking/broker/memtest"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	storetest "go.bryk.io/covid-tracking/storage/memtest"
+	xlog "go.bryk.io/x/log"
+)
+
+func TestHooks(t *testing.T) {
+	store := storetest.New()
+	pub := memtest.NewPublisher()
+	w := &Worker{repos: store, pub: pub, log: xlog.WithZero(false), hooks: newHooks()}
+
+	received := make(chan *protov1.Event, 1)
+	w.Hooks().OnExposureDetected(func(ev *protov1.Event) {
+		received <- ev
+	})
+	w.Hooks().OnExposureDetected(func(ev *protov1.Event) {
+		panic("failing extension")
+	})
+	w.Hooks().OnCredentialIssued(func(ev *protov1.Event) {
+		t.Error("unexpected event kind")
+	})
+
+	now := time.Now().Unix()
+	_ = store.Records().LocationRecords([]*protov1.LocationRecord{
+		{Did: "did:bryk:case", Lat: 19.4326, Lng: -99.1332, Timestamp: now},
+		{Did: "did:bryk:contact", Lat: 19.4326, Lng: -99.1332, Timestamp: now},
+	})
+	d, _ := store.Exposures().Diagnosis("did:bryk:case", "positive", "test", time.Now())
+	w.detectExposures(d)
+
+	select {
+	case ev := <-received:
+		if ev.Did != "did:bryk:contact" || ev.Attributes["diagnosis"] != d.Id {
+			t.Errorf("invalid event: %+v", ev)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("hook not invoked")
+	}
+	if len(pub.Messages("events")) != 1 {
+		t.Error("event should still be published")
+	}
+
+	// Instances without registered hooks
+	(*Hooks)(nil).dispatch(&protov1.Event{Kind: eventRecordStored}, w.log)
+}
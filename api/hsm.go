@@ -0,0 +1,122 @@
+package api
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/ThalesIgnite/crypto11"
+	"github.com/pkg/errors"
+)
+
+// HSMConfig provides the settings required to use private keys stored on a
+// hardware security module, or any other PKCS#11 compatible token, as the
+// server's signing key instead of the "signing.pem" file and as the root CA
+// key instead of the "root-ca.pem" file.
+type HSMConfig struct {
+	// Location of the PKCS#11 library provided by the device vendor.
+	Module string
+
+	// Label of the token (slot) holding the keys.
+	Token string
+
+	// User PIN for the token.
+	Pin string
+
+	// Label of the signing key pair. Optional when an external signer is
+	// used for access credentials.
+	Key string
+
+	// Label of the root CA key pair. If not provided the "root-ca.pem" file
+	// on the home directory is used. The "root-ca.crt" certificate is still
+	// read from the home directory and must match the key.
+	CAKey string
+}
+
+// Open a session with the PKCS#11 token and locate the signing key, if any.
+func setupHSM(conf *HSMConfig) (*crypto11.Context, crypto.Signer, error) {
+	hsm, err := crypto11.Configure(&crypto11.Config{
+		Path:       conf.Module,
+		TokenLabel: conf.Token,
+		Pin:        conf.Pin,
+	})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to open HSM session")
+	}
+	if conf.Key == "" {
+		return hsm, nil, nil
+	}
+	key, err := hsmKey(hsm, conf.Key)
+	if err != nil {
+		_ = hsm.Close()
+		return nil, nil, err
+	}
+	return hsm, key, nil
+}
+
+// Locate the key pair with the provided label on the token.
+func hsmKey(hsm *crypto11.Context, label string) (crypto.Signer, error) {
+	key, err := hsm.FindKeyPair(nil, []byte(label))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to retrieve HSM key")
+	}
+	if key == nil {
+		return nil, errors.Errorf("key not found on HSM: %s", label)
+	}
+	return key, nil
+}
+
+// Root CA with its private key held on the HSM. Certificates are issued by
+// the device, the key material is never loaded on the server.
+type hsmCA struct {
+	cert   *x509.Certificate
+	signer crypto.Signer
+}
+
+// Load the root CA certificate on the home directory and locate its key pair
+// on the token.
+func setupHSMCA(hsm *crypto11.Context, label, home string) (*hsmCA, error) {
+	signer, err := hsmKey(hsm, label)
+	if err != nil {
+		return nil, err
+	}
+	certPEM, err := ioutil.ReadFile(filepath.Clean(filepath.Join(home, "root-ca.crt")))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read root CA certificate")
+	}
+	return newHSMCA(certPEM, signer)
+}
+
+// Returns a root CA for the PEM-encoded certificate; the certificate must
+// belong to the provided signer.
+func newHSMCA(certPEM []byte, signer crypto.Signer) (*hsmCA, error) {
+	block, _ := pem.Decode(certPEM)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.New("invalid root CA certificate")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid root CA certificate")
+	}
+	if !cert.IsCA {
+		return nil, errors.New("root CA certificate is not a CA")
+	}
+	pub, err := x509.MarshalPKIXPublicKey(signer.Public())
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(pub, cert.RawSubjectPublicKeyInfo) {
+		return nil, errors.New("root CA certificate doesn't match the HSM key")
+	}
+	return &hsmCA{cert: cert, signer: signer}, nil
+}
+
+// Issue a DER-encoded certificate for 'pub', based on the provided template,
+// signed by the root CA.
+func (ca *hsmCA) issue(tpl *x509.Certificate, pub crypto.PublicKey) ([]byte, error) {
+	return x509.CreateCertificate(rand.Reader, tpl, ca.cert, pub, ca.signer)
+}
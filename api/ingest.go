@@ -0,0 +1,342 @@
+package api
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/covid-tracking/storage"
+	"go.bryk.io/covid-tracking/utils"
+	"go.bryk.io/x/ccg/did"
+	xlog "go.bryk.io/x/log"
+)
+
+// Record ingestion modes.
+const (
+	// Records are published to the broker and stored asynchronously by the
+	// workers. Used by default.
+	IngestBroker = "broker"
+
+	// Records are validated and stored by the API server while handling the
+	// request. Suitable for small deployments.
+	IngestSync = "sync"
+)
+
+// Validates and stores location and check-in records. The same ingester is
+// used by the workers and, on synchronous mode, by the API servers; so
+// records are processed the same way regardless of the ingestion mode.
+// Records exceeding the daily quota of the author are discarded.
+type ingester struct {
+	log        xlog.Logger
+	repos      storage.Repositories
+	providers  []*did.Provider
+	window     recordWindow
+	quota      *ingestionQuota
+	validators int
+}
+
+// Minimum number of records validated on each concurrent chunk, so the
+// proofs can still be verified in batches.
+const minValidationChunk = 16
+
+// Rejection reason for each record on a request, in the order submitted;
+// empty for the records accepted.
+type ingestResult []string
+
+// Number of records accepted.
+func (ir ingestResult) accepted() int {
+	count := 0
+	for _, reason := range ir {
+		if reason == "" {
+			count++
+		}
+	}
+	return count
+}
+
+// Processing status for the location records on a request. Records without
+// a rejection reason are reported with the provided 'status'.
+func recordStatus(records []*protov1.LocationRecord, res ingestResult, status string) []*protov1.RecordStatus {
+	list := make([]*protov1.RecordStatus, len(records))
+	for i, r := range records {
+		list[i] = &protov1.RecordStatus{
+			Index:  int32(i),
+			Hash:   r.Hash,
+			Status: status,
+		}
+		if res[i] != "" {
+			list[i].Status = recordRejected
+			list[i].Reason = res[i]
+		}
+	}
+	return list
+}
+
+// Final status for the records on a submission. The records forwarded to the
+// workers, reported as pending, are updated with the ingestion result, in the
+// same order.
+func submissionStatus(records []*protov1.RecordStatus, res ingestResult) []*protov1.RecordStatus {
+	next := 0
+	for _, r := range records {
+		if r.Status != recordPending {
+			continue
+		}
+		r.Status = recordAccepted
+		if next < len(res) && res[next] != "" {
+			r.Status = recordRejected
+			r.Reason = res[next]
+		}
+		next++
+	}
+	return records
+}
+
+// Store the valid location records submitted by 'author', along with the
+// client application details, and return the result for each record.
+func (in *ingester) locations(author string, req *protov1.RecordRequest) (ingestResult, error) {
+	id, err := resolveDID(author, in.providers)
+	if err != nil {
+		return nil, errors.New("invalid DID")
+	}
+	res := make(ingestResult, len(req.Records))
+	var candidates []int
+	for i, r := range req.Records {
+		if res[i] = validateRecord(id.DID(), r, in.window); res[i] == "" {
+			candidates = append(candidates, i)
+		}
+	}
+	reasons := in.parallel(len(candidates), func(from, to int) []string {
+		var hashes []string
+		var proofs [][]byte
+		for _, i := range candidates[from:to] {
+			hashes = append(hashes, req.Records[i].Hash)
+			proofs = append(proofs, req.Records[i].Proof)
+		}
+		reasons := make([]string, to-from)
+		for i, err := range verifyProofs(id, hashes, proofs) {
+			if err != nil {
+				reasons[i] = rejectInvalidProof
+			}
+		}
+		return reasons
+	})
+	for j, i := range candidates {
+		res[i] = reasons[j]
+	}
+	proofs := make([][]byte, len(req.Records))
+	expires := make([]time.Time, len(req.Records))
+	for i, r := range req.Records {
+		proofs[i] = r.Proof
+		expires[i] = in.window.expires(r.Timestamp)
+	}
+	nonces, err := in.reserveNonces(id.DID(), proofs, expires, res)
+	if err != nil {
+		return nil, err
+	}
+
+	// Accepted records, up to the author's remaining quota
+	var (
+		records  []*protov1.LocationRecord
+		reserved []string
+		unused   []string
+	)
+	client := clientInfo(req)
+	quota := in.quota.reserve(id.DID(), res.accepted())
+	left := quota.records
+	for i, r := range req.Records {
+		if res[i] != "" {
+			continue
+		}
+		if left >= 0 && len(records) >= left {
+			res[i] = rejectQuotaExceeded
+			unused = append(unused, nonces[i])
+			continue
+		}
+		r.Client = client
+		records = append(records, r)
+		reserved = append(reserved, nonces[i])
+	}
+	in.releaseNonces(id.DID(), unused)
+	if len(records) == 0 {
+		quota.release(0)
+		return res, nil
+	}
+	if err := in.repos.Records().LocationRecords(records); err != nil {
+		quota.release(0)
+		in.releaseNonces(id.DID(), reserved)
+		return nil, errors.Wrap(err, "failed to save record")
+	}
+	quota.release(len(records))
+	return res, nil
+}
+
+// Store the valid check-in records submitted by 'author' and return the
+// result for each record.
+func (in *ingester) checkIns(author string, req *protov1.CheckInRequest) (ingestResult, error) {
+	id, err := resolveDID(author, in.providers)
+	if err != nil {
+		return nil, errors.New("invalid DID")
+	}
+	res := make(ingestResult, len(req.Records))
+	var candidates []int
+	for i, r := range req.Records {
+		if res[i] = validateCheckIn(id.DID(), r, in.window); res[i] == "" {
+			candidates = append(candidates, i)
+		}
+	}
+	reasons := in.parallel(len(candidates), func(from, to int) []string {
+		var hashes []string
+		var proofs [][]byte
+		for _, i := range candidates[from:to] {
+			hashes = append(hashes, req.Records[i].Hash)
+			proofs = append(proofs, req.Records[i].Proof)
+		}
+		reasons := make([]string, to-from)
+		for i, err := range verifyProofs(id, hashes, proofs) {
+			switch {
+			case err != nil:
+				reasons[i] = rejectInvalidProof
+			case !in.repos.Venues().VenueExists(req.Records[candidates[from+i]].Venue):
+				reasons[i] = rejectUnknownVenue
+			}
+		}
+		return reasons
+	})
+	for j, i := range candidates {
+		res[i] = reasons[j]
+	}
+	proofs := make([][]byte, len(req.Records))
+	expires := make([]time.Time, len(req.Records))
+	for i, r := range req.Records {
+		proofs[i] = r.Proof
+		expires[i] = in.window.expires(r.Timestamp)
+	}
+	nonces, err := in.reserveNonces(id.DID(), proofs, expires, res)
+	if err != nil {
+		return nil, err
+	}
+
+	// Accepted records, up to the author's remaining quota
+	var (
+		records  []*protov1.CheckInRecord
+		reserved []string
+		unused   []string
+	)
+	quota := in.quota.reserve(id.DID(), res.accepted())
+	left := quota.records
+	for i, r := range req.Records {
+		if res[i] != "" {
+			continue
+		}
+		if left >= 0 && len(records) >= left {
+			res[i] = rejectQuotaExceeded
+			unused = append(unused, nonces[i])
+			continue
+		}
+		records = append(records, r)
+		reserved = append(reserved, nonces[i])
+	}
+	in.releaseNonces(id.DID(), unused)
+	if len(records) == 0 {
+		quota.release(0)
+		return res, nil
+	}
+	if err := in.repos.Records().CheckIns(records); err != nil {
+		quota.release(0)
+		in.releaseNonces(id.DID(), reserved)
+		return nil, errors.Wrap(err, "failed to save check-in")
+	}
+	quota.release(len(records))
+	return res, nil
+}
+
+// Reserve the proof nonces of the records accepted so far, each kept while
+// its record is within the accepted window, and return the nonce of each
+// record. Records with proofs already used, or repeated on the same request,
+// are rejected. Nonces are reserved before storing the records, so concurrent
+// submissions can't replay the same proofs.
+func (in *ingester) reserveNonces(did string, proofs [][]byte, expires []time.Time,
+	res ingestResult) ([]string, error) {
+	nonces := make([]string, len(proofs))
+	pending := make(map[string]time.Time)
+	for i, proof := range proofs {
+		if res[i] != "" {
+			continue
+		}
+		nonces[i] = utils.SignatureNonce(proof)
+		if _, repeated := pending[nonces[i]]; nonces[i] == "" || repeated {
+			res[i] = rejectReplayedProof
+			continue
+		}
+		pending[nonces[i]] = expires[i]
+	}
+	used, err := in.repos.Nonces().ReserveNonces(did, pending)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to reserve proof nonces")
+	}
+	for i, nonce := range nonces {
+		if res[i] == "" && used[nonce] {
+			res[i] = rejectReplayedProof
+		}
+	}
+	return nonces, nil
+}
+
+// Release the proof nonces reserved for records that were not stored, so
+// they can be submitted again. Nonces that can't be released are kept until
+// they expire.
+func (in *ingester) releaseNonces(did string, nonces []string) {
+	if len(nonces) == 0 {
+		return
+	}
+	if err := in.repos.Nonces().ReleaseNonces(did, nonces); err != nil {
+		in.log.WithFields(xlog.Fields{
+			"did":    did,
+			"nonces": len(nonces),
+			"error":  err.Error(),
+		}).Warning("failed to release proof nonces")
+	}
+}
+
+// Verify the proofs for the records submitted by 'id', all signatures are
+// verified as a single batch. The result for each record is returned.
+func verifyProofs(id *did.Identifier, hashes []string, proofs [][]byte) []error {
+	batch := utils.NewBatchVerifier(id)
+	for i, h := range hashes {
+		batch.Add([]byte(h), proofs[i])
+	}
+	return batch.Verify()
+}
+
+// Validate 'total' records split in chunks, processed concurrently by up to
+// 'in.validators' goroutines; 'validate' returns the rejection reasons for the
+// records in the range [from, to). Results are returned in the original order
+// of the records, so they are stored in the order they were submitted.
+func (in *ingester) parallel(total int, validate func(from, to int) []string) []string {
+	if in.validators <= 1 || total <= minValidationChunk {
+		if total == 0 {
+			return nil
+		}
+		return validate(0, total)
+	}
+	size := (total + in.validators - 1) / in.validators
+	if size < minValidationChunk {
+		size = minValidationChunk
+	}
+	reasons := make([]string, total)
+	wg := sync.WaitGroup{}
+	for from := 0; from < total; from += size {
+		to := from + size
+		if to > total {
+			to = total
+		}
+		wg.Add(1)
+		go func(from, to int) {
+			defer wg.Done()
+			copy(reasons[from:to], validate(from, to))
+		}(from, to)
+	}
+	wg.Wait()
+	return reasons
+}
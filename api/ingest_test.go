@@ -0,0 +1,214 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/covid-tracking/storage"
+	"go.bryk.io/covid-tracking/storage/memtest"
+	xlog "go.bryk.io/x/log"
+)
+
+func TestIngesterParallel(t *testing.T) {
+	for _, validators := range []int{0, 1, 4} {
+		in := &ingester{validators: validators}
+		for _, total := range []int{0, 10, 100, 257} {
+			var calls int32
+			reasons := in.parallel(total, func(from, to int) []string {
+				atomic.AddInt32(&calls, 1)
+				res := make([]string, to-from)
+				for i := range res {
+					if (from+i)%3 != 0 {
+						res[i] = rejectInvalidProof
+					}
+				}
+				return res
+			})
+			if len(reasons) != total {
+				t.Fatalf("invalid results size: %d", len(reasons))
+			}
+			for i, reason := range reasons {
+				if (reason == "") != (i%3 == 0) {
+					t.Fatalf("%d/%d: invalid result for record %d", validators, total, i)
+				}
+			}
+			if max := int32(validators); max > 1 && calls > max {
+				t.Errorf("%d chunks processed with %d validators", calls, validators)
+			}
+		}
+	}
+}
+
+func TestSubmissionStatus(t *testing.T) {
+	records := []*protov1.RecordStatus{
+		{Index: 0, Status: recordPending},
+		{Index: 1, Status: recordRejected, Reason: rejectInvalidHash},
+		{Index: 2, Status: recordPending},
+		{Index: 3, Status: recordPending},
+	}
+	res := ingestResult{"", rejectReplayedProof, rejectQuotaExceeded}
+	expected := []string{"", rejectInvalidHash, rejectReplayedProof, rejectQuotaExceeded}
+	for i, r := range submissionStatus(records, res) {
+		if r.Reason != expected[i] || (r.Reason == "") != (r.Status == recordAccepted) {
+			t.Errorf("invalid status for record %d: %s (%s)", i, r.Status, r.Reason)
+		}
+	}
+}
+
+func TestIngesterReserveNonces(t *testing.T) {
+	store := memtest.New()
+	in := &ingester{repos: store}
+	did := "did:bryk:7889c965-4644-44ff-b760-f396f1d11444"
+	proof := func(nonce string) []byte {
+		return []byte(fmt.Sprintf(`{"nonce": %q}`, nonce))
+	}
+	if _, err := store.ReserveNonces(did, map[string]time.Time{"used": {}}); err != nil {
+		t.Fatal(err)
+	}
+	proofs := [][]byte{proof("a"), proof("used"), proof("a"), []byte("{}"), proof("b"), proof("c")}
+	expires := make([]time.Time, len(proofs))
+	res := ingestResult{"", "", "", "", "", rejectInvalidProof}
+	nonces, err := in.reserveNonces(did, proofs, expires, res)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := ingestResult{"", rejectReplayedProof, rejectReplayedProof, rejectReplayedProof, "", rejectInvalidProof}
+	for i, reason := range res {
+		if reason != expected[i] {
+			t.Errorf("invalid result for record %d: %s", i, reason)
+		}
+	}
+	if nonces[0] != "a" || nonces[4] != "b" {
+		t.Errorf("invalid nonces: %v", nonces)
+	}
+
+	// Nonces are reserved, concurrent submissions are rejected as replays
+	used, _ := store.ReserveNonces(did, map[string]time.Time{"a": {}, "b": {}, "c": {}})
+	if !used["a"] || !used["b"] || used["c"] {
+		t.Errorf("invalid nonces reserved: %v", used)
+	}
+}
+
+// Repositories with a records store that always fails.
+type failingRecords struct {
+	storage.Repositories
+	storage.RecordsRepo
+}
+
+func (fr failingRecords) Records() storage.RecordsRepo {
+	return fr
+}
+
+func (fr failingRecords) LocationRecords(_ []*protov1.LocationRecord) error {
+	return errors.New("storage unavailable")
+}
+
+func TestIngesterLocations(t *testing.T) {
+	_, store, _ := testServer(t)
+	in := &ingester{
+		log:    xlog.WithZero(false),
+		repos:  store,
+		window: newRecordWindow(time.Minute, 24*time.Hour, 0),
+		quota:  &ingestionQuota{counters: store, limit: 3},
+	}
+	id := testIdentifier(t)
+	now := time.Now()
+	var records []*protov1.LocationRecord
+	for i := 0; i < 4; i++ {
+		r := &protov1.LocationRecord{
+			Did:       id.DID(),
+			Lat:       19.4326,
+			Lng:       -99.1332,
+			Timestamp: now.Add(time.Duration(-i) * time.Minute).Unix(),
+		}
+		r.Hash = r.GenerateHash()
+		r.Proof = signValue(t, id, r.Hash)
+		records = append(records, r)
+	}
+	tampered := *records[1]
+	tampered.Lat = 20
+	req := &protov1.RecordRequest{
+		Records: []*protov1.LocationRecord{records[0], records[1], records[0], &tampered, records[2], records[3]},
+	}
+
+	// Nonces are released when the records can't be stored
+	in.repos = failingRecords{store, store.Records()}
+	if _, err := in.locations(id.DID(), req); err == nil {
+		t.Fatal("storage failure not reported")
+	}
+	in.repos = store
+
+	// Records over the quota are rejected
+	res, err := in.locations(id.DID(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := ingestResult{"", "", rejectReplayedProof, rejectInvalidHash, "", rejectQuotaExceeded}
+	for i, reason := range res {
+		if reason != expected[i] {
+			t.Errorf("invalid result for record %d: %s", i, reason)
+		}
+	}
+	data, _ := store.SubjectData(id.DID())
+	if len(data.Records) != 3 {
+		t.Errorf("invalid number of records stored: %d", len(data.Records))
+	}
+	if used, _ := store.QuotaUsage(id.DID(), now); used != 3 {
+		t.Errorf("invalid quota usage: %d", used)
+	}
+
+	// Only the proofs of stored records are consumed
+	in.quota.limit = 10
+	res, err = in.locations(id.DID(), &protov1.RecordRequest{Records: records})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = ingestResult{rejectReplayedProof, rejectReplayedProof, rejectReplayedProof, ""}
+	for i, reason := range res {
+		if reason != expected[i] {
+			t.Errorf("invalid result for record %d on resubmission: %s", i, reason)
+		}
+	}
+	if used, _ := store.QuotaUsage(id.DID(), now); used != 4 {
+		t.Errorf("invalid quota usage after resubmission: %d", used)
+	}
+}
+
+func TestIngesterCheckIns(t *testing.T) {
+	_, store, _ := testServer(t)
+	in := &ingester{log: xlog.WithZero(false), repos: store, window: newRecordWindow(time.Minute, 24*time.Hour, 0)}
+	id := testIdentifier(t)
+	venue, err := store.RegisterVenue("did:bryk:owner", &protov1.RegisterVenueRequest{Name: "venue"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var records []*protov1.CheckInRecord
+	for _, v := range []string{venue.Id, "unknown"} {
+		r := &protov1.CheckInRecord{Did: id.DID(), Venue: v, Timestamp: time.Now().Unix()}
+		r.Hash = r.GenerateHash()
+		r.Proof = signValue(t, id, r.Hash)
+		records = append(records, r)
+	}
+	res, err := in.checkIns(id.DID(), &protov1.CheckInRequest{Records: records})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res[0] != "" || res[1] != rejectUnknownVenue {
+		t.Errorf("invalid results: %v", res)
+	}
+	res, err = in.checkIns(id.DID(), &protov1.CheckInRequest{Records: records[:1]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res[0] != rejectReplayedProof {
+		t.Error("replayed check-in accepted")
+	}
+	data, _ := store.SubjectData(id.DID())
+	if len(data.CheckIns) != 1 {
+		t.Errorf("invalid number of check-ins stored: %d", len(data.CheckIns))
+	}
+}
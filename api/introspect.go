@@ -0,0 +1,142 @@
+package api
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/sha512"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"strings"
+
+	"github.com/pkg/errors"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/covid-tracking/secrets"
+	"go.bryk.io/x/jwx"
+)
+
+// Standard and custom claims included in access credentials.
+type tokenClaims struct {
+	credentialsData
+	Subject   string      `json:"sub"`
+	Issuer    string      `json:"iss"`
+	Audience  interface{} `json:"aud"`
+	ExpiresAt int64       `json:"exp"`
+	IssuedAt  int64       `json:"iat"`
+	NotBefore int64       `json:"nbf"`
+	ID        string      `json:"jti"`
+}
+
+// Introspect validates an access token, including its signature, and
+// returns its claims. Invalid tokens are reported as inactive.
+func (srv *Server) Introspect(req *protov1.IntrospectRequest) (*protov1.IntrospectResponse, error) {
+	inactive := &protov1.IntrospectResponse{Active: false}
+	token, err := jwx.Parse(req.Token)
+	if err != nil {
+		return inactive, nil
+	}
+	if err := srv.validateToken(token, true); err != nil {
+		return inactive, nil
+	}
+
+	// Decode claims
+	segments := strings.Split(req.Token, ".")
+	payload, err := base64.RawURLEncoding.DecodeString(segments[1])
+	if err != nil {
+		return inactive, nil
+	}
+	claims := &tokenClaims{}
+	if err := json.Unmarshal(payload, claims); err != nil || srv.revoked.has(claims.ID) {
+		return inactive, nil
+	}
+	return &protov1.IntrospectResponse{
+		Active: true,
+		Sub:    claims.Subject,
+		Did:    claims.DID,
+		Role:   claims.Role,
+		Lang:   claims.Lang,
+		Iss:    claims.Issuer,
+		Aud:    audience(claims.Audience),
+		Exp:    claims.ExpiresAt,
+		Iat:    claims.IssuedAt,
+		Nbf:    claims.NotBefore,
+		Jti:    claims.ID,
+		Scope:  strings.Join(claims.Scope, " "),
+	}, nil
+}
+
+// Verify the signature of a compact JWS token using any of the provided
+// public keys. ECDSA signatures are accepted in both the JWS "r || s" form
+// and ASN.1 encoding.
+func verifyTokenSignature(token string, keys []crypto.PublicKey) error {
+	segments := strings.Split(token, ".")
+	if len(segments) != 3 {
+		return errors.New("invalid token format")
+	}
+	hd, err := base64.RawURLEncoding.DecodeString(segments[0])
+	if err != nil {
+		return errors.New("invalid token header")
+	}
+	header := struct {
+		Alg string `json:"alg"`
+	}{}
+	if err := json.Unmarshal(hd, &header); err != nil {
+		return errors.New("invalid token header")
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(segments[2])
+	if err != nil {
+		return errors.New("invalid token signature")
+	}
+	input := []byte(segments[0] + "." + segments[1])
+	for _, k := range keys {
+		switch pub := k.(type) {
+		case *ecdsa.PublicKey:
+			if header.Alg == secrets.AlgES384 && verifyES384(pub, input, sig) {
+				return nil
+			}
+		case ed25519.PublicKey:
+			if header.Alg == secrets.AlgEdDSA && ed25519.Verify(pub, input, sig) {
+				return nil
+			}
+		}
+	}
+	return errors.New("invalid token signature")
+}
+
+// Verify an ES384 signature.
+func verifyES384(pub *ecdsa.PublicKey, input, sig []byte) bool {
+	digest := sha512.Sum384(input)
+	r, s := new(big.Int), new(big.Int)
+	if len(sig) == 96 {
+		r.SetBytes(sig[:48])
+		s.SetBytes(sig[48:])
+	} else {
+		var der struct {
+			R, S *big.Int
+		}
+		if _, err := asn1.Unmarshal(sig, &der); err != nil {
+			return false
+		}
+		r, s = der.R, der.S
+	}
+	return ecdsa.Verify(pub, digest[:], r, s)
+}
+
+// The "aud" claim can be either a single value or a list.
+func audience(aud interface{}) []string {
+	switch v := aud.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		list := make([]string, 0, len(v))
+		for _, a := range v {
+			if s, ok := a.(string); ok {
+				list = append(list, s)
+			}
+		}
+		return list
+	}
+	return nil
+}
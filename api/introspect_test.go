@@ -0,0 +1,59 @@
+package api
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"testing"
+
+	"go.bryk.io/x/jwx"
+)
+
+func TestVerifyTokenSignature(t *testing.T) {
+	ecKey, _ := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	edPub, edKey, _ := ed25519.GenerateKey(rand.Reader)
+	other, _ := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+
+	cases := []struct {
+		signer crypto.Signer
+		pub    crypto.PublicKey
+	}{
+		{ecKey, &ecKey.PublicKey},
+		{edKey, edPub},
+	}
+	for _, c := range cases {
+		tg, err := newSignerGenerator("ct19.test", c.signer)
+		if err != nil {
+			t.Fatal(err)
+		}
+		token, err := tg.NewToken("master", &jwx.TokenParameters{
+			Audience:   []string{"ct19.test"},
+			Subject:    "did:bryk:7889c965-4644-44ff-b760-f396f1d11444",
+			NotBefore:  "0ms",
+			Expiration: "1h",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := verifyTokenSignature(token.String(), []crypto.PublicKey{&other.PublicKey, c.pub}); err != nil {
+			t.Error(err)
+		}
+		if err := verifyTokenSignature(token.String(), []crypto.PublicKey{&other.PublicKey}); err == nil {
+			t.Error("invalid key accepted")
+		}
+		if err := verifyTokenSignature(token.String()+"x", []crypto.PublicKey{c.pub}); err == nil {
+			t.Error("invalid signature accepted")
+		}
+	}
+}
+
+func TestAudience(t *testing.T) {
+	if aud := audience("ct19.test"); len(aud) != 1 || aud[0] != "ct19.test" {
+		t.Error("invalid single audience")
+	}
+	if aud := audience([]interface{}{"a", "b"}); len(aud) != 2 {
+		t.Error("invalid audience list")
+	}
+}
@@ -0,0 +1,265 @@
+package api
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.bryk.io/covid-tracking/export"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/covid-tracking/storage"
+	"go.bryk.io/covid-tracking/utils"
+	"go.bryk.io/x/amqp"
+	xlog "go.bryk.io/x/log"
+)
+
+// Batch jobs, submitted by administrators and executed by workers.
+const (
+	// Notify the contacts of a positive case not previously notified.
+	// Parameters: "diagnosis".
+	batchContactMatching = "contact_matching"
+
+	// Export the analytics aggregates for a region and period of time.
+	// Parameters: "region" (geohash prefix), "from" and "to" (YYYY-MM-DD).
+	batchAnalyticsExport = "analytics_export"
+)
+
+// Default and maximum number of jobs returned when listing jobs.
+const (
+	defaultJobsLimit = 50
+	maxJobsLimit     = 500
+)
+
+// Maximum period of time covered by an analytics export job.
+const maxExportPeriod = 366 * 24 * time.Hour
+
+// Returned by running jobs when cancelled.
+var errJobCancelled = errors.New("job cancelled")
+
+// Validate the kind and parameters of a new job.
+func validateJobRequest(req *protov1.JobRequest) error {
+	switch req.Kind {
+	case batchContactMatching:
+		if req.Params["diagnosis"] == "" {
+			return invalidArgument("params.diagnosis", "a diagnosis identifier is required")
+		}
+	case batchAnalyticsExport:
+		if !utils.ValidGeoHash(req.Params["region"]) {
+			return invalidArgument("params.region", "region must be a valid geohash prefix")
+		}
+		from, to, err := exportPeriod(req.Params)
+		if err != nil {
+			return invalidArgument("params.from", err.Error())
+		}
+		if to.Before(from) || to.Sub(from) > maxExportPeriod {
+			return invalidArgument("params.to", "invalid export period")
+		}
+	default:
+		return invalidArgument("kind", "unsupported job kind")
+	}
+	return nil
+}
+
+// Get the period covered by an analytics export job.
+func exportPeriod(params map[string]string) (time.Time, time.Time, error) {
+	from, err := time.Parse("2006-01-02", params["from"])
+	if err != nil {
+		return from, from, errors.New("dates must be in the form YYYY-MM-DD")
+	}
+	to, err := time.Parse("2006-01-02", params["to"])
+	if err != nil {
+		return from, to, errors.New("dates must be in the form YYYY-MM-DD")
+	}
+	return from, to, nil
+}
+
+// SubmitJob registers a new job and dispatches it to the workers.
+func (srv *Server) SubmitJob(ctx context.Context, submittedBy string, req *protov1.JobRequest) (*protov1.Job, error) {
+	if err := validateJobRequest(req); err != nil {
+		return nil, err
+	}
+	job, err := srv.repos.JobQueue().SubmitJob(req.Kind, req.Params, submittedBy)
+	if err != nil {
+		return nil, errInternalError
+	}
+	contents, err := (&protov1.JobQuery{Id: job.Id}).Marshal()
+	if err != nil {
+		return nil, errInternalError
+	}
+	if _, err := srv.submitTask(ctx, "ct19.job", contents, submittedBy); err != nil {
+		// Don't leave the job pending if it can't be dispatched
+		_, _ = srv.repos.JobQueue().CancelJob(job.Id)
+		return nil, errFailedToPublish
+	}
+	srv.log.WithFields(xlog.Fields{
+		"job":  job.Id,
+		"kind": job.Kind,
+	}).Info("job submitted")
+	return job, nil
+}
+
+// GetJob returns the current state of a job.
+func (srv *Server) GetJob(req *protov1.JobQuery) (*protov1.Job, error) {
+	job, err := srv.repos.JobQueue().Job(req.Id)
+	if err != nil {
+		return nil, notFound("job")
+	}
+	return job, nil
+}
+
+// ListJobs returns the most recent jobs, optionally filtered by status.
+func (srv *Server) ListJobs(req *protov1.ListJobsRequest) (*protov1.ListJobsResponse, error) {
+	switch req.Status {
+	case "", storage.JobPending, storage.JobRunning, storage.JobCompleted, storage.JobFailed, storage.JobCancelled:
+	default:
+		return nil, invalidArgument("status", "unsupported job status")
+	}
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultJobsLimit
+	}
+	if limit > maxJobsLimit {
+		limit = maxJobsLimit
+	}
+	list, err := srv.repos.JobQueue().Jobs(req.Status, int64(limit))
+	if err != nil {
+		return nil, errInternalError
+	}
+	return &protov1.ListJobsResponse{Jobs: list}, nil
+}
+
+// CancelJob stops a pending or running job.
+func (srv *Server) CancelJob(req *protov1.JobQuery) (*protov1.Job, error) {
+	job, err := srv.repos.JobQueue().CancelJob(req.Id)
+	if err != nil {
+		return nil, notFound("job")
+	}
+	srv.log.WithField("job", job.Id).Info("job cancelled")
+	return job, nil
+}
+
+// Execute a job submitted by an administrator.
+func (w *Worker) batchJob(msg amqp.Delivery) {
+	log := w.logger(msg)
+	defer func() {
+		_ = msg.Ack(false)
+	}()
+
+	req := &protov1.JobQuery{}
+	if err := req.Unmarshal(msg.Body); err != nil {
+		log.WithField("error", err.Error()).Warning("invalid job message")
+		return
+	}
+	job, err := w.repos.JobQueue().StartJob(req.Id, w.name)
+	if err != nil {
+		log.WithField("error", err.Error()).Error("failed to start job")
+		return
+	}
+	if job == nil {
+		log.WithField("job", req.Id).Info("job is no longer pending")
+		return
+	}
+
+	log = log.WithFields(xlog.Fields{
+		"job":  job.Id,
+		"kind": job.Kind,
+	})
+	var result map[string]string
+	switch job.Kind {
+	case batchContactMatching:
+		result, err = w.contactMatchingJob(job)
+	case batchAnalyticsExport:
+		result, err = w.analyticsExportJob(job)
+	default:
+		err = errors.New("unsupported job kind")
+	}
+	if err == errJobCancelled {
+		log.Info("job cancelled")
+		return
+	}
+	if ferr := w.repos.JobQueue().FinishJob(job.Id, result, err); ferr != nil {
+		log.WithField("error", ferr.Error()).Error("failed to update job")
+	}
+	if err != nil {
+		log.WithField("error", err.Error()).Warning("job failed")
+		return
+	}
+	log.Info("job completed")
+}
+
+// Report the progress of a running job. Returns 'errJobCancelled' if the
+// job should stop.
+func (w *Worker) jobProgress(job *protov1.Job, done, total int) error {
+	progress := uint32(0)
+	if total > 0 {
+		progress = uint32(done * 100 / total)
+	}
+	running, err := w.repos.JobQueue().JobProgress(job.Id, progress)
+	if err != nil {
+		return err
+	}
+	if !running {
+		return errJobCancelled
+	}
+	return nil
+}
+
+// Notify the contacts of a positive case not previously notified.
+func (w *Worker) contactMatchingJob(job *protov1.Job) (map[string]string, error) {
+	d, err := w.repos.Exposures().FindDiagnosis(job.Params["diagnosis"])
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid diagnosis")
+	}
+	if d.Result != "positive" {
+		return nil, errors.New("diagnosis is not positive")
+	}
+	if err := w.jobProgress(job, 0, 1); err != nil {
+		return nil, err
+	}
+	count, err := w.notifyNewContacts(d)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{"exposures": strconv.Itoa(count)}, nil
+}
+
+// Export the analytics aggregates for a region, one set of files per day.
+func (w *Worker) analyticsExportJob(job *protov1.Job) (map[string]string, error) {
+	if w.exp == nil {
+		return nil, errors.New("analytics export is not enabled")
+	}
+	from, to, err := exportPeriod(job.Params)
+	if err != nil {
+		return nil, err
+	}
+	region := job.Params["region"]
+	area := &protov1.Organization{Jurisdiction: []string{region}}
+	days := int(to.Sub(from)/(24*time.Hour)) + 1
+	totalHotspots, totalFlows := 0, 0
+	for i := 0; i < days; i++ {
+		if err := w.jobProgress(job, i, days); err != nil {
+			return nil, err
+		}
+		day := from.Add(time.Duration(i) * 24 * time.Hour)
+		hotspots, flows, err := w.repos.Aggregates().Analytics(day, day.Add(24*time.Hour))
+		if err != nil {
+			return nil, err
+		}
+		res := filterAnalytics(area, &protov1.AnalyticsResponse{Hotspots: hotspots, Flows: flows})
+		ht := export.Hotspots(res.Hotspots)
+		ht.Name = ht.Name + "_" + region
+		ft := export.Flows(res.Flows)
+		ft.Name = ft.Name + "_" + region
+		if err := w.exp.Export(day, ht, ft); err != nil {
+			return nil, err
+		}
+		totalHotspots += len(res.Hotspots)
+		totalFlows += len(res.Flows)
+	}
+	return map[string]string{
+		"days":     strconv.Itoa(days),
+		"hotspots": strconv.Itoa(totalHotspots),
+		"flows":    strconv.Itoa(totalFlows),
+	}, nil
+}
@@ -0,0 +1,43 @@
+package api
+
+import (
+	"testing"
+
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+)
+
+func TestValidateJobRequest(t *testing.T) {
+	cases := []struct {
+		req   *protov1.JobRequest
+		valid bool
+	}{
+		{&protov1.JobRequest{Kind: batchContactMatching, Params: map[string]string{"diagnosis": "abc"}}, true},
+		{&protov1.JobRequest{Kind: batchContactMatching}, false},
+		{&protov1.JobRequest{Kind: batchAnalyticsExport, Params: map[string]string{
+			"region": "9g3",
+			"from":   "2020-05-01",
+			"to":     "2020-05-07",
+		}}, true},
+		{&protov1.JobRequest{Kind: batchAnalyticsExport, Params: map[string]string{
+			"region": "9g3a", // invalid geohash character
+			"from":   "2020-05-01",
+			"to":     "2020-05-07",
+		}}, false},
+		{&protov1.JobRequest{Kind: batchAnalyticsExport, Params: map[string]string{
+			"region": "9g3",
+			"from":   "2020-05-07",
+			"to":     "2020-05-01",
+		}}, false},
+		{&protov1.JobRequest{Kind: batchAnalyticsExport, Params: map[string]string{
+			"region": "9g3",
+			"from":   "05/01/2020",
+			"to":     "2020-05-07",
+		}}, false},
+		{&protov1.JobRequest{Kind: "unknown"}, false},
+	}
+	for i, c := range cases {
+		if err := validateJobRequest(c.req); (err == nil) != c.valid {
+			t.Errorf("case %d: expected valid=%v, got %v", i, c.valid, err)
+		}
+	}
+}
@@ -0,0 +1,223 @@
+package api
+
+import (
+	"crypto"
+	"io/ioutil"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.bryk.io/covid-tracking/secrets"
+	"go.bryk.io/x/jwx"
+	"golang.org/x/crypto/sha3"
+)
+
+// Default period of time secrets are cached before being retrieved again
+// from the provider.
+const defaultSecretsTTL = time.Hour
+
+// Keys used by the server to sign access credentials and produce refresh
+// codes. Keys can be rotated while the server is running.
+type serverKeys struct {
+	tg     tokenGenerator
+	sk     []byte // PEM-encoded signing key, when available locally
+	hk     []byte // current hash key
+	prevHK []byte // previous hash key, to accept refresh codes issued before a rotation
+	pub    []crypto.PublicKey
+	mu     sync.RWMutex
+}
+
+// Return the current token generator.
+func (sk *serverKeys) generator() tokenGenerator {
+	sk.mu.RLock()
+	defer sk.mu.RUnlock()
+	return sk.tg
+}
+
+// Return the signing method used by the current token generator. Signers
+// report the method supported by their key; PEM-encoded ECDSA keys are
+// always used for ES384.
+func (sk *serverKeys) method() string {
+	sk.mu.RLock()
+	defer sk.mu.RUnlock()
+	if sg, ok := sk.tg.(*signerGenerator); ok {
+		return sg.alg
+	}
+	return jwx.ES384
+}
+
+// Return the current and previous hash keys.
+func (sk *serverKeys) hashKeys() [][]byte {
+	sk.mu.RLock()
+	defer sk.mu.RUnlock()
+	if sk.prevHK == nil {
+		return [][]byte{sk.hk}
+	}
+	return [][]byte{sk.hk, sk.prevHK}
+}
+
+// Return the public keys accepted to verify access credentials; the
+// current signing key first and, after a rotation, the previous one.
+func (sk *serverKeys) publicKeys() []crypto.PublicKey {
+	sk.mu.RLock()
+	defer sk.mu.RUnlock()
+	return sk.pub
+}
+
+// Set the public key for a new signing key, keeping the previous one.
+func (sk *serverKeys) setPublicKey(pub crypto.PublicKey) {
+	if len(sk.pub) > 0 {
+		sk.pub = []crypto.PublicKey{pub, sk.pub[0]}
+		return
+	}
+	sk.pub = []crypto.PublicKey{pub}
+}
+
+// Setup the server's signing and hash keys. The signing key is resolved in
+// the following order: an external signer (i.e. cloud KMS), an HSM, or the
+// PEM-encoded key retrieved from the secrets provider. The signing and hash
+// keys are independent of the root CA; when using the files on the home
+// directory, both keys are generated on the first run. The root CA key is
+// used from the HSM when configured.
+func (srv *Server) setupKeys(opts *ServerOptions) error {
+	var (
+		err    error
+		signer crypto.Signer
+	)
+	srv.keys = &serverKeys{}
+
+	// External signer
+	if opts.Signer != nil {
+		signer = opts.Signer
+	}
+
+	// HSM
+	if opts.HSM != nil {
+		var key crypto.Signer
+		if srv.hsm, key, err = setupHSM(opts.HSM); err != nil {
+			return err
+		}
+		if signer == nil {
+			signer = key
+		}
+		if opts.HSM.CAKey != "" {
+			if srv.hsmCA, err = setupHSMCA(srv.hsm, opts.HSM.CAKey, opts.Home); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Local files
+	if opts.Secrets == nil {
+		// Verify credentials and setup PKI, unless the root CA key is
+		// kept on the HSM
+		if srv.hsmCA == nil {
+			if err = verifyRootCA(opts.Home); err != nil {
+				return err
+			}
+			if srv.ca, err = setupPKI(opts.Home); err != nil {
+				return err
+			}
+		}
+
+		// Generate keys if required
+		alg := srv.alg
+		if signer != nil {
+			alg = ""
+		}
+		if err = secrets.EnsureKeys(opts.Home, alg); err != nil {
+			return err
+		}
+	}
+
+	// Signing key
+	if signer != nil {
+		sg, err := newSignerGenerator(opts.Name, signer)
+		if err != nil {
+			return err
+		}
+		if sg.alg != srv.alg {
+			return errors.Errorf("signing key doesn't support the %s algorithm", srv.alg)
+		}
+		srv.keys.tg = sg
+		srv.keys.setPublicKey(signer.Public())
+	} else {
+		if srv.keys.sk, err = srv.secrets.Get(secrets.SigningKey); err != nil {
+			return errors.Wrap(err, "failed to retrieve signing key")
+		}
+		if srv.keys.tg, err = setupTokenGenerator(opts.Name, srv.alg, srv.keys.sk); err != nil {
+			return err
+		}
+		pub, err := signingPublicKey(srv.keys.sk)
+		if err != nil {
+			return err
+		}
+		srv.keys.setPublicKey(pub)
+	}
+
+	// Hash keys
+	if srv.keys.hk, err = srv.secrets.Get(secrets.HashKey); err != nil {
+		return errors.Wrap(err, "failed to retrieve hash key")
+	}
+	srv.keys.prevHK, _ = srv.secrets.Get(secrets.PreviousHashKey)
+	if srv.keys.prevHK == nil && opts.Secrets == nil {
+		srv.keys.prevHK = legacyHashKey(opts.Home)
+	}
+	return nil
+}
+
+// Retrieve the latest version of the signing and hash keys from the secrets
+// provider, replacing the keys in use if required. Refresh codes produced
+// with the previous hash key are still accepted after a rotation.
+func (srv *Server) rotateKeys() {
+	keys := srv.keys
+	keys.mu.Lock()
+	defer keys.mu.Unlock()
+
+	// Signing key
+	if keys.sk != nil {
+		sk, err := srv.secrets.Changed(secrets.SigningKey, keys.sk)
+		if err != nil {
+			srv.log.WithField("error", err).Warning("failed to retrieve signing key")
+		}
+		if sk != nil {
+			tg, err := setupTokenGenerator(srv.name, srv.alg, sk)
+			if err == nil {
+				var pub crypto.PublicKey
+				if pub, err = signingPublicKey(sk); err == nil {
+					keys.tg = tg
+					keys.sk = sk
+					keys.setPublicKey(pub)
+					srv.log.Info("signing key rotated")
+				}
+			}
+			if err != nil {
+				srv.log.WithField("error", err).Error("invalid signing key")
+			}
+		}
+	}
+
+	// Hash key
+	hk, err := srv.secrets.Changed(secrets.HashKey, keys.hk)
+	if err != nil {
+		srv.log.WithField("error", err).Warning("failed to retrieve hash key")
+	}
+	if hk != nil {
+		keys.prevHK = keys.hk
+		keys.hk = hk
+		srv.log.Info("hash key rotated")
+	}
+}
+
+// Previous versions derived the hash key from the root CA private key. The
+// legacy key is accepted, until the next rotation, to validate refresh codes
+// issued before the upgrade.
+func legacyHashKey(home string) []byte {
+	src, err := ioutil.ReadFile(filepath.Clean(filepath.Join(home, "root-ca.pem")))
+	if err != nil {
+		return nil
+	}
+	h := sha3.Sum256(src)
+	return h[:]
+}
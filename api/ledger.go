@@ -0,0 +1,114 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.bryk.io/covid-tracking/storage"
+	"go.bryk.io/x/ccg/did"
+	xlog "go.bryk.io/x/log"
+)
+
+// Default ledger settings.
+const (
+	defaultAnchorInterval   = 24 * time.Hour
+	defaultAnchorDifficulty = 18
+)
+
+// Identifier of the service entry used to anchor the ledger on the DID
+// document.
+const ledgerService = "ct19-ledger"
+
+// LedgerConfig adjusts the tamper-evident ledger kept over the location
+// records, check-ins and audit entries stored. Entries are periodically
+// sealed on hash-chained blocks by the "ledger" job.
+type LedgerConfig struct {
+	// DID used to anchor the ledger. The hash of the most recent block is
+	// added as a service entry on its document, which is published using
+	// the DID/PoW mechanism. The "master" private key must be available.
+	// A nil value disables anchoring.
+	Anchor *did.Identifier
+
+	// Minimum period of time between anchors. If not provided a default
+	// value of 24 hours is used.
+	AnchorInterval time.Duration
+
+	// Proof-of-work difficulty level used to publish the DID document. If
+	// not provided a default value of 18 is used.
+	Difficulty uint
+}
+
+// Seal the ledger entries registered since the last execution and, when
+// due, anchor the most recent block.
+func (w *Worker) sealLedger() error {
+	var head *storage.LedgerBlock
+	for {
+		block, err := w.repos.Ledger().SealLedger()
+		if err != nil {
+			return errors.Wrap(err, "failed to seal ledger block")
+		}
+		if block == nil {
+			break
+		}
+		head = block
+		w.log.WithFields(xlog.Fields{
+			"seq":     block.Seq,
+			"entries": block.Entries,
+			"hash":    block.Hash,
+		}).Debug("ledger block sealed")
+	}
+	if w.ledger.Anchor == nil || head == nil {
+		return nil
+	}
+
+	// Anchor most recent block
+	last, err := w.repos.Ledger().LastAnchor()
+	if err != nil {
+		return err
+	}
+	interval := w.ledger.AnchorInterval
+	if interval == 0 {
+		interval = defaultAnchorInterval
+	}
+	if last != nil && time.Since(last.Anchored) < interval {
+		return nil
+	}
+	anchor, err := anchorLedger(w.ctx, w.dids, w.ledger, head)
+	if err != nil {
+		return errors.Wrap(err, "failed to anchor ledger")
+	}
+	w.log.WithFields(xlog.Fields{
+		"seq":    head.Seq,
+		"anchor": anchor,
+	}).Info("ledger anchored")
+	return w.repos.Ledger().AnchorLedger(head.Seq, anchor)
+}
+
+// Publish the hash of a ledger block as a service entry on the anchor DID
+// document. Returns the reference to the service entry. The proof-of-work
+// for each block is resumed if interrupted.
+func anchorLedger(ctx context.Context, dp *didPublisher, conf *LedgerConfig,
+	block *storage.LedgerBlock) (string, error) {
+	id := conf.Anchor
+	_ = id.RemoveService(ledgerService)
+	err := id.AddService(&did.ServiceEndpoint{
+		ID:       ledgerService,
+		Type:     "ct19.ledger",
+		Endpoint: fmt.Sprintf("urn:ct19:ledger:%d:%s", block.Seq, block.Hash),
+	})
+	if err != nil {
+		return "", err
+	}
+	difficulty := conf.Difficulty
+	if difficulty == 0 {
+		difficulty = defaultAnchorDifficulty
+	}
+	ref := fmt.Sprintf("%s#%s", id.String(), ledgerService)
+	key := fmt.Sprintf("%s:%d", ref, block.Seq)
+	if err := dp.publish(ctx, key, id, difficulty); err != nil {
+		return "", err
+	}
+	return ref, nil
+}
@@ -0,0 +1,68 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// Default request limits.
+const (
+	// Maximum size, in bytes, of a request message.
+	defaultMaxMessageSize = 256 * 1024
+
+	// Maximum number of location or check-in records per request.
+	defaultMaxRecords = 100
+)
+
+// Valid altitude range, in meters, for location records. Covers the lowest
+// land elevation and commercial flights.
+const (
+	minAltitude = -500
+	maxAltitude = 15000
+)
+
+// Size and content limits for incoming requests.
+type requestLimits struct {
+	size    int
+	records int
+}
+
+// Reject request messages larger than the maximum size supported.
+func (srv *Server) limitSize(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	if m, ok := req.(interface{ Size() int }); ok && m.Size() > srv.limits.size {
+		msg := fmt.Sprintf("request message larger than %d bytes", srv.limits.size)
+		return nil, newError(codes.ResourceExhausted, protov1.ErrorCode_ERROR_CODE_INVALID_ARGUMENT, msg)
+	}
+	return handler(ctx, req)
+}
+
+// Verify latitude and longitude values are within the valid ranges. NaN
+// values are rejected by the comparisons.
+func validCoordinates(lat, lng float32) bool {
+	return lat >= -90 && lat <= 90 && lng >= -180 && lng <= 180
+}
+
+// Verify an altitude value is within the supported range.
+func validAltitude(alt float32) bool {
+	return alt >= minAltitude && alt <= maxAltitude
+}
+
+// Verify all location records on a request have valid coordinates. Returns
+// an error describing the first invalid record.
+func validateLocations(records []*protov1.LocationRecord) error {
+	for i, r := range records {
+		if !validCoordinates(r.Lat, r.Lng) {
+			return invalidArgument(fmt.Sprintf("records[%d]", i), "latitude or longitude out of range")
+		}
+		if !validAltitude(r.Alt) {
+			return invalidArgument(fmt.Sprintf("records[%d].alt", i),
+				fmt.Sprintf("altitude must be between %d and %d meters", minAltitude, maxAltitude))
+		}
+	}
+	return nil
+}
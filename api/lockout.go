@@ -0,0 +1,162 @@
+package api
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/prometheus/client_golang/prometheus"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/covid-tracking/storage"
+	xlog "go.bryk.io/x/log"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+)
+
+// Failed credential requests tolerated before locking a DID or a network
+// address. Addresses can be shared by many legitimate users (i.e. carrier
+// NATs) and are given a higher threshold.
+const (
+	lockoutThresholdDID     = 5
+	lockoutThresholdAddress = 20
+)
+
+// Lockouts start at 1 minute and double with every additional failure, up
+// to 24 hours.
+const (
+	lockoutBase = time.Minute
+	lockoutMax  = 24 * time.Hour
+)
+
+// Brute-force protection metrics.
+var (
+	authFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "ct19_credentials_failures_total",
+		Help: "Failed credential requests by reason.",
+	}, []string{"reason"})
+	authLockouts = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "ct19_credentials_lockouts_total",
+		Help: "Lockouts applied by kind of key; 'did' or 'address'.",
+	}, []string{"kind"})
+	authRejected = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "ct19_credentials_locked_requests_total",
+		Help: "Credential requests rejected due to an active lockout.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(authFailures, authLockouts, authRejected)
+}
+
+// Return the lockout period to apply after the provided number of failed
+// attempts.
+func lockoutDuration(failures, threshold int) time.Duration {
+	if failures < threshold {
+		return 0
+	}
+	if failures-threshold > 10 {
+		return lockoutMax
+	}
+	d := lockoutBase << uint(failures-threshold)
+	if d > lockoutMax {
+		return lockoutMax
+	}
+	return d
+}
+
+// Return the reason for a failed credentials request, if it must be counted
+// as a failed attempt.
+func failureReason(err error) string {
+	switch err {
+	case errInvalidSignature:
+		return "invalid_signature"
+	case errReplayedProof:
+		return "replayed_proof"
+	case errInvalidActivationCode:
+		return "invalid_activation_code"
+	case errInvalidAttestation:
+		return "invalid_attestation"
+	default:
+		return ""
+	}
+}
+
+// Reject credential requests for locked DIDs or network addresses. To avoid
+// denying access to legitimate users, storage errors are reported but the
+// request is allowed.
+func (srv *Server) checkLockout(did, address string) error {
+	keys := []string{"did:" + did}
+	if address != "" {
+		keys = append(keys, "address:"+address)
+	}
+	until, err := srv.repos.Lockouts().LockedUntil(keys...)
+	if err != nil {
+		srv.log.WithField("error", err.Error()).Warning("failed to verify lockouts")
+		return nil
+	}
+	if until.IsZero() {
+		return nil
+	}
+	authRejected.Inc()
+	return newError(codes.ResourceExhausted,
+		protov1.ErrorCode_ERROR_CODE_RATE_LIMITED, "too many failed attempts",
+		&errdetails.RetryInfo{RetryDelay: ptypes.DurationProto(time.Until(until).Round(time.Second))})
+}
+
+// Register the result of a credentials request, applying a lockout to the
+// DID and/or network address when the failures threshold is reached.
+func (srv *Server) trackAttempt(did, address string, err error) {
+	reason := failureReason(err)
+	if reason == "" {
+		if err == nil {
+			_ = srv.repos.Lockouts().ResetAuthFailures("did:" + did)
+		}
+		return
+	}
+	authFailures.WithLabelValues(reason).Inc()
+	srv.audit(&storage.AuditEntry{
+		Event:   auditCredentialsFailure,
+		Actor:   did,
+		Address: address,
+		Details: map[string]string{"reason": reason},
+	})
+	srv.applyLockout("did", "did:"+did, lockoutThresholdDID, did, address)
+	if address != "" {
+		srv.applyLockout("address", "address:"+address, lockoutThresholdAddress, did, address)
+	}
+}
+
+// Register a failed attempt for 'key' and lock it if required.
+func (srv *Server) applyLockout(kind, key string, threshold int, did, address string) {
+	failures, err := srv.repos.Lockouts().AuthFailure(key)
+	if err != nil {
+		srv.log.WithField("error", err.Error()).Warning("failed to register authentication failure")
+		return
+	}
+	d := lockoutDuration(failures, threshold)
+	if d == 0 {
+		return
+	}
+	if err := srv.repos.Lockouts().Lock(key, time.Now().Add(d)); err != nil {
+		srv.log.WithField("error", err.Error()).Warning("failed to apply lockout")
+		return
+	}
+	authLockouts.WithLabelValues(kind).Inc()
+	srv.log.WithFields(xlog.Fields{
+		"kind":     kind,
+		"did":      did,
+		"address":  address,
+		"failures": failures,
+		"duration": d.String(),
+	}).Warning("credentials lockout applied")
+	srv.audit(&storage.AuditEntry{
+		Event:   auditCredentialsLockout,
+		Actor:   did,
+		Address: address,
+		Details: map[string]string{
+			"kind":     kind,
+			"failures": strconv.Itoa(failures),
+			"duration": d.String(),
+		},
+	})
+}
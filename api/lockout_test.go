@@ -0,0 +1,27 @@
+package api
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLockoutDuration(t *testing.T) {
+	cases := []struct {
+		failures int
+		expected time.Duration
+	}{
+		{1, 0},
+		{4, 0},
+		{5, time.Minute},
+		{6, 2 * time.Minute},
+		{10, 32 * time.Minute},
+		{15, 1024 * time.Minute},
+		{16, lockoutMax},
+		{1000, lockoutMax},
+	}
+	for _, c := range cases {
+		if d := lockoutDuration(c.failures, lockoutThresholdDID); d != c.expected {
+			t.Errorf("%d failures: expected %s, got %s", c.failures, c.expected, d)
+		}
+	}
+}
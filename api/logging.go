@@ -0,0 +1,127 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"strings"
+	"time"
+
+	xlog "go.bryk.io/x/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// Value used in place of the sensitive data removed from log entries.
+const redacted = "[redacted]"
+
+// Payload fields holding sensitive data: coordinates, identifiers,
+// credentials, proofs and encrypted contents. Matched by name at any
+// nesting level.
+var sensitiveFields = map[string]bool{
+	"lat":             true,
+	"lng":             true,
+	"alt":             true,
+	"area":            true,
+	"did":             true,
+	"sender":          true,
+	"identifiers":     true,
+	"owner":           true,
+	"access_token":    true,
+	"refresh_code":    true,
+	"activation_code": true,
+	"token":           true,
+	"key":             true,
+	"api_key":         true,
+	"secret":          true,
+	"proof":           true,
+	"signature":       true,
+	"hash":            true,
+	"device":          true,
+	"ephemeral_key":   true,
+	"nonce":           true,
+	"ciphertext":      true,
+	"resource":        true,
+	"credential":      true,
+	"qr":              true,
+	"qr_code":         true,
+	"qr_image":        true,
+}
+
+// String values redacted regardless of the field name: DIDs and JWTs, which
+// always start with "eyJ", the encoded '{"' of their header.
+var sensitiveValue = regexp.MustCompile(`did:[a-z0-9]+:|^eyJ[\w-]*\.[\w-]+\.[\w-]+$`)
+
+// Methods not logged, to reduce noise.
+var unloggedMethods = map[string]bool{
+	"/bryk.covid.proto.v1.TrackingServerAPI/Ping": true,
+}
+
+// Log every request processed, along with its request and response payloads.
+// Unless 'unsafe' logging is enabled, coordinates, identifiers, credentials
+// and other sensitive values are redacted from the payloads; unsafe logging
+// should only be enabled for development.
+func (srv *Server) logRequests(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	if unloggedMethods[info.FullMethod] {
+		return handler(ctx, req)
+	}
+	start := time.Now()
+	res, err := handler(ctx, req)
+	fields := xlog.Fields{
+		"request_id": requestID(ctx),
+		"method":     info.FullMethod,
+		"duration":   time.Since(start).String(),
+		"code":       status.Code(err).String(),
+		"request":    srv.payload(req),
+	}
+	if err == nil {
+		fields["response"] = srv.payload(res)
+	}
+	srv.log.WithFields(fields).Debug("request processed")
+	return res, err
+}
+
+// JSON-encoded payload for a log entry.
+func (srv *Server) payload(msg interface{}) string {
+	if msg == nil {
+		return ""
+	}
+	js, err := json.Marshal(msg)
+	if err != nil || srv.rawLogs {
+		return string(js)
+	}
+	var data interface{}
+	if err = json.Unmarshal(js, &data); err != nil {
+		return redacted
+	}
+	js, _ = json.Marshal(redact(data))
+	return string(js)
+}
+
+// Replace the sensitive values on a decoded JSON document.
+func redact(data interface{}) interface{} {
+	switch v := data.(type) {
+	case map[string]interface{}:
+		for k, el := range v {
+			if sensitiveFields[strings.ToLower(k)] {
+				v[k] = redacted
+				continue
+			}
+			v[k] = redact(el)
+		}
+		return v
+	case []interface{}:
+		for i, el := range v {
+			v[i] = redact(el)
+		}
+		return v
+	case string:
+		if sensitiveValue.MatchString(v) {
+			return redacted
+		}
+		return v
+	default:
+		return v
+	}
+}
@@ -0,0 +1,50 @@
+package api
+
+import (
+	"strings"
+	"testing"
+
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+)
+
+func TestRedactPayload(t *testing.T) {
+	srv := &Server{}
+	req := &protov1.RecordRequest{
+		Records: []*protov1.LocationRecord{{
+			Did:       "did:bryk:4d2d7b92-5c2b-4a3b-9c7c-6c2e8b3b7f4d",
+			Lat:       19.4326,
+			Lng:       -99.1332,
+			Timestamp: 1588619270,
+			Hash:      "57365a03a9550e46aba13de8840f4674aaf198f506d0251b48c55c994efc0602",
+			Proof:     []byte("proof"),
+		}},
+		AppVersion: "1.4.2",
+	}
+	out := srv.payload(req)
+	for _, v := range []string{"did:bryk", "19.4326", "-99.1332", "57365a03", "cHJvb2Y="} {
+		if strings.Contains(out, v) {
+			t.Errorf("sensitive value not redacted: %s", v)
+		}
+	}
+	if !strings.Contains(out, "1588619270") || !strings.Contains(out, "1.4.2") {
+		t.Error("non-sensitive values should be preserved")
+	}
+
+	// Values redacted regardless of the field name
+	out = srv.payload(&protov1.ExposureQueryResponse{
+		Identifiers: []string{"did:bryk:4d2d7b92"},
+	})
+	if strings.Contains(out, "did:bryk") {
+		t.Error("identifiers not redacted")
+	}
+	out = srv.payload(&protov1.IntrospectRequest{Token: "eyJhbGciOi.eyJzdWIiOi.c2lnbmF0dXJl"})
+	if strings.Contains(out, "eyJ") {
+		t.Error("token not redacted")
+	}
+
+	// Unsafe logging
+	srv.rawLogs = true
+	if out = srv.payload(req); !strings.Contains(out, "did:bryk") {
+		t.Error("payload should not be redacted")
+	}
+}
@@ -0,0 +1,103 @@
+package api
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+// Maintenance settings are shared through the storage component and
+// refreshed periodically by all server instances.
+const maintenanceRefresh = 10 * time.Second
+
+// Suggested delay before retrying requests rejected during maintenance,
+// when not provided.
+const defaultMaintenanceRetry = 60
+
+// Methods still served while in maintenance mode. All admin operations are
+// allowed, so the mode can be disabled.
+var maintenanceAllowed = map[string]bool{
+	"/bryk.covid.proto.v1.TrackingServerAPI/Ping":             true,
+	"/bryk.covid.proto.v1.TrackingServerAPI/RenewCredentials": true,
+	"/bryk.covid.proto.v1.TrackingServerAPI/Introspect":       true,
+}
+
+type maintenanceMode struct {
+	status *protov1.MaintenanceStatus
+	mu     sync.RWMutex
+}
+
+func (mm *maintenanceMode) get() *protov1.MaintenanceStatus {
+	mm.mu.RLock()
+	defer mm.mu.RUnlock()
+	return mm.status
+}
+
+func (mm *maintenanceMode) set(status *protov1.MaintenanceStatus) {
+	mm.mu.Lock()
+	mm.status = status
+	mm.mu.Unlock()
+}
+
+// GetMaintenance returns the current maintenance mode settings.
+func (srv *Server) GetMaintenance() (*protov1.MaintenanceStatus, error) {
+	status, err := srv.repos.Settings().Maintenance()
+	if err != nil {
+		return nil, errInternalError
+	}
+	srv.maint.set(status)
+	return status, nil
+}
+
+// SetMaintenance enables or disables maintenance mode for all server
+// instances.
+func (srv *Server) SetMaintenance(status *protov1.MaintenanceStatus) (*protov1.MaintenanceStatus, error) {
+	if status.RetryAfter == 0 {
+		status.RetryAfter = defaultMaintenanceRetry
+	}
+	if err := srv.repos.Settings().SetMaintenance(status); err != nil {
+		return nil, errInternalError
+	}
+	srv.maint.set(status)
+	srv.log.WithField("enabled", status.Enabled).Warning("maintenance mode updated")
+	return status, nil
+}
+
+// Load the latest maintenance settings from storage.
+func (srv *Server) refreshMaintenance() {
+	status, err := srv.repos.Settings().Maintenance()
+	if err != nil {
+		srv.log.WithField("error", err.Error()).Warning("failed to refresh maintenance settings")
+		return
+	}
+	srv.maint.set(status)
+}
+
+// Reject requests while in maintenance mode with a retryable error. The
+// suggested delay is also returned on the "retry-after" response header.
+func (srv *Server) maintenanceGuard(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	status := srv.maint.get()
+	if status == nil || !status.Enabled ||
+		maintenanceAllowed[info.FullMethod] ||
+		strings.HasPrefix(info.FullMethod, "/bryk.covid.proto.v1.AdminAPI/") {
+		return handler(ctx, req)
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs("retry-after", strconv.Itoa(int(status.RetryAfter))))
+	msg := "service under maintenance"
+	if status.Message != "" {
+		msg = status.Message
+	}
+	delay := time.Duration(status.RetryAfter) * time.Second
+	return nil, newError(codes.Unavailable, protov1.ErrorCode_ERROR_CODE_MAINTENANCE, msg,
+		&errdetails.RetryInfo{RetryDelay: ptypes.DurationProto(delay)})
+}
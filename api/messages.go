@@ -0,0 +1,110 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/covid-tracking/storage"
+	"go.bryk.io/x/jwx"
+)
+
+// Encrypted messages settings.
+const (
+	// Supported encryption algorithm; a NaCl box between a single-use X25519
+	// key and the recipient's key-agreement key.
+	messageAlgorithm = "x25519-xsalsa20-poly1305"
+
+	// Maximum size of the encrypted message contents.
+	maxMessageSize = 16 * 1024
+
+	// Maximum number of messages returned per request.
+	maxMessagesFetch = 100
+)
+
+// Verify the envelope of an encrypted message. The contents can't be
+// inspected by the server.
+func validateMessage(m *protov1.EncryptedMessage) error {
+	if m.Did == "" {
+		return invalidArgument("did", "recipient is required")
+	}
+	if m.Algorithm != messageAlgorithm {
+		return invalidArgument("algorithm", fmt.Sprintf("supported algorithm is '%s'", messageAlgorithm))
+	}
+	if m.Key != m.Did+"#"+keyAgreement {
+		return invalidArgument("key", "messages must be encrypted to the recipient's key-agreement key")
+	}
+	if len(m.EphemeralKey) != 32 {
+		return invalidArgument("ephemeral_key", "a 32 bytes X25519 public key is required")
+	}
+	if len(m.Nonce) != 24 {
+		return invalidArgument("nonce", "a 24 bytes nonce is required")
+	}
+	if len(m.Ciphertext) == 0 || len(m.Ciphertext) > maxMessageSize {
+		return invalidArgument("ciphertext",
+			fmt.Sprintf("encrypted contents of up to %d bytes are supported", maxMessageSize))
+	}
+	return nil
+}
+
+// SendMessage stores a message encrypted end-to-end to a user. The recipient
+// must be a valid DID including a key-agreement key. Only the ciphertext is
+// stored, and the delivery is registered on the audit log.
+func (srv *Server) SendMessage(ctx context.Context, token *jwx.Token,
+	req *protov1.EncryptedMessage) (*protov1.SendMessageResponse, error) {
+	if err := validateMessage(req); err != nil {
+		return nil, err
+	}
+	data := &credentialsData{}
+	if err := token.Decode(&data); err != nil {
+		return nil, errUnauthenticated
+	}
+	recipient, err := resolveDID(req.Did, srv.providers)
+	if err != nil {
+		return nil, errInvalidDID
+	}
+	if recipient.Key(keyAgreement) == nil {
+		return nil, invalidArgument("did", "the recipient has no key-agreement key")
+	}
+	msg := &protov1.EncryptedMessage{
+		Sender:       data.DID,
+		Did:          req.Did,
+		Key:          req.Key,
+		Algorithm:    req.Algorithm,
+		EphemeralKey: req.EphemeralKey,
+		Nonce:        req.Nonce,
+		Ciphertext:   req.Ciphertext,
+	}
+	if err := srv.repos.Mailbox().Message(msg); err != nil {
+		return nil, errInternalError
+	}
+	srv.audit(&storage.AuditEntry{
+		Event:   "message.send",
+		Actor:   data.DID,
+		Address: clientAddress(ctx),
+		Details: map[string]string{
+			"message":   msg.Id,
+			"recipient": msg.Did,
+		},
+	})
+	return &protov1.SendMessageResponse{Id: msg.Id, Created: msg.Created}, nil
+}
+
+// Messages returns the encrypted messages received by the credential's
+// subject.
+// nolint: interfacer
+func (srv *Server) Messages(token *jwx.Token, req *protov1.MessagesRequest) (*protov1.MessagesResponse, error) {
+	if req.Since < 0 {
+		return nil, invalidArgument("since", "invalid date")
+	}
+	data := &credentialsData{}
+	if err := token.Decode(&data); err != nil {
+		return nil, errUnauthenticated
+	}
+	list, err := srv.repos.Mailbox().Messages(data.DID, time.Unix(req.Since, 0), maxMessagesFetch)
+	if err != nil {
+		return nil, errInternalError
+	}
+	return &protov1.MessagesResponse{Messages: list}, nil
+}
@@ -0,0 +1,42 @@
+package api
+
+import (
+	"bytes"
+	"testing"
+
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+)
+
+func TestValidateMessage(t *testing.T) {
+	recipient := "did:bryk:4d2d7b92-5c2b-4a3b-9c7c-6c2e8b3b7f4d"
+	valid := func() *protov1.EncryptedMessage {
+		return &protov1.EncryptedMessage{
+			Did:          recipient,
+			Key:          recipient + "#" + keyAgreement,
+			Algorithm:    messageAlgorithm,
+			EphemeralKey: make([]byte, 32),
+			Nonce:        make([]byte, 24),
+			Ciphertext:   []byte("sealed contents"),
+		}
+	}
+	if err := validateMessage(valid()); err != nil {
+		t.Fatal(err)
+	}
+
+	invalid := []func(m *protov1.EncryptedMessage){
+		func(m *protov1.EncryptedMessage) { m.Did = "" },
+		func(m *protov1.EncryptedMessage) { m.Algorithm = "aes-256-gcm" },
+		func(m *protov1.EncryptedMessage) { m.Key = recipient + "#" + keyAuthentication },
+		func(m *protov1.EncryptedMessage) { m.EphemeralKey = m.EphemeralKey[:16] },
+		func(m *protov1.EncryptedMessage) { m.Nonce = nil },
+		func(m *protov1.EncryptedMessage) { m.Ciphertext = nil },
+		func(m *protov1.EncryptedMessage) { m.Ciphertext = bytes.Repeat([]byte{1}, maxMessageSize+1) },
+	}
+	for i, fn := range invalid {
+		m := valid()
+		fn(m)
+		if err := validateMessage(m); err == nil {
+			t.Errorf("%d: invalid message should be rejected", i)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package api
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.bryk.io/covid-tracking/i18n"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/x/jwx"
+	xlog "go.bryk.io/x/log"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Middleware returns the interceptors to be applied to all unary RPC calls
+// handled by the server; the additional interceptors provided on the server
+// options are applied after the built-in ones.
+func (srv *Server) Middleware() []grpc.UnaryServerInterceptor {
+	list := []grpc.UnaryServerInterceptor{
+		srv.correlate,
+		srv.resolveClient,
+		srv.logRequests,
+		localizeErrors,
+		srv.trackRequests,
+		srv.limitSize,
+		srv.maintenanceGuard,
+	}
+	return append(list, srv.custom...)
+}
+
+// StreamMiddleware returns the interceptors to be applied to all stream RPC
+// calls handled by the server.
+func (srv *Server) StreamMiddleware() []grpc.StreamServerInterceptor {
+	return []grpc.StreamServerInterceptor{
+		srv.trackStreams,
+	}
+}
+
+// AuthInfo describes the credentials used on an authenticated request.
+type AuthInfo struct {
+	// Full name of the RPC method invoked, i.e.
+	// "/bryk.covid.proto.v1.TrackingServerAPI/Record".
+	Method string
+
+	// Credentials holder.
+	DID string
+
+	// Role of the credentials holder.
+	Role string
+
+	// Permissions the credentials are restricted to, if any.
+	Scope []string
+
+	// Credentials issuer, the server name or a trusted peer.
+	Issuer string
+
+	// Access token, to retrieve any custom claims.
+	Token *jwx.Token
+}
+
+// AuthCheck performs an additional verification on authenticated requests,
+// i.e. validate custom claims or restrict access based on the request metadata
+// available on 'ctx'. Returning an error rejects the request; status errors are
+// returned to the client as-is, any other error is reported as a "permission
+// denied" error using its message.
+type AuthCheck func(ctx context.Context, info *AuthInfo) error
+
+// Run the additional verifications registered for authenticated requests.
+func (srv *Server) runAuthChecks(ctx context.Context, token *jwx.Token) error {
+	if len(srv.checks) == 0 {
+		return nil
+	}
+	claims := &tokenClaims{}
+	if err := token.Decode(claims); err != nil {
+		return errUnauthenticated
+	}
+	info := &AuthInfo{
+		DID:    claims.DID,
+		Role:   claims.Role,
+		Scope:  claims.Scope,
+		Issuer: claims.Issuer,
+		Token:  token,
+	}
+	info.Method, _ = grpc.Method(ctx)
+	for _, check := range srv.checks {
+		err := check(ctx, info)
+		if err == nil {
+			continue
+		}
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		return newError(codes.PermissionDenied, protov1.ErrorCode_ERROR_CODE_UNAUTHORIZED, err.Error())
+	}
+	return nil
+}
+
+// Metadata key used to provide request identifiers.
+const requestIDKey = "x-request-id"
+
+type requestIDCtxKey struct{}
+
+// Ensure every request has a unique identifier, generating a new one if not
+// provided by the client. The identifier is returned to the client as a
+// response header and used to correlate log entries and broker messages.
+func (srv *Server) correlate(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	id := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get(requestIDKey); len(v) > 0 && len(v[0]) <= 128 {
+			id = v[0]
+		}
+	}
+	if id == "" {
+		id = uuid.New().String()
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs(requestIDKey, id))
+	res, err := handler(context.WithValue(ctx, requestIDCtxKey{}, id), req)
+	if err != nil {
+		srv.log.WithFields(xlog.Fields{
+			"request_id": id,
+			"method":     info.FullMethod,
+			"error":      err.Error(),
+		}).Warning("request failed")
+	}
+	return res, err
+}
+
+// Return the identifier for the request being processed.
+func requestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDCtxKey{}).(string)
+	return id
+}
+
+// Add a localized description to all error responses, using the preferred
+// language for the request.
+func localizeErrors(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	res, err := handler(ctx, req)
+	if err == nil {
+		return res, nil
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return res, err
+	}
+	code := protov1.ErrorCode_ERROR_CODE_UNSPECIFIED
+	for _, d := range st.Details() {
+		if ed, ok := d.(*protov1.ErrorDetail); ok {
+			code = ed.Code
+			break
+		}
+	}
+	lang := getLanguage(ctx)
+	key := "error." + strings.ToLower(strings.TrimPrefix(code.String(), "ERROR_CODE_"))
+	localized, e := st.WithDetails(&errdetails.LocalizedMessage{
+		Locale:  string(lang),
+		Message: i18n.Text(lang, key),
+	})
+	if e != nil {
+		return res, err
+	}
+	return res, localized.Err()
+}
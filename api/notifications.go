@@ -0,0 +1,56 @@
+package api
+
+import (
+	"fmt"
+
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/covid-tracking/storage"
+	"go.bryk.io/x/jwx"
+)
+
+// Maximum number of notifications acknowledged per request.
+const maxAckNotifications = 100
+
+// Ack registers the delivery, or reading, of notifications received by the
+// user. Only notifications addressed to the credential's subject are updated.
+// nolint: interfacer
+func (srv *Server) Ack(token *jwx.Token, req *protov1.AckRequest) (*protov1.AckResponse, error) {
+	if len(req.Notifications) == 0 || len(req.Notifications) > maxAckNotifications {
+		return nil, invalidArgument("notifications",
+			fmt.Sprintf("between 1 and %d notifications per request are supported", maxAckNotifications))
+	}
+	if req.Status != storage.NotificationDelivered && req.Status != storage.NotificationRead {
+		return nil, invalidArgument("status", "status must be either 'delivered' or 'read'")
+	}
+	data := &credentialsData{}
+	if err := token.Decode(&data); err != nil {
+		return nil, errUnauthenticated
+	}
+	count, err := srv.repos.Notifications().AckNotifications(data.DID, req.Notifications, req.Status)
+	if err != nil {
+		return nil, errInternalError
+	}
+	return &protov1.AckResponse{Updated: uint32(count)}, nil
+}
+
+// NotificationStatus returns the number of notifications, by delivery status,
+// originated by a diagnosis or venue outbreak.
+func (srv *Server) NotificationStatus(
+	req *protov1.NotificationStatusRequest) (*protov1.NotificationStatusResponse, error) {
+	if req.Source == "" {
+		return nil, invalidArgument("source", "source identifier is required")
+	}
+	counts, err := srv.repos.Notifications().NotificationStatus(req.Source)
+	if err != nil {
+		return nil, errInternalError
+	}
+	res := &protov1.NotificationStatusResponse{
+		Pending:    uint32(counts[storage.NotificationPending]),
+		Dispatched: uint32(counts[storage.NotificationDispatched]),
+		Failed:     uint32(counts[storage.NotificationFailed]),
+		Delivered:  uint32(counts[storage.NotificationDelivered]),
+		Read:       uint32(counts[storage.NotificationRead]),
+	}
+	res.Total = res.Pending + res.Dispatched + res.Failed + res.Delivered + res.Read
+	return res, nil
+}
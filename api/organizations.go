@@ -0,0 +1,129 @@
+package api
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/covid-tracking/utils"
+	"go.bryk.io/x/ccg/did"
+)
+
+// Geohash precision used to verify locations against an organization's
+// jurisdiction.
+const jurisdictionPrecision = 12
+
+// CreateOrganization registers a new organization.
+func (srv *Server) CreateOrganization(req *protov1.Organization) (*protov1.Organization, error) {
+	if req.Name == "" {
+		return nil, invalidArgument("name", "organization name is required")
+	}
+	if !validScope(req.Permissions) {
+		return nil, invalidArgument("permissions", "permissions must be in the form 'resource:action'")
+	}
+	for _, area := range req.Jurisdiction {
+		if !utils.ValidGeoHash(area) {
+			return nil, invalidArgument("jurisdiction", "areas must be valid geohash prefixes")
+		}
+	}
+	org := &protov1.Organization{
+		Id:           uuid.New().String(),
+		Name:         req.Name,
+		Kind:         req.Kind,
+		Jurisdiction: req.Jurisdiction,
+		Permissions:  req.Permissions,
+		Created:      time.Now().Unix(),
+	}
+	if err := srv.repos.OrganizationRegistry().RegisterOrganization(org); err != nil {
+		return nil, errInternalError
+	}
+	return org, nil
+}
+
+// ListOrganizations returns the registered organizations.
+func (srv *Server) ListOrganizations() (*protov1.ListOrganizationsResponse, error) {
+	list, err := srv.repos.OrganizationRegistry().Organizations()
+	if err != nil {
+		return nil, errInternalError
+	}
+	return &protov1.ListOrganizationsResponse{Organizations: list}, nil
+}
+
+// AddMember adds an agent to an organization.
+func (srv *Server) AddMember(req *protov1.MembershipRequest) error {
+	if _, err := did.Parse(req.Did); err != nil {
+		return errInvalidDID
+	}
+	if _, err := srv.repos.OrganizationRegistry().Organization(req.Organization); err != nil {
+		return notFound("organization")
+	}
+	if err := srv.repos.OrganizationRegistry().AddMember(req.Organization, req.Did); err != nil {
+		return errInternalError
+	}
+	return nil
+}
+
+// RemoveMember removes an agent from an organization.
+func (srv *Server) RemoveMember(req *protov1.MembershipRequest) error {
+	if err := srv.repos.OrganizationRegistry().RemoveMember(req.Organization, req.Did); err != nil {
+		return notFound("member")
+	}
+	return nil
+}
+
+// Return the organization the credentials holder belongs to, if any. Only
+// agents can be members of an organization.
+func (srv *Server) organization(data *credentialsData) *protov1.Organization {
+	if data.Role != "agent" || data.DID == "" {
+		return nil
+	}
+	id := srv.repos.OrganizationRegistry().MemberOf(data.DID)
+	if id == "" {
+		return nil
+	}
+	org, err := srv.repos.OrganizationRegistry().Organization(id)
+	if err != nil {
+		return nil
+	}
+	return org
+}
+
+// Verify a geohash cell is within the organization's jurisdiction. A nil
+// organization, or one without jurisdiction areas, is not restricted.
+func inJurisdiction(org *protov1.Organization, cell string) bool {
+	if org == nil || len(org.Jurisdiction) == 0 {
+		return true
+	}
+	for _, area := range org.Jurisdiction {
+		if strings.HasPrefix(cell, area) {
+			return true
+		}
+	}
+	return false
+}
+
+// Verify a location is within the organization's jurisdiction.
+func locationInJurisdiction(org *protov1.Organization, lat, lng float64) bool {
+	return inJurisdiction(org, utils.GeoHash(lat, lng, jurisdictionPrecision))
+}
+
+// Remove hotspots and flows outside the organization's jurisdiction. Flows
+// are kept if either their origin or destination is within the jurisdiction.
+func filterAnalytics(org *protov1.Organization, res *protov1.AnalyticsResponse) *protov1.AnalyticsResponse {
+	if org == nil || len(org.Jurisdiction) == 0 {
+		return res
+	}
+	filtered := &protov1.AnalyticsResponse{}
+	for _, h := range res.Hotspots {
+		if inJurisdiction(org, h.Cell) {
+			filtered.Hotspots = append(filtered.Hotspots, h)
+		}
+	}
+	for _, f := range res.Flows {
+		if inJurisdiction(org, f.Origin) || inJurisdiction(org, f.Destination) {
+			filtered.Flows = append(filtered.Flows, f)
+		}
+	}
+	return filtered
+}
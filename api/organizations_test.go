@@ -0,0 +1,28 @@
+package api
+
+import (
+	"testing"
+
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+)
+
+func TestJurisdiction(t *testing.T) {
+	org := &protov1.Organization{Jurisdiction: []string{"9g3q", "9g3w"}}
+	if !inJurisdiction(org, "9g3qxyz") || inJurisdiction(org, "9g3m123") {
+		t.Error("invalid jurisdiction check")
+	}
+	if !inJurisdiction(nil, "9g3m123") || !inJurisdiction(&protov1.Organization{}, "9g3m123") {
+		t.Error("unrestricted organization")
+	}
+
+	res := filterAnalytics(org, &protov1.AnalyticsResponse{
+		Hotspots: []*protov1.Hotspot{{Cell: "9g3qabc"}, {Cell: "9g3mabc"}},
+		Flows: []*protov1.Flow{
+			{Origin: "9g3mabc", Destination: "9g3wabc"},
+			{Origin: "9g3mabc", Destination: "9g3mdef"},
+		},
+	})
+	if len(res.Hotspots) != 1 || len(res.Flows) != 1 {
+		t.Error("invalid analytics filter")
+	}
+}
@@ -0,0 +1,330 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.bryk.io/covid-tracking/storage"
+	"go.bryk.io/x/amqp"
+	xlog "go.bryk.io/x/log"
+)
+
+// Message publishing settings.
+const (
+	// Attempts to publish a message before reporting a failure. The same
+	// limit applies to messages returned by the broker.
+	publishAttempts = 3
+
+	// Delay before the first retry, doubled on every additional attempt.
+	publishBackoff = 100 * time.Millisecond
+
+	// Published messages are kept for this period so they can be sent
+	// again if returned by the broker.
+	publishReturnWindow = time.Minute
+
+	// Maximum number of published messages kept for retries.
+	publishBufferSize = 10000
+
+	// How often to retry the delivery of messages saved on storage.
+	undeliveredInterval = time.Minute
+
+	// Maximum number of stored messages retried at once.
+	undeliveredBatchSize = 100
+)
+
+// Message publishing metrics.
+var (
+	publishFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "ct19_broker_publish_failures_total",
+		Help: "Messages that couldn't be published after all attempts, by exchange.",
+	}, []string{"exchange"})
+	publishReturns = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "ct19_broker_returned_messages_total",
+		Help: "Messages returned by the broker as unroutable.",
+	})
+	publishUndelivered = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "ct19_broker_undelivered_messages_total",
+		Help: "Messages saved on storage for later delivery.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(publishFailures, publishReturns, publishUndelivered)
+}
+
+// Message published to the broker.
+type outboxEntry struct {
+	msg     amqp.Message
+	opts    amqp.MessageOptions
+	sent    time.Time
+	returns int
+}
+
+// Bounded buffer of recently published messages. Entries are discarded
+// after the return window, or when the buffer is full, oldest first.
+type outbox struct {
+	entries map[string]*outboxEntry
+	order   []string
+	size    int
+	mu      sync.Mutex
+}
+
+func newOutbox(size int) *outbox {
+	return &outbox{
+		entries: make(map[string]*outboxEntry),
+		size:    size,
+	}
+}
+
+// Register a published message.
+func (ob *outbox) add(e *outboxEntry) {
+	ob.mu.Lock()
+	defer ob.mu.Unlock()
+	ob.prune(e.sent.Add(-1 * publishReturnWindow))
+	for len(ob.entries) >= ob.size && len(ob.order) > 0 {
+		delete(ob.entries, ob.order[0])
+		ob.order = ob.order[1:]
+	}
+	ob.entries[e.msg.MessageId] = e
+	ob.order = append(ob.order, e.msg.MessageId)
+}
+
+// Remove and return a published message, if still available.
+func (ob *outbox) take(id string) *outboxEntry {
+	ob.mu.Lock()
+	defer ob.mu.Unlock()
+	e, ok := ob.entries[id]
+	if !ok {
+		return nil
+	}
+	delete(ob.entries, id)
+	return e
+}
+
+// Discard entries published before 'cutoff'. Must be called with the
+// lock held.
+func (ob *outbox) prune(cutoff time.Time) {
+	for len(ob.order) > 0 {
+		e, ok := ob.entries[ob.order[0]]
+		if ok && e.sent.After(cutoff) {
+			return
+		}
+		if ok {
+			delete(ob.entries, ob.order[0])
+		}
+		ob.order = ob.order[1:]
+	}
+}
+
+// Publish a message to the broker, retrying failed attempts. An error is
+// returned if the message couldn't be published; the caller is responsible
+// for reporting the failure to the client.
+func (srv *Server) publish(msg amqp.Message, opts amqp.MessageOptions) (bool, error) {
+	if err := srv.push(&outboxEntry{msg: msg, opts: opts}); err != nil {
+		publishFailures.WithLabelValues(opts.Exchange).Inc()
+		srv.log.WithFields(xlog.Fields{
+			"id":       msg.MessageId,
+			"exchange": opts.Exchange,
+			"error":    err.Error(),
+		}).Error("failed to publish message")
+		return false, err
+	}
+	return true, nil
+}
+
+// Push a message to the broker, waiting for its confirmation. Messages are
+// registered on the outbox before being published, since the broker returns
+// unroutable messages before confirming them, and kept in case they are
+// returned. Pending publications are tracked so the server can wait for them
+// when shutting down; once draining, new publications are rejected.
+func (srv *Server) push(e *outboxEntry) error {
+	if !srv.pending.begin() {
+		return errors.New("server is shutting down")
+	}
+	defer srv.pending.end()
+	return srv.send(e)
+}
+
+// Publish a message, retrying failed attempts. Must be called with the
+// publication registered on the pending tracker.
+func (srv *Server) send(e *outboxEntry) error {
+	var err error
+	delay := publishBackoff
+	for i := 0; i < publishAttempts; i++ {
+		if i > 0 {
+			time.Sleep(delay)
+			delay *= 2
+		}
+		var confirmed bool
+		e.sent = time.Now()
+		srv.outbox.add(e)
+		confirmed, err = srv.publisher().Push(e.msg, e.opts)
+		srv.admission.observe("broker", time.Since(e.sent))
+		if err == nil && confirmed {
+			return nil
+		}
+		srv.outbox.take(e.msg.MessageId)
+		if err == nil {
+			err = errors.New("message not confirmed by the broker")
+		}
+	}
+	return err
+}
+
+// Handle a message returned by the broker as unroutable. The message is
+// published again, up to the maximum number of attempts, and then saved on
+// storage for later delivery. Messages returned while the server is shutting
+// down are saved right away.
+func (srv *Server) messageReturned(id string) {
+	publishReturns.Inc()
+	e := srv.outbox.take(id)
+	if e == nil {
+		srv.log.WithField("id", id).Warning("unknown message returned by the broker")
+		return
+	}
+	e.returns++
+	if e.returns < publishAttempts && srv.pending.begin() {
+		go func() {
+			defer srv.pending.end()
+			if err := srv.send(e); err != nil {
+				srv.saveUndelivered(e)
+			}
+		}()
+		return
+	}
+	srv.saveUndelivered(e)
+}
+
+// Save a message on storage for later delivery.
+func (srv *Server) saveUndelivered(e *outboxEntry) {
+	headers := make(map[string]string)
+	for k, v := range e.msg.Headers {
+		headers[k] = fmt.Sprint(v)
+	}
+	err := srv.repos.Undelivered().SaveUndelivered(&storage.UndeliveredMessage{
+		ID:          e.msg.MessageId,
+		Exchange:    e.opts.Exchange,
+		RoutingKey:  e.opts.RoutingKey,
+		Type:        e.msg.Type,
+		ContentType: e.msg.ContentType,
+		Body:        e.msg.Body,
+		Headers:     headers,
+		Created:     time.Now(),
+	})
+	log := srv.log.WithFields(xlog.Fields{
+		"id":       e.msg.MessageId,
+		"exchange": e.opts.Exchange,
+	})
+	if err != nil {
+		log.WithField("error", err.Error()).Error("message lost, failed to save for later delivery")
+		return
+	}
+	publishUndelivered.Inc()
+	log.Warning("message saved for later delivery")
+}
+
+// Retry the delivery of messages saved on storage.
+func (srv *Server) retryUndelivered() {
+	list, err := srv.repos.Undelivered().UndeliveredMessages(undeliveredBatchSize)
+	if err != nil {
+		srv.log.WithField("error", err.Error()).Warning("failed to retrieve undelivered messages")
+		return
+	}
+	for _, m := range list {
+		headers := make(map[string]interface{})
+		for k, v := range m.Headers {
+			headers[k] = v
+		}
+		e := &outboxEntry{
+			msg: amqp.Message{
+				Type:        m.Type,
+				Timestamp:   time.Now().UTC(),
+				MessageId:   m.ID,
+				ContentType: m.ContentType,
+				Body:        m.Body,
+				Headers:     headers,
+			},
+			opts: amqp.MessageOptions{
+				Exchange:   m.Exchange,
+				RoutingKey: m.RoutingKey,
+				Persistent: true,
+			},
+			// Save the message again if returned
+			returns: publishAttempts - 1,
+		}
+		if err := srv.push(e); err != nil {
+			// Broker still unavailable, wait for the next cycle
+			return
+		}
+		if err := srv.repos.Undelivered().DeleteUndelivered(m.ID); err != nil {
+			srv.log.WithField("error", err.Error()).Warning("failed to remove delivered message")
+		}
+	}
+}
+
+// Start handling messages returned by the broker, until the server is closed
+// or 'stopDelivery' is called.
+func (srv *Server) startDelivery() {
+	ctx, stop := context.WithCancel(srv.ctx)
+	srv.quiesce, srv.delivered = stop, make(chan struct{})
+	go srv.deliveryLoop(ctx)
+}
+
+// Handle messages returned by the broker and periodically retry the delivery
+// of messages saved on storage, until 'ctx' is done. The publisher is replaced
+// if the broker connection is lost.
+func (srv *Server) deliveryLoop(ctx context.Context) {
+	defer close(srv.delivered)
+	ticker := time.NewTicker(undeliveredInterval)
+	defer ticker.Stop()
+	returns := srv.publisher().MessageReturns()
+	for {
+		select {
+		case <-ctx.Done():
+			srv.flushReturns(returns)
+			return
+		case <-ticker.C:
+			srv.retryUndelivered()
+		case msg, ok := <-returns:
+			if ok {
+				srv.messageReturned(msg.MessageId)
+				continue
+			}
+			if !srv.reconnectPublisher() {
+				return
+			}
+			returns = srv.publisher().MessageReturns()
+		}
+	}
+}
+
+// Process the messages already returned by the broker, without waiting for
+// new ones.
+func (srv *Server) flushReturns(returns <-chan amqp.Return) {
+	for {
+		select {
+		case msg, ok := <-returns:
+			if !ok {
+				return
+			}
+			srv.messageReturned(msg.MessageId)
+		default:
+			return
+		}
+	}
+}
+
+// Stop handling messages returned by the broker and wait for the delivery
+// loop to exit, so no message is saved on storage after the handler is
+// closed. Returns already received are processed first.
+func (srv *Server) stopDelivery() {
+	if srv.quiesce == nil {
+		return
+	}
+	srv.quiesce()
+	<-srv.delivered
+}
@@ -0,0 +1,79 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"go.bryk.io/covid-tracking/broker/memtest"
+	"go.bryk.io/covid-tracking/utils"
+	"go.bryk.io/x/amqp"
+	xlog "go.bryk.io/x/log"
+)
+
+func TestOutbox(t *testing.T) {
+	entry := func(id string, sent time.Time) *outboxEntry {
+		return &outboxEntry{msg: amqp.Message{MessageId: id}, sent: sent}
+	}
+	now := time.Now()
+	ob := newOutbox(3)
+
+	// Expired entries are discarded
+	ob.add(entry("a", now.Add(-2*publishReturnWindow)))
+	ob.add(entry("b", now))
+	if ob.take("a") != nil {
+		t.Error("expired entry returned")
+	}
+	if e := ob.take("b"); e == nil || e.msg.MessageId != "b" {
+		t.Error("entry not found")
+	}
+	if ob.take("b") != nil {
+		t.Error("entry returned twice")
+	}
+
+	// Oldest entries are discarded when full
+	for i := 0; i < 5; i++ {
+		ob.add(entry(fmt.Sprintf("%d", i), now))
+	}
+	if len(ob.entries) != 3 || ob.take("1") != nil || ob.take("4") == nil {
+		t.Error("invalid buffer size")
+	}
+}
+
+func TestSubmitTask(t *testing.T) {
+	pub := memtest.NewPublisher()
+	srv := &Server{
+		pub:       pub,
+		log:       xlog.WithZero(false),
+		outbox:    newOutbox(10),
+		pending:   newRequestTracker(),
+		admission: newAdmissionController(nil),
+		shards:    4,
+	}
+	did := "did:bryk:7889c965-4644-44ff-b760-f396f1d11444"
+	ok, err := srv.submitTask(context.Background(), "ct19.location_record", []byte("contents"), did)
+	if err != nil || !ok {
+		t.Fatal("failed to submit task")
+	}
+	list := pub.Messages("tasks")
+	if len(list) != 1 {
+		t.Fatal("task not published")
+	}
+	if list[0].Options.RoutingKey != utils.TaskRoutingKey(did, 4) || list[0].Message.Headers["did"] != did {
+		t.Error("invalid task message")
+	}
+	if srv.outbox.take(list[0].Message.MessageId) == nil {
+		t.Error("confirmed message should be kept on the outbox")
+	}
+
+	// Broker failures are reported
+	pub.Fail(errors.New("connection lost"))
+	if _, err := srv.submitTask(context.Background(), "ct19.location_record", []byte("contents"), did); err == nil {
+		t.Error("failure not reported")
+	}
+	if len(srv.outbox.entries) != 0 {
+		t.Error("failed message should be removed from the outbox")
+	}
+}
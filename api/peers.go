@@ -0,0 +1,89 @@
+package api
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/pem"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.bryk.io/x/jwx"
+)
+
+// TrustedIssuer describes a peer server, like a regional server of the same
+// deployment, whose access credentials are accepted.
+type TrustedIssuer struct {
+	// Issuer identifier, as included on the "iss" claim of its credentials.
+	Name string `mapstructure:"name"`
+
+	// PEM-encoded public key(s) used to verify credentials. Several keys
+	// can be included while the peer is rotating its signing key.
+	PublicKey string `mapstructure:"public_key"`
+}
+
+// Parse the public keys of the trusted peer issuers.
+func loadTrustedIssuers(self string, list []*TrustedIssuer) (map[string][]crypto.PublicKey, error) {
+	peers := make(map[string][]crypto.PublicKey)
+	for _, ti := range list {
+		if ti.Name == "" || ti.Name == self {
+			return nil, errors.Errorf("invalid trusted issuer name: '%s'", ti.Name)
+		}
+		rest := []byte(ti.PublicKey)
+		for {
+			var block *pem.Block
+			block, rest = pem.Decode(rest)
+			if block == nil {
+				break
+			}
+			pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid public key for issuer: %s", ti.Name)
+			}
+			switch pub.(type) {
+			case *ecdsa.PublicKey, ed25519.PublicKey:
+				peers[ti.Name] = append(peers[ti.Name], pub)
+			default:
+				return nil, errors.Errorf("unsupported key type for issuer: %s", ti.Name)
+			}
+		}
+		if len(peers[ti.Name]) == 0 {
+			return nil, errors.Errorf("no public key provided for issuer: %s", ti.Name)
+		}
+	}
+	return peers, nil
+}
+
+// Validate credentials issued by a trusted peer server. The signature is
+// always verified and only "user" credentials are accepted, so users
+// registered with a peer can submit records while travelling.
+func validatePeerToken(token *jwx.Token, claims *tokenClaims, keys []crypto.PublicKey,
+	audiences []string, checkExpiration bool) error {
+	if claims.Role != "user" {
+		return errors.New("unsupported role for peer credentials")
+	}
+	if err := verifyTokenSignature(token.String(), keys); err != nil {
+		return err
+	}
+	valid := false
+	for _, aud := range audience(claims.Audience) {
+		for _, a := range audiences {
+			if aud == a {
+				valid = true
+			}
+		}
+	}
+	if !valid {
+		return errors.New("invalid audience")
+	}
+	now := time.Now()
+	checks := []jwx.ValidatorFunc{
+		jwx.NotBeforeValidator(now),
+		jwx.IssuedAtValidator(now),
+	}
+	if checkExpiration {
+		checks = append(checks, jwx.ExpirationTimeValidator(now, true))
+	}
+	return token.Validate(checks...)
+}
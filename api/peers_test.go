@@ -0,0 +1,50 @@
+package api
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+)
+
+func TestLoadTrustedIssuers(t *testing.T) {
+	encode := func(pub interface{}) string {
+		der, err := x509.MarshalPKIXPublicKey(pub)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+	}
+	ec, _ := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	ed, _, _ := ed25519.GenerateKey(rand.Reader)
+	rk, _ := rsa.GenerateKey(rand.Reader, 2048)
+
+	// Multiple keys per issuer are supported
+	peers, err := loadTrustedIssuers("ct19.example.com", []*TrustedIssuer{
+		{Name: "north.ct19.example.com", PublicKey: encode(&ec.PublicKey) + encode(ed)},
+		{Name: "south.ct19.example.com", PublicKey: encode(ed)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(peers["north.ct19.example.com"]) != 2 || len(peers["south.ct19.example.com"]) != 1 {
+		t.Error("invalid keys loaded")
+	}
+
+	// Invalid settings
+	invalid := [][]*TrustedIssuer{
+		{{Name: "ct19.example.com", PublicKey: encode(ed)}},
+		{{Name: "", PublicKey: encode(ed)}},
+		{{Name: "north.ct19.example.com", PublicKey: ""}},
+		{{Name: "north.ct19.example.com", PublicKey: encode(&rk.PublicKey)}},
+	}
+	for i, list := range invalid {
+		if _, err := loadTrustedIssuers("ct19.example.com", list); err == nil {
+			t.Errorf("case %d: expected error", i)
+		}
+	}
+}
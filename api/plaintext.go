@@ -0,0 +1,37 @@
+package api
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"time"
+
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+)
+
+// NewPlaintextListener returns an HTTP server exposing the RPC server on
+// 'target' through a plaintext port. gRPC requests, using HTTP/2 without TLS
+// (h2c), and HTTP/1.1 requests for the gateway are accepted on the same port
+// and forwarded to the local RPC server. Intended for deployments where TLS
+// is terminated by a load balancer; the port must not be publicly reachable.
+// The address of the original client is provided on the "X-Forwarded-For"
+// header.
+func NewPlaintextListener(port, target int) *http.Server {
+	backend := &url.URL{Scheme: "https", Host: fmt.Sprintf("127.0.0.1:%d", target)}
+	proxy := httputil.NewSingleHostReverseProxy(backend)
+	proxy.FlushInterval = -1 // required for streaming responses
+
+	// The server certificate is issued for its public name, not the loopback
+	// address; same as the internal client used by the HTTP gateway
+	proxy.Transport = &http2.Transport{
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+	}
+	return &http.Server{
+		Addr:              fmt.Sprintf(":%d", port),
+		Handler:           h2c.NewHandler(proxy, &http2.Server{}),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+}
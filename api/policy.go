@@ -0,0 +1,122 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/x/auth"
+)
+
+// ExplainPolicy evaluates an authorization request against the access policy
+// currently in use, without performing any action, and returns the decision
+// along with the evaluation trace. Useful to debug custom roles, scopes and
+// access conditions.
+func (srv *Server) ExplainPolicy(_ context.Context, req *protov1.PolicyRequest) (*protov1.PolicyDecision, error) {
+	if !srv.isRoleValid(req.Role) {
+		return nil, invalidArgument("role", "unsupported role")
+	}
+	if !validScope(req.Scope) {
+		return nil, invalidArgument("scope", "invalid permissions")
+	}
+	if req.Resource == "" || req.Action == "" {
+		return nil, invalidArgument("resource", "resource and action are required")
+	}
+	data := &credentialsData{
+		DID:   req.Did,
+		Role:  req.Role,
+		Scope: req.Scope,
+	}
+	resource := "/" + strings.TrimPrefix(req.Resource, "/")
+	attrs := &resourceAttrs{owner: req.Owner, namespace: req.Namespace}
+	return srv.evaluate(data, resource, req.Action, attrs, true), nil
+}
+
+// Evaluate an authorization request. When 'explain' is set, the decision
+// includes the policy rule matched, if any, and the evaluation trace.
+func (srv *Server) evaluate(data *credentialsData, resource, action string,
+	attrs *resourceAttrs, explain bool) *protov1.PolicyDecision {
+	d := &protov1.PolicyDecision{}
+	trace := func(format string, args ...interface{}) {
+		if explain {
+			d.Trace = append(d.Trace, fmt.Sprintf(format, args...))
+		}
+	}
+
+	// Role permissions
+	d.Allowed = srv.enf.Evaluate(auth.Request{
+		Subject:  data.Role,
+		Resource: resource,
+		Action:   action,
+	})
+	if explain {
+		d.Rule = matchRule(srv.rules, data.Role, resource, action)
+	}
+	if d.Allowed {
+		trace("role '%s' allowed by rule '%s'", data.Role, d.Rule)
+	} else {
+		trace("no rule grants '%s' on '%s' to role '%s'", action, resource, data.Role)
+
+		// Organizations can grant additional permissions to its members
+		if org := srv.organization(data); org != nil {
+			d.Allowed = permitted(org.Permissions, resource, action)
+			if d.Allowed {
+				trace("allowed by organization '%s'", org.Id)
+			} else {
+				trace("not allowed by organization '%s'", org.Id)
+			}
+		}
+	}
+	if !d.Allowed {
+		return d
+	}
+
+	// Credentials scope
+	if !data.allows(resource, action) {
+		d.Allowed = false
+		trace("not included on the credentials scope: %s", strings.Join(data.Scope, ", "))
+		return d
+	}
+
+	// Access conditions
+	now := time.Now()
+	for i, c := range srv.conds {
+		if !c.applies(data.Role, resource, action) {
+			continue
+		}
+		if !c.eval(data.DID, attrs, now) {
+			d.Allowed = false
+			trace("access condition #%d not met", i+1)
+			return d
+		}
+		trace("access condition #%d met", i+1)
+	}
+	return d
+}
+
+// Return the first policy rule granting the action on the resource to the
+// role, if any. Rules entries are evaluated as regular expressions.
+func matchRule(rules []string, role, resource, action string) string {
+	for _, r := range rules {
+		segments := strings.Split(r, ",")
+		if len(segments) != 4 {
+			continue
+		}
+		for i := range segments {
+			segments[i] = strings.TrimSpace(segments[i])
+		}
+		if fullMatch(segments[1], role) && fullMatch(segments[2], resource) && fullMatch(segments[3], action) {
+			return r
+		}
+	}
+	return ""
+}
+
+// Verify 'value' fully matches the regular expression 'pattern'.
+func fullMatch(pattern, value string) bool {
+	ok, err := regexp.MatchString(fmt.Sprintf("^(?:%s)$", pattern), value)
+	return ok && err == nil
+}
@@ -0,0 +1,35 @@
+package api
+
+import (
+	"testing"
+)
+
+func TestMatchRule(t *testing.T) {
+	rules := policyRules(`
+# Sample policy
+r, user, /record, create
+r, lab, /analytics, .*
+r, admin, .*, .*
+`)
+	if len(rules) != 3 {
+		t.Fatalf("invalid number of rules: %d", len(rules))
+	}
+	tt := []struct {
+		role     string
+		resource string
+		action   string
+		rule     string
+	}{
+		{"user", "/record", "create", "r, user, /record, create"},
+		{"user", "/record", "export", ""},
+		{"user", "/record/bulk", "create", ""},
+		{"lab", "/analytics", "read", "r, lab, /analytics, .*"},
+		{"admin", "/audit", "export", "r, admin, .*, .*"},
+		{"agent", "/record", "create", ""},
+	}
+	for _, tc := range tt {
+		if r := matchRule(rules, tc.role, tc.resource, tc.action); r != tc.rule {
+			t.Errorf("%s %s:%s, expected rule '%s', got '%s'", tc.role, tc.resource, tc.action, tc.rule, r)
+		}
+	}
+}
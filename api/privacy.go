@@ -0,0 +1,51 @@
+package api
+
+import (
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"google.golang.org/grpc/codes"
+)
+
+// Returned when a query result covers too few distinct users.
+var errAnonymitySet = newError(codes.FailedPrecondition,
+	protov1.ErrorCode_ERROR_CODE_ANONYMITY_SET, "result covers too few users")
+
+// Default minimum number of distinct users a query result must cover.
+const defaultAnonymitySet = 10
+
+// Refuse to return query and aggregate results covering fewer than 'k'
+// distinct users, to protect against re-identification through narrow
+// geo/time queries.
+type anonymityPolicy struct {
+	k int64
+}
+
+// Verify a result covering the provided number of distinct users can
+// be returned.
+func (ap *anonymityPolicy) check(users int64) error {
+	if users < ap.k {
+		return errAnonymitySet
+	}
+	return nil
+}
+
+// Remove hotspots below the anonymity threshold.
+func (ap *anonymityPolicy) hotspots(list []*protov1.Hotspot) []*protov1.Hotspot {
+	var res []*protov1.Hotspot
+	for _, h := range list {
+		if ap.check(h.Users) == nil {
+			res = append(res, h)
+		}
+	}
+	return res
+}
+
+// Remove movement flows below the anonymity threshold.
+func (ap *anonymityPolicy) flows(list []*protov1.Flow) []*protov1.Flow {
+	var res []*protov1.Flow
+	for _, f := range list {
+		if ap.check(f.Users) == nil {
+			res = append(res, f)
+		}
+	}
+	return res
+}
@@ -0,0 +1,131 @@
+package api
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+type clientAddrCtxKey struct{}
+
+// Networks of the reverse proxies and load balancers allowed to report the
+// address of the original client, using the "X-Forwarded-For" or
+// "X-Real-IP" headers. Loopback addresses are always trusted, since the HTTP
+// gateway and the plaintext listener forward the requests locally.
+type trustedProxies []*net.IPNet
+
+// Parse a list of IP addresses and CIDR blocks.
+func parseTrustedProxies(list []string) (trustedProxies, error) {
+	var tp trustedProxies
+	for _, v := range list {
+		v = strings.TrimSpace(v)
+		if !strings.Contains(v, "/") {
+			ip := net.ParseIP(v)
+			if ip == nil {
+				return nil, errors.Errorf("invalid trusted proxy: %s", v)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			tp = append(tp, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, network, err := net.ParseCIDR(v)
+		if err != nil {
+			return nil, errors.Errorf("invalid trusted proxy: %s", v)
+		}
+		tp = append(tp, network)
+	}
+	return tp, nil
+}
+
+// Whether 'addr' belongs to a trusted proxy.
+func (tp trustedProxies) trusted(addr string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	if ip.IsLoopback() {
+		return true
+	}
+	for _, network := range tp {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// Address of the original client for a request received from 'remote'.
+// Forwarding headers are only considered when the request comes from a
+// trusted proxy; the "X-Forwarded-For" entries are processed from right to
+// left, returning the first one not belonging to a trusted proxy, so values
+// injected by the client itself are ignored.
+func (tp trustedProxies) clientAddress(remote string, md metadata.MD) string {
+	if !tp.trusted(remote) {
+		return remote
+	}
+	var hops []string
+	for _, v := range md.Get("x-forwarded-for") {
+		for _, h := range strings.Split(v, ",") {
+			if h = strings.TrimSpace(h); h != "" {
+				hops = append(hops, h)
+			}
+		}
+	}
+	if len(hops) == 0 {
+		if v := md.Get("x-real-ip"); len(v) > 0 && net.ParseIP(strings.TrimSpace(v[0])) != nil {
+			return strings.TrimSpace(v[0])
+		}
+		return remote
+	}
+	for i := len(hops) - 1; i >= 0; i-- {
+		if net.ParseIP(hops[i]) == nil {
+			// Malformed entry; the ones to its left can't be trusted
+			return remote
+		}
+		if !tp.trusted(hops[i]) || i == 0 {
+			return hops[i]
+		}
+		remote = hops[i]
+	}
+	return remote
+}
+
+// Resolve the address of the client for the incoming request, used by rate
+// limits, audit entries and access restrictions.
+func (srv *Server) resolveClient(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	addr := srv.proxies.clientAddress(peerAddress(ctx), md)
+	return handler(context.WithValue(ctx, clientAddrCtxKey{}, addr), req)
+}
+
+// Network address of the immediate peer for the incoming request.
+func peerAddress(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
+
+// Return the network address of the client for the incoming request. When
+// the request is received through a trusted proxy, including the HTTP
+// gateway, the address reported by the proxy is used instead.
+func clientAddress(ctx context.Context) string {
+	if addr, ok := ctx.Value(clientAddrCtxKey{}).(string); ok {
+		return addr
+	}
+	return peerAddress(ctx)
+}
@@ -0,0 +1,40 @@
+package api
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+)
+
+func TestTrustedProxies(t *testing.T) {
+	if _, err := parseTrustedProxies([]string{"10.0.0.0/33"}); err == nil {
+		t.Error("invalid CIDR block accepted")
+	}
+	tp, err := parseTrustedProxies([]string{"10.0.0.0/8", "192.168.1.10", "2001:db8::/32"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		remote string
+		md     metadata.MD
+		client string
+	}{
+		// Untrusted peers can't report a different address
+		{"203.0.113.5", metadata.Pairs("x-forwarded-for", "198.51.100.7"), "203.0.113.5"},
+		// Gateway
+		{"127.0.0.1", metadata.Pairs("x-forwarded-for", "198.51.100.7"), "198.51.100.7"},
+		// Load balancer and gateway; the first entry is set by the client
+		{"127.0.0.1", metadata.Pairs("x-forwarded-for", "1.1.1.1, 198.51.100.7, 10.1.2.3"), "198.51.100.7"},
+		{"192.168.1.10", metadata.Pairs("x-forwarded-for", "2001:db8::1, 198.51.100.7"), "198.51.100.7"},
+		// Only trusted proxies
+		{"10.1.2.3", metadata.Pairs("x-forwarded-for", "10.4.5.6"), "10.4.5.6"},
+		{"10.1.2.3", metadata.Pairs("x-real-ip", "198.51.100.7"), "198.51.100.7"},
+		{"10.1.2.3", metadata.Pairs("x-forwarded-for", "invalid, 10.4.5.6"), "10.4.5.6"},
+		{"10.1.2.3", metadata.MD{}, "10.1.2.3"},
+	}
+	for _, tt := range tests {
+		if client := tp.clientAddress(tt.remote, tt.md); client != tt.client {
+			t.Errorf("%s %v: expected %s, got %s", tt.remote, tt.md, tt.client, client)
+		}
+	}
+}
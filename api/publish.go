@@ -8,14 +8,234 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/http"
+	"net/url"
+	"strings"
+	"time"
 
-	"go.bryk.io/x/crypto/pow"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.bryk.io/covid-tracking/storage"
+	"go.bryk.io/x/ccg/did"
 	"golang.org/x/crypto/sha3"
 )
 
 const defaultPublishEndpoint = "https://did.bryk.io/v1/process"
 
+// Ticket submission settings.
+const (
+	// Maximum time to wait for a network to process a ticket.
+	publishTimeout = 30 * time.Second
+
+	// Maximum size of the processing result read from a network.
+	publishResponseLimit = 64 * 1024
+
+	// Maximum length of the messages returned by a network included on
+	// errors.
+	publishMessageLimit = 200
+)
+
+// Ticket submissions by network and result: "published", "transient" or
+// "rejected".
+var publishResults = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "ct19_did_publish_total",
+	Help: "DID publish tickets submitted, by network and result.",
+}, []string{"network", "result"})
+
+func init() {
+	prometheus.MustRegister(publishResults)
+}
+
+var publishClient = &http.Client{Timeout: publishTimeout}
+
+// Processing result returned by a network.
+type publishResponse struct {
+	Ok      *bool  `json:"ok"`
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
+
+// Error returned when a network doesn't accept a ticket.
+type publishError struct {
+	network   string
+	status    int // HTTP status code, 0 if no response was received
+	message   string
+	transient bool
+}
+
+func (e *publishError) Error() string {
+	if e.status == 0 {
+		return fmt.Sprintf("%s: %s", e.network, e.message)
+	}
+	return fmt.Sprintf("%s: %s (status %d)", e.network, e.message, e.status)
+}
+
+// Failures submitting a ticket to one or more networks.
+type publishErrors []*publishError
+
+func (pf publishErrors) Error() string {
+	list := make([]string, len(pf))
+	for i, e := range pf {
+		list[i] = e.Error()
+	}
+	return "failed to publish DID: " + strings.Join(list, "; ")
+}
+
+// Whether a failed publication can be retried later; only when all the
+// networks reported a transient failure, like network errors, timeouts or
+// server errors. Tickets rejected are not retried.
+func retryPublish(err error) bool {
+	pf, ok := err.(publishErrors)
+	if !ok || len(pf) == 0 {
+		return false
+	}
+	for _, e := range pf {
+		if !e.transient {
+			return false
+		}
+	}
+	return true
+}
+
+// PublishNetwork describes a DID network where documents are published.
+type PublishNetwork struct {
+	// Network name, used to report results.
+	Name string
+
+	// Endpoint receiving publish requests.
+	Endpoint string
+
+	// DID methods published on the network; all methods if not provided.
+	Methods []string
+}
+
+// Network used when none is configured; all DIDs are published to
+// did.bryk.io.
+var defaultPublishNetworks = []*PublishNetwork{
+	{Name: "bryk", Endpoint: defaultPublishEndpoint},
+}
+
+// Ensure the network settings are valid.
+func (pn *PublishNetwork) validate() error {
+	if pn.Name == "" {
+		return fmt.Errorf("publish network name is required")
+	}
+	u, err := url.Parse(pn.Endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid endpoint for publish network %s", pn.Name)
+	}
+	return nil
+}
+
+// Whether DIDs using 'method' are published on the network.
+func (pn *PublishNetwork) supports(method string) bool {
+	if len(pn.Methods) == 0 {
+		return true
+	}
+	for _, m := range pn.Methods {
+		if strings.EqualFold(m, method) {
+			return true
+		}
+	}
+	return false
+}
+
+// Publishes DID documents on all the networks supporting their method. The
+// proof-of-work for each ticket is solved once and the same ticket is
+// submitted to every network.
+type didPublisher struct {
+	networks []*PublishNetwork
+	store    storage.TicketsRepo
+}
+
+// Return a publisher for the provided networks, or the default network if
+// none are provided. The progress of the tickets is saved on 'store'.
+func newDIDPublisher(networks []*PublishNetwork, store storage.TicketsRepo) (*didPublisher, error) {
+	if len(networks) == 0 {
+		networks = defaultPublishNetworks
+	}
+	for _, n := range networks {
+		if err := n.validate(); err != nil {
+			return nil, err
+		}
+	}
+	return &didPublisher{networks: networks, store: store}, nil
+}
+
+// Networks where DIDs using 'method' are published.
+func (dp *didPublisher) networksFor(method string) []*PublishNetwork {
+	var list []*PublishNetwork
+	for _, n := range dp.networks {
+		if n.supports(method) {
+			list = append(list, n)
+		}
+	}
+	return list
+}
+
+// Publish the document of a DID instance, using 'key' to save the progress
+// and status of its ticket. Networks that already accepted the ticket on a
+// previous attempt are skipped. An error is returned if the DID's method is
+// not supported by any network or the submission fails on any of them; see
+// 'retryPublish'.
+func (dp *didPublisher) publish(ctx context.Context, key string, id *did.Identifier, pow uint) error {
+	method := didMethod(id.String())
+	networks := dp.networksFor(method)
+	if len(networks) == 0 {
+		return fmt.Errorf("no publish network for DID method: %s", method)
+	}
+	ticket, status, err := newPublishTicket(ctx, dp.store, key, id, pow)
+	if err != nil {
+		return err
+	}
+	if status.Networks == nil {
+		status.Networks = make(map[string]string)
+	}
+	var failures publishErrors
+	for _, n := range networks {
+		if status.Networks[n.Name] == storage.PublishCompleted {
+			continue
+		}
+		if err := ticket.Submit(ctx, n); err != nil {
+			pe, _ := err.(*publishError)
+			failures = append(failures, pe)
+			status.Networks[n.Name] = pe.message
+			if pe.transient {
+				publishResults.WithLabelValues(n.Name, "transient").Inc()
+			} else {
+				publishResults.WithLabelValues(n.Name, "rejected").Inc()
+			}
+			continue
+		}
+		status.Networks[n.Name] = storage.PublishCompleted
+		publishResults.WithLabelValues(n.Name, "published").Inc()
+	}
+
+	// Update status
+	status.Attempts++
+	status.Status, status.Error = storage.PublishCompleted, ""
+	if len(failures) > 0 {
+		err = failures
+		status.Status, status.Error = storage.PublishFailed, err.Error()
+		if retryPublish(err) {
+			status.Status = storage.PublishPending
+		}
+	}
+	if dp.store != nil {
+		_ = dp.store.SavePublishTicket(status)
+	}
+	return err
+}
+
+// Method segment of a DID, i.e. "bryk" for "did:bryk:...".
+func didMethod(id string) string {
+	parts := strings.SplitN(id, ":", 3)
+	if len(parts) < 3 || parts[0] != "did" {
+		return ""
+	}
+	return parts[1]
+}
+
 type publishTicket struct {
 	Timestamp  int64  `json:"timestamp"`
 	NonceValue int64  `json:"nonce"`
@@ -60,26 +280,130 @@ func (t *publishTicket) Encode() ([]byte, error) {
 	return append(tc, t.Content...), nil
 }
 
-// Solve the ticket challenge using the proof-of-work mechanism
-func (t *publishTicket) Solve(difficulty uint) []byte {
+// Number of nonce values evaluated between checkpoints of the progress of a
+// ticket's proof-of-work.
+const ticketCheckpoint = 1 << 18
+
+// Solve the ticket challenge using the proof-of-work mechanism, starting from
+// the current nonce value; a solution is a SHA3-256 digest of the encoded
+// ticket with 'difficulty' leading zero bits. 'checkpoint', if provided, is
+// called periodically with the next nonce value to evaluate, so the process
+// can be resumed later. Returns the digest found.
+func (t *publishTicket) Solve(ctx context.Context, difficulty uint, checkpoint func(int64)) ([]byte, error) {
 	if difficulty == 0 {
 		difficulty = 8
 	}
-	challenge := <-pow.Solve(context.Background(), t, sha3.New256(), difficulty)
-	res, _ := hex.DecodeString(challenge)
-	return res
+	h := sha3.New256()
+	for i := 1; ; i++ {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+		if checkpoint != nil && i%ticketCheckpoint == 0 {
+			checkpoint(t.Nonce())
+		}
+		data, err := t.Encode()
+		if err != nil {
+			return nil, err
+		}
+		h.Reset()
+		_, _ = h.Write(data)
+		if digest := h.Sum(nil); leadingZeros(digest, difficulty) {
+			return digest, nil
+		}
+		t.IncrementNonce()
+	}
 }
 
-// Submit a ticket to the network.
-func (t *publishTicket) Submit() bool {
-	res, err := http.Post(defaultPublishEndpoint, "application/json", t.getRequestData())
+// Verify 'digest' is a valid solution for the ticket's current nonce value.
+func (t *publishTicket) Verify(digest []byte, difficulty uint) bool {
+	if difficulty == 0 {
+		difficulty = 8
+	}
+	data, err := t.Encode()
 	if err != nil {
 		return false
 	}
-	_ = res.Body.Close()
+	h := sha3.Sum256(data)
+	return bytes.Equal(h[:], digest) && leadingZeros(digest, difficulty)
+}
+
+// Whether the first 'n' bits of 'digest' are zero.
+func leadingZeros(digest []byte, n uint) bool {
+	if int(n) > len(digest)*8 {
+		return false
+	}
+	for i := uint(0); i < n; i++ {
+		if digest[i/8]&(0x80>>(i%8)) != 0 {
+			return false
+		}
+	}
 	return true
 }
 
+// Submit a ticket to a network. The processing result returned is parsed
+// and failures reported as a '*publishError'. Network errors, timeouts,
+// rate limits and server errors are considered transient.
+func (t *publishTicket) Submit(ctx context.Context, network *PublishNetwork) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, network.Endpoint, t.getRequestData())
+	if err != nil {
+		return &publishError{network: network.Name, message: err.Error()}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	res, err := publishClient.Do(req)
+	if err != nil {
+		return &publishError{network: network.Name, message: err.Error(), transient: true}
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	body, err := ioutil.ReadAll(io.LimitReader(res.Body, publishResponseLimit))
+	if err != nil {
+		return &publishError{network: network.Name, message: err.Error(), transient: true}
+	}
+
+	// Evaluate result
+	result := &publishResponse{}
+	parsed := json.Unmarshal(body, result) == nil
+	pe := &publishError{
+		network: network.Name,
+		status:  res.StatusCode,
+		message: result.describe(body, res.StatusCode),
+	}
+	switch {
+	case res.StatusCode >= http.StatusInternalServerError,
+		res.StatusCode == http.StatusTooManyRequests,
+		res.StatusCode == http.StatusRequestTimeout:
+		pe.transient = true
+		return pe
+	case res.StatusCode >= http.StatusMultipleChoices:
+		return pe
+	case parsed && result.Ok != nil && !*result.Ok:
+		return pe
+	}
+	return nil
+}
+
+// Message describing a processing result; the raw response or the status
+// text are used if the result doesn't include one.
+func (pr *publishResponse) describe(body []byte, status int) string {
+	msg := pr.Error
+	if msg == "" {
+		msg = pr.Message
+	}
+	if msg == "" && (pr.Ok == nil || !*pr.Ok) {
+		msg = strings.TrimSpace(string(body))
+	}
+	if msg == "" {
+		msg = http.StatusText(status)
+	}
+	if len(msg) > publishMessageLimit {
+		msg = msg[:publishMessageLimit]
+	}
+	return msg
+}
+
 // GetRequestData return the ticket properly encoded to submit.
 func (t *publishTicket) getRequestData() io.Reader {
 	req := map[string]interface{}{
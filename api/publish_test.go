@@ -0,0 +1,141 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPublishTicketSolve(t *testing.T) {
+	ticket := &publishTicket{Timestamp: 1589000000, KeyID: "master", Content: []byte("{}")}
+	var checkpoints []int64
+	digest, err := ticket.Solve(context.Background(), 12, func(nonce int64) {
+		checkpoints = append(checkpoints, nonce)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ticket.Verify(digest, 12) {
+		t.Fatal("invalid solution")
+	}
+	solution := ticket.Nonce()
+
+	// Resume from a previous nonce value
+	resumed := &publishTicket{Timestamp: 1589000000, KeyID: "master", Content: []byte("{}"), NonceValue: solution}
+	res, err := resumed.Solve(context.Background(), 12, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resumed.Nonce() != solution || string(res) != string(digest) {
+		t.Error("resumed ticket produced a different solution")
+	}
+
+	// Altered tickets
+	resumed.Content = []byte("[]")
+	if resumed.Verify(digest, 12) {
+		t.Error("altered ticket accepted")
+	}
+
+	// Cancelled
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := ticket.Solve(ctx, 64, nil); err == nil {
+		t.Error("cancelled solve should fail")
+	}
+}
+
+func TestPublishNetworks(t *testing.T) {
+	// Default network
+	dp, err := newDIDPublisher(nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list := dp.networksFor("bryk"); len(list) != 1 || list[0].Endpoint != defaultPublishEndpoint {
+		t.Error("default network should support all methods")
+	}
+
+	// Per-method networks
+	dp, err = newDIDPublisher([]*PublishNetwork{
+		{Name: "bryk", Endpoint: "https://did.bryk.io/v1/process", Methods: []string{"bryk"}},
+		{Name: "local", Endpoint: "http://did.local:9090/v1/process", Methods: []string{"bryk", "iadb"}},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list := dp.networksFor(didMethod("did:bryk:4d3a7c4b")); len(list) != 2 {
+		t.Errorf("expected 2 networks, got %d", len(list))
+	}
+	if list := dp.networksFor(didMethod("did:iadb:4d3a7c4b")); len(list) != 1 || list[0].Name != "local" {
+		t.Error("invalid networks for method")
+	}
+	if list := dp.networksFor(didMethod("did:stack:4d3a7c4b")); len(list) != 0 {
+		t.Error("unsupported method selected a network")
+	}
+
+	// Invalid settings
+	for _, n := range []*PublishNetwork{
+		{Endpoint: defaultPublishEndpoint},
+		{Name: "invalid", Endpoint: "did.bryk.io/v1/process"},
+		{Name: "invalid", Endpoint: "ftp://did.bryk.io"},
+	} {
+		if _, err := newDIDPublisher([]*PublishNetwork{n}, nil); err == nil {
+			t.Errorf("invalid network accepted: %+v", n)
+		}
+	}
+}
+
+func TestPublishTicketSubmit(t *testing.T) {
+	status, body := http.StatusOK, ""
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	defer srv.Close()
+	network := &PublishNetwork{Name: "test", Endpoint: srv.URL}
+	ticket := &publishTicket{Timestamp: 1589000000, KeyID: "master", Content: []byte("{}")}
+
+	tests := []struct {
+		status    int
+		body      string
+		ok        bool
+		transient bool
+	}{
+		{http.StatusOK, `{"ok":true}`, true, false},
+		{http.StatusOK, "", true, false},
+		{http.StatusOK, `{"ok":false,"error":"invalid ticket"}`, false, false},
+		{http.StatusBadRequest, `{"error":"invalid signature"}`, false, false},
+		{http.StatusTooManyRequests, "", false, true},
+		{http.StatusServiceUnavailable, "unavailable", false, true},
+	}
+	for _, tt := range tests {
+		status, body = tt.status, tt.body
+		err := ticket.Submit(context.Background(), network)
+		if tt.ok {
+			if err != nil {
+				t.Errorf("%d %s: unexpected error: %s", tt.status, tt.body, err)
+			}
+			continue
+		}
+		if err == nil {
+			t.Errorf("%d %s: expected error", tt.status, tt.body)
+			continue
+		}
+		if retryPublish(publishErrors{err.(*publishError)}) != tt.transient {
+			t.Errorf("%d %s: invalid transient value", tt.status, tt.body)
+		}
+	}
+
+	// Only retry when all failures are transient
+	failures := publishErrors{
+		{network: "a", message: "timeout", transient: true},
+		{network: "b", status: 400, message: "invalid ticket"},
+	}
+	if retryPublish(failures) {
+		t.Error("rejected ticket should not be retried")
+	}
+	if retryPublish(errors.New("no publish network")) {
+		t.Error("unknown errors should not be retried")
+	}
+}
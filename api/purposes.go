@@ -0,0 +1,28 @@
+package api
+
+import (
+	"fmt"
+
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/covid-tracking/storage"
+)
+
+// Purposes location records can be queried for.
+var recordPurposes = storage.RecordPurposes(&protov1.LocationRecord{})
+
+// Verify the processing purpose declared by the caller is supported and
+// allowed for the requested data.
+func declaredPurpose(purpose string, allowed []string) error {
+	if purpose == "" {
+		return invalidArgument("purpose", "a processing purpose must be declared")
+	}
+	if !storage.ValidPurpose(purpose) {
+		return invalidArgument("purpose", fmt.Sprintf("unsupported purpose: %s", purpose))
+	}
+	for _, p := range allowed {
+		if p == purpose {
+			return nil
+		}
+	}
+	return errPurposeNotAllowed
+}
@@ -0,0 +1,184 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/x/amqp"
+	xlog "go.bryk.io/x/log"
+)
+
+// How often workers collect and report queue statistics.
+const queueStatsInterval = 15 * time.Second
+
+// Queue metrics, used to scale the number of workers based on the backlog.
+var (
+	queueMessages = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "ct19_queue_messages",
+		Help: "Messages ready to be delivered, by queue.",
+	}, []string{"queue"})
+	queueUnacked = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "ct19_queue_unacknowledged_messages",
+		Help: "Messages delivered but not yet acknowledged, by queue.",
+	}, []string{"queue"})
+	queueConsumers = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "ct19_queue_consumers",
+		Help: "Active consumers, by queue.",
+	}, []string{"queue"})
+	queueLag = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "ct19_queue_lag_seconds",
+		Help: "Time between the publication and the delivery of the last message processed, by queue.",
+	}, []string{"queue"})
+)
+
+func init() {
+	prometheus.MustRegister(queueMessages, queueUnacked, queueConsumers, queueLag)
+}
+
+// Queue details returned by the broker management API.
+type managementQueue struct {
+	Messages  uint64 `json:"messages_ready"`
+	Unacked   uint64 `json:"messages_unacknowledged"`
+	Consumers uint32 `json:"consumers"`
+}
+
+// Track the lag of delivered messages and retrieve queue statistics from the
+// broker management API (RabbitMQ management plugin), if available.
+type queueMonitor struct {
+	endpoint  string
+	vhost     string
+	hc        *http.Client
+	lag       map[string]float64
+	processed uint64
+	last      time.Time
+	mu        sync.Mutex
+}
+
+// Returns a new monitor for the management API at 'endpoint', an empty value
+// disables the queue statistics. The virtual host is obtained from the
+// 'broker' connection string.
+func newQueueMonitor(endpoint, broker string) (*queueMonitor, error) {
+	qm := &queueMonitor{
+		hc:  &http.Client{Timeout: 5 * time.Second},
+		lag: make(map[string]float64),
+	}
+	if endpoint == "" {
+		return qm, nil
+	}
+	if _, err := url.Parse(endpoint); err != nil {
+		return nil, errors.Wrap(err, "invalid broker management endpoint")
+	}
+	bu, err := url.Parse(broker)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid broker endpoint")
+	}
+	qm.endpoint = strings.TrimSuffix(endpoint, "/")
+	qm.vhost = strings.TrimPrefix(bu.Path, "/")
+	if qm.vhost == "" {
+		qm.vhost = "/"
+	}
+	return qm, nil
+}
+
+// Register a message delivered from 'queue' and its lag. Messages without
+// a publication date are not considered for the lag.
+func (qm *queueMonitor) delivered(queue string, msg amqp.Delivery) {
+	qm.mu.Lock()
+	qm.processed++
+	qm.last = time.Now()
+	qm.mu.Unlock()
+	if msg.Timestamp.IsZero() {
+		return
+	}
+	lag := time.Since(msg.Timestamp).Seconds()
+	if lag < 0 {
+		lag = 0
+	}
+	queueLag.WithLabelValues(queue).Set(lag)
+	qm.mu.Lock()
+	qm.lag[queue] = lag
+	qm.mu.Unlock()
+}
+
+// Number of messages delivered and the date of the last one.
+func (qm *queueMonitor) activity() (uint64, time.Time) {
+	qm.mu.Lock()
+	defer qm.mu.Unlock()
+	return qm.processed, qm.last
+}
+
+// Retrieve the current statistics for 'queue'. The lag is reset when the
+// queue is empty.
+func (qm *queueMonitor) stats(queue string) (*protov1.QueueStats, error) {
+	res, err := qm.hc.Get(qm.endpoint + "/api/queues/" + url.PathEscape(qm.vhost) + "/" + url.PathEscape(queue))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	if res.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unexpected management API response: %s", res.Status)
+	}
+	mq := &managementQueue{}
+	if err := json.NewDecoder(res.Body).Decode(mq); err != nil {
+		return nil, err
+	}
+
+	qm.mu.Lock()
+	defer qm.mu.Unlock()
+	if mq.Messages == 0 && mq.Unacked == 0 {
+		qm.lag[queue] = 0
+		queueLag.WithLabelValues(queue).Set(0)
+	}
+	return &protov1.QueueStats{
+		Name:           queue,
+		Messages:       mq.Messages,
+		Unacknowledged: mq.Unacked,
+		Consumers:      mq.Consumers,
+		Lag:            qm.lag[queue],
+	}, nil
+}
+
+// Collect the statistics for the queues consumed by the worker, update the
+// queue metrics and report the values on storage.
+func (w *Worker) queueStats() {
+	if w.monitor.endpoint == "" {
+		return
+	}
+	queues := append([]string{"fhir"}, w.queues...)
+	for _, queue := range queues {
+		stats, err := w.monitor.stats(queue)
+		if err != nil {
+			w.log.WithFields(xlog.Fields{
+				"queue": queue,
+				"error": err.Error(),
+			}).Warning("failed to retrieve queue statistics")
+			continue
+		}
+		queueMessages.WithLabelValues(queue).Set(float64(stats.Messages))
+		queueUnacked.WithLabelValues(queue).Set(float64(stats.Unacknowledged))
+		queueConsumers.WithLabelValues(queue).Set(float64(stats.Consumers))
+		stats.Worker = w.name
+		if err := w.repos.Queues().SaveQueueStats(stats); err != nil {
+			w.log.WithField("error", err.Error()).Warning("failed to save queue statistics")
+		}
+	}
+}
+
+// GetQueueStats returns the latest statistics reported by the workers for
+// the broker queues.
+func (srv *Server) GetQueueStats() (*protov1.QueueStatsResponse, error) {
+	list, err := srv.repos.Queues().QueueStats()
+	if err != nil {
+		return nil, errInternalError
+	}
+	return &protov1.QueueStatsResponse{Queues: list}, nil
+}
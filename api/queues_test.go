@@ -0,0 +1,43 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"go.bryk.io/x/amqp"
+)
+
+func TestQueueMonitor(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() != "/api/queues/%2F/tasks" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"messages_ready":0,"messages_unacknowledged":2,"consumers":1}`))
+	}))
+	defer ts.Close()
+
+	qm, err := newQueueMonitor(ts.URL, "amqp://localhost:5672")
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := amqp.Delivery{}
+	msg.Timestamp = time.Now().Add(-5 * time.Second)
+	qm.delivered("tasks", msg)
+	stats, err := qm.stats("tasks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Unacknowledged != 2 || stats.Consumers != 1 || stats.Lag < 5 {
+		t.Errorf("invalid queue stats: %+v", stats)
+	}
+	if _, err := qm.stats("fhir"); err == nil {
+		t.Error("expected error for unknown queue")
+	}
+	qm.delivered("fhir", amqp.Delivery{})
+	if processed, last := qm.activity(); processed != 2 || last.IsZero() {
+		t.Errorf("invalid activity: %d, %s", processed, last)
+	}
+}
@@ -0,0 +1,94 @@
+package api
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/covid-tracking/storage"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+)
+
+// Returned when a request exceeds the daily quota of records for the DID.
+// Clients can retry once the quota is reset, at midnight UTC.
+func quotaExceeded(now time.Time) error {
+	reset := now.UTC().Truncate(24 * time.Hour).Add(24 * time.Hour)
+	return newError(codes.ResourceExhausted,
+		protov1.ErrorCode_ERROR_CODE_QUOTA_EXCEEDED, "daily records quota exceeded",
+		&errdetails.RetryInfo{RetryDelay: ptypes.DurationProto(reset.Sub(now))})
+}
+
+// Daily cap on the number of location and check-in records stored per DID,
+// to contain malfunctioning or malicious clients. A zero limit disables the
+// quota.
+type ingestionQuota struct {
+	counters storage.QuotasRepo
+	limit    int
+}
+
+// Number of records 'did' can still submit today, or -1 if the quota is
+// disabled. Errors reading the counters don't block ingestion.
+func (q *ingestionQuota) remaining(did string) int {
+	if q == nil || q.limit <= 0 {
+		return -1
+	}
+	used, err := q.counters.QuotaUsage(did, time.Now())
+	if err != nil {
+		return -1
+	}
+	if used >= q.limit {
+		return 0
+	}
+	return q.limit - used
+}
+
+// Verify 'did' can submit 'n' additional records today.
+func (q *ingestionQuota) check(did string, n int) error {
+	if left := q.remaining(did); left >= 0 && n > left {
+		return quotaExceeded(time.Now())
+	}
+	return nil
+}
+
+// Records reserved on the daily quota of a DID, while they are stored.
+type quotaReservation struct {
+	counters storage.QuotasRepo
+	did      string
+	date     time.Time
+
+	// Number of records reserved, or -1 if the quota is disabled
+	records int
+}
+
+// Atomically reserve up to 'n' records on today's quota for 'did', so
+// concurrent submissions can't exceed the limit. Errors updating the
+// counters don't block ingestion.
+func (q *ingestionQuota) reserve(did string, n int) *quotaReservation {
+	r := &quotaReservation{did: did, date: time.Now(), records: -1}
+	if q == nil || q.limit <= 0 {
+		return r
+	}
+	r.counters = q.counters
+	if n == 0 {
+		r.records = 0
+		return r
+	}
+	reserved, err := q.counters.ReserveQuota(did, r.date, n, q.limit)
+	if err != nil {
+		r.counters = nil
+		return r
+	}
+	r.records = reserved
+	return r
+}
+
+// Release the reserved records beyond the 'stored' ones. Counters are updated
+// on a best effort basis; errors don't fail the ingestion of records already
+// stored.
+func (r *quotaReservation) release(stored int) {
+	if r.counters == nil || r.records <= stored {
+		return
+	}
+	_, _ = r.counters.AddQuotaUsage(r.did, r.date, stored-r.records)
+}
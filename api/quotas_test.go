@@ -0,0 +1,76 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/covid-tracking/storage/memtest"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+)
+
+func TestIngestionQuota(t *testing.T) {
+	// Disabled quota
+	var q *ingestionQuota
+	if q.remaining("did:bryk:a") != -1 || q.check("did:bryk:a", 5000) != nil {
+		t.Error("nil quota should not restrict ingestion")
+	}
+	q = &ingestionQuota{}
+	if q.check("did:bryk:a", 5000) != nil {
+		t.Error("zero limit should not restrict ingestion")
+	}
+
+	// Retry delay until the quota is reset
+	now := time.Date(2020, 5, 1, 22, 30, 0, 0, time.UTC)
+	st, _ := status.FromError(quotaExceeded(now))
+	var (
+		code  protov1.ErrorCode
+		delay time.Duration
+	)
+	for _, d := range st.Details() {
+		switch v := d.(type) {
+		case *protov1.ErrorDetail:
+			code = v.Code
+		case *errdetails.RetryInfo:
+			delay = time.Duration(v.RetryDelay.Seconds) * time.Second
+		}
+	}
+	if code != protov1.ErrorCode_ERROR_CODE_QUOTA_EXCEEDED {
+		t.Errorf("invalid error code: %s", code)
+	}
+	if delay != 90*time.Minute {
+		t.Errorf("invalid retry delay: %s", delay)
+	}
+}
+
+func TestQuotaReservation(t *testing.T) {
+	store := memtest.New()
+	q := &ingestionQuota{counters: store, limit: 10}
+
+	// Reservations never exceed the limit
+	a := q.reserve("did:bryk:a", 6)
+	b := q.reserve("did:bryk:a", 6)
+	if a.records != 6 || b.records != 4 {
+		t.Fatalf("invalid reservations: %d, %d", a.records, b.records)
+	}
+	if c := q.reserve("did:bryk:a", 1); c.records != 0 {
+		t.Error("records reserved over the limit")
+	}
+
+	// Records not stored are released
+	a.release(2)
+	b.release(0)
+	if used, _ := store.QuotaUsage("did:bryk:a", time.Now()); used != 2 {
+		t.Errorf("invalid usage after release: %d", used)
+	}
+	if q.remaining("did:bryk:a") != 8 {
+		t.Error("released records not available")
+	}
+
+	// Disabled quota
+	var disabled *ingestionQuota
+	if r := disabled.reserve("did:bryk:a", 5000); r.records != -1 {
+		t.Error("nil quota should not restrict ingestion")
+	}
+}
@@ -0,0 +1,269 @@
+package api
+
+import (
+	"context"
+	"crypto"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.bryk.io/covid-tracking/federation"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/covid-tracking/storage"
+	xlog "go.bryk.io/x/log"
+	"go.bryk.io/x/net/rpc"
+	"google.golang.org/grpc"
+)
+
+// ReplicationConfig provides the settings required to replicate diagnosed-
+// case markers with cooperating deployments.
+type ReplicationConfig struct {
+	// Name of the local server, used as origin for the markers it produces.
+	Name string
+
+	// Key used to sign produced batches and pull requests.
+	Signer crypto.Signer
+
+	// Trusted peer servers.
+	Peers []*ReplicationPeer
+}
+
+// ReplicationPeer describes a cooperating server.
+type ReplicationPeer struct {
+	// Peer name, used as origin for the markers it produces.
+	Name string
+
+	// RPC endpoint, i.e. "ct19.example.com:443". Required by workers to
+	// retrieve the markers produced by the peer.
+	Endpoint string
+
+	// Public key used to verify batches and pull requests.
+	PublicKey crypto.PublicKey
+}
+
+// Return the settings for the peer 'name', if any.
+func (rc *ReplicationConfig) peer(name string) *ReplicationPeer {
+	for _, p := range rc.Peers {
+		if p.Name == name {
+			return p
+		}
+	}
+	return nil
+}
+
+const (
+	// Maximum number of markers per batch.
+	replicationBatchSize = 500
+
+	// How often workers retrieve new markers from peers.
+	replicationInterval = 15 * time.Minute
+
+	// Maximum clock difference tolerated for pull requests.
+	replicationMaxSkew = 5 * time.Minute
+)
+
+// Digest signed by peers when requesting markers.
+func pullDigest(req *protov1.PullRequest) []byte {
+	h := sha256.Sum256([]byte(fmt.Sprintf("%s|%d|%d", req.Origin, req.Cursor, req.Timestamp)))
+	return h[:]
+}
+
+// Deterministic digest of a batch contents. Markers are sorted before
+// calculating the digest so their order is irrelevant.
+func batchDigest(b *protov1.ReplicationBatch) []byte {
+	entries := make([]string, len(b.Markers))
+	for i, m := range b.Markers {
+		entries[i] = fmt.Sprintf("%s|%s|%d|%d", m.Diagnosis, m.Cell, m.Bucket, m.Updated)
+	}
+	sort.Strings(entries)
+	h := sha256.Sum256([]byte(fmt.Sprintf("%s\n%d\n%s", b.Origin, b.Sequence, strings.Join(entries, "\n"))))
+	return h[:]
+}
+
+type replicationInterface struct {
+	srv *Server
+}
+
+// Pull streams the markers produced after the provided cursor. Requests
+// must be signed by a trusted peer.
+func (ri *replicationInterface) Pull(req *protov1.PullRequest, stream protov1.ReplicationAPI_PullServer) error {
+	return ri.srv.Pull(req, stream.Send)
+}
+
+// GetReplicationServiceDefinition allows to expose the replication service
+// through an RPC server. Returns nil if replication is not enabled.
+func (srv *Server) GetReplicationServiceDefinition() *rpc.Service {
+	if srv.repl == nil {
+		return nil
+	}
+	return &rpc.Service{
+		GatewaySetup: protov1.RegisterReplicationAPIHandlerFromEndpoint,
+		ServerSetup: func(server *grpc.Server) {
+			protov1.RegisterReplicationAPIServer(server, &replicationInterface{srv: srv})
+		},
+	}
+}
+
+// Pull sends, as signed batches, all the local markers produced after the
+// cursor provided by a trusted peer.
+func (srv *Server) Pull(req *protov1.PullRequest, send func(*protov1.ReplicationBatch) error) error {
+	if srv.repl == nil {
+		return errNotEnabled
+	}
+	peer := srv.repl.peer(req.Origin)
+	if peer == nil {
+		return errUnauthenticated
+	}
+	ts := time.Unix(req.Timestamp, 0)
+	if time.Since(ts) > replicationMaxSkew || time.Until(ts) > replicationMaxSkew {
+		return errUnauthenticated
+	}
+	if !federation.VerifyDigest(peer.PublicKey, pullDigest(req), req.Signature) {
+		return errUnauthenticated
+	}
+
+	cursor := req.Cursor
+	for {
+		markers, err := srv.repos.Replication().Markers(cursor, replicationBatchSize)
+		if err != nil {
+			return errInternalError
+		}
+		if len(markers) == 0 {
+			return nil
+		}
+		batch := &protov1.ReplicationBatch{
+			Origin:   srv.repl.Name,
+			Sequence: markers[len(markers)-1].Sequence,
+			Markers:  make([]*protov1.ReplicationMarker, len(markers)),
+		}
+		for i, m := range markers {
+			batch.Markers[i] = &protov1.ReplicationMarker{
+				Diagnosis: m.Diagnosis,
+				Cell:      m.Cell,
+				Bucket:    m.Bucket,
+				Updated:   m.Updated.Unix(),
+			}
+		}
+		if batch.Signature, err = federation.SignDigest(srv.repl.Signer, batchDigest(batch)); err != nil {
+			return errInternalError
+		}
+		if err := send(batch); err != nil {
+			return err
+		}
+		cursor = batch.Sequence
+	}
+}
+
+// Retrieve new markers from all peers with a known endpoint.
+func (w *Worker) replicationSync() {
+	for _, peer := range w.repl.Peers {
+		if peer.Endpoint == "" {
+			continue
+		}
+		if err := w.replicate(peer); err != nil {
+			w.log.WithFields(xlog.Fields{
+				"peer":  peer.Name,
+				"error": err.Error(),
+			}).Warning("replication failed")
+		}
+	}
+}
+
+// Retrieve and process the markers produced by a peer since the last
+// synchronization.
+func (w *Worker) replicate(peer *ReplicationPeer) error {
+	cursor, err := w.repos.Replication().ReplicationCursor(peer.Name)
+	if err != nil {
+		return err
+	}
+	conn, err := rpc.NewClientConnection(peer.Endpoint, []rpc.ClientOption{
+		rpc.WithCompression(),
+		rpc.WithClientTLS(rpc.ClientTLSConfig{IncludeSystemCAs: true}),
+	}...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	// Signed request
+	req := &protov1.PullRequest{
+		Origin:    w.repl.Name,
+		Cursor:    cursor,
+		Timestamp: time.Now().Unix(),
+	}
+	if req.Signature, err = federation.SignDigest(w.repl.Signer, pullDigest(req)); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+	stream, err := protov1.NewReplicationAPIClient(conn).Pull(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	// Process batches
+	for {
+		batch, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if batch.Origin != peer.Name || batch.Sequence <= cursor ||
+			!federation.VerifyDigest(peer.PublicKey, batchDigest(batch), batch.Signature) {
+			return errors.New("invalid batch received")
+		}
+		w.replicationBatch(batch)
+		if err := w.repos.Replication().SetReplicationCursor(peer.Name, batch.Sequence); err != nil {
+			return err
+		}
+		cursor = batch.Sequence
+	}
+}
+
+// Register the markers received from a peer and notify all local users
+// present on the location cells for previously unknown markers.
+func (w *Worker) replicationBatch(b *protov1.ReplicationBatch) {
+	prov := storage.Provenance{
+		Origin:    b.Origin,
+		Batch:     b.Sequence,
+		Signature: b.Signature,
+	}
+	cases := make(map[string][]storage.Cell)
+	for _, m := range b.Markers {
+		marker := storage.Marker{
+			Diagnosis: m.Diagnosis,
+			Cell:      m.Cell,
+			Bucket:    m.Bucket,
+			Updated:   time.Unix(m.Updated, 0),
+		}
+		applied, err := w.repos.Replication().ApplyMarker(marker, prov)
+		if err != nil {
+			w.log.WithField("error", err.Error()).Error("failed to save replicated marker")
+			continue
+		}
+		if applied {
+			cases[m.Diagnosis] = append(cases[m.Diagnosis], storage.Cell{ID: m.Cell, Bucket: m.Bucket})
+		}
+	}
+	for diagnosis, cells := range cases {
+		users, err := w.repos.Records().PresentAt(cells)
+		if err != nil {
+			w.log.WithField("error", err.Error()).Error("failed to match replicated markers")
+			continue
+		}
+		w.exposures(users, fmt.Sprintf("replication:%s:%s", b.Origin, diagnosis))
+	}
+	w.log.WithFields(xlog.Fields{
+		"origin":   b.Origin,
+		"sequence": b.Sequence,
+		"markers":  len(b.Markers),
+	}).Info("replication batch processed")
+}
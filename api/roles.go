@@ -0,0 +1,129 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/covid-tracking/storage"
+	"go.bryk.io/x/ccg/did"
+	"go.bryk.io/x/jwx"
+)
+
+// Roles available on all deployments. Access rules for these roles are
+// included in the default policy.
+var builtinRoles = []string{
+	"user",
+	"agent",
+	"epidemiologist",
+	"admin",
+}
+
+// Names not available for custom roles; activation codes for registration
+// campaigns are stored along the role codes.
+var reservedRoles = append([]string{"campaign"}, builtinRoles...)
+
+var roleName = regexp.MustCompile(`^[a-z][a-z0-9_]{1,31}$`)
+
+// Role describes a custom account role, like "lab" or "venue", and the
+// permissions granted to it.
+type Role struct {
+	// Role identifier, as included on the "role" claim of access credentials.
+	Name string `mapstructure:"name"`
+
+	// Permissions granted to the role, in the form "resource:action", i.e.
+	// "record:create". Use "*" as action to allow any action on a resource.
+	Permissions []string `mapstructure:"permissions"`
+}
+
+// Return the access policy rules for the role.
+func (r *Role) rules() []string {
+	var list []string
+	for _, p := range r.Permissions {
+		res, act := splitScope(p)
+		if act == "*" {
+			act = ".*"
+		}
+		list = append(list, fmt.Sprintf("r, %s, /%s, %s", r.Name, res, act))
+	}
+	return list
+}
+
+// Validate the custom roles settings and return the names of all the roles
+// supported, including the built-in ones.
+func loadRoles(list []*Role) (map[string]bool, error) {
+	roles := make(map[string]bool)
+	for _, r := range builtinRoles {
+		roles[r] = true
+	}
+	for _, r := range list {
+		if !roleName.MatchString(r.Name) || contains(reservedRoles, r.Name) {
+			return nil, errors.Errorf("invalid role name: '%s'", r.Name)
+		}
+		if roles[r.Name] {
+			return nil, errors.Errorf("duplicated role: %s", r.Name)
+		}
+		if len(r.Permissions) == 0 || !validScope(r.Permissions) {
+			return nil, errors.Errorf("invalid permissions for role: %s", r.Name)
+		}
+		roles[r.Name] = true
+	}
+	return roles, nil
+}
+
+// Access policy including the rules for the custom roles.
+func accessPolicy(base string, list []*Role) string {
+	rules := []string{base}
+	for _, r := range list {
+		rules = append(rules, fmt.Sprintf("# Custom role: %s", r.Name))
+		rules = append(rules, r.rules()...)
+	}
+	return strings.Join(rules, "\n")
+}
+
+// Verify the provided role literal is supported.
+func (srv *Server) isRoleValid(role string) bool {
+	return srv.roles[role]
+}
+
+// ChangeRole assigns a new role to a DID without requiring a new activation
+// code. All the access tokens of the DID are revoked immediately, and can
+// only be renewed to obtain credentials with the new role. Role changes are
+// registered on the audit log.
+func (srv *Server) ChangeRole(ctx context.Context, token *jwx.Token,
+	req *protov1.ChangeRoleRequest) (*protov1.ChangeRoleResponse, error) {
+	if _, err := did.Parse(req.Did); err != nil {
+		return nil, errInvalidDID
+	}
+	if !srv.isRoleValid(req.Role) {
+		return nil, invalidArgument("role", "unsupported role")
+	}
+	data := &credentialsData{}
+	if err := token.Decode(&data); err != nil {
+		return nil, errUnauthenticated
+	}
+	count, err := srv.repos.AccessTokens().ReassignRole(req.Did, req.Role)
+	if err != nil {
+		return nil, errInternalError
+	}
+	if count == 0 {
+		return nil, notFound("session")
+	}
+	srv.refreshRevocations()
+	srv.audit(&storage.AuditEntry{
+		Event:   auditRoleChanged,
+		Actor:   data.DID,
+		Address: clientAddress(ctx),
+		Details: map[string]string{
+			"did":     req.Did,
+			"role":    req.Role,
+			"reason":  req.Reason,
+			"revoked": strconv.FormatInt(count, 10),
+		},
+	})
+	return &protov1.ChangeRoleResponse{Revoked: count}, nil
+}
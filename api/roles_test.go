@@ -0,0 +1,43 @@
+package api
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLoadRoles(t *testing.T) {
+	custom := []*Role{
+		{Name: "lab", Permissions: []string{"diagnosis:create", "credentials:renew"}},
+		{Name: "researcher", Permissions: []string{"analytics:*"}},
+	}
+	roles, err := loadRoles(custom)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range []string{"user", "agent", "admin", "lab", "researcher"} {
+		if !roles[r] {
+			t.Errorf("role should be supported: %s", r)
+		}
+	}
+	policy := accessPolicy("", custom)
+	for _, rule := range []string{"r, lab, /diagnosis, create", "r, researcher, /analytics, .*"} {
+		if !strings.Contains(policy, rule) {
+			t.Errorf("missing policy rule: %s", rule)
+		}
+	}
+
+	// Invalid settings
+	invalid := [][]*Role{
+		{{Name: "admin", Permissions: []string{"record:create"}}},
+		{{Name: "campaign", Permissions: []string{"record:create"}}},
+		{{Name: "Lab", Permissions: []string{"record:create"}}},
+		{{Name: "lab"}},
+		{{Name: "lab", Permissions: []string{"record"}}},
+		{custom[0], custom[0]},
+	}
+	for i, list := range invalid {
+		if _, err := loadRoles(list); err == nil {
+			t.Errorf("%d: invalid roles should be rejected", i)
+		}
+	}
+}
@@ -21,12 +21,16 @@ func (ri *remoteInterface) Ping(_ context.Context, _ *types.Empty) (*protov1.Pin
 func (ri *remoteInterface) ActivationCode(ctx context.Context,
 	req *protov1.ActivationCodeRequest) (*protov1.ActivationCodeResponse, error) {
 	// For security, admin codes can't be generated via the API
-	if !isRoleValid(req.Role) || req.Role == "admin" {
-		return nil, errInvalidRequest
+	if !ri.srv.isRoleValid(req.Role) || req.Role == "admin" {
+		return nil, invalidArgument("role", "unsupported role")
+	}
+	if !validScope(req.Scope) {
+		return nil, invalidArgument("scope", "permissions must be in the form 'resource:action'")
 	}
 
-	// Activation codes for "agent" role require authentication and authorization
-	if req.Role == "agent" {
+	// Activation codes for roles other than "user" require authentication
+	// and authorization
+	if req.Role != "user" {
 		// Authentication (ignoring expiration date)
 		token, err := ri.srv.authenticate(ctx, true)
 		if err != nil {
@@ -34,7 +38,7 @@ func (ri *remoteInterface) ActivationCode(ctx context.Context,
 		}
 
 		// Authorization
-		if !ri.srv.authorize(token, "/activation_code/agent", "create") {
+		if !ri.srv.authorize(token, "/activation_code/"+req.Role, "create") {
 			return nil, errUnauthorized
 		}
 	}
@@ -47,14 +51,53 @@ func (ri *remoteInterface) ActivationCode(ctx context.Context,
 	return &protov1.ActivationCodeResponse{ActivationCode: code}, nil
 }
 
+// BulkActivationCodes generates a batch of "user" activation codes for a
+// registration campaign. This method requires authentication.
+func (ri *remoteInterface) BulkActivationCodes(ctx context.Context,
+	req *protov1.BulkActivationCodesRequest) (*protov1.BulkActivationCodesResponse, error) {
+	if !validScope(req.Scope) {
+		return nil, invalidArgument("scope", "permissions must be in the form 'resource:action'")
+	}
+
+	// Authentication
+	token, err := ri.srv.authenticate(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	if !ri.srv.authorize(token, "/activation_code/bulk", "create") {
+		return nil, errUnauthorized
+	}
+
+	return ri.srv.BulkActivationCodes(req)
+}
+
 // Credentials requests for platform access. This method does not require authentication.
-func (ri *remoteInterface) Credentials(_ context.Context,
+func (ri *remoteInterface) Credentials(ctx context.Context,
 	req *protov1.CredentialsRequest) (*protov1.CredentialsResponse, error) {
 	// For security, admin credentials can't be generated via the API
-	if !isRoleValid(req.Role) || req.Role == "admin" {
-		return nil, errInvalidRequest
+	if !ri.srv.isRoleValid(req.Role) || req.Role == "admin" {
+		return nil, invalidArgument("role", "unsupported role")
+	}
+	if !validScope(req.Scope) {
+		return nil, invalidArgument("scope", "permissions must be in the form 'resource:action'")
 	}
-	return ri.srv.AccessToken(req, true)
+	if req.Lang == "" {
+		req.Lang = string(getLanguage(ctx))
+	}
+	if err := ri.srv.geoCheck(ctx, req.Role); err != nil {
+		return nil, err
+	}
+
+	// Brute-force protection
+	address := clientAddress(ctx)
+	if err := ri.srv.checkLockout(req.Did, address); err != nil {
+		return nil, err
+	}
+	res, err := ri.srv.AccessToken(req, true)
+	ri.srv.trackAttempt(req.Did, address, err)
+	return res, err
 }
 
 // RenewCredentials allows to refresh a valid but expired access token for a new one.
@@ -88,14 +131,350 @@ func (ri *remoteInterface) Record(ctx context.Context,
 	if !ri.srv.authorize(token, "/record", "create") {
 		return nil, errUnauthorized
 	}
+	if err := ri.srv.geoCheckToken(ctx, token); err != nil {
+		return nil, err
+	}
+
+	return ri.srv.LocationRecord(ctx, token, req)
+}
+
+// GetSubmissionStatus returns the processing status of location records
+// submitted on broker ingestion mode. This method requires authentication.
+func (ri *remoteInterface) GetSubmissionStatus(ctx context.Context,
+	req *protov1.SubmissionStatusRequest) (*protov1.SubmissionStatusResponse, error) {
+	// Authentication
+	token, err := ri.srv.authenticate(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	if !ri.srv.authorize(token, "/submission", "read") {
+		return nil, errUnauthorized
+	}
 
-	return ri.srv.LocationRecord(token, req)
+	return ri.srv.SubmissionStatus(token, req)
+}
+
+// CheckExposure allows users to verify whether they were in the same
+// location cells and time buckets as diagnosed cases, without disclosing
+// their precise locations. This method requires authentication.
+func (ri *remoteInterface) CheckExposure(ctx context.Context,
+	req *protov1.CheckExposureRequest) (*protov1.CheckExposureResponse, error) {
+	// Authentication
+	token, err := ri.srv.authenticate(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	if !ri.srv.authorize(token, "/exposure", "check") {
+		return nil, errUnauthorized
+	}
+
+	return ri.srv.CheckExposure(token, req)
+}
+
+// CheckExposurePSI allows users to verify whether they were in the same
+// location cells and time buckets as diagnosed cases using private set
+// intersection. This method requires authentication.
+func (ri *remoteInterface) CheckExposurePSI(ctx context.Context,
+	req *protov1.PSIRequest) (*protov1.PSIResponse, error) {
+	// Authentication
+	token, err := ri.srv.authenticate(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	if !ri.srv.authorize(token, "/exposure", "check") {
+		return nil, errUnauthorized
+	}
+
+	return ri.srv.CheckExposurePSI(token, req)
+}
+
+// GetSamplingPolicy returns how often the user should record and upload
+// locations. This method requires authentication.
+func (ri *remoteInterface) GetSamplingPolicy(ctx context.Context, _ *types.Empty) (*protov1.SamplingPolicy, error) {
+	// Authentication
+	token, err := ri.srv.authenticate(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	if !ri.srv.authorize(token, "/sampling_policy", "read") {
+		return nil, errUnauthorized
+	}
+
+	return ri.srv.SamplingPolicy(token)
 }
 
 // NewIdentifier provides a helper method to generate a new DID instances for
 // clients that can't generate it locally. This is not recommended but supported
 // for legacy and development purposes. This method does not require authentication.
-func (ri *remoteInterface) NewIdentifier(_ context.Context,
+func (ri *remoteInterface) NewIdentifier(ctx context.Context,
 	req *protov1.NewIdentifierRequest) (*protov1.NewIdentifierResponse, error) {
-	return ri.srv.NewIdentifier(req)
+	return ri.srv.NewIdentifier(ctx, req)
+}
+
+// RegisterVenue adds a new venue where users can check-in. This method requires
+// authentication.
+func (ri *remoteInterface) RegisterVenue(ctx context.Context,
+	req *protov1.RegisterVenueRequest) (*protov1.Venue, error) {
+	// Authentication
+	token, err := ri.srv.authenticate(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	if !ri.srv.authorize(token, "/venue", "create") {
+		return nil, errUnauthorized
+	}
+
+	return ri.srv.RegisterVenue(token, req)
+}
+
+// CheckIn record venue visit events.
+func (ri *remoteInterface) CheckIn(ctx context.Context,
+	req *protov1.CheckInRequest) (*protov1.CheckInResponse, error) {
+	// Authentication
+	token, err := ri.srv.authenticate(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	if !ri.srv.authorize(token, "/check_in", "create") {
+		return nil, errUnauthorized
+	}
+	if err := ri.srv.geoCheckToken(ctx, token); err != nil {
+		return nil, err
+	}
+
+	return ri.srv.CheckIn(ctx, token, req)
+}
+
+// VenueOutbreak links a venue to a confirmed case. This method requires
+// authentication.
+func (ri *remoteInterface) VenueOutbreak(ctx context.Context,
+	req *protov1.VenueOutbreakRequest) (*protov1.VenueOutbreakResponse, error) {
+	// Authentication
+	token, err := ri.srv.authenticate(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	if !ri.srv.authorize(token, "/venue/outbreak", "create") {
+		return nil, errUnauthorized
+	}
+
+	return ri.srv.VenueOutbreak(ctx, token, req)
+}
+
+// Analytics returns anonymized hotspots and movement flows. This method
+// requires authentication.
+func (ri *remoteInterface) Analytics(ctx context.Context,
+	req *protov1.AnalyticsRequest) (*protov1.AnalyticsResponse, error) {
+	// Authentication
+	token, err := ri.srv.authenticate(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	if !ri.srv.authorize(token, "/analytics", "read") {
+		return nil, errUnauthorized
+	}
+
+	return ri.srv.Analytics(ctx, token, req)
+}
+
+// LabResult submit test results as HL7 FHIR resources. This method requires
+// authentication.
+func (ri *remoteInterface) LabResult(ctx context.Context,
+	req *protov1.LabResultRequest) (*protov1.LabResultResponse, error) {
+	// Authentication
+	token, err := ri.srv.authenticate(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	if !ri.srv.authorize(token, "/diagnosis", "create") {
+		return nil, errUnauthorized
+	}
+
+	return ri.srv.LabResult(ctx, token, req)
+}
+
+// Certificate issue a verifiable health credential for a test result. This
+// method requires authentication.
+func (ri *remoteInterface) Certificate(ctx context.Context,
+	req *protov1.CertificateRequest) (*protov1.CertificateResponse, error) {
+	// Authentication
+	token, err := ri.srv.authenticate(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	if !ri.srv.authorize(token, "/certificate", "read") {
+		return nil, errUnauthorized
+	}
+
+	return ri.srv.Certificate(token, req)
+}
+
+// Introspect validates an access token and returns its claims. This method
+// requires authentication.
+func (ri *remoteInterface) Introspect(ctx context.Context,
+	req *protov1.IntrospectRequest) (*protov1.IntrospectResponse, error) {
+	// Authentication
+	token, err := ri.srv.authenticate(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	if !ri.srv.authorize(token, "/introspect", "read") {
+		return nil, errUnauthorized
+	}
+
+	return ri.srv.Introspect(req)
+}
+
+// Ack registers the delivery, or reading, of notifications received by the
+// user. This method requires authentication.
+func (ri *remoteInterface) Ack(ctx context.Context, req *protov1.AckRequest) (*protov1.AckResponse, error) {
+	// Authentication
+	token, err := ri.srv.authenticate(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	if !ri.srv.authorize(token, "/notification", "update") {
+		return nil, errUnauthorized
+	}
+
+	return ri.srv.Ack(token, req)
+}
+
+// NotificationStatus returns the delivery status of the notifications
+// originated by a diagnosis or venue outbreak. This method requires
+// authentication.
+func (ri *remoteInterface) NotificationStatus(ctx context.Context,
+	req *protov1.NotificationStatusRequest) (*protov1.NotificationStatusResponse, error) {
+	// Authentication
+	token, err := ri.srv.authenticate(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	if !ri.srv.authorize(token, "/notification", "read") {
+		return nil, errUnauthorized
+	}
+
+	return ri.srv.NotificationStatus(req)
+}
+
+// ExposureQuery returns anonymized presence counts inside an area during a
+// period of time, and optionally the identifiers of the users present. This
+// method requires authentication.
+func (ri *remoteInterface) ExposureQuery(ctx context.Context,
+	req *protov1.ExposureQueryRequest) (*protov1.ExposureQueryResponse, error) {
+	// Authentication
+	token, err := ri.srv.authenticate(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	if !ri.srv.authorize(token, "/exposure", "read") {
+		return nil, errUnauthorized
+	}
+	if req.Identifiers && !ri.srv.authorize(token, "/record", "read") {
+		return nil, errUnauthorized
+	}
+
+	return ri.srv.ExposureQuery(ctx, token, req)
+}
+
+// ListSessions returns the active sessions of the user. This method requires
+// authentication.
+func (ri *remoteInterface) ListSessions(ctx context.Context, _ *types.Empty) (*protov1.ListSessionsResponse, error) {
+	// Authentication
+	token, err := ri.srv.authenticate(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	if !ri.srv.authorize(token, "/session", "read") {
+		return nil, errUnauthorized
+	}
+
+	return ri.srv.ListSessions(token)
+}
+
+// RevokeSession invalidates the credentials of a session of the user. This
+// method requires authentication.
+func (ri *remoteInterface) RevokeSession(ctx context.Context,
+	req *protov1.RevokeSessionRequest) (*types.Empty, error) {
+	// Authentication
+	token, err := ri.srv.authenticate(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	if !ri.srv.authorize(token, "/session", "revoke") {
+		return nil, errUnauthorized
+	}
+
+	if err := ri.srv.RevokeSession(token, req); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// SendMessage stores a message encrypted end-to-end to a user. This method
+// requires authentication.
+func (ri *remoteInterface) SendMessage(ctx context.Context,
+	req *protov1.EncryptedMessage) (*protov1.SendMessageResponse, error) {
+	// Authentication
+	token, err := ri.srv.authenticate(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	if !ri.srv.authorize(token, "/message", "create") {
+		return nil, errUnauthorized
+	}
+
+	return ri.srv.SendMessage(ctx, token, req)
+}
+
+// Messages returns the encrypted messages received by the user. This method
+// requires authentication.
+func (ri *remoteInterface) Messages(ctx context.Context,
+	req *protov1.MessagesRequest) (*protov1.MessagesResponse, error) {
+	// Authentication
+	token, err := ri.srv.authenticate(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	if !ri.srv.authorize(token, "/message", "read") {
+		return nil, errUnauthorized
+	}
+
+	return ri.srv.Messages(token, req)
 }
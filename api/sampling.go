@@ -0,0 +1,136 @@
+package api
+
+import (
+	"time"
+
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/x/jwx"
+)
+
+// Sampling modes reported to clients.
+const (
+	samplingNormal       = "normal"
+	samplingExposureRisk = "exposure_risk"
+)
+
+// Default sampling settings.
+var (
+	defaultSamplingNormal = SamplingMode{
+		RecordInterval: 5 * time.Minute,
+		UploadInterval: 1 * time.Hour,
+		MinDistance:    50,
+	}
+	defaultSamplingRisk = SamplingMode{
+		RecordInterval: 1 * time.Minute,
+		UploadInterval: 15 * time.Minute,
+		MinDistance:    10,
+	}
+	defaultSamplingRefresh = 6 * time.Hour
+)
+
+// SamplingMode adjusts how often clients record and upload locations.
+type SamplingMode struct {
+	// Period of time between location records.
+	RecordInterval time.Duration
+
+	// Period of time between uploads of the records collected.
+	UploadInterval time.Duration
+
+	// Minimum distance, in meters, between consecutive records. A zero
+	// value produces a record on every interval.
+	MinDistance uint32
+}
+
+// SamplingConfig adjusts the data granularity and battery usage of clients
+// fleet-wide. Users with a recent exposure use the "exposure risk" mode,
+// usually with a finer granularity. Zero values use the defaults.
+type SamplingConfig struct {
+	// Settings for users without recent exposures. By default, a record
+	// every 5 minutes, uploaded every hour.
+	Normal SamplingMode
+
+	// Settings for users with a recent exposure. By default, a record every
+	// minute, uploaded every 15 minutes.
+	ExposureRisk SamplingMode
+
+	// Period of time after an exposure is registered during which the
+	// exposure risk mode is used. If not provided, the exposure window of
+	// 14 days is used.
+	RiskPeriod time.Duration
+
+	// How often clients should retrieve the sampling policy. If not
+	// provided a default value of 6 hours is used.
+	Refresh time.Duration
+}
+
+// Fill the settings not provided with the default values.
+func newSamplingConfig(conf *SamplingConfig) SamplingConfig {
+	sc := SamplingConfig{}
+	if conf != nil {
+		sc = *conf
+	}
+	sc.Normal = sc.Normal.withDefaults(defaultSamplingNormal)
+	sc.ExposureRisk = sc.ExposureRisk.withDefaults(defaultSamplingRisk)
+	if sc.RiskPeriod == 0 {
+		sc.RiskPeriod = exposureWindow
+	}
+	if sc.Refresh == 0 {
+		sc.Refresh = defaultSamplingRefresh
+	}
+	return sc
+}
+
+func (sm SamplingMode) withDefaults(def SamplingMode) SamplingMode {
+	if sm.RecordInterval == 0 {
+		sm.RecordInterval = def.RecordInterval
+	}
+	if sm.UploadInterval == 0 {
+		sm.UploadInterval = def.UploadInterval
+	}
+	if sm.MinDistance == 0 {
+		sm.MinDistance = def.MinDistance
+	}
+	return sm
+}
+
+// Sampling policy for a user; 'exposed' is the date of its most recent
+// exposure, if any. While the exposure risk mode applies, clients are asked
+// to retrieve the policy again once it expires.
+func (sc SamplingConfig) policy(exposed time.Time, now time.Time) *protov1.SamplingPolicy {
+	mode, settings, refresh := samplingNormal, sc.Normal, sc.Refresh
+	if !exposed.IsZero() {
+		if left := exposed.Add(sc.RiskPeriod).Sub(now); left > 0 {
+			mode, settings = samplingExposureRisk, sc.ExposureRisk
+			if left < refresh {
+				refresh = left
+			}
+		}
+	}
+	return &protov1.SamplingPolicy{
+		Mode:            mode,
+		RecordInterval:  uint32(settings.RecordInterval.Seconds()),
+		UploadInterval:  uint32(settings.UploadInterval.Seconds()),
+		MinDistance:     settings.MinDistance,
+		RefreshInterval: uint32(refresh.Seconds()),
+	}
+}
+
+// SamplingPolicy returns how often the user should record and upload
+// locations. The normal mode is reported if the exposures of the user can't
+// be retrieved, since the policy is only a recommendation.
+// nolint: interfacer
+func (srv *Server) SamplingPolicy(token *jwx.Token) (*protov1.SamplingPolicy, error) {
+	data := &credentialsData{}
+	if err := token.Decode(&data); err != nil {
+		return nil, errUnauthenticated
+	}
+	var exposed time.Time
+	e, err := srv.repos.Exposures().LastExposure(data.DID)
+	if err != nil {
+		srv.log.WithField("error", err.Error()).Warning("failed to retrieve exposures")
+	}
+	if e != nil {
+		exposed = time.Unix(e.Timestamp, 0)
+	}
+	return srv.sampling.policy(exposed, time.Now()), nil
+}
@@ -0,0 +1,30 @@
+package api
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSamplingPolicy(t *testing.T) {
+	sc := newSamplingConfig(&SamplingConfig{Normal: SamplingMode{RecordInterval: 10 * time.Minute}})
+	now := time.Now()
+
+	p := sc.policy(time.Time{}, now)
+	if p.Mode != samplingNormal || p.RecordInterval != 600 || p.UploadInterval != 3600 {
+		t.Errorf("invalid normal policy: %+v", p)
+	}
+	if p.RefreshInterval != uint32(defaultSamplingRefresh.Seconds()) {
+		t.Errorf("invalid refresh interval: %d", p.RefreshInterval)
+	}
+
+	// Recent exposure, the policy must be refreshed when the risk period ends
+	p = sc.policy(now.Add(-exposureWindow+time.Hour), now)
+	if p.Mode != samplingExposureRisk || p.RecordInterval != 60 || p.RefreshInterval != 3600 {
+		t.Errorf("invalid exposure risk policy: %+v", p)
+	}
+
+	// Exposure outside the risk period
+	if p = sc.policy(now.Add(-exposureWindow), now); p.Mode != samplingNormal {
+		t.Errorf("invalid mode: %s", p.Mode)
+	}
+}
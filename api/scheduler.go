@@ -0,0 +1,276 @@
+package api
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/robfig/cron/v3"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	xlog "go.bryk.io/x/log"
+)
+
+// Recurring jobs executed by workers.
+const (
+	// Match positive cases against location records received after the
+	// diagnosis was processed.
+	jobContactMatching = "contact_matching"
+
+	// Remove expired data and archive old location records.
+	jobRetention = "retention"
+
+	// Generate and export analytics aggregates.
+	jobAnalytics = "analytics"
+
+	// Check the expiration date of the configured certificates.
+	jobCertificates = "certificates"
+
+	// Seal and anchor the tamper-evident ledger.
+	jobLedger = "ledger"
+)
+
+// Default cron expressions used to run recurring jobs.
+var defaultSchedule = map[string]string{
+	jobContactMatching: "0 2 * * *",
+	jobRetention:       "0 3 * * *",
+	jobAnalytics:       "0 1 * * *",
+	jobCertificates:    "0 6 * * *",
+	jobLedger:          "*/30 * * * *",
+}
+
+// Maximum time a worker is considered responsible for a job execution.
+// If the worker fails before completing it, the job can be claimed again
+// once the lease expires.
+const jobLease = 6 * time.Hour
+
+// Certificates expiring within this period are reported.
+const certificateExpiryNotice = 30 * 24 * time.Hour
+
+type scheduledJob struct {
+	name     string
+	schedule cron.Schedule
+	run      func() error
+}
+
+// Parse the cron expressions used to run recurring jobs. Expressions
+// provided in 'conf' override the default schedule; an empty expression
+// disables the job.
+func jobSchedules(conf map[string]string) (map[string]cron.Schedule, error) {
+	list := make(map[string]string)
+	for name, spec := range defaultSchedule {
+		list[name] = spec
+	}
+	for name, spec := range conf {
+		if _, ok := defaultSchedule[name]; !ok {
+			return nil, errors.Errorf("unknown job: %s", name)
+		}
+		list[name] = spec
+	}
+	schedules := make(map[string]cron.Schedule)
+	for name, spec := range list {
+		if spec == "" {
+			continue
+		}
+		s, err := cron.ParseStandard(spec)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid schedule for job %s", name)
+		}
+		schedules[name] = s
+	}
+	return schedules, nil
+}
+
+// Setup the recurring jobs supported by the worker instance.
+func (w *Worker) setupJobs(conf map[string]string) error {
+	schedules, err := jobSchedules(conf)
+	if err != nil {
+		return err
+	}
+	tasks := map[string]func() error{
+		jobContactMatching: w.matchContacts,
+		jobRetention:       w.retention,
+	}
+	if w.precision > 0 {
+		tasks[jobAnalytics] = w.analytics
+	}
+	if len(w.certs) > 0 {
+		tasks[jobCertificates] = w.checkCertificates
+	}
+	if w.ledger != nil {
+		tasks[jobLedger] = w.sealLedger
+	}
+	for name, run := range tasks {
+		if s, ok := schedules[name]; ok {
+			w.jobs = append(w.jobs, &scheduledJob{name: name, schedule: s, run: run})
+		}
+	}
+	return nil
+}
+
+// Execute recurring jobs on their scheduled time. All workers share the
+// same schedule; storage is used to ensure only one of them runs each
+// job execution.
+func (w *Worker) scheduler() {
+	if len(w.jobs) == 0 {
+		return
+	}
+	next := make(map[*scheduledJob]time.Time)
+	for _, job := range w.jobs {
+		next[job] = job.schedule.Next(time.Now())
+	}
+	for {
+		// Wait for the next job due
+		due := time.Time{}
+		for _, t := range next {
+			if due.IsZero() || t.Before(due) {
+				due = t
+			}
+		}
+		timer := time.NewTimer(time.Until(due))
+		select {
+		case <-w.ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		// Run all jobs due
+		now := time.Now()
+		for job, slot := range next {
+			if slot.After(now) {
+				continue
+			}
+			go w.runJob(job, slot)
+			next[job] = job.schedule.Next(now)
+		}
+	}
+}
+
+// Execute a recurring job, if not already claimed by another worker.
+func (w *Worker) runJob(job *scheduledJob, slot time.Time) {
+	log := w.log.WithField("job", job.name)
+	claimed, err := w.repos.Scheduler().ClaimScheduledRun(job.name, w.name, slot, jobLease)
+	if err != nil {
+		log.WithField("error", err.Error()).Error("failed to claim scheduled job")
+		return
+	}
+	if !claimed {
+		log.Debug("scheduled job handled by another worker")
+		return
+	}
+	start := time.Now()
+	result := job.run()
+	if err := w.repos.Scheduler().ScheduledRunFinished(job.name, w.name, result); err != nil {
+		log.WithField("error", err.Error()).Error("failed to release scheduled job")
+	}
+	if result != nil {
+		log.WithField("error", result.Error()).Error("scheduled job failed")
+		return
+	}
+	log.WithField("duration", time.Since(start).String()).Info("scheduled job completed")
+}
+
+// Notify users that were in contact with recent positive cases and were not
+// previously notified. Location records are uploaded by devices periodically,
+// so contacts can be discovered after the diagnosis was processed.
+func (w *Worker) matchContacts() error {
+	cases, err := w.repos.Exposures().PositiveDiagnoses(time.Now().Add(-1 * exposureWindow))
+	if err != nil {
+		return err
+	}
+	total := 0
+	for _, d := range cases {
+		count, err := w.notifyNewContacts(d)
+		if err != nil {
+			return err
+		}
+		total += count
+	}
+	w.log.WithFields(xlog.Fields{
+		"diagnoses": len(cases),
+		"exposures": total,
+	}).Info("contact matching processed")
+	return nil
+}
+
+// Notify the contacts of a positive case not previously notified. Returns
+// the number of new exposures registered.
+func (w *Worker) notifyNewContacts(d *protov1.Diagnosis) (int, error) {
+	from := time.Unix(d.Timestamp, 0).Add(-1 * exposureWindow)
+	contacts, err := w.repos.Records().Contacts(d.Did, from, time.Now())
+	if err != nil {
+		return 0, err
+	}
+	exposed, err := w.repos.Exposures().Exposed(d.Id)
+	if err != nil {
+		return 0, err
+	}
+	known := make(map[string]bool, len(exposed))
+	for _, did := range exposed {
+		known[did] = true
+	}
+	var pending []string
+	for _, did := range contacts {
+		if !known[did] {
+			pending = append(pending, did)
+		}
+	}
+	w.exposures(pending, d.Id)
+	return len(pending), nil
+}
+
+// Enforce the data retention policy. Data under a legal hold is preserved
+// before any entry is removed.
+func (w *Worker) retention() error {
+	held, err := w.repos.LegalHolds().PreserveHeldData()
+	if err != nil {
+		return errors.Wrap(err, "failed to preserve held data")
+	}
+	if held > 0 {
+		w.log.WithField("entries", held).Info("held data preserved")
+	}
+	if err := w.purgeRecords(); err != nil {
+		return err
+	}
+	if w.archive > 0 {
+		return w.archiveRecords()
+	}
+	return nil
+}
+
+// Report certificates that are expired or about to expire.
+func (w *Worker) checkCertificates() error {
+	for _, file := range w.certs {
+		contents, err := ioutil.ReadFile(filepath.Clean(file))
+		if err != nil {
+			return err
+		}
+		for block, rest := pem.Decode(contents); block != nil; block, rest = pem.Decode(rest) {
+			if block.Type != "CERTIFICATE" {
+				continue
+			}
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return errors.Wrapf(err, "invalid certificate in %s", file)
+			}
+			remaining := time.Until(cert.NotAfter)
+			if remaining > certificateExpiryNotice {
+				continue
+			}
+			log := w.log.WithFields(xlog.Fields{
+				"file":    file,
+				"subject": cert.Subject.String(),
+				"expires": cert.NotAfter.Format(time.RFC3339),
+			})
+			if remaining <= 0 {
+				log.Error("certificate expired")
+			} else {
+				log.Warning("certificate about to expire")
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package api
+
+import (
+	"testing"
+	"time"
+)
+
+func TestJobSchedules(t *testing.T) {
+	// Default schedule
+	schedules, err := jobSchedules(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(schedules) != len(defaultSchedule) {
+		t.Fatalf("expected %d jobs, got %d", len(defaultSchedule), len(schedules))
+	}
+
+	// Custom and disabled jobs
+	schedules, err = jobSchedules(map[string]string{
+		jobRetention: "15 4 * * *",
+		jobAnalytics: "",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := schedules[jobAnalytics]; ok {
+		t.Error("disabled job was scheduled")
+	}
+	ref := time.Date(2020, 5, 1, 12, 0, 0, 0, time.Local)
+	next := schedules[jobRetention].Next(ref)
+	if expected := time.Date(2020, 5, 2, 4, 15, 0, 0, time.Local); !next.Equal(expected) {
+		t.Errorf("expected %s, got %s", expected, next)
+	}
+
+	// Invalid settings
+	if _, err := jobSchedules(map[string]string{"unknown": "@daily"}); err == nil {
+		t.Error("unknown job accepted")
+	}
+	if _, err := jobSchedules(map[string]string{jobRetention: "invalid"}); err == nil {
+		t.Error("invalid expression accepted")
+	}
+}
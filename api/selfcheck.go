@@ -0,0 +1,244 @@
+package api
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/covid-tracking/utils"
+	"go.bryk.io/x/jwx"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+)
+
+// Exposure self-check settings.
+const (
+	// How often the cells visited by diagnosed cases are retrieved again.
+	exposureCellsTTL = 15 * time.Minute
+
+	// Maximum number of prefixes per request; enough for a record on every
+	// time bucket of the exposure window.
+	maxExposurePrefixes = utils.MaxCheckTokens
+
+	// Maximum number of prefixes, or blinded tokens, a DID can submit during
+	// each quota window; enough to check the complete exposure window twice.
+	// Prevents clients from enumerating the cells visited by diagnosed cases.
+	maxExposureChecks = 2 * maxExposurePrefixes
+
+	// Exposure self-check quotas are reset at the start of each UTC day.
+	exposureQuotaWindow = 24 * time.Hour
+)
+
+// Protocols supported for exposure self-checks.
+const (
+	// Clients submit prefixes of their cell tokens and compare the tokens
+	// returned locally.
+	exposureCheckTokens = "tokens"
+
+	// Clients use private set intersection, see 'utils.PSIClient'.
+	exposureCheckPSI = "psi"
+)
+
+// Cell tokens for the location cells and time buckets visited by diagnosed
+// cases during their exposure window, indexed by prefix. Shared by all
+// self-check requests and refreshed periodically.
+type exposureCells struct {
+	index   map[string][]string
+	psi     *utils.PSIServer
+	set     []string
+	encoded time.Time
+	updated time.Time
+	mu      sync.Mutex
+	enc     sync.Mutex // serializes encoding the PSI set
+}
+
+// Register 'n' elements submitted by 'did' for exposure self-checks. Returns
+// an error if the quota for the current window is exceeded; no elements are
+// registered in that case. Counters are kept on storage, so the quota is
+// shared by all server instances.
+func (srv *Server) useExposureChecks(did string, n int) error {
+	now := time.Now()
+	ok, err := srv.repos.Quotas().UseExposureChecks(did, now, n, maxExposureChecks)
+	if err != nil {
+		srv.log.WithField("error", err.Error()).Error("failed to update exposure checks quota")
+		return errInternalError
+	}
+	if !ok {
+		reset := now.UTC().Truncate(exposureQuotaWindow).Add(exposureQuotaWindow)
+		return newError(codes.ResourceExhausted,
+			protov1.ErrorCode_ERROR_CODE_RATE_LIMITED, "exposure checks quota exceeded",
+			&errdetails.RetryInfo{RetryDelay: ptypes.DurationProto(reset.Sub(now))})
+	}
+	return nil
+}
+
+// Return the current cell tokens index, refreshing it if required.
+func (srv *Server) exposureIndex() (map[string][]string, time.Time, error) {
+	ec := srv.exposed
+	ec.mu.Lock()
+	defer ec.mu.Unlock()
+	if ec.index != nil && time.Since(ec.updated) < exposureCellsTTL {
+		return ec.index, ec.updated, nil
+	}
+	now := time.Now()
+	cases, err := srv.repos.Exposures().PositiveDiagnoses(now.Add(-1 * exposureWindow))
+	if err != nil {
+		return nil, ec.updated, err
+	}
+	index := make(map[string][]string)
+	seen := make(map[string]bool)
+	for _, d := range cases {
+		from := time.Unix(d.Timestamp, 0).Add(-1 * exposureWindow)
+		cells, err := srv.repos.Records().Cells(d.Did, from, now)
+		if err != nil {
+			return nil, ec.updated, err
+		}
+		for _, c := range cells {
+			token := utils.CellToken(c.ID, c.Bucket)
+			if seen[token] {
+				continue
+			}
+			seen[token] = true
+			prefix := token[:utils.CellTokenPrefix]
+			index[prefix] = append(index[prefix], token)
+		}
+	}
+	ec.index, ec.updated = index, now
+	return index, now, nil
+}
+
+// Return the current set of cell tokens encoded for private set
+// intersection, along with the instance used to encode it. The set is
+// encoded again, with a new random secret, every time the tokens index is
+// refreshed; so evaluated points can't be correlated across refreshes.
+func (srv *Server) exposureSet() (*utils.PSIServer, []string, time.Time, error) {
+	index, updated, err := srv.exposureIndex()
+	if err != nil {
+		return nil, nil, updated, err
+	}
+	ec := srv.exposed
+	ec.enc.Lock()
+	defer ec.enc.Unlock()
+	ec.mu.Lock()
+	psi, set, encoded := ec.psi, ec.set, ec.encoded
+	ec.mu.Unlock()
+	if psi != nil && !encoded.Before(updated) {
+		return psi, set, encoded, nil
+	}
+
+	// Encode the set without holding the index lock
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, nil, updated, err
+	}
+	psi = utils.NewPSIServer(key)
+	set = []string{}
+	for _, tokens := range index {
+		for _, t := range tokens {
+			e, err := psi.Encode(t)
+			if err != nil {
+				return nil, nil, updated, err
+			}
+			set = append(set, e)
+		}
+	}
+	sort.Strings(set)
+	ec.mu.Lock()
+	ec.psi, ec.set, ec.encoded = psi, set, updated
+	ec.mu.Unlock()
+	return psi, set, updated, nil
+}
+
+// CheckExposure allows users to verify whether they were in the same
+// location cells and time buckets as diagnosed cases. Only prefixes of the
+// hashed cells visited are submitted, and the complete tokens matching them
+// are returned for the client to compare locally; so the server doesn't
+// learn the precise locations visited, or the result of the check. Cell
+// tokens are not keyed, so the number of prefixes each DID can submit is
+// capped to prevent enumerating the cells visited by diagnosed cases; the
+// "psi" mode should be preferred.
+// nolint: interfacer
+func (srv *Server) CheckExposure(token *jwx.Token,
+	req *protov1.CheckExposureRequest) (*protov1.CheckExposureResponse, error) {
+	if srv.selfCheck != exposureCheckTokens {
+		return nil, errNotEnabled
+	}
+	if len(req.Prefixes) == 0 || len(req.Prefixes) > maxExposurePrefixes {
+		return nil, invalidArgument("prefixes",
+			fmt.Sprintf("between 1 and %d prefixes per request are supported", maxExposurePrefixes))
+	}
+	for i, p := range req.Prefixes {
+		if _, err := hex.DecodeString(p); err != nil || len(p) != utils.CellTokenPrefix {
+			return nil, invalidArgument(fmt.Sprintf("prefixes[%d]", i),
+				fmt.Sprintf("prefixes must be %d hex characters", utils.CellTokenPrefix))
+		}
+	}
+	data := &credentialsData{}
+	if err := token.Decode(&data); err != nil {
+		return nil, errUnauthenticated
+	}
+	if err := srv.useExposureChecks(data.DID, len(req.Prefixes)); err != nil {
+		return nil, err
+	}
+	index, updated, err := srv.exposureIndex()
+	if err != nil {
+		srv.log.WithField("error", err.Error()).Error("failed to retrieve exposure cells")
+		return nil, errInternalError
+	}
+	res := &protov1.CheckExposureResponse{Updated: updated.Unix()}
+	seen := make(map[string]bool)
+	for _, p := range req.Prefixes {
+		p = strings.ToLower(p)
+		if !seen[p] {
+			seen[p] = true
+			res.Tokens = append(res.Tokens, index[p]...)
+		}
+	}
+	return res, nil
+}
+
+// CheckExposurePSI allows users to verify whether they were in the same
+// location cells and time buckets as diagnosed cases using private set
+// intersection. The client submits its cell tokens blinded and receives them
+// evaluated with the server's secret, along with the encoded set of tokens
+// for the cells visited by diagnosed cases. Neither party learns the
+// locations visited by the other; the client only learns which of its
+// tokens are included on the set. The number of elements each DID can
+// submit is capped, same as for the "tokens" mode.
+// nolint: interfacer
+func (srv *Server) CheckExposurePSI(token *jwx.Token, req *protov1.PSIRequest) (*protov1.PSIResponse, error) {
+	if srv.selfCheck != exposureCheckPSI {
+		return nil, errNotEnabled
+	}
+	if len(req.Blinded) == 0 || len(req.Blinded) > maxExposurePrefixes {
+		return nil, invalidArgument("blinded",
+			fmt.Sprintf("between 1 and %d elements per request are supported", maxExposurePrefixes))
+	}
+	data := &credentialsData{}
+	if err := token.Decode(&data); err != nil {
+		return nil, errUnauthenticated
+	}
+	if err := srv.useExposureChecks(data.DID, len(req.Blinded)); err != nil {
+		return nil, err
+	}
+	psi, set, updated, err := srv.exposureSet()
+	if err != nil {
+		srv.log.WithField("error", err.Error()).Error("failed to retrieve exposure cells")
+		return nil, errInternalError
+	}
+	evaluated, err := psi.Evaluate(req.Blinded)
+	if err != nil {
+		return nil, invalidArgument("blinded", err.Error())
+	}
+	return &protov1.PSIResponse{
+		Evaluated: evaluated,
+		Set:       set,
+		Updated:   updated.Unix(),
+	}, nil
+}
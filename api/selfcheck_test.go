@@ -0,0 +1,169 @@
+package api
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	storetest "go.bryk.io/covid-tracking/storage/memtest"
+	"go.bryk.io/covid-tracking/utils"
+	"go.bryk.io/x/jwx"
+	xlog "go.bryk.io/x/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Credentials for a regular user.
+func userToken(t *testing.T, did string) *jwx.Token {
+	key, _ := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	tg, err := newSignerGenerator("ct19.test", key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := tg.NewToken("master", &jwx.TokenParameters{
+		Audience:            []string{"ct19.test"},
+		Subject:             did,
+		NotBefore:           "0ms",
+		Expiration:          "1h",
+		CustomPayloadClaims: &credentialsData{DID: did, Role: "user"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestCheckExposure(t *testing.T) {
+	store := storetest.New()
+	srv := &Server{
+		repos:     store,
+		exposed:   &exposureCells{},
+		selfCheck: exposureCheckTokens,
+		log:       xlog.WithZero(false),
+	}
+	token := userToken(t, "did:bryk:user")
+
+	now := time.Now()
+	_ = store.Records().LocationRecords([]*protov1.LocationRecord{
+		{Did: "did:bryk:case", Lat: 19.4326, Lng: -99.1332, Timestamp: now.Unix()},
+	})
+	_, _ = store.Exposures().Diagnosis("did:bryk:case", "positive", "test", now)
+
+	cell := utils.GeoHash(19.4326, -99.1332, utils.CellPrecision)
+	visited := utils.CellToken(cell, utils.TimeBucket(now, utils.BucketSize))
+	other := utils.CellToken(cell, utils.TimeBucket(now.Add(-time.Hour), utils.BucketSize))
+	res, err := srv.CheckExposure(token, &protov1.CheckExposureRequest{
+		Prefixes: []string{visited[:utils.CellTokenPrefix], other[:utils.CellTokenPrefix]},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Tokens) != 1 || res.Tokens[0] != visited {
+		t.Errorf("invalid tokens returned: %v", res.Tokens)
+	}
+
+	// Invalid prefixes
+	for _, p := range []string{"", "abc", "zzzz", visited} {
+		if _, err := srv.CheckExposure(token, &protov1.CheckExposureRequest{Prefixes: []string{p}}); err == nil {
+			t.Errorf("invalid prefix accepted: %s", p)
+		}
+	}
+
+	// Quota per DID
+	prefixes := make([]string, maxExposurePrefixes)
+	for i := range prefixes {
+		prefixes[i] = "abcd"
+	}
+	req := &protov1.CheckExposureRequest{Prefixes: prefixes}
+	if _, err := srv.CheckExposure(token, req); err != nil {
+		t.Error(err)
+	}
+	_, err = srv.CheckExposure(token, req)
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("quota not enforced: %v", err)
+	}
+	if _, err := srv.CheckExposure(userToken(t, "did:bryk:other"), req); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCheckExposurePSI(t *testing.T) {
+	store := storetest.New()
+	srv := &Server{
+		repos:     store,
+		exposed:   &exposureCells{},
+		selfCheck: exposureCheckPSI,
+		log:       xlog.WithZero(false),
+	}
+	token := userToken(t, "did:bryk:user")
+
+	now := time.Now()
+	_ = store.Records().LocationRecords([]*protov1.LocationRecord{
+		{Did: "did:bryk:case", Lat: 19.4326, Lng: -99.1332, Timestamp: now.Unix()},
+	})
+	_, _ = store.Exposures().Diagnosis("did:bryk:case", "positive", "test", now)
+
+	cell := utils.GeoHash(19.4326, -99.1332, utils.CellPrecision)
+	visited := utils.CellToken(cell, utils.TimeBucket(now, utils.BucketSize))
+	other := utils.CellToken(cell, utils.TimeBucket(now.Add(-time.Hour), utils.BucketSize))
+	client, err := utils.NewPSIClient([]string{visited, other})
+	if err != nil {
+		t.Fatal(err)
+	}
+	blinded, err := client.Blinded()
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := srv.CheckExposurePSI(token, &protov1.PSIRequest{Blinded: blinded})
+	if err != nil {
+		t.Fatal(err)
+	}
+	matches, err := client.Matches(res.Evaluated, res.Set)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(matches) != 1 || matches[0] != visited {
+		t.Errorf("invalid matches: %v", matches)
+	}
+
+	// Invalid points
+	if _, err := srv.CheckExposurePSI(token, &protov1.PSIRequest{Blinded: [][]byte{[]byte(visited)}}); err == nil {
+		t.Error("invalid point accepted")
+	}
+
+	// The secret is rotated when the index is refreshed
+	srv.exposed.updated = now.Add(-1 * exposureCellsTTL)
+	res2, err := srv.CheckExposurePSI(token, &protov1.PSIRequest{Blinded: blinded})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res2.Set) != 1 || res2.Set[0] == res.Set[0] {
+		t.Error("secret not rotated")
+	}
+	matches, err = client.Matches(res2.Evaluated, res2.Set)
+	if err != nil || len(matches) != 1 {
+		t.Errorf("invalid matches after rotation: %v", matches)
+	}
+
+	// Quota per DID
+	blinded = make([][]byte, maxExposurePrefixes)
+	for i := range blinded {
+		blinded[i] = res.Evaluated[0]
+	}
+	if _, err := srv.CheckExposurePSI(token, &protov1.PSIRequest{Blinded: blinded}); err != nil {
+		t.Error(err)
+	}
+	_, err = srv.CheckExposurePSI(token, &protov1.PSIRequest{Blinded: blinded})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("quota not enforced: %v", err)
+	}
+
+	// Only the configured mode is enabled
+	req := &protov1.CheckExposureRequest{Prefixes: []string{"abcd"}}
+	if _, err := srv.CheckExposure(userToken(t, "did:bryk:user"), req); err != errNotEnabled {
+		t.Error("tokens mode should be disabled")
+	}
+}
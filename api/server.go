@@ -2,13 +2,28 @@ package api
 
 import (
 	"context"
+	"crypto"
+	"crypto/tls"
 	"encoding/base64"
 	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/ThalesIgnite/crypto11"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	"github.com/skip2/go-qrcode"
+	"go.bryk.io/covid-tracking/attestation"
+	"go.bryk.io/covid-tracking/broker/local"
+	"go.bryk.io/covid-tracking/certificate"
+	"go.bryk.io/covid-tracking/fhir"
+	"go.bryk.io/covid-tracking/i18n"
 	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/covid-tracking/secrets"
 	"go.bryk.io/covid-tracking/storage"
 	"go.bryk.io/covid-tracking/utils"
 	"go.bryk.io/x/amqp"
@@ -21,17 +36,13 @@ import (
 	"golang.org/x/crypto/blake2b"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
-// Common error codes
-var (
-	errUnauthorized    = status.Error(codes.PermissionDenied, "unauthorized request")
-	errUnauthenticated = status.Error(codes.Unauthenticated, "invalid credentials")
-	errInvalidRequest  = status.Error(codes.InvalidArgument, "invalid request argument")
-	errInternalError   = status.Error(codes.Internal, "internal error")
-	errFailedToPublish = status.Error(codes.Unavailable, "failed to publish message")
-)
+// Default validity period for issued EU Digital COVID Certificates.
+const defaultCertificateValidity = 72 * time.Hour
+
+// Maximum number of activation codes generated per bulk request.
+const maxBulkCodes = 1000
 
 // ServerOptions provide the configuration settings available/required
 // when creating a new API server instance.
@@ -52,9 +63,169 @@ type ServerOptions struct {
 	// tasks and notifications.
 	Broker string
 
+	// TLS settings used to connect to "amqps" broker endpoints.
+	BrokerTLS *tls.Config
+
+	// Storage repositories used instead of connecting to 'Store', i.e. an
+	// embedded store shared with the worker on standalone instances.
+	Repositories storage.Repositories
+
+	// In-process broker used instead of connecting to 'Broker', to deliver
+	// tasks to a worker running on the same process.
+	LocalBroker *local.Broker
+
+	// Number of partitions for the tasks queue. Tasks are assigned to a
+	// partition based on the DID of their author, so tasks from the same
+	// user are processed in order. Must match the value used by the workers.
+	TaskShards int
+
 	// Supported DID methods.
 	Providers []*did.Provider
 
+	// Maximum period of time to keep data on storage. A zero value disables
+	// the retention policy.
+	Retention time.Duration
+
+	// Record ingestion mode, "broker" (default) to publish location and
+	// check-in records for the workers to store them, or "sync" to validate
+	// and store them while handling the request.
+	Ingestion string
+
+	// Tolerance for record timestamps ahead of the current time. Only used
+	// on synchronous ingestion mode.
+	ClockSkew time.Duration
+
+	// Records older than this period are rejected. If not provided, the
+	// retention period is used. Only used on synchronous ingestion mode.
+	MaxRecordAge time.Duration
+
+	// Maximum number of location and check-in records accepted per DID on
+	// each UTC day. A zero value disables the quota.
+	DailyRecordQuota int
+
+	// Maximum size, in bytes, of request messages. If not provided a default
+	// value of 256KB is used.
+	MaxMessageSize int
+
+	// Maximum number of location or check-in records per request. If not
+	// provided a default value of 100 is used.
+	MaxRecords int
+
+	// Minimum number of distinct users a query or aggregate result must
+	// cover to be returned. If not provided a default value of 10 is used.
+	MinAnonymitySet int
+
+	// Country of the health authority operating the platform, as an ISO
+	// 3166-1 alpha-2 code. Used when issuing health certificates.
+	Country string
+
+	// Validity period for issued EU Digital COVID Certificates. If not
+	// provided a default value of 72 hours is used.
+	CertificateValidity time.Duration
+
+	// Use keys stored on an HSM/PKCS#11 token as the server's signing key
+	// and root CA key. If not provided the "signing.pem" and "root-ca.pem"
+	// files on the home directory are used.
+	HSM *HSMConfig
+
+	// Signing algorithm for access credentials, "ES384" (default) or "EdDSA".
+	// EdDSA (Ed25519) produces smaller tokens and faster verification on
+	// constrained devices. The signing key type must match the algorithm.
+	TokenAlgorithm string
+
+	// External signer for access credentials, like a key stored on a cloud
+	// KMS. Takes precedence over the HSM settings.
+	Signer crypto.Signer
+
+	// Provider used to retrieve the TLS certificate, hash key and signing
+	// key. If not provided the files on the home directory are used.
+	Secrets secrets.Provider
+
+	// Period of time secrets are cached before being retrieved again from
+	// the provider, to detect rotated keys. If not provided a default value
+	// of 1 hour is used.
+	SecretsTTL time.Duration
+
+	// Peer servers whose access credentials are accepted, enabling users
+	// registered with them to submit records to this server.
+	TrustedIssuers []*TrustedIssuer
+
+	// Custom account roles, in addition to the built-in "user", "agent" and
+	// "admin" roles.
+	Roles []*Role
+
+	// Attribute-based restrictions on the permissions granted to the roles,
+	// like time of day, namespace or record ownership.
+	Conditions []*Condition
+
+	// Log the evaluation trace of denied authorization requests. Useful to
+	// debug the access policy, should not be enabled on production.
+	ExplainPolicy bool
+
+	// Log request and response payloads without redacting coordinates,
+	// identifiers and credentials. For development only.
+	UnsafeLogging bool
+
+	// Settings to serve diagnosed-case markers to peer servers. A nil value
+	// disables replication.
+	Replication *ReplicationConfig
+
+	// Require a device attestation statement to redeem "user" activation
+	// codes. Disabled if not provided.
+	Attestation *attestation.Config
+
+	// Thresholds used to reject location records while the storage or
+	// broker are saturated. If not provided the default values are used.
+	Admission *AdmissionConfig
+
+	// Domain included on the proofs of DID documents generated by the
+	// server. If not provided, the server name is used.
+	ProofDomain string
+
+	// Period of time the responses of read-only endpoints, exposed through
+	// the HTTP gateway, can be reused by clients and intermediary caches.
+	CacheRules []*CacheRule
+
+	// Serve the web dashboard for agents, at "/dashboard/", through the HTTP
+	// gateway of the main RPC server.
+	Dashboard bool
+
+	// IP addresses, or CIDR blocks, of the reverse proxies and load
+	// balancers allowed to report the original client address on the
+	// "X-Forwarded-For" and "X-Real-IP" headers.
+	TrustedProxies []string
+
+	// Restrict credential issuance and record submission to clients located
+	// within the deployment jurisdiction. A nil value disables the
+	// restrictions.
+	GeoRestriction *GeoRestriction
+
+	// Additional interceptors applied to all unary RPC calls, after the
+	// built-in ones. Useful to enforce deployment-specific requirements,
+	// like tenant headers.
+	Interceptors []grpc.UnaryServerInterceptor
+
+	// Additional verifications performed on all authenticated requests,
+	// after the credentials are validated. Useful to validate custom claims
+	// or restrict access based on the request metadata.
+	AuthChecks []AuthCheck
+
+	// How often clients should record and upload locations. If not provided
+	// the default values are used.
+	Sampling *SamplingConfig
+
+	// Register the records and audit entries stored on the tamper-evident
+	// ledger. Must be enabled on the workers as well, which seal and anchor
+	// the ledger; see 'WorkerOptions.Ledger'.
+	Ledger bool
+
+	// Protocol used for exposure self-checks, either "psi" (default) or
+	// "tokens". The "psi" mode uses private set intersection, so neither the
+	// client nor the server learns the locations visited by the other. The
+	// "tokens" mode has a lower computational cost, but the cell tokens are
+	// not keyed and can be enumerated; use it only for compatibility.
+	ExposureCheck string
+
 	// To handle output.
 	Logger xlog.Logger
 }
@@ -65,16 +236,58 @@ type Server struct {
 	name      string
 	ctx       context.Context
 	halt      context.CancelFunc
-	pub       *amqp.Publisher
+	pub       publisher
 	enf       *auth.Enforcer
+	roles     map[string]bool
+	rules     []string
+	explain   bool
+	rawLogs   bool
 	tls       *rpc.ServerTLSConfig
 	log       xlog.Logger
-	gw        *rpc.HTTPGateway
+	adminGw   *rpc.HTTPGateway
+	cache     *httpCache
+	dashboard bool
+	proxies   trustedProxies
+	geo       *geoPolicy
 	ca        *pki.CA
-	tg        *jwx.Generator
-	hk        []byte
-	store     *storage.Handler
+	hsmCA     *hsmCA
+	keys      *serverKeys
+	apiKeys   *apiKeySessions
+	hsm       *crypto11.Context
+	secrets   *secrets.Cache
+	ttl       time.Duration
+	alg       string
+	repos     storage.Repositories
 	providers []*did.Provider
+	privacy   *anonymityPolicy
+	issuer    *certificate.Issuer
+	validity  time.Duration
+	attest    *attestation.Service
+	maint     *maintenanceMode
+	revoked   *revocationList
+	peers     map[string][]crypto.PublicKey
+	repl      *ReplicationConfig
+	outbox    *outbox
+	quiesce   context.CancelFunc
+	delivered chan struct{}
+	pending   *requestTracker
+	inflight  *requestTracker
+	shards    int
+	limits    requestLimits
+	admission *admissionController
+	ingest    *ingester
+	window    recordWindow
+	sampling  SamplingConfig
+	exposed   *exposureCells
+	selfCheck string
+	custom    []grpc.UnaryServerInterceptor
+	checks    []AuthCheck
+	conds     []*accessCondition
+	quota     *ingestionQuota
+	domain    string
+	hooks     *Hooks
+	dial      func() (*amqp.Publisher, error)
+	pubMu     sync.RWMutex
 }
 
 // NewServer returns a new service handler instance.
@@ -84,63 +297,191 @@ func NewServer(opts *ServerOptions) (*Server, error) {
 		name:      opts.Name,
 		providers: opts.Providers,
 		log:       opts.Logger,
+		privacy:   &anonymityPolicy{k: defaultAnonymitySet},
+		validity:  defaultCertificateValidity,
+		ttl:       defaultSecretsTTL,
+		alg:       secrets.AlgES384,
+		apiKeys:   &apiKeySessions{list: make(map[string]*apiKeySession)},
+		maint:     &maintenanceMode{},
+		revoked:   &revocationList{},
+		repl:      opts.Replication,
+		outbox:    newOutbox(publishBufferSize),
+		inflight:  newRequestTracker(),
+		pending:   newRequestTracker(),
+		shards:    opts.TaskShards,
+		limits:    requestLimits{size: defaultMaxMessageSize, records: defaultMaxRecords},
+		admission: newAdmissionController(opts.Admission),
+		sampling:  newSamplingConfig(opts.Sampling),
+		exposed:   &exposureCells{},
+		selfCheck: exposureCheckPSI,
+		custom:    opts.Interceptors,
+		checks:    opts.AuthChecks,
+		domain:    opts.ProofDomain,
+		hooks:     newHooks(),
+	}
+	if srv.domain == "" {
+		srv.domain = opts.Name
+	}
+	if opts.MaxMessageSize > 0 {
+		srv.limits.size = opts.MaxMessageSize
+	}
+	if opts.MaxRecords > 0 {
+		srv.limits.records = opts.MaxRecords
+	}
+	if opts.MinAnonymitySet > 0 {
+		srv.privacy.k = int64(opts.MinAnonymitySet)
+	}
+	if opts.CertificateValidity > 0 {
+		srv.validity = opts.CertificateValidity
+	}
+	if opts.SecretsTTL > 0 {
+		srv.ttl = opts.SecretsTTL
+	}
+	if opts.TokenAlgorithm != "" {
+		if opts.TokenAlgorithm != secrets.AlgES384 && opts.TokenAlgorithm != secrets.AlgEdDSA {
+			return nil, errors.Errorf("unsupported token algorithm: %s", opts.TokenAlgorithm)
+		}
+		srv.alg = opts.TokenAlgorithm
+	}
+	if opts.ExposureCheck != "" {
+		if opts.ExposureCheck != exposureCheckTokens && opts.ExposureCheck != exposureCheckPSI {
+			return nil, errors.Errorf("unsupported exposure check mode: %s", opts.ExposureCheck)
+		}
+		srv.selfCheck = opts.ExposureCheck
+	}
+	if opts.Secrets != nil {
+		srv.secrets = secrets.NewCache(opts.Secrets, srv.ttl)
+	} else {
+		srv.secrets = secrets.NewCache(secrets.NewDirProvider(opts.Home), srv.ttl)
 	}
 
-	// Authorization enforcer
-	srv.enf, err = setupAuthEnforcer()
+	// Trusted peer issuers
+	srv.peers, err = loadTrustedIssuers(opts.Name, opts.TrustedIssuers)
 	if err != nil {
 		return nil, err
 	}
 
-	// Verify credentials
-	if err = verifyRootCA(opts.Home); err != nil {
-		return nil, err
+	// Device attestation
+	if opts.Attestation != nil {
+		srv.attest, err = attestation.New(opts.Attestation)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	// Load TLS settings
-	srv.tls, err = verifyTLSCertificate(opts.Home)
+	// Authorization enforcer
+	srv.roles, err = loadRoles(opts.Roles)
 	if err != nil {
 		return nil, err
 	}
-
-	// Setup PKI
-	srv.ca, err = setupPKI(opts.Home)
+	srv.enf, err = setupAuthEnforcer(opts.Roles)
+	if err != nil {
+		return nil, err
+	}
+	srv.conds, err = loadConditions(opts.Conditions, srv.roles)
 	if err != nil {
 		return nil, err
 	}
+	srv.rules = policyRules(accessPolicy(utils.AccessPolicy(), opts.Roles))
+	srv.explain = opts.ExplainPolicy
+	srv.rawLogs = opts.UnsafeLogging
 
-	// Get hash key
-	srv.hk, err = hashKey(opts.Home)
+	// HTTP gateway caching
+	srv.cache, err = newHTTPCache(opts.CacheRules)
 	if err != nil {
 		return nil, err
 	}
+	srv.dashboard = opts.Dashboard
 
-	// Setup token generator
-	srv.tg, err = setupTokenGenerator(opts.Name, opts.Home)
+	// Client address resolution
+	if srv.proxies, err = parseTrustedProxies(opts.TrustedProxies); err != nil {
+		return nil, err
+	}
+
+	// Geographic restrictions
+	if opts.GeoRestriction != nil {
+		if srv.geo, err = newGeoPolicy(opts.GeoRestriction); err != nil {
+			return nil, err
+		}
+	}
+
+	// Setup signing and hash keys
+	if err = srv.setupKeys(opts); err != nil {
+		return nil, err
+	}
+
+	// Load TLS settings
+	srv.tls, err = verifyTLSCertificate(srv.secrets)
 	if err != nil {
 		return nil, err
 	}
 
-	// Get storage handler
-	srv.store, err = storage.NewHandler(opts.Store)
+	// Setup health certificates issuer
+	srv.issuer, err = setupCertificateIssuer(opts.Name, opts.Country, opts.Home)
 	if err != nil {
 		return nil, err
 	}
 
-	// Setup message publisher
-	srv.pub, err = amqp.NewPublisher(opts.Broker, []amqp.Option{
-		amqp.WithTopology(utils.BrokerTopology()),
-		amqp.WithLogger(srv.log.Sub(xlog.Fields{
+	// Get storage handler
+	if srv.repos = opts.Repositories; srv.repos == nil {
+		storeOpts := []storage.Option{storage.WithRetention(opts.Retention)}
+		if opts.Ledger {
+			storeOpts = append(storeOpts, storage.WithLedger())
+		}
+		store, err := storage.NewHandler(opts.Store, storeOpts...)
+		if err != nil {
+			return nil, err
+		}
+		if pending, err := store.PendingMigrations(); err == nil && pending > 0 {
+			srv.log.WithField("pending", pending).Warning("storage schema is outdated, run 'ct19 migrate up'")
+		}
+		srv.repos = store
+	}
+	for _, r := range opts.Roles {
+		if err := srv.repos.Codes().RoleCodes(r.Name); err != nil {
+			return nil, err
+		}
+	}
+
+	// Records are stored directly on synchronous ingestion mode
+	srv.quota = &ingestionQuota{counters: srv.repos.Quotas(), limit: opts.DailyRecordQuota}
+	srv.window = newRecordWindow(opts.ClockSkew, opts.MaxRecordAge, opts.Retention)
+	switch opts.Ingestion {
+	case "", IngestBroker:
+	case IngestSync:
+		srv.ingest = &ingester{
+			log:       srv.log,
+			repos:     srv.repos,
+			providers: opts.Providers,
+			window:    srv.window,
+			quota:     srv.quota,
+		}
+	default:
+		return nil, errors.Errorf("unsupported ingestion mode: %s", opts.Ingestion)
+	}
+
+	// Setup message publisher. The in-process broker can't be reconnected
+	// once closed.
+	srv.dial = func() (*amqp.Publisher, error) {
+		return amqp.NewPublisher(opts.Broker, brokerOptions(srv.log.Sub(xlog.Fields{
 			"component": "amqp",
-		})),
-	}...)
-	if err != nil {
+		}), opts.BrokerTLS, opts.TaskShards)...)
+	}
+	if opts.LocalBroker != nil {
+		srv.pub = opts.LocalBroker
+		srv.dial = func() (*amqp.Publisher, error) {
+			return nil, errors.New("in-process broker closed")
+		}
+	} else if srv.pub, err = srv.dial(); err != nil {
 		return nil, err
 	}
 
 	// All good!
+	srv.refreshMaintenance()
+	srv.refreshRevocations()
 	srv.ctx, srv.halt = context.WithCancel(context.Background())
 	go srv.eventLoop()
+	srv.startDelivery()
 	return srv, nil
 }
 
@@ -148,8 +489,12 @@ func NewServer(opts *ServerOptions) (*Server, error) {
 func (srv *Server) Close() {
 	srv.halt()
 	<-srv.ctx.Done()
-	_ = srv.pub.Close()
-	srv.store.Close()
+	srv.stopDelivery()
+	_ = srv.publisher().Close()
+	srv.repos.Close()
+	if srv.hsm != nil {
+		_ = srv.hsm.Close()
+	}
 }
 
 // GetServiceDefinition allows to expose the handler instance through an RPC server.
@@ -162,79 +507,334 @@ func (srv *Server) GetServiceDefinition() *rpc.Service {
 	}
 }
 
+// GetAdminServiceDefinition allows to expose the administrative operations
+// through an RPC server. The admin service can be registered on the same RPC
+// server as the tracking API or on a separate one.
+func (srv *Server) GetAdminServiceDefinition() *rpc.Service {
+	return &rpc.Service{
+		GatewaySetup: protov1.RegisterAdminAPIHandlerFromEndpoint,
+		ServerSetup: func(server *grpc.Server) {
+			protov1.RegisterAdminAPIServer(server, &adminInterface{srv: srv})
+		},
+	}
+}
+
 // TLSConfig return the TLS settings to setup secure communications with the handler
 // instance when exposed as an RPC server.
 func (srv *Server) TLSConfig() rpc.ServerTLSConfig {
 	return *srv.tls
 }
 
-// HTTPGateway allow HTTPS access to the handler instance.
+// HTTPGateway allow HTTPS access to the handler instance. A new gateway is
+// returned on every call, since each RPC server requires its own instance.
 func (srv *Server) HTTPGateway(port int) (*rpc.HTTPGateway, error) {
-	if srv.gw == nil {
+	if !srv.dashboard {
+		return setupHTTPGateway(port, func(next http.Handler) http.Handler {
+			return srv.trajectoryHandler(srv.cache.handler(next))
+		})
+	}
+	return setupHTTPGateway(port, func(next http.Handler) http.Handler {
+		return dashboardHandler(srv.trajectoryHandler(srv.cache.handler(next)))
+	})
+}
+
+// AdminHTTPGateway allow HTTPS access to the administrative operations when
+// exposed on a separate RPC server.
+func (srv *Server) AdminHTTPGateway(port int) (*rpc.HTTPGateway, error) {
+	if srv.adminGw == nil {
 		var err error
-		srv.gw, err = setupHTTPGateway(port)
+		srv.adminGw, err = setupHTTPGateway(port, srv.cache.handler)
 		if err != nil {
 			return nil, err
 		}
 	}
-	return srv.gw, nil
+	return srv.adminGw, nil
 }
 
 // ActivationCode returns a new activation code for the provided request.
 func (srv *Server) ActivationCode(req *protov1.ActivationCodeRequest) (string, error) {
 	if _, err := did.Parse(req.Did); err != nil {
-		return "", errInvalidRequest
+		return "", errInvalidDID
+	}
+	return srv.repos.Codes().ActivationCode(req)
+}
+
+// BulkActivationCodes returns a batch of "user" activation codes for a
+// registration campaign.
+func (srv *Server) BulkActivationCodes(
+	req *protov1.BulkActivationCodesRequest) (*protov1.BulkActivationCodesResponse, error) {
+	if req.Count == 0 || req.Count > maxBulkCodes {
+		return nil, invalidArgument("count", fmt.Sprintf("between 1 and %d codes per request are supported", maxBulkCodes))
+	}
+	codes, expires, err := srv.repos.Codes().CampaignCodes(req.Campaign, req.Scope, int(req.Count))
+	if err != nil {
+		return nil, errInternalError
+	}
+	res := &protov1.BulkActivationCodesResponse{}
+	for _, code := range codes {
+		cc := &protov1.CampaignCode{
+			ActivationCode: code,
+			Campaign:       req.Campaign,
+			QrCode:         fmt.Sprintf("ct19:activation:%s", code),
+			Expires:        expires.Unix(),
+		}
+		if req.QrImages {
+			if cc.QrImage, err = qrcode.Encode(cc.QrCode, qrcode.Medium, 256); err != nil {
+				return nil, errInternalError
+			}
+		}
+		res.Codes = append(res.Codes, cc)
 	}
-	return srv.store.ActivationCode(req)
+	return res, nil
 }
 
 // AccessToken process an incoming credentials request.
 func (srv *Server) AccessToken(req *protov1.CredentialsRequest,
 	validateCode bool) (*protov1.CredentialsResponse, error) {
 	// Retrieve DID instance
-	identifier, err := utils.ResolveDID(req.Did, srv.providers)
+	identifier, err := resolveDID(req.Did, srv.providers)
 	if err != nil {
-		return nil, errors.Wrap(err, "resolve DID")
+		return nil, errInvalidDID
 	}
 
 	// Verify registration proof
 	if err := utils.VerifySignature(identifier, []byte(req.ActivationCode), req.Proof); err != nil {
-		return nil, errors.Wrap(err, "invalid signature")
+		return nil, errInvalidSignature
+	}
+
+	// Reject replayed proofs
+	fresh, err := freshProof(srv.repos.Nonces(), req.Did, req.Proof, time.Now().Add(activationNonceTTL))
+	if err != nil {
+		srv.log.WithField("error", err.Error()).Error("failed to register proof nonce")
+		return nil, errInternalError
+	}
+	if !fresh {
+		return nil, errReplayedProof
+	}
+
+	// Verify the request originates from a genuine app installation
+	if validateCode && req.Role == "user" && srv.attest != nil {
+		if err := srv.verifyAttestation(req); err != nil {
+			srv.log.WithFields(xlog.Fields{
+				"did":   req.Did,
+				"error": err.Error(),
+			}).Warning("device attestation failed")
+			return nil, errInvalidAttestation
+		}
 	}
 
-	// Validate activation code
+	// Validate activation code, the scope assigned to the code (if any)
+	// takes precedence over the one requested
+	scope := req.Scope
 	if validateCode {
-		if !srv.store.VerifyActivationCode(req) {
-			return nil, errInvalidRequest
+		codeScope, ok := srv.repos.Codes().VerifyActivationCode(req)
+		if !ok {
+			return nil, errInvalidActivationCode
+		}
+		if len(codeScope) > 0 {
+			scope = codeScope
 		}
 	}
 
+	// Register preferred language
+	lang := i18n.Negotiate(req.Lang)
+	if err := srv.repos.Preferences().SetLanguage(req.Did, lang); err != nil {
+		srv.log.WithField("did", req.Did).Warning("failed to save language preference")
+	}
+
 	// Request is valid, return credentials result.
-	return srv.getToken(req.Did, req.Role)
+	return srv.getToken(req.Did, req.Role, lang, scope, "")
+}
+
+// Verify the device attestation statement included in a credentials request.
+func (srv *Server) verifyAttestation(req *protov1.CredentialsRequest) error {
+	if req.Attestation == nil {
+		return errors.New("missing attestation statement")
+	}
+	nonce := attestation.Nonce(req.Did, req.ActivationCode)
+	return srv.attest.Verify(req.Attestation.Platform, req.Attestation.Token, nonce)
 }
 
 // RenewToken will refresh a valid but expired access token.
 func (srv *Server) RenewToken(token *jwx.Token, refreshCode string) (*protov1.CredentialsResponse, error) {
-	// Validate refresh code
-	cc := srv.getRefreshCode(token.String())
-	if cc == "" || cc != refreshCode {
-		return nil, errInvalidRequest
+	// Validate refresh code, codes produced with the hash key in use
+	// before the last rotation are also accepted
+	valid := false
+	for _, hk := range srv.keys.hashKeys() {
+		if cc := srv.getRefreshCode(hk, token.String()); cc != "" && cc == refreshCode {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		return nil, errInvalidRefreshCode
+	}
+
+	// Credentials revoked or already renewed can't be renewed, even after
+	// expiring; unless revoked due to a role change, where only the new role
+	// is issued
+	claims := &tokenClaims{}
+	if err := token.Decode(claims); err != nil {
+		return nil, errUnauthenticated
+	}
+	revoked, role, err := srv.repos.AccessTokens().SessionRevoked(claims.ID)
+	if err != nil {
+		return nil, errInternalError
+	}
+	if revoked && role == "" {
+		return nil, errRevokedCredentials
+	}
+	var res *protov1.CredentialsResponse
+	if role != "" {
+		res, err = srv.getToken(claims.DID, role, i18n.Negotiate(claims.Lang), nil, claims.ID)
+	} else {
+		// Create new token using claims present in the expired version.
+		res, err = srv.getToken(claims.DID, claims.Role, i18n.Negotiate(claims.Lang), claims.Scope, claims.ID)
+	}
+
+	// Tokens can be renewed only once
+	if err == storage.ErrSessionRenewed {
+		return nil, errRevokedCredentials
+	}
+	return res, err
+}
+
+// LocationRecord receive and process incoming location update events.
+// nolint: interfacer
+func (srv *Server) LocationRecord(ctx context.Context, token *jwx.Token,
+	req *protov1.RecordRequest) (*protov1.RecordResponse, error) {
+	// Maximum number of records per-request
+	if len(req.Records) > srv.limits.records {
+		return nil, invalidArgument("records",
+			fmt.Sprintf("a maximum of %d records per request is supported", srv.limits.records))
+	}
+	if err := validateLocations(req.Records); err != nil {
+		return nil, err
+	}
+	if err := validateClient(req); err != nil {
+		return nil, err
+	}
+
+	// Get DID for the credential's subject
+	data := &credentialsData{}
+	if err := token.Decode(&data); err != nil {
+		return nil, errUnauthenticated
+	}
+
+	// Daily quota
+	if err := srv.quota.check(data.DID, len(req.Records)); err != nil {
+		return nil, err
+	}
+
+	// Reject requests while the storage or broker are saturated
+	done, err := srv.admit(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	// Store records directly on synchronous ingestion mode
+	if srv.ingest != nil {
+		res, err := srv.ingest.locations(data.DID, req)
+		if err != nil {
+			srv.log.WithField("error", err.Error()).Error("failed to process record")
+			return nil, errInternalError
+		}
+		srv.event(eventRecordStored, data.DID, map[string]string{
+			"records": fmt.Sprintf("%d", res.accepted()),
+		})
+		return &protov1.RecordResponse{Ok: true, Records: recordStatus(req.Records, res, recordAccepted)}, nil
+	}
+
+	// Records failing the stateless checks are reported right away, the rest
+	// are validated and stored by the workers
+	res := make(ingestResult, len(req.Records))
+	task := &protov1.RecordRequest{
+		AppVersion: req.AppVersion,
+		SdkVersion: req.SdkVersion,
+		Platform:   req.Platform,
+	}
+	for i, r := range req.Records {
+		if res[i] = validateRecord(data.DID, r, srv.window); res[i] == "" {
+			task.Records = append(task.Records, r)
+		}
+	}
+	status := recordStatus(req.Records, res, recordPending)
+	if len(task.Records) == 0 {
+		return &protov1.RecordResponse{Ok: true, Records: status}, nil
+	}
+
+	// Register the submission, the message identifier is used as receipt
+	// for the client to retrieve the final status of its records
+	id := uuid.New().String()
+	receipt := id
+	if err := srv.repos.Submissions().RegisterSubmission(id, data.DID, status); err != nil {
+		srv.log.WithField("error", err.Error()).Warning("failed to register submission")
+		receipt = ""
+	}
+
+	// Publish message
+	contents, err := task.Marshal()
+	if err != nil {
+		return nil, errInternalError
 	}
+	ok, err := srv.submitTaskID(ctx, id, "ct19.location_record", contents, data.DID)
+	if err != nil {
+		return nil, errFailedToPublish
+	}
+	return &protov1.RecordResponse{Ok: ok, Records: status, Receipt: receipt}, nil
+}
 
-	// Create new token using claims present in the expired version.
+// SubmissionStatus returns the processing status of location records
+// submitted on broker ingestion mode.
+// nolint: interfacer
+func (srv *Server) SubmissionStatus(token *jwx.Token,
+	req *protov1.SubmissionStatusRequest) (*protov1.SubmissionStatusResponse, error) {
+	if req.Receipt == "" {
+		return nil, invalidArgument("receipt", "receipt is required")
+	}
 	data := &credentialsData{}
 	if err := token.Decode(&data); err != nil {
 		return nil, errUnauthenticated
 	}
-	return srv.getToken(data.DID, data.Role)
+	res, err := srv.repos.Submissions().Submission(data.DID, req.Receipt)
+	if err != nil {
+		return nil, notFound("submission")
+	}
+	return res, nil
 }
 
-// LocationRecord receive and process incoming location update events.
+// RegisterVenue adds a new venue where users can check-in.
+// nolint: interfacer
+func (srv *Server) RegisterVenue(token *jwx.Token, req *protov1.RegisterVenueRequest) (*protov1.Venue, error) {
+	if req.Name == "" {
+		return nil, invalidArgument("name", "venue name is required")
+	}
+	if !validCoordinates(req.Lat, req.Lng) {
+		return nil, invalidArgument("lat", "latitude or longitude out of range")
+	}
+	data := &credentialsData{}
+	if err := token.Decode(&data); err != nil {
+		return nil, errUnauthenticated
+	}
+	if !locationInJurisdiction(srv.organization(data), float64(req.Lat), float64(req.Lng)) {
+		return nil, invalidArgument("lat", "venue location is outside your organization's jurisdiction")
+	}
+	venue, err := srv.repos.Venues().RegisterVenue(data.DID, req)
+	if err != nil {
+		return nil, errInternalError
+	}
+	return venue, nil
+}
+
+// CheckIn receive and process incoming venue visit events.
 // nolint: interfacer
-func (srv *Server) LocationRecord(token *jwx.Token, req *protov1.RecordRequest) (*protov1.RecordResponse, error) {
-	// Maximum of 100 records per-request
-	if len(req.Records) > 100 {
-		return nil, errInvalidRequest
+func (srv *Server) CheckIn(ctx context.Context, token *jwx.Token,
+	req *protov1.CheckInRequest) (*protov1.CheckInResponse, error) {
+	// Maximum number of records per-request
+	if len(req.Records) == 0 || len(req.Records) > srv.limits.records {
+		return nil, invalidArgument("records",
+			fmt.Sprintf("between 1 and %d records per request are supported", srv.limits.records))
 	}
 
 	// Get DID for the credential's subject
@@ -243,50 +843,82 @@ func (srv *Server) LocationRecord(token *jwx.Token, req *protov1.RecordRequest)
 		return nil, errUnauthenticated
 	}
 
+	// Daily quota
+	if err := srv.quota.check(data.DID, len(req.Records)); err != nil {
+		return nil, err
+	}
+
+	// Store records directly on synchronous ingestion mode
+	if srv.ingest != nil {
+		if _, err := srv.ingest.checkIns(data.DID, req); err != nil {
+			srv.log.WithField("error", err.Error()).Error("failed to process check-in")
+			return nil, errInternalError
+		}
+		return &protov1.CheckInResponse{Ok: true}, nil
+	}
+
 	// Publish message
 	contents, err := req.Marshal()
 	if err != nil {
-		return nil, errInvalidRequest
+		return nil, errInternalError
 	}
-	msg := amqp.Message{
-		Type:        "ct19.location_record",
-		Timestamp:   time.Now().UTC(),
-		MessageId:   uuid.New().String(),
-		ContentType: "application/protobuf",
-		Body:        contents,
-		Headers: map[string]interface{}{
-			"did": data.DID,
-		},
+	res, err := srv.submitTask(ctx, "ct19.check_in", contents, data.DID)
+	if err != nil {
+		return nil, errFailedToPublish
 	}
-	res, err := srv.pub.Push(msg, amqp.MessageOptions{
-		Exchange:   "tasks",
-		Persistent: true,
-	})
+	return &protov1.CheckInResponse{Ok: res}, nil
+}
+
+// VenueOutbreak links a venue to a confirmed case. All users that checked-in at
+// the venue during the exposure window will be notified asynchronously.
+// nolint: interfacer
+func (srv *Server) VenueOutbreak(ctx context.Context, token *jwx.Token,
+	req *protov1.VenueOutbreakRequest) (*protov1.VenueOutbreakResponse, error) {
+	// Validate exposure window
+	if req.From == 0 || req.To < req.From || req.To > time.Now().Unix() {
+		return nil, invalidArgument("from", "invalid exposure window")
+	}
+	venue, err := srv.repos.Venues().Venue(req.Venue)
+	if err != nil {
+		return nil, notFound("venue")
+	}
+
+	// Get DID for the credential's subject
+	data := &credentialsData{}
+	if err := token.Decode(&data); err != nil {
+		return nil, errUnauthenticated
+	}
+
+	// Agents can only report outbreaks within their organization's jurisdiction
+	if !locationInJurisdiction(srv.organization(data), float64(venue.Lat), float64(venue.Lng)) {
+		return nil, errUnauthorized
+	}
+
+	// Publish message
+	contents, err := req.Marshal()
+	if err != nil {
+		return nil, errInternalError
+	}
+	res, err := srv.submitTask(ctx, "ct19.venue_outbreak", contents, data.DID)
 	if err != nil {
 		return nil, errFailedToPublish
 	}
-	return &protov1.RecordResponse{Ok: res}, nil
+	return &protov1.VenueOutbreakResponse{Ok: res}, nil
 }
 
 // NewIdentifier provides a helper method to generate a new DID instances for
 // clients that can't generate it locally. This is not recommended but supported
 // for legacy and development purposes. This method does not require authentication.
-func (srv *Server) NewIdentifier(req *protov1.NewIdentifierRequest) (*protov1.NewIdentifierResponse, error) {
+func (srv *Server) NewIdentifier(ctx context.Context,
+	req *protov1.NewIdentifierRequest) (*protov1.NewIdentifierResponse, error) {
 	// Validate parameters
 	if req.Method == "" {
-		return nil, errInvalidRequest
+		return nil, invalidArgument("method", "DID method is required")
 	}
 
 	// New DID instance
-	var err error
-	id, _ := did.NewIdentifierWithMode(req.Method, "", did.ModeUUID)
-	if err = id.AddNewKey("master", did.KeyTypeEd, did.EncodingBase58); err != nil {
-		return nil, errInternalError
-	}
-	if err = id.AddAuthenticationKey("master"); err != nil {
-		return nil, errInternalError
-	}
-	if err = id.AddProof("master", "sample-ct19.iadb.org"); err != nil {
+	id, err := newIdentifier(req.Method, srv.domain)
+	if err != nil {
 		return nil, errInternalError
 	}
 
@@ -302,9 +934,13 @@ func (srv *Server) NewIdentifier(req *protov1.NewIdentifierRequest) (*protov1.Ne
 			MessageId:   uuid.New().String(),
 			ContentType: "application/json",
 			Body:        js,
+			Headers: map[string]interface{}{
+				"request_id": requestID(ctx),
+			},
 		}
-		_, err := srv.pub.Push(msg, amqp.MessageOptions{
+		_, err := srv.publish(msg, amqp.MessageOptions{
 			Exchange:   "tasks",
+			RoutingKey: utils.TaskRoutingKey(id.String(), srv.shards),
 			Persistent: true,
 		})
 		if err != nil {
@@ -314,35 +950,200 @@ func (srv *Server) NewIdentifier(req *protov1.NewIdentifierRequest) (*protov1.Ne
 	return &protov1.NewIdentifierResponse{Document: contents}, nil
 }
 
+// Analytics returns the anonymized aggregates available for the requested
+// period of time. Results for agents are limited to their organization's
+// jurisdiction. Queries, and the processing purpose declared, are registered
+// on the audit log.
+// nolint: interfacer
+func (srv *Server) Analytics(ctx context.Context, token *jwx.Token,
+	req *protov1.AnalyticsRequest) (*protov1.AnalyticsResponse, error) {
+	if req.From == 0 || req.To < req.From {
+		return nil, invalidArgument("from", "invalid time range")
+	}
+	if err := declaredPurpose(req.Purpose, storage.AggregatePurposes); err != nil {
+		return nil, err
+	}
+	fields, err := parseFieldMask(req.Fields, &protov1.AnalyticsResponse{})
+	if err != nil {
+		return nil, invalidArgument("fields", err.Error())
+	}
+	hotspots, flows, err := srv.repos.Aggregates().Analytics(time.Unix(req.From, 0), time.Unix(req.To, 0))
+	if err != nil {
+		return nil, errInternalError
+	}
+	data := &credentialsData{}
+	if err := token.Decode(&data); err != nil {
+		return nil, errUnauthenticated
+	}
+	res := filterAnalytics(srv.organization(data), &protov1.AnalyticsResponse{
+		Hotspots: srv.privacy.hotspots(hotspots),
+		Flows:    srv.privacy.flows(flows),
+	})
+	fields.apply(res)
+	srv.audit(&storage.AuditEntry{
+		Event:   auditAnalyticsQuery,
+		Actor:   data.DID,
+		Address: clientAddress(ctx),
+		Details: map[string]string{
+			"from":    strconv.FormatInt(req.From, 10),
+			"to":      strconv.FormatInt(req.To, 10),
+			"purpose": req.Purpose,
+		},
+	})
+	return res, nil
+}
+
+// LabResult receive HL7 FHIR resources generated by laboratory systems. Valid
+// resources are processed asynchronously as diagnosis events.
+// nolint: interfacer
+func (srv *Server) LabResult(ctx context.Context, token *jwx.Token,
+	req *protov1.LabResultRequest) (*protov1.LabResultResponse, error) {
+	// Ensure resource is valid
+	if _, err := fhir.Decode(req.Resource); err != nil {
+		return nil, invalidArgument("resource", err.Error())
+	}
+
+	// Get DID for the credential's subject
+	data := &credentialsData{}
+	if err := token.Decode(&data); err != nil {
+		return nil, errUnauthenticated
+	}
+
+	// Publish message
+	msg := amqp.Message{
+		Type:        "ct19.lab_result",
+		Timestamp:   time.Now().UTC(),
+		MessageId:   uuid.New().String(),
+		ContentType: "application/fhir+json",
+		Body:        req.Resource,
+		Headers: map[string]interface{}{
+			"did":        data.DID,
+			"request_id": requestID(ctx),
+		},
+	}
+	res, err := srv.publish(msg, amqp.MessageOptions{
+		Exchange:   "fhir",
+		Persistent: true,
+	})
+	if err != nil {
+		return nil, errFailedToPublish
+	}
+	return &protov1.LabResultResponse{Ok: res}, nil
+}
+
+// Certificate issues a verifiable health credential for one of the test
+// results of the user.
+// nolint: interfacer
+func (srv *Server) Certificate(token *jwx.Token,
+	req *protov1.CertificateRequest) (*protov1.CertificateResponse, error) {
+	if srv.issuer == nil {
+		return nil, errNotEnabled
+	}
+
+	// Get DID for the credential's subject
+	data := &credentialsData{}
+	if err := token.Decode(&data); err != nil {
+		return nil, errUnauthenticated
+	}
+
+	// Users can only retrieve certificates for their own results
+	d, err := srv.repos.Exposures().FindDiagnosis(req.Diagnosis)
+	if err != nil || d.Did != data.DID {
+		return nil, notFound("diagnosis")
+	}
+	rec := &certificate.Record{
+		ID:       d.Id,
+		Subject:  d.Did,
+		Kind:     certificate.Test,
+		Result:   d.Result,
+		Date:     time.Unix(d.Timestamp, 0),
+		Facility: d.Source,
+	}
+
+	// Encode credential
+	res := &protov1.CertificateResponse{Format: req.Format}
+	switch req.Format {
+	case "shc":
+		res.Credential, err = certificate.SmartHealthCard(rec, srv.issuer)
+		res.Qr = certificate.SmartHealthCardQR(res.Credential)
+	case "dcc":
+		res.Credential, err = certificate.DigitalCovidCertificate(rec, srv.issuer, srv.validity)
+		res.Qr = res.Credential
+	default:
+		return nil, invalidArgument("format", "supported formats are 'shc' and 'dcc'")
+	}
+	if err != nil {
+		return nil, invalidArgument("diagnosis", err.Error())
+	}
+	srv.event(eventCertificateIssued, d.Did, map[string]string{
+		"diagnosis": d.Id,
+		"format":    req.Format,
+	})
+	return res, nil
+}
+
+// Publish a protobuf-encoded task for asynchronous processing by the workers.
+// 'author' is the identifier of the user submitting the task.
+func (srv *Server) submitTask(ctx context.Context, kind string, contents []byte, author string) (bool, error) {
+	return srv.submitTaskID(ctx, uuid.New().String(), kind, contents, author)
+}
+
+// Same as 'submitTask', using 'id' as the message identifier.
+func (srv *Server) submitTaskID(ctx context.Context, id, kind string, contents []byte, author string) (bool, error) {
+	msg := amqp.Message{
+		Type:        kind,
+		Timestamp:   time.Now().UTC(),
+		MessageId:   id,
+		ContentType: "application/protobuf",
+		Body:        contents,
+		Headers: map[string]interface{}{
+			"did":        author,
+			"request_id": requestID(ctx),
+		},
+	}
+	return srv.publish(msg, amqp.MessageOptions{
+		Exchange:   "tasks",
+		RoutingKey: utils.TaskRoutingKey(author, srv.shards),
+		Persistent: true,
+	})
+}
+
 // Generate bearer token and refresh code.
-func (srv *Server) getToken(id, role string) (*protov1.CredentialsResponse, error) {
+func (srv *Server) getToken(id, role string, lang i18n.Language,
+	scope []string, previous string) (*protov1.CredentialsResponse, error) {
 	// Get access token
 	params := &jwx.TokenParameters{
 		Audience:   []string{srv.name},
 		Subject:    id,
-		Method:     jwx.ES384,
+		Method:     srv.keys.method(),
 		NotBefore:  "0ms",
 		Expiration: "168h", // 1 week by default
 		CustomPayloadClaims: &credentialsData{
-			DID:  id,
-			Role: role,
+			DID:   id,
+			Role:  role,
+			Lang:  string(lang),
+			Scope: scope,
 		},
 	}
-	token, err := srv.tg.NewToken("master", params)
+	token, err := srv.keys.generator().NewToken("master", params)
 	if err != nil {
 		return nil, err
 	}
+	if err := srv.openSession(token, previous); err != nil {
+		return nil, err
+	}
+	srv.event(eventCredentialIssued, id, map[string]string{"role": role})
 
 	// Return result
 	return &protov1.CredentialsResponse{
 		AccessToken: token.String(),
-		RefreshCode: srv.getRefreshCode(token.String()),
+		RefreshCode: srv.getRefreshCode(srv.keys.hashKeys()[0], token.String()),
 	}, nil
 }
 
 // Refresh codes are base64-encoded authenticated hashes for generated credentials.
-func (srv *Server) getRefreshCode(seed string) string {
-	h, err := blake2b.New256(srv.hk)
+func (srv *Server) getRefreshCode(hk []byte, seed string) string {
+	h, err := blake2b.New256(hk)
 	if err != nil {
 		return ""
 	}
@@ -355,15 +1156,63 @@ func (srv *Server) getRefreshCode(seed string) string {
 }
 
 // Handle authentication for requests that require it. Authentication is based on
-// "bearer" JWT credentials.
+// "bearer" JWT credentials. The additional checks registered are performed once
+// the credentials are validated.
 func (srv *Server) authenticate(ctx context.Context, checkExpiration bool) (*jwx.Token, error) {
+	token, err := srv.credentials(ctx, checkExpiration)
+	if err != nil {
+		return nil, err
+	}
+	if err := srv.runAuthChecks(ctx, token); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+// Retrieve and validate the credentials provided for a request.
+func (srv *Server) credentials(ctx context.Context, checkExpiration bool) (*jwx.Token, error) {
+	// Backend integrations can use API keys instead
+	if key, ok := getAPIKeyFromContext(ctx); ok {
+		return srv.authenticateAPIKey(key)
+	}
+
 	// Retrieve credentials
 	token, err := getTokenFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	// Credentials issued by a trusted peer server
+	claims := &tokenClaims{}
+	if err := token.Decode(claims); err == nil && claims.Issuer != srv.name {
+		if keys, ok := srv.peers[claims.Issuer]; ok {
+			audiences := []string{srv.name, claims.Issuer}
+			if err := validatePeerToken(token, claims, keys, audiences, checkExpiration); err != nil {
+				return nil, newError(codes.Unauthenticated, protov1.ErrorCode_ERROR_CODE_UNAUTHENTICATED, err.Error())
+			}
+			return token, nil
+		}
+	}
+
 	// Validate token
+	if err := srv.validateToken(token, checkExpiration); err != nil {
+		return nil, newError(codes.Unauthenticated, protov1.ErrorCode_ERROR_CODE_UNAUTHENTICATED, err.Error())
+	}
+	// Tokens revoked are verified again when renewing them, as those revoked
+	// due to a role change can still be renewed
+	if checkExpiration && srv.revoked.has(claims.ID) {
+		return nil, errRevokedCredentials
+	}
+	return token, nil
+}
+
+// Verify the signature, issuer, audience and validity period of a token.
+// Tokens must be signed with the current signing key or, after a rotation,
+// the previous one.
+func (srv *Server) validateToken(token *jwx.Token, checkExpiration bool) error {
+	if err := verifyTokenSignature(token.String(), srv.keys.publicKeys()); err != nil {
+		return err
+	}
 	now := time.Now()
 	checks := []jwx.ValidatorFunc{
 		jwx.IssuerValidator(srv.name),
@@ -374,40 +1223,59 @@ func (srv *Server) authenticate(ctx context.Context, checkExpiration bool) (*jwx
 	if checkExpiration {
 		checks = append(checks, jwx.ExpirationTimeValidator(now, true))
 	}
-	if err := token.Validate(checks...); err != nil {
-		return nil, status.Error(codes.Unauthenticated, err.Error())
-	}
-	return token, nil
+	return token.Validate(checks...)
 }
 
 // Handle authorization requests based on the platform's access policy.
 // nolint: interfacer
 func (srv *Server) authorize(token *jwx.Token, resource string, action string) bool {
+	return srv.authorizeFor(token, resource, action, nil)
+}
+
+// Verify the provided credentials are allowed to perform the requested action
+// on a specific resource. The resource attributes are used to evaluate the
+// access conditions, if any.
+func (srv *Server) authorizeFor(token *jwx.Token, resource, action string, attrs *resourceAttrs) bool {
 	data := &credentialsData{}
 	if err := token.Decode(&data); err != nil {
 		return false
 	}
-	return srv.enf.Evaluate(auth.Request{
-		Subject:  data.Role,
-		Resource: resource,
-		Action:   action,
-	})
+	d := srv.evaluate(data, resource, action, attrs, srv.explain)
+	if !d.Allowed && srv.explain {
+		srv.log.WithFields(xlog.Fields{
+			"did":      data.DID,
+			"role":     data.Role,
+			"resource": resource,
+			"action":   action,
+			"rule":     d.Rule,
+			"trace":    strings.Join(d.Trace, "; "),
+		}).Debug("authorization denied")
+	}
+	return d.Allowed
 }
 
 // Internal event processing.
 func (srv *Server) eventLoop() {
+	rotation := time.NewTicker(srv.ttl)
+	defer rotation.Stop()
+	maintenance := time.NewTicker(maintenanceRefresh)
+	defer maintenance.Stop()
+	revocations := time.NewTicker(revocationsRefresh)
+	defer revocations.Stop()
+	probe := time.NewTicker(admissionProbe)
+	defer probe.Stop()
 	for {
 		select {
 		case <-srv.ctx.Done():
 			return
-		case msg, ok := <-srv.pub.MessageReturns():
-			if !ok {
-				return
-			}
-			srv.log.WithFields(xlog.Fields{
-				"id":    msg.MessageId,
-				"stamp": msg.Timestamp,
-			}).Warning("message returned by the broker")
+		case <-rotation.C:
+			srv.rotateKeys()
+		case <-maintenance.C:
+			srv.refreshMaintenance()
+		case <-revocations.C:
+			srv.refreshRevocations()
+		case <-probe.C:
+			srv.probeStorage()
 		}
 	}
 }
@@ -0,0 +1,200 @@
+package api
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"go.bryk.io/covid-tracking/broker/memtest"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/covid-tracking/storage"
+	storetest "go.bryk.io/covid-tracking/storage/memtest"
+	"go.bryk.io/x/ccg/did"
+	"go.bryk.io/x/jwx"
+	xlog "go.bryk.io/x/log"
+	"golang.org/x/crypto/sha3"
+)
+
+// Server instance backed by the in-memory storage and broker doubles, with
+// the same defaults as 'NewServer'. Background loops are not started.
+func testServer(t *testing.T) (*Server, *storetest.Store, *memtest.Publisher) {
+	key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tg, err := newSignerGenerator("ct19.test", key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := storetest.New()
+	pub := memtest.NewPublisher()
+	srv := &Server{
+		name:      "ct19.test",
+		log:       xlog.WithZero(false),
+		repos:     store,
+		pub:       pub,
+		keys:      &serverKeys{tg: tg, hk: []byte("hash-key"), pub: []crypto.PublicKey{key.Public()}},
+		privacy:   &anonymityPolicy{k: defaultAnonymitySet},
+		validity:  defaultCertificateValidity,
+		apiKeys:   &apiKeySessions{list: make(map[string]*apiKeySession)},
+		maint:     &maintenanceMode{},
+		revoked:   &revocationList{},
+		outbox:    newOutbox(publishBufferSize),
+		inflight:  newRequestTracker(),
+		pending:   newRequestTracker(),
+		limits:    requestLimits{size: defaultMaxMessageSize, records: defaultMaxRecords},
+		admission: newAdmissionController(nil),
+		exposed:   &exposureCells{},
+		selfCheck: exposureCheckPSI,
+		quota:     &ingestionQuota{counters: store},
+		window:    newRecordWindow(time.Minute, 24*time.Hour, 0),
+		domain:    "ct19.test",
+		hooks:     newHooks(),
+	}
+	srv.ingest = &ingester{log: srv.log, repos: store, window: srv.window, quota: srv.quota}
+	return srv, store, pub
+}
+
+// New DID instance, resolved locally while the test runs.
+func testIdentifier(t *testing.T) *did.Identifier {
+	id, err := newIdentifier("bryk", "ct19.test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resolve := resolveDID
+	resolveDID = func(value string, providers []*did.Provider) (*did.Identifier, error) {
+		if value == id.DID() {
+			return id, nil
+		}
+		return resolve(value, providers)
+	}
+	t.Cleanup(func() {
+		resolveDID = resolve
+	})
+	return id
+}
+
+// JSON-encoded LD signature for 'value', produced with the "master" key.
+func signValue(t *testing.T, id *did.Identifier, value string) []byte {
+	input := sha3.Sum256([]byte(value))
+	signature, err := id.Key(keyAuthentication).ProduceSignatureLD(input[:], "ct19.test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	proof, err := json.Marshal(signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return proof
+}
+
+// Repositories with a nonces store that always fails.
+type failingNonces struct {
+	storage.Repositories
+}
+
+func (fn failingNonces) Nonces() storage.NoncesRepo {
+	return fn
+}
+
+func (fn failingNonces) UseNonce(_, _ string, _ time.Time) (bool, error) {
+	return false, errors.New("storage unavailable")
+}
+
+func (fn failingNonces) ReserveNonces(_ string, _ map[string]time.Time) (map[string]bool, error) {
+	return nil, errors.New("storage unavailable")
+}
+
+func (fn failingNonces) ReleaseNonces(_ string, _ []string) error {
+	return errors.New("storage unavailable")
+}
+
+func TestAccessToken(t *testing.T) {
+	srv, store, _ := testServer(t)
+	id := testIdentifier(t)
+	code, err := store.ActivationCode(&protov1.ActivationCodeRequest{Did: id.DID(), Role: "user"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := &protov1.CredentialsRequest{
+		Did:            id.DID(),
+		Role:           "user",
+		ActivationCode: code,
+		Proof:          signValue(t, id, code),
+		Lang:           "es",
+	}
+
+	// Storage failures are not reported as invalid credentials
+	srv.repos = failingNonces{store}
+	_, err = srv.AccessToken(req, true)
+	if err != errInternalError {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	srv.trackAttempt(req.Did, "", err)
+	if failures, _ := store.AuthFailure("did:" + req.Did); failures != 1 {
+		t.Error("storage failure counted towards the lockout")
+	}
+	_ = store.ResetAuthFailures("did:" + req.Did)
+	srv.repos = store
+
+	// Valid request, the nonce used on the failed attempt was not registered
+	res, err := srv.AccessToken(req, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.AccessToken == "" || res.RefreshCode == "" {
+		t.Error("invalid credentials")
+	}
+	sessions, _, _ := store.Sessions(req.Did)
+	if len(sessions) != 1 || sessions[0].Role != "user" {
+		t.Error("session not registered")
+	}
+	if lang := store.Language(req.Did); lang != "es" {
+		t.Errorf("language preference not registered: %s", lang)
+	}
+
+	// Proofs can't be replayed
+	if _, err := srv.AccessToken(req, true); err != errReplayedProof {
+		t.Errorf("replayed proof accepted: %v", err)
+	}
+
+	// Activation codes are consumed
+	req.Proof = signValue(t, id, code)
+	if _, err := srv.AccessToken(req, true); err != errInvalidActivationCode {
+		t.Errorf("activation code accepted twice: %v", err)
+	}
+
+	// Proofs must be produced for the activation code
+	req.Proof = signValue(t, id, "another-code")
+	if _, err := srv.AccessToken(req, true); err != errInvalidSignature {
+		t.Errorf("invalid proof accepted: %v", err)
+	}
+}
+
+func TestValidateToken(t *testing.T) {
+	srv, _, _ := testServer(t)
+	token, err := srv.keys.generator().NewToken("master", &jwx.TokenParameters{
+		Audience:            []string{srv.name},
+		Subject:             "did:bryk:7889c965-4644-44ff-b760-f396f1d11444",
+		NotBefore:           "0ms",
+		Expiration:          "1h",
+		CustomPayloadClaims: &credentialsData{Role: "user"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := srv.validateToken(token, true); err != nil {
+		t.Error(err)
+	}
+
+	// Same issuer and audience, signed with a different key
+	forged := userToken(t, "did:bryk:7889c965-4644-44ff-b760-f396f1d11444")
+	if err := srv.validateToken(forged, true); err == nil {
+		t.Error("token signed with an unknown key accepted")
+	}
+}
@@ -0,0 +1,105 @@
+package api
+
+import (
+	"sync"
+	"time"
+
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/covid-tracking/storage"
+	"go.bryk.io/x/jwx"
+	"google.golang.org/grpc/codes"
+)
+
+// Revoked tokens are shared through the storage component and refreshed
+// periodically by all server instances.
+const revocationsRefresh = 30 * time.Second
+
+var errRevokedCredentials = newError(codes.Unauthenticated,
+	protov1.ErrorCode_ERROR_CODE_UNAUTHENTICATED, "revoked credentials")
+
+// Identifiers of revoked access tokens not yet expired.
+type revocationList struct {
+	list map[string]bool
+	mu   sync.RWMutex
+}
+
+func (rl *revocationList) has(id string) bool {
+	rl.mu.RLock()
+	defer rl.mu.RUnlock()
+	return rl.list[id]
+}
+
+func (rl *revocationList) set(ids []string) {
+	list := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		list[id] = true
+	}
+	rl.mu.Lock()
+	rl.list = list
+	rl.mu.Unlock()
+}
+
+// Retrieve the latest list of revoked tokens.
+func (srv *Server) refreshRevocations() {
+	ids, err := srv.repos.AccessTokens().RevokedTokens()
+	if err != nil {
+		srv.log.WithField("error", err.Error()).Warning("failed to refresh revoked credentials")
+		return
+	}
+	srv.revoked.set(ids)
+}
+
+// Register a newly issued access token. When 'previous' is provided the
+// token is the result of renewing it.
+func (srv *Server) openSession(token *jwx.Token, previous string) error {
+	claims := &tokenClaims{}
+	if err := token.Decode(claims); err != nil {
+		return err
+	}
+	return srv.repos.AccessTokens().OpenSession(&storage.SessionToken{
+		ID:      claims.ID,
+		DID:     claims.DID,
+		Role:    claims.Role,
+		Issued:  time.Unix(claims.IssuedAt, 0),
+		Expires: time.Unix(claims.ExpiresAt, 0),
+	}, previous)
+}
+
+// ListSessions returns the active sessions of the credential's subject, one
+// for each device holding valid credentials.
+func (srv *Server) ListSessions(token *jwx.Token) (*protov1.ListSessionsResponse, error) {
+	claims := &tokenClaims{}
+	if err := token.Decode(claims); err != nil {
+		return nil, errUnauthenticated
+	}
+	list, tokens, err := srv.repos.AccessTokens().Sessions(claims.DID)
+	if err != nil {
+		return nil, errInternalError
+	}
+	for i, s := range list {
+		s.Current = tokens[i] == claims.ID
+	}
+	return &protov1.ListSessionsResponse{Sessions: list}, nil
+}
+
+// RevokeSession invalidates the credentials of a session of the credential's
+// subject, i.e. those held by a lost device. Revoked credentials can't be
+// used or renewed.
+func (srv *Server) RevokeSession(token *jwx.Token, req *protov1.RevokeSessionRequest) error {
+	if req.Id == "" {
+		return invalidArgument("id", "session identifier is required")
+	}
+	claims := &tokenClaims{}
+	if err := token.Decode(claims); err != nil {
+		return errUnauthenticated
+	}
+	ok, err := srv.repos.AccessTokens().RevokeSession(claims.DID, req.Id)
+	if err != nil {
+		return errInternalError
+	}
+	if !ok {
+		return notFound("session")
+	}
+	srv.refreshRevocations()
+	return nil
+}
@@ -0,0 +1,18 @@
+package api
+
+import "testing"
+
+func TestRevocationList(t *testing.T) {
+	rl := &revocationList{}
+	if rl.has("a") {
+		t.Error("empty list")
+	}
+	rl.set([]string{"a", "b"})
+	if !rl.has("a") || !rl.has("b") || rl.has("c") {
+		t.Error("invalid revocation list")
+	}
+	rl.set([]string{"c"})
+	if rl.has("a") || !rl.has("c") {
+		t.Error("list should be replaced")
+	}
+}
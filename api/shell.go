@@ -1,17 +1,20 @@
 package api
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 
 	"github.com/gogo/protobuf/types"
 	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/covid-tracking/utils"
 	"go.bryk.io/x/cli/shell"
 )
 
 // GetShellCommands return the shell commands available when using a
-// CLI client to interact with a server handler instance.
-func GetShellCommands(sh *shell.Instance, cl protov1.TrackingServerAPIClient) []*shell.Command {
+// CLI client to interact with a server handler instance. Results are
+// displayed using the provided output format: "table", "json" or "yaml".
+func GetShellCommands(sh *shell.Instance, cl protov1.TrackingServerAPIClient, format string) []*shell.Command {
 	var commands []*shell.Command
 
 	// Clear
@@ -33,9 +36,18 @@ func GetShellCommands(sh *shell.Instance, cl protov1.TrackingServerAPIClient) []
 			if err != nil {
 				return fmt.Sprintf("error: %s", err)
 			}
-			return fmt.Sprintf("ping status: %v", r.Ok)
+			return shellOutput(format, r)
 		},
 	})
 
 	return commands
 }
+
+// Text representation of a shell command result.
+func shellOutput(format string, result interface{}) string {
+	buf := &bytes.Buffer{}
+	if err := utils.Render(buf, format, result); err != nil {
+		return fmt.Sprintf("error: %s", err)
+	}
+	return buf.String()
+}
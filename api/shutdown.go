@@ -0,0 +1,115 @@
+package api
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// Suggested delay before retrying requests rejected while the server is
+// shutting down; clients are expected to reach another instance.
+const shutdownRetry = time.Second
+
+var errShuttingDown = newError(codes.Unavailable, protov1.ErrorCode_ERROR_CODE_UNAVAILABLE, "server is shutting down",
+	&errdetails.RetryInfo{RetryDelay: ptypes.DurationProto(shutdownRetry)})
+
+// Keep track of the requests being processed, so the server can wait for
+// them to complete before closing its resources.
+type requestTracker struct {
+	active   int
+	draining bool
+	idle     chan struct{}
+	mu       sync.Mutex
+}
+
+func newRequestTracker() *requestTracker {
+	return &requestTracker{idle: make(chan struct{})}
+}
+
+// Register a new request; returns false if the server is draining.
+func (rt *requestTracker) begin() bool {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	if rt.draining {
+		return false
+	}
+	rt.active++
+	return true
+}
+
+// Register a completed request.
+func (rt *requestTracker) end() {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	rt.active--
+	if rt.draining && rt.active == 0 {
+		close(rt.idle)
+	}
+}
+
+// Reject new requests and wait for the active ones to complete, up to
+// 'grace'. Returns the number of requests still active.
+func (rt *requestTracker) drain(grace time.Duration) int {
+	rt.mu.Lock()
+	if !rt.draining {
+		rt.draining = true
+		if rt.active == 0 {
+			close(rt.idle)
+		}
+	}
+	rt.mu.Unlock()
+
+	timer := time.NewTimer(grace)
+	defer timer.Stop()
+	select {
+	case <-rt.idle:
+	case <-timer.C:
+	}
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	return rt.active
+}
+
+// Track the requests processed by the server, rejecting new requests with a
+// retryable error once shutdown starts.
+func (srv *Server) trackRequests(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	if !srv.inflight.begin() {
+		return nil, errShuttingDown
+	}
+	defer srv.inflight.end()
+	return handler(ctx, req)
+}
+
+// Track the streams processed by the server, same as 'trackRequests'.
+func (srv *Server) trackStreams(ss interface{}, stream grpc.ServerStream, _ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	if !srv.inflight.begin() {
+		return errShuttingDown
+	}
+	defer srv.inflight.end()
+	return handler(ss, stream)
+}
+
+// Drain stops accepting new requests and waits, up to 'grace', for the
+// requests in progress and the pending message publications to complete,
+// including messages waiting for the broker confirmation and the retries of
+// messages returned. The handling of messages returned by the broker is then
+// stopped, returns already received are saved for later delivery. Must be
+// called before stopping the RPC servers and closing the handler.
+func (srv *Server) Drain(grace time.Duration) {
+	deadline := time.Now().Add(grace)
+	if active := srv.inflight.drain(grace); active > 0 {
+		srv.log.WithField("requests", active).Warning("shutdown grace period expired with requests in progress")
+	}
+	if pending := srv.pending.drain(time.Until(deadline)); pending > 0 {
+		srv.log.WithField("messages", pending).Warning("shutdown grace period expired with messages pending publication")
+	}
+	srv.stopDelivery()
+}
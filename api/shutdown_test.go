@@ -0,0 +1,86 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.bryk.io/x/amqp"
+	"google.golang.org/grpc"
+)
+
+func TestRequestTracker(t *testing.T) {
+	rt := newRequestTracker()
+	if !rt.begin() {
+		t.Fatal("request should be accepted")
+	}
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		rt.end()
+	}()
+	if active := rt.drain(time.Second); active != 0 {
+		t.Errorf("active requests: %d", active)
+	}
+	if rt.begin() {
+		t.Error("request should be rejected while draining")
+	}
+
+	// Grace period expired
+	rt = newRequestTracker()
+	rt.begin()
+	if active := rt.drain(10 * time.Millisecond); active != 1 {
+		t.Errorf("active requests: %d", active)
+	}
+	rt.end()
+}
+
+func TestDrain(t *testing.T) {
+	srv, _, _ := testServer(t)
+	stream := func(_ interface{}, _ grpc.ServerStream) error { return nil }
+	if err := srv.trackStreams(nil, nil, nil, stream); err != nil {
+		t.Fatal(err)
+	}
+
+	// Publications in progress are awaited
+	srv.pending.begin()
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		srv.pending.end()
+	}()
+	srv.Drain(time.Second)
+	if srv.pending.active != 0 {
+		t.Error("pending publications not awaited")
+	}
+
+	// New requests, streams and publications are rejected
+	if err := srv.trackStreams(nil, nil, nil, stream); err == nil {
+		t.Error("stream should be rejected while draining")
+	}
+	if err := srv.push(&outboxEntry{msg: amqp.Message{MessageId: "1"}}); err == nil {
+		t.Error("publication should be rejected while draining")
+	}
+}
+
+func TestDrainReturns(t *testing.T) {
+	srv, store, pub := testServer(t)
+	srv.ctx, srv.halt = context.WithCancel(context.Background())
+	defer srv.halt()
+	srv.startDelivery()
+
+	// Messages returned before draining completes are saved for later delivery
+	e := &outboxEntry{msg: amqp.Message{MessageId: "1"}, returns: publishAttempts - 1}
+	if err := srv.push(e); err != nil {
+		t.Fatal(err)
+	}
+	pub.Return("1")
+	srv.Drain(time.Second)
+	select {
+	case <-srv.delivered:
+	default:
+		t.Fatal("delivery loop still running after draining")
+	}
+	list, _ := store.UndeliveredMessages(10)
+	if len(list) != 1 || list[0].ID != "1" {
+		t.Error("returned message not saved for later delivery")
+	}
+}
@@ -0,0 +1,157 @@
+package api
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"go.bryk.io/covid-tracking/secrets"
+	"go.bryk.io/x/jwx"
+)
+
+// Token generators produce signed access credentials.
+type tokenGenerator interface {
+	NewToken(keyID string, params *jwx.TokenParameters) (*jwx.Token, error)
+}
+
+// signerGenerator produces JWT credentials using an opaque signer, like a
+// key stored on an HSM, where the private key material is not available to
+// the process; or an Ed25519 key. The signing method is determined by the
+// key type: ES384 for ECDSA P-384 keys and EdDSA for Ed25519 keys.
+type signerGenerator struct {
+	iss    string
+	kid    string
+	alg    string
+	signer crypto.Signer
+}
+
+// Returns a token generator for the provided signer.
+func newSignerGenerator(issuer string, signer crypto.Signer) (*signerGenerator, error) {
+	alg := ""
+	switch pub := signer.Public().(type) {
+	case *ecdsa.PublicKey:
+		if pub.Curve == elliptic.P384() {
+			alg = secrets.AlgES384
+		}
+	case ed25519.PublicKey:
+		alg = secrets.AlgEdDSA
+	}
+	if alg == "" {
+		return nil, errors.New("signing key must be an ECDSA P-384 or Ed25519 key")
+	}
+	der, err := x509.MarshalPKIXPublicKey(signer.Public())
+	if err != nil {
+		return nil, err
+	}
+	fp := sha512.Sum384(der)
+	kid := make([]string, 16)
+	for i, b := range fp[:16] {
+		kid[i] = fmt.Sprintf("%x", b)
+	}
+	return &signerGenerator{
+		iss:    issuer,
+		kid:    strings.Join(kid, ":"),
+		alg:    alg,
+		signer: signer,
+	}, nil
+}
+
+// NewToken returns a new signed credential. The signing method on the
+// parameters is ignored in favor of the one supported by the key.
+func (sg *signerGenerator) NewToken(_ string, params *jwx.TokenParameters) (*jwx.Token, error) {
+	// Token claims
+	now := time.Now()
+	claims := map[string]interface{}{}
+	if params.CustomPayloadClaims != nil {
+		custom, err := json.Marshal(params.CustomPayloadClaims)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(custom, &claims); err != nil {
+			return nil, err
+		}
+	}
+	claims["iss"] = sg.iss
+	claims["aud"] = params.Audience
+	claims["sub"] = params.Subject
+	claims["jti"] = uuid.New().String()
+	claims["iat"] = now.Unix()
+	if params.NotBefore != "" {
+		nbf, err := time.ParseDuration(params.NotBefore)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid 'not before' value")
+		}
+		claims["nbf"] = now.Add(nbf).Unix()
+	}
+	if params.Expiration != "" {
+		exp, err := time.ParseDuration(params.Expiration)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid expiration value")
+		}
+		claims["exp"] = now.Add(exp).Unix()
+	}
+
+	// Encode and sign
+	header, err := json.Marshal(map[string]string{"typ": "JWT", "alg": sg.alg, "kid": sg.kid})
+	if err != nil {
+		return nil, err
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return nil, err
+	}
+	input := fmt.Sprintf("%s.%s",
+		base64.RawURLEncoding.EncodeToString(header),
+		base64.RawURLEncoding.EncodeToString(payload))
+	signature, err := sg.sign([]byte(input))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign token")
+	}
+	return jwx.Parse(fmt.Sprintf("%s.%s", input, base64.RawURLEncoding.EncodeToString(signature)))
+}
+
+// Produce a JWS signature for the provided input.
+func (sg *signerGenerator) sign(input []byte) ([]byte, error) {
+	if sg.alg == secrets.AlgEdDSA {
+		// Ed25519 signs the complete message
+		return sg.signer.Sign(rand.Reader, input, crypto.Hash(0))
+	}
+	digest := sha512.Sum384(input)
+	der, err := sg.signer.Sign(rand.Reader, digest[:], crypto.SHA384)
+	if err != nil {
+		return nil, err
+	}
+	return rawSignature(der, 48)
+}
+
+// Convert an ASN.1 ECDSA signature into the fixed-size "r || s" form used
+// by JWS.
+func rawSignature(der []byte, size int) ([]byte, error) {
+	var sig struct {
+		R, S *big.Int
+	}
+	if _, err := asn1.Unmarshal(der, &sig); err != nil {
+		return nil, errors.New("invalid signature")
+	}
+	r, s := sig.R.Bytes(), sig.S.Bytes()
+	if len(r) > size || len(s) > size {
+		return nil, errors.New("invalid signature")
+	}
+	raw := make([]byte, 2*size)
+	copy(raw[size-len(r):size], r)
+	copy(raw[2*size-len(s):], s)
+	return raw, nil
+}
@@ -0,0 +1,111 @@
+package api
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/base64"
+	"math/big"
+	"strings"
+	"testing"
+
+	"go.bryk.io/x/jwx"
+)
+
+func TestSignerGenerator(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tg, err := newSignerGenerator("ct19.test", key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := tg.NewToken("master", &jwx.TokenParameters{
+		Audience:   []string{"ct19.test"},
+		Subject:    "did:bryk:7889c965-4644-44ff-b760-f396f1d11444",
+		Method:     jwx.ES384,
+		NotBefore:  "0ms",
+		Expiration: "1h",
+		CustomPayloadClaims: &credentialsData{
+			DID:  "did:bryk:7889c965-4644-44ff-b760-f396f1d11444",
+			Role: "user",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Verify signature
+	segments := strings.Split(token.String(), ".")
+	if len(segments) != 3 {
+		t.Fatal("invalid token format")
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(segments[2])
+	if err != nil || len(sig) != 96 {
+		t.Fatal("invalid signature encoding")
+	}
+	digest := sha512.Sum384([]byte(segments[0] + "." + segments[1]))
+	r := new(big.Int).SetBytes(sig[:48])
+	s := new(big.Int).SetBytes(sig[48:])
+	if !ecdsa.Verify(&key.PublicKey, digest[:], r, s) {
+		t.Error("invalid signature")
+	}
+
+	// Verify claims
+	data := &credentialsData{}
+	if err := token.Decode(&data); err != nil || data.Role != "user" {
+		t.Error("invalid custom claims")
+	}
+
+	// Only P-384 and Ed25519 keys are supported
+	weak, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if _, err := newSignerGenerator("ct19.test", weak); err == nil {
+		t.Error("P-256 key accepted")
+	}
+}
+
+func TestSignerGeneratorEdDSA(t *testing.T) {
+	pub, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tg, err := newSignerGenerator("ct19.test", key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := tg.NewToken("master", &jwx.TokenParameters{
+		Audience:   []string{"ct19.test"},
+		Subject:    "did:bryk:7889c965-4644-44ff-b760-f396f1d11444",
+		NotBefore:  "0ms",
+		Expiration: "1h",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Verify header and signature
+	segments := strings.Split(token.String(), ".")
+	if len(segments) != 3 {
+		t.Fatal("invalid token format")
+	}
+	header, _ := base64.RawURLEncoding.DecodeString(segments[0])
+	if !strings.Contains(string(header), `"alg":"EdDSA"`) {
+		t.Error("invalid signing method")
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(segments[2])
+	if err != nil || len(sig) != ed25519.SignatureSize {
+		t.Fatal("invalid signature encoding")
+	}
+	if !ed25519.Verify(pub, []byte(segments[0]+"."+segments[1]), sig) {
+		t.Error("invalid signature")
+	}
+
+	// Credentials report the method of the active key
+	keys := &serverKeys{tg: tg}
+	if method := keys.method(); method != "EdDSA" {
+		t.Errorf("invalid signing method: %s", method)
+	}
+}
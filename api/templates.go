@@ -0,0 +1,116 @@
+package api
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"go.bryk.io/covid-tracking/i18n"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+)
+
+// Maximum length of notification template texts.
+const (
+	maxTemplateTitle = 120
+	maxTemplateBody  = 1000
+)
+
+// Details available for each kind of notification generated by the workers.
+// Templates for other kinds can be registered but their variables are not
+// verified.
+var notificationVariables = map[string][]string{
+	"exposure":       {"exposure"},
+	"venue_outbreak": {"venue", "name", "from", "to"},
+}
+
+var (
+	templateKind     = regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)
+	templateVariable = regexp.MustCompile(`\{([a-z0-9_]+)\}`)
+)
+
+// Return the variables used on a notification template, sorted by name.
+func templateVariables(t *protov1.NotificationTemplate) []string {
+	set := make(map[string]bool)
+	for _, text := range []string{t.Title, t.Body} {
+		for _, m := range templateVariable.FindAllStringSubmatch(text, -1) {
+			set[m[1]] = true
+		}
+	}
+	list := make([]string, 0, len(set))
+	for v := range set {
+		list = append(list, v)
+	}
+	sort.Strings(list)
+	return list
+}
+
+// Verify a notification template can be safely stored.
+func validateTemplate(t *protov1.NotificationTemplate) error {
+	if !templateKind.MatchString(t.Kind) {
+		return invalidArgument("kind", "invalid notification kind")
+	}
+	if !i18n.Supported(i18n.Language(t.Lang)) {
+		return invalidArgument("lang", "unsupported language")
+	}
+	if t.Title == "" || len(t.Title) > maxTemplateTitle {
+		return invalidArgument("title", fmt.Sprintf("between 1 and %d characters are supported", maxTemplateTitle))
+	}
+	if t.Body == "" || len(t.Body) > maxTemplateBody {
+		return invalidArgument("body", fmt.Sprintf("between 1 and %d characters are supported", maxTemplateBody))
+	}
+	known, ok := notificationVariables[t.Kind]
+	if !ok {
+		return nil
+	}
+	for _, v := range templateVariables(t) {
+		if !contains(known, v) {
+			return invalidArgument("body", fmt.Sprintf("unknown variable for '%s' notifications: %s", t.Kind, v))
+		}
+	}
+	return nil
+}
+
+func contains(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// SetNotificationTemplate registers, or replaces, a notification template.
+// Templates are used by the workers when rendering new notifications.
+func (srv *Server) SetNotificationTemplate(req *protov1.NotificationTemplate) (*protov1.NotificationTemplate, error) {
+	if err := validateTemplate(req); err != nil {
+		return nil, err
+	}
+	req.Variables = templateVariables(req)
+	if err := srv.repos.Notifications().SaveTemplate(req); err != nil {
+		return nil, errInternalError
+	}
+	return req, nil
+}
+
+// ListNotificationTemplates returns the registered notification templates,
+// optionally filtered by kind.
+func (srv *Server) ListNotificationTemplates(
+	req *protov1.ListTemplatesRequest) (*protov1.ListTemplatesResponse, error) {
+	list, err := srv.repos.Notifications().Templates(req.Kind)
+	if err != nil {
+		return nil, errInternalError
+	}
+	return &protov1.ListTemplatesResponse{Templates: list}, nil
+}
+
+// DeleteNotificationTemplate removes a notification template.
+func (srv *Server) DeleteNotificationTemplate(req *protov1.TemplateQuery) error {
+	ok, err := srv.repos.Notifications().DeleteTemplate(req.Kind, req.Lang)
+	if err != nil {
+		return errInternalError
+	}
+	if !ok {
+		return notFound("template")
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package api
+
+import (
+	"reflect"
+	"testing"
+
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+)
+
+func TestValidateTemplate(t *testing.T) {
+	tpl := func(kind, lang, body string) *protov1.NotificationTemplate {
+		return &protov1.NotificationTemplate{Kind: kind, Lang: lang, Title: "Notice", Body: body}
+	}
+	cases := []struct {
+		t     *protov1.NotificationTemplate
+		valid bool
+	}{
+		{tpl("venue_outbreak", "es", "Un caso confirmado visitó {name}."), true},
+		{tpl("venue_outbreak", "es", "Un caso confirmado visitó {place}."), false},
+		{tpl("exposure", "xx", "Possible exposure."), false},
+		{tpl("Exposure", "en", "Possible exposure."), false},
+		{tpl("exposure", "en", ""), false},
+		{tpl("vaccination_reminder", "en", "Your {dose} is due on {date}."), true},
+	}
+	for i, c := range cases {
+		if err := validateTemplate(c.t); (err == nil) != c.valid {
+			t.Errorf("case %d: expected valid=%v, got %v", i, c.valid, err)
+		}
+	}
+
+	vars := templateVariables(&protov1.NotificationTemplate{Title: "{name}", Body: "{to} {name} {from}"})
+	if !reflect.DeepEqual(vars, []string{"from", "name", "to"}) {
+		t.Errorf("invalid variables: %v", vars)
+	}
+}
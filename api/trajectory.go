@@ -0,0 +1,74 @@
+package api
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+)
+
+// HTTP gateway endpoint accepting location records in the compact
+// trajectory format.
+const trajectoryPath = "/v1/api/record"
+
+// Location records submitted using the compact trajectory format, see
+// 'protov1.ContentTypeTrajectory', are restored to the regular JSON request
+// before reaching the gateway; so they are validated the same way. The
+// contents can be gzip-compressed. All other requests are passed to 'next'.
+func (srv *Server) trajectoryHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mt, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if mt != protov1.ContentTypeTrajectory {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if r.Method != http.MethodPost || r.URL.Path != trajectoryPath {
+			http.Error(w, "unsupported content type", http.StatusUnsupportedMediaType)
+			return
+		}
+
+		// Read contents, the size limit applies to the decompressed data
+		var body io.Reader = http.MaxBytesReader(w, r.Body, int64(srv.limits.size))
+		switch r.Header.Get("Content-Encoding") {
+		case "", "identity":
+		case "gzip":
+			zr, err := gzip.NewReader(body)
+			if err != nil {
+				http.Error(w, "invalid compressed contents", http.StatusBadRequest)
+				return
+			}
+			body = io.LimitReader(zr, int64(srv.limits.size)+1)
+		default:
+			http.Error(w, "unsupported content encoding", http.StatusUnsupportedMediaType)
+			return
+		}
+		data, err := ioutil.ReadAll(body)
+		if err != nil || len(data) > srv.limits.size {
+			http.Error(w, "request too large or malformed", http.StatusRequestEntityTooLarge)
+			return
+		}
+
+		// Restore records
+		req, err := protov1.DecodeTrajectory(data)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		contents, err := json.Marshal(req)
+		if err != nil {
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(contents))
+		r.ContentLength = int64(len(contents))
+		r.Header.Set("Content-Type", "application/json")
+		r.Header.Del("Content-Encoding")
+		r.Header.Del("Content-Length")
+		next.ServeHTTP(w, r)
+	})
+}
@@ -1,15 +1,28 @@
 package api
 
 import (
+	"bytes"
 	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
+	"encoding/pem"
+	"fmt"
 	"io/ioutil"
+	"net/http"
 	"path/filepath"
 	"strings"
 	"time"
 
 	"github.com/pkg/errors"
+	"go.bryk.io/covid-tracking/certificate"
+	"go.bryk.io/covid-tracking/i18n"
 	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/covid-tracking/secrets"
+	"go.bryk.io/covid-tracking/storage"
 	"go.bryk.io/covid-tracking/utils"
 	"go.bryk.io/x/auth"
 	"go.bryk.io/x/ccg/did"
@@ -17,10 +30,13 @@ import (
 	xlog "go.bryk.io/x/log"
 	"go.bryk.io/x/net/rpc"
 	"go.bryk.io/x/pki"
-	"golang.org/x/crypto/sha3"
 	"google.golang.org/grpc/metadata"
 )
 
+// DID resolution, replaced on tests so DID instances can be resolved without
+// network access.
+var resolveDID = utils.ResolveDID
+
 var defaultPKIConf = `{
   "signing": {
     "default": {
@@ -99,20 +115,18 @@ func verifyRootCA(home string) error {
 }
 
 // Ensure the TLS certificate is in place and valid.
-func verifyTLSCertificate(home string) (*rpc.ServerTLSConfig, error) {
-	certFile := filepath.Join(home, "tls", "tls.crt")
-	keyFile := filepath.Join(home, "tls", "tls.key")
-	if !pki.IsKeyPairFile(certFile, keyFile) {
-		return nil, errors.New("TLS certificate is required")
-	}
-	cert, err := ioutil.ReadFile(filepath.Clean(certFile))
+func verifyTLSCertificate(sp secrets.Provider) (*rpc.ServerTLSConfig, error) {
+	cert, err := sp.Get(secrets.TLSCertificate)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to read TLS certificate")
 	}
-	key, err := ioutil.ReadFile(filepath.Clean(keyFile))
+	key, err := sp.Get(secrets.TLSKey)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to read TLS key")
 	}
+	if _, err := tls.X509KeyPair(cert, key); err != nil {
+		return nil, errors.Wrap(err, "invalid TLS certificate")
+	}
 	tlsConf := rpc.ServerTLSConfig{
 		Cert:             cert,
 		PrivateKey:       key,
@@ -141,27 +155,25 @@ func setupPKI(home string) (*pki.CA, error) {
 }
 
 // Prepare the HTTP gateway interface.
-func setupHTTPGateway(port int) (*rpc.HTTPGateway, error) {
+func setupHTTPGateway(port int, middleware func(http.Handler) http.Handler) (*rpc.HTTPGateway, error) {
 	gwOpts := []rpc.HTTPGatewayOption{
 		rpc.WithGatewayPort(port),
 		rpc.WithClientOptions([]rpc.ClientOption{
 			rpc.WithInsecureSkipVerify(),
 			rpc.WithClientTLS(rpc.ClientTLSConfig{IncludeSystemCAs: true}),
 		}),
+		rpc.WithGatewayMiddleware(middleware),
 	}
 	return rpc.NewHTTPGateway(gwOpts...)
 }
 
-// Prepare authorization enforcer.
-func setupAuthEnforcer() (*auth.Enforcer, error) {
+// Prepare authorization enforcer, including the rules for custom roles.
+func setupAuthEnforcer(roles []*Role) (*auth.Enforcer, error) {
 	enf, err := auth.NewEnforcer()
 	if err != nil {
 		return nil, err
 	}
-	for _, r := range strings.Split(utils.AccessPolicy(), "\n") {
-		if strings.HasPrefix(r, "#") || strings.TrimSpace(r) == "" {
-			continue // Ignore comments and empty lines
-		}
+	for _, r := range policyRules(accessPolicy(utils.AccessPolicy(), roles)) {
 		ar := &auth.Rule{}
 		if err := ar.FromString(r); err != nil {
 			return nil, err
@@ -173,27 +185,122 @@ func setupAuthEnforcer() (*auth.Enforcer, error) {
 	return enf, nil
 }
 
-// Prepares a new token generator instance.
-func setupTokenGenerator(serverName string, serverHome string) (*jwx.Generator, error) {
-	keyPEM, err := ioutil.ReadFile(filepath.Clean(filepath.Join(serverHome, "root-ca.pem")))
+// Rules included in an access policy, ignoring comments and empty lines.
+func policyRules(policy string) []string {
+	var rules []string
+	for _, r := range strings.Split(policy, "\n") {
+		if strings.HasPrefix(r, "#") || strings.TrimSpace(r) == "" {
+			continue
+		}
+		rules = append(rules, strings.TrimSpace(r))
+	}
+	return rules
+}
+
+// Keys included on DID documents generated by the server, by purpose. The
+// "master" key authenticates the DID holder and signs records, so it's the
+// one referenced on the document's authentication entries and proof. The
+// assertion key is reserved to issue verifiable credentials, and the key
+// agreement key, converted to X25519, to establish encrypted channels with
+// the user.
+const (
+	keyAuthentication = "master"
+	keyAssertion      = "assertion"
+	keyAgreement      = "key-agreement"
+)
+
+// Generate a new DID instance with a separate Ed25519 key for each purpose,
+// and a document proof produced under 'domain'.
+func newIdentifier(method, domain string) (*did.Identifier, error) {
+	id, err := did.NewIdentifierWithMode(method, "", did.ModeUUID)
 	if err != nil {
 		return nil, err
 	}
+	for _, k := range []string{keyAuthentication, keyAssertion, keyAgreement} {
+		if err = id.AddNewKey(k, did.KeyTypeEd, did.EncodingBase58); err != nil {
+			return nil, err
+		}
+	}
+	if err = id.AddAuthenticationKey(keyAuthentication); err != nil {
+		return nil, err
+	}
+	if err = id.AddProof(keyAuthentication, domain); err != nil {
+		return nil, err
+	}
+	return id, nil
+}
+
+// Prepares a new token generator instance for the PEM-encoded signing key.
+// The key type must match the selected signing algorithm: ECDSA keys are
+// used for ES384 and Ed25519 keys for EdDSA.
+func setupTokenGenerator(serverName, alg string, keyPEM []byte) (tokenGenerator, error) {
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		return nil, errors.New("invalid signing key")
+	}
+	if alg == secrets.AlgEdDSA {
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid signing key")
+		}
+		edKey, ok := key.(ed25519.PrivateKey)
+		if !ok {
+			return nil, errors.New("EdDSA credentials require an Ed25519 signing key")
+		}
+		return newSignerGenerator(serverName, edKey)
+	}
 	key, err := jwx.NewGeneratorKey("master", jwx.KeyTypeEC, keyPEM)
 	if err != nil {
-		return nil, err
+		return nil, errors.Wrap(err, "ES384 credentials require an ECDSA signing key")
 	}
 	return jwx.NewGenerator(serverName, *key)
 }
 
-// Return the key used for authenticated hash operations.
-func hashKey(home string) ([]byte, error) {
-	src, err := ioutil.ReadFile(filepath.Clean(filepath.Join(home, "root-ca.pem")))
+// Return the public key for a PEM-encoded signing key, either an EC key in
+// SEC 1 format or a PKCS #8 key.
+func signingPublicKey(keyPEM []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		return nil, errors.New("invalid signing key")
+	}
+	if key, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		return key.Public(), nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid signing key")
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("invalid signing key")
+	}
+	return signer.Public(), nil
+}
+
+// Prepare the issuer for health certificates. Credentials are signed using
+// the P-256 key in the "certificates.pem" file; if not available, health
+// certificates are disabled and a nil issuer is returned.
+func setupCertificateIssuer(serverName, country, serverHome string) (*certificate.Issuer, error) {
+	keyPEM, err := ioutil.ReadFile(filepath.Clean(filepath.Join(serverHome, "certificates.pem")))
+	if err != nil {
+		return nil, nil
+	}
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		return nil, errors.New("invalid certificates key")
+	}
+	key, err := x509.ParseECPrivateKey(block.Bytes)
 	if err != nil {
 		return nil, err
 	}
-	h := sha3.Sum256(src)
-	return h[:], nil
+	if key.Curve != elliptic.P256() {
+		return nil, errors.New("certificates key must use the P-256 curve")
+	}
+	return &certificate.Issuer{
+		ID:      fmt.Sprintf("https://%s", serverName),
+		Country: country,
+		Key:     key,
+	}, nil
 }
 
 // Retrieve a bearer credential from the incoming request context.
@@ -213,53 +320,195 @@ func getTokenFromContext(ctx context.Context) (*jwx.Token, error) {
 	return jwx.Parse(strings.Split(t[0], " ")[1])
 }
 
-// Verify the provided role literal is supported.
-func isRoleValid(role string) bool {
-	for _, r := range supportedRoles {
-		if role == r {
-			return true
+// Retrieve the API key included in the request, if any. API keys are
+// provided as "ApiKey" authorization credentials.
+func getAPIKeyFromContext(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+	t := md.Get("authorization")
+	if len(t) != 1 || !strings.HasPrefix(t[0], "ApiKey ") {
+		return "", false
+	}
+	return strings.TrimSpace(strings.TrimPrefix(t[0], "ApiKey ")), true
+}
+
+// Return the preferred language for the incoming request, based on the
+// "Accept-Language" header or the claims in the bearer credential.
+func getLanguage(ctx context.Context) i18n.Language {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, k := range []string{"accept-language", "grpcgateway-accept-language"} {
+			if v := md.Get(k); len(v) > 0 && v[0] != "" {
+				return i18n.Negotiate(v[0])
+			}
+		}
+	}
+	if token, err := getTokenFromContext(ctx); err == nil {
+		data := &credentialsData{}
+		if err := token.Decode(&data); err == nil && data.Lang != "" {
+			return i18n.Negotiate(data.Lang)
 		}
 	}
-	return false
+	return i18n.Default
 }
 
-// Ensure a location record is valid and can be safely indexed and stored.
-func validateRecord(id *did.Identifier, r *protov1.LocationRecord) bool {
-	// Verify DID is correct on the record entry
-	if r.Did != id.DID() {
-		return false
+// Accepted period of time for record timestamps.
+type recordWindow struct {
+	// Tolerance for timestamps ahead of the current time, to account for
+	// devices with slightly fast clocks.
+	skew time.Duration
+
+	// Maximum age for records. A zero value disables the check.
+	maxAge time.Duration
+}
+
+// Accepted window for record timestamps. If 'maxAge' is not provided, the
+// retention period is used.
+func newRecordWindow(skew, maxAge, retention time.Duration) recordWindow {
+	if maxAge == 0 {
+		maxAge = retention
 	}
+	return recordWindow{skew: skew, maxAge: maxAge}
+}
 
-	// Lat and Lng are required
-	if r.Lat == 0 || r.Lng == 0 {
+// Verify a record timestamp is within the accepted window.
+func (rw recordWindow) valid(ts int64) bool {
+	now := time.Now()
+	if ts == 0 || ts > now.Add(rw.skew).Unix() {
 		return false
 	}
+	return rw.maxAge == 0 || ts >= now.Add(-rw.maxAge).Unix()
+}
+
+// Time a record with timestamp 'ts' leaves the accepted window, the nonce of
+// its proof must be kept until then to prevent replays. A zero value is
+// returned if records are accepted regardless of their age.
+func (rw recordWindow) expires(ts int64) time.Time {
+	if rw.maxAge == 0 {
+		return time.Time{}
+	}
+	return time.Unix(ts, 0).Add(rw.maxAge)
+}
+
+// Processing status reported for each record submitted.
+const (
+	recordAccepted = "accepted"
+	recordPending  = "pending"
+	recordRejected = "rejected"
+)
+
+// Reason codes reported for rejected records.
+const (
+	rejectInvalidDID       = "invalid_did"
+	rejectInvalidLocation  = "invalid_location"
+	rejectNotGeneralized   = "not_generalized"
+	rejectInvalidTimestamp = "invalid_timestamp"
+	rejectInvalidHash      = "invalid_hash"
+	rejectInvalidProof     = "invalid_proof"
+	rejectReplayedProof    = "replayed_proof"
+	rejectUnknownVenue     = "unknown_venue"
+	rejectQuotaExceeded    = "quota_exceeded"
+)
+
+// Ensure a location record submitted by 'author' is valid and can be safely
+// indexed and stored. The reason code is returned for invalid records, an
+// empty string otherwise. The record's proof is verified separately, see
+// 'verifyProofs'.
+func validateRecord(author string, r *protov1.LocationRecord, rw recordWindow) string {
+	// Verify DID is correct on the record entry
+	if r.Did != author {
+		return rejectInvalidDID
+	}
+
+	// Coordinates must be within the valid ranges
+	if !validCoordinates(r.Lat, r.Lng) || !validAltitude(r.Alt) {
+		return rejectInvalidLocation
+	}
+
+	// Aggregate-only records must be generalized by the client
+	if r.AggregateOnly && !r.IsGeneralized() {
+		return rejectNotGeneralized
+	}
 
 	// Invalid timestamp value
-	now := time.Now()
-	if r.Timestamp == 0 || r.Timestamp > now.Unix() {
-		return false
+	if !rw.valid(r.Timestamp) {
+		return rejectInvalidTimestamp
 	}
 
 	// Invalid hash value
 	if r.GenerateHash() != r.Hash {
-		return false
+		return rejectInvalidHash
 	}
+	return ""
+}
 
-	// Validate record's signature
-	if err := utils.VerifySignature(id, []byte(r.GetHash()), r.Proof); err != nil {
-		return false
+// Ensure a check-in record submitted by 'author' is valid and can be safely
+// stored. The reason code is returned for invalid records, an empty string
+// otherwise. The record's proof is verified separately, see 'verifyProofs'.
+func validateCheckIn(author string, r *protov1.CheckInRecord, rw recordWindow) string {
+	// Verify DID is correct on the record entry
+	if r.Did != author {
+		return rejectInvalidDID
+	}
+	if r.Venue == "" {
+		return rejectUnknownVenue
 	}
 
-	// All good!
-	return true
+	// Invalid timestamp value
+	if !rw.valid(r.Timestamp) {
+		return rejectInvalidTimestamp
+	}
+
+	// Invalid hash value
+	if r.GenerateHash() != r.Hash {
+		return rejectInvalidHash
+	}
+	return ""
 }
 
-// Publish a DID instance
-func publishDID(id *did.Identifier, pow uint, ll xlog.Logger) {
-	var err error
+// Nonces of activation proofs are kept while the signed activation code can
+// still be used; campaign codes, the longest lived, are valid for 30 days.
+const activationNonceTTL = 30 * 24 * time.Hour
+
+// Verify a signature proof was not used before and register its nonce, kept
+// until 'expires'. Proofs must include a nonce value to be accepted.
+func freshProof(nonces storage.NoncesRepo, id string, proof []byte, expires time.Time) (bool, error) {
+	nonce := utils.SignatureNonce(proof)
+	if nonce == "" {
+		return false, nil
+	}
+	return nonces.UseNonce(id, nonce, expires)
+}
 
-	// Get ticket
+// Publish a DID instance. The progress of the proof-of-work is saved and
+// resumed on later attempts to publish the same DID.
+func publishDID(ctx context.Context, dp *didPublisher, id *did.Identifier, pow uint, ll xlog.Logger) error {
+	if err := dp.publish(ctx, id.String(), id, pow); err != nil {
+		ll.WithFields(xlog.Fields{
+			"error":     err.Error(),
+			"transient": retryPublish(err),
+		}).Error("failed to publish DID")
+		return err
+	}
+	ll.WithField("did", id.String()).Info("DID published successfully")
+	return nil
+}
+
+// Generate a ticket to publish the document of a DID instance, solved with
+// the provided proof-of-work difficulty and signed with its "master" key.
+// If 'store' is provided, the progress is saved under 'key' on a best-effort
+// basis; when a ticket with the same key was previously started for the
+// same document, and neither rejected nor completed, its status is restored
+// and the proof-of-work resumed from the stored nonce. Progress for a
+// different document is discarded. The final solution is verified before
+// signing the ticket. Returns the ticket along with its status.
+func newPublishTicket(ctx context.Context, store storage.TicketsRepo, key string, id *did.Identifier,
+	pow uint) (*publishTicket, *storage.PublishTicket, error) {
+	signer := id.Key("master")
+	if signer == nil {
+		return nil, nil, errors.New("master key not available")
+	}
 	sd, _ := json.Marshal(id.SafeDocument())
 	ticket := &publishTicket{
 		Timestamp:  time.Now().Unix(),
@@ -267,18 +516,42 @@ func publishDID(id *did.Identifier, pow uint, ll xlog.Logger) {
 		KeyID:      "master",
 		NonceValue: 0,
 	}
-	key := id.Key("master")
-	ticket.Signature, err = key.Sign(ticket.Solve(pow))
-	if err != nil {
-		ll.Error("failed to generate ticket")
-		return
+	progress := &storage.PublishTicket{
+		ID:         key,
+		DID:        id.String(),
+		Timestamp:  ticket.Timestamp,
+		Content:    ticket.Content,
+		Difficulty: pow,
 	}
 
-	// Submit request
-	if !ticket.Submit() {
-		ll.Error("failed to publish DID")
-		return
+	// Resume previous progress
+	save := func(int64) {}
+	if store != nil {
+		prev, err := store.PublishTicket(key)
+		if err == nil && prev != nil && prev.Difficulty == pow && bytes.Equal(prev.Content, sd) &&
+			prev.Status != storage.PublishFailed && prev.Status != storage.PublishCompleted {
+			progress = prev
+			ticket.Timestamp, ticket.NonceValue = prev.Timestamp, prev.Nonce
+		}
+		save = func(nonce int64) {
+			progress.Nonce = nonce
+			_ = store.SavePublishTicket(progress)
+		}
+		save(ticket.Nonce())
 	}
 
-	ll.WithField("did", id.String()).Info("DID published successfully")
+	// Solve and verify the solution
+	digest, err := ticket.Solve(ctx, pow, save)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !ticket.Verify(digest, pow) {
+		return nil, nil, errors.New("invalid proof-of-work solution")
+	}
+	progress.Solved = true
+	save(ticket.Nonce())
+	if ticket.Signature, err = signer.Sign(digest); err != nil {
+		return nil, nil, err
+	}
+	return ticket, progress, nil
 }
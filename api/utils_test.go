@@ -1,14 +1,18 @@
 package api
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"math"
 	"testing"
 	"time"
 
 	"github.com/gogo/protobuf/jsonpb"
 	protov1 "go.bryk.io/covid-tracking/proto/v1"
-	"go.bryk.io/x/ccg/did"
+	"go.bryk.io/covid-tracking/storage"
+	"go.bryk.io/covid-tracking/utils"
 )
 
 const signature = `{
@@ -50,20 +54,47 @@ func TestHandler_LocationRecord(t *testing.T) {
 	fmt.Printf("%s", output)
 }
 
-func TestPublishTicket(t *testing.T) {
-	var err error
+func TestSignatureNonce(t *testing.T) {
+	if nonce := utils.SignatureNonce([]byte(signature)); nonce != "135fdd076c7ea45b00c352119c1c46b7" {
+		t.Errorf("invalid nonce: %s", nonce)
+	}
+	if nonce := utils.SignatureNonce([]byte("invalid")); nonce != "" {
+		t.Error("nonce returned for invalid document")
+	}
+}
 
-	// New DID instance
-	id, _ := did.NewIdentifierWithMode("iadb", "", did.ModeUUID)
-	if err = id.AddNewKey("master", did.KeyTypeEd, did.EncodingBase58); err != nil {
-		t.Fatal(err)
+func TestRecordWindow(t *testing.T) {
+	rw := recordWindow{skew: time.Minute, maxAge: 24 * time.Hour}
+	now := time.Now()
+	checks := []struct {
+		ts    int64
+		valid bool
+	}{
+		{0, false},
+		{now.Unix(), true},
+		{now.Add(30 * time.Second).Unix(), true},
+		{now.Add(5 * time.Minute).Unix(), false},
+		{now.Add(-23 * time.Hour).Unix(), true},
+		{now.Add(-25 * time.Hour).Unix(), false},
 	}
-	if err = id.AddAuthenticationKey("master"); err != nil {
-		t.Fatal(err)
+	for i, c := range checks {
+		if rw.valid(c.ts) != c.valid {
+			t.Errorf("case %d: expected valid=%v", i, c.valid)
+		}
 	}
-	if err = id.AddProof("master", "sample-ct19.iadb.org"); err != nil {
+}
+
+func TestPublishTicket(t *testing.T) {
+	// New DID instance
+	id, err := newIdentifier("iadb", "sample-ct19.iadb.org")
+	if err != nil {
 		t.Fatal(err)
 	}
+	for _, k := range []string{keyAuthentication, keyAssertion, keyAgreement} {
+		if id.Key(k) == nil {
+			t.Errorf("missing key: %s", k)
+		}
+	}
 
 	// Get publish ticket
 	sd, _ := json.Marshal(id.SafeDocument())
@@ -74,9 +105,118 @@ func TestPublishTicket(t *testing.T) {
 		NonceValue: 0,
 	}
 	key := id.Key("master")
-	ticket.Signature, err = key.Sign(ticket.Solve(18))
+	digest, err := ticket.Solve(context.TODO(), 18, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ticket.Verify(digest, 18) {
+		t.Error("invalid proof-of-work solution")
+	}
+	ticket.Signature, err = key.Sign(digest)
 	if err != nil {
 		t.Fatal(err)
 	}
 	// ticket.Submit()
 }
+
+// In-memory storage for publish tickets.
+type memTickets map[string]*storage.PublishTicket
+
+func (mt memTickets) PublishTicket(id string) (*storage.PublishTicket, error) {
+	return mt[id], nil
+}
+
+func (mt memTickets) SavePublishTicket(t *storage.PublishTicket) error {
+	mt[t.ID] = t
+	return nil
+}
+
+func TestPublishTicketResume(t *testing.T) {
+	id, err := newIdentifier("iadb", "sample-ct19.iadb.org")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sd, _ := json.Marshal(id.SafeDocument())
+	store := memTickets{}
+
+	// Progress for the same document is resumed
+	store[id.String()] = &storage.PublishTicket{ID: id.String(), Timestamp: 100, Content: sd, Difficulty: 8}
+	ticket, _, err := newPublishTicket(context.TODO(), store, id.String(), id, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ticket.Timestamp != 100 {
+		t.Error("previous progress not resumed")
+	}
+
+	// Progress for a stale document is discarded
+	store[id.String()] = &storage.PublishTicket{ID: id.String(), Timestamp: 100, Content: []byte("{}"), Difficulty: 8}
+	ticket, _, err = newPublishTicket(context.TODO(), store, id.String(), id, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ticket.Timestamp == 100 || !bytes.Equal(ticket.Content, sd) {
+		t.Error("stale progress resumed")
+	}
+
+	// Completed tickets are not resumed
+	store[id.String()] = &storage.PublishTicket{ID: id.String(), Timestamp: 100, Content: sd, Difficulty: 8,
+		Status: storage.PublishCompleted}
+	ticket, status, err := newPublishTicket(context.TODO(), store, id.String(), id, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ticket.Timestamp == 100 || status.Status == storage.PublishCompleted {
+		t.Error("completed ticket resumed")
+	}
+}
+
+func TestValidCoordinates(t *testing.T) {
+	checks := []struct {
+		lat, lng, alt float32
+		valid         bool
+	}{
+		{0, 0, 0, true},
+		{-77.08672, 38.862848, 120, true},
+		{90, 180, 0, true},
+		{90.5, 0, 0, false},
+		{0, -180.1, 0, false},
+		{float32(math.NaN()), 0, 0, false},
+		{0, 0, -600, false},
+		{0, 0, 20000, false},
+	}
+	for i, c := range checks {
+		if (validCoordinates(c.lat, c.lng) && validAltitude(c.alt)) != c.valid {
+			t.Errorf("case %d: expected valid=%v", i, c.valid)
+		}
+	}
+}
+
+func TestGeneralizeRecord(t *testing.T) {
+	r := &protov1.LocationRecord{
+		Did:       "did:bryk:7889c965-4644-44ff-b760-f396f1d11444",
+		Lat:       19.432608,
+		Lng:       -179.996,
+		Alt:       2240,
+		Timestamp: 1588619270,
+	}
+	if r.IsGeneralized() {
+		t.Error("record is not generalized")
+	}
+	hash := r.GenerateHash()
+	r.Generalize()
+	if !r.IsGeneralized() || !r.AggregateOnly {
+		t.Fatal("record should be generalized")
+	}
+	if r.Lat != float32(19.43) || r.Lng != float32(-180) || r.Alt != 0 || r.Timestamp != 1588618800 {
+		t.Errorf("invalid generalized values: %v", r)
+	}
+
+	// The flag is covered by the record hash
+	r.AggregateOnly = false
+	plain := r.GenerateHash()
+	r.AggregateOnly = true
+	if r.GenerateHash() == plain || r.GenerateHash() == hash {
+		t.Error("aggregate-only flag should be included on the hash")
+	}
+}
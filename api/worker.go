@@ -3,9 +3,18 @@ package api
 import (
 	"context"
 	"crypto/rand"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
+	"runtime"
+	"sync"
+	"time"
 
+	"github.com/pkg/errors"
+	"go.bryk.io/covid-tracking/broker/local"
+	"go.bryk.io/covid-tracking/export"
+	"go.bryk.io/covid-tracking/federation"
+	"go.bryk.io/covid-tracking/fhir"
 	protov1 "go.bryk.io/covid-tracking/proto/v1"
 	"go.bryk.io/covid-tracking/storage"
 	"go.bryk.io/covid-tracking/utils"
@@ -25,13 +34,118 @@ type WorkerOptions struct {
 	// tasks and notifications.
 	Broker string
 
+	// TLS settings used to connect to "amqps" broker endpoints.
+	BrokerTLS *tls.Config
+
+	// Storage repositories used instead of connecting to 'Store', i.e. an
+	// embedded store shared with the API server on standalone instances.
+	Repositories storage.Repositories
+
+	// In-process broker used instead of connecting to 'Broker', to receive
+	// the tasks published by an API server running on the same process.
+	LocalBroker *local.Broker
+
+	// Number of partitions for the tasks queue. Must match the value used
+	// by the API servers.
+	TaskShards int
+
+	// Task partitions consumed by the worker, all partitions if not provided.
+	// Each partition must be consumed by a single worker for tasks from the
+	// same user to be processed in order.
+	Shards []int
+
+	// Endpoint of the broker management API, used to collect queue
+	// statistics. Credentials, if required, must be included on the URL.
+	// Queue statistics are disabled if not provided.
+	BrokerAdmin string
+
 	// Supported DID methods.
 	Providers []*did.Provider
 
+	// Location records older than this period will be removed from the
+	// main storage partitions. A zero value disables archival.
+	ArchiveAfter time.Duration
+
+	// Whether to discard archived records instead of moving them to cold
+	// storage.
+	ArchiveDiscard bool
+
+	// Maximum period of time to keep data on storage. A zero value disables
+	// the retention policy.
+	Retention time.Duration
+
+	// Tolerance for record timestamps ahead of the current time, to account
+	// for devices with slightly fast clocks.
+	ClockSkew time.Duration
+
+	// Records older than this period are rejected. If not provided, the
+	// retention period is used.
+	MaxRecordAge time.Duration
+
+	// Maximum number of location and check-in records stored per DID on
+	// each UTC day; records exceeding the quota are discarded. A zero value
+	// disables the quota.
+	DailyRecordQuota int
+
+	// Maximum number of goroutines used to validate the records on each
+	// task. Defaults to the number of CPUs available.
+	RecordValidators int
+
+	// Geohash precision used to generate analytics aggregates. A zero value
+	// disables analytics processing.
+	AnalyticsPrecision int
+
+	// Minimum number of distinct users an analytics aggregate must cover
+	// to be stored.
+	MinAnonymitySet int
+
+	// Federation gateway settings, used to exchange positive cases with other
+	// health authorities. A nil value disables federation.
+	Federation *federation.Config
+
+	// Settings to replicate diagnosed-case markers with peer servers. A nil
+	// value disables replication.
+	Replication *ReplicationConfig
+
+	// Used to export daily analytics aggregates for external consumption.
+	// A nil value disables exports.
+	Exporter *export.Exporter
+
+	// Cron expressions used to run recurring jobs, by job name. Jobs not
+	// included use their default schedule; an empty expression disables
+	// the job.
+	Schedule map[string]string
+
+	// PEM-encoded certificate files to check for upcoming expiration.
+	Certificates []string
+
+	// DID networks where new DIDs, and the ledger anchors, are published.
+	// If not provided, all DIDs are published to did.bryk.io.
+	PublishNetworks []*PublishNetwork
+
+	// Settings for the tamper-evident ledger over the records and audit
+	// entries stored. A nil value disables the ledger. Servers must enable
+	// it as well, see 'ServerOptions.Ledger'.
+	Ledger *LedgerConfig
+
 	// To handle output.
 	Logger xlog.Logger
 }
 
+// Period of time before a positive test during which contacts are
+// considered at risk.
+const exposureWindow = 14 * 24 * time.Hour
+
+// How often to synchronize with the federation gateway.
+const federationInterval = 1 * time.Hour
+
+// Retries for DIDs that failed to publish with a transient error. The delay
+// between attempts starts at 'publishRetryBackoff' and doubles every time.
+const (
+	publishRetries      = 5
+	publishRetryBackoff = 30 * time.Second
+)
+
 // Worker instances are responsible for asynchronously handling
 // incoming tasks and notifications from the broker.
 type Worker struct {
@@ -39,9 +153,29 @@ type Worker struct {
 	ctx       context.Context
 	halt      context.CancelFunc
 	sub       *amqp.Consumer
+	local     *local.Broker
+	pub       publisher
 	log       xlog.Logger
-	store     *storage.Handler
-	providers []*did.Provider
+	repos     storage.Repositories
+	archive   time.Duration
+	discard   bool
+	precision int
+	k         int
+	fed       *federation.Client
+	repl      *ReplicationConfig
+	exp       *export.Exporter
+	ingest    *ingester
+	jobs      []*scheduledJob
+	certs     []string
+	ledger    *LedgerConfig
+	dids      *didPublisher
+	queues    []string
+	monitor   *queueMonitor
+	dial      func() (*amqp.Consumer, error)
+	lost      chan *amqp.Consumer
+	hooks     *Hooks
+	started   time.Time
+	mu        sync.Mutex
 }
 
 // NewWorker returns a new worker instance.
@@ -53,22 +187,73 @@ func NewWorker(opts *WorkerOptions) (*Worker, error) {
 	// Get worker instance
 	w := &Worker{
 		name:      fmt.Sprintf("worker-%x", seed),
-		providers: opts.Providers,
 		log:       opts.Logger,
+		archive:   opts.ArchiveAfter,
+		discard:   opts.ArchiveDiscard,
+		precision: opts.AnalyticsPrecision,
+		k:         opts.MinAnonymitySet,
+		exp:       opts.Exporter,
+		repl:      opts.Replication,
+		certs:     opts.Certificates,
+		ledger:    opts.Ledger,
+		hooks:     newHooks(),
+		started:   time.Now(),
+	}
+
+	// Recurring jobs
+	if err := w.setupJobs(opts.Schedule); err != nil {
+		return nil, err
+	}
+
+	// Task partitions
+	if w.queues, err = taskQueues(opts.TaskShards, opts.Shards); err != nil {
+		return nil, err
+	}
+	if w.monitor, err = newQueueMonitor(opts.BrokerAdmin, opts.Broker); err != nil {
+		return nil, err
+	}
+
+	// Get federation client
+	if opts.Federation != nil {
+		if w.fed, err = federation.NewClient(opts.Federation); err != nil {
+			return nil, err
+		}
 	}
 
 	// Get storage handler
-	w.store, err = storage.NewHandler(opts.Store)
-	if err != nil {
+	if w.repos = opts.Repositories; w.repos == nil {
+		storeOpts := []storage.Option{storage.WithRetention(opts.Retention)}
+		if opts.Ledger != nil {
+			storeOpts = append(storeOpts, storage.WithLedger())
+		}
+		store, err := storage.NewHandler(opts.Store, storeOpts...)
+		if err != nil {
+			return nil, err
+		}
+		if pending, err := store.PendingMigrations(); err == nil && pending > 0 {
+			w.log.WithField("pending", pending).Warning("storage schema is outdated, run 'ct19 migrate up'")
+		}
+		w.repos = store
+	}
+	if w.dids, err = newDIDPublisher(opts.PublishNetworks, w.repos.Tickets()); err != nil {
 		return nil, err
 	}
+	w.ingest = &ingester{
+		log:       w.log,
+		repos:     w.repos,
+		providers: opts.Providers,
+		window:    newRecordWindow(opts.ClockSkew, opts.MaxRecordAge, opts.Retention),
+		quota:     &ingestionQuota{counters: w.repos.Quotas(), limit: opts.DailyRecordQuota},
+	}
+	if w.ingest.validators = opts.RecordValidators; w.ingest.validators <= 0 {
+		w.ingest.validators = runtime.NumCPU()
+	}
 
-	w.sub, err = amqp.NewConsumer(opts.Broker, []amqp.Option{
-		amqp.WithTopology(utils.BrokerTopology()),
-		amqp.WithName(w.name),
-		amqp.WithLogger(w.log),
-	}...)
-	if err != nil {
+	// Tasks are received from the in-process broker, when provided
+	if opts.LocalBroker != nil {
+		w.local = opts.LocalBroker
+		w.pub = opts.LocalBroker
+	} else if err = w.setupBroker(opts); err != nil {
 		return nil, err
 	}
 
@@ -78,12 +263,37 @@ func NewWorker(opts *WorkerOptions) (*Worker, error) {
 	return w, nil
 }
 
+// Connect to the message broker, used to receive tasks and to dispatch
+// notifications.
+func (w *Worker) setupBroker(opts *WorkerOptions) (err error) {
+	w.dial = func() (*amqp.Consumer, error) {
+		return amqp.NewConsumer(opts.Broker,
+			brokerOptions(w.log, opts.BrokerTLS, opts.TaskShards, amqp.WithName(w.name))...)
+	}
+	if w.sub, err = w.dial(); err != nil {
+		return err
+	}
+	w.lost = make(chan *amqp.Consumer, 2)
+	w.pub, err = amqp.NewPublisher(opts.Broker, brokerOptions(w.log.Sub(xlog.Fields{
+		"component": "amqp",
+	}), opts.BrokerTLS, opts.TaskShards)...)
+	return err
+}
+
 // Close properly finish the worker execution.
 func (w *Worker) Close() {
 	w.halt()
 	<-w.ctx.Done()
-	_ = w.sub.Close()
-	w.store.Close()
+	if err := w.repos.WorkerRegistry().RemoveWorker(w.name); err != nil {
+		w.log.WithField("error", err.Error()).Warning("failed to remove worker heartbeat")
+	}
+	w.mu.Lock()
+	if w.sub != nil {
+		_ = w.sub.Close()
+	}
+	w.mu.Unlock()
+	_ = w.pub.Close()
+	w.repos.Close()
 }
 
 // Name returns the worker unique identifier.
@@ -91,14 +301,42 @@ func (w *Worker) Name() string {
 	return w.name
 }
 
-// Process messages received from the "tasks" queue.
-func (w *Worker) handleTasks(deliveries <-chan amqp.Delivery) {
+// Names of the task queues consumed by the worker.
+func taskQueues(shards int, consume []int) ([]string, error) {
+	if shards <= 1 {
+		return []string{utils.TaskQueue(0, shards)}, nil
+	}
+	if len(consume) == 0 {
+		for i := 0; i < shards; i++ {
+			consume = append(consume, i)
+		}
+	}
+	var list []string
+	for _, i := range consume {
+		if i < 0 || i >= shards {
+			return nil, errors.Errorf("invalid task shard: %d", i)
+		}
+		list = append(list, utils.TaskQueue(i, shards))
+	}
+	return list, nil
+}
+
+// Process messages received from a tasks queue. Messages are handled
+// sequentially, in the order they were published.
+func (w *Worker) handleTasks(queue string, deliveries <-chan amqp.Delivery) {
 	for msg := range deliveries {
+		w.monitor.delivered(queue, msg)
 		switch msg.Type {
 		case "ct19.location_record":
 			w.locationRecord(msg)
 		case "ct19.new_did":
 			w.publishDID(msg)
+		case "ct19.check_in":
+			w.checkIn(msg)
+		case "ct19.venue_outbreak":
+			w.venueOutbreak(msg)
+		case "ct19.job":
+			w.batchJob(msg)
 		default:
 			w.log.WithFields(xlog.Fields{
 				"kind":         msg.Type,
@@ -111,86 +349,563 @@ func (w *Worker) handleTasks(deliveries <-chan amqp.Delivery) {
 	}
 }
 
+// Process lab results received from the "fhir" queue. Resources can be
+// submitted through the API server or published directly to the broker
+// by laboratory systems.
+func (w *Worker) handleLabResults(deliveries <-chan amqp.Delivery) {
+	for msg := range deliveries {
+		w.monitor.delivered("fhir", msg)
+		w.labResult(msg)
+	}
+}
+
+// Store diagnosis events included in a FHIR resource.
+func (w *Worker) labResult(msg amqp.Delivery) {
+	log := w.logger(msg)
+	defer func() {
+		_ = msg.Ack(false)
+	}()
+
+	list, err := fhir.Decode(msg.Body)
+	if err != nil {
+		log.WithField("error", err.Error()).Warning("invalid lab result")
+		return
+	}
+	for _, entry := range list {
+		d, err := w.repos.Exposures().Diagnosis(entry.Subject, string(entry.Result), entry.Source, entry.Date)
+		if err != nil {
+			log.WithField("error", err.Error()).Error("failed to save diagnosis")
+			continue
+		}
+		w.event(eventDiagnosisStored, d.Did, map[string]string{
+			"diagnosis": d.Id,
+			"result":    d.Result,
+			"source":    d.Source,
+		})
+		log.WithFields(xlog.Fields{
+			"id":     d.Id,
+			"source": d.Source,
+		}).Info("diagnosis processed")
+		if entry.Result == fhir.Positive {
+			w.detectExposures(d)
+		}
+	}
+}
+
+// Notify all users that were in contact with a positive case during
+// the exposure window.
+func (w *Worker) detectExposures(d *protov1.Diagnosis) {
+	from := time.Unix(d.Timestamp, 0).Add(-1 * exposureWindow)
+	contacts, err := w.repos.Records().Contacts(d.Did, from, time.Now())
+	if err != nil {
+		w.log.WithField("error", err.Error()).Error("failed to retrieve contacts")
+		return
+	}
+	for _, contact := range contacts {
+		e, err := w.repos.Exposures().Exposure(contact, d.Id)
+		if err != nil {
+			w.log.WithField("error", err.Error()).Error("failed to save exposure")
+			continue
+		}
+		w.notify(contact, "exposure", d.Id, map[string]string{
+			"exposure": e.Id,
+		})
+		w.event(eventExposureDetected, contact, map[string]string{
+			"exposure":  e.Id,
+			"diagnosis": d.Id,
+		})
+	}
+	w.log.WithFields(xlog.Fields{
+		"diagnosis": d.Id,
+		"exposures": len(contacts),
+	}).Info("exposures processed")
+
+	// Share the case with other authorities and peer servers
+	if w.fed == nil && w.repl == nil {
+		return
+	}
+	cells, err := w.repos.Records().Cells(d.Did, from, time.Now())
+	if err != nil {
+		w.log.WithField("error", err.Error()).Error("failed to retrieve cells")
+		return
+	}
+	if w.fed != nil {
+		if err := w.repos.Federation().QueueFederationKeys(d.Id, cells); err != nil {
+			w.log.WithField("error", err.Error()).Error("failed to queue federation keys")
+		}
+	}
+	if w.repl != nil {
+		if err := w.repos.Replication().RecordMarkers(d.Id, cells); err != nil {
+			w.log.WithField("error", err.Error()).Error("failed to record replication markers")
+		}
+	}
+}
+
+// Upload pending keys to the federation gateway and process the batches
+// published by other authorities.
+func (w *Worker) federationSync() {
+	// Upload
+	now := time.Now()
+	cells, err := w.repos.Federation().PendingFederationKeys()
+	if err != nil {
+		w.log.WithField("error", err.Error()).Error("failed to retrieve federation keys")
+		return
+	}
+	if len(cells) > 0 {
+		keys := make([]federation.Key, len(cells))
+		for i, c := range cells {
+			keys[i] = federation.Key{Cell: c.ID, Bucket: c.Bucket}
+		}
+		tag, err := w.fed.Upload(keys)
+		if err != nil {
+			w.log.WithField("error", err.Error()).Warning("federation upload failed")
+		} else if err := w.repos.Federation().FederationKeysUploaded(now, tag); err != nil {
+			w.log.WithField("error", err.Error()).Error("failed to update federation keys")
+		} else {
+			w.log.WithFields(xlog.Fields{
+				"batch": tag,
+				"keys":  len(keys),
+			}).Info("federation batch uploaded")
+		}
+	}
+
+	// Download
+	batches, err := w.fed.Download(now)
+	if err != nil {
+		w.log.WithField("error", err.Error()).Warning("federation download failed")
+	}
+	for _, b := range batches {
+		if ok, err := w.repos.Federation().FederationBatchProcessed(b.Origin, b.Tag); !ok || err != nil {
+			continue
+		}
+		w.federationBatch(b)
+	}
+}
+
+// Notify all local users present on the location cells included in a
+// batch received from another authority.
+func (w *Worker) federationBatch(b *federation.Batch) {
+	cells := make([]storage.Cell, len(b.Keys))
+	for i, k := range b.Keys {
+		cells[i] = storage.Cell{ID: k.Cell, Bucket: k.Bucket}
+	}
+	users, err := w.repos.Records().PresentAt(cells)
+	if err != nil {
+		w.log.WithField("error", err.Error()).Error("failed to match federation batch")
+		return
+	}
+	w.exposures(users, fmt.Sprintf("federation:%s:%s", b.Origin, b.Tag))
+	w.log.WithFields(xlog.Fields{
+		"origin":    b.Origin,
+		"batch":     b.Tag,
+		"exposures": len(users),
+	}).Info("federation batch processed")
+}
+
+// Register and notify the exposure of local users to a case reported by an
+// external source.
+func (w *Worker) exposures(users []string, source string) {
+	for _, user := range users {
+		e, err := w.repos.Exposures().Exposure(user, source)
+		if err != nil {
+			w.log.WithField("error", err.Error()).Error("failed to save exposure")
+			continue
+		}
+		w.notify(user, "exposure", source, map[string]string{
+			"exposure": e.Id,
+		})
+		w.event(eventExposureDetected, user, map[string]string{
+			"exposure":  e.Id,
+			"diagnosis": source,
+		})
+	}
+}
+
 // Validate and save location records.
 func (w *Worker) locationRecord(msg amqp.Delivery) {
+	log := w.logger(msg)
 	defer func() {
 		_ = msg.Ack(false)
 	}()
 
 	// Get author DID
-	userDID, ok := msg.Headers["did"]
+	userDID, ok := msg.Headers["did"].(string)
 	if !ok {
-		w.log.Error("record without DID")
+		log.Error("record without DID")
 		return
 	}
 
 	// Decode message contents
 	req := &protov1.RecordRequest{}
 	if err := req.Unmarshal(msg.Body); err != nil {
-		w.log.Error("invalid record contents")
+		log.Error("invalid record contents")
 		return
 	}
 
-	// Resolve DID document for the credential's subject
-	id, err := utils.ResolveDID(userDID.(string), w.providers)
+	// Validate and store records
+	res, err := w.ingest.locations(userDID, req)
+	w.finishSubmission(userDID, msg.MessageId, res, err)
 	if err != nil {
-		w.log.Error("invalid DID")
+		log.WithField("error", err.Error()).Error("failed to process record")
 		return
 	}
 
-	// Validate records
-	var records []*protov1.LocationRecord
-	for _, r := range req.Records {
-		if validateRecord(id, r) {
-			records = append(records, r)
-		}
+	// Success message
+	w.event(eventRecordStored, userDID, map[string]string{
+		"records": fmt.Sprintf("%d", res.accepted()),
+	})
+	log.WithFields(xlog.Fields{
+		"did":       userDID,
+		"timestamp": msg.Timestamp.Unix(),
+	}).Info("location record processed")
+}
+
+// Record the final status of a records submission, if a receipt was issued
+// for it.
+func (w *Worker) finishSubmission(author, receipt string, res ingestResult, err error) {
+	sub, serr := w.repos.Submissions().Submission(author, receipt)
+	if serr != nil {
+		return
+	}
+	if err == nil {
+		sub.Records = submissionStatus(sub.Records, res)
+	}
+	if serr = w.repos.Submissions().FinishSubmission(receipt, sub.Records, err); serr != nil {
+		w.log.WithField("error", serr.Error()).Warning("failed to update submission")
+	}
+}
+
+// Validate and save check-in records.
+func (w *Worker) checkIn(msg amqp.Delivery) {
+	log := w.logger(msg)
+	defer func() {
+		_ = msg.Ack(false)
+	}()
+
+	// Get author DID
+	userDID, ok := msg.Headers["did"].(string)
+	if !ok {
+		log.Error("check-in without DID")
+		return
 	}
 
-	// Store valid records and return final result
-	if err := w.store.LocationRecords(records); err != nil {
-		w.log.WithField("error", err.Error()).Error("failed to save record")
+	// Decode message contents
+	req := &protov1.CheckInRequest{}
+	if err := req.Unmarshal(msg.Body); err != nil {
+		log.Error("invalid check-in contents")
+		return
+	}
+
+	// Validate and store records
+	res, err := w.ingest.checkIns(userDID, req)
+	if err != nil {
+		log.WithField("error", err.Error()).Error("failed to process check-in")
+		return
+	}
+	if res.accepted() == 0 {
 		return
 	}
 
 	// Success message
-	w.log.WithFields(xlog.Fields{
-		"did":       userDID.(string),
+	log.WithFields(xlog.Fields{
+		"did":       userDID,
 		"timestamp": msg.Timestamp.Unix(),
-	}).Info("location record processed")
+	}).Info("check-in processed")
 }
 
-// Publish a new DID instance.
-func (w *Worker) publishDID(msg amqp.Delivery) {
+// Notify all users that visited a venue during an outbreak's exposure window.
+func (w *Worker) venueOutbreak(msg amqp.Delivery) {
+	log := w.logger(msg)
 	defer func() {
 		_ = msg.Ack(false)
 	}()
 
+	// Decode message contents
+	req := &protov1.VenueOutbreakRequest{}
+	if err := req.Unmarshal(msg.Body); err != nil {
+		log.Error("invalid outbreak contents")
+		return
+	}
+
+	// Get visitors
+	visitors, err := w.repos.Records().Visitors(req.Venue, time.Unix(req.From, 0), time.Unix(req.To, 0))
+	if err != nil {
+		log.WithField("error", err.Error()).Error("failed to retrieve venue visitors")
+		return
+	}
+
+	// Notify visitors
+	details := map[string]string{
+		"venue": req.Venue,
+		"name":  w.repos.Venues().VenueName(req.Venue),
+		"from":  fmt.Sprintf("%d", req.From),
+		"to":    fmt.Sprintf("%d", req.To),
+	}
+	for _, visitor := range visitors {
+		w.notify(visitor, "venue_outbreak", req.Venue, details)
+	}
+	log.WithFields(xlog.Fields{
+		"venue":    req.Venue,
+		"notified": len(visitors),
+	}).Info("venue outbreak processed")
+}
+
+// Return a logger instance including the correlation details of the
+// provided message.
+func (w *Worker) logger(msg amqp.Delivery) xlog.Logger {
+	fields := xlog.Fields{"message": msg.MessageId}
+	if id, ok := msg.Headers["request_id"].(string); ok && id != "" {
+		fields["request_id"] = id
+	}
+	return w.log.WithFields(fields)
+}
+
+// Store and dispatch a new notification for the user 'recipient'. 'source'
+// is the diagnosis or venue that originated the notification.
+func (w *Worker) notify(recipient, kind, source string, details map[string]string) {
+	n, err := w.repos.Notifications().Notification(recipient, kind, source, details)
+	if err != nil {
+		w.log.WithField("error", err.Error()).Error("failed to save notification")
+		return
+	}
+	contents, err := n.Marshal()
+	if err != nil {
+		return
+	}
+	msg := amqp.Message{
+		Type:        "ct19.notification",
+		Timestamp:   time.Now().UTC(),
+		MessageId:   n.Id,
+		ContentType: "application/protobuf",
+		Body:        contents,
+		Headers: map[string]interface{}{
+			"did": recipient,
+		},
+	}
+	confirmed, err := w.pub.Push(msg, amqp.MessageOptions{
+		Exchange:   "notifications",
+		Persistent: true,
+	})
+	if err == nil && !confirmed {
+		err = errors.New("message not confirmed by the broker")
+	}
+	if err != nil {
+		w.log.WithField("id", n.Id).Warning("failed to dispatch notification")
+	}
+	if err := w.repos.Notifications().NotificationAttempt(n.Id, err); err != nil {
+		w.log.WithField("error", err.Error()).Warning("failed to register notification attempt")
+	}
+}
+
+// Publish a new DID instance. The message is acknowledged once the DID is
+// published, rejected by a network, or after the maximum number of retries
+// for transient failures; if the worker is closed before, the message is
+// delivered again and the proof-of-work resumed from the progress saved.
+func (w *Worker) publishDID(msg amqp.Delivery) {
+	log := w.logger(msg)
+
 	// Decode DID document
 	doc := did.Document{}
 	if err := json.Unmarshal(msg.Body, &doc); err != nil {
-		w.log.Warning("invalid message contents")
+		log.Warning("invalid message contents")
+		_ = msg.Ack(false)
+		return
 	}
 	id, err := did.FromDocument(&doc)
 	if err != nil {
-		w.log.Warning("invalid message contents")
+		log.Warning("invalid message contents")
+		_ = msg.Ack(false)
+		return
 	}
 
 	// Submit publish request
-	go publishDID(id, 18, w.log)
+	go func() {
+		backoff := publishRetryBackoff
+		for attempt := 1; ; attempt++ {
+			err := publishDID(w.ctx, w.dids, id, 18, log)
+			if w.ctx.Err() != nil {
+				return
+			}
+			if err == nil || !retryPublish(err) || attempt >= publishRetries {
+				break
+			}
+			select {
+			case <-w.ctx.Done():
+				return
+			case <-time.After(backoff):
+				backoff *= 2
+			}
+		}
+		_ = msg.Ack(false)
+	}()
+}
+
+// Move old location records out of the main storage partitions.
+func (w *Worker) archiveRecords() error {
+	cutoff := time.Now().Add(-1 * w.archive)
+	archived, err := w.repos.Records().ArchiveRecords(cutoff, w.discard)
+	for _, name := range archived {
+		w.log.WithFields(xlog.Fields{
+			"partition": name,
+			"discard":   w.discard,
+		}).Info("records partition archived")
+	}
+	return errors.Wrap(err, "failed to archive records")
+}
+
+// Permanently remove data older than the retention period. TTL indexes
+// already handle most of the expired entries, the purge process ensures
+// no expired data remains on storage, including cold storage. TTL indexes
+// are not used while legal holds are in place.
+func (w *Worker) purgeRecords() error {
+	total, err := w.repos.Purge()
+	if err != nil {
+		return errors.Wrap(err, "failed to purge expired records")
+	}
+	w.log.WithField("deleted", total).Info("expired records purged")
+	return nil
+}
+
+// Generate anonymized hotspots and movement flows for the previous day.
+func (w *Worker) analytics() error {
+	to := time.Now().UTC().Truncate(24 * time.Hour)
+	from := to.Add(-24 * time.Hour)
+	hotspots, flows, err := w.repos.Records().ClusterRecords(from, to, w.precision, w.k)
+	if err != nil {
+		return errors.Wrap(err, "failed to cluster records")
+	}
+	if err := w.repos.Aggregates().SaveAnalytics(hotspots, flows); err != nil {
+		return errors.Wrap(err, "failed to save analytics")
+	}
+	w.log.WithFields(xlog.Fields{
+		"hotspots": len(hotspots),
+		"flows":    len(flows),
+	}).Info("analytics processed")
+	if w.exp != nil {
+		return w.exportAnalytics(from, to)
+	}
+	return nil
+}
+
+// Export the stored aggregates for the provided period.
+func (w *Worker) exportAnalytics(from, to time.Time) error {
+	hotspots, flows, err := w.repos.Aggregates().Analytics(from, to)
+	if err != nil {
+		return errors.Wrap(err, "failed to retrieve analytics")
+	}
+	if err := w.exp.Export(from, export.Hotspots(hotspots), export.Flows(flows)); err != nil {
+		return errors.Wrap(err, "failed to export analytics")
+	}
+	w.log.WithField("date", from.Format("2006-01-02")).Info("analytics exported")
+	return nil
+}
+
+// Open the "tasks" and "fhir" subscriptions. The consumer is reported as
+// lost when a subscription can't be opened or is closed.
+func (w *Worker) subscribe(sub *amqp.Consumer) {
+	for _, queue := range w.queues {
+		deliveries, _, err := sub.Subscribe(amqp.SubscribeOptions{Queue: queue})
+		if err != nil {
+			w.log.WithField("queue", queue).Warning("failed to open tasks subscription")
+			w.connectionLost(sub)
+			return
+		}
+		go func(queue string) {
+			w.handleTasks(queue, deliveries)
+			w.connectionLost(sub)
+		}(queue)
+	}
+	results, _, err := sub.Subscribe(amqp.SubscribeOptions{Queue: "fhir"})
+	if err != nil {
+		w.log.Warning("failed to open fhir subscription")
+		w.connectionLost(sub)
+		return
+	}
+	go func() {
+		w.handleLabResults(results)
+		w.connectionLost(sub)
+	}()
+}
+
+// Report a consumer whose subscriptions are no longer active.
+func (w *Worker) connectionLost(sub *amqp.Consumer) {
+	select {
+	case w.lost <- sub:
+	default:
+	}
 }
 
 // Internal event processing
 func (w *Worker) eventLoop() {
+	// Recurring jobs
+	go w.scheduler()
+
+	// Federation synchronization runs every hour
+	fed := time.NewTicker(federationInterval)
+	defer fed.Stop()
+
+	// Replication with peer servers
+	repl := time.NewTicker(replicationInterval)
+	defer repl.Stop()
+
+	// Queue statistics
+	queues := time.NewTicker(queueStatsInterval)
+	defer queues.Stop()
+
+	// Worker heartbeat
+	w.heartbeat()
+	hb := time.NewTicker(heartbeatInterval)
+	defer hb.Stop()
+
+	// Broker subscriptions
+	if w.local != nil {
+		w.consumeLocal()
+	} else {
+		go w.consume()
+	}
+
+	for {
+		select {
+		case <-w.ctx.Done():
+			return
+		case <-fed.C:
+			if w.fed != nil {
+				w.federationSync()
+			}
+		case <-repl.C:
+			if w.repl != nil {
+				w.replicationSync()
+			}
+		case <-queues.C:
+			w.queueStats()
+		case <-hb.C:
+			w.heartbeat()
+		}
+	}
+}
+
+// Open the subscriptions once the broker consumer is ready, and replace the
+// consumer after the broker connection is lost.
+func (w *Worker) consume() {
 	for {
 		select {
 		case <-w.ctx.Done():
 			return
 		case <-w.sub.Ready():
-			deliveries, _, err := w.sub.Subscribe(amqp.SubscribeOptions{Queue: "tasks"})
-			if err != nil {
-				w.log.Warning("failed to open tasks subscription")
+			w.subscribe(w.sub)
+		case sub := <-w.lost:
+			// Ignore notifications from previous consumers
+			if sub == w.sub && !w.reconnectConsumer() {
+				return
 			}
-			go w.handleTasks(deliveries)
 		}
 	}
 }
+
+// Process the messages routed by the in-process broker.
+func (w *Worker) consumeLocal() {
+	for _, queue := range w.queues {
+		go w.handleTasks(queue, w.local.Consume(queue))
+	}
+	go w.handleLabResults(w.local.Consume("fhir"))
+}
@@ -0,0 +1,42 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"go.bryk.io/covid-tracking/broker/memtest"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	storetest "go.bryk.io/covid-tracking/storage/memtest"
+	xlog "go.bryk.io/x/log"
+)
+
+func TestDetectExposures(t *testing.T) {
+	store := storetest.New()
+	pub := memtest.NewPublisher()
+	w := &Worker{repos: store, pub: pub, log: xlog.WithZero(false)}
+
+	// A contact on the same cell and time bucket, and a user elsewhere
+	now := time.Now().Unix()
+	_ = store.Records().LocationRecords([]*protov1.LocationRecord{
+		{Did: "did:bryk:case", Lat: 19.4326, Lng: -99.1332, Timestamp: now},
+		{Did: "did:bryk:contact", Lat: 19.4326, Lng: -99.1332, Timestamp: now},
+		{Did: "did:bryk:other", Lat: 20.6597, Lng: -103.3496, Timestamp: now},
+	})
+	d, _ := store.Exposures().Diagnosis("did:bryk:case", "positive", "test", time.Now())
+	w.detectExposures(d)
+
+	exposed, _ := store.Exposures().Exposed(d.Id)
+	if len(exposed) != 1 || exposed[0] != "did:bryk:contact" {
+		t.Errorf("invalid exposures: %v", exposed)
+	}
+	notifications := pub.Messages("notifications")
+	if len(notifications) != 1 || notifications[0].Message.Headers["did"] != "did:bryk:contact" {
+		t.Error("contact not notified")
+	}
+	if len(store.UserNotifications("did:bryk:contact")) != 1 {
+		t.Error("notification not stored")
+	}
+	if len(pub.Messages("events")) != 1 {
+		t.Error("exposure event not published")
+	}
+}
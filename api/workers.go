@@ -0,0 +1,53 @@
+package api
+
+import (
+	"time"
+
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+)
+
+// How often workers report a heartbeat.
+const heartbeatInterval = 30 * time.Second
+
+// Workers missing this many consecutive heartbeats are no longer considered
+// live.
+const missedHeartbeats = 3
+
+// Report the worker status on storage.
+func (w *Worker) heartbeat() {
+	processed, last := w.monitor.activity()
+	status := &protov1.WorkerStatus{
+		Name:      w.name,
+		Queues:    append([]string{"fhir"}, w.queues...),
+		Started:   w.started.Unix(),
+		Processed: processed,
+	}
+	if !last.IsZero() {
+		status.LastProcessed = last.Unix()
+	}
+	if err := w.repos.WorkerRegistry().SaveHeartbeat(status); err != nil {
+		w.log.WithField("error", err.Error()).Warning("failed to save worker heartbeat")
+	}
+}
+
+// Whether a worker reported its last heartbeat recently enough to be
+// considered live.
+func workerLive(status *protov1.WorkerStatus, now time.Time) bool {
+	return now.Sub(time.Unix(status.Heartbeat, 0)) <= missedHeartbeats*heartbeatInterval
+}
+
+// ListWorkers returns the status reported by the workers. Workers that stop
+// reporting heartbeats are still included, flagged as not live, until their
+// entry expires; a live worker with messages ready on its queues but no
+// recent processing activity is likely a stuck consumer.
+func (srv *Server) ListWorkers() (*protov1.ListWorkersResponse, error) {
+	list, err := srv.repos.WorkerRegistry().Workers()
+	if err != nil {
+		return nil, errInternalError
+	}
+	now := time.Now()
+	for _, w := range list {
+		w.Live = workerLive(w, now)
+	}
+	return &protov1.ListWorkersResponse{Workers: list}, nil
+}
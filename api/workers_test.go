@@ -0,0 +1,20 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+)
+
+func TestWorkerLive(t *testing.T) {
+	now := time.Now()
+	status := &protov1.WorkerStatus{Heartbeat: now.Add(-heartbeatInterval).Unix()}
+	if !workerLive(status, now) {
+		t.Error("worker should be live")
+	}
+	status.Heartbeat = now.Add(-4 * heartbeatInterval).Unix()
+	if workerLive(status, now) {
+		t.Error("worker should not be live")
+	}
+}
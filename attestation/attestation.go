@@ -0,0 +1,94 @@
+package attestation
+
+import (
+	"crypto/sha256"
+
+	"github.com/pkg/errors"
+)
+
+// Supported platforms.
+const (
+	// Google Play Integrity API.
+	PlayIntegrity = "android"
+
+	// Google SafetyNet Attestation API.
+	SafetyNet = "safetynet"
+
+	// Apple DeviceCheck.
+	DeviceCheck = "ios"
+)
+
+// Config provides the settings for each supported platform; attestation
+// tokens for platforms not configured will be rejected.
+type Config struct {
+	// Google Play Integrity API.
+	Android *PlayIntegrityConfig
+
+	// Google SafetyNet Attestation API.
+	SafetyNet *SafetyNetConfig
+
+	// Apple DeviceCheck.
+	Apple *DeviceCheckConfig
+}
+
+// Verifier instances validate attestation tokens for a specific platform.
+type Verifier interface {
+	// Verify the provided token was produced by a genuine app installation
+	// for the expected nonce value.
+	Verify(token string, nonce []byte) error
+}
+
+// Service verifies attestation tokens from all configured platforms.
+type Service struct {
+	verifiers map[string]Verifier
+}
+
+// New returns a service instance for the provided configuration.
+func New(conf *Config) (*Service, error) {
+	s := &Service{verifiers: make(map[string]Verifier)}
+	if conf.Android != nil {
+		v, err := newPlayIntegrity(conf.Android)
+		if err != nil {
+			return nil, err
+		}
+		s.verifiers[PlayIntegrity] = v
+	}
+	if conf.SafetyNet != nil {
+		v, err := newSafetyNet(conf.SafetyNet)
+		if err != nil {
+			return nil, err
+		}
+		s.verifiers[SafetyNet] = v
+	}
+	if conf.Apple != nil {
+		v, err := newDeviceCheck(conf.Apple)
+		if err != nil {
+			return nil, err
+		}
+		s.verifiers[DeviceCheck] = v
+	}
+	if len(s.verifiers) == 0 {
+		return nil, errors.New("no attestation platform configured")
+	}
+	return s, nil
+}
+
+// Verify an attestation token produced on the specified platform.
+func (s *Service) Verify(platform, token string, nonce []byte) error {
+	v, ok := s.verifiers[platform]
+	if !ok {
+		return errors.Errorf("unsupported platform: %s", platform)
+	}
+	if token == "" {
+		return errors.New("empty attestation token")
+	}
+	return v.Verify(token, nonce)
+}
+
+// Nonce returns the value clients must use when requesting an attestation
+// token for an activation request; i.e. the SHA-256 digest of the string
+// "<did>:<activation_code>".
+func Nonce(did, code string) []byte {
+	n := sha256.Sum256([]byte(did + ":" + code))
+	return n[:]
+}
@@ -0,0 +1,181 @@
+package attestation
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Generate a sample certificate, signed by 'parent' if provided.
+func sampleCert(t *testing.T, name string, ca bool,
+	parent *x509.Certificate, signer *rsa.PrivateKey) (*x509.Certificate, *rsa.PrivateKey) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  ca,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	if !ca {
+		tpl.DNSNames = []string{name}
+	}
+	if parent == nil {
+		parent, signer = tpl, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tpl, parent, &key.PublicKey, signer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+	return cert, key
+}
+
+func TestSafetyNet(t *testing.T) {
+	root, rootKey := sampleCert(t, "Sample Root CA", true, nil, nil)
+	leaf, leafKey := sampleCert(t, "attest.android.com", false, root, rootKey)
+	roots := x509.NewCertPool()
+	roots.AddCert(root)
+
+	nonce := Nonce("did:bryk:sample", "123456")
+	statement := func(payload map[string]interface{}) string {
+		h, _ := json.Marshal(map[string]interface{}{
+			"alg": "RS256",
+			"x5c": []string{base64.StdEncoding.EncodeToString(leaf.Raw)},
+		})
+		p, _ := json.Marshal(payload)
+		input := base64.RawURLEncoding.EncodeToString(h) + "." + base64.RawURLEncoding.EncodeToString(p)
+		digest := sha256.Sum256([]byte(input))
+		sig, _ := rsa.SignPKCS1v15(rand.Reader, leafKey, crypto.SHA256, digest[:])
+		return input + "." + base64.RawURLEncoding.EncodeToString(sig)
+	}
+	payload := func() map[string]interface{} {
+		return map[string]interface{}{
+			"nonce":           base64.StdEncoding.EncodeToString(nonce),
+			"timestampMs":     time.Now().UnixNano() / int64(time.Millisecond),
+			"apkPackageName":  "io.bryk.ct19",
+			"basicIntegrity":  true,
+			"ctsProfileMatch": false,
+		}
+	}
+
+	sn, _ := newSafetyNet(&SafetyNetConfig{PackageName: "io.bryk.ct19"})
+	sn.roots = roots
+	if err := sn.Verify(statement(payload()), nonce); err != nil {
+		t.Fatal(err)
+	}
+
+	// Invalid nonce
+	if err := sn.Verify(statement(payload()), Nonce("did:bryk:sample", "654321")); err == nil {
+		t.Error("invalid nonce")
+	}
+
+	// CTS profile required
+	sn.conf.RequireCTS = true
+	if err := sn.Verify(statement(payload()), nonce); err == nil {
+		t.Error("CTS profile match required")
+	}
+	sn.conf.RequireCTS = false
+
+	// Expired statement
+	p := payload()
+	p["timestampMs"] = time.Now().Add(-time.Hour).UnixNano() / int64(time.Millisecond)
+	if err := sn.Verify(statement(p), nonce); err == nil {
+		t.Error("expired statement")
+	}
+
+	// Tampered payload
+	st := strings.Split(statement(payload()), ".")
+	p = payload()
+	p["apkPackageName"] = "io.sample.fake"
+	fake, _ := json.Marshal(p)
+	st[1] = base64.RawURLEncoding.EncodeToString(fake)
+	if err := sn.Verify(strings.Join(st, "."), nonce); err == nil {
+		t.Error("invalid signature")
+	}
+
+	// Untrusted certificate
+	sn.roots = x509.NewCertPool()
+	if err := sn.Verify(statement(payload()), nonce); err == nil {
+		t.Error("untrusted certificate")
+	}
+}
+
+func TestDeviceCheck(t *testing.T) {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	der, _ := x509.MarshalPKCS8PrivateKey(key)
+	conf := &DeviceCheckConfig{
+		TeamID: "TEAM123456",
+		KeyID:  "KEY1234567",
+		Key:    pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}),
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := strings.Split(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "), ".")
+		if r.URL.Path != "/v1/validate_device_token" || len(auth) != 3 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		sig, _ := base64.RawURLEncoding.DecodeString(auth[2])
+		digest := sha256.Sum256([]byte(auth[0] + "." + auth[1]))
+		r1, s1 := new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:])
+		if !ecdsa.Verify(&key.PublicKey, digest[:], r1, s1) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		req := map[string]interface{}{}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		if req["device_token"] != "genuine-device" {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer srv.Close()
+
+	dc, err := newDeviceCheck(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dc.endpoint = srv.URL
+	if err := dc.Verify("genuine-device", nil); err != nil {
+		t.Error(err)
+	}
+	if err := dc.Verify("fake-device", nil); err == nil {
+		t.Error("invalid device token")
+	}
+}
+
+func TestService(t *testing.T) {
+	if _, err := New(&Config{}); err == nil {
+		t.Error("no platform configured")
+	}
+	s, err := New(&Config{SafetyNet: &SafetyNetConfig{PackageName: "io.bryk.ct19"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Verify(DeviceCheck, "token", nil); err == nil {
+		t.Error("unsupported platform")
+	}
+	if err := s.Verify(SafetyNet, "", nil); err == nil {
+		t.Error("empty token")
+	}
+}
@@ -0,0 +1,144 @@
+package attestation
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Authentication tokens for the DeviceCheck API are valid for up to one hour.
+const deviceCheckTokenTTL = 50 * time.Minute
+
+// DeviceCheckConfig provides the settings required to validate Apple
+// DeviceCheck tokens.
+type DeviceCheckConfig struct {
+	// Apple developer team identifier.
+	TeamID string
+
+	// Identifier of the DeviceCheck private key.
+	KeyID string
+
+	// PEM-encoded PKCS8 private key, as downloaded from the developer
+	// account (".p8" file).
+	Key []byte
+
+	// Use the development environment.
+	Development bool
+}
+
+type deviceCheck struct {
+	conf     *DeviceCheckConfig
+	key      *ecdsa.PrivateKey
+	hc       *http.Client
+	endpoint string
+	token    string
+	expires  time.Time
+	mu       sync.Mutex
+}
+
+func newDeviceCheck(conf *DeviceCheckConfig) (*deviceCheck, error) {
+	if conf.TeamID == "" || conf.KeyID == "" {
+		return nil, errors.New("devicecheck: team and key identifiers are required")
+	}
+	block, _ := pem.Decode(conf.Key)
+	if block == nil {
+		return nil, errors.New("devicecheck: invalid private key")
+	}
+	k, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "devicecheck: invalid private key")
+	}
+	key, ok := k.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("devicecheck: unsupported key type")
+	}
+	endpoint := "https://api.devicecheck.apple.com"
+	if conf.Development {
+		endpoint = "https://api.development.devicecheck.apple.com"
+	}
+	return &deviceCheck{
+		conf:     conf,
+		key:      key,
+		hc:       &http.Client{Timeout: 10 * time.Second},
+		endpoint: endpoint,
+	}, nil
+}
+
+// DeviceCheck tokens are opaque to the server and don't include the nonce
+// value; validity is confirmed directly with Apple.
+func (dc *deviceCheck) Verify(token string, _ []byte) error {
+	auth, err := dc.authToken()
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	txID := make([]byte, 16)
+	if _, err := rand.Read(txID); err != nil {
+		return err
+	}
+	body, _ := json.Marshal(map[string]interface{}{
+		"device_token":   token,
+		"transaction_id": base64.RawURLEncoding.EncodeToString(txID),
+		"timestamp":      now.UnixNano() / int64(time.Millisecond),
+	})
+	req, err := http.NewRequest(http.MethodPost, dc.endpoint+"/v1/validate_device_token", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+auth)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := dc.hc.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = ioutil.ReadAll(res.Body)
+		_ = res.Body.Close()
+	}()
+	if res.StatusCode != http.StatusOK {
+		return errors.Errorf("devicecheck: invalid device token, status: %d", res.StatusCode)
+	}
+	return nil
+}
+
+// Return a valid authentication token, generating a new one if required.
+func (dc *deviceCheck) authToken() (string, error) {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	now := time.Now()
+	if dc.token != "" && now.Before(dc.expires) {
+		return dc.token, nil
+	}
+	header, _ := json.Marshal(map[string]string{"alg": "ES256", "kid": dc.conf.KeyID})
+	claims, _ := json.Marshal(map[string]interface{}{"iss": dc.conf.TeamID, "iat": now.Unix()})
+	input := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(input))
+	r, s, err := ecdsa.Sign(rand.Reader, dc.key, digest[:])
+	if err != nil {
+		return "", err
+	}
+	sig := append(padInt(r, 32), padInt(s, 32)...)
+	dc.token = input + "." + base64.RawURLEncoding.EncodeToString(sig)
+	dc.expires = now.Add(deviceCheckTokenTTL)
+	return dc.token, nil
+}
+
+// Encode an integer as a big-endian, zero-padded, fixed size value.
+func padInt(v *big.Int, size int) []byte {
+	b := v.Bytes()
+	res := make([]byte, size)
+	copy(res[size-len(b):], b)
+	return res
+}
@@ -0,0 +1,16 @@
+/*
+Package attestation provides verification of device integrity statements
+produced by the official mobile applications.
+
+When enabled, the user activation flow requires the client to present an
+attestation token obtained from the platform services; activation codes can
+only be redeemed by genuine app installations running on legitimate devices.
+Supported platforms are:
+
+  - Android: Google Play Integrity API, or the legacy SafetyNet Attestation API
+  - iOS: Apple DeviceCheck
+
+The attestation nonce binds each statement to a specific activation request,
+see Nonce.
+*/
+package attestation
@@ -0,0 +1,103 @@
+package attestation
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.bryk.io/covid-tracking/utils"
+)
+
+// PlayIntegrityConfig provides the settings required to verify Google Play
+// Integrity tokens.
+type PlayIntegrityConfig struct {
+	// Android application package name.
+	PackageName string
+
+	// Optional OAuth2 access token; when not provided one is obtained from
+	// the GCE metadata server for the default service account.
+	Token string
+}
+
+type playIntegrity struct {
+	conf     *PlayIntegrityConfig
+	hc       *http.Client
+	token    *utils.GCPToken
+	endpoint string
+}
+
+func newPlayIntegrity(conf *PlayIntegrityConfig) (*playIntegrity, error) {
+	if conf.PackageName == "" {
+		return nil, errors.New("play integrity: package name is required")
+	}
+	return &playIntegrity{
+		conf:     conf,
+		hc:       &http.Client{Timeout: 10 * time.Second},
+		token:    utils.NewGCPToken(conf.Token),
+		endpoint: "https://playintegrity.googleapis.com",
+	}, nil
+}
+
+func (pi *playIntegrity) Verify(token string, nonce []byte) error {
+	accessToken, err := pi.token.Get()
+	if err != nil {
+		return err
+	}
+	body, _ := json.Marshal(map[string]string{"integrity_token": token})
+	target := fmt.Sprintf("%s/v1/%s:decodeIntegrityToken", pi.endpoint, pi.conf.PackageName)
+	req, err := http.NewRequest(http.MethodPost, target, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := pi.hc.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	if res.StatusCode != http.StatusOK {
+		return errors.Errorf("play integrity: request failed with status: %d", res.StatusCode)
+	}
+	verdict := struct {
+		Payload struct {
+			RequestDetails struct {
+				RequestPackageName string `json:"requestPackageName"`
+				Nonce              string `json:"nonce"`
+			} `json:"requestDetails"`
+			AppIntegrity struct {
+				AppRecognitionVerdict string `json:"appRecognitionVerdict"`
+			} `json:"appIntegrity"`
+			DeviceIntegrity struct {
+				DeviceRecognitionVerdict []string `json:"deviceRecognitionVerdict"`
+			} `json:"deviceIntegrity"`
+		} `json:"tokenPayloadExternal"`
+	}{}
+	if err := json.NewDecoder(res.Body).Decode(&verdict); err != nil {
+		return errors.Wrap(err, "play integrity: invalid response")
+	}
+	details := verdict.Payload.RequestDetails
+	if details.RequestPackageName != pi.conf.PackageName {
+		return errors.New("play integrity: invalid package name")
+	}
+	// Nonce values are web-safe base64 encoded, padding is optional
+	if strings.TrimRight(details.Nonce, "=") != base64.RawURLEncoding.EncodeToString(nonce) {
+		return errors.New("play integrity: invalid nonce")
+	}
+	if verdict.Payload.AppIntegrity.AppRecognitionVerdict != "PLAY_RECOGNIZED" {
+		return errors.New("play integrity: unrecognized application")
+	}
+	for _, v := range verdict.Payload.DeviceIntegrity.DeviceRecognitionVerdict {
+		if v == "MEETS_DEVICE_INTEGRITY" {
+			return nil
+		}
+	}
+	return errors.New("play integrity: device integrity check failed")
+}
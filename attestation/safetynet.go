@@ -0,0 +1,134 @@
+package attestation
+
+import (
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Maximum age for SafetyNet attestation statements.
+const safetyNetMaxAge = 10 * time.Minute
+
+// SafetyNetConfig provides the settings required to verify Google SafetyNet
+// attestation statements. Statements are verified locally.
+type SafetyNetConfig struct {
+	// Android application package name.
+	PackageName string
+
+	// Require the device to pass the CTS profile check, in addition to the
+	// basic integrity check.
+	RequireCTS bool
+}
+
+type safetyNet struct {
+	conf  *SafetyNetConfig
+	roots *x509.CertPool // nil to use the system roots
+	now   func() time.Time
+}
+
+func newSafetyNet(conf *SafetyNetConfig) (*safetyNet, error) {
+	if conf.PackageName == "" {
+		return nil, errors.New("safetynet: package name is required")
+	}
+	return &safetyNet{conf: conf, now: time.Now}, nil
+}
+
+func (sn *safetyNet) Verify(token string, nonce []byte) error {
+	segments := strings.Split(token, ".")
+	if len(segments) != 3 {
+		return errors.New("safetynet: invalid statement")
+	}
+	header := struct {
+		Alg string   `json:"alg"`
+		X5c []string `json:"x5c"`
+	}{}
+	if err := decodeSegment(segments[0], &header); err != nil {
+		return errors.Wrap(err, "safetynet: invalid header")
+	}
+	if header.Alg != "RS256" || len(header.X5c) == 0 {
+		return errors.New("safetynet: unsupported statement format")
+	}
+
+	// Verify certificate chain
+	var chain []*x509.Certificate
+	for _, c := range header.X5c {
+		der, err := base64.StdEncoding.DecodeString(c)
+		if err != nil {
+			return errors.Wrap(err, "safetynet: invalid certificate")
+		}
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return errors.Wrap(err, "safetynet: invalid certificate")
+		}
+		chain = append(chain, cert)
+	}
+	intermediates := x509.NewCertPool()
+	for _, c := range chain[1:] {
+		intermediates.AddCert(c)
+	}
+	opts := x509.VerifyOptions{
+		DNSName:       "attest.android.com",
+		Roots:         sn.roots,
+		Intermediates: intermediates,
+		CurrentTime:   sn.now(),
+	}
+	if _, err := chain[0].Verify(opts); err != nil {
+		return errors.Wrap(err, "safetynet: invalid certificate chain")
+	}
+
+	// Verify signature
+	pub, ok := chain[0].PublicKey.(*rsa.PublicKey)
+	if !ok {
+		return errors.New("safetynet: unsupported key type")
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(segments[2])
+	if err != nil {
+		return errors.Wrap(err, "safetynet: invalid signature")
+	}
+	digest := sha256.Sum256([]byte(segments[0] + "." + segments[1]))
+	if err := rsa.VerifyPKCS1v15(pub, crypto.SHA256, digest[:], sig); err != nil {
+		return errors.New("safetynet: invalid signature")
+	}
+
+	// Verify statement contents
+	st := struct {
+		Nonce           string `json:"nonce"`
+		TimestampMs     int64  `json:"timestampMs"`
+		APKPackageName  string `json:"apkPackageName"`
+		CTSProfileMatch bool   `json:"ctsProfileMatch"`
+		BasicIntegrity  bool   `json:"basicIntegrity"`
+	}{}
+	if err := decodeSegment(segments[1], &st); err != nil {
+		return errors.Wrap(err, "safetynet: invalid payload")
+	}
+	if st.Nonce != base64.StdEncoding.EncodeToString(nonce) {
+		return errors.New("safetynet: invalid nonce")
+	}
+	if st.APKPackageName != sn.conf.PackageName {
+		return errors.New("safetynet: invalid package name")
+	}
+	ts := time.Unix(0, st.TimestampMs*int64(time.Millisecond))
+	if sn.now().Sub(ts) > safetyNetMaxAge || ts.Sub(sn.now()) > time.Minute {
+		return errors.New("safetynet: expired statement")
+	}
+	if !st.BasicIntegrity || (sn.conf.RequireCTS && !st.CTSProfileMatch) {
+		return errors.New("safetynet: device integrity check failed")
+	}
+	return nil
+}
+
+// Decode a base64url encoded JSON segment.
+func decodeSegment(segment string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(segment, "="))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
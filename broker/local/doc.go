@@ -0,0 +1,20 @@
+/*
+Package local provides an in-process message broker, used by standalone
+instances to route the messages published by the API server to the worker
+running on the same process, without an external AMQP broker.
+
+Messages are routed using the same topology as the AMQP broker, see
+'utils.BrokerTopology'. Each queue is available to a single consumer and
+messages routed to queues without a consumer are discarded.
+
+	b := local.New(1)
+	tasks := b.Consume("tasks")
+	_, _ = b.Push(msg, amqp.MessageOptions{Exchange: "tasks"})
+	for d := range tasks {
+		fmt.Println(d.Type)
+	}
+
+Messages are kept in memory only; pending messages are lost when the
+broker is closed.
+*/
+package local
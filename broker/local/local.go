@@ -0,0 +1,144 @@
+package local
+
+import (
+	"errors"
+	"sync"
+
+	"go.bryk.io/covid-tracking/utils"
+	"go.bryk.io/x/amqp"
+)
+
+// Number of messages buffered on each queue before publishers are blocked.
+const queueBuffer = 256
+
+// Broker routes the published messages to in-process consumers. The zero
+// value is not usable, use New to get a broker instance.
+type Broker struct {
+	topology amqp.Topology
+	queues   map[string]chan amqp.Delivery
+	returns  chan amqp.Return
+	done     chan struct{}
+	closed   bool
+	once     sync.Once
+	mu       sync.RWMutex
+}
+
+// New returns a broker using the default topology with 'shards' task
+// partitions.
+func New(shards int) *Broker {
+	return &Broker{
+		topology: utils.BrokerTopology(shards),
+		queues:   make(map[string]chan amqp.Delivery),
+		returns:  make(chan amqp.Return),
+		done:     make(chan struct{}),
+	}
+}
+
+// Consume returns the messages routed to 'queue' from now on. The channel is
+// closed when the broker is closed.
+func (b *Broker) Consume(queue string) <-chan amqp.Delivery {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	ch, ok := b.queues[queue]
+	if !ok {
+		ch = make(chan amqp.Delivery, queueBuffer)
+		if b.closed {
+			close(ch)
+		}
+		b.queues[queue] = ch
+	}
+	return ch
+}
+
+// Push routes a new message to the queues bound to its exchange. Messages
+// are always confirmed; publishers are blocked while the queues are full.
+func (b *Broker) Push(msg amqp.Message, opts amqp.MessageOptions) (bool, error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if b.closed {
+		return false, errors.New("broker closed")
+	}
+	d := delivery(msg, opts)
+	for _, queue := range b.route(opts) {
+		ch, ok := b.queues[queue]
+		if !ok {
+			continue
+		}
+		select {
+		case ch <- d:
+		case <-b.done:
+			return false, errors.New("broker closed")
+		}
+	}
+	return true, nil
+}
+
+// MessageReturns provides the messages returned as unroutable. Messages are
+// never returned, the channel is closed when the broker is closed.
+func (b *Broker) MessageReturns() <-chan amqp.Return {
+	return b.returns
+}
+
+// Close the broker, publishers blocked are released and all the consumer
+// channels are closed.
+func (b *Broker) Close() error {
+	b.once.Do(func() {
+		close(b.done)
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		b.closed = true
+		for _, ch := range b.queues {
+			close(ch)
+		}
+		close(b.returns)
+	})
+	return nil
+}
+
+// Queues bound to the message exchange for its routing key.
+func (b *Broker) route(opts amqp.MessageOptions) []string {
+	fanout := false
+	for _, ex := range b.topology.Exchanges {
+		if ex.Name == opts.Exchange {
+			fanout = ex.Kind == "fanout"
+		}
+	}
+	var list []string
+	for _, bd := range b.topology.Bindings {
+		if bd.Exchange == opts.Exchange && (fanout || bd.RoutingKey == opts.RoutingKey) {
+			list = append(list, bd.Queue)
+		}
+	}
+	return list
+}
+
+// Delivery for a message published to the broker.
+func delivery(msg amqp.Message, opts amqp.MessageOptions) amqp.Delivery {
+	d := amqp.Delivery{}
+	d.Acknowledger = acknowledger{}
+	d.Exchange = opts.Exchange
+	d.RoutingKey = opts.RoutingKey
+	d.Type = msg.Type
+	d.MessageId = msg.MessageId
+	d.Timestamp = msg.Timestamp
+	d.ContentType = msg.ContentType
+	d.Headers = msg.Headers
+	d.Body = msg.Body
+	return d
+}
+
+// Messages are removed from the queues once delivered, acknowledgements
+// are ignored.
+type acknowledger struct{}
+
+func (acknowledger) Ack(_ uint64, _ bool) error {
+	return nil
+}
+
+func (acknowledger) Nack(_ uint64, _, _ bool) error {
+	return nil
+}
+
+func (acknowledger) Reject(_ uint64, _ bool) error {
+	return nil
+}
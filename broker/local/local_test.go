@@ -0,0 +1,55 @@
+package local
+
+import (
+	"testing"
+
+	"go.bryk.io/x/amqp"
+)
+
+func TestBroker(t *testing.T) {
+	b := New(1)
+	tasks := b.Consume("tasks")
+	msg := amqp.Message{Type: "ct19.check_in", MessageId: "1"}
+
+	// Messages are routed to the queues consumed
+	if ok, err := b.Push(msg, amqp.MessageOptions{Exchange: "tasks"}); !ok || err != nil {
+		t.Fatalf("message not confirmed: %v", err)
+	}
+	d := <-tasks
+	if d.Type != msg.Type || d.MessageId != msg.MessageId || d.Exchange != "tasks" {
+		t.Errorf("invalid delivery: %+v", d)
+	}
+	if err := d.Ack(false); err != nil {
+		t.Error(err)
+	}
+
+	// Messages for queues without a consumer are discarded
+	if ok, err := b.Push(msg, amqp.MessageOptions{Exchange: "notifications"}); !ok || err != nil {
+		t.Fatalf("message not confirmed: %v", err)
+	}
+
+	// Consumers are released when closed
+	_ = b.Close()
+	if _, ok := <-tasks; ok {
+		t.Error("consumer channel not closed")
+	}
+	if _, err := b.Push(msg, amqp.MessageOptions{Exchange: "tasks"}); err == nil {
+		t.Error("message published after closing the broker")
+	}
+}
+
+func TestBrokerShards(t *testing.T) {
+	b := New(2)
+	defer func() {
+		_ = b.Close()
+	}()
+	first := b.Consume("tasks.0")
+	second := b.Consume("tasks.1")
+	msg := amqp.Message{Type: "ct19.location_record"}
+	_, _ = b.Push(msg, amqp.MessageOptions{Exchange: "tasks", RoutingKey: "tasks.1"})
+	select {
+	case <-first:
+		t.Error("message routed to the wrong partition")
+	case <-second:
+	}
+}
@@ -0,0 +1,14 @@
+/*
+Package memtest provides an in-memory message publisher, meant to be used as
+a test double for the broker client.
+
+Published messages are kept in memory and can be inspected by the tests, and
+publishing failures and returned messages can be simulated.
+
+	pub := memtest.NewPublisher()
+	_, _ = pub.Push(msg, amqp.MessageOptions{Exchange: "tasks"})
+	for _, m := range pub.Messages("tasks") {
+		fmt.Println(m.Message.Type)
+	}
+*/
+package memtest
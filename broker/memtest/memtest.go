@@ -0,0 +1,92 @@
+package memtest
+
+import (
+	"errors"
+	"sync"
+
+	"go.bryk.io/x/amqp"
+)
+
+// Published message, along with the options used to publish it.
+type Published struct {
+	Message amqp.Message
+	Options amqp.MessageOptions
+}
+
+// Publisher keeps all published messages in memory. The zero value is not
+// usable, use NewPublisher to get a publisher instance.
+type Publisher struct {
+	msgs    []Published
+	returns chan amqp.Return
+	err     error
+	closed  bool
+	mu      sync.Mutex
+}
+
+// NewPublisher returns a publisher instance ready to be used.
+func NewPublisher() *Publisher {
+	return &Publisher{returns: make(chan amqp.Return, 100)}
+}
+
+// Push registers a new message. Messages are always confirmed unless a
+// failure was set using 'Fail'.
+func (p *Publisher) Push(msg amqp.Message, opts amqp.MessageOptions) (bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		return false, errors.New("publisher closed")
+	}
+	if p.err != nil {
+		return false, p.err
+	}
+	p.msgs = append(p.msgs, Published{Message: msg, Options: opts})
+	return true, nil
+}
+
+// MessageReturns provides the messages returned using 'Return'. The channel
+// is closed when the publisher is closed.
+func (p *Publisher) MessageReturns() <-chan amqp.Return {
+	return p.returns
+}
+
+// Close the publisher, simulating a lost connection with the broker.
+func (p *Publisher) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.closed {
+		p.closed = true
+		close(p.returns)
+	}
+	return nil
+}
+
+// Fail makes all subsequent messages fail with the provided error. Use a nil
+// value to restore the normal behavior.
+func (p *Publisher) Fail(err error) {
+	p.mu.Lock()
+	p.err = err
+	p.mu.Unlock()
+}
+
+// Return simulates the broker returning a message as unroutable.
+func (p *Publisher) Return(id string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.closed {
+		p.returns <- amqp.Return{MessageId: id}
+	}
+}
+
+// Messages returns the messages published to an exchange, or all published
+// messages if 'exchange' is empty.
+func (p *Publisher) Messages(exchange string) []Published {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var list []Published
+	for _, m := range p.msgs {
+		if exchange == "" || m.Options.Exchange == exchange {
+			list = append(list, m)
+		}
+	}
+	return list
+}
@@ -0,0 +1,59 @@
+package certificate
+
+import (
+	"errors"
+	"strings"
+)
+
+const base45Alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ $%*+-./:"
+
+// Base45 encoding, as defined by RFC 9285.
+func base45Encode(src []byte) string {
+	sb := strings.Builder{}
+	for i := 0; i+1 < len(src); i += 2 {
+		n := int(src[i])*256 + int(src[i+1])
+		sb.WriteByte(base45Alphabet[n%45])
+		sb.WriteByte(base45Alphabet[(n/45)%45])
+		sb.WriteByte(base45Alphabet[n/2025])
+	}
+	if len(src)%2 == 1 {
+		n := int(src[len(src)-1])
+		sb.WriteByte(base45Alphabet[n%45])
+		sb.WriteByte(base45Alphabet[n/45])
+	}
+	return sb.String()
+}
+
+// Base45 decoding, as defined by RFC 9285.
+func base45Decode(src string) ([]byte, error) {
+	var out []byte
+	for i := 0; i < len(src); i += 3 {
+		chunk := src[i:]
+		if len(chunk) > 3 {
+			chunk = chunk[:3]
+		}
+		if len(chunk) < 2 {
+			return nil, errors.New("invalid base45 length")
+		}
+		n := 0
+		for j := len(chunk) - 1; j >= 0; j-- {
+			v := strings.IndexByte(base45Alphabet, chunk[j])
+			if v < 0 {
+				return nil, errors.New("invalid base45 character")
+			}
+			n = n*45 + v
+		}
+		if len(chunk) == 3 {
+			if n > 0xffff {
+				return nil, errors.New("invalid base45 value")
+			}
+			out = append(out, byte(n>>8), byte(n))
+		} else {
+			if n > 0xff {
+				return nil, errors.New("invalid base45 value")
+			}
+			out = append(out, byte(n))
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,272 @@
+package certificate
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Minimal CBOR (RFC 7049) encoder and decoder, covering only the types
+// required to produce and verify CWT and COSE structures.
+
+// Map with deterministic key order.
+type cborMap []cborPair
+
+type cborPair struct {
+	key   interface{}
+	value interface{}
+}
+
+// Tagged data item.
+type cborTag struct {
+	number  uint64
+	content interface{}
+}
+
+// CBOR major types.
+const (
+	majorUint   = 0
+	majorNegint = 1
+	majorBytes  = 2
+	majorText   = 3
+	majorArray  = 4
+	majorMap    = 5
+	majorTag    = 6
+	majorSimple = 7
+)
+
+func cborEncode(v interface{}) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	if err := cborWrite(buf, v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func cborWrite(buf *bytes.Buffer, v interface{}) error {
+	switch val := v.(type) {
+	case int:
+		cborInt(buf, int64(val))
+	case int64:
+		cborInt(buf, val)
+	case string:
+		cborHead(buf, majorText, uint64(len(val)))
+		buf.WriteString(val)
+	case []byte:
+		cborHead(buf, majorBytes, uint64(len(val)))
+		buf.Write(val)
+	case []interface{}:
+		cborHead(buf, majorArray, uint64(len(val)))
+		for _, item := range val {
+			if err := cborWrite(buf, item); err != nil {
+				return err
+			}
+		}
+	case cborMap:
+		cborHead(buf, majorMap, uint64(len(val)))
+		for _, p := range val {
+			if err := cborWrite(buf, p.key); err != nil {
+				return err
+			}
+			if err := cborWrite(buf, p.value); err != nil {
+				return err
+			}
+		}
+	case cborTag:
+		cborHead(buf, majorTag, val.number)
+		return cborWrite(buf, val.content)
+	default:
+		return fmt.Errorf("unsupported CBOR type: %T", v)
+	}
+	return nil
+}
+
+func cborInt(buf *bytes.Buffer, n int64) {
+	if n < 0 {
+		cborHead(buf, majorNegint, uint64(-1-n))
+		return
+	}
+	cborHead(buf, majorUint, uint64(n))
+}
+
+// Write the initial byte and argument for a data item.
+func cborHead(buf *bytes.Buffer, major byte, arg uint64) {
+	mt := major << 5
+	switch {
+	case arg < 24:
+		buf.WriteByte(mt | byte(arg))
+	case arg <= 0xff:
+		buf.WriteByte(mt | 24)
+		buf.WriteByte(byte(arg))
+	case arg <= 0xffff:
+		buf.WriteByte(mt | 25)
+		_ = binary.Write(buf, binary.BigEndian, uint16(arg))
+	case arg <= 0xffffffff:
+		buf.WriteByte(mt | 26)
+		_ = binary.Write(buf, binary.BigEndian, uint32(arg))
+	default:
+		buf.WriteByte(mt | 27)
+		_ = binary.Write(buf, binary.BigEndian, arg)
+	}
+}
+
+// Decode a single data item. Integers are returned as int64 values, floats
+// as float64 and maps as cborMap; indefinite-length items are not supported.
+func cborDecode(data []byte) (interface{}, error) {
+	v, rest, err := cborRead(data, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) > 0 {
+		return nil, errors.New("unexpected trailing CBOR data")
+	}
+	return v, nil
+}
+
+// Nesting limit for decoded items.
+const cborMaxDepth = 32
+
+func cborRead(data []byte, depth int) (interface{}, []byte, error) {
+	if depth > cborMaxDepth {
+		return nil, nil, errors.New("CBOR data nested too deeply")
+	}
+	if len(data) == 0 {
+		return nil, nil, errors.New("unexpected end of CBOR data")
+	}
+	major := data[0] >> 5
+	info := data[0] & 0x1f
+	data = data[1:]
+
+	// Simple values and floats
+	if major == majorSimple {
+		switch info {
+		case 20:
+			return false, data, nil
+		case 21:
+			return true, data, nil
+		case 22, 23:
+			return nil, data, nil
+		}
+	}
+
+	// Read argument
+	var arg uint64
+	switch {
+	case info < 24:
+		arg = uint64(info)
+	case info <= 27:
+		size := 1 << (info - 24)
+		if len(data) < size {
+			return nil, nil, errors.New("unexpected end of CBOR data")
+		}
+		for _, b := range data[:size] {
+			arg = arg<<8 | uint64(b)
+		}
+		data = data[size:]
+	default:
+		return nil, nil, fmt.Errorf("unsupported CBOR item: 0x%x", major<<5|info)
+	}
+
+	switch major {
+	case majorUint:
+		if arg > math.MaxInt64 {
+			return nil, nil, errors.New("CBOR integer overflow")
+		}
+		return int64(arg), data, nil
+	case majorNegint:
+		if arg > math.MaxInt64 {
+			return nil, nil, errors.New("CBOR integer overflow")
+		}
+		return -1 - int64(arg), data, nil
+	case majorBytes, majorText:
+		if uint64(len(data)) < arg {
+			return nil, nil, errors.New("unexpected end of CBOR data")
+		}
+		if major == majorText {
+			return string(data[:arg]), data[arg:], nil
+		}
+		return append([]byte{}, data[:arg]...), data[arg:], nil
+	case majorArray:
+		if uint64(len(data)) < arg {
+			return nil, nil, errors.New("unexpected end of CBOR data")
+		}
+		list := make([]interface{}, 0, arg)
+		for i := uint64(0); i < arg; i++ {
+			var item interface{}
+			var err error
+			if item, data, err = cborRead(data, depth+1); err != nil {
+				return nil, nil, err
+			}
+			list = append(list, item)
+		}
+		return list, data, nil
+	case majorMap:
+		if arg > uint64(len(data))/2 {
+			return nil, nil, errors.New("unexpected end of CBOR data")
+		}
+		m := make(cborMap, 0, arg)
+		for i := uint64(0); i < arg; i++ {
+			var key, value interface{}
+			var err error
+			if key, data, err = cborRead(data, depth+1); err != nil {
+				return nil, nil, err
+			}
+			if value, data, err = cborRead(data, depth+1); err != nil {
+				return nil, nil, err
+			}
+			m = append(m, cborPair{key, value})
+		}
+		return m, data, nil
+	case majorTag:
+		content, rest, err := cborRead(data, depth+1)
+		if err != nil {
+			return nil, nil, err
+		}
+		return cborTag{number: arg, content: content}, rest, nil
+	default:
+		switch info {
+		case 25:
+			return float16(uint16(arg)), data, nil
+		case 26:
+			return float64(math.Float32frombits(uint32(arg))), data, nil
+		case 27:
+			return math.Float64frombits(arg), data, nil
+		}
+		return nil, nil, fmt.Errorf("unsupported CBOR simple value: %d", arg)
+	}
+}
+
+// Half-precision float value.
+func float16(bits uint16) float64 {
+	exp := int(bits>>10) & 0x1f
+	mant := float64(bits & 0x3ff)
+	var v float64
+	switch exp {
+	case 0:
+		v = math.Ldexp(mant, -24)
+	case 31:
+		if mant == 0 {
+			v = math.Inf(1)
+		} else {
+			v = math.NaN()
+		}
+	default:
+		v = math.Ldexp(mant+1024, exp-25)
+	}
+	if bits&0x8000 != 0 {
+		return -v
+	}
+	return v
+}
+
+// Get the value for an entry on a decoded map.
+func (m cborMap) get(key interface{}) (interface{}, bool) {
+	for _, p := range m {
+		if p.key == key {
+			return p.value, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,126 @@
+package certificate
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"errors"
+	"math/big"
+	"time"
+)
+
+// Kind of health credential.
+type Kind string
+
+const (
+	// Test result credential.
+	Test Kind = "test"
+
+	// Vaccination credential.
+	Vaccination Kind = "vaccination"
+)
+
+// Record contains the details about the health event being certified.
+type Record struct {
+	// Unique identifier for the credential.
+	ID string
+
+	// Subject's DID.
+	Subject string
+
+	// Subject's name, when available.
+	FamilyName string
+	GivenName  string
+
+	// Subject's date of birth, when available.
+	BirthDate time.Time
+
+	// Kind of event.
+	Kind Kind
+
+	// Test result, one of "positive", "negative" or "inconclusive".
+	Result string
+
+	// Date of the test or vaccination.
+	Date time.Time
+
+	// Facility responsible for the test or vaccination.
+	Facility string
+
+	// Vaccine medicinal product and manufacturer identifiers (vaccination only).
+	Product      string
+	Manufacturer string
+
+	// Dose number and total number of doses in the series (vaccination only).
+	Dose  int
+	Doses int
+}
+
+// Issuer holds the details of the authority issuing credentials.
+type Issuer struct {
+	// Issuer identifier. For SMART Health Cards it MUST be the base URL
+	// where the issuer keys are published.
+	ID string
+
+	// Issuer country, as an ISO 3166-1 alpha-2 code.
+	Country string
+
+	// Signing key; only P-256 keys are supported.
+	Key *ecdsa.PrivateKey
+}
+
+// KeyID returns the identifier for the issuer's signing key, calculated as
+// the first 8 bytes of the SHA-256 digest of its DER encoded public key.
+func (is *Issuer) KeyID() ([]byte, error) {
+	return keyID(is.Key.Public())
+}
+
+func keyID(pub crypto.PublicKey) ([]byte, error) {
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return nil, err
+	}
+	h := sha256.Sum256(der)
+	return h[:8], nil
+}
+
+func (is *Issuer) validate() error {
+	if is.Key == nil || is.Key.Curve != elliptic.P256() {
+		return errors.New("a P-256 signing key is required")
+	}
+	return nil
+}
+
+// SNOMED CT codes for test results.
+var snomedResults = map[string]string{
+	"positive":     "260373001", // Detected
+	"negative":     "260415000", // Not detected
+	"inconclusive": "419984006", // Inconclusive
+}
+
+// Produce an ES256 signature over 'data' in its raw "r || s" form.
+func sign(key *ecdsa.PrivateKey, data []byte) ([]byte, error) {
+	digest := sha256.Sum256(data)
+	r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+	if err != nil {
+		return nil, err
+	}
+	sig := make([]byte, 64)
+	copy(sig[32-len(r.Bytes()):32], r.Bytes())
+	copy(sig[64-len(s.Bytes()):], s.Bytes())
+	return sig, nil
+}
+
+// Verify an ES256 signature in its raw "r || s" form.
+func verify(pub *ecdsa.PublicKey, data, sig []byte) bool {
+	if len(sig) != 64 {
+		return false
+	}
+	digest := sha256.Sum256(data)
+	r := new(big.Int).SetBytes(sig[:32])
+	s := new(big.Int).SetBytes(sig[32:])
+	return ecdsa.Verify(pub, digest[:], r, s)
+}
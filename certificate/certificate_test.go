@@ -0,0 +1,232 @@
+package certificate
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/zlib"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testIssuer(t *testing.T) *Issuer {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Issuer{
+		ID:      "https://ct19.example.org",
+		Country: "MX",
+		Key:     key,
+	}
+}
+
+func testRecord() *Record {
+	return &Record{
+		ID:       "a5b0c3a4-2b8f-4c55-9d55-3b5c1d2d6f4e",
+		Subject:  "did:bryk:4d8e6a31-5b38-4b0c-8f0e-2c7e8e8f5b7a",
+		Kind:     Test,
+		Result:   "negative",
+		Date:     time.Date(2020, 5, 1, 10, 0, 0, 0, time.UTC),
+		Facility: "Lab A",
+	}
+}
+
+func TestBase45(t *testing.T) {
+	vectors := map[string]string{
+		"AB":       "BB8",
+		"Hello!!":  "%69 VD92EX0",
+		"base-45":  "UJCLQE7W581",
+		"ietf!":    "QED8WEX0",
+		"":         "",
+		"\x00\x00": "000",
+		"\xff\xff": "FGW",
+	}
+	for in, out := range vectors {
+		if enc := base45Encode([]byte(in)); enc != out {
+			t.Errorf("encode %q: expected %q, got %q", in, out, enc)
+		}
+		dec, err := base45Decode(out)
+		if err != nil || string(dec) != in {
+			t.Errorf("decode %q: expected %q, got %q", out, in, dec)
+		}
+	}
+	if _, err := base45Decode("GGW"); err == nil {
+		t.Error("invalid value should fail")
+	}
+}
+
+func TestCBOR(t *testing.T) {
+	// Vectors from RFC 7049, appendix A
+	vectors := []struct {
+		value    interface{}
+		expected string
+	}{
+		{0, "00"},
+		{23, "17"},
+		{24, "1818"},
+		{1000, "1903e8"},
+		{1000000, "1a000f4240"},
+		{-1, "20"},
+		{-1000, "3903e7"},
+		{"IETF", "6449455446"},
+		{[]byte{1, 2, 3, 4}, "4401020304"},
+		{[]interface{}{1, 2, 3}, "83010203"},
+		{cborMap{{1, 2}, {3, 4}}, "a201020304"},
+		{cborTag{number: 1, content: 1363896240}, "c11a514b67b0"},
+	}
+	for _, v := range vectors {
+		res, err := cborEncode(v.value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hex.EncodeToString(res) != v.expected {
+			t.Errorf("%v: expected %s, got %x", v.value, v.expected, res)
+		}
+	}
+	if _, err := cborEncode(1.5); err == nil {
+		t.Error("unsupported type should fail")
+	}
+
+	// Decoding
+	for _, v := range vectors {
+		raw, _ := hex.DecodeString(v.expected)
+		res, err := cborDecode(raw)
+		if err != nil {
+			t.Fatal(err)
+		}
+		encoded, _ := cborEncode(res)
+		if hex.EncodeToString(encoded) != v.expected {
+			t.Errorf("%s: invalid decoded value: %v", v.expected, res)
+		}
+	}
+	for _, invalid := range []string{"", "1a000f", "83010203ff", "9f0102ff", "bbffffffffffffffff"} {
+		raw, _ := hex.DecodeString(invalid)
+		if _, err := cborDecode(raw); err == nil {
+			t.Errorf("%s: invalid data should fail", invalid)
+		}
+	}
+}
+
+func TestSmartHealthCard(t *testing.T) {
+	is := testIssuer(t)
+	jws, err := SmartHealthCard(testRecord(), is)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Verify signature
+	parts := strings.Split(jws, ".")
+	if len(parts) != 3 {
+		t.Fatal("invalid JWS")
+	}
+	sig, _ := base64.RawURLEncoding.DecodeString(parts[2])
+	if !verify(&is.Key.PublicKey, []byte(parts[0]+"."+parts[1]), sig) {
+		t.Error("invalid signature")
+	}
+
+	// Decode payload
+	compressed, _ := base64.RawURLEncoding.DecodeString(parts[1])
+	raw, err := ioutil.ReadAll(flate.NewReader(bytes.NewReader(compressed)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload := struct {
+		Iss string `json:"iss"`
+		VC  struct {
+			Type []string `json:"type"`
+		} `json:"vc"`
+	}{}
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		t.Fatal(err)
+	}
+	if payload.Iss != is.ID || len(payload.VC.Type) != 3 {
+		t.Error("invalid payload")
+	}
+
+	// QR numeric encoding
+	qr := SmartHealthCardQR(jws)
+	if !strings.HasPrefix(qr, "shc:/") || len(qr) != 5+2*len(jws) {
+		t.Fatal("invalid QR contents")
+	}
+	restored := strings.Builder{}
+	for i := 5; i < len(qr); i += 2 {
+		n, _ := strconv.Atoi(qr[i : i+2])
+		restored.WriteByte(byte(n + 45))
+	}
+	if restored.String() != jws {
+		t.Error("invalid QR encoding")
+	}
+}
+
+func TestDigitalCovidCertificate(t *testing.T) {
+	is := testIssuer(t)
+	hc1, err := DigitalCovidCertificate(testRecord(), is, 72*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(hc1, "HC1:") {
+		t.Fatal("invalid prefix")
+	}
+	compressed, err := base45Decode(strings.TrimPrefix(hc1, "HC1:"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	zr, err := zlib.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cose, _ := ioutil.ReadAll(zr)
+	if len(cose) < 2 || cose[0] != 0xd2 || cose[1] != 0x84 {
+		t.Error("expected a tagged COSE_Sign1 structure")
+	}
+
+	// Inconclusive results can't be certified
+	rec := testRecord()
+	rec.Result = "inconclusive"
+	if _, err := DigitalCovidCertificate(rec, is, time.Hour); err == nil {
+		t.Error("inconclusive result should fail")
+	}
+}
+
+func TestVerify(t *testing.T) {
+	is := testIssuer(t)
+	other := testIssuer(t)
+	jws, err := SmartHealthCard(testRecord(), is)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hc1, err := DigitalCovidCertificate(testRecord(), is, 72*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, credential := range []string{jws, SmartHealthCardQR(jws), hc1} {
+		claims, err := Verify(credential, &is.Key.PublicKey)
+		if err != nil {
+			t.Fatalf("%s: %s", credential[:4], err)
+		}
+		if claims.Expired() || claims.IssuedAt.IsZero() {
+			t.Errorf("%s: invalid claims: %+v", claims.Format, claims)
+		}
+		if _, err := Verify(credential, &other.Key.PublicKey); err == nil {
+			t.Errorf("%s: credential accepted with a different key", claims.Format)
+		}
+	}
+
+	// Tampered credentials
+	parts := strings.Split(jws, ".")
+	if _, err := Verify(parts[0]+"."+parts[1][1:]+"."+parts[2], &is.Key.PublicKey); err == nil {
+		t.Error("tampered credential accepted")
+	}
+	if _, err := Verify("not-a-credential", &is.Key.PublicKey); err == nil {
+		t.Error("unknown format accepted")
+	}
+}
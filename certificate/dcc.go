@@ -0,0 +1,136 @@
+package certificate
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"time"
+)
+
+// EU DCC schema version produced.
+const dccVersion = "1.3.0"
+
+// SNOMED CT code for COVID-19, used as the disease targeted.
+const dccTarget = "840539006"
+
+// CWT claim keys.
+const (
+	cwtIssuer    = 1
+	cwtExpires   = 4
+	cwtIssuedAt  = 6
+	cwtHealthCrt = -260
+)
+
+// DigitalCovidCertificate returns the record as an EU Digital COVID
+// Certificate, signed by the issuer and valid for the provided period of
+// time. The result is the "HC1:" prefixed Base45 string to be encoded as
+// a QR code.
+func DigitalCovidCertificate(rec *Record, is *Issuer, validity time.Duration) (string, error) {
+	if err := is.validate(); err != nil {
+		return "", err
+	}
+	kid, err := is.KeyID()
+	if err != nil {
+		return "", err
+	}
+	hcert, err := dccPayload(rec, is)
+	if err != nil {
+		return "", err
+	}
+
+	// CBOR Web Token
+	now := time.Now()
+	claims, err := cborEncode(cborMap{
+		{cwtIssuer, is.Country},
+		{cwtExpires, now.Add(validity).Unix()},
+		{cwtIssuedAt, now.Unix()},
+		{cwtHealthCrt, cborMap{{1, hcert}}},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	// COSE_Sign1 (RFC 8152)
+	protected, err := cborEncode(cborMap{
+		{1, -7}, // alg: ES256
+		{4, kid},
+	})
+	if err != nil {
+		return "", err
+	}
+	toSign, err := cborEncode([]interface{}{"Signature1", protected, []byte{}, claims})
+	if err != nil {
+		return "", err
+	}
+	sig, err := sign(is.Key, toSign)
+	if err != nil {
+		return "", err
+	}
+	cose, err := cborEncode(cborTag{
+		number:  18,
+		content: []interface{}{protected, cborMap{}, claims, sig},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	// Compress and encode
+	buf := new(bytes.Buffer)
+	zw, _ := zlib.NewWriterLevel(buf, zlib.BestCompression)
+	if _, err := zw.Write(cose); err != nil {
+		return "", err
+	}
+	if err := zw.Close(); err != nil {
+		return "", err
+	}
+	return "HC1:" + base45Encode(buf.Bytes()), nil
+}
+
+// Build the health certificate contents.
+func dccPayload(rec *Record, is *Issuer) (cborMap, error) {
+	ci := fmt.Sprintf("URN:UVCI:01:%s:%s", is.Country, rec.ID)
+	hcert := cborMap{
+		{"ver", dccVersion},
+		{"nam", cborMap{
+			{"fn", rec.FamilyName},
+			{"gn", rec.GivenName},
+		}},
+		{"dob", ""},
+	}
+	if !rec.BirthDate.IsZero() {
+		hcert[2].value = rec.BirthDate.Format("2006-01-02")
+	}
+	switch rec.Kind {
+	case Test:
+		code, ok := snomedResults[rec.Result]
+		if !ok || rec.Result == "inconclusive" {
+			return nil, fmt.Errorf("invalid test result: %s", rec.Result)
+		}
+		hcert = append(hcert, cborPair{"t", []interface{}{cborMap{
+			{"tg", dccTarget},
+			{"tt", "LP6464-4"}, // Nucleic acid amplification
+			{"sc", rec.Date.UTC().Format(time.RFC3339)},
+			{"tr", code},
+			{"tc", rec.Facility},
+			{"co", is.Country},
+			{"is", is.ID},
+			{"ci", ci},
+		}}})
+	case Vaccination:
+		hcert = append(hcert, cborPair{"v", []interface{}{cborMap{
+			{"tg", dccTarget},
+			{"vp", "J07BX03"}, // Covid-19 vaccines
+			{"mp", rec.Product},
+			{"ma", rec.Manufacturer},
+			{"dn", rec.Dose},
+			{"sd", rec.Doses},
+			{"dt", rec.Date.UTC().Format("2006-01-02")},
+			{"co", is.Country},
+			{"is", is.ID},
+			{"ci", ci},
+		}}})
+	default:
+		return nil, fmt.Errorf("invalid credential kind: %s", rec.Kind)
+	}
+	return hcert, nil
+}
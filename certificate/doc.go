@@ -0,0 +1,13 @@
+/*
+Package certificate provides encoders to issue verifiable COVID-19 health
+credentials using widely adopted formats, allowing results to be validated
+with existing third-party scanner applications.
+
+Supported formats are:
+
+  - SMART Health Cards: compact JWS (ES256) with a compressed FHIR bundle as
+    payload, and its "shc:/" numeric QR representation.
+  - EU Digital COVID Certificates: CBOR Web Token signed as a COSE_Sign1
+    structure, compressed and Base45 encoded with the "HC1:" prefix.
+*/
+package certificate
@@ -0,0 +1,151 @@
+package certificate
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.bryk.io/covid-tracking/fhir"
+)
+
+// SMART Health Card credential types.
+const (
+	shcHealthCard   = "https://smarthealth.cards#health-card"
+	shcCovid19      = "https://smarthealth.cards#covid19"
+	shcLaboratory   = "https://smarthealth.cards#laboratory"
+	shcImmunization = "https://smarthealth.cards#immunization"
+)
+
+// SmartHealthCard returns the record as a SMART Health Card, encoded as a
+// compact JWS signed by the issuer.
+func SmartHealthCard(rec *Record, is *Issuer) (string, error) {
+	if err := is.validate(); err != nil {
+		return "", err
+	}
+	kid, err := is.KeyID()
+	if err != nil {
+		return "", err
+	}
+	bundle, types, err := shcBundle(rec)
+	if err != nil {
+		return "", err
+	}
+
+	// Payload is minified JSON compressed with raw DEFLATE
+	payload, err := json.Marshal(map[string]interface{}{
+		"iss": is.ID,
+		"nbf": time.Now().Unix(),
+		"vc": map[string]interface{}{
+			"type": types,
+			"credentialSubject": map[string]interface{}{
+				"fhirVersion": "4.0.1",
+				"fhirBundle":  bundle,
+			},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	buf := new(bytes.Buffer)
+	zw, _ := flate.NewWriter(buf, flate.BestCompression)
+	if _, err := zw.Write(payload); err != nil {
+		return "", err
+	}
+	if err := zw.Close(); err != nil {
+		return "", err
+	}
+
+	// Sign
+	header, _ := json.Marshal(map[string]string{
+		"alg": "ES256",
+		"zip": "DEF",
+		"kid": base64.RawURLEncoding.EncodeToString(kid),
+	})
+	input := fmt.Sprintf("%s.%s",
+		base64.RawURLEncoding.EncodeToString(header),
+		base64.RawURLEncoding.EncodeToString(buf.Bytes()))
+	sig, err := sign(is.Key, []byte(input))
+	if err != nil {
+		return "", err
+	}
+	return input + "." + base64.RawURLEncoding.EncodeToString(sig), nil
+}
+
+// SmartHealthCardQR returns the numeric representation of a SMART Health
+// Card JWS, suitable to be encoded as a QR code.
+func SmartHealthCardQR(jws string) string {
+	sb := strings.Builder{}
+	sb.WriteString("shc:/")
+	for _, c := range jws {
+		sb.WriteString(fmt.Sprintf("%02d", c-45))
+	}
+	return sb.String()
+}
+
+// Build the FHIR bundle included in the health card.
+func shcBundle(rec *Record) (map[string]interface{}, []string, error) {
+	patient := map[string]interface{}{
+		"resourceType": "Patient",
+		"identifier":   []fhir.Identifier{{Value: rec.Subject}},
+	}
+	if rec.FamilyName != "" || rec.GivenName != "" {
+		patient["name"] = []map[string]interface{}{{
+			"family": rec.FamilyName,
+			"given":  []string{rec.GivenName},
+		}}
+	}
+	if !rec.BirthDate.IsZero() {
+		patient["birthDate"] = rec.BirthDate.Format("2006-01-02")
+	}
+
+	var event interface{}
+	types := []string{shcHealthCard, shcCovid19}
+	switch rec.Kind {
+	case Test:
+		code, ok := snomedResults[rec.Result]
+		if !ok {
+			return nil, nil, fmt.Errorf("invalid test result: %s", rec.Result)
+		}
+		types = append(types, shcLaboratory)
+		event = &fhir.Observation{
+			ResourceType: "Observation",
+			Status:       "final",
+			Code: fhir.CodeableConcept{
+				Coding: []fhir.Coding{{System: "http://loinc.org", Code: "94500-6"}},
+			},
+			Subject:           &fhir.Reference{Reference: "resource:0"},
+			EffectiveDateTime: rec.Date.UTC().Format(time.RFC3339),
+			ValueCodeableConcept: &fhir.CodeableConcept{
+				Coding: []fhir.Coding{{System: "http://snomed.info/sct", Code: code}},
+			},
+		}
+	case Vaccination:
+		types = append(types, shcImmunization)
+		event = map[string]interface{}{
+			"resourceType": "Immunization",
+			"status":       "completed",
+			"vaccineCode": fhir.CodeableConcept{
+				Coding: []fhir.Coding{{System: "http://hl7.org/fhir/sid/cvx", Code: rec.Product}},
+			},
+			"patient":            fhir.Reference{Reference: "resource:0"},
+			"occurrenceDateTime": rec.Date.UTC().Format("2006-01-02"),
+			"performer": []map[string]interface{}{{
+				"actor": fhir.Reference{Display: rec.Facility},
+			}},
+		}
+	default:
+		return nil, nil, fmt.Errorf("invalid credential kind: %s", rec.Kind)
+	}
+	return map[string]interface{}{
+		"resourceType": "Bundle",
+		"type":         "collection",
+		"entry": []map[string]interface{}{
+			{"fullUrl": "resource:0", "resource": patient},
+			{"fullUrl": "resource:1", "resource": event},
+		},
+	}, types, nil
+}
@@ -0,0 +1,267 @@
+package certificate
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/zlib"
+	"crypto/ecdsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Maximum size accepted for a decompressed credential payload.
+const maxPayloadSize = 1 << 20
+
+// Claims contains the details of a verified health credential.
+type Claims struct {
+	// Credential format, either "shc" or "dcc".
+	Format string `json:"format"`
+
+	// Issuer identifier; the issuer URL for SMART Health Cards and the
+	// issuer country for EU Digital COVID Certificates.
+	Issuer string `json:"issuer"`
+
+	// Date the credential was issued.
+	IssuedAt time.Time `json:"issued_at"`
+
+	// Credential expiration date, if any.
+	Expires time.Time `json:"expires,omitempty"`
+}
+
+// Expired returns true if the credential is past its expiration date.
+func (c *Claims) Expired() bool {
+	return !c.Expires.IsZero() && time.Now().After(c.Expires)
+}
+
+// Verify validates a health credential against the public key of its
+// issuer. Both the credentials produced by SmartHealthCard and
+// DigitalCovidCertificate are supported, as well as their QR contents.
+// The expiration date is reported on the claims returned but not enforced.
+func Verify(credential string, pub *ecdsa.PublicKey) (*Claims, error) {
+	credential = strings.TrimSpace(credential)
+	switch {
+	case strings.HasPrefix(credential, "HC1:"):
+		return verifyDCC(credential, pub)
+	case strings.HasPrefix(credential, "shc:/"):
+		jws, err := decodeSmartHealthCardQR(credential)
+		if err != nil {
+			return nil, err
+		}
+		return verifySHC(jws, pub)
+	case strings.Count(credential, ".") == 2:
+		return verifySHC(credential, pub)
+	default:
+		return nil, errors.New("unknown credential format")
+	}
+}
+
+// Restore the JWS from the numeric QR representation of a health card.
+func decodeSmartHealthCardQR(qr string) (string, error) {
+	digits := strings.TrimPrefix(qr, "shc:/")
+	if len(digits)%2 != 0 {
+		return "", errors.New("invalid QR contents")
+	}
+	sb := strings.Builder{}
+	for i := 0; i < len(digits); i += 2 {
+		n, err := strconv.Atoi(digits[i : i+2])
+		if err != nil {
+			return "", errors.New("invalid QR contents")
+		}
+		sb.WriteByte(byte(n + 45))
+	}
+	return sb.String(), nil
+}
+
+func verifySHC(jws string, pub *ecdsa.PublicKey) (*Claims, error) {
+	parts := strings.Split(jws, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("invalid JWS")
+	}
+
+	// Validate header
+	raw, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, errors.New("invalid JWS header")
+	}
+	header := map[string]string{}
+	if err := json.Unmarshal(raw, &header); err != nil || header["alg"] != "ES256" {
+		return nil, errors.New("invalid JWS header")
+	}
+	if err := checkKeyID(pub, header["kid"]); err != nil {
+		return nil, err
+	}
+
+	// Verify signature
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil || !verify(pub, []byte(parts[0]+"."+parts[1]), sig) {
+		return nil, errors.New("invalid signature")
+	}
+
+	// Decode payload
+	compressed, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, errors.New("invalid JWS payload")
+	}
+	if header["zip"] == "DEF" {
+		zr := flate.NewReader(bytes.NewReader(compressed))
+		defer func() {
+			_ = zr.Close()
+		}()
+		if raw, err = ioutil.ReadAll(&limitedReader{r: zr, n: maxPayloadSize}); err != nil {
+			return nil, err
+		}
+	} else {
+		raw = compressed
+	}
+	payload := struct {
+		Iss string `json:"iss"`
+		Nbf int64  `json:"nbf"`
+		Exp int64  `json:"exp"`
+	}{}
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return nil, errors.New("invalid JWS payload")
+	}
+	claims := &Claims{
+		Format:   "shc",
+		Issuer:   payload.Iss,
+		IssuedAt: time.Unix(payload.Nbf, 0).UTC(),
+	}
+	if payload.Exp > 0 {
+		claims.Expires = time.Unix(payload.Exp, 0).UTC()
+	}
+	return claims, nil
+}
+
+func verifyDCC(hc1 string, pub *ecdsa.PublicKey) (*Claims, error) {
+	compressed, err := base45Decode(strings.TrimPrefix(hc1, "HC1:"))
+	if err != nil {
+		return nil, err
+	}
+	zr, err := zlib.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, errors.New("invalid compressed data")
+	}
+	defer func() {
+		_ = zr.Close()
+	}()
+	raw, err := ioutil.ReadAll(&limitedReader{r: zr, n: maxPayloadSize})
+	if err != nil {
+		return nil, err
+	}
+
+	// COSE_Sign1 structure, optionally tagged
+	item, err := cborDecode(raw)
+	if err != nil {
+		return nil, err
+	}
+	if tag, ok := item.(cborTag); ok && tag.number == 18 {
+		item = tag.content
+	}
+	cose, ok := item.([]interface{})
+	if !ok || len(cose) != 4 {
+		return nil, errors.New("invalid COSE_Sign1 structure")
+	}
+	protected, ok1 := cose[0].([]byte)
+	unprotected, ok2 := cose[1].(cborMap)
+	payload, ok3 := cose[2].([]byte)
+	sig, ok4 := cose[3].([]byte)
+	if !ok1 || !ok2 || !ok3 || !ok4 {
+		return nil, errors.New("invalid COSE_Sign1 structure")
+	}
+
+	// Validate headers
+	headers, err := cborDecode(protected)
+	if err != nil {
+		return nil, err
+	}
+	ph, ok := headers.(cborMap)
+	if !ok {
+		return nil, errors.New("invalid COSE protected header")
+	}
+	if alg, _ := ph.get(int64(1)); alg != int64(-7) {
+		return nil, errors.New("unsupported signing algorithm")
+	}
+	kid, ok := ph.get(int64(4))
+	if !ok {
+		kid, _ = unprotected.get(int64(4))
+	}
+	kidBytes, _ := kid.([]byte)
+	if err := checkKeyID(pub, base64.RawURLEncoding.EncodeToString(kidBytes)); err != nil {
+		return nil, err
+	}
+
+	// Verify signature
+	toVerify, err := cborEncode([]interface{}{"Signature1", protected, []byte{}, payload})
+	if err != nil {
+		return nil, err
+	}
+	if !verify(pub, toVerify, sig) {
+		return nil, errors.New("invalid signature")
+	}
+
+	// Decode claims
+	decoded, err := cborDecode(payload)
+	if err != nil {
+		return nil, err
+	}
+	cwt, ok := decoded.(cborMap)
+	if !ok {
+		return nil, errors.New("invalid CWT claims")
+	}
+	claims := &Claims{Format: "dcc"}
+	if v, ok := cwt.get(int64(cwtIssuer)); ok {
+		claims.Issuer, _ = v.(string)
+	}
+	if v, ok := cwt.get(int64(cwtIssuedAt)); ok {
+		if ts, ok := v.(int64); ok {
+			claims.IssuedAt = time.Unix(ts, 0).UTC()
+		}
+	}
+	if v, ok := cwt.get(int64(cwtExpires)); ok {
+		if ts, ok := v.(int64); ok {
+			claims.Expires = time.Unix(ts, 0).UTC()
+		}
+	}
+	return claims, nil
+}
+
+// Ensure the key identifier on a credential, when present, corresponds to
+// the provided public key.
+func checkKeyID(pub *ecdsa.PublicKey, kid string) error {
+	if kid == "" {
+		return nil
+	}
+	expected, err := keyID(pub)
+	if err != nil {
+		return err
+	}
+	if kid != base64.RawURLEncoding.EncodeToString(expected) {
+		return errors.New("credential signed with a different key")
+	}
+	return nil
+}
+
+// Reader failing when more than 'n' bytes are available, to protect against
+// compressed payloads expanding to very large sizes.
+type limitedReader struct {
+	r io.Reader
+	n int64
+}
+
+func (lr *limitedReader) Read(p []byte) (int, error) {
+	if lr.n <= 0 {
+		return 0, errors.New("credential payload too large")
+	}
+	if int64(len(p)) > lr.n {
+		p = p[:lr.n]
+	}
+	n, err := lr.r.Read(p)
+	lr.n -= int64(n)
+	return n, err
+}
@@ -0,0 +1,435 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/covid-tracking/utils"
+	"go.bryk.io/x/net/rpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+// Default client settings.
+const (
+	defaultTimeout   = 5 * time.Second
+	defaultRetries   = 3
+	defaultBackoff   = 500 * time.Millisecond
+	defaultUserAgent = "ct19-client-go/" + sdkVersion
+)
+
+// Version of the SDK, reported with the location records submitted.
+const sdkVersion = "0.1.0"
+
+// Client provides access to the platform API, handling credentials renewal
+// and retries transparently.
+type Client struct {
+	conn     *grpc.ClientConn
+	api      protov1.TrackingServerAPIClient
+	endpoint string
+	cas      [][]byte
+	creds    *protov1.CredentialsResponse
+	apiKey   string
+	store    Store
+	lang     string
+	app      string
+	platform string
+	insecure bool
+	timeout  time.Duration
+	retries  int
+	backoff  time.Duration
+	mu       sync.RWMutex
+}
+
+// New returns a client instance connected to the provided server endpoint.
+// The endpoint can be empty when the 'WithProfile' option is used.
+func New(endpoint string, options ...Option) (*Client, error) {
+	c := &Client{
+		timeout: defaultTimeout,
+		retries: defaultRetries,
+		backoff: defaultBackoff,
+	}
+	for _, opt := range options {
+		if err := opt(c); err != nil {
+			return nil, err
+		}
+	}
+	if endpoint == "" {
+		endpoint = c.endpoint
+	}
+	if endpoint == "" {
+		return nil, errors.New("a server endpoint is required")
+	}
+
+	// Open connection
+	clOpts := []rpc.ClientOption{
+		rpc.WaitForReady(),
+		rpc.WithTimeout(c.timeout),
+		rpc.WithCompression(),
+		rpc.WithClientTLS(rpc.ClientTLSConfig{IncludeSystemCAs: true, CustomCAs: c.cas}),
+		rpc.WithUserAgent(defaultUserAgent),
+	}
+	if c.insecure {
+		clOpts = append(clOpts, rpc.WithInsecureSkipVerify())
+	}
+	conn, err := rpc.NewClientConnection(endpoint, clOpts...)
+	if err != nil {
+		return nil, err
+	}
+	c.conn = conn
+	c.api = protov1.NewTrackingServerAPIClient(conn)
+	return c, nil
+}
+
+// Close the connection with the server.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// Credentials returns the access credentials currently used by the client.
+func (c *Client) Credentials() *protov1.CredentialsResponse {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.creds
+}
+
+// Ping provides a reachability test.
+func (c *Client) Ping(ctx context.Context) (res *protov1.PingResponse, err error) {
+	err = c.invoke(ctx, func(ctx context.Context, opts ...grpc.CallOption) (err error) {
+		res, err = c.api.Ping(ctx, &types.Empty{}, opts...)
+		return
+	})
+	return
+}
+
+// ActivationCode requests a new device activation code. The request can
+// include a scope to restrict the permissions granted to the credentials
+// obtained with the code.
+func (c *Client) ActivationCode(ctx context.Context,
+	req *protov1.ActivationCodeRequest) (code string, err error) {
+	err = c.invoke(ctx, func(ctx context.Context, opts ...grpc.CallOption) error {
+		res, err := c.api.ActivationCode(ctx, req, opts...)
+		if err == nil {
+			code = res.ActivationCode
+		}
+		return err
+	})
+	return
+}
+
+// BulkActivationCodes requests a batch of "user" activation codes for a
+// registration campaign.
+func (c *Client) BulkActivationCodes(ctx context.Context,
+	req *protov1.BulkActivationCodesRequest) (codes []*protov1.CampaignCode, err error) {
+	err = c.invoke(ctx, func(ctx context.Context, opts ...grpc.CallOption) error {
+		res, err := c.api.BulkActivationCodes(ctx, req, opts...)
+		if err == nil {
+			codes = res.Codes
+		}
+		return err
+	})
+	return
+}
+
+// Register requests new access credentials. On success, the credentials are
+// used for all subsequent requests.
+func (c *Client) Register(ctx context.Context, req *protov1.CredentialsRequest) error {
+	return c.invoke(ctx, func(ctx context.Context, opts ...grpc.CallOption) error {
+		res, err := c.api.Credentials(ctx, req, opts...)
+		if err != nil {
+			return err
+		}
+		return c.setCredentials(res)
+	})
+}
+
+// Record submits location records. Records must be signed in advance using
+// the SignRecord helper.
+func (c *Client) Record(ctx context.Context,
+	records ...*protov1.LocationRecord) (res *protov1.RecordResponse, err error) {
+	err = c.invoke(ctx, func(ctx context.Context, opts ...grpc.CallOption) (err error) {
+		res, err = c.api.Record(ctx, &protov1.RecordRequest{
+			Records:    records,
+			AppVersion: c.app,
+			SdkVersion: sdkVersion,
+			Platform:   c.platform,
+		}, opts...)
+		return
+	})
+	return
+}
+
+// SubmissionStatus returns the processing status of location records
+// submitted on broker ingestion mode, using the receipt returned by Record.
+func (c *Client) SubmissionStatus(ctx context.Context,
+	receipt string) (res *protov1.SubmissionStatusResponse, err error) {
+	err = c.invoke(ctx, func(ctx context.Context, opts ...grpc.CallOption) (err error) {
+		res, err = c.api.GetSubmissionStatus(ctx, &protov1.SubmissionStatusRequest{Receipt: receipt}, opts...)
+		return
+	})
+	return
+}
+
+// CheckExposure verifies whether any of the cell tokens provided, see
+// CellTokens, were also visited by diagnosed cases; the matching tokens are
+// returned. Only the token prefixes are submitted, the comparison is done
+// locally. Large sets of tokens are submitted on several requests.
+func (c *Client) CheckExposure(ctx context.Context, tokens []string) ([]string, error) {
+	var prefixes []string
+	seen := make(map[string]bool)
+	for _, t := range tokens {
+		if len(t) < utils.CellTokenPrefix {
+			return nil, errors.New("invalid cell token")
+		}
+		if p := t[:utils.CellTokenPrefix]; !seen[p] {
+			seen[p] = true
+			prefixes = append(prefixes, p)
+		}
+	}
+	exposed := make(map[string]bool)
+	for _, batch := range splitTokens(prefixes) {
+		var res *protov1.CheckExposureResponse
+		err := c.invoke(ctx, func(ctx context.Context, opts ...grpc.CallOption) (err error) {
+			res, err = c.api.CheckExposure(ctx, &protov1.CheckExposureRequest{Prefixes: batch}, opts...)
+			return
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range res.Tokens {
+			exposed[t] = true
+		}
+	}
+	var matches []string
+	for _, t := range tokens {
+		if exposed[t] {
+			matches = append(matches, t)
+		}
+	}
+	return matches, nil
+}
+
+// CheckExposurePSI verifies whether any of the cell tokens provided, see
+// CellTokens, were also visited by diagnosed cases, using private set
+// intersection; the matching tokens are returned. Requires the server to use
+// the "psi" exposure check mode, the default. Large sets of tokens are
+// submitted on several requests.
+func (c *Client) CheckExposurePSI(ctx context.Context, tokens []string) ([]string, error) {
+	var matches []string
+	for _, batch := range splitTokens(tokens) {
+		psi, err := utils.NewPSIClient(batch)
+		if err != nil {
+			return nil, err
+		}
+		blinded, err := psi.Blinded()
+		if err != nil {
+			return nil, err
+		}
+		var res *protov1.PSIResponse
+		err = c.invoke(ctx, func(ctx context.Context, opts ...grpc.CallOption) (err error) {
+			res, err = c.api.CheckExposurePSI(ctx, &protov1.PSIRequest{Blinded: blinded}, opts...)
+			return
+		})
+		if err != nil {
+			return nil, err
+		}
+		found, err := psi.Matches(res.Evaluated, res.Set)
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, found...)
+	}
+	return matches, nil
+}
+
+// Split the elements submitted for exposure self-checks on batches of the
+// maximum size supported per request.
+func splitTokens(list []string) [][]string {
+	var batches [][]string
+	for len(list) > utils.MaxCheckTokens {
+		batches = append(batches, list[:utils.MaxCheckTokens])
+		list = list[utils.MaxCheckTokens:]
+	}
+	if len(list) > 0 {
+		batches = append(batches, list)
+	}
+	return batches
+}
+
+// SamplingPolicy returns how often location records should be collected and
+// uploaded; the policy must be retrieved again after its refresh interval.
+func (c *Client) SamplingPolicy(ctx context.Context) (res *protov1.SamplingPolicy, err error) {
+	err = c.invoke(ctx, func(ctx context.Context, opts ...grpc.CallOption) (err error) {
+		res, err = c.api.GetSamplingPolicy(ctx, &types.Empty{}, opts...)
+		return
+	})
+	return
+}
+
+// CheckIn submits venue visit records. Records must be signed in advance
+// using the SignCheckIn helper.
+func (c *Client) CheckIn(ctx context.Context,
+	records ...*protov1.CheckInRecord) (res *protov1.CheckInResponse, err error) {
+	err = c.invoke(ctx, func(ctx context.Context, opts ...grpc.CallOption) (err error) {
+		res, err = c.api.CheckIn(ctx, &protov1.CheckInRequest{Records: records}, opts...)
+		return
+	})
+	return
+}
+
+// RegisterVenue adds a new venue where users can check-in.
+func (c *Client) RegisterVenue(ctx context.Context, req *protov1.RegisterVenueRequest) (res *protov1.Venue, err error) {
+	err = c.invoke(ctx, func(ctx context.Context, opts ...grpc.CallOption) (err error) {
+		res, err = c.api.RegisterVenue(ctx, req, opts...)
+		return
+	})
+	return
+}
+
+// VenueOutbreak links a venue to a confirmed case.
+func (c *Client) VenueOutbreak(ctx context.Context,
+	req *protov1.VenueOutbreakRequest) (res *protov1.VenueOutbreakResponse, err error) {
+	err = c.invoke(ctx, func(ctx context.Context, opts ...grpc.CallOption) (err error) {
+		res, err = c.api.VenueOutbreak(ctx, req, opts...)
+		return
+	})
+	return
+}
+
+// LabResult submits test results as HL7 FHIR resources.
+func (c *Client) LabResult(ctx context.Context,
+	req *protov1.LabResultRequest) (res *protov1.LabResultResponse, err error) {
+	err = c.invoke(ctx, func(ctx context.Context, opts ...grpc.CallOption) (err error) {
+		res, err = c.api.LabResult(ctx, req, opts...)
+		return
+	})
+	return
+}
+
+// Analytics returns anonymized hotspots and movement flows.
+func (c *Client) Analytics(ctx context.Context,
+	req *protov1.AnalyticsRequest) (res *protov1.AnalyticsResponse, err error) {
+	err = c.invoke(ctx, func(ctx context.Context, opts ...grpc.CallOption) (err error) {
+		res, err = c.api.Analytics(ctx, req, opts...)
+		return
+	})
+	return
+}
+
+// Certificate requests a verifiable health credential for a test result.
+func (c *Client) Certificate(ctx context.Context,
+	req *protov1.CertificateRequest) (res *protov1.CertificateResponse, err error) {
+	err = c.invoke(ctx, func(ctx context.Context, opts ...grpc.CallOption) (err error) {
+		res, err = c.api.Certificate(ctx, req, opts...)
+		return
+	})
+	return
+}
+
+// Execute a request. Expired credentials are renewed automatically and
+// requests failing due to temporary conditions are retried using an
+// exponential backoff strategy. Returned errors are of type *Error when
+// the server provides a status for the failed request.
+func (c *Client) invoke(ctx context.Context, call func(context.Context, ...grpc.CallOption) error) error {
+	var (
+		renewed bool
+		header  metadata.MD
+		delay   = c.backoff
+	)
+	for attempt := 1; ; attempt++ {
+		err := call(c.outgoingContext(ctx), grpc.Header(&header))
+		if err == nil {
+			return nil
+		}
+		err = parseError(err, requestID(header))
+		e, ok := err.(*Error)
+		if !ok {
+			return err
+		}
+
+		// Renew expired credentials, only once per request
+		if e.Status == codes.Unauthenticated && !renewed && c.canRenew() {
+			renewed = true
+			if err := c.renew(ctx); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// Retry temporary failures
+		if !e.Temporary() || attempt >= c.retries {
+			return e
+		}
+		wait := delay
+		if e.RetryAfter > 0 {
+			wait = e.RetryAfter
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+			delay *= 2
+		}
+	}
+}
+
+// Obtain new access credentials using the refresh code available.
+func (c *Client) renew(ctx context.Context) error {
+	c.mu.RLock()
+	req := &protov1.RenewCredentialsRequest{RefreshCode: c.creds.RefreshCode}
+	c.mu.RUnlock()
+	var header metadata.MD
+	res, err := c.api.RenewCredentials(c.outgoingContext(ctx), req, grpc.Header(&header))
+	if err != nil {
+		return parseError(err, requestID(header))
+	}
+	return c.setCredentials(res)
+}
+
+// Update the credentials used by the client and persist them if a
+// credentials store was provided.
+func (c *Client) setCredentials(creds *protov1.CredentialsResponse) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.creds = creds
+	if c.store == nil {
+		return nil
+	}
+	return c.store.Save(creds)
+}
+
+// Whether the current credentials can be renewed.
+func (c *Client) canRenew() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.apiKey == "" && c.creds != nil && c.creds.RefreshCode != ""
+}
+
+// Attach the access token and language preference to an outgoing request.
+func (c *Client) outgoingContext(ctx context.Context) context.Context {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	switch {
+	case c.apiKey != "":
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "ApiKey "+c.apiKey)
+	case c.creds != nil && c.creds.AccessToken != "":
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+c.creds.AccessToken)
+	}
+	if c.lang != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "accept-language", c.lang)
+	}
+	return ctx
+}
+
+// Return the request identifier assigned by the server, if any.
+func requestID(md metadata.MD) string {
+	if v := md.Get("x-request-id"); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
@@ -0,0 +1,32 @@
+package client
+
+import (
+	"fmt"
+	"testing"
+
+	"go.bryk.io/covid-tracking/utils"
+)
+
+func TestSplitTokens(t *testing.T) {
+	tokens := make([]string, 2*utils.MaxCheckTokens+1)
+	for i := range tokens {
+		tokens[i] = fmt.Sprintf("%04x", i)
+	}
+	batches := splitTokens(tokens)
+	if len(batches) != 3 {
+		t.Fatalf("invalid number of batches: %d", len(batches))
+	}
+	total := 0
+	for _, b := range batches {
+		if len(b) > utils.MaxCheckTokens {
+			t.Errorf("batch too large: %d", len(b))
+		}
+		total += len(b)
+	}
+	if total != len(tokens) || batches[2][0] != tokens[len(tokens)-1] {
+		t.Error("invalid batches")
+	}
+	if len(splitTokens(nil)) != 0 {
+		t.Error("no batches expected for an empty list")
+	}
+}
@@ -0,0 +1,28 @@
+/*
+Package client provides a Go SDK for the contact tracing platform API.
+
+The client wraps the gRPC stubs and takes care of the common integration
+details: credentials loading and persistence, automatic renewal of expired
+access tokens using the refresh code, retries with exponential backoff for
+temporary failures, record signing helpers and typed errors.
+
+	cl, err := client.New("ct19.example.org:443",
+		client.WithCredentialsFile("credentials.json"))
+	if err != nil {
+		panic(err)
+	}
+	defer cl.Close()
+
+	// Sign and submit a location record
+	record := &protov1.LocationRecord{
+		Did:       id.DID(),
+		Lat:       19.4326,
+		Lng:       -99.1332,
+		Timestamp: time.Now().Unix(),
+	}
+	if err := client.SignRecord(id, record); err != nil {
+		panic(err)
+	}
+	_, err = cl.Record(context.Background(), record)
+*/
+package client
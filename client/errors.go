@@ -0,0 +1,78 @@
+package client
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Error provides the details of a failed API request.
+type Error struct {
+	// Standard gRPC status code.
+	Status codes.Code
+
+	// Error condition, as defined by the platform's error catalog.
+	Code protov1.ErrorCode
+
+	// Error description.
+	Message string
+
+	// Localized error description, suitable to be displayed to end users.
+	LocalizedMessage string
+
+	// Invalid request fields and the corresponding violation description.
+	Violations map[string]string
+
+	// Suggested delay before retrying the request, if applicable.
+	RetryAfter time.Duration
+
+	// Identifier assigned by the server to the failed request.
+	RequestID string
+}
+
+// Error returns a textual representation of the error.
+func (e *Error) Error() string {
+	if e.RequestID != "" {
+		return fmt.Sprintf("%s (%s, request: %s)", e.Message, e.Code, e.RequestID)
+	}
+	return fmt.Sprintf("%s (%s)", e.Message, e.Code)
+}
+
+// Temporary returns true if the request can be retried.
+func (e *Error) Temporary() bool {
+	return e.Status == codes.Unavailable || e.Status == codes.ResourceExhausted
+}
+
+// Convert an error returned by the gRPC stubs into an Error instance.
+func parseError(err error, requestID string) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	e := &Error{
+		Status:    st.Code(),
+		Message:   st.Message(),
+		RequestID: requestID,
+	}
+	for _, d := range st.Details() {
+		switch detail := d.(type) {
+		case *protov1.ErrorDetail:
+			e.Code = detail.Code
+		case *errdetails.LocalizedMessage:
+			e.LocalizedMessage = detail.Message
+		case *errdetails.BadRequest:
+			e.Violations = make(map[string]string)
+			for _, v := range detail.FieldViolations {
+				e.Violations[v.Field] = v.Description
+			}
+		case *errdetails.RetryInfo:
+			e.RetryAfter, _ = ptypes.Duration(detail.RetryDelay)
+		}
+	}
+	return e
+}
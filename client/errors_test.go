@@ -0,0 +1,50 @@
+package client
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestParseError(t *testing.T) {
+	st, err := status.New(codes.Unavailable, "failed to publish message").WithDetails(
+		&protov1.ErrorDetail{Code: protov1.ErrorCode_ERROR_CODE_UNAVAILABLE},
+		&errdetails.RetryInfo{RetryDelay: ptypes.DurationProto(5 * time.Second)},
+		&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "records", Description: "empty request"},
+			},
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, ok := parseError(st.Err(), "request-1").(*Error)
+	if !ok {
+		t.Fatal("expected a typed error")
+	}
+	if e.Code != protov1.ErrorCode_ERROR_CODE_UNAVAILABLE {
+		t.Errorf("invalid error code: %s", e.Code)
+	}
+	if !e.Temporary() || e.RetryAfter != 5*time.Second {
+		t.Error("invalid retry information")
+	}
+	if e.Violations["records"] != "empty request" {
+		t.Error("missing field violation")
+	}
+	if e.RequestID != "request-1" {
+		t.Error("invalid request identifier")
+	}
+
+	// Non-status errors are returned as-is
+	plain := errors.New("connection closed")
+	if parseError(plain, "") != plain {
+		t.Error("unexpected conversion")
+	}
+}
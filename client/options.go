@@ -0,0 +1,129 @@
+package client
+
+import (
+	"time"
+
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+)
+
+// Option allows to adjust the behavior of a client instance.
+type Option func(*Client) error
+
+// WithCredentials sets the access credentials used by the client.
+func WithCredentials(credentials *protov1.CredentialsResponse) Option {
+	return func(c *Client) error {
+		c.creds = credentials
+		return nil
+	}
+}
+
+// WithAPIKey authenticates all requests using the provided API key instead
+// of access credentials. Meant for backend integrations.
+func WithAPIKey(key string) Option {
+	return func(c *Client) error {
+		c.apiKey = key
+		return nil
+	}
+}
+
+// WithCredentialsFile loads the access credentials from a JSON file, as
+// generated by the "register" command. Renewed credentials are saved back
+// to the same file.
+func WithCredentialsFile(file string) Option {
+	return WithCredentialsStore(&FileStore{Path: file})
+}
+
+// WithCredentialsStore loads the access credentials from the provided store.
+// Renewed credentials are saved back to the same store.
+func WithCredentialsStore(store Store) Option {
+	return func(c *Client) error {
+		credentials, err := store.Load()
+		if err != nil {
+			return err
+		}
+		c.creds = credentials
+		c.store = store
+		return nil
+	}
+}
+
+// WithRetries sets the maximum number of attempts for requests failing due
+// to temporary conditions, and the initial delay between attempts. The delay
+// is doubled on every attempt, unless the server suggests a specific value.
+func WithRetries(attempts int, delay time.Duration) Option {
+	return func(c *Client) error {
+		c.retries = attempts
+		c.backoff = delay
+		return nil
+	}
+}
+
+// WithTimeout sets the maximum duration for individual requests.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) error {
+		c.timeout = timeout
+		return nil
+	}
+}
+
+// WithLanguage sets the preferred language for error messages and
+// notifications, i.e. "es-MX".
+func WithLanguage(lang string) Option {
+	return func(c *Client) error {
+		c.lang = lang
+		return nil
+	}
+}
+
+// WithAppVersion sets the version of the client application and the device
+// platform, i.e. "android", reported with the location records submitted.
+func WithAppVersion(version, platform string) Option {
+	return func(c *Client) error {
+		c.app = version
+		c.platform = platform
+		return nil
+	}
+}
+
+// WithInsecureSkipVerify accepts any certificate presented by the server.
+// Dangerous, for development only.
+func WithInsecureSkipVerify() Option {
+	return func(c *Client) error {
+		c.insecure = true
+		return nil
+	}
+}
+
+// WithCACert trusts an additional, PEM-encoded, CA certificate to validate
+// the server certificate, i.e. for private deployments.
+func WithCACert(cert []byte) Option {
+	return func(c *Client) error {
+		c.cas = append(c.cas, cert)
+		return nil
+	}
+}
+
+// WithProfile uses the credentials and TLS settings of a profile stored on
+// the provided file; the current profile is used if 'name' is empty. Renewed
+// credentials are saved back to the profile. The profile's endpoint is used
+// if no endpoint is provided when creating the client.
+func WithProfile(file *ProfilesFile, name string) Option {
+	return func(c *Client) error {
+		p, err := file.Get(name)
+		if err != nil {
+			return err
+		}
+		if p.Credentials != nil {
+			c.creds = p.Credentials
+			c.store = file.Store(p.Name)
+		}
+		if p.TLS != nil {
+			c.insecure = c.insecure || p.TLS.Insecure
+			if p.TLS.CACert != "" {
+				c.cas = append(c.cas, []byte(p.TLS.CACert))
+			}
+		}
+		c.endpoint = p.Endpoint
+		return nil
+	}
+}
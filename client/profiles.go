@@ -0,0 +1,236 @@
+package client
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/pkg/errors"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+)
+
+// ErrProfileNotFound is returned when the requested profile is not available
+// on the profiles file.
+var ErrProfileNotFound = errors.New("profile not found")
+
+// Profile groups the settings required to access a platform deployment
+// under a given identity, like the contexts on a kubeconfig file.
+type Profile struct {
+	// Unique profile name, i.e. "agent-bogota".
+	Name string `json:"name"`
+
+	// Server endpoint, i.e. "ct19.example.org:443".
+	Endpoint string `json:"endpoint"`
+
+	// TLS settings used to connect to the server.
+	TLS *ProfileTLS `json:"tls,omitempty"`
+
+	// Access credentials, as generated by the "register" command.
+	Credentials *protov1.CredentialsResponse `json:"credentials,omitempty"`
+}
+
+// ProfileTLS provides the TLS settings for a profile.
+type ProfileTLS struct {
+	// PEM-encoded certificate of an additional CA trusted to validate the
+	// server certificate, i.e. for private deployments.
+	CACert string `json:"ca_cert,omitempty"`
+
+	// Accept any certificate presented by the server. Dangerous, for
+	// development only.
+	Insecure bool `json:"insecure,omitempty"`
+}
+
+// Contents of a profiles file.
+type profilesDoc struct {
+	Current  string     `json:"current"`
+	Profiles []*Profile `json:"profiles"`
+}
+
+// ProfilesFile keeps several named profiles on a local JSON file, along with
+// the profile used by default. As with FileStore, the file contents are
+// encrypted when a passphrase is provided.
+type ProfilesFile struct {
+	// Profiles file location.
+	Path string
+
+	// Optional passphrase used to encrypt the file contents.
+	Passphrase []byte
+}
+
+// List returns the available profiles, sorted by name, and the name of the
+// current profile. A missing file is treated as an empty list.
+func (pf *ProfilesFile) List() ([]*Profile, string, error) {
+	doc, err := pf.load()
+	if err != nil {
+		return nil, "", err
+	}
+	return doc.Profiles, doc.Current, nil
+}
+
+// Get returns the profile with the provided name, or the current profile
+// if 'name' is empty.
+func (pf *ProfilesFile) Get(name string) (*Profile, error) {
+	doc, err := pf.load()
+	if err != nil {
+		return nil, err
+	}
+	if name == "" {
+		name = doc.Current
+	}
+	if p := doc.get(name); p != nil {
+		return p, nil
+	}
+	return nil, ErrProfileNotFound
+}
+
+// Set adds a profile, or replaces an existing one with the same name. The
+// first profile added becomes the current one.
+func (pf *ProfilesFile) Set(profile *Profile) error {
+	if profile.Name == "" {
+		return errors.New("a profile name is required")
+	}
+	doc, err := pf.load()
+	if err != nil {
+		return err
+	}
+	replaced := false
+	for i, p := range doc.Profiles {
+		if p.Name == profile.Name {
+			doc.Profiles[i] = profile
+			replaced = true
+		}
+	}
+	if !replaced {
+		doc.Profiles = append(doc.Profiles, profile)
+	}
+	if doc.Current == "" {
+		doc.Current = profile.Name
+	}
+	return pf.save(doc)
+}
+
+// Remove discards a profile.
+func (pf *ProfilesFile) Remove(name string) error {
+	doc, err := pf.load()
+	if err != nil {
+		return err
+	}
+	var list []*Profile
+	for _, p := range doc.Profiles {
+		if p.Name != name {
+			list = append(list, p)
+		}
+	}
+	if len(list) == len(doc.Profiles) {
+		return ErrProfileNotFound
+	}
+	doc.Profiles = list
+	if doc.Current == name {
+		doc.Current = ""
+	}
+	return pf.save(doc)
+}
+
+// Use sets the profile used by default.
+func (pf *ProfilesFile) Use(name string) error {
+	doc, err := pf.load()
+	if err != nil {
+		return err
+	}
+	if doc.get(name) == nil {
+		return ErrProfileNotFound
+	}
+	doc.Current = name
+	return pf.save(doc)
+}
+
+// Store returns a credentials store for the profile with the provided name,
+// so renewed credentials are saved back to the profile.
+func (pf *ProfilesFile) Store(name string) Store {
+	return &profileStore{file: pf, name: name}
+}
+
+func (pf *ProfilesFile) load() (*profilesDoc, error) {
+	doc := &profilesDoc{}
+	contents, err := ioutil.ReadFile(filepath.Clean(pf.Path))
+	if os.IsNotExist(err) {
+		return doc, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	sf := &sealedFile{}
+	if err := json.Unmarshal(contents, sf); err == nil && len(sf.Ciphertext) > 0 {
+		if len(pf.Passphrase) == 0 {
+			return nil, ErrPassphraseRequired
+		}
+		if contents, err = open(sf, pf.Passphrase); err != nil {
+			return nil, err
+		}
+	}
+	if err := json.Unmarshal(contents, doc); err != nil {
+		return nil, errors.Wrap(err, "failed to decode profiles file")
+	}
+	return doc, nil
+}
+
+func (pf *ProfilesFile) save(doc *profilesDoc) error {
+	sort.Slice(doc.Profiles, func(i, j int) bool { return doc.Profiles[i].Name < doc.Profiles[j].Name })
+	contents, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return err
+	}
+	if len(pf.Passphrase) > 0 {
+		sf, err := seal(contents, pf.Passphrase)
+		if err != nil {
+			return err
+		}
+		if contents, err = json.MarshalIndent(sf, "", "  "); err != nil {
+			return err
+		}
+	}
+	if err := os.MkdirAll(filepath.Dir(filepath.Clean(pf.Path)), 0700); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(filepath.Clean(pf.Path), contents, 0600); err != nil {
+		return errors.Wrap(err, "failed to save profiles")
+	}
+	return nil
+}
+
+func (doc *profilesDoc) get(name string) *Profile {
+	for _, p := range doc.Profiles {
+		if p.Name == name {
+			return p
+		}
+	}
+	return nil
+}
+
+// Credentials store backed by a profile.
+type profileStore struct {
+	file *ProfilesFile
+	name string
+}
+
+func (ps *profileStore) Load() (*protov1.CredentialsResponse, error) {
+	p, err := ps.file.Get(ps.name)
+	if err != nil {
+		return nil, err
+	}
+	if p.Credentials == nil {
+		return nil, errors.Errorf("no credentials available for profile: %s", p.Name)
+	}
+	return p.Credentials, nil
+}
+
+func (ps *profileStore) Save(credentials *protov1.CredentialsResponse) error {
+	p, err := ps.file.Get(ps.name)
+	if err != nil {
+		return err
+	}
+	p.Credentials = credentials
+	return ps.file.Set(p)
+}
@@ -0,0 +1,68 @@
+package client
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+)
+
+func TestProfilesFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "profiles")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	pf := &ProfilesFile{Path: filepath.Join(dir, "profiles.json"), Passphrase: []byte("super-secret")}
+	if _, err := pf.Get(""); err != ErrProfileNotFound {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	// The first profile becomes the current one
+	for _, name := range []string{"agent-bogota", "agent-lima"} {
+		err := pf.Set(&Profile{
+			Name:        name,
+			Endpoint:    name + ".ct19.example.org:443",
+			Credentials: &protov1.CredentialsResponse{AccessToken: "token-" + name},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	p, err := pf.Get("")
+	if err != nil || p.Name != "agent-bogota" {
+		t.Fatalf("invalid current profile: %v", err)
+	}
+	if err := pf.Use("agent-lima"); err != nil {
+		t.Fatal(err)
+	}
+	if err := pf.Use("agent-quito"); err != ErrProfileNotFound {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	// Renewed credentials are saved to the profile
+	store := pf.Store("")
+	if err := store.Save(&protov1.CredentialsResponse{AccessToken: "renewed"}); err != nil {
+		t.Fatal(err)
+	}
+	creds, err := pf.Store("agent-lima").Load()
+	if err != nil || creds.AccessToken != "renewed" {
+		t.Errorf("credentials not updated: %v", err)
+	}
+
+	// Encrypted contents
+	if _, _, err := (&ProfilesFile{Path: pf.Path}).List(); err != ErrPassphraseRequired {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := pf.Remove("agent-lima"); err != nil {
+		t.Fatal(err)
+	}
+	list, current, err := pf.List()
+	if err != nil || len(list) != 1 || current != "" {
+		t.Errorf("invalid profiles list: %d %s %v", len(list), current, err)
+	}
+}
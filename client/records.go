@@ -0,0 +1,94 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/covid-tracking/utils"
+	"go.bryk.io/x/ccg/did"
+	"golang.org/x/crypto/sha3"
+)
+
+// Key used to sign records and domain value included on signatures.
+const (
+	signingKey    = "master"
+	signingDomain = "ct19.bryk.io"
+)
+
+// SignRecord calculates the hash of a location record and adds the
+// corresponding proof, produced using the "master" key of the provided
+// DID instance.
+func SignRecord(id *did.Identifier, r *protov1.LocationRecord) (err error) {
+	r.Hash = r.GenerateHash()
+	r.Proof, err = sign(id, r.Hash)
+	return
+}
+
+// SignCheckIn calculates the hash of a check-in record and adds the
+// corresponding proof, produced using the "master" key of the provided
+// DID instance.
+func SignCheckIn(id *did.Identifier, r *protov1.CheckInRecord) (err error) {
+	r.Hash = r.GenerateHash()
+	r.Proof, err = sign(id, r.Hash)
+	return
+}
+
+// VerifyRecord ensures a location record was produced by the provided DID
+// instance, i.e. its hash is valid and the proof was generated by one of the
+// DID keys.
+func VerifyRecord(id *did.Identifier, r *protov1.LocationRecord) error {
+	return verify(id, r.Did, r.Hash, r.GenerateHash(), r.Proof)
+}
+
+// VerifyCheckIn ensures a check-in record was produced by the provided DID
+// instance, i.e. its hash is valid and the proof was generated by one of the
+// DID keys.
+func VerifyCheckIn(id *did.Identifier, r *protov1.CheckInRecord) error {
+	return verify(id, r.Did, r.Hash, r.GenerateHash(), r.Proof)
+}
+
+// CellTokens returns the tokens for the location cells and time buckets
+// visited on the provided records, as used for exposure self-checks.
+// Aggregate-only records are ignored.
+func CellTokens(records []*protov1.LocationRecord) []string {
+	var tokens []string
+	seen := make(map[string]bool)
+	for _, r := range records {
+		if r.AggregateOnly {
+			continue
+		}
+		cell := utils.GeoHash(float64(r.Lat), float64(r.Lng), utils.CellPrecision)
+		bucket := utils.TimeBucket(time.Unix(r.Timestamp, 0), utils.BucketSize)
+		if token := utils.CellToken(cell, bucket); !seen[token] {
+			seen[token] = true
+			tokens = append(tokens, token)
+		}
+	}
+	return tokens
+}
+
+func verify(id *did.Identifier, subject, hash, expected string, proof []byte) error {
+	if subject != id.DID() {
+		return errors.New("record doesn't belong to the DID")
+	}
+	if hash != expected {
+		return errors.New("invalid record hash")
+	}
+	return utils.VerifySignature(id, []byte(hash), proof)
+}
+
+// Produce a JSON-encoded LD signature document for the provided value.
+func sign(id *did.Identifier, value string) ([]byte, error) {
+	key := id.Key(signingKey)
+	if key == nil {
+		return nil, errors.New("signing key not available")
+	}
+	input := sha3.Sum256([]byte(value))
+	signature, err := key.ProduceSignatureLD(input[:], signingDomain)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(signature)
+}
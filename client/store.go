@@ -0,0 +1,205 @@
+package client
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/pkg/errors"
+	"github.com/zalando/go-keyring"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Parameters used to derive encryption keys from passphrases.
+const (
+	scryptN      = 32768
+	scryptR      = 8
+	scryptP      = 1
+	scryptKeyLen = 32
+	saltSize     = 16
+)
+
+// ErrPassphraseRequired is returned when loading an encrypted credentials
+// file without providing a passphrase.
+var ErrPassphraseRequired = errors.New("credentials file is encrypted, a passphrase is required")
+
+// Store provides a persistence mechanism for access credentials.
+type Store interface {
+	// Load previously saved credentials.
+	Load() (*protov1.CredentialsResponse, error)
+
+	// Save credentials, replacing any existing value.
+	Save(credentials *protov1.CredentialsResponse) error
+}
+
+// FileStore keeps access credentials in a local JSON file. When a passphrase
+// is provided the file contents are encrypted using AES-256-GCM with a key
+// derived from the passphrase using scrypt.
+type FileStore struct {
+	// Credentials file location.
+	Path string
+
+	// Optional passphrase used to encrypt the file contents.
+	Passphrase []byte
+}
+
+// Encrypted credentials file contents.
+type sealedFile struct {
+	KDF        string `json:"kdf"`
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// Load credentials from the file. Encrypted files require a passphrase.
+func (fs *FileStore) Load() (*protov1.CredentialsResponse, error) {
+	contents, err := ioutil.ReadFile(filepath.Clean(fs.Path))
+	if err != nil {
+		return nil, err
+	}
+	sf := &sealedFile{}
+	if err := json.Unmarshal(contents, sf); err == nil && len(sf.Ciphertext) > 0 {
+		if len(fs.Passphrase) == 0 {
+			return nil, ErrPassphraseRequired
+		}
+		if contents, err = open(sf, fs.Passphrase); err != nil {
+			return nil, err
+		}
+	}
+	return decodeCredentials(contents)
+}
+
+// Save credentials to the file, encrypting the contents if a passphrase
+// is available.
+func (fs *FileStore) Save(credentials *protov1.CredentialsResponse) error {
+	contents, err := encodeCredentials(credentials)
+	if err != nil {
+		return err
+	}
+	if len(fs.Passphrase) > 0 {
+		sf, err := seal(contents, fs.Passphrase)
+		if err != nil {
+			return err
+		}
+		if contents, err = json.MarshalIndent(sf, "", "  "); err != nil {
+			return err
+		}
+	}
+	if err := ioutil.WriteFile(filepath.Clean(fs.Path), contents, 0600); err != nil {
+		return errors.Wrap(err, "failed to save credentials")
+	}
+	return nil
+}
+
+// KeyringStore keeps access credentials in the OS keychain/keyring: Keychain
+// on macOS, the Secret Service (GNOME Keyring, KWallet) on Linux and the
+// Credential Manager on Windows.
+type KeyringStore struct {
+	// Service name used to group the stored entries.
+	Service string
+
+	// Account name for the credentials, i.e. the server endpoint.
+	Account string
+}
+
+// Load credentials from the OS keyring.
+func (ks *KeyringStore) Load() (*protov1.CredentialsResponse, error) {
+	secret, err := keyring.Get(ks.Service, ks.Account)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read credentials from keyring")
+	}
+	return decodeCredentials([]byte(secret))
+}
+
+// Save credentials to the OS keyring.
+func (ks *KeyringStore) Save(credentials *protov1.CredentialsResponse) error {
+	contents, err := encodeCredentials(credentials)
+	if err != nil {
+		return err
+	}
+	if err := keyring.Set(ks.Service, ks.Account, string(contents)); err != nil {
+		return errors.Wrap(err, "failed to save credentials to keyring")
+	}
+	return nil
+}
+
+// Remove the credentials from the OS keyring.
+func (ks *KeyringStore) Remove() error {
+	return keyring.Delete(ks.Service, ks.Account)
+}
+
+// Encode credentials in JSON format, as generated by the "register" command.
+func encodeCredentials(credentials *protov1.CredentialsResponse) ([]byte, error) {
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: true,
+		OrigName:     true,
+	}
+	output, err := m.MarshalToString(credentials)
+	return []byte(output), err
+}
+
+// Decode JSON-encoded credentials.
+func decodeCredentials(contents []byte) (*protov1.CredentialsResponse, error) {
+	credentials := &protov1.CredentialsResponse{}
+	if err := jsonpb.Unmarshal(bytes.NewReader(contents), credentials); err != nil {
+		return nil, errors.Wrap(err, "failed to decode credentials content")
+	}
+	return credentials, nil
+}
+
+// Encrypt data using a key derived from the provided passphrase.
+func seal(data, passphrase []byte) (*sealedFile, error) {
+	sf := &sealedFile{
+		KDF:  "scrypt",
+		Salt: make([]byte, saltSize),
+	}
+	if _, err := rand.Read(sf.Salt); err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(passphrase, sf.Salt)
+	if err != nil {
+		return nil, err
+	}
+	sf.Nonce = make([]byte, aead.NonceSize())
+	if _, err := rand.Read(sf.Nonce); err != nil {
+		return nil, err
+	}
+	sf.Ciphertext = aead.Seal(nil, sf.Nonce, data, nil)
+	return sf, nil
+}
+
+// Decrypt data previously encrypted with 'seal'.
+func open(sf *sealedFile, passphrase []byte) ([]byte, error) {
+	if sf.KDF != "scrypt" {
+		return nil, errors.Errorf("unsupported key derivation function: %s", sf.KDF)
+	}
+	aead, err := newAEAD(passphrase, sf.Salt)
+	if err != nil {
+		return nil, err
+	}
+	data, err := aead.Open(nil, sf.Nonce, sf.Ciphertext, nil)
+	if err != nil {
+		return nil, errors.New("invalid passphrase or corrupted credentials file")
+	}
+	return data, nil
+}
+
+// Prepare an AES-256-GCM cipher using a key derived from the passphrase.
+func newAEAD(passphrase, salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key(passphrase, salt, scryptN, scryptR, scryptP, scryptKeyLen)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
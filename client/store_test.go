@@ -0,0 +1,27 @@
+package client
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSealOpen(t *testing.T) {
+	data := []byte(`{"access_token":"eyJhbGciOiJFUzM4NCJ9...","refresh_code":"f4c1d2"}`)
+	sf, err := seal(data, []byte("super-secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(sf.Ciphertext, []byte("access_token")) {
+		t.Error("data not encrypted")
+	}
+	res, err := open(sf, []byte("super-secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(res, data) {
+		t.Error("invalid decrypted data")
+	}
+	if _, err := open(sf, []byte("wrong-passphrase")); err == nil {
+		t.Error("invalid passphrase accepted")
+	}
+}
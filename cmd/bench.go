@@ -0,0 +1,289 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.bryk.io/covid-tracking/client"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/x/ccg/did"
+	"go.bryk.io/x/cli"
+	xlog "go.bryk.io/x/log"
+)
+
+var benchCmd = &cobra.Command{
+	Use:     "bench",
+	Short:   "Generate synthetic load against an API server",
+	Example: "bench server.com:443 --credentials credentials.json --rate 200 --duration 120",
+	RunE:    runBench,
+	Long: `Load testing
+
+Generates synthetic DIDs and signed location record batches and submits
+them to an API server at a constant rate, reporting the latency percentiles
+and error rates observed. Useful to size a deployment before launch.
+
+Requests are authenticated using the provided credentials, which must be
+allowed to submit location records. Records are signed by the synthetic
+DIDs, so they exercise the complete ingestion path on the API servers but
+are discarded by the validation performed before storing them.`,
+}
+
+func init() {
+	params := []cli.Param{
+		{
+			Name:      "credentials",
+			Usage:     "Credentials file to use",
+			FlagKey:   "bench.credentials",
+			ByDefault: "credentials.json",
+		},
+		{
+			Name:      "users",
+			Usage:     "Number of synthetic DIDs to generate",
+			FlagKey:   "bench.users",
+			ByDefault: 100,
+		},
+		{
+			Name:      "batch",
+			Usage:     "Number of location records per request",
+			FlagKey:   "bench.batch",
+			ByDefault: 10,
+		},
+		{
+			Name:      "rate",
+			Usage:     "Number of requests per second",
+			FlagKey:   "bench.rate",
+			ByDefault: 50,
+		},
+		{
+			Name:      "duration",
+			Usage:     "Duration of the test, in seconds",
+			FlagKey:   "bench.duration",
+			ByDefault: 60,
+		},
+		{
+			Name:      "concurrency",
+			Usage:     "Maximum number of requests in flight",
+			FlagKey:   "bench.concurrency",
+			ByDefault: 50,
+		},
+		{
+			Name:      "insecure",
+			Usage:     "Accept any certificate presented. Dangerous, for development only",
+			FlagKey:   "bench.insecure",
+			ByDefault: false,
+		},
+	}
+	if err := cli.SetupCommandParams(benchCmd, params); err != nil {
+		panic(err)
+	}
+	rootCmd.AddCommand(benchCmd)
+}
+
+// Results collected during a load test.
+type benchResults struct {
+	latency []time.Duration
+	errors  map[string]int
+	mu      sync.Mutex
+}
+
+func (br *benchResults) add(d time.Duration, err error) {
+	br.mu.Lock()
+	defer br.mu.Unlock()
+	br.latency = append(br.latency, d)
+	if err == nil {
+		return
+	}
+	kind := "unknown"
+	if e, ok := err.(*client.Error); ok {
+		kind = e.Status.String()
+	}
+	br.errors[kind]++
+}
+
+// Latency value below which 'p' percent of the requests completed.
+func (br *benchResults) percentile(p float64) time.Duration {
+	if len(br.latency) == 0 {
+		return 0
+	}
+	i := int(float64(len(br.latency))*p/100+0.5) - 1
+	if i < 0 {
+		i = 0
+	}
+	if i >= len(br.latency) {
+		i = len(br.latency) - 1
+	}
+	return br.latency[i]
+}
+
+// Summary of the results of a load test.
+type benchReport struct {
+	Requests int               `json:"requests"`
+	Rate     float64           `json:"rate"`
+	Failed   int               `json:"failed"`
+	Errors   map[string]int    `json:"errors"`
+	Latency  map[string]string `json:"latency"`
+}
+
+// Percentiles included on the report.
+var benchPercentiles = []float64{50, 90, 95, 99, 100}
+
+func (rep *benchReport) Table() ([]string, [][]string) {
+	rows := [][]string{
+		{"requests", fmt.Sprintf("%d (%.1f/s)", rep.Requests, rep.Rate)},
+	}
+	if rep.Requests > 0 {
+		rate := float64(rep.Failed) * 100 / float64(rep.Requests)
+		rows = append(rows, []string{"errors", fmt.Sprintf("%d (%.2f%%)", rep.Failed, rate)})
+	}
+	kinds := make([]string, 0, len(rep.Errors))
+	for kind := range rep.Errors {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	for _, kind := range kinds {
+		rows = append(rows, []string{"  " + kind, strconv.Itoa(rep.Errors[kind])})
+	}
+	for _, p := range benchPercentiles {
+		key := fmt.Sprintf("p%v", p)
+		rows = append(rows, []string{"latency " + key, rep.Latency[key]})
+	}
+	return []string{"metric", "value"}, rows
+}
+
+// Summarize the results.
+func (br *benchResults) report(elapsed time.Duration) *benchReport {
+	br.mu.Lock()
+	defer br.mu.Unlock()
+	sort.Slice(br.latency, func(i, j int) bool { return br.latency[i] < br.latency[j] })
+	rep := &benchReport{
+		Requests: len(br.latency),
+		Rate:     float64(len(br.latency)) / elapsed.Seconds(),
+		Errors:   br.errors,
+		Latency:  make(map[string]string),
+	}
+	for _, n := range br.errors {
+		rep.Failed += n
+	}
+	for _, p := range benchPercentiles {
+		rep.Latency[fmt.Sprintf("p%v", p)] = br.percentile(p).String()
+	}
+	return rep
+}
+
+func runBench(_ *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return errors.New("you must specify the server endpoint")
+	}
+	users := viper.GetInt("bench.users")
+	batch := viper.GetInt("bench.batch")
+	rate := viper.GetInt("bench.rate")
+	concurrency := viper.GetInt("bench.concurrency")
+	duration := time.Duration(viper.GetInt("bench.duration")) * time.Second
+	if users < 1 || batch < 1 || rate < 1 || concurrency < 1 || duration <= 0 {
+		return errors.New("users, batch, rate, concurrency and duration must be positive values")
+	}
+
+	// Client instance, failed requests are not retried
+	credentials, err := loadCredentials(args[0], viper.GetString("bench.credentials"), false)
+	if err != nil {
+		return errors.Wrap(err, "failed to load credentials")
+	}
+	opts := []client.Option{
+		client.WithCredentials(credentials),
+		client.WithRetries(1, 0),
+	}
+	if viper.GetBool("bench.insecure") {
+		log.Warning("insecure client connection")
+		opts = append(opts, client.WithInsecureSkipVerify())
+	}
+	cl, err := client.New(args[0], opts...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = cl.Close()
+	}()
+
+	// Synthetic identifiers
+	rand.Seed(time.Now().UnixNano())
+	log.WithField("users", users).Info("generating synthetic DIDs")
+	ids := make([]*did.Identifier, users)
+	for i := range ids {
+		if ids[i], err = syntheticDID(); err != nil {
+			return err
+		}
+	}
+
+	// Submit requests at a constant rate
+	log.WithFields(xlog.Fields{
+		"rate":     rate,
+		"duration": duration.String(),
+	}).Info("starting load test")
+	results := &benchResults{errors: make(map[string]int)}
+	ticker := time.NewTicker(time.Second / time.Duration(rate))
+	defer ticker.Stop()
+	slots := make(chan struct{}, concurrency)
+	wg := sync.WaitGroup{}
+	start := time.Now()
+	for time.Since(start) < duration {
+		<-ticker.C
+		records, err := syntheticRecords(ids[rand.Intn(len(ids))], batch) // nolint: gosec
+		if err != nil {
+			return err
+		}
+		slots <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			ts := time.Now()
+			_, err := cl.Record(context.Background(), records...)
+			results.add(time.Since(ts), err)
+		}()
+	}
+	wg.Wait()
+	return printResult(results.report(time.Since(start)))
+}
+
+// Generate a new DID with a "master" key used to sign records.
+func syntheticDID() (*did.Identifier, error) {
+	id, err := did.NewIdentifierWithMode("bryk", "", did.ModeUUID)
+	if err != nil {
+		return nil, err
+	}
+	if err = id.AddNewKey("master", did.KeyTypeEd, did.EncodingBase58); err != nil {
+		return nil, err
+	}
+	return id, nil
+}
+
+// Generate a batch of signed location records, around a random location,
+// for a synthetic DID.
+func syntheticRecords(id *did.Identifier, size int) ([]*protov1.LocationRecord, error) {
+	lat := rand.Float32()*120 - 60  // nolint: gosec
+	lng := rand.Float32()*340 - 170 // nolint: gosec
+	now := time.Now().Unix()
+	list := make([]*protov1.LocationRecord, size)
+	for i := range list {
+		list[i] = &protov1.LocationRecord{
+			Did:       id.String(),
+			Lat:       lat + rand.Float32()*0.01, // nolint: gosec
+			Lng:       lng + rand.Float32()*0.01, // nolint: gosec
+			Timestamp: now - int64(size-i)*60,
+		}
+		if err := client.SignRecord(id, list[i]); err != nil {
+			return nil, err
+		}
+	}
+	return list, nil
+}
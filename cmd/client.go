@@ -2,21 +2,32 @@ package cmd
 
 import (
 	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
 	"io/ioutil"
+	"os"
 	"path/filepath"
 	"time"
 
-	"github.com/gogo/protobuf/jsonpb"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"go.bryk.io/covid-tracking/api"
+	"go.bryk.io/covid-tracking/client"
 	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/covid-tracking/utils"
 	"go.bryk.io/x/cli"
 	"go.bryk.io/x/cli/shell"
-	"go.bryk.io/x/net/rpc"
 )
 
+// Service name used for credentials stored in the OS keyring.
+const keyringService = "ct19"
+
+// Environment variable used to provide the credentials file passphrase
+// in non-interactive environments.
+const passphraseEnv = "CT19_CREDENTIALS_PASSPHRASE"
+
 var clientCmd = &cobra.Command{
 	Use:     "client",
 	Short:   "Start an interactive CLI-based client",
@@ -24,6 +35,34 @@ var clientCmd = &cobra.Command{
 	RunE:    runClient,
 }
 
+var clientProtectCmd = &cobra.Command{
+	Use:     "protect",
+	Short:   "Encrypt a credentials file or move it to the OS keyring",
+	Example: "client protect server.com:443 --credentials ~/.covid-tracking.json --keyring",
+	RunE:    runClientProtect,
+	Long: `Protect access credentials
+
+Access tokens are valid for several days and the credentials file generated
+during registration stores them in plaintext. This command encrypts the
+credentials file in place using a passphrase or, when the "keyring" flag is
+set, moves the credentials to the OS keychain/keyring and removes the file.`,
+}
+
+var clientCodesCmd = &cobra.Command{
+	Use:     "codes",
+	Short:   "Generate activation codes for a registration campaign",
+	Example: "client codes server.com:443 --count 500 --campaign district-7 --qr-dir qr",
+	RunE:    runClientCodes,
+	Long: `Generate activation codes for a registration campaign
+
+Registration drives in areas without connectivity can use a batch of
+pre-generated "user" activation codes. The codes are not bound to a specific
+DID and can be redeemed once by any device. The list of codes is saved as a
+CSV file; when the "qr-dir" flag is set a PNG image of the QR code for each
+activation code is saved on the provided directory and referenced in the CSV
+file, ready to be used for printing.`,
+}
+
 func init() {
 	params := []cli.Param{
 		{
@@ -32,6 +71,12 @@ func init() {
 			FlagKey:   "client.credentials",
 			ByDefault: "credentials.json",
 		},
+		{
+			Name:      "keyring",
+			Usage:     "Use credentials stored in the OS keychain/keyring",
+			FlagKey:   "client.keyring",
+			ByDefault: false,
+		},
 		{
 			Name:      "insecure",
 			Usage:     "Accept any certificate presented. Dangerous, for development only",
@@ -42,44 +87,79 @@ func init() {
 	if err := cli.SetupCommandParams(clientCmd, params); err != nil {
 		panic(err)
 	}
+	protectParams := []cli.Param{
+		{
+			Name:      "credentials",
+			Usage:     "Credentials file to protect",
+			FlagKey:   "client.protect.credentials",
+			ByDefault: "credentials.json",
+		},
+		{
+			Name:      "keyring",
+			Usage:     "Move the credentials to the OS keychain/keyring",
+			FlagKey:   "client.protect.keyring",
+			ByDefault: false,
+		},
+	}
+	if err := cli.SetupCommandParams(clientProtectCmd, protectParams); err != nil {
+		panic(err)
+	}
+	codesParams := []cli.Param{
+		{
+			Name:      "credentials",
+			Usage:     "Credentials file to use",
+			FlagKey:   "client.codes.credentials",
+			ByDefault: "credentials.json",
+		},
+		{
+			Name:      "count",
+			Usage:     "Number of activation codes to generate",
+			FlagKey:   "client.codes.count",
+			ByDefault: 100,
+		},
+		{
+			Name:      "campaign",
+			Usage:     "Label for the registration campaign",
+			FlagKey:   "client.codes.campaign",
+			ByDefault: "",
+		},
+		{
+			Name:      "file",
+			Usage:     "CSV file to save the generated codes",
+			FlagKey:   "client.codes.file",
+			ByDefault: "activation-codes.csv",
+		},
+		{
+			Name:      "qr-dir",
+			Usage:     "Directory to save the QR code images",
+			FlagKey:   "client.codes.qr_dir",
+			ByDefault: "",
+		},
+		{
+			Name:      "insecure",
+			Usage:     "Accept any certificate presented. Dangerous, for development only",
+			FlagKey:   "client.codes.insecure",
+			ByDefault: false,
+		},
+	}
+	if err := cli.SetupCommandParams(clientCodesCmd, codesParams); err != nil {
+		panic(err)
+	}
+	clientCmd.AddCommand(clientProtectCmd)
+	clientCmd.AddCommand(clientCodesCmd)
 	rootCmd.AddCommand(clientCmd)
 }
 
 func runClient(_ *cobra.Command, args []string) error {
-	// Get server endpoint
-	if len(args) == 0 {
-		return errors.New("you must specify the server endpoint")
-	}
-	endpoint := args[0]
-
-	// Open credentials file
-	location := filepath.Clean(viper.GetString("client.credentials"))
-	contents, err := ioutil.ReadFile(filepath.Clean(location))
+	// Get server endpoint and credentials
+	cs, err := connectionSettings(args, viper.GetString("client.profile"), viper.GetString("client.credentials"),
+		viper.GetBool("client.keyring"), viper.GetBool("client.insecure"))
 	if err != nil {
-		return errors.Wrap(err, "failed to open credentials file")
-	}
-	credentials := &protov1.CredentialsResponse{}
-	if err = jsonpb.Unmarshal(bytes.NewReader(contents), credentials); err != nil {
-		return errors.Wrap(err, "failed to decode credentials content")
-	}
-
-	// Client configuration
-	clOpts := []rpc.ClientOption{
-		rpc.WaitForReady(),
-		rpc.WithTimeout(5 * time.Second),
-		rpc.WithCompression(),
-		rpc.WithClientTLS(rpc.ClientTLSConfig{IncludeSystemCAs: true}),
-		rpc.WithUserAgent("cli-client/0.1.0"),
-		rpc.WithAuthToken(credentials.AccessToken),
-	}
-	if viper.GetBool("client.insecure") {
-		log.Warning("insecure client connection")
-		clOpts = append(clOpts, rpc.WithInsecureSkipVerify())
+		return err
 	}
 
 	// Open connection
-	log.WithField("endpoint", endpoint).Debug("contacting server")
-	conn, err := rpc.NewClientConnection(endpoint, clOpts...)
+	conn, err := cs.connect(5 * time.Second)
 	if err != nil {
 		return err
 	}
@@ -91,7 +171,7 @@ func runClient(_ *cobra.Command, args []string) error {
 	if err != nil {
 		return errors.Wrap(err, "failed to start shell instance")
 	}
-	for _, cmd := range api.GetShellCommands(sh, cl) {
+	for _, cmd := range api.GetShellCommands(sh, cl, outputFormat(utils.OutputTable)) {
 		sh.AddCommand(cmd)
 	}
 	sh.Start()
@@ -100,3 +180,133 @@ func runClient(_ *cobra.Command, args []string) error {
 	log.Info("closing client")
 	return conn.Close()
 }
+
+func runClientProtect(_ *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return errors.New("you must specify the server endpoint")
+	}
+	location := filepath.Clean(viper.GetString("client.protect.credentials"))
+	credentials, err := (&client.FileStore{Path: location}).Load()
+	if err != nil {
+		return errors.Wrap(err, "failed to load credentials")
+	}
+
+	// Move credentials to the OS keyring
+	if viper.GetBool("client.protect.keyring") {
+		store := &client.KeyringStore{Service: keyringService, Account: args[0]}
+		if err := store.Save(credentials); err != nil {
+			return err
+		}
+		if err := os.Remove(location); err != nil {
+			return errors.Wrap(err, "failed to remove credentials file")
+		}
+		log.WithField("endpoint", args[0]).Info("credentials moved to the OS keyring")
+		return nil
+	}
+
+	// Encrypt credentials file
+	passphrase, err := utils.ReadSecret("Passphrase")
+	if err != nil {
+		return err
+	}
+	confirmation, err := utils.ReadSecret("Confirm passphrase")
+	if err != nil {
+		return err
+	}
+	if len(passphrase) == 0 || !bytes.Equal(passphrase, confirmation) {
+		return errors.New("passphrase is empty or doesn't match")
+	}
+	if err := (&client.FileStore{Path: location, Passphrase: passphrase}).Save(credentials); err != nil {
+		return err
+	}
+	log.WithField("file", location).Info("credentials file encrypted")
+	return nil
+}
+
+func runClientCodes(_ *cobra.Command, args []string) error {
+	cs, err := connectionSettings(args, viper.GetString("client.profile"), viper.GetString("client.codes.credentials"),
+		false, viper.GetBool("client.codes.insecure"))
+	if err != nil {
+		return err
+	}
+	cl, err := client.New(cs.endpoint, cs.clientOptions()...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = cl.Close()
+	}()
+
+	// Request codes
+	qrDir := viper.GetString("client.codes.qr_dir")
+	codes, err := cl.BulkActivationCodes(context.Background(), &protov1.BulkActivationCodesRequest{
+		Count:    uint32(viper.GetInt("client.codes.count")),
+		Campaign: viper.GetString("client.codes.campaign"),
+		QrImages: qrDir != "",
+	})
+	if err != nil {
+		return err
+	}
+
+	// Save results
+	if qrDir != "" {
+		if err := os.MkdirAll(filepath.Clean(qrDir), 0700); err != nil {
+			return err
+		}
+	}
+	output, err := os.Create(filepath.Clean(viper.GetString("client.codes.file")))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = output.Close()
+	}()
+	w := csv.NewWriter(output)
+	_ = w.Write([]string{"activation_code", "campaign", "qr_code", "qr_image", "expires"})
+	for _, c := range codes {
+		image := ""
+		if qrDir != "" {
+			image = filepath.Join(qrDir, fmt.Sprintf("%s.png", c.ActivationCode))
+			if err := ioutil.WriteFile(image, c.QrImage, 0600); err != nil {
+				return err
+			}
+		}
+		expires := time.Unix(c.Expires, 0).UTC().Format(time.RFC3339)
+		_ = w.Write([]string{c.ActivationCode, c.Campaign, c.QrCode, image, expires})
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return err
+	}
+	log.WithField("codes", len(codes)).Info("activation codes generated")
+	return nil
+}
+
+// Load credentials from the store selected by the user. For encrypted files
+// the passphrase is read from the environment or requested interactively.
+func loadCredentials(endpoint, file string, keyring bool) (*protov1.CredentialsResponse, error) {
+	store, err := credentialsStore(endpoint, file, keyring)
+	if err != nil {
+		return nil, err
+	}
+	return store.Load()
+}
+
+// Return the credentials store selected by the user, ready to be used.
+func credentialsStore(endpoint, file string, keyring bool) (client.Store, error) {
+	if keyring {
+		return &client.KeyringStore{Service: keyringService, Account: endpoint}, nil
+	}
+	store := &client.FileStore{
+		Path:       filepath.Clean(file),
+		Passphrase: []byte(os.Getenv(passphraseEnv)),
+	}
+	_, err := store.Load()
+	if err != client.ErrPassphraseRequired {
+		return store, err
+	}
+	if store.Passphrase, err = utils.ReadSecret("Passphrase"); err != nil {
+		return nil, err
+	}
+	return store, nil
+}
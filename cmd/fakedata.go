@@ -0,0 +1,277 @@
+package cmd
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/covid-tracking/storage"
+	"go.bryk.io/x/cli"
+	xlog "go.bryk.io/x/log"
+)
+
+var fakeDataCmd = &cobra.Command{
+	Use:     "fake-data",
+	Short:   "Populate storage with synthetic data for development",
+	Example: "fake-data --storage mongodb://localhost:27017 --users 500 --days 14",
+	RunE:    runFakeData,
+	Long: `Synthetic data generator
+
+Simulates the daily routine of a population of users around a location and
+stores the resulting location records, venue check-ins and diagnoses directly
+on the storage component. Useful to develop and test the contact matching and
+analytics features without real personal data. NEVER use it on production
+deployments.`,
+}
+
+func init() {
+	params := []cli.Param{
+		{
+			Name:      "storage",
+			Usage:     "Storage component endpoint",
+			FlagKey:   "storage",
+			ByDefault: "mongodb://localhost:27017",
+		},
+		{
+			Name:      "users",
+			Usage:     "Number of simulated users",
+			FlagKey:   "fake_data.users",
+			ByDefault: 100,
+		},
+		{
+			Name:      "days",
+			Usage:     "Number of days to simulate, up to the current date",
+			FlagKey:   "fake_data.days",
+			ByDefault: 7,
+		},
+		{
+			Name:      "venues",
+			Usage:     "Number of venues available for check-ins",
+			FlagKey:   "fake_data.venues",
+			ByDefault: 20,
+		},
+		{
+			Name:      "center",
+			Usage:     "Center of the simulated area, as 'lat,lng'",
+			FlagKey:   "fake_data.center",
+			ByDefault: "19.4326,-99.1332",
+		},
+		{
+			Name:      "radius",
+			Usage:     "Radius of the simulated area, in kilometers",
+			FlagKey:   "fake_data.radius",
+			ByDefault: 5,
+		},
+		{
+			Name:      "interval",
+			Usage:     "Minutes between location records",
+			FlagKey:   "fake_data.interval",
+			ByDefault: 15,
+		},
+		{
+			Name:      "positives",
+			Usage:     "Percentage of users with a positive diagnosis",
+			FlagKey:   "fake_data.positives",
+			ByDefault: 5,
+		},
+		{
+			Name:      "seed",
+			Usage:     "Seed for the random generator, to produce reproducible data sets (0 for a random seed)",
+			FlagKey:   "fake_data.seed",
+			ByDefault: 0,
+		},
+	}
+	if err := cli.SetupCommandParams(fakeDataCmd, params); err != nil {
+		panic(err)
+	}
+	rootCmd.AddCommand(fakeDataCmd)
+}
+
+// Owner registered for the simulated venues.
+const fakeDataOwner = "did:bryk:fake-data"
+
+// Geographic point, in degrees.
+type fakePoint struct {
+	lat float64
+	lng float64
+}
+
+// Simulated population over an area.
+type fakeWorld struct {
+	rnd      *rand.Rand
+	center   fakePoint
+	radius   float64
+	interval time.Duration
+	venues   []*protov1.Venue
+}
+
+// Simulated user with a fixed home and workplace.
+type fakeUser struct {
+	did  string
+	home fakePoint
+	work fakePoint
+}
+
+// Random point within 'radius' kilometers of 'p'.
+func (fw *fakeWorld) near(p fakePoint, radius float64) fakePoint {
+	d := radius * math.Sqrt(fw.rnd.Float64())
+	a := 2 * math.Pi * fw.rnd.Float64()
+	return fakePoint{
+		lat: p.lat + d*math.Sin(a)/111.32,
+		lng: p.lng + d*math.Cos(a)/(111.32*math.Cos(p.lat*math.Pi/180)),
+	}
+}
+
+func (fw *fakeWorld) newUser() *fakeUser {
+	return &fakeUser{
+		did:  fmt.Sprintf("did:bryk:%s", uuid.New().String()),
+		home: fw.near(fw.center, fw.radius),
+		work: fw.near(fw.center, fw.radius),
+	}
+}
+
+// Simulate a day for a user. Users stay home at night, commute to work on
+// weekdays and occasionally visit a venue in the evening.
+func (fw *fakeWorld) day(u *fakeUser, date time.Time) ([]*protov1.LocationRecord, []*protov1.CheckInRecord) {
+	var (
+		records  []*protov1.LocationRecord
+		checkIns []*protov1.CheckInRecord
+		venue    *protov1.Venue
+	)
+	now := time.Now()
+	weekday := date.Weekday() != time.Saturday && date.Weekday() != time.Sunday
+	if len(fw.venues) > 0 && fw.rnd.Float64() < 0.3 {
+		venue = fw.venues[fw.rnd.Intn(len(fw.venues))]
+		ts := date.Add(18*time.Hour + time.Duration(fw.rnd.Intn(60))*time.Minute)
+		if ts.Before(now) {
+			checkIns = append(checkIns, &protov1.CheckInRecord{
+				Did:       u.did,
+				Venue:     venue.Id,
+				Timestamp: ts.Unix(),
+			})
+		}
+	}
+	for ts := date; ts.Before(date.Add(24 * time.Hour)); ts = ts.Add(fw.interval) {
+		if ts.After(now) {
+			break
+		}
+		hour := ts.Sub(date).Hours()
+		p := u.home
+		switch {
+		case weekday && hour >= 8 && hour < 9:
+			p = fakePoint{
+				lat: u.home.lat + (u.work.lat-u.home.lat)*(hour-8),
+				lng: u.home.lng + (u.work.lng-u.home.lng)*(hour-8),
+			}
+		case weekday && hour >= 9 && hour < 17:
+			p = u.work
+		case venue != nil && hour >= 18 && hour < 20:
+			p = fakePoint{lat: float64(venue.Lat), lng: float64(venue.Lng)}
+		}
+		p = fw.near(p, 0.02)
+		r := &protov1.LocationRecord{
+			Did:       u.did,
+			Lat:       float32(p.lat),
+			Lng:       float32(p.lng),
+			Timestamp: ts.Unix(),
+		}
+		r.Hash = r.GenerateHash()
+		records = append(records, r)
+	}
+	for _, c := range checkIns {
+		c.Hash = c.GenerateHash()
+	}
+	return records, checkIns
+}
+
+func runFakeData(_ *cobra.Command, _ []string) error {
+	users := viper.GetInt("fake_data.users")
+	days := viper.GetInt("fake_data.days")
+	interval := viper.GetInt("fake_data.interval")
+	if users < 1 || days < 1 || interval < 1 {
+		return errors.New("users, days and interval must be positive values")
+	}
+	var center fakePoint
+	if _, err := fmt.Sscanf(viper.GetString("fake_data.center"), "%f,%f", &center.lat, &center.lng); err != nil {
+		return errors.New("invalid center, must be provided as 'lat,lng'")
+	}
+	seed := viper.GetInt64("fake_data.seed")
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	fw := &fakeWorld{
+		rnd:      rand.New(rand.NewSource(seed)), // nolint: gosec
+		center:   center,
+		radius:   viper.GetFloat64("fake_data.radius"),
+		interval: time.Duration(interval) * time.Minute,
+	}
+	store, err := storage.NewHandler(viper.GetString("storage"))
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+	log.WithField("seed", seed).Warning("populating storage with synthetic data")
+
+	// Venues
+	for i := 0; i < viper.GetInt("fake_data.venues"); i++ {
+		p := fw.near(fw.center, fw.radius)
+		venue, err := store.RegisterVenue(fakeDataOwner, &protov1.RegisterVenueRequest{
+			Name: fmt.Sprintf("Venue %d", i+1),
+			Lat:  float32(p.lat),
+			Lng:  float32(p.lng),
+		})
+		if err != nil {
+			return err
+		}
+		fw.venues = append(fw.venues, venue)
+	}
+
+	// Location records and check-ins
+	y, m, d := time.Now().UTC().AddDate(0, 0, -days+1).Date()
+	start := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	population := make([]*fakeUser, users)
+	total := 0
+	for i := range population {
+		population[i] = fw.newUser()
+		for day := 0; day < days; day++ {
+			records, checkIns := fw.day(population[i], start.AddDate(0, 0, day))
+			if err := store.Records().LocationRecords(records); err != nil {
+				return err
+			}
+			if len(checkIns) > 0 {
+				if err := store.Records().CheckIns(checkIns); err != nil {
+					return err
+				}
+			}
+			total += len(records)
+		}
+	}
+
+	// Diagnoses
+	positives := 0
+	rate := float64(viper.GetInt("fake_data.positives")) / 100
+	for _, u := range population {
+		result := "negative"
+		if fw.rnd.Float64() < rate {
+			result = "positive"
+			positives++
+		}
+		date := start.Add(time.Duration(fw.rnd.Int63n(int64(time.Since(start)))))
+		if _, err := store.Exposures().Diagnosis(u.did, result, "fake-data", date); err != nil {
+			return err
+		}
+	}
+	log.WithFields(xlog.Fields{
+		"users":     users,
+		"records":   total,
+		"venues":    len(fw.venues),
+		"positives": positives,
+	}).Info("synthetic data stored")
+	return nil
+}
@@ -0,0 +1,148 @@
+package cmd
+
+import (
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.bryk.io/covid-tracking/secrets"
+	"go.bryk.io/x/cli"
+)
+
+var keysCmd = &cobra.Command{
+	Use:   "keys",
+	Short: "Manage the server's signing and hash keys",
+	Long: `Signing and Hash Keys
+
+Access credentials are signed with a dedicated key and refresh codes are
+produced using a separate hash key, both stored on the server's home
+directory and independent of the root CA. Running servers pick up rotated
+keys automatically once their cached values expire.`,
+}
+
+var keysStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Display the status of the server's keys",
+	RunE:  runKeysStatus,
+}
+
+var keysRotateCmd = &cobra.Command{
+	Use:     "rotate",
+	Short:   "Replace the server's keys with newly generated values",
+	Example: "keys rotate --signing --hash",
+	RunE:    runKeysRotate,
+}
+
+func init() {
+	statusParams := []cli.Param{
+		{
+			Name:      "home",
+			Usage:     "Home directory for the server instance, by default 'server.home'",
+			FlagKey:   "keys.status.home",
+			ByDefault: "",
+		},
+	}
+	if err := cli.SetupCommandParams(keysStatusCmd, statusParams); err != nil {
+		panic(err)
+	}
+	rotateParams := []cli.Param{
+		{
+			Name:      "home",
+			Usage:     "Home directory for the server instance, by default 'server.home'",
+			FlagKey:   "keys.rotate.home",
+			ByDefault: "",
+		},
+		{
+			Name:      "signing",
+			Usage:     "Rotate the signing key used for access credentials",
+			FlagKey:   "keys.rotate.signing",
+			ByDefault: false,
+		},
+		{
+			Name:      "hash",
+			Usage:     "Rotate the hash key used for refresh codes",
+			FlagKey:   "keys.rotate.hash",
+			ByDefault: false,
+		},
+	}
+	if err := cli.SetupCommandParams(keysRotateCmd, rotateParams); err != nil {
+		panic(err)
+	}
+	keysCmd.AddCommand(keysStatusCmd)
+	keysCmd.AddCommand(keysRotateCmd)
+	rootCmd.AddCommand(keysCmd)
+}
+
+// Status of a key on the server's home directory.
+type keyStatus struct {
+	Name     string     `json:"name"`
+	Modified *time.Time `json:"modified,omitempty"`
+}
+
+type keysStatus []*keyStatus
+
+func (ks keysStatus) Table() ([]string, [][]string) {
+	var rows [][]string
+	for _, k := range ks {
+		status := "missing"
+		if k.Modified != nil {
+			status = k.Modified.Format("2006-01-02 15:04:05")
+		}
+		rows = append(rows, []string{k.Name, status})
+	}
+	return []string{"key", "modified"}, rows
+}
+
+func runKeysStatus(_ *cobra.Command, _ []string) error {
+	home := keysHome("keys.status.home")
+	var list keysStatus
+	for _, name := range []string{secrets.SigningKey, secrets.HashKey, secrets.PreviousHashKey} {
+		ks := &keyStatus{Name: name}
+		if info, err := os.Stat(secrets.HomeFile(home, name)); err == nil {
+			modified := info.ModTime().UTC()
+			ks.Modified = &modified
+		}
+		list = append(list, ks)
+	}
+	return printResult(list)
+}
+
+func runKeysRotate(_ *cobra.Command, _ []string) error {
+	var keys []string
+	if viper.GetBool("keys.rotate.signing") {
+		keys = append(keys, secrets.SigningKey)
+	}
+	if viper.GetBool("keys.rotate.hash") {
+		keys = append(keys, secrets.HashKey)
+	}
+	if len(keys) == 0 {
+		return errors.New("select the keys to rotate using the 'signing' and/or 'hash' flags")
+	}
+	home := keysHome("keys.rotate.home")
+	for _, k := range keys {
+		if err := secrets.Rotate(home, k, tokenAlgorithm()); err != nil {
+			return errors.Wrapf(err, "failed to rotate %s", k)
+		}
+		log.WithField("key", k).Info("key rotated")
+	}
+	return nil
+}
+
+// Home directory of the server instance, provided using the 'flag' key or
+// the server's settings.
+func keysHome(flag string) string {
+	if home := viper.GetString(flag); home != "" {
+		return home
+	}
+	return viper.GetString("server.home")
+}
+
+// Signing algorithm for access credentials.
+func tokenAlgorithm() string {
+	if alg := viper.GetString("server.token_algorithm"); alg != "" {
+		return alg
+	}
+	return secrets.AlgES384
+}
@@ -0,0 +1,154 @@
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.bryk.io/covid-tracking/client"
+	"go.bryk.io/covid-tracking/kiosk"
+	"go.bryk.io/x/cli"
+)
+
+var kioskCmd = &cobra.Command{
+	Use:     "kiosk",
+	Short:   "Start a local service for registration desks",
+	Example: "kiosk server.com:443 --credentials agent.json --campaign district-7",
+	RunE:    runKiosk,
+	Long: `Registration desk service
+
+Starts a small local HTTP service, using the provided agent credentials, for
+registration desks with intermittent connectivity. The service keeps a pool of
+pre-generated activation codes for the selected campaign, handed out along
+with their QR images even while offline; check-ins and lab results submitted
+to the service are saved locally and forwarded once the platform API is
+reachable.
+
+  GET  /status      service status
+  POST /code        hand out an activation code
+  POST /check_in    queue a check-in request (JSON)
+  POST /lab_result  queue a lab result request (JSON)
+
+The service doesn't authenticate its clients, it should only be exposed on
+the local host or the desk's private network.`,
+}
+
+func init() {
+	params := []cli.Param{
+		{
+			Name:      "credentials",
+			Usage:     "Agent credentials file to use",
+			FlagKey:   "kiosk.credentials",
+			ByDefault: "credentials.json",
+		},
+		{
+			Name:      "keyring",
+			Usage:     "Use credentials stored in the OS keychain/keyring",
+			FlagKey:   "kiosk.keyring",
+			ByDefault: false,
+		},
+		{
+			Name:      "profile",
+			Usage:     "Client profile to use instead of an endpoint and credentials file",
+			FlagKey:   "kiosk.profile",
+			ByDefault: "",
+		},
+		{
+			Name:      "listen",
+			Usage:     "Local address to expose the service",
+			FlagKey:   "kiosk.listen",
+			ByDefault: "127.0.0.1:8484",
+		},
+		{
+			Name:      "campaign",
+			Usage:     "Label for the registration campaign",
+			FlagKey:   "kiosk.campaign",
+			ByDefault: "",
+		},
+		{
+			Name:      "pool-size",
+			Usage:     "Number of activation codes kept available for offline use",
+			FlagKey:   "kiosk.pool_size",
+			ByDefault: 200,
+		},
+		{
+			Name:      "state",
+			Usage:     "File used to persist pending submissions and available codes",
+			FlagKey:   "kiosk.state",
+			ByDefault: "kiosk-state.json",
+		},
+		{
+			Name:      "sync",
+			Usage:     "Seconds between synchronization attempts with the platform API",
+			FlagKey:   "kiosk.sync",
+			ByDefault: 30,
+		},
+		{
+			Name:      "insecure",
+			Usage:     "Accept any certificate presented. Dangerous, for development only",
+			FlagKey:   "kiosk.insecure",
+			ByDefault: false,
+		},
+	}
+	if err := cli.SetupCommandParams(kioskCmd, params); err != nil {
+		panic(err)
+	}
+	rootCmd.AddCommand(kioskCmd)
+}
+
+func runKiosk(_ *cobra.Command, args []string) error {
+	// Renewed credentials are saved back to the store
+	cs, err := connectionSettings(args, viper.GetString("kiosk.profile"), viper.GetString("kiosk.credentials"),
+		viper.GetBool("kiosk.keyring"), viper.GetBool("kiosk.insecure"))
+	if err != nil {
+		return err
+	}
+	cl, err := client.New(cs.endpoint, cs.clientOptions()...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = cl.Close()
+	}()
+
+	// Start service
+	svc, err := kiosk.New(cl, &kiosk.Config{
+		Campaign:     viper.GetString("kiosk.campaign"),
+		PoolSize:     viper.GetInt("kiosk.pool_size"),
+		StateFile:    viper.GetString("kiosk.state"),
+		SyncInterval: time.Duration(viper.GetInt("kiosk.sync")) * time.Second,
+		Logger:       log,
+	})
+	if err != nil {
+		return err
+	}
+	srv := &http.Server{
+		Addr:              viper.GetString("kiosk.listen"),
+		Handler:           svc.Handler(),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.WithField("error", err.Error()).Error("failed to start kiosk service")
+		}
+	}()
+	log.Infof("kiosk service available at: %s", srv.Addr)
+
+	// Catch interruption signals and quit
+	<-cli.SignalsHandler([]os.Signal{
+		syscall.SIGHUP,
+		syscall.SIGINT,
+		syscall.SIGTERM,
+		syscall.SIGQUIT,
+		os.Interrupt,
+	})
+	log.Warning("closing kiosk service")
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_ = srv.Shutdown(ctx)
+	return svc.Close()
+}
@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"context"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.bryk.io/covid-tracking/storage"
+	"go.bryk.io/x/cli"
+)
+
+var ledgerCmd = &cobra.Command{
+	Use:   "ledger",
+	Short: "Inspect the tamper-evident ledger",
+	Long: `Tamper-Evident Ledger
+
+When enabled, the location records, check-ins and audit entries stored
+are registered on an append-only ledger. Workers periodically seal the
+entries on hash-chained blocks and anchor the most recent block by
+publishing it on a DID document, so the data can later be proven not
+to have been retroactively altered.`,
+}
+
+var ledgerVerifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Verify the integrity of all the blocks on the ledger",
+	RunE:  runLedgerVerify,
+}
+
+func init() {
+	params := []cli.Param{
+		{
+			Name:      "storage",
+			Usage:     "Storage component endpoint",
+			FlagKey:   "storage",
+			ByDefault: "mongodb://localhost:27017",
+		},
+	}
+	if err := cli.SetupCommandParams(ledgerCmd, params); err != nil {
+		panic(err)
+	}
+	ledgerCmd.AddCommand(ledgerVerifyCmd)
+	rootCmd.AddCommand(ledgerCmd)
+}
+
+func runLedgerVerify(_ *cobra.Command, _ []string) error {
+	store, err := storage.NewHandler(viper.GetString("storage"))
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	res := &ledgerStatus{}
+	err = store.VerifyLedger(context.Background(), func(block *storage.LedgerBlock) error {
+		res.Blocks++
+		res.Entries += block.Entries
+		res.Head = block.Hash
+		sealed := block.Sealed.UTC()
+		res.Sealed = &sealed
+		if block.Anchor != "" {
+			anchored := block.Anchored.UTC()
+			res.Anchor, res.Anchored = block.Anchor, &anchored
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return printResult(res)
+}
+
+// Result of a ledger verification.
+type ledgerStatus struct {
+	Blocks   int        `json:"blocks"`
+	Entries  int        `json:"entries"`
+	Head     string     `json:"head,omitempty"`
+	Sealed   *time.Time `json:"sealed,omitempty"`
+	Anchor   string     `json:"anchor,omitempty"`
+	Anchored *time.Time `json:"anchored,omitempty"`
+}
@@ -0,0 +1,151 @@
+package cmd
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/covid-tracking/storage"
+	"go.bryk.io/x/cli"
+)
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Manage storage schema migrations",
+	Long: `Storage Migrations
+
+Indexes and schema changes on the storage component are handled by
+versioned migrations. Migrations must be applied before starting
+new server and worker instances after an upgrade.`,
+}
+
+var migrateUpCmd = &cobra.Command{
+	Use:   "up",
+	Short: "Apply all pending migrations",
+	RunE:  runMigrateUp,
+}
+
+var migrateStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Display the status of all registered migrations",
+	RunE:  runMigrateStatus,
+}
+
+func init() {
+	params := []cli.Param{
+		{
+			Name:      "storage",
+			Usage:     "Storage component endpoint",
+			FlagKey:   "storage",
+			ByDefault: "mongodb://localhost:27017",
+		},
+	}
+	if err := cli.SetupCommandParams(migrateCmd, params); err != nil {
+		panic(err)
+	}
+	upParams := []cli.Param{
+		{
+			Name:      "maintenance",
+			Usage:     "Enable maintenance mode on all servers while applying migrations",
+			FlagKey:   "migrate.maintenance",
+			ByDefault: false,
+		},
+	}
+	if err := cli.SetupCommandParams(migrateUpCmd, upParams); err != nil {
+		panic(err)
+	}
+	migrateCmd.AddCommand(migrateUpCmd)
+	migrateCmd.AddCommand(migrateStatusCmd)
+	rootCmd.AddCommand(migrateCmd)
+}
+
+func runMigrateUp(_ *cobra.Command, _ []string) error {
+	store, err := storage.NewHandler(viper.GetString("storage"))
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	// Servers detect the maintenance mode on their next refresh
+	if viper.GetBool("migrate.maintenance") {
+		status := &protov1.MaintenanceStatus{
+			Enabled:    true,
+			RetryAfter: 60,
+			Message:    "applying storage migrations",
+		}
+		if err := store.SetMaintenance(status); err != nil {
+			return err
+		}
+		log.Info("maintenance mode enabled")
+		defer func() {
+			if err := store.SetMaintenance(&protov1.MaintenanceStatus{}); err != nil {
+				log.WithField("error", err.Error()).Error("failed to disable maintenance mode")
+				return
+			}
+			log.Info("maintenance mode disabled")
+		}()
+		time.Sleep(15 * time.Second)
+	}
+
+	applied, err := store.Migrate()
+	for _, v := range applied {
+		log.WithField("version", v).Info("migration applied")
+	}
+	if err != nil {
+		return err
+	}
+	if len(applied) == 0 {
+		log.Info("storage schema is up to date")
+	}
+	return nil
+}
+
+func runMigrateStatus(_ *cobra.Command, _ []string) error {
+	store, err := storage.NewHandler(viper.GetString("storage"))
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	list, err := store.MigrationStatus()
+	if err != nil {
+		return err
+	}
+	res := make(migrationsStatus, len(list))
+	for i, ms := range list {
+		res[i] = &migrationStatus{
+			Version:     ms.Version,
+			Description: ms.Description,
+			Applied:     ms.Applied,
+		}
+		if ms.Applied {
+			appliedAt := ms.AppliedAt.UTC()
+			res[i].AppliedAt = &appliedAt
+		}
+	}
+	return printResult(res)
+}
+
+// Status of a storage migration.
+type migrationStatus struct {
+	Version     int        `json:"version"`
+	Description string     `json:"description"`
+	Applied     bool       `json:"applied"`
+	AppliedAt   *time.Time `json:"applied_at,omitempty"`
+}
+
+type migrationsStatus []*migrationStatus
+
+func (ms migrationsStatus) Table() ([]string, [][]string) {
+	var rows [][]string
+	for _, m := range ms {
+		applied := "pending"
+		if m.AppliedAt != nil {
+			applied = m.AppliedAt.Format("2006-01-02 15:04:05")
+		}
+		rows = append(rows, []string{strconv.Itoa(m.Version), applied, m.Description})
+	}
+	return []string{"version", "applied", "description"}, rows
+}
@@ -0,0 +1,30 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/spf13/viper"
+	"go.bryk.io/covid-tracking/utils"
+)
+
+func init() {
+	rootCmd.PersistentFlags().StringP("output", "o", "",
+		"output format for command results: table, json or yaml")
+	if err := viper.BindPFlag("output", rootCmd.PersistentFlags().Lookup("output")); err != nil {
+		panic(err)
+	}
+}
+
+// Output format selected with the "output" flag, or 'fallback' if not set.
+func outputFormat(fallback string) string {
+	if format := viper.GetString("output"); format != "" {
+		return format
+	}
+	return fallback
+}
+
+// Print a command result on standard output, using the format selected with
+// the "output" flag; tables are used by default.
+func printResult(result interface{}) error {
+	return utils.Render(os.Stdout, outputFormat(utils.OutputTable), result)
+}
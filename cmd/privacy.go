@@ -0,0 +1,185 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/x/cli"
+	"google.golang.org/grpc"
+)
+
+var clientHoldCmd = &cobra.Command{
+	Use:     "legal-hold",
+	Short:   "Place or release a legal hold on the data of a user",
+	Example: "client legal-hold admin.server.com:443 --did did:bryk:... --reason \"case 2020-117\"",
+	RunE:    runClientHold,
+	Long: `Legal hold
+
+Data under a legal hold is exempted from the retention policy, and
+preserved by the workers before expired entries are removed, until
+the hold is released. Both operations are registered on the audit
+log. Requires administrator credentials and access to the admin API.`,
+}
+
+var clientSubjectCmd = &cobra.Command{
+	Use:     "subject-access",
+	Short:   "Compile all the data stored for a user",
+	Example: "client subject-access admin.server.com:443 --did did:bryk:... --reason \"SAR 42\" --file sar.json",
+	RunE:    runClientSubject,
+	Long: `Subject access request
+
+Compiles all the data stored for a user: location records, including
+archived and preserved entries, venue check-ins, test results,
+exposures, notifications and the audit log entries where the user is
+the actor. The bundle is saved as a JSON file. Every request is
+registered on the audit log. Requires administrator credentials and
+access to the admin API.`,
+}
+
+func init() {
+	holdParams := []cli.Param{
+		{
+			Name:      "credentials",
+			Usage:     "Credentials file to use",
+			FlagKey:   "client.legal_hold.credentials",
+			ByDefault: "credentials.json",
+		},
+		{
+			Name:      "did",
+			Usage:     "User identifier",
+			FlagKey:   "client.legal_hold.did",
+			ByDefault: "",
+		},
+		{
+			Name:      "reason",
+			Usage:     "Justification for the request, i.e. a court order reference",
+			FlagKey:   "client.legal_hold.reason",
+			ByDefault: "",
+		},
+		{
+			Name:      "release",
+			Usage:     "Release an existing hold instead of placing a new one",
+			FlagKey:   "client.legal_hold.release",
+			ByDefault: false,
+		},
+		{
+			Name:      "insecure",
+			Usage:     "Accept any certificate presented. Dangerous, for development only",
+			FlagKey:   "client.legal_hold.insecure",
+			ByDefault: false,
+		},
+	}
+	if err := cli.SetupCommandParams(clientHoldCmd, holdParams); err != nil {
+		panic(err)
+	}
+	subjectParams := []cli.Param{
+		{
+			Name:      "credentials",
+			Usage:     "Credentials file to use",
+			FlagKey:   "client.subject_access.credentials",
+			ByDefault: "credentials.json",
+		},
+		{
+			Name:      "did",
+			Usage:     "User identifier",
+			FlagKey:   "client.subject_access.did",
+			ByDefault: "",
+		},
+		{
+			Name:      "reason",
+			Usage:     "Justification for the request, i.e. a request reference number",
+			FlagKey:   "client.subject_access.reason",
+			ByDefault: "",
+		},
+		{
+			Name:      "file",
+			Usage:     "JSON file to save the compiled data",
+			FlagKey:   "client.subject_access.file",
+			ByDefault: "subject-access.json",
+		},
+		{
+			Name:      "insecure",
+			Usage:     "Accept any certificate presented. Dangerous, for development only",
+			FlagKey:   "client.subject_access.insecure",
+			ByDefault: false,
+		},
+	}
+	if err := cli.SetupCommandParams(clientSubjectCmd, subjectParams); err != nil {
+		panic(err)
+	}
+	clientCmd.AddCommand(clientHoldCmd)
+	clientCmd.AddCommand(clientSubjectCmd)
+}
+
+func runClientHold(_ *cobra.Command, args []string) error {
+	conn, err := adminConnection(args, viper.GetString("client.legal_hold.credentials"),
+		viper.GetBool("client.legal_hold.insecure"))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+	cl := protov1.NewAdminAPIClient(conn)
+	req := &protov1.LegalHoldRequest{
+		Did:    viper.GetString("client.legal_hold.did"),
+		Reason: viper.GetString("client.legal_hold.reason"),
+	}
+	if viper.GetBool("client.legal_hold.release") {
+		if _, err := cl.ReleaseLegalHold(context.Background(), req); err != nil {
+			return err
+		}
+		log.WithField("did", req.Did).Info("legal hold released")
+		return nil
+	}
+	if _, err := cl.PlaceLegalHold(context.Background(), req); err != nil {
+		return err
+	}
+	log.WithField("did", req.Did).Info("legal hold placed")
+	return nil
+}
+
+func runClientSubject(_ *cobra.Command, args []string) error {
+	conn, err := adminConnection(args, viper.GetString("client.subject_access.credentials"),
+		viper.GetBool("client.subject_access.insecure"))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+	res, err := protov1.NewAdminAPIClient(conn).SubjectAccess(context.Background(), &protov1.SubjectAccessRequest{
+		Did:    viper.GetString("client.subject_access.did"),
+		Reason: viper.GetString("client.subject_access.reason"),
+	})
+	if err != nil {
+		return err
+	}
+	contents, err := json.MarshalIndent(res, "", "  ")
+	if err != nil {
+		return err
+	}
+	output := filepath.Clean(viper.GetString("client.subject_access.file"))
+	if err := ioutil.WriteFile(output, contents, 0600); err != nil {
+		return err
+	}
+	log.WithField("output", output).Info("subject data saved")
+	return nil
+}
+
+// Open a connection to the admin API using the provided credentials file,
+// or the selected profile. Subject access bundles can be large, so a longer
+// timeout is used.
+func adminConnection(args []string, file string, insecure bool) (*grpc.ClientConn, error) {
+	cs, err := connectionSettings(args, viper.GetString("client.profile"), file, false, insecure)
+	if err != nil {
+		return nil, err
+	}
+	return cs.connect(5 * time.Minute)
+}
@@ -0,0 +1,354 @@
+package cmd
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.bryk.io/covid-tracking/client"
+	"go.bryk.io/covid-tracking/utils"
+	"go.bryk.io/x/cli"
+	"go.bryk.io/x/net/rpc"
+	"google.golang.org/grpc"
+)
+
+var clientProfileCmd = &cobra.Command{
+	Use:   "profile",
+	Short: "Manage the client profiles",
+	Long: `Client profiles
+
+Profiles group the server endpoint, TLS settings and access credentials
+required to use a platform deployment under a given identity, similar to
+kubeconfig contexts. Profiles are kept on a single file, by default
+"~/.ct19/profiles.json", and are selected on all client commands using the
+"profile" flag; when no endpoint or profile is provided the current profile
+is used. Renewed credentials are saved back to the profile. The file is
+encrypted when the CT19_CREDENTIALS_PASSPHRASE environment variable is set.`,
+}
+
+var clientProfileAddCmd = &cobra.Command{
+	Use:     "add",
+	Short:   "Add or replace a profile",
+	Example: "client profile add agent-bogota --endpoint bogota.ct19.example.org:443 --credentials agent.json",
+	RunE:    runClientProfileAdd,
+}
+
+var clientProfileListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the available profiles",
+	RunE:  runClientProfileList,
+}
+
+var clientProfileUseCmd = &cobra.Command{
+	Use:     "use",
+	Short:   "Set the profile used by default",
+	Example: "client profile use agent-bogota",
+	RunE:    runClientProfileUse,
+}
+
+var clientProfileRemoveCmd = &cobra.Command{
+	Use:     "remove",
+	Short:   "Remove a profile",
+	Example: "client profile remove agent-bogota",
+	RunE:    runClientProfileRemove,
+}
+
+func init() {
+	flags := clientCmd.PersistentFlags()
+	flags.String("profile", "", "client profile to use")
+	flags.String("profiles", "", "profiles file (default \"~/.ct19/profiles.json\")")
+	for key, name := range map[string]string{"client.profile": "profile", "client.profiles": "profiles"} {
+		if err := viper.BindPFlag(key, flags.Lookup(name)); err != nil {
+			panic(err)
+		}
+	}
+	addParams := []cli.Param{
+		{
+			Name:      "endpoint",
+			Usage:     "Server endpoint",
+			FlagKey:   "client.profile_add.endpoint",
+			ByDefault: "",
+		},
+		{
+			Name:      "credentials",
+			Usage:     "Credentials file to include on the profile",
+			FlagKey:   "client.profile_add.credentials",
+			ByDefault: "",
+		},
+		{
+			Name:      "ca-cert",
+			Usage:     "PEM-encoded certificate of an additional CA trusted to validate the server",
+			FlagKey:   "client.profile_add.ca_cert",
+			ByDefault: "",
+		},
+		{
+			Name:      "insecure",
+			Usage:     "Accept any certificate presented. Dangerous, for development only",
+			FlagKey:   "client.profile_add.insecure",
+			ByDefault: false,
+		},
+		{
+			Name:      "use",
+			Usage:     "Set the profile as the current one",
+			FlagKey:   "client.profile_add.use",
+			ByDefault: false,
+		},
+	}
+	if err := cli.SetupCommandParams(clientProfileAddCmd, addParams); err != nil {
+		panic(err)
+	}
+	clientProfileCmd.AddCommand(clientProfileAddCmd)
+	clientProfileCmd.AddCommand(clientProfileListCmd)
+	clientProfileCmd.AddCommand(clientProfileUseCmd)
+	clientProfileCmd.AddCommand(clientProfileRemoveCmd)
+	clientCmd.AddCommand(clientProfileCmd)
+}
+
+func runClientProfileAdd(_ *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return errors.New("you must specify the profile name")
+	}
+	pf, err := profilesFile()
+	if err != nil {
+		return err
+	}
+	profile := &client.Profile{
+		Name:     args[0],
+		Endpoint: viper.GetString("client.profile_add.endpoint"),
+		TLS:      &client.ProfileTLS{Insecure: viper.GetBool("client.profile_add.insecure")},
+	}
+	if profile.Endpoint == "" {
+		return errors.New("you must specify the server endpoint")
+	}
+	if file := viper.GetString("client.profile_add.credentials"); file != "" {
+		if profile.Credentials, err = loadCredentials(profile.Endpoint, file, false); err != nil {
+			return errors.Wrap(err, "failed to load credentials")
+		}
+	}
+	if file := viper.GetString("client.profile_add.ca_cert"); file != "" {
+		cert, err := ioutil.ReadFile(filepath.Clean(file))
+		if err != nil {
+			return errors.Wrap(err, "failed to read CA certificate")
+		}
+		profile.TLS.CACert = string(cert)
+	}
+	if err := pf.Set(profile); err != nil {
+		return err
+	}
+	if viper.GetBool("client.profile_add.use") {
+		if err := pf.Use(profile.Name); err != nil {
+			return err
+		}
+	}
+	log.WithField("profile", profile.Name).Info("profile saved")
+	return nil
+}
+
+// Profile summary, credentials are not displayed.
+type profileEntry struct {
+	Name     string `json:"name"`
+	Endpoint string `json:"endpoint"`
+	Current  bool   `json:"current"`
+	Insecure bool   `json:"insecure"`
+	CACert   bool   `json:"ca_cert"`
+	Token    bool   `json:"credentials"`
+}
+
+type profileList []*profileEntry
+
+func (pl profileList) Table() ([]string, [][]string) {
+	var rows [][]string
+	for _, p := range pl {
+		current := ""
+		if p.Current {
+			current = "*"
+		}
+		tls := "system CAs"
+		if p.CACert {
+			tls = "custom CA"
+		}
+		if p.Insecure {
+			tls = "insecure"
+		}
+		credentials := "no"
+		if p.Token {
+			credentials = "yes"
+		}
+		rows = append(rows, []string{current, p.Name, p.Endpoint, tls, credentials})
+	}
+	return []string{"current", "name", "endpoint", "tls", "credentials"}, rows
+}
+
+func runClientProfileList(_ *cobra.Command, _ []string) error {
+	pf, err := profilesFile()
+	if err != nil {
+		return err
+	}
+	profiles, current, err := pf.List()
+	if err != nil {
+		return err
+	}
+	list := profileList{}
+	for _, p := range profiles {
+		entry := &profileEntry{
+			Name:     p.Name,
+			Endpoint: p.Endpoint,
+			Current:  p.Name == current,
+			Token:    p.Credentials != nil,
+		}
+		if p.TLS != nil {
+			entry.Insecure = p.TLS.Insecure
+			entry.CACert = p.TLS.CACert != ""
+		}
+		list = append(list, entry)
+	}
+	return printResult(list)
+}
+
+func runClientProfileUse(_ *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return errors.New("you must specify the profile name")
+	}
+	pf, err := profilesFile()
+	if err != nil {
+		return err
+	}
+	if err := pf.Use(args[0]); err != nil {
+		return err
+	}
+	log.WithField("profile", args[0]).Info("current profile updated")
+	return nil
+}
+
+func runClientProfileRemove(_ *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return errors.New("you must specify the profile name")
+	}
+	pf, err := profilesFile()
+	if err != nil {
+		return err
+	}
+	if err := pf.Remove(args[0]); err != nil {
+		return err
+	}
+	log.WithField("profile", args[0]).Info("profile removed")
+	return nil
+}
+
+// Open the profiles file selected by the user. For encrypted files the
+// passphrase is read from the environment or requested interactively.
+func profilesFile() (*client.ProfilesFile, error) {
+	location := viper.GetString("client.profiles")
+	if location == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, err
+		}
+		location = filepath.Join(home, ".ct19", "profiles.json")
+	}
+	pf := &client.ProfilesFile{
+		Path:       filepath.Clean(location),
+		Passphrase: []byte(os.Getenv(passphraseEnv)),
+	}
+	_, _, err := pf.List()
+	if err != client.ErrPassphraseRequired {
+		return pf, err
+	}
+	if pf.Passphrase, err = utils.ReadSecret("Passphrase"); err != nil {
+		return nil, err
+	}
+	return pf, nil
+}
+
+// Connection settings for the client commands.
+type connSettings struct {
+	endpoint string
+	store    client.Store
+	insecure bool
+	cas      [][]byte
+}
+
+// Resolve the connection settings for a client command. When a profile is
+// selected, or no endpoint is provided, the endpoint, credentials and TLS
+// settings of the profile are used; the current profile is used if 'name'
+// is empty. An endpoint argument and the "insecure" flag still take
+// precedence. Otherwise the credentials are loaded from the provided file,
+// or the OS keyring.
+func connectionSettings(args []string, name, file string, keyring, insecure bool) (*connSettings, error) {
+	cs := &connSettings{insecure: insecure}
+	if len(args) > 0 {
+		cs.endpoint = args[0]
+	}
+	if name == "" && cs.endpoint != "" {
+		store, err := credentialsStore(cs.endpoint, file, keyring)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load credentials")
+		}
+		cs.store = store
+		return cs, nil
+	}
+
+	// Use profile
+	pf, err := profilesFile()
+	if err != nil {
+		return nil, err
+	}
+	profile, err := pf.Get(name)
+	if err == client.ErrProfileNotFound && name == "" {
+		return nil, errors.New("you must specify the server endpoint or a profile")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if cs.endpoint == "" {
+		cs.endpoint = profile.Endpoint
+	}
+	if profile.TLS != nil {
+		cs.insecure = cs.insecure || profile.TLS.Insecure
+		if profile.TLS.CACert != "" {
+			cs.cas = append(cs.cas, []byte(profile.TLS.CACert))
+		}
+	}
+	cs.store = pf.Store(profile.Name)
+	log.WithField("profile", profile.Name).Debug("using client profile")
+	return cs, nil
+}
+
+// Options for a client instance.
+func (cs *connSettings) clientOptions() []client.Option {
+	opts := []client.Option{client.WithCredentialsStore(cs.store)}
+	for _, ca := range cs.cas {
+		opts = append(opts, client.WithCACert(ca))
+	}
+	if cs.insecure {
+		log.Warning("insecure client connection")
+		opts = append(opts, client.WithInsecureSkipVerify())
+	}
+	return opts
+}
+
+// Open an RPC connection using the stored access credentials.
+func (cs *connSettings) connect(timeout time.Duration) (*grpc.ClientConn, error) {
+	credentials, err := cs.store.Load()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load credentials")
+	}
+	clOpts := []rpc.ClientOption{
+		rpc.WaitForReady(),
+		rpc.WithTimeout(timeout),
+		rpc.WithCompression(),
+		rpc.WithClientTLS(rpc.ClientTLSConfig{IncludeSystemCAs: true, CustomCAs: cs.cas}),
+		rpc.WithUserAgent("cli-client/0.1.0"),
+		rpc.WithAuthToken(credentials.AccessToken),
+	}
+	if cs.insecure {
+		log.Warning("insecure client connection")
+		clOpts = append(clOpts, rpc.WithInsecureSkipVerify())
+	}
+	log.WithField("endpoint", cs.endpoint).Debug("contacting server")
+	return rpc.NewClientConnection(cs.endpoint, clOpts...)
+}
@@ -1,12 +1,11 @@
 package cmd
 
 import (
-	"fmt"
 	"io/ioutil"
+	"os"
 	"path/filepath"
 	"strings"
 
-	"github.com/gogo/protobuf/jsonpb"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -55,6 +54,12 @@ func init() {
 			FlagKey:   "register.proof",
 			ByDefault: "",
 		},
+		{
+			Name:      "scope",
+			Usage:     "Comma-separated list of permissions granted, i.e. 'record:create'",
+			FlagKey:   "register.scope",
+			ByDefault: "",
+		},
 	}
 	if err := cli.SetupCommandParams(registerCmd, params); err != nil {
 		panic(err)
@@ -91,6 +96,13 @@ func runRegister(_ *cobra.Command, _ []string) error {
 		return err
 	}
 
+	var scope []string
+	for _, s := range strings.Split(viper.GetString("register.scope"), ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			scope = append(scope, s)
+		}
+	}
+
 	// Get service handler
 	handler, err := getServerHandler()
 	if err != nil {
@@ -104,22 +116,14 @@ func runRegister(_ *cobra.Command, _ []string) error {
 		Role:           role,
 		ActivationCode: code,
 		Proof:          proof,
+		Scope:          scope,
 	}
 	credentials, err := handler.AccessToken(req, false)
 	if err != nil {
 		return errors.Wrap(err, "get credentials")
 	}
 
-	// Save generated credentials and exit
-	m := jsonpb.Marshaler{
-		EnumsAsInts:  false,
-		EmitDefaults: true,
-		OrigName:     true,
-	}
-	output, err := m.MarshalToString(credentials)
-	if err != nil {
-		return err
-	}
-	fmt.Printf("%s", output)
-	return nil
+	// Print generated credentials and exit, the JSON output can be used
+	// directly as a credentials file
+	return utils.Render(os.Stdout, outputFormat(utils.OutputJSON), credentials)
 }
@@ -1,12 +1,22 @@
 package cmd
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"net/url"
 	"os"
+	"path/filepath"
 	"strings"
+	"time"
 
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"go.bryk.io/covid-tracking/api"
+	"go.bryk.io/covid-tracking/attestation"
+	"go.bryk.io/covid-tracking/federation"
+	"go.bryk.io/covid-tracking/secrets"
 	xlog "go.bryk.io/x/log"
 )
 
@@ -66,13 +76,96 @@ func initConfig() {
 }
 
 func getServerHandler() (*api.Server, error) {
-	// API server options
+	opts, err := serverOptions()
+	if err != nil {
+		return nil, err
+	}
+	return api.NewServer(opts)
+}
+
+// Load the API server settings from the current configuration.
+func serverOptions() (*api.ServerOptions, error) {
 	opts := &api.ServerOptions{
-		Name:   viper.GetString("server.name"),
-		Home:   viper.GetString("server.home"),
-		Store:  viper.GetString("storage"),
-		Broker: viper.GetString("broker"),
-		Logger: log,
+		Name:            viper.GetString("server.name"),
+		Home:            viper.GetString("server.home"),
+		Store:           viper.GetString("storage"),
+		Retention:       time.Duration(viper.GetInt("retention")) * 24 * time.Hour,
+		MinAnonymitySet: viper.GetInt("analytics.k"),
+		Country:         viper.GetString("server.country"),
+		TokenAlgorithm:  viper.GetString("server.token_algorithm"),
+		TaskShards:      viper.GetInt("tasks.shards"),
+		MaxMessageSize:  viper.GetInt("server.limits.max_message_size"),
+		MaxRecords:      viper.GetInt("server.limits.max_records"),
+		Ingestion:       viper.GetString("server.ingestion"),
+		ExplainPolicy:   viper.GetBool("server.explain_policy"),
+		UnsafeLogging:   viper.GetBool("server.unsafe_logging"),
+		ProofDomain:     viper.GetString("server.proof_domain"),
+		TrustedProxies:  viper.GetStringSlice("server.trusted_proxies"),
+		Dashboard:       viper.GetBool("server.dashboard"),
+		Logger:          log,
+	}
+	opts.ClockSkew = time.Duration(viper.GetInt("records.clock_skew")) * time.Second
+	opts.MaxRecordAge = time.Duration(viper.GetInt("records.max_age")) * 24 * time.Hour
+	opts.DailyRecordQuota = viper.GetInt("records.daily_quota")
+	opts.CertificateValidity = time.Duration(viper.GetInt("server.certificate_validity")) * time.Hour
+	opts.Admission = &api.AdmissionConfig{
+		BrokerLatency:  time.Duration(viper.GetInt("server.admission.broker_latency")) * time.Millisecond,
+		StorageLatency: time.Duration(viper.GetInt("server.admission.storage_latency")) * time.Millisecond,
+		MaxInFlight:    viper.GetInt("server.admission.max_inflight"),
+	}
+	opts.ExposureCheck = viper.GetString("server.exposure_check")
+	opts.Ledger = viper.GetBool("ledger.enabled")
+	opts.Sampling = &api.SamplingConfig{
+		Normal: api.SamplingMode{
+			RecordInterval: time.Duration(viper.GetInt("server.sampling.normal.record_interval")) * time.Second,
+			UploadInterval: time.Duration(viper.GetInt("server.sampling.normal.upload_interval")) * time.Second,
+			MinDistance:    uint32(viper.GetInt("server.sampling.normal.min_distance")),
+		},
+		ExposureRisk: api.SamplingMode{
+			RecordInterval: time.Duration(viper.GetInt("server.sampling.exposure_risk.record_interval")) * time.Second,
+			UploadInterval: time.Duration(viper.GetInt("server.sampling.exposure_risk.upload_interval")) * time.Second,
+			MinDistance:    uint32(viper.GetInt("server.sampling.exposure_risk.min_distance")),
+		},
+		RiskPeriod: time.Duration(viper.GetInt("server.sampling.risk_period")) * 24 * time.Hour,
+		Refresh:    time.Duration(viper.GetInt("server.sampling.refresh")) * time.Minute,
+	}
+
+	// Signing and root CA keys stored on an HSM. For security, the PIN can
+	// only be provided using the configuration file or the "CT19_SERVER_HSM_PIN"
+	// environment variable.
+	if module := viper.GetString("server.hsm.module"); module != "" {
+		opts.HSM = &api.HSMConfig{
+			Module: module,
+			Token:  viper.GetString("server.hsm.token"),
+			Key:    viper.GetString("server.hsm.key"),
+			CAKey:  viper.GetString("server.hsm.ca_key"),
+			Pin:    viper.GetString("server.hsm.pin"),
+		}
+	}
+
+	// Message broker
+	broker, brokerTLS, err := brokerSettings()
+	if err != nil {
+		return nil, err
+	}
+	opts.Broker = broker
+	opts.BrokerTLS = brokerTLS
+
+	// Secrets provider and cloud KMS signer
+	if err := setupSecrets(opts); err != nil {
+		return nil, err
+	}
+
+	// Replication with peer servers
+	repl, err := replicationConfig()
+	if err != nil {
+		return nil, err
+	}
+	opts.Replication = repl
+
+	// Device attestation
+	if err := setupAttestation(opts); err != nil {
+		return nil, err
 	}
 
 	// Get resolver settings
@@ -80,6 +173,193 @@ func getServerHandler() (*api.Server, error) {
 		return nil, err
 	}
 
-	// Prepare server handler
-	return api.NewServer(opts)
+	// Peer servers whose credentials are accepted
+	if err := viper.UnmarshalKey("trusted_issuers", &opts.TrustedIssuers); err != nil {
+		return nil, err
+	}
+
+	// Custom account roles
+	if err := viper.UnmarshalKey("roles", &opts.Roles); err != nil {
+		return nil, err
+	}
+
+	// Access conditions
+	if err := viper.UnmarshalKey("access_conditions", &opts.Conditions); err != nil {
+		return nil, err
+	}
+
+	// HTTP gateway caching
+	if err := viper.UnmarshalKey("http_cache", &opts.CacheRules); err != nil {
+		return nil, err
+	}
+
+	// Geographic restrictions
+	if viper.IsSet("geo_restriction") {
+		opts.GeoRestriction = &api.GeoRestriction{}
+		if err := viper.UnmarshalKey("geo_restriction", opts.GeoRestriction); err != nil {
+			return nil, err
+		}
+	}
+
+	return opts, nil
+}
+
+// Load the secrets provider and signing key settings, if any.
+func setupSecrets(opts *api.ServerOptions) (err error) {
+	opts.SecretsTTL = time.Duration(viper.GetInt("secrets.ttl")) * time.Minute
+	if viper.GetString("secrets.vault.address") != "" {
+		opts.Secrets, err = secrets.NewVaultProvider(&secrets.VaultConfig{
+			Address: viper.GetString("secrets.vault.address"),
+			Token:   viper.GetString("secrets.vault.token"),
+			Mount:   viper.GetString("secrets.vault.mount"),
+			Path:    viper.GetString("secrets.vault.path"),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	switch {
+	case viper.GetString("secrets.kms.aws.key_id") != "":
+		opts.Signer, err = secrets.NewAWSKMSSigner(&secrets.AWSKMSConfig{
+			KeyID:     viper.GetString("secrets.kms.aws.key_id"),
+			Region:    viper.GetString("secrets.kms.aws.region"),
+			Endpoint:  viper.GetString("secrets.kms.aws.endpoint"),
+			AccessKey: viper.GetString("secrets.kms.aws.access_key"),
+			SecretKey: viper.GetString("secrets.kms.aws.secret_key"),
+		})
+	case viper.GetString("secrets.kms.gcp.key_version") != "":
+		opts.Signer, err = secrets.NewGCPKMSSigner(&secrets.GCPKMSConfig{
+			KeyVersion: viper.GetString("secrets.kms.gcp.key_version"),
+			Token:      viper.GetString("secrets.kms.gcp.token"),
+		})
+	}
+	return err
+}
+
+// Load the device attestation settings, if any.
+func setupAttestation(opts *api.ServerOptions) error {
+	conf := &attestation.Config{}
+	if pkg := viper.GetString("attestation.android.package"); pkg != "" {
+		conf.Android = &attestation.PlayIntegrityConfig{
+			PackageName: pkg,
+			Token:       viper.GetString("attestation.android.token"),
+		}
+	}
+	if pkg := viper.GetString("attestation.safetynet.package"); pkg != "" {
+		conf.SafetyNet = &attestation.SafetyNetConfig{
+			PackageName: pkg,
+			RequireCTS:  viper.GetBool("attestation.safetynet.require_cts"),
+		}
+	}
+	if team := viper.GetString("attestation.ios.team_id"); team != "" {
+		key, err := ioutil.ReadFile(viper.GetString("attestation.ios.key_file"))
+		if err != nil {
+			return err
+		}
+		conf.Apple = &attestation.DeviceCheckConfig{
+			TeamID:      team,
+			KeyID:       viper.GetString("attestation.ios.key_id"),
+			Key:         key,
+			Development: viper.GetBool("attestation.ios.development"),
+		}
+	}
+	if conf.Android != nil || conf.SafetyNet != nil || conf.Apple != nil {
+		opts.Attestation = conf
+	}
+	return nil
+}
+
+// Load the settings to replicate diagnosed-case markers with peer servers.
+// Returns nil if replication is not enabled.
+func replicationConfig() (*api.ReplicationConfig, error) {
+	if viper.GetString("replication.key") == "" {
+		return nil, nil
+	}
+	conf := &api.ReplicationConfig{
+		Name: viper.GetString("replication.name"),
+	}
+	if conf.Name == "" {
+		conf.Name = viper.GetString("server.name")
+	}
+	key, err := ioutil.ReadFile(viper.GetString("replication.key"))
+	if err != nil {
+		return nil, err
+	}
+	if conf.Signer, err = federation.LoadPrivateKey(key); err != nil {
+		return nil, err
+	}
+	peers := []struct {
+		Name     string `mapstructure:"name"`
+		Endpoint string `mapstructure:"endpoint"`
+		Key      string `mapstructure:"key"`
+	}{}
+	if err := viper.UnmarshalKey("replication.peers", &peers); err != nil {
+		return nil, err
+	}
+	for _, p := range peers {
+		pem, err := ioutil.ReadFile(p.Key)
+		if err != nil {
+			return nil, err
+		}
+		pub, err := federation.LoadPublicKey(pem)
+		if err != nil {
+			return nil, err
+		}
+		conf.Peers = append(conf.Peers, &api.ReplicationPeer{
+			Name:      p.Name,
+			Endpoint:  p.Endpoint,
+			PublicKey: pub,
+		})
+	}
+	return conf, nil
+}
+
+// Include the broker credentials, if provided, on 'endpoint'. Used for the
+// broker connection string and the management API.
+func brokerCredentials(endpoint string) (string, error) {
+	user := viper.GetString("amqp.username")
+	if user == "" || endpoint == "" {
+		return endpoint, nil
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", err
+	}
+	u.User = url.UserPassword(user, viper.GetString("amqp.password"))
+	return u.String(), nil
+}
+
+// Load the message broker connection settings. Credentials, if provided, are
+// included on the endpoint URL; TLS settings are only returned for "amqps"
+// endpoints.
+func brokerSettings() (string, *tls.Config, error) {
+	endpoint, err := brokerCredentials(viper.GetString("broker"))
+	if err != nil {
+		return "", nil, err
+	}
+	if !strings.HasPrefix(endpoint, "amqps://") {
+		return endpoint, nil, nil
+	}
+	conf := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: viper.GetString("amqp.tls.server_name"),
+	}
+	if ca := viper.GetString("amqp.tls.ca"); ca != "" {
+		pem, err := ioutil.ReadFile(filepath.Clean(ca))
+		if err != nil {
+			return "", nil, err
+		}
+		conf.RootCAs = x509.NewCertPool()
+		if !conf.RootCAs.AppendCertsFromPEM(pem) {
+			return "", nil, errors.New("invalid broker CA certificate")
+		}
+	}
+	if cert := viper.GetString("amqp.tls.cert"); cert != "" {
+		pair, err := tls.LoadX509KeyPair(cert, viper.GetString("amqp.tls.key"))
+		if err != nil {
+			return "", nil, err
+		}
+		conf.Certificates = []tls.Certificate{pair}
+	}
+	return endpoint, conf, nil
 }
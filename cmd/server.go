@@ -1,11 +1,17 @@
 package cmd
 
 import (
+	"net"
+	"net/http"
 	"os"
+	"strings"
 	"syscall"
+	"time"
 
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"go.bryk.io/covid-tracking/api"
 	"go.bryk.io/x/cli"
 	"go.bryk.io/x/net/rpc"
 )
@@ -30,6 +36,30 @@ func init() {
 			FlagKey:   "server.port",
 			ByDefault: 9090,
 		},
+		{
+			Name:      "interface",
+			Usage:     "Network interfaces for the main RPC server, comma-separated ('all', 'local' or interface names)",
+			FlagKey:   "server.interface",
+			ByDefault: rpc.NetworkInterfaceAll,
+		},
+		{
+			Name:      "plaintext-port",
+			Usage:     "TCP port for plaintext gRPC (h2c) and HTTP/1.1 requests, behind a TLS proxy (0 to disable)",
+			FlagKey:   "server.plaintext_port",
+			ByDefault: 0,
+		},
+		{
+			Name:      "admin-port",
+			Usage:     "TCP port to use for the admin RPC server (0 to expose it on the main port)",
+			FlagKey:   "server.admin.port",
+			ByDefault: 0,
+		},
+		{
+			Name:      "admin-interface",
+			Usage:     "Network interface for the admin RPC server ('local', 'all' or an interface name)",
+			FlagKey:   "server.admin.interface",
+			ByDefault: rpc.NetworkInterfaceLocal,
+		},
 		{
 			Name:      "home",
 			Usage:     "Home directory for the server instance",
@@ -48,6 +78,108 @@ func init() {
 			FlagKey:   "broker",
 			ByDefault: "amqp://localhost:5672",
 		},
+		{
+			Name:      "retention",
+			Usage:     "Number of days to keep data on storage (0 to disable)",
+			FlagKey:   "retention",
+			ByDefault: 21,
+		},
+		{
+			Name:      "ingestion",
+			Usage:     "Record ingestion mode ('broker' to store records using the workers, or 'sync')",
+			FlagKey:   "server.ingestion",
+			ByDefault: api.IngestBroker,
+		},
+		{
+			Name:      "clock-skew",
+			Usage:     "Tolerance, in seconds, for record timestamps ahead of the current time",
+			FlagKey:   "records.clock_skew",
+			ByDefault: 60,
+		},
+		{
+			Name:      "daily-quota",
+			Usage:     "Maximum number of records accepted per DID each day (0 to disable)",
+			FlagKey:   "records.daily_quota",
+			ByDefault: 2000,
+		},
+		{
+			Name:      "analytics-k",
+			Usage:     "Minimum number of distinct users required on query results",
+			FlagKey:   "analytics.k",
+			ByDefault: 10,
+		},
+		{
+			Name:      "country",
+			Usage:     "Country of the health authority (ISO 3166-1 alpha-2), used on health certificates",
+			FlagKey:   "server.country",
+			ByDefault: "",
+		},
+		{
+			Name:      "certificate-validity",
+			Usage:     "Number of hours an issued EU Digital COVID Certificate is valid",
+			FlagKey:   "server.certificate_validity",
+			ByDefault: 72,
+		},
+		{
+			Name:      "token-algorithm",
+			Usage:     "Signing algorithm for access credentials (ES384, EdDSA)",
+			FlagKey:   "server.token_algorithm",
+			ByDefault: "ES384",
+		},
+		{
+			Name:      "proof-domain",
+			Usage:     "Domain used on the proofs of DID documents generated by the server (the server name by default)",
+			FlagKey:   "server.proof_domain",
+			ByDefault: "",
+		},
+		{
+			Name:      "explain-policy",
+			Usage:     "Log the evaluation trace of denied authorization requests (for debugging only)",
+			FlagKey:   "server.explain_policy",
+			ByDefault: false,
+		},
+		{
+			Name:      "unsafe-logging",
+			Usage:     "Log request and response payloads without redacting sensitive data (for development only)",
+			FlagKey:   "server.unsafe_logging",
+			ByDefault: false,
+		},
+		{
+			Name:      "dashboard",
+			Usage:     "Serve the web dashboard for agents at \"/dashboard/\"",
+			FlagKey:   "server.dashboard",
+			ByDefault: false,
+		},
+		{
+			Name:      "shutdown-grace",
+			Usage:     "Number of seconds to wait for requests in progress when shutting down",
+			FlagKey:   "server.shutdown_grace",
+			ByDefault: 30,
+		},
+		{
+			Name:      "hsm-module",
+			Usage:     "PKCS#11 library used to access an HSM holding the server's keys",
+			FlagKey:   "server.hsm.module",
+			ByDefault: "",
+		},
+		{
+			Name:      "hsm-token",
+			Usage:     "Label of the HSM token holding the server's keys",
+			FlagKey:   "server.hsm.token",
+			ByDefault: "",
+		},
+		{
+			Name:      "hsm-key",
+			Usage:     "Label of the server's signing key on the HSM",
+			FlagKey:   "server.hsm.key",
+			ByDefault: "",
+		},
+		{
+			Name:      "hsm-ca-key",
+			Usage:     "Label of the root CA key on the HSM",
+			FlagKey:   "server.hsm.ca_key",
+			ByDefault: "",
+		},
 	}
 	if err := cli.SetupCommandParams(serverCmd, params); err != nil {
 		panic(err)
@@ -56,66 +188,214 @@ func init() {
 }
 
 func runServer(_ *cobra.Command, _ []string) error {
-	port := viper.GetInt("server.port")
 	handler, err := getServerHandler()
 	if err != nil {
 		return err
 	}
+	stop, err := startServer(handler)
+	if err != nil {
+		return err
+	}
+
+	// Catch interruption signals and quit
+	<-cli.SignalsHandler([]os.Signal{
+		syscall.SIGHUP,
+		syscall.SIGINT,
+		syscall.SIGTERM,
+		syscall.SIGQUIT,
+		os.Interrupt,
+	})
+	log.Warning("server closed")
+	stop()
+	return nil
+}
+
+// Start the RPC servers for an API server handler. The returned function
+// stops the servers and closes the handler; new requests are rejected and
+// the ones in progress are allowed to complete during the shutdown grace
+// period.
+func startServer(handler *api.Server) (func(), error) {
+	port := viper.GetInt("server.port")
+	interfaces, err := listenInterfaces()
+	if err != nil {
+		return nil, err
+	}
+
+	// Start an RPC server on every network interface
+	var servers []*rpc.Server
+	for i, ni := range interfaces {
+		srv, err := startMainServer(handler, ni, port, i == 0)
+		if err != nil {
+			for _, s := range servers {
+				_ = s.Stop(false)
+			}
+			return nil, err
+		}
+		servers = append(servers, srv)
+		log.WithField("interface", ni).Infof("waiting for requests at port: %d", port)
+	}
+
+	// Plaintext access to the main server, on a single port
+	var plainSrv *http.Server
+	if plainPort := viper.GetInt("server.plaintext_port"); plainPort != 0 {
+		if !loopbackReachable(interfaces) {
+			return nil, errors.New("the plaintext port requires the main server to listen on the 'all' or 'local' interface")
+		}
+		plainSrv = api.NewPlaintextListener(plainPort, port)
+		go func() {
+			if err := plainSrv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				log.Error(err.Error())
+			}
+		}()
+		log.Infof("waiting for plaintext requests at port: %d", plainPort)
+	}
+
+	// Start admin server
+	var adminSrv *rpc.Server
+	if adminPort := viper.GetInt("server.admin.port"); adminPort != 0 {
+		if adminSrv, err = startAdminServer(handler, adminPort); err != nil {
+			return nil, err
+		}
+		log.Infof("waiting for admin requests at port: %d", adminPort)
+	}
+	return func() {
+		handler.Drain(time.Duration(viper.GetInt("server.shutdown_grace")) * time.Second)
+		if plainSrv != nil {
+			_ = plainSrv.Close()
+		}
+		for _, srv := range servers {
+			_ = srv.Stop(true)
+		}
+		if adminSrv != nil {
+			_ = adminSrv.Stop(true)
+		}
+		handler.Close()
+	}, nil
+}
 
+// Start the main RPC server on the network interface 'ni'. Prometheus
+// collectors can only be registered once per process, so monitoring is
+// only enabled on the first server.
+func startMainServer(handler *api.Server, ni string, port int, monitoring bool) (*rpc.Server, error) {
 	// Setup HTTP access
 	httpGw, err := handler.HTTPGateway(port)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Setup RPC server
 	srvOptions := []rpc.ServerOption{
-		rpc.WithNetworkInterface(rpc.NetworkInterfaceAll),
+		rpc.WithNetworkInterface(ni),
 		rpc.WithPort(port),
 		rpc.WithInputValidation(),
 		rpc.WithPanicRecovery(),
+		rpc.WithUnaryMiddleware(handler.Middleware()...),
+		rpc.WithStreamMiddleware(handler.StreamMiddleware()...),
 		rpc.WithService(handler.GetServiceDefinition()),
 		rpc.WithTLS(handler.TLSConfig()),
 		rpc.WithHTTPGateway(httpGw),
-		rpc.WithMonitoring(rpc.MonitoringOptions{
+	}
+	if monitoring {
+		srvOptions = append(srvOptions, rpc.WithMonitoring(rpc.MonitoringOptions{
 			IncludeHistograms:   true,
 			UseGoCollector:      true,
 			UseProcessCollector: true,
-		}),
-		rpc.WithLogger(rpc.LoggingOptions{
-			Logger: log,
-			FilterMethods: []string{
-				"bryk.covid.proto.v1.TrackingServerAPI/Ping",
-			},
-		}),
+		}))
+	}
+
+	// Replication with peer servers
+	if repl := handler.GetReplicationServiceDefinition(); repl != nil {
+		srvOptions = append(srvOptions, rpc.WithService(repl))
 	}
 
-	// Start server
+	// Admin operations are exposed on the main server unless a dedicated
+	// port is provided
+	if viper.GetInt("server.admin.port") == 0 {
+		srvOptions = append(srvOptions, rpc.WithService(handler.GetAdminServiceDefinition()))
+	}
+
+	// Start server and wait for it to be ready
 	ready := make(chan bool)
 	srv, err := rpc.NewServer(srvOptions...)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	go func() {
 		if err := srv.Start(ready); err != nil {
 			log.Error(err.Error())
 		}
 	}()
-
-	// Wait for server to be ready
 	<-ready
-	log.Infof("waiting for requests at port: %d", port)
+	return srv, nil
+}
 
-	// Catch interruption signals and quit
-	<-cli.SignalsHandler([]os.Signal{
-		syscall.SIGHUP,
-		syscall.SIGINT,
-		syscall.SIGTERM,
-		syscall.SIGQUIT,
-		os.Interrupt,
-	})
-	log.Warning("server closed")
-	handler.Close()
-	_ = srv.Stop(true)
-	return nil
+// Network interfaces for the main RPC server, provided as a list on the
+// configuration file or as a comma-separated value. All interfaces are used
+// by default.
+func listenInterfaces() ([]string, error) {
+	var list []string
+	seen := make(map[string]bool)
+	for _, v := range viper.GetStringSlice("server.interface") {
+		for _, ni := range strings.Split(v, ",") {
+			ni = strings.TrimSpace(ni)
+			if ni == "" || seen[ni] {
+				continue
+			}
+			if ni != rpc.NetworkInterfaceAll && ni != rpc.NetworkInterfaceLocal {
+				if _, err := net.InterfaceByName(ni); err != nil {
+					return nil, errors.Wrapf(err, "invalid network interface: %s", ni)
+				}
+			}
+			seen[ni] = true
+			list = append(list, ni)
+		}
+	}
+	if len(list) == 0 {
+		return []string{rpc.NetworkInterfaceAll}, nil
+	}
+	if seen[rpc.NetworkInterfaceAll] && len(list) > 1 {
+		return nil, errors.New("the 'all' network interface can't be combined with others")
+	}
+	return list, nil
+}
+
+// Whether the main RPC server is reachable on the loopback address when
+// listening on 'interfaces'.
+func loopbackReachable(interfaces []string) bool {
+	for _, ni := range interfaces {
+		if ni == rpc.NetworkInterfaceAll || ni == rpc.NetworkInterfaceLocal {
+			return true
+		}
+	}
+	return false
+}
+
+// Start a dedicated RPC server for the admin operations.
+func startAdminServer(handler *api.Server, port int) (*rpc.Server, error) {
+	httpGw, err := handler.AdminHTTPGateway(port)
+	if err != nil {
+		return nil, err
+	}
+	srv, err := rpc.NewServer([]rpc.ServerOption{
+		rpc.WithNetworkInterface(viper.GetString("server.admin.interface")),
+		rpc.WithPort(port),
+		rpc.WithInputValidation(),
+		rpc.WithPanicRecovery(),
+		rpc.WithUnaryMiddleware(handler.Middleware()...),
+		rpc.WithStreamMiddleware(handler.StreamMiddleware()...),
+		rpc.WithService(handler.GetAdminServiceDefinition()),
+		rpc.WithTLS(handler.TLSConfig()),
+		rpc.WithHTTPGateway(httpGw),
+	}...)
+	if err != nil {
+		return nil, err
+	}
+	ready := make(chan bool)
+	go func() {
+		if err := srv.Start(ready); err != nil {
+			log.Error(err.Error())
+		}
+	}()
+	<-ready
+	return srv, nil
 }
@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"os"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.bryk.io/covid-tracking/api"
+	"go.bryk.io/covid-tracking/broker/local"
+	"go.bryk.io/covid-tracking/storage/memtest"
+	"go.bryk.io/x/cli"
+)
+
+var standaloneCmd = &cobra.Command{
+	Use:   "standalone",
+	Short: "Start an API server and worker on a single process",
+	RunE:  runStandalone,
+	Long: `Standalone Mode
+
+Runs an API server and a worker on a single process, for demos,
+workshops and functional testing. Location and check-in records
+are stored synchronously by the server. Data is kept on an
+embedded in-memory store and tasks are delivered to the worker
+by an in-process broker, so no external components are required;
+all data is lost when the instance is closed.`,
+}
+
+func init() {
+	params := []cli.Param{
+		{
+			Name:      "name",
+			Usage:     "FQDN use as the main server address and identifier",
+			FlagKey:   "server.name",
+			ByDefault: "covid-tracking.test",
+		},
+		{
+			Name:      "port",
+			Usage:     "TCP port to use for the main RPC server",
+			FlagKey:   "server.port",
+			ByDefault: 9090,
+		},
+		{
+			Name:      "home",
+			Usage:     "Home directory for the server instance",
+			FlagKey:   "server.home",
+			ByDefault: "/etc/ct19",
+		},
+	}
+	if err := cli.SetupCommandParams(standaloneCmd, params); err != nil {
+		panic(err)
+	}
+	rootCmd.AddCommand(standaloneCmd)
+}
+
+func runStandalone(_ *cobra.Command, _ []string) error {
+	// Embedded store and in-process broker, shared by the server and worker
+	store := memtest.New()
+	broker := local.New(viper.GetInt("tasks.shards"))
+
+	// Records are stored by the server, the worker handles all other tasks
+	viper.Set("server.ingestion", api.IngestSync)
+	wOpts, err := workerOptions()
+	if err != nil {
+		return err
+	}
+	wOpts.Repositories = store
+	wOpts.LocalBroker = broker
+	wOpts.BrokerAdmin = ""
+	wOpts.Shards = nil
+	worker, err := api.NewWorker(wOpts)
+	if err != nil {
+		return err
+	}
+	sOpts, err := serverOptions()
+	if err != nil {
+		worker.Close()
+		return err
+	}
+	sOpts.Repositories = store
+	sOpts.LocalBroker = broker
+	handler, err := api.NewServer(sOpts)
+	if err != nil {
+		worker.Close()
+		return err
+	}
+	stop, err := startServer(handler)
+	if err != nil {
+		worker.Close()
+		return err
+	}
+
+	// Catch interruption signals and quit
+	<-cli.SignalsHandler([]os.Signal{
+		syscall.SIGHUP,
+		syscall.SIGINT,
+		syscall.SIGTERM,
+		syscall.SIGQUIT,
+		os.Interrupt,
+	})
+	log.Warning("standalone instance closed")
+	stop()
+	worker.Close()
+	return nil
+}
@@ -0,0 +1,387 @@
+package cmd
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.bryk.io/covid-tracking/certificate"
+	"go.bryk.io/covid-tracking/client"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/covid-tracking/utils"
+	"go.bryk.io/x/ccg/did"
+	"go.bryk.io/x/cli"
+)
+
+var verifyCmd = &cobra.Command{
+	Use:     "verify",
+	Short:   "Verify signed records, signatures and health credentials offline",
+	Example: "verify records.json --did-document did-cache/",
+	RunE:    runVerify,
+	Long: `Offline verification
+
+Validates signed location and check-in records, LD signature documents and
+issued health credentials without network access, i.e. for auditors and
+support staff. DID documents are not resolved, they must be available on
+the local cache provided with the "did-document" flag (a single document
+file or a directory of ".json" files) or embedded on the file verified.
+
+The file can contain:
+  - A location or check-in record, a list of records or a records request.
+  - An LD signature document, verified against the contents of the file
+    provided with the "data" flag. Use the "canonicalization" flag when the
+    signed contents are a JSON-LD document canonicalized with URDNA2015.
+  - A SMART Health Card or EU Digital COVID Certificate, verified using the
+    issuer public key provided with the "issuer-key" flag.
+  - A bundle combining any of the above:
+
+    {
+      "did_documents": [ ... ],
+      "records": [ ... ],
+      "signatures": [ {"data": "...", "proof": { ... }, "canonicalization": "URDNA2015"} ],
+      "credentials": [ "HC1:...", "shc:/..." ]
+    }
+
+The command fails if any of the items verified is invalid.`,
+}
+
+func init() {
+	params := []cli.Param{
+		{
+			Name:      "did-document",
+			Usage:     "Cached DID document, or directory with DID documents",
+			FlagKey:   "verify.did_document",
+			ByDefault: "",
+		},
+		{
+			Name:      "data",
+			Usage:     "File with the original contents for an LD signature document",
+			FlagKey:   "verify.data",
+			ByDefault: "",
+		},
+		{
+			Name:      "canonicalization",
+			Usage:     "Canonicalization used for the signed contents, 'URDNA2015' for JSON-LD documents",
+			FlagKey:   "verify.canonicalization",
+			ByDefault: "",
+		},
+		{
+			Name:      "issuer-key",
+			Usage:     "PEM-encoded public key or certificate of the health credentials issuer",
+			FlagKey:   "verify.issuer_key",
+			ByDefault: "",
+		},
+	}
+	if err := cli.SetupCommandParams(verifyCmd, params); err != nil {
+		panic(err)
+	}
+	rootCmd.AddCommand(verifyCmd)
+}
+
+// Contents of a verification bundle.
+type verifyBundle struct {
+	DIDDocuments []json.RawMessage `json:"did_documents,omitempty"`
+	Records      []json.RawMessage `json:"records,omitempty"`
+	Signatures   []*signedData     `json:"signatures,omitempty"`
+	Credentials  []string          `json:"credentials,omitempty"`
+}
+
+// LD signature document and the original contents signed. When using
+// URDNA2015 canonicalization the data is a JSON-LD document.
+type signedData struct {
+	Data             string          `json:"data"`
+	Proof            json.RawMessage `json:"proof"`
+	Canonicalization string          `json:"canonicalization,omitempty"`
+}
+
+// Verification result for a single item.
+type verifyResult struct {
+	Kind    string `json:"kind"`
+	Subject string `json:"subject"`
+	Valid   bool   `json:"valid"`
+	Details string `json:"details,omitempty"`
+}
+
+type verifyReport []*verifyResult
+
+func (vr verifyReport) Table() ([]string, [][]string) {
+	var rows [][]string
+	for _, r := range vr {
+		status := "valid"
+		if !r.Valid {
+			status = "invalid"
+		}
+		rows = append(rows, []string{r.Kind, r.Subject, status, r.Details})
+	}
+	return []string{"kind", "subject", "status", "details"}, rows
+}
+
+// Verifies the items on a bundle.
+type verifier struct {
+	ids       map[string]*did.Identifier
+	issuerKey *ecdsa.PublicKey
+	report    verifyReport
+}
+
+func runVerify(_ *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return errors.New("you must specify the file to verify")
+	}
+	contents, err := ioutil.ReadFile(filepath.Clean(args[0]))
+	if err != nil {
+		return err
+	}
+	bundle, err := parseBundle(contents)
+	if err != nil {
+		return err
+	}
+
+	// Local DID documents
+	vf := &verifier{ids: make(map[string]*did.Identifier)}
+	if location := viper.GetString("verify.did_document"); location != "" {
+		if vf.ids, err = utils.LoadDIDDocuments(location); err != nil {
+			return errors.Wrap(err, "failed to load DID documents")
+		}
+	}
+	for _, doc := range bundle.DIDDocuments {
+		id, err := parseDIDDocument(doc)
+		if err != nil {
+			return err
+		}
+		vf.ids[id.DID()] = id
+	}
+
+	// Issuer key for health credentials
+	if file := viper.GetString("verify.issuer_key"); file != "" {
+		if vf.issuerKey, err = loadIssuerKey(file); err != nil {
+			return err
+		}
+	}
+
+	// Verify contents
+	for _, r := range bundle.Records {
+		vf.record(r)
+	}
+	for _, sd := range bundle.Signatures {
+		vf.signature(sd)
+	}
+	for _, c := range bundle.Credentials {
+		vf.credential(c)
+	}
+	if len(vf.report) == 0 {
+		return errors.New("no verifiable contents found")
+	}
+	if err := printResult(vf.report); err != nil {
+		return err
+	}
+	invalid := 0
+	for _, r := range vf.report {
+		if !r.Valid {
+			invalid++
+		}
+	}
+	if invalid > 0 {
+		return fmt.Errorf("verification failed: %d of %d items are invalid", invalid, len(vf.report))
+	}
+	return nil
+}
+
+// Detect the kind of contents provided and return them as a bundle.
+func parseBundle(contents []byte) (*verifyBundle, error) {
+	contents = bytes.TrimSpace(contents)
+	bundle := &verifyBundle{}
+
+	// Health credential
+	if len(contents) > 0 && contents[0] != '{' && contents[0] != '[' {
+		bundle.Credentials = []string{string(contents)}
+		return bundle, nil
+	}
+
+	// List of records
+	if len(contents) > 0 && contents[0] == '[' {
+		if err := json.Unmarshal(contents, &bundle.Records); err != nil {
+			return nil, errors.Wrap(err, "invalid records list")
+		}
+		return bundle, nil
+	}
+
+	// JSON object
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(contents, &fields); err != nil {
+		return nil, errors.Wrap(err, "invalid file contents")
+	}
+	switch {
+	case fields["signatureValue"] != nil:
+		// LD signature document
+		file := viper.GetString("verify.data")
+		if file == "" {
+			return nil, errors.New("the signed contents are required to verify a signature document")
+		}
+		data, err := ioutil.ReadFile(filepath.Clean(file))
+		if err != nil {
+			return nil, err
+		}
+		bundle.Signatures = []*signedData{{
+			Data:             string(data),
+			Proof:            contents,
+			Canonicalization: viper.GetString("verify.canonicalization"),
+		}}
+	case fields["hash"] != nil:
+		// Single record
+		bundle.Records = []json.RawMessage{contents}
+	default:
+		// Bundle, or records request
+		if err := json.Unmarshal(contents, bundle); err != nil {
+			return nil, errors.Wrap(err, "invalid bundle")
+		}
+	}
+	return bundle, nil
+}
+
+// Verify a location or check-in record.
+func (vf *verifier) record(raw json.RawMessage) {
+	fields := map[string]json.RawMessage{}
+	_ = json.Unmarshal(raw, &fields)
+	if fields["venue"] != nil {
+		r := &protov1.CheckInRecord{}
+		if err := json.Unmarshal(raw, r); err != nil {
+			vf.add("check_in", "", errors.New("invalid record"))
+			return
+		}
+		id, err := vf.resolve(r.Did)
+		if err == nil {
+			err = client.VerifyCheckIn(id, r)
+		}
+		vf.add("check_in", r.Did, err)
+		return
+	}
+	r := &protov1.LocationRecord{}
+	if err := json.Unmarshal(raw, r); err != nil {
+		vf.add("record", "", errors.New("invalid record"))
+		return
+	}
+	id, err := vf.resolve(r.Did)
+	if err == nil {
+		err = client.VerifyRecord(id, r)
+	}
+	vf.add("record", r.Did, err)
+}
+
+// Verify an LD signature document.
+func (vf *verifier) signature(sd *signedData) {
+	signature := &did.SignatureLD{}
+	if err := json.Unmarshal(sd.Proof, signature); err != nil {
+		vf.add("signature", "", errors.New("invalid signature document"))
+		return
+	}
+	subject := strings.Split(signature.Creator, "#")[0]
+	id, err := vf.resolve(subject)
+	if err == nil {
+		switch sd.Canonicalization {
+		case "":
+			err = utils.VerifySignature(id, []byte(sd.Data), sd.Proof)
+		case utils.CanonicalURDNA2015:
+			err = utils.VerifyDocumentLD(id, []byte(sd.Data), sd.Proof)
+		default:
+			err = fmt.Errorf("unsupported canonicalization: %s", sd.Canonicalization)
+		}
+	}
+	vf.add("signature", subject, err)
+}
+
+// Verify a health credential.
+func (vf *verifier) credential(c string) {
+	if vf.issuerKey == nil {
+		vf.add("credential", "", errors.New("issuer key not provided"))
+		return
+	}
+	claims, err := certificate.Verify(c, vf.issuerKey)
+	if err != nil {
+		vf.add("credential", "", err)
+		return
+	}
+	if claims.Expired() {
+		vf.add("credential", claims.Issuer, fmt.Errorf("expired on %s", claims.Expires.Format(time.RFC3339)))
+		return
+	}
+	res := vf.add("credential", claims.Issuer, nil)
+	res.Details = fmt.Sprintf("%s issued on %s", claims.Format, claims.IssuedAt.Format(time.RFC3339))
+}
+
+// Get a DID instance from the local documents.
+func (vf *verifier) resolve(id string) (*did.Identifier, error) {
+	if instance, ok := vf.ids[id]; ok {
+		return instance, nil
+	}
+	return nil, errors.New("DID document not available")
+}
+
+// Register the verification result for an item.
+func (vf *verifier) add(kind, subject string, err error) *verifyResult {
+	res := &verifyResult{Kind: kind, Subject: subject, Valid: err == nil}
+	if err != nil {
+		res.Details = err.Error()
+	}
+	vf.report = append(vf.report, res)
+	return res
+}
+
+func parseDIDDocument(raw json.RawMessage) (*did.Identifier, error) {
+	doc := &did.Document{}
+	if err := json.Unmarshal(raw, doc); err != nil {
+		return nil, errors.Wrap(err, "invalid DID document")
+	}
+	id, err := did.FromDocument(doc)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid DID document")
+	}
+	return id, nil
+}
+
+// Load the public key of a health credentials issuer. The key can be
+// provided as a public key, certificate or the issuer's private key.
+func loadIssuerKey(file string) (*ecdsa.PublicKey, error) {
+	contents, err := ioutil.ReadFile(filepath.Clean(file))
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(contents)
+	if block == nil {
+		return nil, errors.New("invalid issuer key")
+	}
+	var pub interface{}
+	switch block.Type {
+	case "PUBLIC KEY":
+		pub, err = x509.ParsePKIXPublicKey(block.Bytes)
+	case "CERTIFICATE":
+		var cert *x509.Certificate
+		if cert, err = x509.ParseCertificate(block.Bytes); err == nil {
+			pub = cert.PublicKey
+		}
+	case "EC PRIVATE KEY":
+		var key *ecdsa.PrivateKey
+		if key, err = x509.ParseECPrivateKey(block.Bytes); err == nil {
+			pub = &key.PublicKey
+		}
+	default:
+		return nil, fmt.Errorf("unsupported issuer key type: %s", block.Type)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid issuer key")
+	}
+	key, ok := pub.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, errors.New("issuer key must be an ECDSA key")
+	}
+	return key, nil
+}
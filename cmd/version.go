@@ -22,23 +22,21 @@ var versionCmd = &cobra.Command{
 	Use:     "version",
 	Aliases: []string{"info"},
 	Short:   "Show version information",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		var components = map[string]string{
-			"Version":    coreVersion,
-			"Build code": buildCode,
-			"OS/Arch":    fmt.Sprintf("%s/%s", runtime.GOOS, runtime.GOARCH),
-			"Go version": runtime.Version(),
-			"Home":       "https://github.com/bryk-io/covid-tracking",
+			"version":    coreVersion,
+			"build_code": buildCode,
+			"os_arch":    fmt.Sprintf("%s/%s", runtime.GOOS, runtime.GOARCH),
+			"go_version": runtime.Version(),
+			"home":       "https://github.com/bryk-io/covid-tracking",
 		}
 		if buildTimestamp != "" {
 			st, err := strconv.ParseInt(buildTimestamp, 10, 64)
 			if err == nil {
-				components["Release Date"] = time.Unix(st, 0).Format(time.RFC822)
+				components["release_date"] = time.Unix(st, 0).Format(time.RFC822)
 			}
 		}
-		for k, v := range components {
-			fmt.Printf("\033[21;37m%-13s:\033[0m %s\n", k, v)
-		}
+		return printResult(components)
 	},
 }
 
@@ -1,12 +1,21 @@
 package cmd
 
 import (
+	"crypto"
+	"fmt"
+	"io/ioutil"
+	"net/http"
 	"os"
+	"strings"
 	"syscall"
+	"time"
 
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"go.bryk.io/covid-tracking/api"
+	"go.bryk.io/covid-tracking/export"
+	"go.bryk.io/covid-tracking/federation"
 	"go.bryk.io/x/cli"
 )
 
@@ -35,6 +44,90 @@ func init() {
 			FlagKey:   "broker",
 			ByDefault: "amqp://localhost:5672",
 		},
+		{
+			Name:      "retention",
+			Usage:     "Number of days to keep data on storage (0 to disable)",
+			FlagKey:   "retention",
+			ByDefault: 21,
+		},
+		{
+			Name:      "clock-skew",
+			Usage:     "Tolerance, in seconds, for record timestamps ahead of the current time",
+			FlagKey:   "records.clock_skew",
+			ByDefault: 60,
+		},
+		{
+			Name:      "daily-quota",
+			Usage:     "Maximum number of records accepted per DID each day (0 to disable)",
+			FlagKey:   "records.daily_quota",
+			ByDefault: 2000,
+		},
+		{
+			Name:      "max-record-age",
+			Usage:     "Reject records older than this number of days (0 to use the retention period)",
+			FlagKey:   "records.max_age",
+			ByDefault: 0,
+		},
+		{
+			Name:      "analytics-precision",
+			Usage:     "Geohash precision used for analytics aggregates (0 to disable)",
+			FlagKey:   "analytics.precision",
+			ByDefault: 6,
+		},
+		{
+			Name:      "analytics-k",
+			Usage:     "Minimum number of distinct users required on analytics aggregates",
+			FlagKey:   "analytics.k",
+			ByDefault: 10,
+		},
+		{
+			Name:      "archive-after",
+			Usage:     "Number of days to keep location records on the main storage (0 to disable archival)",
+			FlagKey:   "archive.after",
+			ByDefault: 0,
+		},
+		{
+			Name:      "archive-discard",
+			Usage:     "Delete old location records instead of moving them to cold storage",
+			FlagKey:   "archive.discard",
+			ByDefault: false,
+		},
+		{
+			Name:      "federation-endpoint",
+			Usage:     "Federation gateway endpoint (empty to disable federation)",
+			FlagKey:   "federation.endpoint",
+			ByDefault: "",
+		},
+		{
+			Name:      "federation-country",
+			Usage:     "Country of origin reported to the federation gateway (ISO 3166-1 alpha-2)",
+			FlagKey:   "federation.country",
+			ByDefault: "",
+		},
+		{
+			Name:      "federation-key",
+			Usage:     "PEM-encoded private key used to sign federation batches",
+			FlagKey:   "federation.key",
+			ByDefault: "",
+		},
+		{
+			Name:      "export-format",
+			Usage:     "Format for exported analytics files, 'csv' or 'parquet'",
+			FlagKey:   "export.format",
+			ByDefault: "csv",
+		},
+		{
+			Name:      "export-dir",
+			Usage:     "Local directory to store exported analytics files",
+			FlagKey:   "export.dir",
+			ByDefault: "",
+		},
+		{
+			Name:      "metrics-port",
+			Usage:     "TCP port to expose the worker metrics (0 to disable)",
+			FlagKey:   "metrics.port",
+			ByDefault: 0,
+		},
 	}
 	if err := cli.SetupCommandParams(workerCmd, params); err != nil {
 		panic(err)
@@ -43,20 +136,15 @@ func init() {
 }
 
 func runWorker(_ *cobra.Command, _ []string) error {
-	// Get worker settings
-	opts := &api.WorkerOptions{
-		Store:  viper.GetString("storage"),
-		Broker: viper.GetString("broker"),
-		Logger: log,
-	}
-	if err := viper.UnmarshalKey("resolver", &opts.Providers); err != nil {
+	worker, err := getWorker()
+	if err != nil {
 		return err
 	}
 
-	// Create new worker instance
-	worker, err := api.NewWorker(opts)
-	if err != nil {
-		return nil
+	// Expose metrics
+	if port := viper.GetInt("metrics.port"); port != 0 {
+		go serveMetrics(port)
+		log.Infof("metrics available at port: %d", port)
 	}
 
 	// Catch interruption signals and quit
@@ -71,3 +159,163 @@ func runWorker(_ *cobra.Command, _ []string) error {
 	worker.Close()
 	return nil
 }
+
+// Create a worker instance using the current configuration.
+func getWorker() (*api.Worker, error) {
+	opts, err := workerOptions()
+	if err != nil {
+		return nil, err
+	}
+	return api.NewWorker(opts)
+}
+
+// Load the worker settings from the current configuration.
+func workerOptions() (*api.WorkerOptions, error) {
+	opts := &api.WorkerOptions{
+		Store:              viper.GetString("storage"),
+		ArchiveAfter:       time.Duration(viper.GetInt("archive.after")) * 24 * time.Hour,
+		ArchiveDiscard:     viper.GetBool("archive.discard"),
+		Retention:          time.Duration(viper.GetInt("retention")) * 24 * time.Hour,
+		ClockSkew:          time.Duration(viper.GetInt("records.clock_skew")) * time.Second,
+		MaxRecordAge:       time.Duration(viper.GetInt("records.max_age")) * 24 * time.Hour,
+		DailyRecordQuota:   viper.GetInt("records.daily_quota"),
+		AnalyticsPrecision: viper.GetInt("analytics.precision"),
+		MinAnonymitySet:    viper.GetInt("analytics.k"),
+		Schedule:           viper.GetStringMapString("scheduler.jobs"),
+		Certificates:       viper.GetStringSlice("scheduler.certificates"),
+		TaskShards:         viper.GetInt("tasks.shards"),
+		Shards:             viper.GetIntSlice("tasks.consume"),
+		RecordValidators:   viper.GetInt("tasks.validators"),
+		Logger:             log,
+	}
+	broker, brokerTLS, err := brokerSettings()
+	if err != nil {
+		return nil, err
+	}
+	opts.Broker = broker
+	opts.BrokerTLS = brokerTLS
+	if opts.BrokerAdmin, err = brokerCredentials(viper.GetString("amqp.management")); err != nil {
+		return nil, err
+	}
+	if err := viper.UnmarshalKey("resolver", &opts.Providers); err != nil {
+		return nil, err
+	}
+	if err := viper.UnmarshalKey("publish", &opts.PublishNetworks); err != nil {
+		return nil, err
+	}
+	if viper.GetString("federation.endpoint") != "" {
+		conf, err := federationConfig()
+		if err != nil {
+			return nil, err
+		}
+		opts.Federation = conf
+	}
+	repl, err := replicationConfig()
+	if err != nil {
+		return nil, err
+	}
+	opts.Replication = repl
+	exp, err := analyticsExporter()
+	if err != nil {
+		return nil, err
+	}
+	opts.Exporter = exp
+	if opts.Ledger, err = ledgerConfig(); err != nil {
+		return nil, err
+	}
+
+	return opts, nil
+}
+
+// Expose the worker metrics on the "/metrics" endpoint.
+func serveMetrics(port int) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	srv := &http.Server{
+		Addr:              fmt.Sprintf(":%d", port),
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	if err := srv.ListenAndServe(); err != nil {
+		log.WithField("error", err.Error()).Error("failed to start metrics server")
+	}
+}
+
+// Load federation settings. Trusted peers are provided as a map of
+// country codes to PEM-encoded public keys or certificates.
+func federationConfig() (*federation.Config, error) {
+	conf := &federation.Config{
+		Endpoint: viper.GetString("federation.endpoint"),
+		Country:  viper.GetString("federation.country"),
+		Peers:    make(map[string]crypto.PublicKey),
+	}
+	key, err := ioutil.ReadFile(viper.GetString("federation.key"))
+	if err != nil {
+		return nil, err
+	}
+	if conf.Signer, err = federation.LoadPrivateKey(key); err != nil {
+		return nil, err
+	}
+	for country, file := range viper.GetStringMapString("federation.peers") {
+		pem, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		pub, err := federation.LoadPublicKey(pem)
+		if err != nil {
+			return nil, err
+		}
+		conf.Peers[strings.ToUpper(country)] = pub
+	}
+	return conf, nil
+}
+
+// Load the tamper-evident ledger settings. The anchor DID is provided as a
+// document file including its private keys.
+func ledgerConfig() (*api.LedgerConfig, error) {
+	if !viper.GetBool("ledger.enabled") {
+		return nil, nil
+	}
+	conf := &api.LedgerConfig{
+		AnchorInterval: time.Duration(viper.GetInt("ledger.anchor.interval")) * time.Hour,
+		Difficulty:     uint(viper.GetInt("ledger.anchor.difficulty")),
+	}
+	if file := viper.GetString("ledger.anchor.did"); file != "" {
+		contents, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		if conf.Anchor, err = parseDIDDocument(contents); err != nil {
+			return nil, err
+		}
+	}
+	return conf, nil
+}
+
+// Setup the analytics exporter. Files are stored on a local directory or on
+// an S3-compatible bucket, if neither is configured exports are disabled.
+func analyticsExporter() (*export.Exporter, error) {
+	var (
+		sink export.Sink
+		err  error
+	)
+	switch {
+	case viper.GetString("export.s3.bucket") != "":
+		sink, err = export.NewS3Sink(&export.S3Config{
+			Endpoint:  viper.GetString("export.s3.endpoint"),
+			Region:    viper.GetString("export.s3.region"),
+			Bucket:    viper.GetString("export.s3.bucket"),
+			Prefix:    viper.GetString("export.s3.prefix"),
+			AccessKey: viper.GetString("export.s3.access_key"),
+			SecretKey: viper.GetString("export.s3.secret_key"),
+		})
+	case viper.GetString("export.dir") != "":
+		sink, err = export.NewDirSink(viper.GetString("export.dir"))
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return export.NewExporter(export.Format(viper.GetString("export.format")), sink)
+}
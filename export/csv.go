@@ -0,0 +1,38 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+)
+
+// WriteCSV encodes the table as CSV, including a header row with the
+// column names.
+func WriteCSV(w io.Writer, t *Table) error {
+	cw := csv.NewWriter(w)
+	header := make([]string, len(t.Columns))
+	for i, c := range t.Columns {
+		header[i] = c.Name
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	record := make([]string, len(t.Columns))
+	for _, row := range t.Rows {
+		for i, v := range row {
+			switch val := v.(type) {
+			case int64:
+				record[i] = strconv.FormatInt(val, 10)
+			case float64:
+				record[i] = strconv.FormatFloat(val, 'f', -1, 64)
+			case string:
+				record[i] = val
+			}
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,10 @@
+/*
+Package export produces files with anonymized analytics aggregates, suitable
+for ingestion into data warehouses, and delivers them to a local directory or
+an S3-compatible object storage service.
+
+Supported file formats are CSV and Apache Parquet. Parquet files are written
+using a single row group, PLAIN encoding and no compression, which keeps the
+implementation dependency-free while remaining readable by standard tools.
+*/
+package export
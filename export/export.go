@@ -0,0 +1,57 @@
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+)
+
+// Format of the exported files.
+type Format string
+
+const (
+	// CSV files, including a header row.
+	CSV Format = "csv"
+
+	// Apache Parquet files.
+	Parquet Format = "parquet"
+)
+
+// Exporter instances encode tables and deliver them to a sink.
+type Exporter struct {
+	format Format
+	sink   Sink
+}
+
+// NewExporter returns a new exporter instance producing files of the
+// provided format.
+func NewExporter(format Format, sink Sink) (*Exporter, error) {
+	if format != CSV && format != Parquet {
+		return nil, fmt.Errorf("unsupported format: %s", format)
+	}
+	return &Exporter{format: format, sink: sink}, nil
+}
+
+// Export encodes and stores the provided tables. Files are named using
+// the table name and the date of the exported period, for example:
+// "hotspots_2020-05-01.parquet".
+func (e *Exporter) Export(date time.Time, tables ...*Table) error {
+	for _, t := range tables {
+		buf := new(bytes.Buffer)
+		var err error
+		switch e.format {
+		case CSV:
+			err = WriteCSV(buf, t)
+		case Parquet:
+			err = WriteParquet(buf, t)
+		}
+		if err != nil {
+			return err
+		}
+		name := fmt.Sprintf("%s_%s.%s", t.Name, date.UTC().Format("2006-01-02"), e.format)
+		if err := e.sink.Put(name, buf.Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
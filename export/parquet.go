@@ -0,0 +1,141 @@
+package export
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+)
+
+const parquetMagic = "PAR1"
+
+// Parquet physical types.
+const (
+	parquetInt64     = 2
+	parquetDouble    = 5
+	parquetByteArray = 6
+)
+
+// Parquet enumerations used.
+const (
+	parquetRequired     = 0 // field repetition type
+	parquetUTF8         = 0 // converted type
+	parquetPlain        = 0 // encoding
+	parquetRLE          = 3 // encoding
+	parquetUncompressed = 0 // compression codec
+	parquetDataPage     = 0 // page type
+)
+
+// WriteParquet encodes the table as an Apache Parquet file. All columns are
+// required and stored as a single data page on a single row group.
+func WriteParquet(w io.Writer, t *Table) error {
+	out := new(bytes.Buffer)
+	out.WriteString(parquetMagic)
+
+	// Column chunks
+	offsets := make([]int64, len(t.Columns))
+	sizes := make([]int64, len(t.Columns))
+	var total int64
+	for i, c := range t.Columns {
+		data := columnData(t, i, c.Type)
+		header := newThriftWriter()
+		header.i32(1, parquetDataPage)
+		header.i32(2, int32(len(data)))
+		header.i32(3, int32(len(data)))
+		header.structBegin(5)
+		header.i32(1, int32(len(t.Rows)))
+		header.i32(2, parquetPlain)
+		header.i32(3, parquetRLE)
+		header.i32(4, parquetRLE)
+		header.structEnd()
+		header.structEnd()
+
+		offsets[i] = int64(out.Len())
+		out.Write(header.Bytes())
+		out.Write(data)
+		sizes[i] = int64(out.Len()) - offsets[i]
+		total += sizes[i]
+	}
+
+	// File metadata
+	meta := newThriftWriter()
+	meta.i32(1, 1) // version
+	meta.listHeader(2, thriftStruct, len(t.Columns)+1)
+	meta.structBegin(0)
+	meta.binary(4, "schema")
+	meta.i32(5, int32(len(t.Columns)))
+	meta.structEnd()
+	for _, c := range t.Columns {
+		meta.structBegin(0)
+		meta.i32(1, physicalType(c.Type))
+		meta.i32(3, parquetRequired)
+		meta.binary(4, c.Name)
+		if c.Type == String {
+			meta.i32(6, parquetUTF8)
+		}
+		meta.structEnd()
+	}
+	meta.i64(3, int64(len(t.Rows)))
+	meta.listHeader(4, thriftStruct, 1)
+	meta.structBegin(0)
+	meta.listHeader(1, thriftStruct, len(t.Columns))
+	for i, c := range t.Columns {
+		meta.structBegin(0)
+		meta.i64(2, offsets[i])
+		meta.structBegin(3)
+		meta.i32(1, physicalType(c.Type))
+		meta.listHeader(2, thriftI32, 2)
+		meta.varint(uint64(zigzag(parquetPlain)))
+		meta.varint(uint64(zigzag(parquetRLE)))
+		meta.listHeader(3, thriftBinary, 1)
+		meta.rawBinary(c.Name)
+		meta.i32(4, parquetUncompressed)
+		meta.i64(5, int64(len(t.Rows)))
+		meta.i64(6, sizes[i])
+		meta.i64(7, sizes[i])
+		meta.i64(9, offsets[i])
+		meta.structEnd()
+		meta.structEnd()
+	}
+	meta.i64(2, total)
+	meta.i64(3, int64(len(t.Rows)))
+	meta.structEnd()
+	meta.binary(6, "ct19")
+	meta.structEnd()
+
+	// Footer
+	out.Write(meta.Bytes())
+	_ = binary.Write(out, binary.LittleEndian, uint32(len(meta.Bytes())))
+	out.WriteString(parquetMagic)
+	_, err := w.Write(out.Bytes())
+	return err
+}
+
+// PLAIN encoded values for a column.
+func columnData(t *Table, col int, kind ColumnType) []byte {
+	buf := new(bytes.Buffer)
+	for _, row := range t.Rows {
+		switch kind {
+		case Int64:
+			_ = binary.Write(buf, binary.LittleEndian, row[col].(int64))
+		case Double:
+			_ = binary.Write(buf, binary.LittleEndian, math.Float64bits(row[col].(float64)))
+		case String:
+			v := row[col].(string)
+			_ = binary.Write(buf, binary.LittleEndian, uint32(len(v)))
+			buf.WriteString(v)
+		}
+	}
+	return buf.Bytes()
+}
+
+func physicalType(kind ColumnType) int32 {
+	switch kind {
+	case Double:
+		return parquetDouble
+	case String:
+		return parquetByteArray
+	default:
+		return parquetInt64
+	}
+}
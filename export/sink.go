@@ -0,0 +1,106 @@
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"go.bryk.io/covid-tracking/utils"
+)
+
+// Sink represents a destination for exported files.
+type Sink interface {
+	// Put stores a file with the provided name and contents.
+	Put(name string, data []byte) error
+}
+
+// S3Config provides the settings required to deliver files to an
+// S3-compatible object storage service.
+type S3Config struct {
+	// Service endpoint, i.e. "https://s3.us-east-1.amazonaws.com".
+	Endpoint string
+
+	// Region used to sign requests.
+	Region string
+
+	// Bucket name.
+	Bucket string
+
+	// Key prefix for all stored objects.
+	Prefix string
+
+	// Access credentials.
+	AccessKey string
+	SecretKey string
+}
+
+// NewDirSink returns a sink storing files on a local directory. The
+// directory is created if it doesn't exists.
+func NewDirSink(dir string) (Sink, error) {
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return nil, err
+	}
+	return &dirSink{dir: dir}, nil
+}
+
+// NewS3Sink returns a sink storing files on an S3-compatible bucket.
+// Objects are stored using path-style requests.
+func NewS3Sink(conf *S3Config) (Sink, error) {
+	if conf.Endpoint == "" || conf.Bucket == "" {
+		return nil, fmt.Errorf("endpoint and bucket are required")
+	}
+	if conf.Region == "" {
+		conf.Region = "us-east-1"
+	}
+	return &s3Sink{
+		conf: conf,
+		hc:   &http.Client{Timeout: 5 * time.Minute},
+	}, nil
+}
+
+type dirSink struct {
+	dir string
+}
+
+func (ds *dirSink) Put(name string, data []byte) error {
+	return ioutil.WriteFile(filepath.Join(ds.dir, filepath.Base(name)), data, 0640)
+}
+
+type s3Sink struct {
+	conf *S3Config
+	hc   *http.Client
+}
+
+func (ss *s3Sink) Put(name string, data []byte) error {
+	key := strings.TrimPrefix(fmt.Sprintf("%s/%s", strings.Trim(ss.conf.Prefix, "/"), name), "/")
+	target := fmt.Sprintf("%s/%s/%s", strings.TrimSuffix(ss.conf.Endpoint, "/"), ss.conf.Bucket, key)
+	req, err := http.NewRequest(http.MethodPut, target, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	signV4(req, data, ss.conf, time.Now())
+	res, err := ss.hc.Do(req)
+	if err != nil {
+		return err
+	}
+	_ = res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("upload failed with status: %d", res.StatusCode)
+	}
+	return nil
+}
+
+// Add an AWS Signature Version 4 "Authorization" header to the request.
+func signV4(req *http.Request, body []byte, conf *S3Config, ts time.Time) {
+	creds := utils.AWSCredentials{
+		AccessKey: conf.AccessKey,
+		SecretKey: conf.SecretKey,
+		Region:    conf.Region,
+	}
+	utils.SignAWSRequest(req, body, creds, "s3", ts)
+}
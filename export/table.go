@@ -0,0 +1,95 @@
+package export
+
+import (
+	"fmt"
+
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+)
+
+// ColumnType identifies the kind of values stored on a column.
+type ColumnType int
+
+const (
+	// Int64 values.
+	Int64 ColumnType = iota
+
+	// Double precision floating point values.
+	Double
+
+	// UTF-8 string values.
+	String
+)
+
+// Column definition.
+type Column struct {
+	Name string
+	Type ColumnType
+}
+
+// Table is a named set of rows sharing the same columns.
+type Table struct {
+	Name    string
+	Columns []Column
+	Rows    [][]interface{}
+}
+
+// Append a new row to the table. Values must be provided in the same order
+// as the table columns.
+func (t *Table) Append(values ...interface{}) error {
+	if len(values) != len(t.Columns) {
+		return fmt.Errorf("expected %d values, got %d", len(t.Columns), len(values))
+	}
+	for i, c := range t.Columns {
+		var ok bool
+		switch c.Type {
+		case Int64:
+			_, ok = values[i].(int64)
+		case Double:
+			_, ok = values[i].(float64)
+		case String:
+			_, ok = values[i].(string)
+		}
+		if !ok {
+			return fmt.Errorf("invalid value for column '%s': %v", c.Name, values[i])
+		}
+	}
+	t.Rows = append(t.Rows, values)
+	return nil
+}
+
+// Hotspots returns a table containing the provided aggregates.
+func Hotspots(list []*protov1.Hotspot) *Table {
+	t := &Table{
+		Name: "hotspots",
+		Columns: []Column{
+			{Name: "cell", Type: String},
+			{Name: "lat", Type: Double},
+			{Name: "lng", Type: Double},
+			{Name: "users", Type: Int64},
+			{Name: "from", Type: Int64},
+			{Name: "to", Type: Int64},
+		},
+	}
+	for _, h := range list {
+		_ = t.Append(h.Cell, float64(h.Lat), float64(h.Lng), h.Users, h.From, h.To)
+	}
+	return t
+}
+
+// Flows returns a table containing the provided aggregates.
+func Flows(list []*protov1.Flow) *Table {
+	t := &Table{
+		Name: "flows",
+		Columns: []Column{
+			{Name: "origin", Type: String},
+			{Name: "destination", Type: String},
+			{Name: "users", Type: Int64},
+			{Name: "from", Type: Int64},
+			{Name: "to", Type: Int64},
+		},
+	}
+	for _, f := range list {
+		_ = t.Append(f.Origin, f.Destination, f.Users, f.From, f.To)
+	}
+	return t
+}
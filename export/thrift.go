@@ -0,0 +1,95 @@
+package export
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// Minimal Thrift compact protocol encoder, covering only the types required
+// to produce Parquet metadata structures.
+
+// Compact protocol field types.
+const (
+	thriftI32    = 5
+	thriftI64    = 6
+	thriftBinary = 8
+	thriftList   = 9
+	thriftStruct = 12
+)
+
+type thriftWriter struct {
+	buf  *bytes.Buffer
+	last []int16 // last field id written, per nested struct
+}
+
+func newThriftWriter() *thriftWriter {
+	return &thriftWriter{buf: new(bytes.Buffer), last: []int16{0}}
+}
+
+func (tw *thriftWriter) Bytes() []byte {
+	return tw.buf.Bytes()
+}
+
+func (tw *thriftWriter) fieldHeader(id int16, kind byte) {
+	last := tw.last[len(tw.last)-1]
+	if delta := id - last; delta > 0 && delta <= 15 {
+		tw.buf.WriteByte(byte(delta)<<4 | kind)
+	} else {
+		tw.buf.WriteByte(kind)
+		tw.varint(uint64(zigzag(int64(id))))
+	}
+	tw.last[len(tw.last)-1] = id
+}
+
+func (tw *thriftWriter) varint(v uint64) {
+	b := make([]byte, binary.MaxVarintLen64)
+	tw.buf.Write(b[:binary.PutUvarint(b, v)])
+}
+
+func (tw *thriftWriter) i32(id int16, v int32) {
+	tw.fieldHeader(id, thriftI32)
+	tw.varint(uint64(zigzag(int64(v))))
+}
+
+func (tw *thriftWriter) i64(id int16, v int64) {
+	tw.fieldHeader(id, thriftI64)
+	tw.varint(uint64(zigzag(v)))
+}
+
+func (tw *thriftWriter) binary(id int16, v string) {
+	tw.fieldHeader(id, thriftBinary)
+	tw.rawBinary(v)
+}
+
+func (tw *thriftWriter) rawBinary(v string) {
+	tw.varint(uint64(len(v)))
+	tw.buf.WriteString(v)
+}
+
+func (tw *thriftWriter) listHeader(id int16, kind byte, size int) {
+	tw.fieldHeader(id, thriftList)
+	if size < 15 {
+		tw.buf.WriteByte(byte(size)<<4 | kind)
+		return
+	}
+	tw.buf.WriteByte(0xf0 | kind)
+	tw.varint(uint64(size))
+}
+
+// Start a struct value; if 'id' is zero the struct is written as a list
+// element, without a field header.
+func (tw *thriftWriter) structBegin(id int16) {
+	if id != 0 {
+		tw.fieldHeader(id, thriftStruct)
+	}
+	tw.last = append(tw.last, 0)
+}
+
+func (tw *thriftWriter) structEnd() {
+	tw.buf.WriteByte(0) // stop field
+	tw.last = tw.last[:len(tw.last)-1]
+}
+
+func zigzag(v int64) int64 {
+	return (v << 1) ^ (v >> 63)
+}
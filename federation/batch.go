@@ -0,0 +1,137 @@
+package federation
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+)
+
+// Key represents the presence of a diagnosed case in a geohash cell during
+// a specific time bucket.
+type Key struct {
+	// Geohash cell identifier.
+	Cell string `json:"cell"`
+
+	// Time bucket.
+	Bucket int64 `json:"bucket"`
+
+	// Country of origin, as an ISO 3166-1 alpha-2 code.
+	Origin string `json:"origin"`
+}
+
+// Batch of keys exchanged with the federation gateway.
+type Batch struct {
+	// Batch identifier assigned by the gateway.
+	Tag string `json:"tag,omitempty"`
+
+	// Country of origin, as an ISO 3166-1 alpha-2 code.
+	Origin string `json:"origin"`
+
+	// Keys included in the batch.
+	Keys []Key `json:"keys"`
+
+	// Signature generated by the origin over the batch contents.
+	Signature []byte `json:"signature,omitempty"`
+}
+
+// Digest returns a deterministic hash value of the batch contents. Keys are
+// sorted before calculating the digest so their order is irrelevant.
+func (b *Batch) Digest() []byte {
+	entries := make([]string, len(b.Keys))
+	for i, k := range b.Keys {
+		entries[i] = fmt.Sprintf("%s|%s|%d", k.Origin, k.Cell, k.Bucket)
+	}
+	sort.Strings(entries)
+	h := sha256.Sum256([]byte(b.Origin + "\n" + strings.Join(entries, "\n")))
+	return h[:]
+}
+
+// Sign the batch contents.
+func (b *Batch) Sign(signer crypto.Signer) (err error) {
+	b.Signature, err = SignDigest(signer, b.Digest())
+	return err
+}
+
+// Verify the batch signature using the provided public key. A batch is
+// only valid if all its keys share the batch's country of origin.
+func (b *Batch) Verify(pub crypto.PublicKey) bool {
+	for _, k := range b.Keys {
+		if k.Origin != b.Origin {
+			return false
+		}
+	}
+	return VerifyDigest(pub, b.Digest(), b.Signature)
+}
+
+// SignDigest produces a signature for a SHA-256 digest value. ECDSA
+// signatures are ASN.1 encoded.
+func SignDigest(signer crypto.Signer, digest []byte) ([]byte, error) {
+	opts := crypto.Hash(0)
+	if _, ok := signer.Public().(*ecdsa.PublicKey); ok {
+		opts = crypto.SHA256
+	}
+	return signer.Sign(rand.Reader, digest, opts)
+}
+
+// VerifyDigest validates a signature, produced with SignDigest, using the
+// provided public key.
+func VerifyDigest(pub crypto.PublicKey, digest, signature []byte) bool {
+	switch key := pub.(type) {
+	case *ecdsa.PublicKey:
+		sig := struct{ R, S *big.Int }{}
+		if _, err := asn1.Unmarshal(signature, &sig); err != nil {
+			return false
+		}
+		return ecdsa.Verify(key, digest, sig.R, sig.S)
+	case ed25519.PublicKey:
+		return ed25519.Verify(key, digest, signature)
+	default:
+		return false
+	}
+}
+
+// LoadPublicKey decodes a PEM-encoded public key or certificate.
+func LoadPublicKey(data []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("invalid PEM contents")
+	}
+	if block.Type == "CERTIFICATE" {
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return cert.PublicKey, nil
+	}
+	return x509.ParsePKIXPublicKey(block.Bytes)
+}
+
+// LoadPrivateKey decodes a PEM-encoded EC or PKCS8 private key.
+func LoadPrivateKey(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("invalid PEM contents")
+	}
+	if block.Type == "EC PRIVATE KEY" {
+		return x509.ParseECPrivateKey(block.Bytes)
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("unsupported private key")
+	}
+	return signer, nil
+}
@@ -0,0 +1,168 @@
+package federation
+
+import (
+	"bytes"
+	"crypto"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Config provides the settings required to participate on a federation.
+type Config struct {
+	// Federation gateway endpoint.
+	Endpoint string
+
+	// Country of origin for the local instance, as an ISO 3166-1 alpha-2 code.
+	Country string
+
+	// Key used to sign uploaded batches.
+	Signer crypto.Signer
+
+	// Trusted public keys, by country of origin, used to verify downloaded
+	// batches. Batches from countries without a trusted key are discarded.
+	Peers map[string]crypto.PublicKey
+}
+
+// Client instances interact with a federation gateway.
+type Client struct {
+	conf *Config
+	hc   *http.Client
+}
+
+// NewClient returns a new federation gateway client.
+func NewClient(conf *Config) (*Client, error) {
+	if conf.Endpoint == "" || conf.Country == "" || conf.Signer == nil {
+		return nil, fmt.Errorf("endpoint, country and signer are required")
+	}
+	return &Client{
+		conf: conf,
+		hc:   &http.Client{Timeout: 30 * time.Second},
+	}, nil
+}
+
+// Country returns the country of origin for the local instance.
+func (c *Client) Country() string {
+	return c.conf.Country
+}
+
+// Upload a new signed batch containing the provided keys. All keys will be
+// tagged with the local country of origin. Returns the batch tag assigned
+// by the gateway.
+func (c *Client) Upload(keys []Key) (string, error) {
+	batch := &Batch{
+		Origin: c.conf.Country,
+		Keys:   make([]Key, len(keys)),
+	}
+	for i, k := range keys {
+		k.Origin = c.conf.Country
+		batch.Keys[i] = k
+	}
+	if err := batch.Sign(c.conf.Signer); err != nil {
+		return "", err
+	}
+	body, err := json.Marshal(batch.Keys)
+	if err != nil {
+		return "", err
+	}
+
+	// Submit request
+	req, err := http.NewRequest(http.MethodPost, c.url("diagnosiskeys/upload"), bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("batchOrigin", batch.Origin)
+	req.Header.Set("batchSignature", base64.StdEncoding.EncodeToString(batch.Signature))
+	res, err := c.hc.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated {
+		return "", fmt.Errorf("upload failed with status: %d", res.StatusCode)
+	}
+	return res.Header.Get("batchTag"), nil
+}
+
+// Download all batches published on the gateway for the provided date.
+// Only batches from other countries, signed by a trusted peer, are
+// returned.
+func (c *Client) Download(date time.Time) ([]*Batch, error) {
+	var list []*Batch
+	tag := ""
+	for {
+		batch, next, err := c.download(date, tag)
+		if err != nil {
+			return list, err
+		}
+		if batch != nil && batch.Origin != c.conf.Country {
+			pub, ok := c.conf.Peers[batch.Origin]
+			if ok && batch.Verify(pub) {
+				list = append(list, batch)
+			}
+		}
+		if next == "" {
+			return list, nil
+		}
+		tag = next
+	}
+}
+
+// Retrieve an individual batch. Returns the batch and the tag for the
+// next batch available.
+func (c *Client) download(date time.Time, tag string) (*Batch, string, error) {
+	req, err := http.NewRequest(http.MethodGet, c.url("diagnosiskeys/download/"+date.UTC().Format("2006-01-02")), nil)
+	if err != nil {
+		return nil, "", err
+	}
+	req.Header.Set("Accept", "application/json")
+	if tag != "" {
+		req.Header.Set("batchTag", tag)
+	}
+	res, err := c.hc.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	switch res.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, "", nil // No batches available
+	default:
+		return nil, "", fmt.Errorf("download failed with status: %d", res.StatusCode)
+	}
+
+	// Decode batch
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, "", err
+	}
+	batch := &Batch{
+		Tag:    res.Header.Get("batchTag"),
+		Origin: res.Header.Get("batchOrigin"),
+	}
+	if err := json.Unmarshal(body, &batch.Keys); err != nil {
+		return nil, "", err
+	}
+	if batch.Signature, err = base64.StdEncoding.DecodeString(res.Header.Get("batchSignature")); err != nil {
+		return nil, "", err
+	}
+	next := res.Header.Get("nextBatchTag")
+	if next == "null" {
+		next = ""
+	}
+	return batch, next, nil
+}
+
+func (c *Client) url(path string) string {
+	return fmt.Sprintf("%s/%s", strings.TrimSuffix(c.conf.Endpoint, "/"), path)
+}
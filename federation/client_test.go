@@ -0,0 +1,93 @@
+package federation
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// Minimal in-memory federation gateway.
+func testGateway(t *testing.T) *httptest.Server {
+	var batches []*Batch
+	mux := http.NewServeMux()
+	mux.HandleFunc("/diagnosiskeys/upload", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		b := &Batch{Origin: r.Header.Get("batchOrigin")}
+		if err := json.Unmarshal(body, &b.Keys); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		b.Signature, _ = base64.StdEncoding.DecodeString(r.Header.Get("batchSignature"))
+		b.Tag = time.Now().Format("20060102") + "-" + string(rune('a'+len(batches)))
+		batches = append(batches, b)
+		w.Header().Set("batchTag", b.Tag)
+		w.WriteHeader(http.StatusCreated)
+	})
+	mux.HandleFunc("/diagnosiskeys/download/", func(w http.ResponseWriter, r *http.Request) {
+		if len(batches) == 0 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		i := 0
+		for j, b := range batches {
+			if b.Tag == r.Header.Get("batchTag") {
+				i = j
+			}
+		}
+		b := batches[i]
+		w.Header().Set("batchTag", b.Tag)
+		w.Header().Set("batchOrigin", b.Origin)
+		w.Header().Set("batchSignature", base64.StdEncoding.EncodeToString(b.Signature))
+		w.Header().Set("nextBatchTag", "null")
+		if i+1 < len(batches) {
+			w.Header().Set("nextBatchTag", batches[i+1].Tag)
+		}
+		_ = json.NewEncoder(w).Encode(b.Keys)
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestClient(t *testing.T) {
+	gw := testGateway(t)
+	defer gw.Close()
+
+	mx, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	co, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	rogue, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	peers := map[string]crypto.PublicKey{
+		"MX": mx.Public(),
+		"CO": co.Public(),
+	}
+	clMX, _ := NewClient(&Config{Endpoint: gw.URL, Country: "MX", Signer: mx, Peers: peers})
+	clCO, _ := NewClient(&Config{Endpoint: gw.URL, Country: "CO", Signer: co, Peers: peers})
+	clRogue, _ := NewClient(&Config{Endpoint: gw.URL, Country: "CO", Signer: rogue, Peers: peers})
+
+	// Upload batches
+	keys := []Key{{Cell: "d2g6f3q", Bucket: 5295397}, {Cell: "d2g6f3r", Bucket: 5295398}}
+	if _, err := clCO.Upload(keys); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := clRogue.Upload(keys); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := clMX.Upload(keys); err != nil {
+		t.Fatal(err)
+	}
+
+	// Only the valid batch from CO should be accepted by MX
+	list, err := clMX.Download(time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 1 || list[0].Origin != "CO" || len(list[0].Keys) != 2 {
+		t.Errorf("invalid batches received: %d", len(list))
+	}
+}
@@ -0,0 +1,15 @@
+/*
+Package federation provides the tools required to exchange diagnosed-case
+markers with peer instances, enabling cross-border exposure notification.
+
+The exchange is performed through a federation gateway following the same
+flow as the EU Federation Gateway Service (EFGS). Each participant uploads
+signed batches containing the markers generated by its own diagnosed cases,
+tagged with its country of origin; and periodically downloads the batches
+uploaded by other participants.
+
+A marker (Key) represents the presence of a diagnosed case in a geohash cell
+during a specific time bucket. Downloaded batches are only accepted if signed
+by a trusted peer for the batch's country of origin.
+*/
+package federation
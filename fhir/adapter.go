@@ -0,0 +1,231 @@
+package fhir
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Result of a diagnostic test.
+type Result string
+
+const (
+	// Positive test result.
+	Positive Result = "positive"
+
+	// Negative test result.
+	Negative Result = "negative"
+
+	// Inconclusive test result.
+	Inconclusive Result = "inconclusive"
+)
+
+// Diagnosis represents the outcome of a SARS-CoV-2 test performed on a user.
+type Diagnosis struct {
+	// Subject's DID.
+	Subject string
+
+	// Test result.
+	Result Result
+
+	// Date of the test.
+	Date time.Time
+
+	// Source resource, in the form "ResourceType/id".
+	Source string
+}
+
+// LOINC codes for SARS-CoV-2 tests.
+var covidTests = map[string]bool{
+	"94309-2": true, // SARS-CoV-2 RNA [Presence] in Specimen by NAA with probe detection
+	"94500-6": true, // SARS-CoV-2 RNA [Presence] in Respiratory specimen by NAA with probe detection
+	"94531-1": true, // SARS-CoV-2 RNA panel in Respiratory specimen by NAA with probe detection
+	"94533-7": true, // SARS-CoV-2 N gene [Presence] in Respiratory specimen by NAA with probe detection
+	"94534-5": true, // SARS-CoV-2 RdRp gene [Presence] in Respiratory specimen by NAA with probe detection
+	"94558-4": true, // SARS-CoV-2 Ag [Presence] in Respiratory specimen by Rapid immunoassay
+	"94759-8": true, // SARS-CoV-2 RNA [Presence] in Nasopharynx by NAA with probe detection
+	"94845-5": true, // SARS-CoV-2 RNA [Presence] in Saliva by NAA with probe detection
+	"95209-3": true, // SARS-CoV-2 Ag [Presence] in Respiratory specimen by Immunoassay
+	"96119-3": true, // SARS-CoV-2 Ag [Presence] in Upper respiratory specimen by Immunoassay
+}
+
+// Result codes, both SNOMED CT and HL7 v3 observation interpretation.
+var resultCodes = map[string]Result{
+	"10828004":  Positive,     // SNOMED: Positive
+	"260373001": Positive,     // SNOMED: Detected
+	"260385009": Negative,     // SNOMED: Negative
+	"260415000": Negative,     // SNOMED: Not detected
+	"419984006": Inconclusive, // SNOMED: Inconclusive
+	"POS":       Positive,
+	"DET":       Positive,
+	"NEG":       Negative,
+	"ND":        Negative,
+	"IND":       Inconclusive,
+}
+
+// Only finalized results are processed.
+var validStatus = map[string]bool{
+	"final":     true,
+	"amended":   true,
+	"corrected": true,
+}
+
+// Decode the provided JSON-encoded FHIR resource and return the diagnosis
+// events it contains.
+func Decode(data []byte) ([]*Diagnosis, error) {
+	header := struct {
+		ResourceType string `json:"resourceType"`
+	}{}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return nil, errors.New("invalid FHIR resource")
+	}
+	switch header.ResourceType {
+	case "Observation":
+		obs := &Observation{}
+		if err := json.Unmarshal(data, obs); err != nil {
+			return nil, err
+		}
+		d, err := fromObservation(obs)
+		if err != nil {
+			return nil, err
+		}
+		return []*Diagnosis{d}, nil
+	case "DiagnosticReport":
+		report := &DiagnosticReport{}
+		if err := json.Unmarshal(data, report); err != nil {
+			return nil, err
+		}
+		d, err := fromReport(report)
+		if err != nil {
+			return nil, err
+		}
+		return []*Diagnosis{d}, nil
+	case "Bundle":
+		bundle := &Bundle{}
+		if err := json.Unmarshal(data, bundle); err != nil {
+			return nil, err
+		}
+		var list []*Diagnosis
+		for _, entry := range bundle.Entry {
+			res, err := Decode(entry.Resource)
+			if err != nil {
+				continue // Ignore unsupported entries
+			}
+			list = append(list, res...)
+		}
+		if len(list) == 0 {
+			return nil, errors.New("no diagnosis found in bundle")
+		}
+		return list, nil
+	default:
+		return nil, fmt.Errorf("unsupported resource type: %s", header.ResourceType)
+	}
+}
+
+func fromObservation(obs *Observation) (*Diagnosis, error) {
+	if !validStatus[obs.Status] {
+		return nil, fmt.Errorf("invalid status: %s", obs.Status)
+	}
+	if !isCovidTest(obs.Code) {
+		return nil, errors.New("unsupported test code")
+	}
+	subject, err := getSubject(obs.Subject)
+	if err != nil {
+		return nil, err
+	}
+	date, err := getDate(obs.EffectiveDateTime, obs.Issued)
+	if err != nil {
+		return nil, err
+	}
+	concepts := obs.Interpretation
+	if obs.ValueCodeableConcept != nil {
+		concepts = append([]CodeableConcept{*obs.ValueCodeableConcept}, concepts...)
+	}
+	result, err := getResult(concepts)
+	if err != nil {
+		return nil, err
+	}
+	return &Diagnosis{
+		Subject: subject,
+		Result:  result,
+		Date:    date,
+		Source:  fmt.Sprintf("Observation/%s", obs.ID),
+	}, nil
+}
+
+func fromReport(report *DiagnosticReport) (*Diagnosis, error) {
+	if !validStatus[report.Status] {
+		return nil, fmt.Errorf("invalid status: %s", report.Status)
+	}
+	if !isCovidTest(report.Code) {
+		return nil, errors.New("unsupported test code")
+	}
+	subject, err := getSubject(report.Subject)
+	if err != nil {
+		return nil, err
+	}
+	date, err := getDate(report.EffectiveDateTime, report.Issued)
+	if err != nil {
+		return nil, err
+	}
+	result, err := getResult(report.ConclusionCode)
+	if err != nil {
+		return nil, err
+	}
+	return &Diagnosis{
+		Subject: subject,
+		Result:  result,
+		Date:    date,
+		Source:  fmt.Sprintf("DiagnosticReport/%s", report.ID),
+	}, nil
+}
+
+// Verify the code corresponds to a supported SARS-CoV-2 test.
+func isCovidTest(code CodeableConcept) bool {
+	for _, c := range code.Coding {
+		if c.System == "http://loinc.org" && covidTests[c.Code] {
+			return true
+		}
+	}
+	return false
+}
+
+// Retrieve the DID of the subject.
+func getSubject(ref *Reference) (string, error) {
+	if ref == nil {
+		return "", errors.New("missing subject")
+	}
+	if ref.Identifier != nil && strings.HasPrefix(ref.Identifier.Value, "did:") {
+		return ref.Identifier.Value, nil
+	}
+	if i := strings.Index(ref.Reference, "did:"); i >= 0 {
+		return ref.Reference[i:], nil
+	}
+	return "", errors.New("subject is not identified by a DID")
+}
+
+// Retrieve the date of the test.
+func getDate(values ...string) (time.Time, error) {
+	for _, v := range values {
+		for _, layout := range []string{time.RFC3339, "2006-01-02"} {
+			if t, err := time.Parse(layout, v); err == nil {
+				return t, nil
+			}
+		}
+	}
+	return time.Time{}, errors.New("missing or invalid date")
+}
+
+// Retrieve the test result from the provided concepts.
+func getResult(concepts []CodeableConcept) (Result, error) {
+	for _, cc := range concepts {
+		for _, c := range cc.Coding {
+			if r, ok := resultCodes[c.Code]; ok {
+				return r, nil
+			}
+		}
+	}
+	return "", errors.New("missing or unsupported result")
+}
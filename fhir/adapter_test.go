@@ -0,0 +1,105 @@
+package fhir
+
+import (
+	"testing"
+)
+
+const sampleObservation = `{
+  "resourceType": "Observation",
+  "id": "2c4d8b2e",
+  "status": "final",
+  "code": {
+    "coding": [
+      {
+        "system": "http://loinc.org",
+        "code": "94500-6",
+        "display": "SARS-CoV-2 (COVID-19) RNA [Presence] in Respiratory specimen by NAA with probe detection"
+      }
+    ]
+  },
+  "subject": {
+    "identifier": {
+      "system": "urn:ietf:rfc:3986",
+      "value": "did:bryk:7889c965-4644-44ff-b760-f396f1d11444"
+    }
+  },
+  "effectiveDateTime": "2020-05-04T19:08:59Z",
+  "valueCodeableConcept": {
+    "coding": [
+      {
+        "system": "http://snomed.info/sct",
+        "code": "260373001",
+        "display": "Detected"
+      }
+    ]
+  }
+}`
+
+const sampleReport = `{
+  "resourceType": "DiagnosticReport",
+  "id": "f3b1c7d0",
+  "status": "final",
+  "code": {
+    "coding": [
+      {
+        "system": "http://loinc.org",
+        "code": "94558-4"
+      }
+    ]
+  },
+  "subject": {
+    "reference": "Patient/did:bryk:7889c965-4644-44ff-b760-f396f1d11444"
+  },
+  "issued": "2020-05-05",
+  "conclusionCode": [
+    {
+      "coding": [
+        {
+          "system": "http://snomed.info/sct",
+          "code": "260385009"
+        }
+      ]
+    }
+  ]
+}`
+
+func TestDecode(t *testing.T) {
+	subject := "did:bryk:7889c965-4644-44ff-b760-f396f1d11444"
+
+	// Observation
+	list, err := Decode([]byte(sampleObservation))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 1 || list[0].Subject != subject || list[0].Result != Positive {
+		t.Error("invalid observation diagnosis")
+	}
+	if list[0].Source != "Observation/2c4d8b2e" || list[0].Date.Unix() != 1588619339 {
+		t.Error("invalid observation details")
+	}
+
+	// Diagnostic report
+	list, err = Decode([]byte(sampleReport))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 1 || list[0].Subject != subject || list[0].Result != Negative {
+		t.Error("invalid report diagnosis")
+	}
+
+	// Bundle
+	bundle := `{"resourceType": "Bundle", "entry": [{"resource": ` + sampleObservation + `},
+{"resource": ` + sampleReport + `}, {"resource": {"resourceType": "Patient"}}]}`
+	list, err = Decode([]byte(bundle))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 2 {
+		t.Errorf("invalid bundle contents: %d", len(list))
+	}
+
+	// Unsupported resources
+	if _, err = Decode([]byte(`{"resourceType": "Patient"}`)); err == nil {
+		t.Error("unsupported resource should fail")
+	}
+}
@@ -0,0 +1,9 @@
+/*
+Package fhir provides an adapter to ingest HL7 FHIR resources generated by
+laboratory systems and convert them into platform diagnosis events.
+
+Supported resources are "Observation" and "DiagnosticReport", individually
+or as entries of a "Bundle". The subject of a resource must be identified
+by its DID, either as the identifier value or the reference of the subject.
+*/
+package fhir
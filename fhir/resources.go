@@ -0,0 +1,68 @@
+package fhir
+
+import "encoding/json"
+
+// Identifier for a resource or entity.
+type Identifier struct {
+	System string `json:"system,omitempty"`
+	Value  string `json:"value,omitempty"`
+}
+
+// Reference from one resource to another.
+type Reference struct {
+	Reference  string      `json:"reference,omitempty"`
+	Identifier *Identifier `json:"identifier,omitempty"`
+	Display    string      `json:"display,omitempty"`
+}
+
+// Coding is a reference to a code defined by a terminology system.
+type Coding struct {
+	System  string `json:"system,omitempty"`
+	Code    string `json:"code,omitempty"`
+	Display string `json:"display,omitempty"`
+}
+
+// CodeableConcept represents a value that is usually supplied by providing
+// a reference to one or more terminologies.
+type CodeableConcept struct {
+	Coding []Coding `json:"coding,omitempty"`
+	Text   string   `json:"text,omitempty"`
+}
+
+// Observation holds measurements and simple assertions made about a patient.
+type Observation struct {
+	ResourceType         string            `json:"resourceType"`
+	ID                   string            `json:"id,omitempty"`
+	Status               string            `json:"status"`
+	Code                 CodeableConcept   `json:"code"`
+	Subject              *Reference        `json:"subject,omitempty"`
+	EffectiveDateTime    string            `json:"effectiveDateTime,omitempty"`
+	Issued               string            `json:"issued,omitempty"`
+	ValueCodeableConcept *CodeableConcept  `json:"valueCodeableConcept,omitempty"`
+	Interpretation       []CodeableConcept `json:"interpretation,omitempty"`
+}
+
+// DiagnosticReport holds the findings and interpretation of diagnostic tests
+// performed on a patient.
+type DiagnosticReport struct {
+	ResourceType      string            `json:"resourceType"`
+	ID                string            `json:"id,omitempty"`
+	Status            string            `json:"status"`
+	Code              CodeableConcept   `json:"code"`
+	Subject           *Reference        `json:"subject,omitempty"`
+	EffectiveDateTime string            `json:"effectiveDateTime,omitempty"`
+	Issued            string            `json:"issued,omitempty"`
+	Conclusion        string            `json:"conclusion,omitempty"`
+	ConclusionCode    []CodeableConcept `json:"conclusionCode,omitempty"`
+}
+
+// Bundle is a container for a collection of resources.
+type Bundle struct {
+	ResourceType string        `json:"resourceType"`
+	Entry        []BundleEntry `json:"entry,omitempty"`
+}
+
+// BundleEntry is an individual resource in a bundle.
+type BundleEntry struct {
+	Resource json.RawMessage `json:"resource"`
+}
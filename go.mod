@@ -1,21 +1,35 @@
 module go.bryk.io/covid-tracking
 
-go 1.14
+go 1.16
 
 require (
+	github.com/ThalesIgnite/crypto11 v1.2.1
+	github.com/btcsuite/btcd v0.20.1-beta
 	github.com/gogo/googleapis v1.3.2
 	github.com/gogo/protobuf v1.3.1
 	github.com/golang/protobuf v1.3.5
 	github.com/google/uuid v1.1.1
 	github.com/grpc-ecosystem/grpc-gateway v1.13.0
+	github.com/hdevalence/ed25519consensus v0.0.0-20210204194344-59a8610d2b87
+	github.com/mr-tron/base58 v1.1.3
 	github.com/mwitkow/go-proto-validators v0.3.0
+	github.com/piprate/json-gold v0.3.0
 	github.com/pkg/errors v0.9.1
+	github.com/prometheus/client_golang v1.5.1
+	github.com/robfig/cron/v3 v3.0.1
+	github.com/skip2/go-qrcode v0.0.0-20200617195104-da1b6568686e
 	github.com/spf13/cobra v1.0.0
 	github.com/spf13/viper v1.6.3
+	github.com/zalando/go-keyring v0.1.1
 	go.bryk.io/x v0.0.0-20200512190419-e5abc3ed8c7d
 	go.mongodb.org/mongo-driver v1.3.2
 	golang.org/x/crypto v0.0.0-20200406173513-056763e48d71
+	golang.org/x/net v0.0.0-20200324143707-d3edc9973b7e
+	golang.org/x/sync v0.0.0-20200317015054-43a5402ce75a
+	golang.org/x/time v0.0.0-20191024005414-555d28b269f0
+	google.golang.org/genproto v0.0.0-20190927181202-20e1ac93f88c
 	google.golang.org/grpc v1.28.1
+	gopkg.in/yaml.v2 v2.2.8
 )
 
 replace github.com/cloudflare/cfssl => github.com/bryk-io/cfssl v0.0.0-20191204191638-bb9c164a4cb1
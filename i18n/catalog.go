@@ -0,0 +1,96 @@
+package i18n
+
+// Message catalogs by language. All keys MUST be available on the default
+// language catalog.
+var catalog = map[Language]map[string]string{
+	English: {
+		// Errors
+		"error.unspecified":             "An unexpected error occurred.",
+		"error.unauthenticated":         "Your session is invalid or has expired, please sign in again.",
+		"error.unauthorized":            "You are not allowed to perform this operation.",
+		"error.invalid_argument":        "The request contains invalid information.",
+		"error.invalid_did":             "Your device identifier is invalid.",
+		"error.invalid_signature":       "The request signature is invalid.",
+		"error.invalid_activation_code": "The activation code is invalid or has expired.",
+		"error.invalid_refresh_code":    "Your session can't be renewed, please sign in again.",
+		"error.not_found":               "The requested information was not found.",
+		"error.anonymity_set":           "Not enough data is available to protect the privacy of users.",
+		"error.not_enabled":             "This feature is not available.",
+		"error.unavailable":             "The service is temporarily unavailable, please try again later.",
+		"error.internal":                "An unexpected error occurred, please try again later.",
+		"error.rate_limited":            "Too many requests, please try again later.",
+		"error.invalid_attestation":     "Your device could not be verified, please use the official app.",
+		"error.maintenance":             "The service is under maintenance, please try again later.",
+		"error.overloaded":              "The service is busy, please try again shortly.",
+		"error.quota_exceeded":          "You have reached the daily limit of records, please try again tomorrow.",
+		"error.geo_restricted":          "This service is not available in your location.",
+
+		// Notifications
+		"notification.exposure.title": "Possible exposure to COVID-19",
+		"notification.exposure.body": "You were recently near someone who tested positive for COVID-19. " +
+			"Please follow the recommendations of your local health authority.",
+		"notification.venue_outbreak.title": "COVID-19 case at a place you visited",
+		"notification.venue_outbreak.body": "A confirmed case visited {name} while you were there. " +
+			"Please follow the recommendations of your local health authority.",
+	},
+	Spanish: {
+		// Errors
+		"error.unspecified":             "Ocurrió un error inesperado.",
+		"error.unauthenticated":         "Tu sesión no es válida o ha expirado, por favor inicia sesión de nuevo.",
+		"error.unauthorized":            "No tienes permiso para realizar esta operación.",
+		"error.invalid_argument":        "La solicitud contiene información no válida.",
+		"error.invalid_did":             "El identificador de tu dispositivo no es válido.",
+		"error.invalid_signature":       "La firma de la solicitud no es válida.",
+		"error.invalid_activation_code": "El código de activación no es válido o ha expirado.",
+		"error.invalid_refresh_code":    "No es posible renovar tu sesión, por favor inicia sesión de nuevo.",
+		"error.not_found":               "No se encontró la información solicitada.",
+		"error.anonymity_set":           "No hay datos suficientes para proteger la privacidad de los usuarios.",
+		"error.not_enabled":             "Esta funcionalidad no está disponible.",
+		"error.unavailable":             "El servicio no está disponible temporalmente, por favor intenta más tarde.",
+		"error.internal":                "Ocurrió un error inesperado, por favor intenta más tarde.",
+		"error.rate_limited":            "Demasiadas solicitudes, por favor intenta más tarde.",
+		"error.invalid_attestation":     "No fue posible verificar tu dispositivo, por favor usa la aplicación oficial.",
+		"error.maintenance":             "El servicio está en mantenimiento, por favor intenta más tarde.",
+		"error.overloaded":              "El servicio está ocupado, por favor intenta de nuevo en breve.",
+		"error.quota_exceeded":          "Alcanzaste el límite diario de registros, por favor intenta de nuevo mañana.",
+		"error.geo_restricted":          "Este servicio no está disponible en tu ubicación.",
+
+		// Notifications
+		"notification.exposure.title": "Posible exposición a COVID-19",
+		"notification.exposure.body": "Recientemente estuviste cerca de alguien con un resultado positivo de " +
+			"COVID-19. Por favor sigue las recomendaciones de tu autoridad de salud local.",
+		"notification.venue_outbreak.title": "Caso de COVID-19 en un lugar que visitaste",
+		"notification.venue_outbreak.body": "Un caso confirmado visitó {name} mientras estabas ahí. " +
+			"Por favor sigue las recomendaciones de tu autoridad de salud local.",
+	},
+	Portuguese: {
+		// Errors
+		"error.unspecified":             "Ocorreu um erro inesperado.",
+		"error.unauthenticated":         "Sua sessão é inválida ou expirou, por favor entre novamente.",
+		"error.unauthorized":            "Você não tem permissão para realizar esta operação.",
+		"error.invalid_argument":        "A solicitação contém informações inválidas.",
+		"error.invalid_did":             "O identificador do seu dispositivo é inválido.",
+		"error.invalid_signature":       "A assinatura da solicitação é inválida.",
+		"error.invalid_activation_code": "O código de ativação é inválido ou expirou.",
+		"error.invalid_refresh_code":    "Não é possível renovar sua sessão, por favor entre novamente.",
+		"error.not_found":               "As informações solicitadas não foram encontradas.",
+		"error.anonymity_set":           "Não há dados suficientes para proteger a privacidade dos usuários.",
+		"error.not_enabled":             "Esta funcionalidade não está disponível.",
+		"error.unavailable":             "O serviço está temporariamente indisponível, tente novamente mais tarde.",
+		"error.internal":                "Ocorreu um erro inesperado, tente novamente mais tarde.",
+		"error.rate_limited":            "Muitas solicitações, tente novamente mais tarde.",
+		"error.invalid_attestation":     "Não foi possível verificar seu dispositivo, por favor use o aplicativo oficial.",
+		"error.maintenance":             "O serviço está em manutenção, tente novamente mais tarde.",
+		"error.overloaded":              "O serviço está ocupado, tente novamente em breve.",
+		"error.quota_exceeded":          "Você atingiu o limite diário de registros, tente novamente amanhã.",
+		"error.geo_restricted":          "Este serviço não está disponível na sua localização.",
+
+		// Notifications
+		"notification.exposure.title": "Possível exposição à COVID-19",
+		"notification.exposure.body": "Você esteve recentemente perto de alguém com resultado positivo para " +
+			"COVID-19. Siga as recomendações da sua autoridade de saúde local.",
+		"notification.venue_outbreak.title": "Caso de COVID-19 em um local que você visitou",
+		"notification.venue_outbreak.body": "Um caso confirmado visitou {name} enquanto você estava lá. " +
+			"Siga as recomendações da sua autoridade de saúde local.",
+	},
+}
@@ -0,0 +1,14 @@
+/*
+Package i18n provides localized versions of the user-facing messages produced
+by the platform, like error descriptions and notification contents.
+
+Supported languages are English (default), Spanish and Portuguese. Messages
+are identified by a key and can include named parameters in the form
+"{name}", replaced when the message is formatted.
+
+	lang := i18n.Negotiate("es-MX,es;q=0.9,en;q=0.8")
+	msg := i18n.Format(lang, "notification.venue_outbreak.body", map[string]string{
+		"name": "Central Market",
+	})
+*/
+package i18n
@@ -0,0 +1,99 @@
+package i18n
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Language identifier, as a two-letter ISO 639-1 code.
+type Language string
+
+const (
+	// English messages.
+	English Language = "en"
+
+	// Spanish messages.
+	Spanish Language = "es"
+
+	// Portuguese messages.
+	Portuguese Language = "pt"
+
+	// Default language, used when no supported language is requested.
+	Default = English
+)
+
+// Supported returns true if 'lang' has a message catalog available.
+func Supported(lang Language) bool {
+	_, ok := catalog[lang]
+	return ok
+}
+
+// Negotiate returns the best supported language for the provided list of
+// preferences. The value can be a single language tag, i.e. "pt-BR", or the
+// contents of an "Accept-Language" header.
+func Negotiate(accept string) Language {
+	type pref struct {
+		lang Language
+		q    float64
+	}
+	var prefs []pref
+	for _, entry := range strings.Split(accept, ",") {
+		parts := strings.Split(strings.TrimSpace(entry), ";")
+		tag := strings.ToLower(strings.TrimSpace(parts[0]))
+		if tag == "" {
+			continue
+		}
+		q := 1.0
+		for _, p := range parts[1:] {
+			p = strings.TrimSpace(p)
+			if strings.HasPrefix(p, "q=") {
+				if v, err := strconv.ParseFloat(p[2:], 64); err == nil {
+					q = v
+				}
+			}
+		}
+		// Only the primary language subtag is considered
+		prefs = append(prefs, pref{lang: Language(strings.Split(tag, "-")[0]), q: q})
+	}
+	sort.SliceStable(prefs, func(i, j int) bool {
+		return prefs[i].q > prefs[j].q
+	})
+	for _, p := range prefs {
+		if p.q > 0 && Supported(p.lang) {
+			return p.lang
+		}
+	}
+	return Default
+}
+
+// Text returns the message identified by 'key' in the requested language.
+// If not available, the default language version is returned; if the key
+// is unknown the key itself is returned.
+func Text(lang Language, key string) string {
+	if msg, ok := catalog[lang][key]; ok {
+		return msg
+	}
+	if msg, ok := catalog[Default][key]; ok {
+		return msg
+	}
+	return key
+}
+
+// Format returns the message identified by 'key' in the requested language,
+// replacing all "{name}" parameters with the provided values.
+func Format(lang Language, key string, params map[string]string) string {
+	return Render(Text(lang, key), params)
+}
+
+// Render replaces all "{name}" parameters on 'msg' with the provided values.
+func Render(msg string, params map[string]string) string {
+	if len(params) == 0 {
+		return msg
+	}
+	pairs := make([]string, 0, len(params)*2)
+	for k, v := range params {
+		pairs = append(pairs, "{"+k+"}", v)
+	}
+	return strings.NewReplacer(pairs...).Replace(msg)
+}
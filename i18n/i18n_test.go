@@ -0,0 +1,50 @@
+package i18n
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNegotiate(t *testing.T) {
+	cases := map[string]Language{
+		"":                              English,
+		"es":                            Spanish,
+		"pt-BR":                         Portuguese,
+		"fr-FR,fr;q=0.9":                English,
+		"fr-FR,fr;q=0.9,es;q=0.8":       Spanish,
+		"en;q=0.5,pt-BR;q=0.9,es;q=0.7": Portuguese,
+		"es;q=0,pt;q=0.1":               Portuguese,
+		"ES-mx, en-US;q=0.8, en;q=0.7":  Spanish,
+		"invalid;;q=abc":                English,
+	}
+	for accept, expected := range cases {
+		if lang := Negotiate(accept); lang != expected {
+			t.Errorf("%q: expected %s, got %s", accept, expected, lang)
+		}
+	}
+}
+
+func TestCatalog(t *testing.T) {
+	// All keys must be available on the default catalog
+	for lang, messages := range catalog {
+		for key := range messages {
+			if _, ok := catalog[Default][key]; !ok {
+				t.Errorf("%s: key '%s' missing on default catalog", lang, key)
+			}
+		}
+	}
+
+	// Fallback
+	if Text(Spanish, "unknown.key") != "unknown.key" {
+		t.Error("unknown keys should be returned as-is")
+	}
+	if Text("fr", "error.internal") != catalog[English]["error.internal"] {
+		t.Error("unsupported languages should use the default catalog")
+	}
+
+	// Parameters
+	msg := Format(Spanish, "notification.venue_outbreak.body", map[string]string{"name": "Mercado Central"})
+	if !strings.Contains(msg, "Mercado Central") || strings.Contains(msg, "{name}") {
+		t.Errorf("invalid formatted message: %s", msg)
+	}
+}
@@ -0,0 +1,24 @@
+/*
+Package kiosk provides a small local HTTP service for registration desks and
+other agent workstations with intermittent connectivity.
+
+The service keeps a pool of pre-generated activation codes for a registration
+campaign, refilled while the platform API is reachable, so codes and their QR
+images can be handed out offline. Check-ins and lab results submitted to the
+service are saved on a local state file and forwarded using the agent
+credentials once the API is available, in the order they were received.
+
+The service is meant to be exposed only on the local host or the desk's
+private network; it doesn't authenticate its clients.
+
+	svc, err := kiosk.New(cl, &kiosk.Config{
+		Campaign:  "district-7",
+		StateFile: "kiosk-state.json",
+	})
+	if err != nil {
+		panic(err)
+	}
+	defer svc.Close()
+	_ = http.ListenAndServe("127.0.0.1:8484", svc.Handler())
+*/
+package kiosk
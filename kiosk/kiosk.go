@@ -0,0 +1,429 @@
+package kiosk
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/skip2/go-qrcode"
+	"go.bryk.io/covid-tracking/client"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	xlog "go.bryk.io/x/log"
+	"google.golang.org/grpc/codes"
+)
+
+// Default service settings.
+const (
+	defaultPoolSize     = 200
+	defaultSyncInterval = 30 * time.Second
+	maxPendingItems     = 10000
+	maxBatchSize        = 1000
+	maxSubmissionSize   = 256 * 1024
+	requestTimeout      = 10 * time.Second
+)
+
+// Upstream provides access to the platform API; implemented by client.Client.
+type Upstream interface {
+	Ping(ctx context.Context) (*protov1.PingResponse, error)
+	BulkActivationCodes(ctx context.Context, req *protov1.BulkActivationCodesRequest) ([]*protov1.CampaignCode, error)
+	CheckIn(ctx context.Context, records ...*protov1.CheckInRecord) (*protov1.CheckInResponse, error)
+	LabResult(ctx context.Context, req *protov1.LabResultRequest) (*protov1.LabResultResponse, error)
+}
+
+// Config provides the settings available when creating a new kiosk service.
+type Config struct {
+	// Registration campaign for the activation codes handed out.
+	Campaign string
+
+	// Number of activation codes kept available for offline use. The pool
+	// is refilled when it drops below half its size. Defaults to 200.
+	PoolSize int
+
+	// File used to persist the pending submissions and available codes.
+	StateFile string
+
+	// How often to contact the platform API to refill the codes pool and
+	// forward pending submissions. Defaults to 30 seconds.
+	SyncInterval time.Duration
+
+	// To handle output. Defaults to JSON entries on standard output.
+	Logger xlog.Logger
+}
+
+// Status reports the current state of the service.
+type Status struct {
+	// Whether the platform API was reachable on the last attempt.
+	Online bool `json:"online"`
+
+	// Date of the last successful synchronization, if any.
+	LastSync *time.Time `json:"last_sync,omitempty"`
+
+	// Activation codes available.
+	Codes int `json:"codes"`
+
+	// Submissions waiting to be forwarded.
+	Pending int `json:"pending"`
+
+	// Submissions rejected by the platform API.
+	Rejected int `json:"rejected"`
+}
+
+// Service instances handle the requests of a registration desk.
+type Service struct {
+	api    Upstream
+	conf   *Config
+	log    xlog.Logger
+	st     *state
+	online bool
+	synced time.Time
+	ctx    context.Context
+	halt   context.CancelFunc
+	wake   chan struct{}
+	done   chan struct{}
+	mu     sync.Mutex
+}
+
+// New returns a kiosk service using 'api' to access the platform. The
+// synchronization with the platform API starts right away.
+func New(api Upstream, conf *Config) (*Service, error) {
+	if conf.StateFile == "" {
+		return nil, errors.New("a state file is required")
+	}
+	if conf.PoolSize <= 0 {
+		conf.PoolSize = defaultPoolSize
+	}
+	if conf.SyncInterval <= 0 {
+		conf.SyncInterval = defaultSyncInterval
+	}
+	if conf.Logger == nil {
+		conf.Logger = xlog.WithZero(false)
+	}
+	st, err := loadState(conf.StateFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load state file")
+	}
+	s := &Service{
+		api:  api,
+		conf: conf,
+		log:  conf.Logger,
+		st:   st,
+		wake: make(chan struct{}, 1),
+		done: make(chan struct{}),
+	}
+	s.ctx, s.halt = context.WithCancel(context.Background())
+	go s.loop()
+	return s, nil
+}
+
+// Close stops the synchronization with the platform API and saves the
+// current state.
+func (s *Service) Close() error {
+	s.halt()
+	<-s.done
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.st.save(s.conf.StateFile)
+}
+
+// Status returns the current state of the service.
+func (s *Service) Status() *Status {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	st := &Status{
+		Online:   s.online,
+		Codes:    len(s.st.Codes),
+		Pending:  len(s.st.Pending),
+		Rejected: s.st.Rejected,
+	}
+	if !s.synced.IsZero() {
+		synced := s.synced
+		st.LastSync = &synced
+	}
+	return st
+}
+
+// Handler returns the HTTP interface of the service.
+//
+//	GET  /status      service status
+//	POST /code        hand out an activation code, including its QR image
+//	POST /check_in    queue a check-in request
+//	POST /lab_result  queue a lab result request
+func (s *Service) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/status", s.handleStatus)
+	mux.HandleFunc("/code", s.handleCode)
+	mux.HandleFunc("/check_in", s.handleSubmission(kindCheckIn))
+	mux.HandleFunc("/lab_result", s.handleSubmission(kindLabResult))
+	return mux
+}
+
+func (s *Service) handleStatus(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		reply(w, http.StatusMethodNotAllowed, errorBody("method not allowed"))
+		return
+	}
+	reply(w, http.StatusOK, s.Status())
+}
+
+func (s *Service) handleCode(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		reply(w, http.StatusMethodNotAllowed, errorBody("method not allowed"))
+		return
+	}
+	code, err := s.takeCode()
+	if err != nil {
+		reply(w, http.StatusServiceUnavailable, errorBody(err.Error()))
+		return
+	}
+	if len(code.QrImage) == 0 {
+		if code.QrImage, err = qrcode.Encode(code.QrCode, qrcode.Medium, 256); err != nil {
+			reply(w, http.StatusInternalServerError, errorBody("failed to render QR code"))
+			return
+		}
+	}
+	reply(w, http.StatusOK, code)
+}
+
+func (s *Service) handleSubmission(kind string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			reply(w, http.StatusMethodNotAllowed, errorBody("method not allowed"))
+			return
+		}
+		payload, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxSubmissionSize))
+		if err != nil {
+			reply(w, http.StatusRequestEntityTooLarge, errorBody("submission too large"))
+			return
+		}
+		id, err := s.enqueue(kind, payload)
+		if err != nil {
+			reply(w, http.StatusBadRequest, errorBody(err.Error()))
+			return
+		}
+		reply(w, http.StatusAccepted, map[string]string{"id": id})
+	}
+}
+
+// Return the next activation code available; expired codes are discarded.
+func (s *Service) takeCode() (*protov1.CampaignCode, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.st.pruneCodes(time.Now())
+	if len(s.st.Codes) == 0 {
+		s.trigger()
+		return nil, errors.New("no activation codes available")
+	}
+	code := s.st.Codes[0]
+	s.st.Codes = s.st.Codes[1:]
+	if err := s.st.save(s.conf.StateFile); err != nil {
+		s.log.WithField("error", err.Error()).Error("failed to save state")
+	}
+	if len(s.st.Codes) < s.conf.PoolSize/2 {
+		s.trigger()
+	}
+	return code, nil
+}
+
+// Validate and queue a submission, returning its identifier.
+func (s *Service) enqueue(kind string, payload []byte) (string, error) {
+	if _, err := decode(kind, payload); err != nil {
+		return "", errors.Wrap(err, "invalid submission")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.st.Pending) >= maxPendingItems {
+		return "", errors.New("too many pending submissions")
+	}
+	item := &submission{
+		ID:       uuid.New().String(),
+		Kind:     kind,
+		Payload:  payload,
+		Received: time.Now().UTC(),
+	}
+	s.st.Pending = append(s.st.Pending, item)
+	if err := s.st.save(s.conf.StateFile); err != nil {
+		s.st.Pending = s.st.Pending[:len(s.st.Pending)-1]
+		return "", errors.Wrap(err, "failed to save submission")
+	}
+	s.trigger()
+	return item.ID, nil
+}
+
+// Decode a submission payload.
+func decode(kind string, payload []byte) (interface{}, error) {
+	switch kind {
+	case kindCheckIn:
+		req := &protov1.CheckInRequest{}
+		if err := json.Unmarshal(payload, req); err != nil {
+			return nil, err
+		}
+		if len(req.Records) == 0 {
+			return nil, errors.New("no records provided")
+		}
+		return req, nil
+	case kindLabResult:
+		req := &protov1.LabResultRequest{}
+		if err := json.Unmarshal(payload, req); err != nil {
+			return nil, err
+		}
+		if len(req.Resource) == 0 {
+			return nil, errors.New("no resource provided")
+		}
+		return req, nil
+	default:
+		return nil, errors.Errorf("unsupported submission: %s", kind)
+	}
+}
+
+// Request a synchronization, without waiting for the next interval. Must be
+// called with the lock held.
+func (s *Service) trigger() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Periodically synchronize with the platform API.
+func (s *Service) loop() {
+	defer close(s.done)
+	ticker := time.NewTicker(s.conf.SyncInterval)
+	defer ticker.Stop()
+	s.sync()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			s.sync()
+		case <-s.wake:
+			s.sync()
+		}
+	}
+}
+
+// Refill the codes pool and forward the pending submissions, in order.
+// Submissions rejected by the API are discarded; the process stops on
+// temporary failures, to be resumed on the next synchronization.
+func (s *Service) sync() {
+	ctx, cancel := context.WithTimeout(s.ctx, requestTimeout)
+	_, err := s.api.Ping(ctx)
+	cancel()
+	s.mu.Lock()
+	s.online = err == nil
+	s.mu.Unlock()
+	if err != nil {
+		return
+	}
+	if err := s.refill(); err != nil {
+		s.log.WithField("error", err.Error()).Warning("failed to refill activation codes")
+	}
+	for {
+		s.mu.Lock()
+		if len(s.st.Pending) == 0 {
+			s.synced = time.Now().UTC()
+			s.mu.Unlock()
+			return
+		}
+		item := s.st.Pending[0]
+		s.mu.Unlock()
+
+		err := s.forward(item)
+		if err != nil && retryable(err) {
+			s.log.WithFields(xlog.Fields{
+				"id":    item.ID,
+				"error": err.Error(),
+			}).Warning("submission delayed")
+			s.mu.Lock()
+			item.Attempts++
+			s.online = false
+			_ = s.st.save(s.conf.StateFile)
+			s.mu.Unlock()
+			return
+		}
+		s.mu.Lock()
+		s.st.Pending = s.st.Pending[1:]
+		if err != nil {
+			s.st.Rejected++
+			s.log.WithFields(xlog.Fields{
+				"id":    item.ID,
+				"kind":  item.Kind,
+				"error": err.Error(),
+			}).Error("submission rejected")
+		}
+		if err := s.st.save(s.conf.StateFile); err != nil {
+			s.log.WithField("error", err.Error()).Error("failed to save state")
+		}
+		s.mu.Unlock()
+	}
+}
+
+// Request new activation codes when the pool drops below half its size.
+func (s *Service) refill() error {
+	s.mu.Lock()
+	s.st.pruneCodes(time.Now())
+	missing := s.conf.PoolSize - len(s.st.Codes)
+	s.mu.Unlock()
+	if missing < s.conf.PoolSize/2 {
+		return nil
+	}
+	if missing > maxBatchSize {
+		missing = maxBatchSize
+	}
+	ctx, cancel := context.WithTimeout(s.ctx, requestTimeout)
+	defer cancel()
+	list, err := s.api.BulkActivationCodes(ctx, &protov1.BulkActivationCodesRequest{
+		Count:    uint32(missing),
+		Campaign: s.conf.Campaign,
+	})
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.st.Codes = append(s.st.Codes, list...)
+	return s.st.save(s.conf.StateFile)
+}
+
+// Submit a pending item to the platform API.
+func (s *Service) forward(item *submission) error {
+	req, err := decode(item.Kind, item.Payload)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(s.ctx, requestTimeout)
+	defer cancel()
+	switch r := req.(type) {
+	case *protov1.CheckInRequest:
+		_, err = s.api.CheckIn(ctx, r.Records...)
+	case *protov1.LabResultRequest:
+		_, err = s.api.LabResult(ctx, r)
+	}
+	return err
+}
+
+// Whether a failed submission should be retried later. Expired credentials
+// that can't be renewed are also retried, to avoid discarding submissions
+// until the agent credentials are replaced.
+func retryable(err error) bool {
+	e, ok := err.(*client.Error)
+	if !ok {
+		return true
+	}
+	return e.Temporary() || e.Status == codes.Unauthenticated
+}
+
+func reply(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func errorBody(msg string) map[string]string {
+	return map[string]string{"error": msg}
+}
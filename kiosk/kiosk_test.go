@@ -0,0 +1,175 @@
+package kiosk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"go.bryk.io/covid-tracking/client"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	xlog "go.bryk.io/x/log"
+	"google.golang.org/grpc/codes"
+)
+
+// Platform API with controllable availability.
+type fakeUpstream struct {
+	online   bool
+	checkIns int
+	reject   bool
+	mu       sync.Mutex
+}
+
+func (f *fakeUpstream) setOnline(online bool) {
+	f.mu.Lock()
+	f.online = online
+	f.mu.Unlock()
+}
+
+func (f *fakeUpstream) Ping(_ context.Context) (*protov1.PingResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if !f.online {
+		return nil, errors.New("connection refused")
+	}
+	return &protov1.PingResponse{Ok: true}, nil
+}
+
+func (f *fakeUpstream) BulkActivationCodes(_ context.Context,
+	req *protov1.BulkActivationCodesRequest) ([]*protov1.CampaignCode, error) {
+	var list []*protov1.CampaignCode
+	for i := 0; i < int(req.Count); i++ {
+		code := fmt.Sprintf("code-%d", i)
+		list = append(list, &protov1.CampaignCode{
+			ActivationCode: code,
+			Campaign:       req.Campaign,
+			QrCode:         "ct19:activation:" + code,
+			Expires:        time.Now().Add(time.Hour).Unix(),
+		})
+	}
+	return list, nil
+}
+
+func (f *fakeUpstream) CheckIn(_ context.Context,
+	_ ...*protov1.CheckInRecord) (*protov1.CheckInResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.checkIns++
+	return &protov1.CheckInResponse{Ok: true}, nil
+}
+
+func (f *fakeUpstream) LabResult(_ context.Context, _ *protov1.LabResultRequest) (*protov1.LabResultResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.reject {
+		return nil, &client.Error{Status: codes.InvalidArgument, Message: "invalid resource"}
+	}
+	return &protov1.LabResultResponse{Ok: true}, nil
+}
+
+// Wait for the service to reach the expected condition.
+func waitFor(t *testing.T, svc *Service, cond func(st *Status) bool) {
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		if cond(svc.Status()) {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("unexpected status: %+v", svc.Status())
+}
+
+func TestService(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kiosk")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	stateFile := filepath.Join(dir, "state.json")
+	api := &fakeUpstream{reject: true}
+	svc, err := New(api, &Config{
+		Campaign:     "district-7",
+		PoolSize:     4,
+		StateFile:    stateFile,
+		SyncInterval: time.Hour,
+		Logger:       xlog.WithZero(false),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := svc.Handler()
+	post := func(path, body string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, path, strings.NewReader(body)))
+		return rec
+	}
+
+	// Offline: submissions are queued, but no codes are available
+	if rec := post("/code", ""); rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("unexpected status: %d", rec.Code)
+	}
+	checkIn := `{"records":[{"venue":"venue-1","timestamp":1590000000}]}`
+	if rec := post("/check_in", checkIn); rec.Code != http.StatusAccepted {
+		t.Errorf("unexpected status: %d", rec.Code)
+	}
+	if rec := post("/lab_result", `{"resource":"e30="}`); rec.Code != http.StatusAccepted {
+		t.Errorf("unexpected status: %d", rec.Code)
+	}
+	if rec := post("/check_in", `{"records":[]}`); rec.Code != http.StatusBadRequest {
+		t.Errorf("empty submission accepted: %d", rec.Code)
+	}
+	waitFor(t, svc, func(st *Status) bool { return !st.Online && st.Pending == 2 })
+
+	// Online: codes pool is filled and pending submissions forwarded
+	api.setOnline(true)
+	svc.mu.Lock()
+	svc.trigger()
+	svc.mu.Unlock()
+	waitFor(t, svc, func(st *Status) bool { return st.Online && st.Pending == 0 && st.Codes == 4 })
+	if st := svc.Status(); st.Rejected != 1 || st.LastSync == nil {
+		t.Errorf("unexpected status: %+v", st)
+	}
+	api.mu.Lock()
+	if api.checkIns != 1 {
+		t.Errorf("unexpected check-ins forwarded: %d", api.checkIns)
+	}
+	api.mu.Unlock()
+	rec := post("/code", "")
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), "ct19:activation:") {
+		t.Errorf("invalid code response: %d %s", rec.Code, rec.Body.String())
+	}
+	if err := svc.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// State is preserved across restarts
+	st, err := loadState(stateFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(st.Codes) != 3 || len(st.Pending) != 0 || st.Rejected != 1 {
+		t.Errorf("invalid state: %+v", st)
+	}
+}
+
+func TestRetryable(t *testing.T) {
+	if !retryable(errors.New("connection refused")) {
+		t.Error("network errors should be retried")
+	}
+	if !retryable(&client.Error{Status: codes.Unavailable}) {
+		t.Error("temporary errors should be retried")
+	}
+	if retryable(&client.Error{Status: codes.InvalidArgument}) {
+		t.Error("invalid submissions should not be retried")
+	}
+}
@@ -0,0 +1,71 @@
+package kiosk
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+)
+
+// Kinds of submissions supported.
+const (
+	kindCheckIn   = "check_in"
+	kindLabResult = "lab_result"
+)
+
+// Submission waiting to be forwarded to the platform API.
+type submission struct {
+	ID       string          `json:"id"`
+	Kind     string          `json:"kind"`
+	Payload  json.RawMessage `json:"payload"`
+	Received time.Time       `json:"received"`
+	Attempts int             `json:"attempts"`
+}
+
+// Kiosk state persisted on the local file system, so pending submissions and
+// available codes survive restarts.
+type state struct {
+	Codes    []*protov1.CampaignCode `json:"codes"`
+	Pending  []*submission           `json:"pending"`
+	Rejected int                     `json:"rejected"`
+}
+
+// Load the state file, an empty state is returned if the file doesn't exist.
+func loadState(file string) (*state, error) {
+	st := &state{}
+	contents, err := ioutil.ReadFile(filepath.Clean(file))
+	if os.IsNotExist(err) {
+		return st, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return st, json.Unmarshal(contents, st)
+}
+
+// Save the state file, replacing its contents atomically.
+func (st *state) save(file string) error {
+	contents, err := json.Marshal(st)
+	if err != nil {
+		return err
+	}
+	tmp := filepath.Clean(file) + ".tmp"
+	if err := ioutil.WriteFile(tmp, contents, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, filepath.Clean(file))
+}
+
+// Discard the activation codes expired at 'now'.
+func (st *state) pruneCodes(now time.Time) {
+	var valid []*protov1.CampaignCode
+	for _, c := range st.Codes {
+		if c.Expires == 0 || time.Unix(c.Expires, 0).After(now) {
+			valid = append(valid, c)
+		}
+	}
+	st.Codes = valid
+}
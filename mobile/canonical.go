@@ -0,0 +1,49 @@
+// +build js,wasm
+
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+
+	"github.com/piprate/json-gold/ld"
+)
+
+// Canonicalization algorithm supported for JSON-LD documents.
+const canonicalURDNA2015 = "URDNA2015"
+
+// Remote contexts are retrieved only once.
+var ldLoader = ld.NewCachingDocumentLoader(ld.NewDefaultDocumentLoader(nil))
+
+// Return the value signed for the provided contents. When a canonicalization
+// algorithm is selected the contents are processed as a JSON-LD document and
+// the SHA-256 digest of its URDNA2015 canonical form is used, same as the
+// "SignDocumentLD" and "VerifyDocumentLD" utilities on the server.
+func signatureInput(contents, canonicalization string) ([]byte, error) {
+	switch canonicalization {
+	case "":
+		return []byte(contents), nil
+	case canonicalURDNA2015:
+	default:
+		return nil, errors.New("unsupported canonicalization algorithm")
+	}
+	var input interface{}
+	if err := json.Unmarshal([]byte(contents), &input); err != nil {
+		return nil, errors.New("invalid JSON-LD document")
+	}
+	opts := ld.NewJsonLdOptions("")
+	opts.Algorithm = canonicalURDNA2015
+	opts.Format = "application/n-quads"
+	opts.DocumentLoader = ldLoader
+	res, err := ld.NewJsonLdProcessor().Normalize(input, opts)
+	if err != nil {
+		return nil, errors.New("failed to canonicalize document")
+	}
+	nquads, ok := res.(string)
+	if !ok || nquads == "" {
+		return nil, errors.New("document doesn't contain any linked data statements")
+	}
+	digest := sha256.Sum256([]byte(nquads))
+	return digest[:], nil
+}
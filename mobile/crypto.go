@@ -0,0 +1,144 @@
+// +build js,wasm
+
+package main
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha512"
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/mr-tron/base58"
+	"go.bryk.io/x/ccg/did"
+	"golang.org/x/crypto/nacl/box"
+)
+
+// Encryption algorithm used for end-to-end encrypted messages.
+const messageAlgorithm = "x25519-xsalsa20-poly1305"
+
+// DID key used to encrypt and decrypt messages.
+const keyAgreement = "key-agreement"
+
+// Encrypted message, as expected and returned by the server API.
+type encryptedMessage struct {
+	ID           string `json:"id,omitempty"`
+	Sender       string `json:"sender,omitempty"`
+	DID          string `json:"did"`
+	Key          string `json:"key"`
+	Algorithm    string `json:"algorithm"`
+	EphemeralKey []byte `json:"ephemeral_key"`
+	Nonce        []byte `json:"nonce"`
+	Ciphertext   []byte `json:"ciphertext"`
+	Created      int64  `json:"created,string,omitempty"`
+}
+
+// Seal 'contents' for the key-agreement key of the DID 'id', using a
+// single-use X25519 key.
+func encryptMessage(id *did.Identifier, contents []byte) (*encryptedMessage, error) {
+	key := id.Key(keyAgreement)
+	if key == nil {
+		return nil, errors.New("the recipient has no key-agreement key")
+	}
+	pub, err := base58.Decode(key.ValueBase58)
+	if err != nil || len(pub) != ed25519.PublicKeySize {
+		return nil, errors.New("invalid key-agreement key")
+	}
+	peer, err := x25519Public(pub)
+	if err != nil {
+		return nil, err
+	}
+	ephPub, ephPriv, err := box.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	var nonce [24]byte
+	if _, err := rand.Read(nonce[:]); err != nil {
+		return nil, err
+	}
+	return &encryptedMessage{
+		DID:          strings.SplitN(key.ID, "#", 2)[0],
+		Key:          key.ID,
+		Algorithm:    messageAlgorithm,
+		EphemeralKey: ephPub[:],
+		Nonce:        nonce[:],
+		Ciphertext:   box.Seal(nil, contents, &nonce, peer, ephPriv),
+	}, nil
+}
+
+// Open a message sealed for the key-agreement key of the DID 'id'. The
+// private key must be available.
+func decryptMessage(id *did.Identifier, msg *encryptedMessage) ([]byte, error) {
+	if msg.Algorithm != messageAlgorithm {
+		return nil, errors.New("unsupported algorithm")
+	}
+	key := id.Key(keyAgreement)
+	if key == nil || len(key.Private) == 0 {
+		return nil, errors.New("no private key-agreement key available")
+	}
+	if len(msg.EphemeralKey) != 32 || len(msg.Nonce) != 24 {
+		return nil, errors.New("invalid message")
+	}
+	var peer [32]byte
+	var nonce [24]byte
+	copy(peer[:], msg.EphemeralKey)
+	copy(nonce[:], msg.Nonce)
+	contents, ok := box.Open(nil, msg.Ciphertext, &nonce, &peer, x25519Private(key.Private))
+	if !ok {
+		return nil, errors.New("failed to decrypt message")
+	}
+	return contents, nil
+}
+
+// Convert an Ed25519 private key, or its seed, to the corresponding X25519
+// private key, as described on RFC 8032 section 5.1.5.
+func x25519Private(key []byte) *[32]byte {
+	seed := key
+	if len(key) == ed25519.PrivateKeySize {
+		seed = ed25519.PrivateKey(key).Seed()
+	}
+	h := sha512.Sum512(seed)
+	var priv [32]byte
+	copy(priv[:], h[:32])
+	priv[0] &= 248
+	priv[31] &= 127
+	priv[31] |= 64
+	return &priv
+}
+
+// Convert an Ed25519 public key to the corresponding X25519 public key,
+// using the birational map u = (1 + y) / (1 - y) described on RFC 7748.
+func x25519Public(key []byte) (*[32]byte, error) {
+	p := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 255), big.NewInt(19))
+
+	// Decode 'y' from its little-endian representation, ignoring the sign
+	// bit of 'x'
+	le := make([]byte, 32)
+	for i := range key {
+		le[31-i] = key[i]
+	}
+	le[0] &= 0x7f
+	y := new(big.Int).SetBytes(le)
+	if y.Cmp(p) >= 0 {
+		return nil, errors.New("invalid public key")
+	}
+
+	one := big.NewInt(1)
+	den := new(big.Int).Sub(one, y)
+	den.Mod(den, p)
+	if den.Sign() == 0 {
+		return nil, errors.New("invalid public key")
+	}
+	u := new(big.Int).Add(one, y)
+	u.Mul(u, den.ModInverse(den, p))
+	u.Mod(u, p)
+
+	// Encode 'u' as little-endian
+	var pub [32]byte
+	be := u.Bytes()
+	for i := range be {
+		pub[i] = be[len(be)-1-i]
+	}
+	return &pub, nil
+}
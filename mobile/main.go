@@ -12,6 +12,9 @@ import (
 	"go.bryk.io/x/ccg/did"
 )
 
+// Domain used on DID document proofs when not provided by the caller.
+const defaultProofDomain = "sample-ct19.iadb.org"
+
 // Restore DID instance from its document
 func loadDID(contents string) (*did.Identifier, error) {
 	// Get DID from document
@@ -39,24 +42,34 @@ func encodeError(err error) interface{} {
 // JSON-encoded document.
 // Parameters:
 // - method (string)
+// - proof domain (string, optional)
 func CreateDID(this js.Value, args []js.Value) interface{} {
 	// Get parameters
 	if len(args) == 0 {
 		return encodeError(errors.New("missing required parameters"))
 	}
 	method := args[0].String()
+	domain := defaultProofDomain
+	if len(args) > 1 && args[1].Type() == js.TypeString && args[1].String() != "" {
+		domain = args[1].String()
+	}
 
-	// Generate DID of requested method, add an Ed25519 master
-	// key authentication key and prepare a document proof
-	var err error
-	id, _ := did.NewIdentifierWithMode(method, "", did.ModeUUID)
-	if err = id.AddNewKey("master", did.KeyTypeEd, did.EncodingBase58); err != nil {
+	// Generate DID of requested method, add an Ed25519 key for each
+	// purpose, use the master key for authentication and prepare a
+	// document proof
+	id, err := did.NewIdentifierWithMode(method, "", did.ModeUUID)
+	if err != nil {
 		return encodeError(err)
 	}
+	for _, k := range []string{"master", "assertion", keyAgreement} {
+		if err = id.AddNewKey(k, did.KeyTypeEd, did.EncodingBase58); err != nil {
+			return encodeError(err)
+		}
+	}
 	if err = id.AddAuthenticationKey("master"); err != nil {
 		return encodeError(err)
 	}
-	if err = id.AddProof("master", "sample-ct19.iadb.org"); err != nil {
+	if err = id.AddProof("master", domain); err != nil {
 		return encodeError(err)
 	}
 
@@ -109,14 +122,19 @@ func PublishRequest(this js.Value, args []js.Value) interface{} {
 // - did document (string)
 // - contents to sign (string)
 // - domain value (string)
+// - canonicalization algorithm (string, optional, "URDNA2015" for JSON-LD)
 func GetSignatureLD(this js.Value, args []js.Value) interface{} {
 	// Get parameters
-	if len(args) != 3 {
+	if len(args) < 3 {
 		return encodeError(errors.New("missing required parameters"))
 	}
 	doc := args[0].String()
 	contents := args[1].String()
 	domain := args[2].String()
+	canonicalization := ""
+	if len(args) > 3 && args[3].Type() == js.TypeString {
+		canonicalization = args[3].String()
+	}
 
 	// Get DID from document
 	id, err := loadDID(doc)
@@ -124,8 +142,12 @@ func GetSignatureLD(this js.Value, args []js.Value) interface{} {
 		return encodeError(err)
 	}
 
+	input, err := signatureInput(contents, canonicalization)
+	if err != nil {
+		return encodeError(err)
+	}
 	key := id.Key("master")
-	signature, err := key.ProduceSignatureLD([]byte(contents), domain)
+	signature, err := key.ProduceSignatureLD(input, domain)
 	if err != nil {
 		return encodeError(err)
 	}
@@ -135,11 +157,116 @@ func GetSignatureLD(this js.Value, args []js.Value) interface{} {
 	return js.ValueOf(fmt.Sprintf("%s", output)).String()
 }
 
+// Verify a signature LD document. Returns the "valid" property set to true
+// if the signature was produced by one of the DID keys.
+// Parameters:
+// - did document (string)
+// - signed contents (string)
+// - JSON-encoded signature LD document (string)
+// - canonicalization algorithm (string, optional)
+func VerifySignatureLD(this js.Value, args []js.Value) interface{} {
+	// Get parameters
+	if len(args) < 3 {
+		return encodeError(errors.New("missing required parameters"))
+	}
+	doc := args[0].String()
+	contents := args[1].String()
+	signature := &did.SignatureLD{}
+	if err := json.Unmarshal([]byte(args[2].String()), signature); err != nil {
+		return encodeError(errors.New("invalid signature document"))
+	}
+	canonicalization := ""
+	if len(args) > 3 && args[3].Type() == js.TypeString {
+		canonicalization = args[3].String()
+	}
+
+	// Get DID from document
+	id, err := loadDID(doc)
+	if err != nil {
+		return encodeError(err)
+	}
+
+	input, err := signatureInput(contents, canonicalization)
+	if err != nil {
+		return encodeError(err)
+	}
+	key := id.Key(signature.Creator)
+	valid := key != nil && key.VerifySignatureLD(input, signature)
+
+	// Return JSON-encoded result
+	output, _ := json.MarshalIndent(map[string]bool{"valid": valid}, "", "  ")
+	return js.ValueOf(fmt.Sprintf("%s", output)).String()
+}
+
+// Encrypt a message for a user, using the key-agreement key of its DID.
+// Returns the JSON-encoded encrypted message, as expected by the server.
+// Parameters:
+// - recipient did document (string)
+// - contents to encrypt (string)
+func EncryptFor(this js.Value, args []js.Value) interface{} {
+	// Get parameters
+	if len(args) != 2 {
+		return encodeError(errors.New("missing required parameters"))
+	}
+	doc := args[0].String()
+	contents := args[1].String()
+
+	// Get DID from document
+	id, err := loadDID(doc)
+	if err != nil {
+		return encodeError(err)
+	}
+
+	msg, err := encryptMessage(id, []byte(contents))
+	if err != nil {
+		return encodeError(err)
+	}
+
+	// Return JSON-encoded message
+	output, _ := json.MarshalIndent(msg, "", "  ")
+	return js.ValueOf(fmt.Sprintf("%s", output)).String()
+}
+
+// Decrypt a message received, using the key-agreement key of the DID.
+// Returns the message contents on the "contents" property.
+// Parameters:
+// - did document (string)
+// - JSON-encoded encrypted message (string)
+func DecryptFrom(this js.Value, args []js.Value) interface{} {
+	// Get parameters
+	if len(args) != 2 {
+		return encodeError(errors.New("missing required parameters"))
+	}
+	doc := args[0].String()
+	msg := &encryptedMessage{}
+	if err := json.Unmarshal([]byte(args[1].String()), msg); err != nil {
+		return encodeError(errors.New("invalid message"))
+	}
+
+	// Get DID from document
+	id, err := loadDID(doc)
+	if err != nil {
+		return encodeError(err)
+	}
+
+	contents, err := decryptMessage(id, msg)
+	if err != nil {
+		return encodeError(err)
+	}
+
+	// Return JSON-encoded contents
+	output, _ := json.MarshalIndent(map[string]string{"contents": string(contents)}, "", "  ")
+	return js.ValueOf(fmt.Sprintf("%s", output)).String()
+}
+
 func main() {
 	// Register "exported" methods
 	js.Global().Set("createDID", js.FuncOf(CreateDID))
 	js.Global().Set("publishRequest", js.FuncOf(PublishRequest))
 	js.Global().Set("signatureLD", js.FuncOf(GetSignatureLD))
+	js.Global().Set("verifySignatureLD", js.FuncOf(VerifySignatureLD))
+	js.Global().Set("encryptFor", js.FuncOf(EncryptFor))
+	js.Global().Set("decryptFrom", js.FuncOf(DecryptFrom))
 
 	// Block and prevent program to exit
 	select {}
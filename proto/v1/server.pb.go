@@ -9,6 +9,7 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -43,10 +44,17 @@ type LocationRecord struct {
 	Hash string `protobuf:"bytes,6,opt,name=hash,proto3" json:"hash,omitempty"`
 	// LD document containing a cryptographic proof for the record obtained
 	// when signing its corresponding hash.
-	Proof                []byte   `protobuf:"bytes,7,opt,name=proof,proto3" json:"proof,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Proof []byte `protobuf:"bytes,7,opt,name=proof,proto3" json:"proof,omitempty"`
+	// Generalized record, only used for analytics and never linked to
+	// exposures. Coordinates must be rounded to 2 decimal places, the
+	// timestamp truncated to the hour and the altitude omitted.
+	AggregateOnly bool `protobuf:"varint,8,opt,name=aggregate_only,json=aggregateOnly,proto3" json:"aggregate_only,omitempty"`
+	// Client application that submitted the record; set by the server based
+	// on the request details.
+	Client               *ClientInfo `protobuf:"bytes,9,opt,name=client,proto3" json:"client,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *LocationRecord) Reset()      { *m = LocationRecord{} }
@@ -130,412 +138,6751 @@ func (m *LocationRecord) GetProof() []byte {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*LocationRecord)(nil), "bryk.covid.proto.v1.LocationRecord")
+func (m *LocationRecord) GetAggregateOnly() bool {
+	if m != nil {
+		return m.AggregateOnly
+	}
+	return false
 }
 
-func init() { proto.RegisterFile("proto/v1/server.proto", fileDescriptor_84c2b3ce2e73d961) }
+func (m *LocationRecord) GetClient() *ClientInfo {
+	if m != nil {
+		return m.Client
+	}
+	return nil
+}
 
-var fileDescriptor_84c2b3ce2e73d961 = []byte{
-	// 318 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xbd, 0x4a, 0xc3, 0x50,
-	0x14, 0xc7, 0x3d, 0x49, 0x3f, 0xe8, 0x55, 0x44, 0x52, 0x95, 0x8b, 0xc8, 0x25, 0x38, 0x65, 0x31,
-	0x21, 0xf8, 0x06, 0xe9, 0xea, 0x50, 0x22, 0x74, 0x90, 0x2e, 0xf9, 0x6a, 0x1a, 0x6c, 0x7b, 0x4a,
-	0x72, 0x1b, 0x70, 0xf3, 0x09, 0x7c, 0x06, 0x71, 0x12, 0x9f, 0xc0, 0xd1, 0x51, 0x9c, 0x1c, 0x1d,
-	0x9b, 0xfb, 0x04, 0x8e, 0x8e, 0x72, 0x4f, 0x04, 0x17, 0xb7, 0xff, 0xff, 0x77, 0xee, 0xef, 0x1e,
-	0x38, 0xec, 0x68, 0x5d, 0xa2, 0x44, 0xaf, 0xf6, 0xbd, 0x2a, 0x2b, 0xeb, 0xac, 0x74, 0xa9, 0x5b,
-	0xc3, 0xb8, 0xbc, 0xbd, 0x71, 0x13, 0xac, 0x8b, 0xb4, 0x25, 0x6e, 0xed, 0x9f, 0x9c, 0xe7, 0x85,
-	0x9c, 0x6f, 0x62, 0x37, 0xc1, 0xa5, 0x97, 0x63, 0x8e, 0x1e, 0x4d, 0xe2, 0xcd, 0x8c, 0x5a, 0xfb,
-	0x91, 0x4e, 0xad, 0x71, 0xf6, 0x00, 0x6c, 0xff, 0x12, 0x93, 0x48, 0x16, 0xb8, 0x0a, 0xb3, 0x04,
-	0xcb, 0xd4, 0x3a, 0x60, 0x66, 0x5a, 0xa4, 0x1c, 0x6c, 0x70, 0x06, 0xa1, 0x8e, 0x9a, 0x2c, 0x22,
-	0xc9, 0x0d, 0x1b, 0x1c, 0x23, 0xd4, 0x91, 0xc8, 0x2a, 0xe7, 0xe6, 0x2f, 0x59, 0xe5, 0x9a, 0x44,
-	0x0b, 0xc9, 0x3b, 0x2d, 0x89, 0x16, 0xd2, 0x3a, 0x65, 0x03, 0x59, 0x2c, 0xb3, 0x4a, 0x46, 0xcb,
-	0x35, 0xef, 0xda, 0xe0, 0x98, 0xe1, 0x1f, 0xb0, 0x2c, 0xd6, 0x99, 0x47, 0xd5, 0x9c, 0xf7, 0x68,
-	0x0d, 0x65, 0xeb, 0x90, 0x75, 0xd7, 0x25, 0xe2, 0x8c, 0xf7, 0x6d, 0x70, 0xf6, 0xc2, 0xb6, 0x04,
-	0xf7, 0xf0, 0xd9, 0x88, 0x9d, 0x6d, 0x23, 0xe0, 0xab, 0x11, 0xf0, 0xdd, 0x08, 0xb8, 0x53, 0x02,
-	0x9e, 0x94, 0x80, 0x17, 0x25, 0xe0, 0x55, 0x09, 0x78, 0x53, 0x02, 0x3e, 0x94, 0x80, 0xad, 0x12,
-	0xc0, 0x8e, 0x0b, 0x74, 0xff, 0x39, 0x4c, 0xb0, 0x7b, 0x45, 0xb7, 0x1b, 0xeb, 0x3e, 0x86, 0xeb,
-	0x3e, 0x0d, 0x6a, 0xff, 0xd1, 0x30, 0x83, 0xd1, 0xf8, 0xd9, 0x18, 0x06, 0xda, 0x19, 0x91, 0x43,
-	0x6f, 0xdc, 0x89, 0xff, 0xde, 0xd2, 0x29, 0xd1, 0x29, 0xd1, 0xe9, 0xc4, 0x8f, 0x7b, 0xa4, 0x5e,
-	0xfc, 0x04, 0x00, 0x00, 0xff, 0xff, 0xf3, 0x82, 0xa4, 0xc1, 0x97, 0x01, 0x00, 0x00,
+// Client application and SDK versions used to submit data.
+type ClientInfo struct {
+	// Application version, i.e. "1.4.2".
+	AppVersion string `protobuf:"bytes,1,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+	// SDK version, i.e. "0.9.0".
+	SdkVersion string `protobuf:"bytes,2,opt,name=sdk_version,json=sdkVersion,proto3" json:"sdk_version,omitempty"`
+	// Device platform, i.e. "android" or "ios".
+	Platform             string   `protobuf:"bytes,3,opt,name=platform,proto3" json:"platform,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (this *LocationRecord) VerboseEqual(that interface{}) error {
-	if that == nil {
-		if this == nil {
-			return nil
+func (m *ClientInfo) Reset()      { *m = ClientInfo{} }
+func (*ClientInfo) ProtoMessage() {}
+func (*ClientInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c2b3ce2e73d961, []int{1}
+}
+func (m *ClientInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClientInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClientInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		return fmt.Errorf("that == nil && this != nil")
+		return b[:n], nil
 	}
+}
+func (m *ClientInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientInfo.Merge(m, src)
+}
+func (m *ClientInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClientInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientInfo.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*LocationRecord)
-	if !ok {
-		that2, ok := that.(LocationRecord)
-		if ok {
-			that1 = &that2
-		} else {
-			return fmt.Errorf("that is not of type *LocationRecord")
-		}
+var xxx_messageInfo_ClientInfo proto.InternalMessageInfo
+
+func (m *ClientInfo) GetAppVersion() string {
+	if m != nil {
+		return m.AppVersion
 	}
-	if that1 == nil {
-		if this == nil {
-			return nil
-		}
-		return fmt.Errorf("that is type *LocationRecord but is nil && this != nil")
-	} else if this == nil {
-		return fmt.Errorf("that is type *LocationRecord but is not nil && this == nil")
+	return ""
+}
+
+func (m *ClientInfo) GetSdkVersion() string {
+	if m != nil {
+		return m.SdkVersion
 	}
-	if this.Did != that1.Did {
-		return fmt.Errorf("Did this(%v) Not Equal that(%v)", this.Did, that1.Did)
+	return ""
+}
+
+func (m *ClientInfo) GetPlatform() string {
+	if m != nil {
+		return m.Platform
 	}
-	if this.Lat != that1.Lat {
-		return fmt.Errorf("Lat this(%v) Not Equal that(%v)", this.Lat, that1.Lat)
+	return ""
+}
+
+// Physical location registered by an agent where users can check-in.
+type Venue struct {
+	// Unique identifier.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Display name.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Latitude.
+	Lat float32 `protobuf:"fixed32,3,opt,name=lat,proto3" json:"lat,omitempty"`
+	// Longitude.
+	Lng float32 `protobuf:"fixed32,4,opt,name=lng,proto3" json:"lng,omitempty"`
+	// Contents to be encoded as a QR code at the venue's entrance.
+	QrCode string `protobuf:"bytes,5,opt,name=qr_code,json=qrCode,proto3" json:"qr_code,omitempty"`
+	// DID of the agent that registered the venue.
+	Owner string `protobuf:"bytes,6,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Creation date (in seconds and for UTC).
+	Created              int64    `protobuf:"varint,7,opt,name=created,proto3" json:"created,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Venue) Reset()      { *m = Venue{} }
+func (*Venue) ProtoMessage() {}
+func (*Venue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c2b3ce2e73d961, []int{2}
+}
+func (m *Venue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Venue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Venue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.Lng != that1.Lng {
-		return fmt.Errorf("Lng this(%v) Not Equal that(%v)", this.Lng, that1.Lng)
+}
+func (m *Venue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Venue.Merge(m, src)
+}
+func (m *Venue) XXX_Size() int {
+	return m.Size()
+}
+func (m *Venue) XXX_DiscardUnknown() {
+	xxx_messageInfo_Venue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Venue proto.InternalMessageInfo
+
+func (m *Venue) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	if this.Alt != that1.Alt {
-		return fmt.Errorf("Alt this(%v) Not Equal that(%v)", this.Alt, that1.Alt)
+	return ""
+}
+
+func (m *Venue) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if this.Timestamp != that1.Timestamp {
-		return fmt.Errorf("Timestamp this(%v) Not Equal that(%v)", this.Timestamp, that1.Timestamp)
+	return ""
+}
+
+func (m *Venue) GetLat() float32 {
+	if m != nil {
+		return m.Lat
 	}
-	if this.Hash != that1.Hash {
-		return fmt.Errorf("Hash this(%v) Not Equal that(%v)", this.Hash, that1.Hash)
+	return 0
+}
+
+func (m *Venue) GetLng() float32 {
+	if m != nil {
+		return m.Lng
 	}
-	if !bytes.Equal(this.Proof, that1.Proof) {
-		return fmt.Errorf("Proof this(%v) Not Equal that(%v)", this.Proof, that1.Proof)
+	return 0
+}
+
+func (m *Venue) GetQrCode() string {
+	if m != nil {
+		return m.QrCode
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	return ""
+}
+
+func (m *Venue) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return nil
+	return ""
 }
-func (this *LocationRecord) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *Venue) GetCreated() int64 {
+	if m != nil {
+		return m.Created
 	}
+	return 0
+}
 
-	that1, ok := that.(*LocationRecord)
-	if !ok {
-		that2, ok := that.(LocationRecord)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// Group of agents representing the same entity, like a hospital or a
+// municipality.
+type Organization struct {
+	// Unique identifier.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Display name.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Kind of entity, i.e. "hospital" or "municipality".
+	Kind string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Geohash prefixes covering the areas under the organization's
+	// jurisdiction. Members can only access data within these areas. If
+	// empty, no restrictions are applied.
+	Jurisdiction []string `protobuf:"bytes,4,rep,name=jurisdiction,proto3" json:"jurisdiction,omitempty"`
+	// Additional permissions granted to members, in the form
+	// "resource:action".
+	Permissions []string `protobuf:"bytes,5,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	// Creation date (in seconds and for UTC).
+	Created              int64    `protobuf:"varint,6,opt,name=created,proto3" json:"created,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Organization) Reset()      { *m = Organization{} }
+func (*Organization) ProtoMessage() {}
+func (*Organization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c2b3ce2e73d961, []int{3}
+}
+func (m *Organization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Organization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Organization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Did != that1.Did {
-		return false
-	}
-	if this.Lat != that1.Lat {
-		return false
-	}
-	if this.Lng != that1.Lng {
-		return false
-	}
-	if this.Alt != that1.Alt {
-		return false
-	}
-	if this.Timestamp != that1.Timestamp {
-		return false
-	}
-	if this.Hash != that1.Hash {
-		return false
-	}
-	if !bytes.Equal(this.Proof, that1.Proof) {
-		return false
+}
+func (m *Organization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Organization.Merge(m, src)
+}
+func (m *Organization) XXX_Size() int {
+	return m.Size()
+}
+func (m *Organization) XXX_DiscardUnknown() {
+	xxx_messageInfo_Organization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Organization proto.InternalMessageInfo
+
+func (m *Organization) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
+	return ""
+}
+
+func (m *Organization) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return true
+	return ""
 }
-func (this *LocationRecord) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *Organization) GetKind() string {
+	if m != nil {
+		return m.Kind
 	}
-	s := make([]string, 0, 11)
-	s = append(s, "&protov1.LocationRecord{")
-	s = append(s, "Did: "+fmt.Sprintf("%#v", this.Did)+",\n")
-	s = append(s, "Lat: "+fmt.Sprintf("%#v", this.Lat)+",\n")
-	s = append(s, "Lng: "+fmt.Sprintf("%#v", this.Lng)+",\n")
-	s = append(s, "Alt: "+fmt.Sprintf("%#v", this.Alt)+",\n")
-	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
-	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
-	s = append(s, "Proof: "+fmt.Sprintf("%#v", this.Proof)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	return ""
+}
+
+func (m *Organization) GetJurisdiction() []string {
+	if m != nil {
+		return m.Jurisdiction
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return nil
 }
-func valueToGoStringServer(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+func (m *Organization) GetPermissions() []string {
+	if m != nil {
+		return m.Permissions
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+	return nil
 }
-func (m *LocationRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *Organization) GetCreated() int64 {
+	if m != nil {
+		return m.Created
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *LocationRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Represents a user/device visit to a registered venue.
+type CheckInRecord struct {
+	// User/device identifier.
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// Venue identifier.
+	Venue string `protobuf:"bytes,2,opt,name=venue,proto3" json:"venue,omitempty"`
+	// Timestamp (in seconds and for UTC).
+	Timestamp int64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// SHA256( did|venue|timestamp ) in hex format.
+	Hash string `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
+	// LD document containing a cryptographic proof for the record obtained
+	// when signing its corresponding hash.
+	Proof                []byte   `protobuf:"bytes,5,opt,name=proof,proto3" json:"proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LocationRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *CheckInRecord) Reset()      { *m = CheckInRecord{} }
+func (*CheckInRecord) ProtoMessage() {}
+func (*CheckInRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c2b3ce2e73d961, []int{4}
+}
+func (m *CheckInRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckInRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckInRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Proof) > 0 {
-		i -= len(m.Proof)
-		copy(dAtA[i:], m.Proof)
-		i = encodeVarintServer(dAtA, i, uint64(len(m.Proof)))
-		i--
-		dAtA[i] = 0x3a
+}
+func (m *CheckInRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckInRecord.Merge(m, src)
+}
+func (m *CheckInRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckInRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckInRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckInRecord proto.InternalMessageInfo
+
+func (m *CheckInRecord) GetDid() string {
+	if m != nil {
+		return m.Did
 	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintServer(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0x32
+	return ""
+}
+
+func (m *CheckInRecord) GetVenue() string {
+	if m != nil {
+		return m.Venue
 	}
-	if m.Timestamp != 0 {
-		i = encodeVarintServer(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x28
+	return ""
+}
+
+func (m *CheckInRecord) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
 	}
-	if m.Alt != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Alt))))
-		i--
-		dAtA[i] = 0x25
+	return 0
+}
+
+func (m *CheckInRecord) GetHash() string {
+	if m != nil {
+		return m.Hash
 	}
-	if m.Lng != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Lng))))
-		i--
-		dAtA[i] = 0x1d
+	return ""
+}
+
+func (m *CheckInRecord) GetProof() []byte {
+	if m != nil {
+		return m.Proof
 	}
-	if m.Lat != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Lat))))
-		i--
-		dAtA[i] = 0x15
+	return nil
+}
+
+// Message dispatched to a user.
+type Notification struct {
+	// Unique identifier.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Recipient identifier.
+	Did string `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
+	// Notification type, i.e. "venue_outbreak".
+	Kind string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Creation date (in seconds and for UTC).
+	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Additional notification details.
+	Details map[string]string `protobuf:"bytes,5,rep,name=details,proto3" json:"details,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Localized notification title.
+	Title string `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
+	// Localized notification contents.
+	Body string `protobuf:"bytes,7,opt,name=body,proto3" json:"body,omitempty"`
+	// Language used for the notification title and contents.
+	Lang                 string   `protobuf:"bytes,8,opt,name=lang,proto3" json:"lang,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Notification) Reset()      { *m = Notification{} }
+func (*Notification) ProtoMessage() {}
+func (*Notification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c2b3ce2e73d961, []int{5}
+}
+func (m *Notification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Notification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Notification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Did) > 0 {
-		i -= len(m.Did)
-		copy(dAtA[i:], m.Did)
-		i = encodeVarintServer(dAtA, i, uint64(len(m.Did)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *Notification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Notification.Merge(m, src)
+}
+func (m *Notification) XXX_Size() int {
+	return m.Size()
+}
+func (m *Notification) XXX_DiscardUnknown() {
+	xxx_messageInfo_Notification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Notification proto.InternalMessageInfo
+
+func (m *Notification) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintServer(dAtA []byte, offset int, v uint64) int {
-	offset -= sovServer(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Notification) GetDid() string {
+	if m != nil {
+		return m.Did
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func NewPopulatedLocationRecord(r randyServer, easy bool) *LocationRecord {
-	this := &LocationRecord{}
-	this.Did = string(randStringServer(r))
-	this.Lat = float32(r.Float32())
-	if r.Intn(2) == 0 {
-		this.Lat *= -1
+
+func (m *Notification) GetKind() string {
+	if m != nil {
+		return m.Kind
 	}
-	this.Lng = float32(r.Float32())
-	if r.Intn(2) == 0 {
-		this.Lng *= -1
+	return ""
+}
+
+func (m *Notification) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
 	}
-	this.Alt = float32(r.Float32())
-	if r.Intn(2) == 0 {
-		this.Alt *= -1
+	return 0
+}
+
+func (m *Notification) GetDetails() map[string]string {
+	if m != nil {
+		return m.Details
 	}
-	this.Timestamp = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.Timestamp *= -1
+	return nil
+}
+
+func (m *Notification) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	this.Hash = string(randStringServer(r))
-	v1 := r.Intn(100)
-	this.Proof = make([]byte, v1)
-	for i := 0; i < v1; i++ {
-		this.Proof[i] = byte(r.Intn(256))
+	return ""
+}
+
+func (m *Notification) GetBody() string {
+	if m != nil {
+		return m.Body
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedServer(r, 8)
+	return ""
+}
+
+func (m *Notification) GetLang() string {
+	if m != nil {
+		return m.Lang
 	}
-	return this
+	return ""
 }
 
-type randyServer interface {
-	Float32() float32
-	Float64() float64
-	Int63() int64
-	Int31() int32
-	Uint32() uint32
-	Intn(n int) int
+// Message encrypted end-to-end to the "key-agreement" key of the recipient's
+// DID; the server only handles the ciphertext. Using the algorithm
+// "x25519-xsalsa20-poly1305", the sender seals the contents in a NaCl box
+// between a single-use X25519 key and the recipient's key, converted from
+// Ed25519 to X25519.
+type EncryptedMessage struct {
+	// Unique identifier.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// DID of the sender.
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Recipient identifier.
+	Did string `protobuf:"bytes,3,opt,name=did,proto3" json:"did,omitempty"`
+	// Identifier of the recipient's key used, i.e. "did:bryk:...#key-agreement".
+	Key string `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	// Encryption algorithm, "x25519-xsalsa20-poly1305".
+	Algorithm string `protobuf:"bytes,5,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	// Public single-use X25519 key generated by the sender.
+	EphemeralKey []byte `protobuf:"bytes,6,opt,name=ephemeral_key,json=ephemeralKey,proto3" json:"ephemeral_key,omitempty"`
+	// 24 bytes nonce.
+	Nonce []byte `protobuf:"bytes,7,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// Encrypted message contents.
+	Ciphertext []byte `protobuf:"bytes,8,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	// Creation date (in seconds and for UTC).
+	Created              int64    `protobuf:"varint,9,opt,name=created,proto3" json:"created,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func randUTF8RuneServer(r randyServer) rune {
-	ru := r.Intn(62)
-	if ru < 10 {
-		return rune(ru + 48)
-	} else if ru < 36 {
-		return rune(ru + 55)
-	}
-	return rune(ru + 61)
+func (m *EncryptedMessage) Reset()      { *m = EncryptedMessage{} }
+func (*EncryptedMessage) ProtoMessage() {}
+func (*EncryptedMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c2b3ce2e73d961, []int{6}
 }
-func randStringServer(r randyServer) string {
-	v2 := r.Intn(100)
-	tmps := make([]rune, v2)
-	for i := 0; i < v2; i++ {
-		tmps[i] = randUTF8RuneServer(r)
-	}
-	return string(tmps)
+func (m *EncryptedMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func randUnrecognizedServer(r randyServer, maxFieldNumber int) (dAtA []byte) {
-	l := r.Intn(5)
-	for i := 0; i < l; i++ {
-		wire := r.Intn(4)
-		if wire == 3 {
-			wire = 5
+func (m *EncryptedMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EncryptedMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		fieldNumber := maxFieldNumber + r.Intn(100)
-		dAtA = randFieldServer(dAtA, r, fieldNumber, wire)
+		return b[:n], nil
 	}
-	return dAtA
 }
-func randFieldServer(dAtA []byte, r randyServer, fieldNumber int, wire int) []byte {
-	key := uint32(fieldNumber)<<3 | uint32(wire)
-	switch wire {
-	case 0:
-		dAtA = encodeVarintPopulateServer(dAtA, uint64(key))
-		v3 := r.Int63()
-		if r.Intn(2) == 0 {
-			v3 *= -1
-		}
-		dAtA = encodeVarintPopulateServer(dAtA, uint64(v3))
-	case 1:
-		dAtA = encodeVarintPopulateServer(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
-	case 2:
-		dAtA = encodeVarintPopulateServer(dAtA, uint64(key))
-		ll := r.Intn(100)
-		dAtA = encodeVarintPopulateServer(dAtA, uint64(ll))
-		for j := 0; j < ll; j++ {
-			dAtA = append(dAtA, byte(r.Intn(256)))
-		}
-	default:
-		dAtA = encodeVarintPopulateServer(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+func (m *EncryptedMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncryptedMessage.Merge(m, src)
+}
+func (m *EncryptedMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *EncryptedMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncryptedMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EncryptedMessage proto.InternalMessageInfo
+
+func (m *EncryptedMessage) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA
+	return ""
 }
-func encodeVarintPopulateServer(dAtA []byte, v uint64) []byte {
-	for v >= 1<<7 {
-		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
-		v >>= 7
+
+func (m *EncryptedMessage) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	dAtA = append(dAtA, uint8(v))
-	return dAtA
+	return ""
 }
-func (m *LocationRecord) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *EncryptedMessage) GetDid() string {
+	if m != nil {
+		return m.Did
 	}
-	var l int
-	_ = l
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovServer(uint64(l))
+	return ""
+}
+
+func (m *EncryptedMessage) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	if m.Lat != 0 {
-		n += 5
+	return ""
+}
+
+func (m *EncryptedMessage) GetAlgorithm() string {
+	if m != nil {
+		return m.Algorithm
 	}
-	if m.Lng != 0 {
-		n += 5
+	return ""
+}
+
+func (m *EncryptedMessage) GetEphemeralKey() []byte {
+	if m != nil {
+		return m.EphemeralKey
 	}
-	if m.Alt != 0 {
-		n += 5
+	return nil
+}
+
+func (m *EncryptedMessage) GetNonce() []byte {
+	if m != nil {
+		return m.Nonce
 	}
-	if m.Timestamp != 0 {
-		n += 1 + sovServer(uint64(m.Timestamp))
+	return nil
+}
+
+func (m *EncryptedMessage) GetCiphertext() []byte {
+	if m != nil {
+		return m.Ciphertext
 	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovServer(uint64(l))
+	return nil
+}
+
+func (m *EncryptedMessage) GetCreated() int64 {
+	if m != nil {
+		return m.Created
 	}
-	l = len(m.Proof)
-	if l > 0 {
-		n += 1 + l + sovServer(uint64(l))
+	return 0
+}
+
+// Normalized platform event, published to the "events" exchange for
+// consumption by downstream systems.
+type Event struct {
+	// Unique identifier.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Event type, one of: "record_stored", "diagnosis_stored",
+	// "exposure_detected", "credential_issued" or "certificate_issued".
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Creation date (in seconds and for UTC).
+	Timestamp int64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Identifier of the user the event refers to.
+	Did string `protobuf:"bytes,4,opt,name=did,proto3" json:"did,omitempty"`
+	// Additional event details, specific to each event type.
+	Attributes           map[string]string `protobuf:"bytes,5,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *Event) Reset()      { *m = Event{} }
+func (*Event) ProtoMessage() {}
+func (*Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c2b3ce2e73d961, []int{7}
+}
+func (m *Event) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Event.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+}
+func (m *Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event.Merge(m, src)
+}
+func (m *Event) XXX_Size() int {
+	return m.Size()
+}
+func (m *Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Event proto.InternalMessageInfo
+
+func (m *Event) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return n
+	return ""
 }
 
-func sovServer(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *Event) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
 }
-func sozServer(x uint64) (n int) {
-	return sovServer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *Event) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
 }
-func (this *LocationRecord) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Event) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+func (m *Event) GetAttributes() map[string]string {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+// Area with a high concentration of users during a period of time.
+type Hotspot struct {
+	// Geohash cell identifier.
+	Cell string `protobuf:"bytes,1,opt,name=cell,proto3" json:"cell,omitempty"`
+	// Latitude at the center of the cell.
+	Lat float32 `protobuf:"fixed32,2,opt,name=lat,proto3" json:"lat,omitempty"`
+	// Longitude at the center of the cell.
+	Lng float32 `protobuf:"fixed32,3,opt,name=lng,proto3" json:"lng,omitempty"`
+	// Number of distinct users present in the cell.
+	Users int64 `protobuf:"varint,4,opt,name=users,proto3" json:"users,omitempty"`
+	// Beginning of the analyzed period (in seconds and for UTC).
+	From int64 `protobuf:"varint,5,opt,name=from,proto3" json:"from,omitempty"`
+	// End of the analyzed period (in seconds and for UTC).
+	To                   int64    `protobuf:"varint,6,opt,name=to,proto3" json:"to,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Hotspot) Reset()      { *m = Hotspot{} }
+func (*Hotspot) ProtoMessage() {}
+func (*Hotspot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c2b3ce2e73d961, []int{8}
+}
+func (m *Hotspot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Hotspot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Hotspot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Hotspot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Hotspot.Merge(m, src)
+}
+func (m *Hotspot) XXX_Size() int {
+	return m.Size()
+}
+func (m *Hotspot) XXX_DiscardUnknown() {
+	xxx_messageInfo_Hotspot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Hotspot proto.InternalMessageInfo
+
+func (m *Hotspot) GetCell() string {
+	if m != nil {
+		return m.Cell
+	}
+	return ""
+}
+
+func (m *Hotspot) GetLat() float32 {
+	if m != nil {
+		return m.Lat
+	}
+	return 0
+}
+
+func (m *Hotspot) GetLng() float32 {
+	if m != nil {
+		return m.Lng
+	}
+	return 0
+}
+
+func (m *Hotspot) GetUsers() int64 {
+	if m != nil {
+		return m.Users
+	}
+	return 0
+}
+
+func (m *Hotspot) GetFrom() int64 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *Hotspot) GetTo() int64 {
+	if m != nil {
+		return m.To
+	}
+	return 0
+}
+
+// Aggregated movement of users between two areas during a period of time.
+type Flow struct {
+	// Geohash cell identifier for the origin area.
+	Origin string `protobuf:"bytes,1,opt,name=origin,proto3" json:"origin,omitempty"`
+	// Geohash cell identifier for the destination area.
+	Destination string `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	// Number of distinct users moving from origin to destination.
+	Users int64 `protobuf:"varint,3,opt,name=users,proto3" json:"users,omitempty"`
+	// Beginning of the analyzed period (in seconds and for UTC).
+	From int64 `protobuf:"varint,4,opt,name=from,proto3" json:"from,omitempty"`
+	// End of the analyzed period (in seconds and for UTC).
+	To                   int64    `protobuf:"varint,5,opt,name=to,proto3" json:"to,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Flow) Reset()      { *m = Flow{} }
+func (*Flow) ProtoMessage() {}
+func (*Flow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c2b3ce2e73d961, []int{9}
+}
+func (m *Flow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Flow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Flow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Flow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Flow.Merge(m, src)
+}
+func (m *Flow) XXX_Size() int {
+	return m.Size()
+}
+func (m *Flow) XXX_DiscardUnknown() {
+	xxx_messageInfo_Flow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Flow proto.InternalMessageInfo
+
+func (m *Flow) GetOrigin() string {
+	if m != nil {
+		return m.Origin
+	}
+	return ""
+}
+
+func (m *Flow) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+func (m *Flow) GetUsers() int64 {
+	if m != nil {
+		return m.Users
+	}
+	return 0
+}
+
+func (m *Flow) GetFrom() int64 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *Flow) GetTo() int64 {
+	if m != nil {
+		return m.To
+	}
+	return 0
+}
+
+// Outcome of a SARS-CoV-2 test performed on a user.
+type Diagnosis struct {
+	// Unique identifier.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// User/device identifier.
+	Did string `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
+	// Test result, one of: "positive", "negative" or "inconclusive".
+	Result string `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	// Date of the test (in seconds and for UTC).
+	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Original resource the diagnosis was obtained from, i.e.
+	// "Observation/2c4d8b2e".
+	Source               string   `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Diagnosis) Reset()      { *m = Diagnosis{} }
+func (*Diagnosis) ProtoMessage() {}
+func (*Diagnosis) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c2b3ce2e73d961, []int{10}
+}
+func (m *Diagnosis) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Diagnosis) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Diagnosis.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Diagnosis) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Diagnosis.Merge(m, src)
+}
+func (m *Diagnosis) XXX_Size() int {
+	return m.Size()
+}
+func (m *Diagnosis) XXX_DiscardUnknown() {
+	xxx_messageInfo_Diagnosis.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Diagnosis proto.InternalMessageInfo
+
+func (m *Diagnosis) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Diagnosis) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+func (m *Diagnosis) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+func (m *Diagnosis) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *Diagnosis) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+// Potential contagion risk detected for a user.
+type Exposure struct {
+	// Unique identifier.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Identifier of the user at risk.
+	Did string `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
+	// Identifier of the diagnosis originating the exposure.
+	Diagnosis string `protobuf:"bytes,3,opt,name=diagnosis,proto3" json:"diagnosis,omitempty"`
+	// Detection date (in seconds and for UTC).
+	Timestamp            int64    `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Exposure) Reset()      { *m = Exposure{} }
+func (*Exposure) ProtoMessage() {}
+func (*Exposure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c2b3ce2e73d961, []int{11}
+}
+func (m *Exposure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Exposure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Exposure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Exposure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Exposure.Merge(m, src)
+}
+func (m *Exposure) XXX_Size() int {
+	return m.Size()
+}
+func (m *Exposure) XXX_DiscardUnknown() {
+	xxx_messageInfo_Exposure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Exposure proto.InternalMessageInfo
+
+func (m *Exposure) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Exposure) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+func (m *Exposure) GetDiagnosis() string {
+	if m != nil {
+		return m.Diagnosis
+	}
+	return ""
+}
+
+func (m *Exposure) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*LocationRecord)(nil), "bryk.covid.proto.v1.LocationRecord")
+	proto.RegisterType((*ClientInfo)(nil), "bryk.covid.proto.v1.ClientInfo")
+	proto.RegisterType((*Venue)(nil), "bryk.covid.proto.v1.Venue")
+	proto.RegisterType((*Organization)(nil), "bryk.covid.proto.v1.Organization")
+	proto.RegisterType((*CheckInRecord)(nil), "bryk.covid.proto.v1.CheckInRecord")
+	proto.RegisterType((*Notification)(nil), "bryk.covid.proto.v1.Notification")
+	proto.RegisterMapType((map[string]string)(nil), "bryk.covid.proto.v1.Notification.DetailsEntry")
+	proto.RegisterType((*EncryptedMessage)(nil), "bryk.covid.proto.v1.EncryptedMessage")
+	proto.RegisterType((*Event)(nil), "bryk.covid.proto.v1.Event")
+	proto.RegisterMapType((map[string]string)(nil), "bryk.covid.proto.v1.Event.AttributesEntry")
+	proto.RegisterType((*Hotspot)(nil), "bryk.covid.proto.v1.Hotspot")
+	proto.RegisterType((*Flow)(nil), "bryk.covid.proto.v1.Flow")
+	proto.RegisterType((*Diagnosis)(nil), "bryk.covid.proto.v1.Diagnosis")
+	proto.RegisterType((*Exposure)(nil), "bryk.covid.proto.v1.Exposure")
+}
+
+func init() { proto.RegisterFile("proto/v1/server.proto", fileDescriptor_84c2b3ce2e73d961) }
+
+var fileDescriptor_84c2b3ce2e73d961 = []byte{
+	// 1020 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0x66, 0xd6, 0x6b, 0x3b, 0xfb, 0xe2, 0x84, 0x6a, 0x1b, 0xc2, 0x2a, 0x8a, 0xb6, 0x96, 0x11,
+	0x92, 0x85, 0x84, 0xa3, 0x94, 0x03, 0xa8, 0x12, 0x07, 0xe2, 0x06, 0xb5, 0xfc, 0x6a, 0xb4, 0x48,
+	0x39, 0xa0, 0x48, 0xd1, 0x78, 0x77, 0xbc, 0x9e, 0x7a, 0x3d, 0xb3, 0x9d, 0x19, 0xbb, 0x75, 0x7b,
+	0xa0, 0x7f, 0x01, 0x67, 0xce, 0x1c, 0x2a, 0xc4, 0x5f, 0xc0, 0x91, 0x23, 0xe2, 0xc4, 0x91, 0x63,
+	0xe3, 0x03, 0x67, 0xb8, 0x71, 0x44, 0x33, 0x3b, 0xeb, 0x1f, 0x8d, 0x03, 0xe9, 0xed, 0x7d, 0xdf,
+	0xbc, 0x37, 0xef, 0xcd, 0xe7, 0x6f, 0x66, 0x0d, 0x6f, 0xe5, 0x82, 0x2b, 0x7e, 0x30, 0x39, 0x3c,
+	0x90, 0x44, 0x4c, 0x88, 0xe8, 0x18, 0xec, 0xdf, 0xec, 0x89, 0xe9, 0xb0, 0x13, 0xf3, 0x09, 0x4d,
+	0x0a, 0xa6, 0x33, 0x39, 0xdc, 0x7b, 0x3f, 0xa5, 0x6a, 0x30, 0xee, 0x75, 0x62, 0x3e, 0x3a, 0x48,
+	0x79, 0xca, 0x0f, 0xcc, 0x4a, 0x6f, 0xdc, 0x37, 0xa8, 0xd8, 0x48, 0x47, 0x45, 0x45, 0xeb, 0xb9,
+	0x03, 0xdb, 0x5f, 0xf0, 0x18, 0x2b, 0xca, 0x59, 0x44, 0x62, 0x2e, 0x12, 0xff, 0x06, 0x54, 0x12,
+	0x9a, 0x04, 0xa8, 0x89, 0xda, 0x5e, 0xa4, 0x43, 0xcd, 0x64, 0x58, 0x05, 0x4e, 0x13, 0xb5, 0x9d,
+	0x48, 0x87, 0x86, 0x61, 0x69, 0x50, 0xb1, 0x0c, 0x4b, 0x35, 0x83, 0x33, 0x15, 0xb8, 0x05, 0x83,
+	0x33, 0xe5, 0xef, 0x83, 0xa7, 0xe8, 0x88, 0x48, 0x85, 0x47, 0x79, 0x50, 0x6d, 0xa2, 0x76, 0x25,
+	0x5a, 0x10, 0xbe, 0x0f, 0xee, 0x00, 0xcb, 0x41, 0x50, 0x33, 0x6d, 0x4c, 0xec, 0xef, 0x40, 0x35,
+	0x17, 0x9c, 0xf7, 0x83, 0x7a, 0x13, 0xb5, 0x1b, 0x51, 0x01, 0xfc, 0x77, 0x61, 0x1b, 0xa7, 0xa9,
+	0x20, 0x29, 0x56, 0xe4, 0x9c, 0xb3, 0x6c, 0x1a, 0x6c, 0x34, 0x51, 0x7b, 0x23, 0xda, 0x9a, 0xb3,
+	0x0f, 0x58, 0x36, 0xf5, 0x3f, 0x84, 0x5a, 0x9c, 0x51, 0xc2, 0x54, 0xe0, 0x35, 0x51, 0x7b, 0xf3,
+	0xf6, 0xad, 0xce, 0x1a, 0x79, 0x3a, 0x5d, 0x93, 0x72, 0x9f, 0xf5, 0x79, 0x64, 0xd3, 0x5b, 0x0f,
+	0x01, 0x16, 0xac, 0x7f, 0x0b, 0x36, 0x71, 0x9e, 0x9f, 0x4f, 0x88, 0x90, 0x94, 0x33, 0xab, 0x02,
+	0xe0, 0x3c, 0x3f, 0x2d, 0x18, 0x9d, 0x20, 0x93, 0xe1, 0x3c, 0xc1, 0x29, 0x12, 0x64, 0x32, 0x2c,
+	0x13, 0xf6, 0x60, 0x23, 0xcf, 0xb0, 0xea, 0x73, 0x31, 0x32, 0x02, 0x79, 0xd1, 0x1c, 0xb7, 0xbe,
+	0x47, 0x50, 0x3d, 0x25, 0x6c, 0x4c, 0xfc, 0x6d, 0x70, 0xe6, 0x22, 0x3b, 0x34, 0xd1, 0x7a, 0x30,
+	0x3c, 0x22, 0x76, 0x3f, 0x13, 0x97, 0xba, 0x57, 0x2e, 0xe9, 0xee, 0x2e, 0x74, 0x7f, 0x1b, 0xea,
+	0x8f, 0xc4, 0x79, 0xcc, 0x13, 0x62, 0x34, 0xf6, 0xa2, 0xda, 0x23, 0xd1, 0xe5, 0x09, 0xd1, 0x62,
+	0xf2, 0xc7, 0x8c, 0x08, 0xab, 0x70, 0x01, 0xfc, 0x00, 0xea, 0xb1, 0x20, 0x58, 0x91, 0xc4, 0x88,
+	0x5c, 0x89, 0x4a, 0xd8, 0x7a, 0x81, 0xa0, 0xf1, 0x40, 0xa4, 0x98, 0xd1, 0xa7, 0xc6, 0x0d, 0xd7,
+	0x9a, 0xd0, 0x07, 0x77, 0x48, 0x59, 0x62, 0xcf, 0x69, 0x62, 0xbf, 0x05, 0x8d, 0x87, 0x63, 0x41,
+	0x65, 0x42, 0x63, 0xbd, 0x4f, 0xe0, 0x36, 0x2b, 0x6d, 0x2f, 0x5a, 0xe1, 0xfc, 0x26, 0x6c, 0xe6,
+	0x44, 0x8c, 0xa8, 0xd4, 0x8a, 0xc9, 0xa0, 0x6a, 0x52, 0x96, 0xa9, 0xe5, 0x41, 0x6b, 0xab, 0x83,
+	0x7e, 0x0b, 0x5b, 0xdd, 0x01, 0x89, 0x87, 0xf7, 0xaf, 0x36, 0xec, 0x0e, 0x54, 0x27, 0x5a, 0x65,
+	0x3b, 0x6b, 0x01, 0x56, 0x0d, 0x59, 0xb9, 0xca, 0x90, 0xee, 0x3a, 0x43, 0x56, 0x97, 0x0c, 0xd9,
+	0x7a, 0xe1, 0x40, 0xe3, 0x2b, 0xae, 0x68, 0x9f, 0xc6, 0xeb, 0x95, 0xb2, 0x03, 0x39, 0x8b, 0x81,
+	0xd6, 0xe9, 0xb4, 0x32, 0x8e, 0xfb, 0xea, 0x38, 0xf7, 0xa0, 0x9e, 0x10, 0x85, 0x69, 0x56, 0xa8,
+	0xb3, 0x79, 0xbb, 0xb3, 0xd6, 0xcf, 0xcb, 0x73, 0x74, 0xee, 0x16, 0x05, 0xc7, 0x4c, 0x89, 0x69,
+	0x54, 0x96, 0xeb, 0x43, 0x28, 0xaa, 0x32, 0x52, 0x1a, 0xc1, 0x00, 0x3d, 0x51, 0x8f, 0x27, 0x53,
+	0xe3, 0x02, 0x2f, 0x32, 0xb1, 0xe6, 0x32, 0xcc, 0x52, 0x73, 0xbf, 0xbc, 0xc8, 0xc4, 0x7b, 0x77,
+	0xa0, 0xb1, 0xbc, 0xad, 0x3e, 0xdb, 0x90, 0x4c, 0x4b, 0xb1, 0x87, 0x64, 0x6a, 0xc4, 0xc6, 0xd9,
+	0x92, 0xd8, 0x1a, 0xdc, 0x71, 0x3e, 0x42, 0xad, 0xbf, 0x11, 0xdc, 0x38, 0x66, 0xb1, 0x98, 0xe6,
+	0x8a, 0x24, 0x5f, 0x12, 0x29, 0x71, 0x7a, 0xd9, 0xf8, 0xbb, 0x50, 0x93, 0x84, 0x25, 0x44, 0xd8,
+	0x7a, 0x8b, 0x4a, 0x11, 0x2b, 0x2b, 0xcf, 0x90, 0x6e, 0xed, 0x2e, 0x5a, 0xef, 0x83, 0x87, 0xb3,
+	0x94, 0x0b, 0xaa, 0x06, 0x23, 0x6b, 0xff, 0x05, 0xe1, 0xbf, 0x03, 0x5b, 0x24, 0x1f, 0x90, 0x11,
+	0x11, 0x38, 0x3b, 0xd7, 0x95, 0x35, 0xf3, 0x2b, 0x36, 0xe6, 0xe4, 0xe7, 0xc5, 0xf4, 0x8c, 0xb3,
+	0x98, 0x94, 0x6f, 0x8e, 0x01, 0x7e, 0x08, 0x10, 0xd3, 0x7c, 0x40, 0x84, 0x22, 0x4f, 0x94, 0xd1,
+	0xa3, 0x11, 0x2d, 0x31, 0xcb, 0xee, 0xf4, 0x56, 0xdd, 0xf9, 0x27, 0x82, 0xea, 0xf1, 0x84, 0x30,
+	0xb5, 0xee, 0xfe, 0x18, 0x0f, 0x38, 0x57, 0x79, 0xe0, 0x92, 0x25, 0xad, 0x04, 0xee, 0x42, 0x82,
+	0xcf, 0x00, 0xb0, 0x52, 0x82, 0xf6, 0xc6, 0x8a, 0x94, 0xc6, 0x78, 0x6f, 0xad, 0x31, 0xcc, 0x0c,
+	0x9d, 0x4f, 0xe6, 0xc9, 0x85, 0x29, 0x96, 0xaa, 0xf7, 0x3e, 0x86, 0x37, 0x5f, 0x59, 0x7e, 0xad,
+	0x1f, 0xf7, 0x19, 0xd4, 0xef, 0x71, 0x25, 0x73, 0xae, 0xf4, 0xc9, 0x62, 0x92, 0x65, 0xb6, 0xce,
+	0xc4, 0xd7, 0xfa, 0x66, 0xec, 0x40, 0x75, 0x2c, 0x89, 0x90, 0xd6, 0xfd, 0x05, 0xd0, 0xbb, 0xf5,
+	0x05, 0x1f, 0xd9, 0x4f, 0x86, 0x89, 0xb5, 0x96, 0x8a, 0xdb, 0x87, 0xc0, 0x51, 0xbc, 0xf5, 0x14,
+	0xdc, 0x4f, 0x33, 0xfe, 0x58, 0x9b, 0x87, 0x0b, 0x9a, 0xd2, 0xf2, 0xa1, 0xb6, 0x48, 0xbf, 0x2f,
+	0x09, 0x91, 0x8a, 0x32, 0x73, 0x31, 0xec, 0xf0, 0xcb, 0xd4, 0xa2, 0x77, 0x65, 0x5d, 0x6f, 0xf7,
+	0x52, 0xef, 0xea, 0xbc, 0xf7, 0x33, 0xf0, 0xee, 0x52, 0x9c, 0x32, 0x2e, 0xa9, 0xbc, 0xc6, 0xd5,
+	0xdf, 0x85, 0x9a, 0x20, 0x72, 0x9c, 0x29, 0x6b, 0x65, 0x8b, 0xfe, 0xe7, 0xfa, 0xeb, 0x5b, 0xc1,
+	0xc7, 0x22, 0x9e, 0xbf, 0xea, 0x05, 0x6a, 0x0d, 0x60, 0xe3, 0xf8, 0x49, 0xce, 0xe5, 0x58, 0x90,
+	0x6b, 0xf4, 0xde, 0x07, 0x2f, 0x29, 0x47, 0xb5, 0xed, 0x17, 0xc4, 0x7f, 0x4f, 0x70, 0xf4, 0x1d,
+	0xfa, 0xe3, 0x22, 0x7c, 0xe3, 0xe5, 0x45, 0x88, 0xfe, 0xba, 0x08, 0xd1, 0x3f, 0x17, 0x21, 0x7a,
+	0x3e, 0x0b, 0xd1, 0x8f, 0xb3, 0x10, 0xfd, 0x3c, 0x0b, 0xd1, 0x2f, 0xb3, 0x10, 0xfd, 0x3a, 0x0b,
+	0xd1, 0xef, 0xb3, 0x10, 0xbd, 0x9c, 0x85, 0x08, 0x76, 0x29, 0x5f, 0xe7, 0xc3, 0xa3, 0xcd, 0xaf,
+	0xcd, 0x5f, 0x96, 0x13, 0x8d, 0x4f, 0xd0, 0x37, 0x75, 0xb3, 0x30, 0x39, 0xfc, 0xc1, 0xa9, 0x1c,
+	0x75, 0x4f, 0x7e, 0x72, 0x6e, 0x1e, 0xe9, 0x9a, 0xae, 0xa9, 0x31, 0x39, 0x9d, 0xd3, 0xc3, 0xdf,
+	0x0a, 0xf6, 0xcc, 0xb0, 0x67, 0x86, 0x3d, 0x3b, 0x3d, 0xec, 0xd5, 0x4c, 0xe9, 0x07, 0xff, 0x06,
+	0x00, 0x00, 0xff, 0xff, 0x8a, 0x02, 0x65, 0x99, 0x0e, 0x09, 0x00, 0x00,
+}
+
+func (this *LocationRecord) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*LocationRecord)
+	if !ok {
+		that2, ok := that.(LocationRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *LocationRecord")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *LocationRecord but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *LocationRecord but is not nil && this == nil")
+	}
+	if this.Did != that1.Did {
+		return fmt.Errorf("Did this(%v) Not Equal that(%v)", this.Did, that1.Did)
+	}
+	if this.Lat != that1.Lat {
+		return fmt.Errorf("Lat this(%v) Not Equal that(%v)", this.Lat, that1.Lat)
+	}
+	if this.Lng != that1.Lng {
+		return fmt.Errorf("Lng this(%v) Not Equal that(%v)", this.Lng, that1.Lng)
+	}
+	if this.Alt != that1.Alt {
+		return fmt.Errorf("Alt this(%v) Not Equal that(%v)", this.Alt, that1.Alt)
+	}
+	if this.Timestamp != that1.Timestamp {
+		return fmt.Errorf("Timestamp this(%v) Not Equal that(%v)", this.Timestamp, that1.Timestamp)
+	}
+	if this.Hash != that1.Hash {
+		return fmt.Errorf("Hash this(%v) Not Equal that(%v)", this.Hash, that1.Hash)
+	}
+	if !bytes.Equal(this.Proof, that1.Proof) {
+		return fmt.Errorf("Proof this(%v) Not Equal that(%v)", this.Proof, that1.Proof)
+	}
+	if this.AggregateOnly != that1.AggregateOnly {
+		return fmt.Errorf("AggregateOnly this(%v) Not Equal that(%v)", this.AggregateOnly, that1.AggregateOnly)
+	}
+	if !this.Client.Equal(that1.Client) {
+		return fmt.Errorf("Client this(%v) Not Equal that(%v)", this.Client, that1.Client)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *LocationRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LocationRecord)
+	if !ok {
+		that2, ok := that.(LocationRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Did != that1.Did {
+		return false
+	}
+	if this.Lat != that1.Lat {
+		return false
+	}
+	if this.Lng != that1.Lng {
+		return false
+	}
+	if this.Alt != that1.Alt {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	if this.Hash != that1.Hash {
+		return false
+	}
+	if !bytes.Equal(this.Proof, that1.Proof) {
+		return false
+	}
+	if this.AggregateOnly != that1.AggregateOnly {
+		return false
+	}
+	if !this.Client.Equal(that1.Client) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *ClientInfo) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*ClientInfo)
+	if !ok {
+		that2, ok := that.(ClientInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *ClientInfo")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *ClientInfo but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *ClientInfo but is not nil && this == nil")
+	}
+	if this.AppVersion != that1.AppVersion {
+		return fmt.Errorf("AppVersion this(%v) Not Equal that(%v)", this.AppVersion, that1.AppVersion)
+	}
+	if this.SdkVersion != that1.SdkVersion {
+		return fmt.Errorf("SdkVersion this(%v) Not Equal that(%v)", this.SdkVersion, that1.SdkVersion)
+	}
+	if this.Platform != that1.Platform {
+		return fmt.Errorf("Platform this(%v) Not Equal that(%v)", this.Platform, that1.Platform)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *ClientInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ClientInfo)
+	if !ok {
+		that2, ok := that.(ClientInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.AppVersion != that1.AppVersion {
+		return false
+	}
+	if this.SdkVersion != that1.SdkVersion {
+		return false
+	}
+	if this.Platform != that1.Platform {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *Venue) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*Venue)
+	if !ok {
+		that2, ok := that.(Venue)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *Venue")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *Venue but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *Venue but is not nil && this == nil")
+	}
+	if this.Id != that1.Id {
+		return fmt.Errorf("Id this(%v) Not Equal that(%v)", this.Id, that1.Id)
+	}
+	if this.Name != that1.Name {
+		return fmt.Errorf("Name this(%v) Not Equal that(%v)", this.Name, that1.Name)
+	}
+	if this.Lat != that1.Lat {
+		return fmt.Errorf("Lat this(%v) Not Equal that(%v)", this.Lat, that1.Lat)
+	}
+	if this.Lng != that1.Lng {
+		return fmt.Errorf("Lng this(%v) Not Equal that(%v)", this.Lng, that1.Lng)
+	}
+	if this.QrCode != that1.QrCode {
+		return fmt.Errorf("QrCode this(%v) Not Equal that(%v)", this.QrCode, that1.QrCode)
+	}
+	if this.Owner != that1.Owner {
+		return fmt.Errorf("Owner this(%v) Not Equal that(%v)", this.Owner, that1.Owner)
+	}
+	if this.Created != that1.Created {
+		return fmt.Errorf("Created this(%v) Not Equal that(%v)", this.Created, that1.Created)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *Venue) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Venue)
+	if !ok {
+		that2, ok := that.(Venue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Lat != that1.Lat {
+		return false
+	}
+	if this.Lng != that1.Lng {
+		return false
+	}
+	if this.QrCode != that1.QrCode {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if this.Created != that1.Created {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *Organization) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*Organization)
+	if !ok {
+		that2, ok := that.(Organization)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *Organization")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *Organization but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *Organization but is not nil && this == nil")
+	}
+	if this.Id != that1.Id {
+		return fmt.Errorf("Id this(%v) Not Equal that(%v)", this.Id, that1.Id)
+	}
+	if this.Name != that1.Name {
+		return fmt.Errorf("Name this(%v) Not Equal that(%v)", this.Name, that1.Name)
+	}
+	if this.Kind != that1.Kind {
+		return fmt.Errorf("Kind this(%v) Not Equal that(%v)", this.Kind, that1.Kind)
+	}
+	if len(this.Jurisdiction) != len(that1.Jurisdiction) {
+		return fmt.Errorf("Jurisdiction this(%v) Not Equal that(%v)", len(this.Jurisdiction), len(that1.Jurisdiction))
+	}
+	for i := range this.Jurisdiction {
+		if this.Jurisdiction[i] != that1.Jurisdiction[i] {
+			return fmt.Errorf("Jurisdiction this[%v](%v) Not Equal that[%v](%v)", i, this.Jurisdiction[i], i, that1.Jurisdiction[i])
+		}
+	}
+	if len(this.Permissions) != len(that1.Permissions) {
+		return fmt.Errorf("Permissions this(%v) Not Equal that(%v)", len(this.Permissions), len(that1.Permissions))
+	}
+	for i := range this.Permissions {
+		if this.Permissions[i] != that1.Permissions[i] {
+			return fmt.Errorf("Permissions this[%v](%v) Not Equal that[%v](%v)", i, this.Permissions[i], i, that1.Permissions[i])
+		}
+	}
+	if this.Created != that1.Created {
+		return fmt.Errorf("Created this(%v) Not Equal that(%v)", this.Created, that1.Created)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *Organization) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Organization)
+	if !ok {
+		that2, ok := that.(Organization)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Kind != that1.Kind {
+		return false
+	}
+	if len(this.Jurisdiction) != len(that1.Jurisdiction) {
+		return false
+	}
+	for i := range this.Jurisdiction {
+		if this.Jurisdiction[i] != that1.Jurisdiction[i] {
+			return false
+		}
+	}
+	if len(this.Permissions) != len(that1.Permissions) {
+		return false
+	}
+	for i := range this.Permissions {
+		if this.Permissions[i] != that1.Permissions[i] {
+			return false
+		}
+	}
+	if this.Created != that1.Created {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *CheckInRecord) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*CheckInRecord)
+	if !ok {
+		that2, ok := that.(CheckInRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *CheckInRecord")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *CheckInRecord but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *CheckInRecord but is not nil && this == nil")
+	}
+	if this.Did != that1.Did {
+		return fmt.Errorf("Did this(%v) Not Equal that(%v)", this.Did, that1.Did)
+	}
+	if this.Venue != that1.Venue {
+		return fmt.Errorf("Venue this(%v) Not Equal that(%v)", this.Venue, that1.Venue)
+	}
+	if this.Timestamp != that1.Timestamp {
+		return fmt.Errorf("Timestamp this(%v) Not Equal that(%v)", this.Timestamp, that1.Timestamp)
+	}
+	if this.Hash != that1.Hash {
+		return fmt.Errorf("Hash this(%v) Not Equal that(%v)", this.Hash, that1.Hash)
+	}
+	if !bytes.Equal(this.Proof, that1.Proof) {
+		return fmt.Errorf("Proof this(%v) Not Equal that(%v)", this.Proof, that1.Proof)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *CheckInRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CheckInRecord)
+	if !ok {
+		that2, ok := that.(CheckInRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Did != that1.Did {
+		return false
+	}
+	if this.Venue != that1.Venue {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	if this.Hash != that1.Hash {
+		return false
+	}
+	if !bytes.Equal(this.Proof, that1.Proof) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *Notification) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*Notification)
+	if !ok {
+		that2, ok := that.(Notification)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *Notification")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *Notification but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *Notification but is not nil && this == nil")
+	}
+	if this.Id != that1.Id {
+		return fmt.Errorf("Id this(%v) Not Equal that(%v)", this.Id, that1.Id)
+	}
+	if this.Did != that1.Did {
+		return fmt.Errorf("Did this(%v) Not Equal that(%v)", this.Did, that1.Did)
+	}
+	if this.Kind != that1.Kind {
+		return fmt.Errorf("Kind this(%v) Not Equal that(%v)", this.Kind, that1.Kind)
+	}
+	if this.Timestamp != that1.Timestamp {
+		return fmt.Errorf("Timestamp this(%v) Not Equal that(%v)", this.Timestamp, that1.Timestamp)
+	}
+	if len(this.Details) != len(that1.Details) {
+		return fmt.Errorf("Details this(%v) Not Equal that(%v)", len(this.Details), len(that1.Details))
+	}
+	for i := range this.Details {
+		if this.Details[i] != that1.Details[i] {
+			return fmt.Errorf("Details this[%v](%v) Not Equal that[%v](%v)", i, this.Details[i], i, that1.Details[i])
+		}
+	}
+	if this.Title != that1.Title {
+		return fmt.Errorf("Title this(%v) Not Equal that(%v)", this.Title, that1.Title)
+	}
+	if this.Body != that1.Body {
+		return fmt.Errorf("Body this(%v) Not Equal that(%v)", this.Body, that1.Body)
+	}
+	if this.Lang != that1.Lang {
+		return fmt.Errorf("Lang this(%v) Not Equal that(%v)", this.Lang, that1.Lang)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *Notification) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Notification)
+	if !ok {
+		that2, ok := that.(Notification)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Did != that1.Did {
+		return false
+	}
+	if this.Kind != that1.Kind {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	if len(this.Details) != len(that1.Details) {
+		return false
+	}
+	for i := range this.Details {
+		if this.Details[i] != that1.Details[i] {
+			return false
+		}
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Body != that1.Body {
+		return false
+	}
+	if this.Lang != that1.Lang {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *EncryptedMessage) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*EncryptedMessage)
+	if !ok {
+		that2, ok := that.(EncryptedMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *EncryptedMessage")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *EncryptedMessage but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *EncryptedMessage but is not nil && this == nil")
+	}
+	if this.Id != that1.Id {
+		return fmt.Errorf("Id this(%v) Not Equal that(%v)", this.Id, that1.Id)
+	}
+	if this.Sender != that1.Sender {
+		return fmt.Errorf("Sender this(%v) Not Equal that(%v)", this.Sender, that1.Sender)
+	}
+	if this.Did != that1.Did {
+		return fmt.Errorf("Did this(%v) Not Equal that(%v)", this.Did, that1.Did)
+	}
+	if this.Key != that1.Key {
+		return fmt.Errorf("Key this(%v) Not Equal that(%v)", this.Key, that1.Key)
+	}
+	if this.Algorithm != that1.Algorithm {
+		return fmt.Errorf("Algorithm this(%v) Not Equal that(%v)", this.Algorithm, that1.Algorithm)
+	}
+	if !bytes.Equal(this.EphemeralKey, that1.EphemeralKey) {
+		return fmt.Errorf("EphemeralKey this(%v) Not Equal that(%v)", this.EphemeralKey, that1.EphemeralKey)
+	}
+	if !bytes.Equal(this.Nonce, that1.Nonce) {
+		return fmt.Errorf("Nonce this(%v) Not Equal that(%v)", this.Nonce, that1.Nonce)
+	}
+	if !bytes.Equal(this.Ciphertext, that1.Ciphertext) {
+		return fmt.Errorf("Ciphertext this(%v) Not Equal that(%v)", this.Ciphertext, that1.Ciphertext)
+	}
+	if this.Created != that1.Created {
+		return fmt.Errorf("Created this(%v) Not Equal that(%v)", this.Created, that1.Created)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *EncryptedMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EncryptedMessage)
+	if !ok {
+		that2, ok := that.(EncryptedMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	if this.Did != that1.Did {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if this.Algorithm != that1.Algorithm {
+		return false
+	}
+	if !bytes.Equal(this.EphemeralKey, that1.EphemeralKey) {
+		return false
+	}
+	if !bytes.Equal(this.Nonce, that1.Nonce) {
+		return false
+	}
+	if !bytes.Equal(this.Ciphertext, that1.Ciphertext) {
+		return false
+	}
+	if this.Created != that1.Created {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *Event) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*Event)
+	if !ok {
+		that2, ok := that.(Event)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *Event")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *Event but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *Event but is not nil && this == nil")
+	}
+	if this.Id != that1.Id {
+		return fmt.Errorf("Id this(%v) Not Equal that(%v)", this.Id, that1.Id)
+	}
+	if this.Kind != that1.Kind {
+		return fmt.Errorf("Kind this(%v) Not Equal that(%v)", this.Kind, that1.Kind)
+	}
+	if this.Timestamp != that1.Timestamp {
+		return fmt.Errorf("Timestamp this(%v) Not Equal that(%v)", this.Timestamp, that1.Timestamp)
+	}
+	if this.Did != that1.Did {
+		return fmt.Errorf("Did this(%v) Not Equal that(%v)", this.Did, that1.Did)
+	}
+	if len(this.Attributes) != len(that1.Attributes) {
+		return fmt.Errorf("Attributes this(%v) Not Equal that(%v)", len(this.Attributes), len(that1.Attributes))
+	}
+	for i := range this.Attributes {
+		if this.Attributes[i] != that1.Attributes[i] {
+			return fmt.Errorf("Attributes this[%v](%v) Not Equal that[%v](%v)", i, this.Attributes[i], i, that1.Attributes[i])
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *Event) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Event)
+	if !ok {
+		that2, ok := that.(Event)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Kind != that1.Kind {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	if this.Did != that1.Did {
+		return false
+	}
+	if len(this.Attributes) != len(that1.Attributes) {
+		return false
+	}
+	for i := range this.Attributes {
+		if this.Attributes[i] != that1.Attributes[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *Hotspot) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*Hotspot)
+	if !ok {
+		that2, ok := that.(Hotspot)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *Hotspot")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *Hotspot but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *Hotspot but is not nil && this == nil")
+	}
+	if this.Cell != that1.Cell {
+		return fmt.Errorf("Cell this(%v) Not Equal that(%v)", this.Cell, that1.Cell)
+	}
+	if this.Lat != that1.Lat {
+		return fmt.Errorf("Lat this(%v) Not Equal that(%v)", this.Lat, that1.Lat)
+	}
+	if this.Lng != that1.Lng {
+		return fmt.Errorf("Lng this(%v) Not Equal that(%v)", this.Lng, that1.Lng)
+	}
+	if this.Users != that1.Users {
+		return fmt.Errorf("Users this(%v) Not Equal that(%v)", this.Users, that1.Users)
+	}
+	if this.From != that1.From {
+		return fmt.Errorf("From this(%v) Not Equal that(%v)", this.From, that1.From)
+	}
+	if this.To != that1.To {
+		return fmt.Errorf("To this(%v) Not Equal that(%v)", this.To, that1.To)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *Hotspot) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Hotspot)
+	if !ok {
+		that2, ok := that.(Hotspot)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Cell != that1.Cell {
+		return false
+	}
+	if this.Lat != that1.Lat {
+		return false
+	}
+	if this.Lng != that1.Lng {
+		return false
+	}
+	if this.Users != that1.Users {
+		return false
+	}
+	if this.From != that1.From {
+		return false
+	}
+	if this.To != that1.To {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *Flow) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*Flow)
+	if !ok {
+		that2, ok := that.(Flow)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *Flow")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *Flow but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *Flow but is not nil && this == nil")
+	}
+	if this.Origin != that1.Origin {
+		return fmt.Errorf("Origin this(%v) Not Equal that(%v)", this.Origin, that1.Origin)
+	}
+	if this.Destination != that1.Destination {
+		return fmt.Errorf("Destination this(%v) Not Equal that(%v)", this.Destination, that1.Destination)
+	}
+	if this.Users != that1.Users {
+		return fmt.Errorf("Users this(%v) Not Equal that(%v)", this.Users, that1.Users)
+	}
+	if this.From != that1.From {
+		return fmt.Errorf("From this(%v) Not Equal that(%v)", this.From, that1.From)
+	}
+	if this.To != that1.To {
+		return fmt.Errorf("To this(%v) Not Equal that(%v)", this.To, that1.To)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *Flow) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Flow)
+	if !ok {
+		that2, ok := that.(Flow)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Origin != that1.Origin {
+		return false
+	}
+	if this.Destination != that1.Destination {
+		return false
+	}
+	if this.Users != that1.Users {
+		return false
+	}
+	if this.From != that1.From {
+		return false
+	}
+	if this.To != that1.To {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *Diagnosis) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*Diagnosis)
+	if !ok {
+		that2, ok := that.(Diagnosis)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *Diagnosis")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *Diagnosis but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *Diagnosis but is not nil && this == nil")
+	}
+	if this.Id != that1.Id {
+		return fmt.Errorf("Id this(%v) Not Equal that(%v)", this.Id, that1.Id)
+	}
+	if this.Did != that1.Did {
+		return fmt.Errorf("Did this(%v) Not Equal that(%v)", this.Did, that1.Did)
+	}
+	if this.Result != that1.Result {
+		return fmt.Errorf("Result this(%v) Not Equal that(%v)", this.Result, that1.Result)
+	}
+	if this.Timestamp != that1.Timestamp {
+		return fmt.Errorf("Timestamp this(%v) Not Equal that(%v)", this.Timestamp, that1.Timestamp)
+	}
+	if this.Source != that1.Source {
+		return fmt.Errorf("Source this(%v) Not Equal that(%v)", this.Source, that1.Source)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *Diagnosis) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Diagnosis)
+	if !ok {
+		that2, ok := that.(Diagnosis)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Did != that1.Did {
+		return false
+	}
+	if this.Result != that1.Result {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	if this.Source != that1.Source {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *Exposure) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*Exposure)
+	if !ok {
+		that2, ok := that.(Exposure)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *Exposure")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *Exposure but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *Exposure but is not nil && this == nil")
+	}
+	if this.Id != that1.Id {
+		return fmt.Errorf("Id this(%v) Not Equal that(%v)", this.Id, that1.Id)
+	}
+	if this.Did != that1.Did {
+		return fmt.Errorf("Did this(%v) Not Equal that(%v)", this.Did, that1.Did)
+	}
+	if this.Diagnosis != that1.Diagnosis {
+		return fmt.Errorf("Diagnosis this(%v) Not Equal that(%v)", this.Diagnosis, that1.Diagnosis)
+	}
+	if this.Timestamp != that1.Timestamp {
+		return fmt.Errorf("Timestamp this(%v) Not Equal that(%v)", this.Timestamp, that1.Timestamp)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *Exposure) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Exposure)
+	if !ok {
+		that2, ok := that.(Exposure)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Did != that1.Did {
+		return false
+	}
+	if this.Diagnosis != that1.Diagnosis {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *LocationRecord) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 13)
+	s = append(s, "&protov1.LocationRecord{")
+	s = append(s, "Did: "+fmt.Sprintf("%#v", this.Did)+",\n")
+	s = append(s, "Lat: "+fmt.Sprintf("%#v", this.Lat)+",\n")
+	s = append(s, "Lng: "+fmt.Sprintf("%#v", this.Lng)+",\n")
+	s = append(s, "Alt: "+fmt.Sprintf("%#v", this.Alt)+",\n")
+	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "Proof: "+fmt.Sprintf("%#v", this.Proof)+",\n")
+	s = append(s, "AggregateOnly: "+fmt.Sprintf("%#v", this.AggregateOnly)+",\n")
+	if this.Client != nil {
+		s = append(s, "Client: "+fmt.Sprintf("%#v", this.Client)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ClientInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&protov1.ClientInfo{")
+	s = append(s, "AppVersion: "+fmt.Sprintf("%#v", this.AppVersion)+",\n")
+	s = append(s, "SdkVersion: "+fmt.Sprintf("%#v", this.SdkVersion)+",\n")
+	s = append(s, "Platform: "+fmt.Sprintf("%#v", this.Platform)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Venue) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&protov1.Venue{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Lat: "+fmt.Sprintf("%#v", this.Lat)+",\n")
+	s = append(s, "Lng: "+fmt.Sprintf("%#v", this.Lng)+",\n")
+	s = append(s, "QrCode: "+fmt.Sprintf("%#v", this.QrCode)+",\n")
+	s = append(s, "Owner: "+fmt.Sprintf("%#v", this.Owner)+",\n")
+	s = append(s, "Created: "+fmt.Sprintf("%#v", this.Created)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Organization) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&protov1.Organization{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Kind: "+fmt.Sprintf("%#v", this.Kind)+",\n")
+	s = append(s, "Jurisdiction: "+fmt.Sprintf("%#v", this.Jurisdiction)+",\n")
+	s = append(s, "Permissions: "+fmt.Sprintf("%#v", this.Permissions)+",\n")
+	s = append(s, "Created: "+fmt.Sprintf("%#v", this.Created)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CheckInRecord) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&protov1.CheckInRecord{")
+	s = append(s, "Did: "+fmt.Sprintf("%#v", this.Did)+",\n")
+	s = append(s, "Venue: "+fmt.Sprintf("%#v", this.Venue)+",\n")
+	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "Proof: "+fmt.Sprintf("%#v", this.Proof)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Notification) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&protov1.Notification{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "Did: "+fmt.Sprintf("%#v", this.Did)+",\n")
+	s = append(s, "Kind: "+fmt.Sprintf("%#v", this.Kind)+",\n")
+	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
+	keysForDetails := make([]string, 0, len(this.Details))
+	for k, _ := range this.Details {
+		keysForDetails = append(keysForDetails, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForDetails)
+	mapStringForDetails := "map[string]string{"
+	for _, k := range keysForDetails {
+		mapStringForDetails += fmt.Sprintf("%#v: %#v,", k, this.Details[k])
+	}
+	mapStringForDetails += "}"
+	if this.Details != nil {
+		s = append(s, "Details: "+mapStringForDetails+",\n")
+	}
+	s = append(s, "Title: "+fmt.Sprintf("%#v", this.Title)+",\n")
+	s = append(s, "Body: "+fmt.Sprintf("%#v", this.Body)+",\n")
+	s = append(s, "Lang: "+fmt.Sprintf("%#v", this.Lang)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EncryptedMessage) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 13)
+	s = append(s, "&protov1.EncryptedMessage{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "Sender: "+fmt.Sprintf("%#v", this.Sender)+",\n")
+	s = append(s, "Did: "+fmt.Sprintf("%#v", this.Did)+",\n")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "Algorithm: "+fmt.Sprintf("%#v", this.Algorithm)+",\n")
+	s = append(s, "EphemeralKey: "+fmt.Sprintf("%#v", this.EphemeralKey)+",\n")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "Ciphertext: "+fmt.Sprintf("%#v", this.Ciphertext)+",\n")
+	s = append(s, "Created: "+fmt.Sprintf("%#v", this.Created)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Event) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&protov1.Event{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "Kind: "+fmt.Sprintf("%#v", this.Kind)+",\n")
+	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
+	s = append(s, "Did: "+fmt.Sprintf("%#v", this.Did)+",\n")
+	keysForAttributes := make([]string, 0, len(this.Attributes))
+	for k, _ := range this.Attributes {
+		keysForAttributes = append(keysForAttributes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAttributes)
+	mapStringForAttributes := "map[string]string{"
+	for _, k := range keysForAttributes {
+		mapStringForAttributes += fmt.Sprintf("%#v: %#v,", k, this.Attributes[k])
+	}
+	mapStringForAttributes += "}"
+	if this.Attributes != nil {
+		s = append(s, "Attributes: "+mapStringForAttributes+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Hotspot) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&protov1.Hotspot{")
+	s = append(s, "Cell: "+fmt.Sprintf("%#v", this.Cell)+",\n")
+	s = append(s, "Lat: "+fmt.Sprintf("%#v", this.Lat)+",\n")
+	s = append(s, "Lng: "+fmt.Sprintf("%#v", this.Lng)+",\n")
+	s = append(s, "Users: "+fmt.Sprintf("%#v", this.Users)+",\n")
+	s = append(s, "From: "+fmt.Sprintf("%#v", this.From)+",\n")
+	s = append(s, "To: "+fmt.Sprintf("%#v", this.To)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Flow) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&protov1.Flow{")
+	s = append(s, "Origin: "+fmt.Sprintf("%#v", this.Origin)+",\n")
+	s = append(s, "Destination: "+fmt.Sprintf("%#v", this.Destination)+",\n")
+	s = append(s, "Users: "+fmt.Sprintf("%#v", this.Users)+",\n")
+	s = append(s, "From: "+fmt.Sprintf("%#v", this.From)+",\n")
+	s = append(s, "To: "+fmt.Sprintf("%#v", this.To)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Diagnosis) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&protov1.Diagnosis{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "Did: "+fmt.Sprintf("%#v", this.Did)+",\n")
+	s = append(s, "Result: "+fmt.Sprintf("%#v", this.Result)+",\n")
+	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
+	s = append(s, "Source: "+fmt.Sprintf("%#v", this.Source)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Exposure) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&protov1.Exposure{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "Did: "+fmt.Sprintf("%#v", this.Did)+",\n")
+	s = append(s, "Diagnosis: "+fmt.Sprintf("%#v", this.Diagnosis)+",\n")
+	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringServer(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *LocationRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LocationRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LocationRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Client != nil {
+		{
+			size, err := m.Client.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintServer(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.AggregateOnly {
+		i--
+		if m.AggregateOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintServer(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintServer(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintServer(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Alt != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Alt))))
+		i--
+		dAtA[i] = 0x25
+	}
+	if m.Lng != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Lng))))
+		i--
+		dAtA[i] = 0x1d
+	}
+	if m.Lat != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Lat))))
+		i--
+		dAtA[i] = 0x15
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintServer(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClientInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Platform) > 0 {
+		i -= len(m.Platform)
+		copy(dAtA[i:], m.Platform)
+		i = encodeVarintServer(dAtA, i, uint64(len(m.Platform)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SdkVersion) > 0 {
+		i -= len(m.SdkVersion)
+		copy(dAtA[i:], m.SdkVersion)
+		i = encodeVarintServer(dAtA, i, uint64(len(m.SdkVersion)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AppVersion) > 0 {
+		i -= len(m.AppVersion)
+		copy(dAtA[i:], m.AppVersion)
+		i = encodeVarintServer(dAtA, i, uint64(len(m.AppVersion)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Venue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Venue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Venue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Created != 0 {
+		i = encodeVarintServer(dAtA, i, uint64(m.Created))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintServer(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.QrCode) > 0 {
+		i -= len(m.QrCode)
+		copy(dAtA[i:], m.QrCode)
+		i = encodeVarintServer(dAtA, i, uint64(len(m.QrCode)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Lng != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Lng))))
+		i--
+		dAtA[i] = 0x25
+	}
+	if m.Lat != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Lat))))
+		i--
+		dAtA[i] = 0x1d
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintServer(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintServer(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Organization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Organization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Organization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Created != 0 {
+		i = encodeVarintServer(dAtA, i, uint64(m.Created))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Permissions) > 0 {
+		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Permissions[iNdEx])
+			copy(dAtA[i:], m.Permissions[iNdEx])
+			i = encodeVarintServer(dAtA, i, uint64(len(m.Permissions[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Jurisdiction) > 0 {
+		for iNdEx := len(m.Jurisdiction) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Jurisdiction[iNdEx])
+			copy(dAtA[i:], m.Jurisdiction[iNdEx])
+			i = encodeVarintServer(dAtA, i, uint64(len(m.Jurisdiction[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintServer(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintServer(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintServer(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckInRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckInRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckInRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintServer(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintServer(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintServer(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Venue) > 0 {
+		i -= len(m.Venue)
+		copy(dAtA[i:], m.Venue)
+		i = encodeVarintServer(dAtA, i, uint64(len(m.Venue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintServer(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Notification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Notification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Notification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Lang) > 0 {
+		i -= len(m.Lang)
+		copy(dAtA[i:], m.Lang)
+		i = encodeVarintServer(dAtA, i, uint64(len(m.Lang)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Body) > 0 {
+		i -= len(m.Body)
+		copy(dAtA[i:], m.Body)
+		i = encodeVarintServer(dAtA, i, uint64(len(m.Body)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintServer(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Details) > 0 {
+		for k := range m.Details {
+			v := m.Details[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintServer(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintServer(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintServer(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintServer(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintServer(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintServer(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintServer(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EncryptedMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EncryptedMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EncryptedMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Created != 0 {
+		i = encodeVarintServer(dAtA, i, uint64(m.Created))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Ciphertext) > 0 {
+		i -= len(m.Ciphertext)
+		copy(dAtA[i:], m.Ciphertext)
+		i = encodeVarintServer(dAtA, i, uint64(len(m.Ciphertext)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Nonce) > 0 {
+		i -= len(m.Nonce)
+		copy(dAtA[i:], m.Nonce)
+		i = encodeVarintServer(dAtA, i, uint64(len(m.Nonce)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.EphemeralKey) > 0 {
+		i -= len(m.EphemeralKey)
+		copy(dAtA[i:], m.EphemeralKey)
+		i = encodeVarintServer(dAtA, i, uint64(len(m.EphemeralKey)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Algorithm) > 0 {
+		i -= len(m.Algorithm)
+		copy(dAtA[i:], m.Algorithm)
+		i = encodeVarintServer(dAtA, i, uint64(len(m.Algorithm)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintServer(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintServer(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintServer(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintServer(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Event) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Event) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Attributes) > 0 {
+		for k := range m.Attributes {
+			v := m.Attributes[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintServer(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintServer(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintServer(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintServer(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintServer(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintServer(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintServer(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Hotspot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Hotspot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Hotspot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.To != 0 {
+		i = encodeVarintServer(dAtA, i, uint64(m.To))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.From != 0 {
+		i = encodeVarintServer(dAtA, i, uint64(m.From))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Users != 0 {
+		i = encodeVarintServer(dAtA, i, uint64(m.Users))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Lng != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Lng))))
+		i--
+		dAtA[i] = 0x1d
+	}
+	if m.Lat != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Lat))))
+		i--
+		dAtA[i] = 0x15
+	}
+	if len(m.Cell) > 0 {
+		i -= len(m.Cell)
+		copy(dAtA[i:], m.Cell)
+		i = encodeVarintServer(dAtA, i, uint64(len(m.Cell)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Flow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Flow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Flow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.To != 0 {
+		i = encodeVarintServer(dAtA, i, uint64(m.To))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.From != 0 {
+		i = encodeVarintServer(dAtA, i, uint64(m.From))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Users != 0 {
+		i = encodeVarintServer(dAtA, i, uint64(m.Users))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintServer(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Origin) > 0 {
+		i -= len(m.Origin)
+		copy(dAtA[i:], m.Origin)
+		i = encodeVarintServer(dAtA, i, uint64(len(m.Origin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Diagnosis) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Diagnosis) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Diagnosis) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintServer(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintServer(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Result) > 0 {
+		i -= len(m.Result)
+		copy(dAtA[i:], m.Result)
+		i = encodeVarintServer(dAtA, i, uint64(len(m.Result)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintServer(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintServer(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Exposure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Exposure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Exposure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintServer(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Diagnosis) > 0 {
+		i -= len(m.Diagnosis)
+		copy(dAtA[i:], m.Diagnosis)
+		i = encodeVarintServer(dAtA, i, uint64(len(m.Diagnosis)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintServer(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintServer(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintServer(dAtA []byte, offset int, v uint64) int {
+	offset -= sovServer(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedLocationRecord(r randyServer, easy bool) *LocationRecord {
+	this := &LocationRecord{}
+	this.Did = string(randStringServer(r))
+	this.Lat = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.Lat *= -1
+	}
+	this.Lng = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.Lng *= -1
+	}
+	this.Alt = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.Alt *= -1
+	}
+	this.Timestamp = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Timestamp *= -1
+	}
+	this.Hash = string(randStringServer(r))
+	v1 := r.Intn(100)
+	this.Proof = make([]byte, v1)
+	for i := 0; i < v1; i++ {
+		this.Proof[i] = byte(r.Intn(256))
+	}
+	this.AggregateOnly = bool(bool(r.Intn(2) == 0))
+	if r.Intn(5) != 0 {
+		this.Client = NewPopulatedClientInfo(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedServer(r, 10)
+	}
+	return this
+}
+
+func NewPopulatedClientInfo(r randyServer, easy bool) *ClientInfo {
+	this := &ClientInfo{}
+	this.AppVersion = string(randStringServer(r))
+	this.SdkVersion = string(randStringServer(r))
+	this.Platform = string(randStringServer(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedServer(r, 4)
+	}
+	return this
+}
+
+func NewPopulatedVenue(r randyServer, easy bool) *Venue {
+	this := &Venue{}
+	this.Id = string(randStringServer(r))
+	this.Name = string(randStringServer(r))
+	this.Lat = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.Lat *= -1
+	}
+	this.Lng = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.Lng *= -1
+	}
+	this.QrCode = string(randStringServer(r))
+	this.Owner = string(randStringServer(r))
+	this.Created = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Created *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedServer(r, 8)
+	}
+	return this
+}
+
+func NewPopulatedOrganization(r randyServer, easy bool) *Organization {
+	this := &Organization{}
+	this.Id = string(randStringServer(r))
+	this.Name = string(randStringServer(r))
+	this.Kind = string(randStringServer(r))
+	v2 := r.Intn(10)
+	this.Jurisdiction = make([]string, v2)
+	for i := 0; i < v2; i++ {
+		this.Jurisdiction[i] = string(randStringServer(r))
+	}
+	v3 := r.Intn(10)
+	this.Permissions = make([]string, v3)
+	for i := 0; i < v3; i++ {
+		this.Permissions[i] = string(randStringServer(r))
+	}
+	this.Created = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Created *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedServer(r, 7)
+	}
+	return this
+}
+
+func NewPopulatedCheckInRecord(r randyServer, easy bool) *CheckInRecord {
+	this := &CheckInRecord{}
+	this.Did = string(randStringServer(r))
+	this.Venue = string(randStringServer(r))
+	this.Timestamp = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Timestamp *= -1
+	}
+	this.Hash = string(randStringServer(r))
+	v4 := r.Intn(100)
+	this.Proof = make([]byte, v4)
+	for i := 0; i < v4; i++ {
+		this.Proof[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedServer(r, 6)
+	}
+	return this
+}
+
+func NewPopulatedNotification(r randyServer, easy bool) *Notification {
+	this := &Notification{}
+	this.Id = string(randStringServer(r))
+	this.Did = string(randStringServer(r))
+	this.Kind = string(randStringServer(r))
+	this.Timestamp = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Timestamp *= -1
+	}
+	if r.Intn(5) != 0 {
+		v5 := r.Intn(10)
+		this.Details = make(map[string]string)
+		for i := 0; i < v5; i++ {
+			this.Details[randStringServer(r)] = randStringServer(r)
+		}
+	}
+	this.Title = string(randStringServer(r))
+	this.Body = string(randStringServer(r))
+	this.Lang = string(randStringServer(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedServer(r, 9)
+	}
+	return this
+}
+
+func NewPopulatedEncryptedMessage(r randyServer, easy bool) *EncryptedMessage {
+	this := &EncryptedMessage{}
+	this.Id = string(randStringServer(r))
+	this.Sender = string(randStringServer(r))
+	this.Did = string(randStringServer(r))
+	this.Key = string(randStringServer(r))
+	this.Algorithm = string(randStringServer(r))
+	v6 := r.Intn(100)
+	this.EphemeralKey = make([]byte, v6)
+	for i := 0; i < v6; i++ {
+		this.EphemeralKey[i] = byte(r.Intn(256))
+	}
+	v7 := r.Intn(100)
+	this.Nonce = make([]byte, v7)
+	for i := 0; i < v7; i++ {
+		this.Nonce[i] = byte(r.Intn(256))
+	}
+	v8 := r.Intn(100)
+	this.Ciphertext = make([]byte, v8)
+	for i := 0; i < v8; i++ {
+		this.Ciphertext[i] = byte(r.Intn(256))
+	}
+	this.Created = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Created *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedServer(r, 10)
+	}
+	return this
+}
+
+func NewPopulatedEvent(r randyServer, easy bool) *Event {
+	this := &Event{}
+	this.Id = string(randStringServer(r))
+	this.Kind = string(randStringServer(r))
+	this.Timestamp = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Timestamp *= -1
+	}
+	this.Did = string(randStringServer(r))
+	if r.Intn(5) != 0 {
+		v9 := r.Intn(10)
+		this.Attributes = make(map[string]string)
+		for i := 0; i < v9; i++ {
+			this.Attributes[randStringServer(r)] = randStringServer(r)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedServer(r, 6)
+	}
+	return this
+}
+
+func NewPopulatedHotspot(r randyServer, easy bool) *Hotspot {
+	this := &Hotspot{}
+	this.Cell = string(randStringServer(r))
+	this.Lat = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.Lat *= -1
+	}
+	this.Lng = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.Lng *= -1
+	}
+	this.Users = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Users *= -1
+	}
+	this.From = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.From *= -1
+	}
+	this.To = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.To *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedServer(r, 7)
+	}
+	return this
+}
+
+func NewPopulatedFlow(r randyServer, easy bool) *Flow {
+	this := &Flow{}
+	this.Origin = string(randStringServer(r))
+	this.Destination = string(randStringServer(r))
+	this.Users = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Users *= -1
+	}
+	this.From = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.From *= -1
+	}
+	this.To = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.To *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedServer(r, 6)
+	}
+	return this
+}
+
+func NewPopulatedDiagnosis(r randyServer, easy bool) *Diagnosis {
+	this := &Diagnosis{}
+	this.Id = string(randStringServer(r))
+	this.Did = string(randStringServer(r))
+	this.Result = string(randStringServer(r))
+	this.Timestamp = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Timestamp *= -1
+	}
+	this.Source = string(randStringServer(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedServer(r, 6)
+	}
+	return this
+}
+
+func NewPopulatedExposure(r randyServer, easy bool) *Exposure {
+	this := &Exposure{}
+	this.Id = string(randStringServer(r))
+	this.Did = string(randStringServer(r))
+	this.Diagnosis = string(randStringServer(r))
+	this.Timestamp = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Timestamp *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedServer(r, 5)
+	}
+	return this
+}
+
+type randyServer interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneServer(r randyServer) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringServer(r randyServer) string {
+	v10 := r.Intn(100)
+	tmps := make([]rune, v10)
+	for i := 0; i < v10; i++ {
+		tmps[i] = randUTF8RuneServer(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedServer(r randyServer, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldServer(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldServer(dAtA []byte, r randyServer, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateServer(dAtA, uint64(key))
+		v11 := r.Int63()
+		if r.Intn(2) == 0 {
+			v11 *= -1
+		}
+		dAtA = encodeVarintPopulateServer(dAtA, uint64(v11))
+	case 1:
+		dAtA = encodeVarintPopulateServer(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateServer(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateServer(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateServer(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateServer(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *LocationRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovServer(uint64(l))
+	}
+	if m.Lat != 0 {
+		n += 5
+	}
+	if m.Lng != 0 {
+		n += 5
+	}
+	if m.Alt != 0 {
+		n += 5
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovServer(uint64(m.Timestamp))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovServer(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovServer(uint64(l))
+	}
+	if m.AggregateOnly {
+		n += 2
+	}
+	if m.Client != nil {
+		l = m.Client.Size()
+		n += 1 + l + sovServer(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClientInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AppVersion)
+	if l > 0 {
+		n += 1 + l + sovServer(uint64(l))
+	}
+	l = len(m.SdkVersion)
+	if l > 0 {
+		n += 1 + l + sovServer(uint64(l))
+	}
+	l = len(m.Platform)
+	if l > 0 {
+		n += 1 + l + sovServer(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Venue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovServer(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovServer(uint64(l))
+	}
+	if m.Lat != 0 {
+		n += 5
+	}
+	if m.Lng != 0 {
+		n += 5
+	}
+	l = len(m.QrCode)
+	if l > 0 {
+		n += 1 + l + sovServer(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovServer(uint64(l))
+	}
+	if m.Created != 0 {
+		n += 1 + sovServer(uint64(m.Created))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Organization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovServer(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovServer(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovServer(uint64(l))
+	}
+	if len(m.Jurisdiction) > 0 {
+		for _, s := range m.Jurisdiction {
+			l = len(s)
+			n += 1 + l + sovServer(uint64(l))
+		}
+	}
+	if len(m.Permissions) > 0 {
+		for _, s := range m.Permissions {
+			l = len(s)
+			n += 1 + l + sovServer(uint64(l))
+		}
+	}
+	if m.Created != 0 {
+		n += 1 + sovServer(uint64(m.Created))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CheckInRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovServer(uint64(l))
+	}
+	l = len(m.Venue)
+	if l > 0 {
+		n += 1 + l + sovServer(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovServer(uint64(m.Timestamp))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovServer(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovServer(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Notification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovServer(uint64(l))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovServer(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovServer(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovServer(uint64(m.Timestamp))
+	}
+	if len(m.Details) > 0 {
+		for k, v := range m.Details {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovServer(uint64(len(k))) + 1 + len(v) + sovServer(uint64(len(v)))
+			n += mapEntrySize + 1 + sovServer(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovServer(uint64(l))
+	}
+	l = len(m.Body)
+	if l > 0 {
+		n += 1 + l + sovServer(uint64(l))
+	}
+	l = len(m.Lang)
+	if l > 0 {
+		n += 1 + l + sovServer(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EncryptedMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovServer(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovServer(uint64(l))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovServer(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovServer(uint64(l))
+	}
+	l = len(m.Algorithm)
+	if l > 0 {
+		n += 1 + l + sovServer(uint64(l))
+	}
+	l = len(m.EphemeralKey)
+	if l > 0 {
+		n += 1 + l + sovServer(uint64(l))
+	}
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovServer(uint64(l))
+	}
+	l = len(m.Ciphertext)
+	if l > 0 {
+		n += 1 + l + sovServer(uint64(l))
+	}
+	if m.Created != 0 {
+		n += 1 + sovServer(uint64(m.Created))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Event) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovServer(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovServer(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovServer(uint64(m.Timestamp))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovServer(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for k, v := range m.Attributes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovServer(uint64(len(k))) + 1 + len(v) + sovServer(uint64(len(v)))
+			n += mapEntrySize + 1 + sovServer(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Hotspot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Cell)
+	if l > 0 {
+		n += 1 + l + sovServer(uint64(l))
+	}
+	if m.Lat != 0 {
+		n += 5
+	}
+	if m.Lng != 0 {
+		n += 5
+	}
+	if m.Users != 0 {
+		n += 1 + sovServer(uint64(m.Users))
+	}
+	if m.From != 0 {
+		n += 1 + sovServer(uint64(m.From))
+	}
+	if m.To != 0 {
+		n += 1 + sovServer(uint64(m.To))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Flow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Origin)
+	if l > 0 {
+		n += 1 + l + sovServer(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovServer(uint64(l))
+	}
+	if m.Users != 0 {
+		n += 1 + sovServer(uint64(m.Users))
+	}
+	if m.From != 0 {
+		n += 1 + sovServer(uint64(m.From))
+	}
+	if m.To != 0 {
+		n += 1 + sovServer(uint64(m.To))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Diagnosis) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovServer(uint64(l))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovServer(uint64(l))
+	}
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovServer(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovServer(uint64(m.Timestamp))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovServer(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Exposure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovServer(uint64(l))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovServer(uint64(l))
+	}
+	l = len(m.Diagnosis)
+	if l > 0 {
+		n += 1 + l + sovServer(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovServer(uint64(m.Timestamp))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovServer(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozServer(x uint64) (n int) {
+	return sovServer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *LocationRecord) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LocationRecord{`,
+		`Did:` + fmt.Sprintf("%v", this.Did) + `,`,
+		`Lat:` + fmt.Sprintf("%v", this.Lat) + `,`,
+		`Lng:` + fmt.Sprintf("%v", this.Lng) + `,`,
+		`Alt:` + fmt.Sprintf("%v", this.Alt) + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`Proof:` + fmt.Sprintf("%v", this.Proof) + `,`,
+		`AggregateOnly:` + fmt.Sprintf("%v", this.AggregateOnly) + `,`,
+		`Client:` + strings.Replace(this.Client.String(), "ClientInfo", "ClientInfo", 1) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClientInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClientInfo{`,
+		`AppVersion:` + fmt.Sprintf("%v", this.AppVersion) + `,`,
+		`SdkVersion:` + fmt.Sprintf("%v", this.SdkVersion) + `,`,
+		`Platform:` + fmt.Sprintf("%v", this.Platform) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Venue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Venue{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Lat:` + fmt.Sprintf("%v", this.Lat) + `,`,
+		`Lng:` + fmt.Sprintf("%v", this.Lng) + `,`,
+		`QrCode:` + fmt.Sprintf("%v", this.QrCode) + `,`,
+		`Owner:` + fmt.Sprintf("%v", this.Owner) + `,`,
+		`Created:` + fmt.Sprintf("%v", this.Created) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Organization) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Organization{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Jurisdiction:` + fmt.Sprintf("%v", this.Jurisdiction) + `,`,
+		`Permissions:` + fmt.Sprintf("%v", this.Permissions) + `,`,
+		`Created:` + fmt.Sprintf("%v", this.Created) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CheckInRecord) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CheckInRecord{`,
+		`Did:` + fmt.Sprintf("%v", this.Did) + `,`,
+		`Venue:` + fmt.Sprintf("%v", this.Venue) + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`Proof:` + fmt.Sprintf("%v", this.Proof) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Notification) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForDetails := make([]string, 0, len(this.Details))
+	for k, _ := range this.Details {
+		keysForDetails = append(keysForDetails, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForDetails)
+	mapStringForDetails := "map[string]string{"
+	for _, k := range keysForDetails {
+		mapStringForDetails += fmt.Sprintf("%v: %v,", k, this.Details[k])
+	}
+	mapStringForDetails += "}"
+	s := strings.Join([]string{`&Notification{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Did:` + fmt.Sprintf("%v", this.Did) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`Details:` + mapStringForDetails + `,`,
+		`Title:` + fmt.Sprintf("%v", this.Title) + `,`,
+		`Body:` + fmt.Sprintf("%v", this.Body) + `,`,
+		`Lang:` + fmt.Sprintf("%v", this.Lang) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EncryptedMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EncryptedMessage{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Sender:` + fmt.Sprintf("%v", this.Sender) + `,`,
+		`Did:` + fmt.Sprintf("%v", this.Did) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Algorithm:` + fmt.Sprintf("%v", this.Algorithm) + `,`,
+		`EphemeralKey:` + fmt.Sprintf("%v", this.EphemeralKey) + `,`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`Ciphertext:` + fmt.Sprintf("%v", this.Ciphertext) + `,`,
+		`Created:` + fmt.Sprintf("%v", this.Created) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Event) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForAttributes := make([]string, 0, len(this.Attributes))
+	for k, _ := range this.Attributes {
+		keysForAttributes = append(keysForAttributes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAttributes)
+	mapStringForAttributes := "map[string]string{"
+	for _, k := range keysForAttributes {
+		mapStringForAttributes += fmt.Sprintf("%v: %v,", k, this.Attributes[k])
+	}
+	mapStringForAttributes += "}"
+	s := strings.Join([]string{`&Event{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`Did:` + fmt.Sprintf("%v", this.Did) + `,`,
+		`Attributes:` + mapStringForAttributes + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Hotspot) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Hotspot{`,
+		`Cell:` + fmt.Sprintf("%v", this.Cell) + `,`,
+		`Lat:` + fmt.Sprintf("%v", this.Lat) + `,`,
+		`Lng:` + fmt.Sprintf("%v", this.Lng) + `,`,
+		`Users:` + fmt.Sprintf("%v", this.Users) + `,`,
+		`From:` + fmt.Sprintf("%v", this.From) + `,`,
+		`To:` + fmt.Sprintf("%v", this.To) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Flow) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Flow{`,
+		`Origin:` + fmt.Sprintf("%v", this.Origin) + `,`,
+		`Destination:` + fmt.Sprintf("%v", this.Destination) + `,`,
+		`Users:` + fmt.Sprintf("%v", this.Users) + `,`,
+		`From:` + fmt.Sprintf("%v", this.From) + `,`,
+		`To:` + fmt.Sprintf("%v", this.To) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Diagnosis) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Diagnosis{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Did:` + fmt.Sprintf("%v", this.Did) + `,`,
+		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Exposure) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Exposure{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Did:` + fmt.Sprintf("%v", this.Did) + `,`,
+		`Diagnosis:` + fmt.Sprintf("%v", this.Diagnosis) + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringServer(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *LocationRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LocationRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LocationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lat", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Lat = float32(math.Float32frombits(v))
+		case 3:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lng", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Lng = float32(math.Float32frombits(v))
+		case 4:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alt", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Alt = float32(math.Float32frombits(v))
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AggregateOnly = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Client == nil {
+				m.Client = &ClientInfo{}
+			}
+			if err := m.Client.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServer
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClientInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SdkVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SdkVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Platform = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServer
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Venue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Venue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Venue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lat", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Lat = float32(math.Float32frombits(v))
+		case 4:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lng", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Lng = float32(math.Float32frombits(v))
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QrCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QrCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			m.Created = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Created |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServer
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Organization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Organization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Organization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jurisdiction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Jurisdiction = append(m.Jurisdiction, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			m.Created = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Created |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServer
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckInRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckInRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckInRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Venue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Venue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServer
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Notification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Notification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Notification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Details == nil {
+				m.Details = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowServer
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowServer
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthServer
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthServer
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowServer
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthServer
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthServer
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipServer(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthServer
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Details[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lang", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lang = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServer
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EncryptedMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EncryptedMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EncryptedMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Algorithm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Algorithm = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EphemeralKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EphemeralKey = append(m.EphemeralKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.EphemeralKey == nil {
+				m.EphemeralKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nonce = append(m.Nonce[:0], dAtA[iNdEx:postIndex]...)
+			if m.Nonce == nil {
+				m.Nonce = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ciphertext", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ciphertext = append(m.Ciphertext[:0], dAtA[iNdEx:postIndex]...)
+			if m.Ciphertext == nil {
+				m.Ciphertext = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			m.Created = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Created |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServer
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Event) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Event: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attributes == nil {
+				m.Attributes = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowServer
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowServer
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthServer
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthServer
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowServer
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthServer
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthServer
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipServer(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthServer
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Attributes[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServer
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Hotspot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Hotspot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Hotspot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cell", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cell = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lat", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Lat = float32(math.Float32frombits(v))
+		case 3:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lng", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Lng = float32(math.Float32frombits(v))
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Users", wireType)
+			}
+			m.Users = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Users |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			m.From = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.From |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			m.To = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.To |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServer
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Flow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Flow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Flow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Origin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Users", wireType)
+			}
+			m.Users = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Users |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			m.From = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.From |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			m.To = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.To |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServer
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	s := strings.Join([]string{`&LocationRecord{`,
-		`Did:` + fmt.Sprintf("%v", this.Did) + `,`,
-		`Lat:` + fmt.Sprintf("%v", this.Lat) + `,`,
-		`Lng:` + fmt.Sprintf("%v", this.Lng) + `,`,
-		`Alt:` + fmt.Sprintf("%v", this.Alt) + `,`,
-		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
-		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
-		`Proof:` + fmt.Sprintf("%v", this.Proof) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringServer(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *LocationRecord) Unmarshal(dAtA []byte) error {
+func (m *Diagnosis) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -558,15 +6905,15 @@ func (m *LocationRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LocationRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: Diagnosis: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LocationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Diagnosis: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -594,42 +6941,73 @@ func (m *LocationRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Did = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lat", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Lat = float32(math.Float32frombits(v))
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lng", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Lng = float32(math.Float32frombits(v))
-		case 4:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Alt", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServer
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Alt = float32(math.Float32frombits(v))
-		case 5:
+			m.Result = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
@@ -648,9 +7026,9 @@ func (m *LocationRecord) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -678,13 +7056,67 @@ func (m *LocationRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServer
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Exposure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Exposure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Exposure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServer
@@ -694,26 +7126,107 @@ func (m *LocationRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthServer
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthServer
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
-			if m.Proof == nil {
-				m.Proof = []byte{}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Diagnosis", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Diagnosis = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServer(dAtA[iNdEx:])
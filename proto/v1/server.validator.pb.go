@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_mwitkow_go_proto_validators "github.com/mwitkow/go-proto-validators"
 	math "math"
 )
 
@@ -16,5 +17,45 @@ var _ = fmt.Errorf
 var _ = math.Inf
 
 func (this *LocationRecord) Validate() error {
+	if this.Client != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Client); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Client", err)
+		}
+	}
+	return nil
+}
+func (this *ClientInfo) Validate() error {
+	return nil
+}
+func (this *Venue) Validate() error {
+	return nil
+}
+func (this *Organization) Validate() error {
+	return nil
+}
+func (this *CheckInRecord) Validate() error {
+	return nil
+}
+func (this *Notification) Validate() error {
+	// Validation of proto3 map<> fields is unsupported.
+	return nil
+}
+func (this *EncryptedMessage) Validate() error {
+	return nil
+}
+func (this *Event) Validate() error {
+	// Validation of proto3 map<> fields is unsupported.
+	return nil
+}
+func (this *Hotspot) Validate() error {
+	return nil
+}
+func (this *Flow) Validate() error {
+	return nil
+}
+func (this *Diagnosis) Validate() error {
+	return nil
+}
+func (this *Exposure) Validate() error {
 	return nil
 }
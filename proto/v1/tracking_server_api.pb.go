@@ -6,6 +6,7 @@ package protov1
 import (
 	bytes "bytes"
 	context "context"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	_ "github.com/gogo/googleapis/google/api"
 	_ "github.com/gogo/protobuf/gogoproto"
@@ -83,7 +84,15 @@ type ActivationCodeRequest struct {
 	// Identifier.
 	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
 	// Account role.
-	Role                 string   `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	Role string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	// Permissions granted to the credentials obtained with the code, in the
+	// form "resource:action", i.e. "record:create". If not provided, all the
+	// permissions available to the role are granted.
+	Scope []string `protobuf:"bytes,3,rep,name=scope,proto3" json:"scope,omitempty"`
+	// Bind the code to a device, using a public key or device identifier
+	// provided by the client that will redeem it. Bound codes are only
+	// accepted on credentials requests including the same value.
+	Device               string   `protobuf:"bytes,4,opt,name=device,proto3" json:"device,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -135,6 +144,20 @@ func (m *ActivationCodeRequest) GetRole() string {
 	return ""
 }
 
+func (m *ActivationCodeRequest) GetScope() []string {
+	if m != nil {
+		return m.Scope
+	}
+	return nil
+}
+
+func (m *ActivationCodeRequest) GetDevice() string {
+	if m != nil {
+		return m.Device
+	}
+	return ""
+}
+
 type ActivationCodeResponse struct {
 	// Activation code generated.
 	ActivationCode       string   `protobuf:"bytes,1,opt,name=activation_code,json=activationCode,proto3" json:"activation_code,omitempty"`
@@ -182,6 +205,211 @@ func (m *ActivationCodeResponse) GetActivationCode() string {
 	return ""
 }
 
+type BulkActivationCodesRequest struct {
+	// Number of codes to generate, a maximum of 1000 per request is supported.
+	Count uint32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// Label for the registration campaign the codes are generated for.
+	Campaign string `protobuf:"bytes,2,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	// Permissions granted to the credentials obtained with the codes, in the
+	// form "resource:action".
+	Scope []string `protobuf:"bytes,3,rep,name=scope,proto3" json:"scope,omitempty"`
+	// Include a PNG image of the QR code for each activation code.
+	QrImages             bool     `protobuf:"varint,4,opt,name=qr_images,json=qrImages,proto3" json:"qr_images,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BulkActivationCodesRequest) Reset()      { *m = BulkActivationCodesRequest{} }
+func (*BulkActivationCodesRequest) ProtoMessage() {}
+func (*BulkActivationCodesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f8a97bd56b0960, []int{3}
+}
+func (m *BulkActivationCodesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BulkActivationCodesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BulkActivationCodesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BulkActivationCodesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BulkActivationCodesRequest.Merge(m, src)
+}
+func (m *BulkActivationCodesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BulkActivationCodesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BulkActivationCodesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BulkActivationCodesRequest proto.InternalMessageInfo
+
+func (m *BulkActivationCodesRequest) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *BulkActivationCodesRequest) GetCampaign() string {
+	if m != nil {
+		return m.Campaign
+	}
+	return ""
+}
+
+func (m *BulkActivationCodesRequest) GetScope() []string {
+	if m != nil {
+		return m.Scope
+	}
+	return nil
+}
+
+func (m *BulkActivationCodesRequest) GetQrImages() bool {
+	if m != nil {
+		return m.QrImages
+	}
+	return false
+}
+
+type BulkActivationCodesResponse struct {
+	// Generated activation codes.
+	Codes                []*CampaignCode `protobuf:"bytes,1,rep,name=codes,proto3" json:"codes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *BulkActivationCodesResponse) Reset()      { *m = BulkActivationCodesResponse{} }
+func (*BulkActivationCodesResponse) ProtoMessage() {}
+func (*BulkActivationCodesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f8a97bd56b0960, []int{4}
+}
+func (m *BulkActivationCodesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BulkActivationCodesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BulkActivationCodesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BulkActivationCodesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BulkActivationCodesResponse.Merge(m, src)
+}
+func (m *BulkActivationCodesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BulkActivationCodesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BulkActivationCodesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BulkActivationCodesResponse proto.InternalMessageInfo
+
+func (m *BulkActivationCodesResponse) GetCodes() []*CampaignCode {
+	if m != nil {
+		return m.Codes
+	}
+	return nil
+}
+
+type CampaignCode struct {
+	// Activation code.
+	ActivationCode string `protobuf:"bytes,1,opt,name=activation_code,json=activationCode,proto3" json:"activation_code,omitempty"`
+	// Label for the registration campaign.
+	Campaign string `protobuf:"bytes,2,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	// Contents to be encoded as a QR code.
+	QrCode string `protobuf:"bytes,3,opt,name=qr_code,json=qrCode,proto3" json:"qr_code,omitempty"`
+	// PNG image of the QR code, if requested.
+	QrImage []byte `protobuf:"bytes,4,opt,name=qr_image,json=qrImage,proto3" json:"qr_image,omitempty"`
+	// Expiration date (in seconds and for UTC).
+	Expires              int64    `protobuf:"varint,5,opt,name=expires,proto3" json:"expires,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CampaignCode) Reset()      { *m = CampaignCode{} }
+func (*CampaignCode) ProtoMessage() {}
+func (*CampaignCode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f8a97bd56b0960, []int{5}
+}
+func (m *CampaignCode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CampaignCode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CampaignCode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CampaignCode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CampaignCode.Merge(m, src)
+}
+func (m *CampaignCode) XXX_Size() int {
+	return m.Size()
+}
+func (m *CampaignCode) XXX_DiscardUnknown() {
+	xxx_messageInfo_CampaignCode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CampaignCode proto.InternalMessageInfo
+
+func (m *CampaignCode) GetActivationCode() string {
+	if m != nil {
+		return m.ActivationCode
+	}
+	return ""
+}
+
+func (m *CampaignCode) GetCampaign() string {
+	if m != nil {
+		return m.Campaign
+	}
+	return ""
+}
+
+func (m *CampaignCode) GetQrCode() string {
+	if m != nil {
+		return m.QrCode
+	}
+	return ""
+}
+
+func (m *CampaignCode) GetQrImage() []byte {
+	if m != nil {
+		return m.QrImage
+	}
+	return nil
+}
+
+func (m *CampaignCode) GetExpires() int64 {
+	if m != nil {
+		return m.Expires
+	}
+	return 0
+}
+
 type CredentialsRequest struct {
 	// Identifier.
 	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
@@ -190,7 +418,20 @@ type CredentialsRequest struct {
 	// Activation code previously obtained from the server.
 	ActivationCode string `protobuf:"bytes,3,opt,name=activation_code,json=activationCode,proto3" json:"activation_code,omitempty"`
 	// LD document containing a signed activation code.
-	Proof                []byte   `protobuf:"bytes,4,opt,name=proof,proto3" json:"proof,omitempty"`
+	Proof []byte `protobuf:"bytes,4,opt,name=proof,proto3" json:"proof,omitempty"`
+	// Preferred language for messages and notifications, i.e. "es-MX". If
+	// not provided, the "Accept-Language" header value is used.
+	Lang string `protobuf:"bytes,5,opt,name=lang,proto3" json:"lang,omitempty"`
+	// Restrict the permissions granted to the credentials, in the form
+	// "resource:action". Ignored if the activation code used already
+	// specifies a scope.
+	Scope []string `protobuf:"bytes,6,rep,name=scope,proto3" json:"scope,omitempty"`
+	// Device attestation statement; required to redeem user activation
+	// codes when enabled on the server.
+	Attestation *DeviceAttestation `protobuf:"bytes,7,opt,name=attestation,proto3" json:"attestation,omitempty"`
+	// Public key or device identifier; required to redeem activation codes
+	// bound to a device.
+	Device               string   `protobuf:"bytes,8,opt,name=device,proto3" json:"device,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -199,7 +440,7 @@ type CredentialsRequest struct {
 func (m *CredentialsRequest) Reset()      { *m = CredentialsRequest{} }
 func (*CredentialsRequest) ProtoMessage() {}
 func (*CredentialsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1f8a97bd56b0960, []int{3}
+	return fileDescriptor_f1f8a97bd56b0960, []int{6}
 }
 func (m *CredentialsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -256,6 +497,94 @@ func (m *CredentialsRequest) GetProof() []byte {
 	return nil
 }
 
+func (m *CredentialsRequest) GetLang() string {
+	if m != nil {
+		return m.Lang
+	}
+	return ""
+}
+
+func (m *CredentialsRequest) GetScope() []string {
+	if m != nil {
+		return m.Scope
+	}
+	return nil
+}
+
+func (m *CredentialsRequest) GetAttestation() *DeviceAttestation {
+	if m != nil {
+		return m.Attestation
+	}
+	return nil
+}
+
+func (m *CredentialsRequest) GetDevice() string {
+	if m != nil {
+		return m.Device
+	}
+	return ""
+}
+
+// Integrity statement produced by the mobile platform services. The
+// nonce value used to request the statement must be the SHA-256 digest
+// of the string "<did>:<activation_code>".
+type DeviceAttestation struct {
+	// Platform used to produce the statement: "android" (Play Integrity),
+	// "safetynet" or "ios" (DeviceCheck).
+	Platform string `protobuf:"bytes,1,opt,name=platform,proto3" json:"platform,omitempty"`
+	// Attestation token as returned by the platform services.
+	Token                string   `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeviceAttestation) Reset()      { *m = DeviceAttestation{} }
+func (*DeviceAttestation) ProtoMessage() {}
+func (*DeviceAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f8a97bd56b0960, []int{7}
+}
+func (m *DeviceAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeviceAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeviceAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeviceAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceAttestation.Merge(m, src)
+}
+func (m *DeviceAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeviceAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceAttestation proto.InternalMessageInfo
+
+func (m *DeviceAttestation) GetPlatform() string {
+	if m != nil {
+		return m.Platform
+	}
+	return ""
+}
+
+func (m *DeviceAttestation) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
 type RenewCredentialsRequest struct {
 	// Obtained when initially requesting the credential if it is renewable.
 	RefreshCode          string   `protobuf:"bytes,1,opt,name=refresh_code,json=refreshCode,proto3" json:"refresh_code,omitempty"`
@@ -267,7 +596,7 @@ type RenewCredentialsRequest struct {
 func (m *RenewCredentialsRequest) Reset()      { *m = RenewCredentialsRequest{} }
 func (*RenewCredentialsRequest) ProtoMessage() {}
 func (*RenewCredentialsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1f8a97bd56b0960, []int{4}
+	return fileDescriptor_f1f8a97bd56b0960, []int{8}
 }
 func (m *RenewCredentialsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -317,7 +646,7 @@ type CredentialsResponse struct {
 func (m *CredentialsResponse) Reset()      { *m = CredentialsResponse{} }
 func (*CredentialsResponse) ProtoMessage() {}
 func (*CredentialsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1f8a97bd56b0960, []int{5}
+	return fileDescriptor_f1f8a97bd56b0960, []int{9}
 }
 func (m *CredentialsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -362,16 +691,22 @@ func (m *CredentialsResponse) GetRefreshCode() string {
 
 type RecordRequest struct {
 	// New location records to process.
-	Records              []*LocationRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Records []*LocationRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	// Version of the client application, i.e. "1.4.2".
+	AppVersion string `protobuf:"bytes,2,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+	// Version of the SDK used by the client application, i.e. "0.9.0".
+	SdkVersion string `protobuf:"bytes,3,opt,name=sdk_version,json=sdkVersion,proto3" json:"sdk_version,omitempty"`
+	// Device platform, i.e. "android" or "ios".
+	Platform             string   `protobuf:"bytes,4,opt,name=platform,proto3" json:"platform,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *RecordRequest) Reset()      { *m = RecordRequest{} }
 func (*RecordRequest) ProtoMessage() {}
 func (*RecordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1f8a97bd56b0960, []int{6}
+	return fileDescriptor_f1f8a97bd56b0960, []int{10}
 }
 func (m *RecordRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -407,10 +742,36 @@ func (m *RecordRequest) GetRecords() []*LocationRecord {
 	return nil
 }
 
+func (m *RecordRequest) GetAppVersion() string {
+	if m != nil {
+		return m.AppVersion
+	}
+	return ""
+}
+
+func (m *RecordRequest) GetSdkVersion() string {
+	if m != nil {
+		return m.SdkVersion
+	}
+	return ""
+}
+
+func (m *RecordRequest) GetPlatform() string {
+	if m != nil {
+		return m.Platform
+	}
+	return ""
+}
+
 type RecordResponse struct {
 	// Whether the record(s) request was successfully received
 	// and handled.
-	Ok                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	Ok bool `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	// Processing status for each record, in the order submitted.
+	Records []*RecordStatus `protobuf:"bytes,2,rep,name=records,proto3" json:"records,omitempty"`
+	// Receipt identifier for records queued for the workers on broker
+	// ingestion mode, used to retrieve their final status.
+	Receipt              string   `protobuf:"bytes,3,opt,name=receipt,proto3" json:"receipt,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -419,7 +780,7 @@ type RecordResponse struct {
 func (m *RecordResponse) Reset()      { *m = RecordResponse{} }
 func (*RecordResponse) ProtoMessage() {}
 func (*RecordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1f8a97bd56b0960, []int{7}
+	return fileDescriptor_f1f8a97bd56b0960, []int{11}
 }
 func (m *RecordResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -455,28 +816,49 @@ func (m *RecordResponse) GetOk() bool {
 	return false
 }
 
-type NewIdentifierRequest struct {
-	// DID method to use for the generated identifier.
-	Method string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
-	// Whether to automatically publish the identifier once
-	// generated.
-	AutoPublish          bool     `protobuf:"varint,2,opt,name=auto_publish,json=autoPublish,proto3" json:"auto_publish,omitempty"`
+func (m *RecordResponse) GetRecords() []*RecordStatus {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *RecordResponse) GetReceipt() string {
+	if m != nil {
+		return m.Receipt
+	}
+	return ""
+}
+
+// Processing status for a single location record.
+type RecordStatus struct {
+	// Position of the record on the request.
+	Index int32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// Record hash, as submitted.
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// One of "accepted" (validated and stored), "pending" (queued for
+	// validation and storage by the workers) or "rejected".
+	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	// Reason code for rejected records: "invalid_did", "invalid_location",
+	// "not_generalized", "invalid_timestamp", "invalid_hash", "invalid_proof",
+	// "replayed_proof" or "quota_exceeded".
+	Reason               string   `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *NewIdentifierRequest) Reset()      { *m = NewIdentifierRequest{} }
-func (*NewIdentifierRequest) ProtoMessage() {}
-func (*NewIdentifierRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1f8a97bd56b0960, []int{8}
+func (m *RecordStatus) Reset()      { *m = RecordStatus{} }
+func (*RecordStatus) ProtoMessage() {}
+func (*RecordStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f8a97bd56b0960, []int{12}
 }
-func (m *NewIdentifierRequest) XXX_Unmarshal(b []byte) error {
+func (m *RecordStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *NewIdentifierRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RecordStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_NewIdentifierRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RecordStatus.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -486,51 +868,68 @@ func (m *NewIdentifierRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *NewIdentifierRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NewIdentifierRequest.Merge(m, src)
+func (m *RecordStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordStatus.Merge(m, src)
 }
-func (m *NewIdentifierRequest) XXX_Size() int {
+func (m *RecordStatus) XXX_Size() int {
 	return m.Size()
 }
-func (m *NewIdentifierRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_NewIdentifierRequest.DiscardUnknown(m)
+func (m *RecordStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordStatus.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NewIdentifierRequest proto.InternalMessageInfo
+var xxx_messageInfo_RecordStatus proto.InternalMessageInfo
 
-func (m *NewIdentifierRequest) GetMethod() string {
+func (m *RecordStatus) GetIndex() int32 {
 	if m != nil {
-		return m.Method
+		return m.Index
+	}
+	return 0
+}
+
+func (m *RecordStatus) GetHash() string {
+	if m != nil {
+		return m.Hash
 	}
 	return ""
 }
 
-func (m *NewIdentifierRequest) GetAutoPublish() bool {
+func (m *RecordStatus) GetStatus() string {
 	if m != nil {
-		return m.AutoPublish
+		return m.Status
 	}
-	return false
+	return ""
 }
 
-type NewIdentifierResponse struct {
-	// Base64-encoded DID document.
-	Document             string   `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+func (m *RecordStatus) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type CheckExposureRequest struct {
+	// Prefixes, 4 hex characters long, of the tokens for the location cells
+	// and time buckets visited, calculated as: SHA256(cell|bucket) in hex
+	// format. Cells are geohashes of 7 characters and buckets the sequential
+	// number of 5 minutes time windows.
+	Prefixes             []string `protobuf:"bytes,1,rep,name=prefixes,proto3" json:"prefixes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *NewIdentifierResponse) Reset()      { *m = NewIdentifierResponse{} }
-func (*NewIdentifierResponse) ProtoMessage() {}
-func (*NewIdentifierResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1f8a97bd56b0960, []int{9}
+func (m *CheckExposureRequest) Reset()      { *m = CheckExposureRequest{} }
+func (*CheckExposureRequest) ProtoMessage() {}
+func (*CheckExposureRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f8a97bd56b0960, []int{13}
 }
-func (m *NewIdentifierResponse) XXX_Unmarshal(b []byte) error {
+func (m *CheckExposureRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *NewIdentifierResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CheckExposureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_NewIdentifierResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CheckExposureRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -540,2070 +939,18001 @@ func (m *NewIdentifierResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *NewIdentifierResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NewIdentifierResponse.Merge(m, src)
+func (m *CheckExposureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckExposureRequest.Merge(m, src)
 }
-func (m *NewIdentifierResponse) XXX_Size() int {
+func (m *CheckExposureRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *NewIdentifierResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_NewIdentifierResponse.DiscardUnknown(m)
+func (m *CheckExposureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckExposureRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NewIdentifierResponse proto.InternalMessageInfo
+var xxx_messageInfo_CheckExposureRequest proto.InternalMessageInfo
 
-func (m *NewIdentifierResponse) GetDocument() string {
+func (m *CheckExposureRequest) GetPrefixes() []string {
 	if m != nil {
-		return m.Document
+		return m.Prefixes
 	}
-	return ""
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*PingResponse)(nil), "bryk.covid.proto.v1.PingResponse")
-	proto.RegisterType((*ActivationCodeRequest)(nil), "bryk.covid.proto.v1.ActivationCodeRequest")
-	proto.RegisterType((*ActivationCodeResponse)(nil), "bryk.covid.proto.v1.ActivationCodeResponse")
-	proto.RegisterType((*CredentialsRequest)(nil), "bryk.covid.proto.v1.CredentialsRequest")
-	proto.RegisterType((*RenewCredentialsRequest)(nil), "bryk.covid.proto.v1.RenewCredentialsRequest")
-	proto.RegisterType((*CredentialsResponse)(nil), "bryk.covid.proto.v1.CredentialsResponse")
-	proto.RegisterType((*RecordRequest)(nil), "bryk.covid.proto.v1.RecordRequest")
-	proto.RegisterType((*RecordResponse)(nil), "bryk.covid.proto.v1.RecordResponse")
-	proto.RegisterType((*NewIdentifierRequest)(nil), "bryk.covid.proto.v1.NewIdentifierRequest")
-	proto.RegisterType((*NewIdentifierResponse)(nil), "bryk.covid.proto.v1.NewIdentifierResponse")
+type CheckExposureResponse struct {
+	// Tokens for the cells and time buckets visited by diagnosed cases during
+	// their exposure window, matching the prefixes submitted.
+	Tokens []string `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	// UNIX timestamp for the date the diagnosed cases data was retrieved.
+	Updated              int64    `protobuf:"varint,2,opt,name=updated,proto3" json:"updated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("proto/v1/tracking_server_api.proto", fileDescriptor_f1f8a97bd56b0960) }
-
-var fileDescriptor_f1f8a97bd56b0960 = []byte{
-	// 781 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x41, 0x6f, 0x12, 0x4f,
-	0x1c, 0xfd, 0x0f, 0xf4, 0x4f, 0xeb, 0x40, 0xb1, 0x0e, 0x2d, 0xa5, 0x5b, 0xb3, 0x81, 0xa9, 0x49,
-	0x11, 0x75, 0x09, 0xed, 0xc1, 0xa4, 0xb1, 0x87, 0x42, 0x3c, 0x34, 0x31, 0x0d, 0xae, 0x4d, 0x4d,
-	0xb4, 0x09, 0x59, 0x76, 0x07, 0x98, 0x00, 0x3b, 0xeb, 0xee, 0x42, 0xd3, 0x5b, 0xe3, 0xcd, 0x44,
-	0x13, 0x13, 0x4f, 0x5e, 0x3d, 0x19, 0x3f, 0x81, 0x47, 0x8f, 0xc6, 0x93, 0x89, 0x17, 0x8f, 0x85,
-	0xf8, 0x01, 0x3c, 0x7a, 0x34, 0x3b, 0x3b, 0x8b, 0x40, 0xb7, 0xda, 0xde, 0x76, 0xde, 0xfc, 0x7e,
-	0xef, 0xbd, 0x81, 0xf7, 0x20, 0xb6, 0x6c, 0xe6, 0xb2, 0x62, 0xbf, 0x54, 0x74, 0x6d, 0x4d, 0x6f,
-	0x53, 0xb3, 0x59, 0x73, 0x88, 0xdd, 0x27, 0x76, 0x4d, 0xb3, 0xa8, 0xc2, 0x2f, 0x51, 0xaa, 0x6e,
-	0x1f, 0xb7, 0x15, 0x9d, 0xf5, 0xa9, 0xe1, 0x23, 0x4a, 0xbf, 0x24, 0xdd, 0x6d, 0x52, 0xb7, 0xd5,
-	0xab, 0x2b, 0x3a, 0xeb, 0x16, 0x9b, 0xac, 0xc9, 0x8a, 0x4d, 0xc6, 0x9a, 0x1d, 0xa2, 0x59, 0xd4,
-	0x11, 0x9f, 0x45, 0xcd, 0xa2, 0x45, 0xcd, 0x34, 0x99, 0xab, 0xb9, 0x94, 0x99, 0x8e, 0xbf, 0x2b,
-	0xdd, 0x99, 0x5e, 0xe4, 0x70, 0xbd, 0xd7, 0xe0, 0x27, 0xdf, 0x8e, 0xf7, 0x25, 0xc6, 0x57, 0x05,
-	0xd9, 0x68, 0x8a, 0x74, 0x2d, 0xf7, 0x58, 0x5c, 0x2e, 0x8d, 0xdc, 0xfb, 0xa6, 0x7d, 0x18, 0xcb,
-	0x30, 0x51, 0xa5, 0x66, 0x53, 0x25, 0x8e, 0xc5, 0x4c, 0x87, 0xa0, 0x24, 0x8c, 0xb0, 0x76, 0x06,
-	0x64, 0x41, 0x7e, 0x4e, 0x8d, 0xb0, 0x36, 0xde, 0x86, 0x4b, 0x3b, 0xba, 0x4b, 0xfb, 0xdc, 0x57,
-	0x85, 0x19, 0x44, 0x25, 0xcf, 0x7a, 0xc4, 0x71, 0xd1, 0x02, 0x8c, 0x1a, 0xd4, 0xe0, 0x93, 0x57,
-	0x54, 0xef, 0x13, 0x21, 0x38, 0x63, 0xb3, 0x0e, 0xc9, 0x44, 0x38, 0xc4, 0xbf, 0xf1, 0x0e, 0x4c,
-	0x4f, 0xaf, 0x0b, 0xa1, 0x75, 0x78, 0x55, 0x1b, 0xdd, 0xd4, 0x74, 0x66, 0x10, 0xc1, 0x95, 0xd4,
-	0x26, 0x16, 0xf0, 0x31, 0x44, 0x15, 0x9b, 0x18, 0xc4, 0x74, 0xa9, 0xd6, 0x71, 0x2e, 0x25, 0x1f,
-	0x26, 0x12, 0x0d, 0x13, 0x41, 0x8b, 0xf0, 0x7f, 0xcb, 0x66, 0xac, 0x91, 0x99, 0xc9, 0x82, 0x7c,
-	0x42, 0xf5, 0x0f, 0xf8, 0x1e, 0x5c, 0x56, 0x89, 0x49, 0x8e, 0x42, 0xf4, 0x73, 0x30, 0x61, 0x93,
-	0x86, 0x4d, 0x9c, 0xd6, 0xb8, 0xf7, 0xb8, 0xc0, 0xb8, 0xf1, 0xa7, 0x30, 0x35, 0xb1, 0x28, 0x1e,
-	0x9e, 0x83, 0x09, 0x4d, 0xd7, 0x89, 0xe3, 0xd4, 0x5c, 0xd6, 0x26, 0x66, 0xb0, 0xe9, 0x63, 0xfb,
-	0x1e, 0x74, 0x86, 0x3c, 0x72, 0x96, 0x7c, 0x0f, 0xce, 0xab, 0x44, 0x67, 0xb6, 0x11, 0x18, 0xda,
-	0x86, 0xb3, 0x36, 0x07, 0x9c, 0x0c, 0xc8, 0x46, 0xf3, 0xf1, 0x8d, 0x35, 0x25, 0x24, 0x8b, 0xca,
-	0x03, 0xa6, 0xf3, 0x57, 0x8b, 0xe5, 0x60, 0x07, 0x67, 0x61, 0x32, 0xe0, 0x3b, 0x27, 0x09, 0x0f,
-	0xe1, 0xe2, 0x1e, 0x39, 0xda, 0xe5, 0xef, 0x69, 0x50, 0x62, 0x07, 0xc2, 0x69, 0x18, 0xeb, 0x12,
-	0xb7, 0xc5, 0x82, 0x3f, 0x43, 0x9c, 0xf8, 0x3b, 0x7b, 0x2e, 0xab, 0x59, 0xbd, 0x7a, 0x87, 0x3a,
-	0x2d, 0xfe, 0x88, 0x39, 0x35, 0xee, 0x61, 0x55, 0x1f, 0xc2, 0x9b, 0x70, 0x69, 0x8a, 0x52, 0x68,
-	0x4b, 0x70, 0xce, 0x60, 0x7a, 0xaf, 0x4b, 0x4c, 0x57, 0xb0, 0x8e, 0xce, 0x1b, 0x2f, 0x63, 0xf0,
-	0xda, 0xbe, 0x28, 0xe0, 0x23, 0x1e, 0xe5, 0x9d, 0xea, 0x2e, 0x7a, 0x0c, 0x67, 0xbc, 0x1c, 0xa3,
-	0xb4, 0xe2, 0x97, 0x40, 0x09, 0x4a, 0xa0, 0xdc, 0xf7, 0x4a, 0x20, 0xe5, 0x42, 0x7f, 0x8d, 0xf1,
-	0xe8, 0xe3, 0xc5, 0xe7, 0xdf, 0x7e, 0xbc, 0x89, 0x24, 0x51, 0xc2, 0x2b, 0x89, 0x57, 0x48, 0xcb,
-	0x23, 0x7c, 0x05, 0x60, 0x72, 0x32, 0xc2, 0xa8, 0x10, 0xca, 0x15, 0x5a, 0x13, 0xe9, 0xd6, 0x85,
-	0x66, 0x85, 0x03, 0xcc, 0x1d, 0x5c, 0xc7, 0xcb, 0x81, 0x83, 0xa9, 0xf0, 0x6e, 0x81, 0x02, 0x3a,
-	0x01, 0x30, 0x3e, 0x16, 0x2b, 0xb4, 0x1e, 0x2a, 0x70, 0x36, 0xb1, 0x52, 0xfe, 0xdf, 0x83, 0xc2,
-	0x86, 0xcc, 0x6d, 0x64, 0x70, 0x2a, 0xb0, 0xa1, 0xff, 0x19, 0xf2, 0x2c, 0xbc, 0x06, 0x70, 0x61,
-	0xba, 0x17, 0xe8, 0x76, 0x28, 0xfd, 0x39, 0xf5, 0xb9, 0x84, 0x99, 0x1b, 0xdc, 0x8c, 0x8c, 0x57,
-	0x42, 0xcc, 0xd4, 0x6c, 0x8f, 0xde, 0xb3, 0xd4, 0x81, 0x31, 0x3f, 0xbe, 0x08, 0x9f, 0xe3, 0x63,
-	0xac, 0x2b, 0xd2, 0xda, 0x5f, 0x67, 0x84, 0xf0, 0x0a, 0x17, 0x4e, 0xe1, 0x64, 0x20, 0xec, 0x57,
-	0xc5, 0x53, 0x7b, 0x01, 0xe0, 0xfc, 0x44, 0x70, 0xd1, 0xcd, 0x50, 0xc6, 0xb0, 0xbe, 0x48, 0x85,
-	0x8b, 0x8c, 0x0a, 0x0f, 0x39, 0xee, 0x61, 0x15, 0xa7, 0x03, 0x0f, 0x26, 0x39, 0xaa, 0xd1, 0xd1,
-	0xdc, 0x16, 0x28, 0x94, 0xdf, 0x82, 0xef, 0x03, 0xf9, 0xbf, 0xd3, 0x81, 0x0c, 0x7e, 0x0e, 0x64,
-	0xf0, 0x6b, 0x20, 0x83, 0x93, 0xa1, 0x0c, 0xde, 0x0f, 0x65, 0xf0, 0x71, 0x28, 0x83, 0x4f, 0x43,
-	0x19, 0x7c, 0x1e, 0xca, 0xe0, 0xeb, 0x50, 0x06, 0xa7, 0x43, 0x19, 0xc0, 0x34, 0x65, 0x61, 0xfa,
-	0xe5, 0xf4, 0x54, 0xa5, 0x2c, 0x5a, 0xf5, 0xae, 0xaa, 0xe0, 0xc9, 0x2c, 0x9f, 0xe9, 0x97, 0xde,
-	0x45, 0xa2, 0xe5, 0x4a, 0xf5, 0x43, 0x24, 0x55, 0xf6, 0xd6, 0x2b, 0x7c, 0x9d, 0xcf, 0x28, 0x07,
-	0xa5, 0x2f, 0x3e, 0x7a, 0xc8, 0xd1, 0x43, 0x8e, 0x1e, 0x1e, 0x94, 0xea, 0x31, 0xbe, 0xba, 0xf9,
-	0x3b, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x7f, 0xec, 0x59, 0x3a, 0x07, 0x00, 0x00,
+func (m *CheckExposureResponse) Reset()      { *m = CheckExposureResponse{} }
+func (*CheckExposureResponse) ProtoMessage() {}
+func (*CheckExposureResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f8a97bd56b0960, []int{14}
 }
-
-func (this *PingResponse) VerboseEqual(that interface{}) error {
-	if that == nil {
-		if this == nil {
-			return nil
+func (m *CheckExposureResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckExposureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckExposureResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		return fmt.Errorf("that == nil && this != nil")
+		return b[:n], nil
 	}
+}
+func (m *CheckExposureResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckExposureResponse.Merge(m, src)
+}
+func (m *CheckExposureResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckExposureResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckExposureResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*PingResponse)
-	if !ok {
-		that2, ok := that.(PingResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return fmt.Errorf("that is not of type *PingResponse")
-		}
-	}
-	if that1 == nil {
-		if this == nil {
-			return nil
-		}
-		return fmt.Errorf("that is type *PingResponse but is nil && this != nil")
-	} else if this == nil {
-		return fmt.Errorf("that is type *PingResponse but is not nil && this == nil")
-	}
-	if this.Ok != that1.Ok {
-		return fmt.Errorf("Ok this(%v) Not Equal that(%v)", this.Ok, that1.Ok)
-	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+var xxx_messageInfo_CheckExposureResponse proto.InternalMessageInfo
+
+func (m *CheckExposureResponse) GetTokens() []string {
+	if m != nil {
+		return m.Tokens
 	}
 	return nil
 }
-func (this *PingResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*PingResponse)
-	if !ok {
-		that2, ok := that.(PingResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Ok != that1.Ok {
-		return false
-	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
+func (m *CheckExposureResponse) GetUpdated() int64 {
+	if m != nil {
+		return m.Updated
 	}
-	return true
+	return 0
 }
-func (this *ActivationCodeRequest) VerboseEqual(that interface{}) error {
-	if that == nil {
-		if this == nil {
-			return nil
+
+type PSIRequest struct {
+	// Tokens for the location cells and time buckets visited, mapped to P-256
+	// curve points and multiplied by a random scalar; in compressed format.
+	Blinded              [][]byte `protobuf:"bytes,1,rep,name=blinded,proto3" json:"blinded,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PSIRequest) Reset()      { *m = PSIRequest{} }
+func (*PSIRequest) ProtoMessage() {}
+func (*PSIRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f8a97bd56b0960, []int{15}
+}
+func (m *PSIRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PSIRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PSIRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		return fmt.Errorf("that == nil && this != nil")
+		return b[:n], nil
 	}
+}
+func (m *PSIRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PSIRequest.Merge(m, src)
+}
+func (m *PSIRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PSIRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PSIRequest.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*ActivationCodeRequest)
-	if !ok {
-		that2, ok := that.(ActivationCodeRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return fmt.Errorf("that is not of type *ActivationCodeRequest")
-		}
+var xxx_messageInfo_PSIRequest proto.InternalMessageInfo
+
+func (m *PSIRequest) GetBlinded() [][]byte {
+	if m != nil {
+		return m.Blinded
 	}
-	if that1 == nil {
-		if this == nil {
-			return nil
+	return nil
+}
+
+type PSIResponse struct {
+	// Blinded points submitted, multiplied by the server's secret scalar; in
+	// the same order.
+	Evaluated [][]byte `protobuf:"bytes,1,rep,name=evaluated,proto3" json:"evaluated,omitempty"`
+	// Tokens for the cells and time buckets visited by diagnosed cases during
+	// their exposure window, encoded with the server's secret scalar.
+	Set []string `protobuf:"bytes,2,rep,name=set,proto3" json:"set,omitempty"`
+	// UNIX timestamp for the date the diagnosed cases data was retrieved.
+	Updated              int64    `protobuf:"varint,3,opt,name=updated,proto3" json:"updated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PSIResponse) Reset()      { *m = PSIResponse{} }
+func (*PSIResponse) ProtoMessage() {}
+func (*PSIResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f8a97bd56b0960, []int{16}
+}
+func (m *PSIResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PSIResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PSIResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		return fmt.Errorf("that is type *ActivationCodeRequest but is nil && this != nil")
-	} else if this == nil {
-		return fmt.Errorf("that is type *ActivationCodeRequest but is not nil && this == nil")
-	}
-	if this.Did != that1.Did {
-		return fmt.Errorf("Did this(%v) Not Equal that(%v)", this.Did, that1.Did)
+		return b[:n], nil
 	}
-	if this.Role != that1.Role {
-		return fmt.Errorf("Role this(%v) Not Equal that(%v)", this.Role, that1.Role)
+}
+func (m *PSIResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PSIResponse.Merge(m, src)
+}
+func (m *PSIResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PSIResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PSIResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PSIResponse proto.InternalMessageInfo
+
+func (m *PSIResponse) GetEvaluated() [][]byte {
+	if m != nil {
+		return m.Evaluated
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	return nil
+}
+
+func (m *PSIResponse) GetSet() []string {
+	if m != nil {
+		return m.Set
 	}
 	return nil
 }
-func (this *ActivationCodeRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *PSIResponse) GetUpdated() int64 {
+	if m != nil {
+		return m.Updated
 	}
+	return 0
+}
 
-	that1, ok := that.(*ActivationCodeRequest)
-	if !ok {
-		that2, ok := that.(ActivationCodeRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type SamplingPolicy struct {
+	// Either "normal" or "exposure_risk", for users with a recent exposure.
+	Mode string `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	// Seconds between location records.
+	RecordInterval uint32 `protobuf:"varint,2,opt,name=record_interval,json=recordInterval,proto3" json:"record_interval,omitempty"`
+	// Seconds between uploads of the records collected.
+	UploadInterval uint32 `protobuf:"varint,3,opt,name=upload_interval,json=uploadInterval,proto3" json:"upload_interval,omitempty"`
+	// Minimum distance, in meters, between consecutive records. A zero value
+	// produces a record on every interval.
+	MinDistance uint32 `protobuf:"varint,4,opt,name=min_distance,json=minDistance,proto3" json:"min_distance,omitempty"`
+	// Seconds before the policy should be retrieved again.
+	RefreshInterval      uint32   `protobuf:"varint,5,opt,name=refresh_interval,json=refreshInterval,proto3" json:"refresh_interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SamplingPolicy) Reset()      { *m = SamplingPolicy{} }
+func (*SamplingPolicy) ProtoMessage() {}
+func (*SamplingPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f8a97bd56b0960, []int{17}
+}
+func (m *SamplingPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SamplingPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SamplingPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *SamplingPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SamplingPolicy.Merge(m, src)
+}
+func (m *SamplingPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *SamplingPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_SamplingPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SamplingPolicy proto.InternalMessageInfo
+
+func (m *SamplingPolicy) GetMode() string {
+	if m != nil {
+		return m.Mode
 	}
-	if this.Did != that1.Did {
-		return false
+	return ""
+}
+
+func (m *SamplingPolicy) GetRecordInterval() uint32 {
+	if m != nil {
+		return m.RecordInterval
 	}
-	if this.Role != that1.Role {
-		return false
+	return 0
+}
+
+func (m *SamplingPolicy) GetUploadInterval() uint32 {
+	if m != nil {
+		return m.UploadInterval
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
+	return 0
+}
+
+func (m *SamplingPolicy) GetMinDistance() uint32 {
+	if m != nil {
+		return m.MinDistance
 	}
-	return true
+	return 0
 }
-func (this *ActivationCodeResponse) VerboseEqual(that interface{}) error {
-	if that == nil {
-		if this == nil {
-			return nil
-		}
-		return fmt.Errorf("that == nil && this != nil")
+
+func (m *SamplingPolicy) GetRefreshInterval() uint32 {
+	if m != nil {
+		return m.RefreshInterval
 	}
+	return 0
+}
 
-	that1, ok := that.(*ActivationCodeResponse)
-	if !ok {
-		that2, ok := that.(ActivationCodeResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return fmt.Errorf("that is not of type *ActivationCodeResponse")
-		}
-	}
-	if that1 == nil {
-		if this == nil {
-			return nil
-		}
-		return fmt.Errorf("that is type *ActivationCodeResponse but is nil && this != nil")
-	} else if this == nil {
-		return fmt.Errorf("that is type *ActivationCodeResponse but is not nil && this == nil")
-	}
-	if this.ActivationCode != that1.ActivationCode {
-		return fmt.Errorf("ActivationCode this(%v) Not Equal that(%v)", this.ActivationCode, that1.ActivationCode)
-	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
-	}
-	return nil
+type SubmissionStatusRequest struct {
+	// Receipt identifier returned when the records were submitted.
+	Receipt              string   `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (this *ActivationCodeResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*ActivationCodeResponse)
-	if !ok {
-		that2, ok := that.(ActivationCodeResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.ActivationCode != that1.ActivationCode {
-		return false
-	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
-	}
-	return true
+func (m *SubmissionStatusRequest) Reset()      { *m = SubmissionStatusRequest{} }
+func (*SubmissionStatusRequest) ProtoMessage() {}
+func (*SubmissionStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f8a97bd56b0960, []int{18}
 }
-func (this *CredentialsRequest) VerboseEqual(that interface{}) error {
-	if that == nil {
-		if this == nil {
-			return nil
+func (m *SubmissionStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubmissionStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubmissionStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		return fmt.Errorf("that == nil && this != nil")
+		return b[:n], nil
 	}
+}
+func (m *SubmissionStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmissionStatusRequest.Merge(m, src)
+}
+func (m *SubmissionStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubmissionStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmissionStatusRequest.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*CredentialsRequest)
-	if !ok {
-		that2, ok := that.(CredentialsRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return fmt.Errorf("that is not of type *CredentialsRequest")
-		}
-	}
-	if that1 == nil {
-		if this == nil {
-			return nil
-		}
-		return fmt.Errorf("that is type *CredentialsRequest but is nil && this != nil")
-	} else if this == nil {
-		return fmt.Errorf("that is type *CredentialsRequest but is not nil && this == nil")
-	}
-	if this.Did != that1.Did {
-		return fmt.Errorf("Did this(%v) Not Equal that(%v)", this.Did, that1.Did)
-	}
-	if this.Role != that1.Role {
-		return fmt.Errorf("Role this(%v) Not Equal that(%v)", this.Role, that1.Role)
-	}
-	if this.ActivationCode != that1.ActivationCode {
-		return fmt.Errorf("ActivationCode this(%v) Not Equal that(%v)", this.ActivationCode, that1.ActivationCode)
-	}
-	if !bytes.Equal(this.Proof, that1.Proof) {
-		return fmt.Errorf("Proof this(%v) Not Equal that(%v)", this.Proof, that1.Proof)
-	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+var xxx_messageInfo_SubmissionStatusRequest proto.InternalMessageInfo
+
+func (m *SubmissionStatusRequest) GetReceipt() string {
+	if m != nil {
+		return m.Receipt
 	}
-	return nil
+	return ""
 }
-func (this *CredentialsRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*CredentialsRequest)
-	if !ok {
-		that2, ok := that.(CredentialsRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Did != that1.Did {
-		return false
-	}
-	if this.Role != that1.Role {
-		return false
-	}
-	if this.ActivationCode != that1.ActivationCode {
-		return false
-	}
-	if !bytes.Equal(this.Proof, that1.Proof) {
-		return false
-	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
-	}
-	return true
+type SubmissionStatusResponse struct {
+	// Receipt identifier.
+	Receipt string `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	// Either "pending", while the records are queued for the workers,
+	// "processed" or "failed" if the records couldn't be stored.
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Processing status for each record, in the order submitted.
+	Records []*RecordStatus `protobuf:"bytes,3,rep,name=records,proto3" json:"records,omitempty"`
+	// UNIX timestamp for the submission date.
+	Created int64 `protobuf:"varint,4,opt,name=created,proto3" json:"created,omitempty"`
+	// UNIX timestamp for the date the records were processed, if any.
+	Processed            int64    `protobuf:"varint,5,opt,name=processed,proto3" json:"processed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (this *RenewCredentialsRequest) VerboseEqual(that interface{}) error {
-	if that == nil {
-		if this == nil {
-			return nil
-		}
-		return fmt.Errorf("that == nil && this != nil")
-	}
 
-	that1, ok := that.(*RenewCredentialsRequest)
-	if !ok {
-		that2, ok := that.(RenewCredentialsRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return fmt.Errorf("that is not of type *RenewCredentialsRequest")
+func (m *SubmissionStatusResponse) Reset()      { *m = SubmissionStatusResponse{} }
+func (*SubmissionStatusResponse) ProtoMessage() {}
+func (*SubmissionStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f8a97bd56b0960, []int{19}
+}
+func (m *SubmissionStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubmissionStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubmissionStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		if this == nil {
-			return nil
-		}
-		return fmt.Errorf("that is type *RenewCredentialsRequest but is nil && this != nil")
-	} else if this == nil {
-		return fmt.Errorf("that is type *RenewCredentialsRequest but is not nil && this == nil")
+}
+func (m *SubmissionStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmissionStatusResponse.Merge(m, src)
+}
+func (m *SubmissionStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubmissionStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmissionStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmissionStatusResponse proto.InternalMessageInfo
+
+func (m *SubmissionStatusResponse) GetReceipt() string {
+	if m != nil {
+		return m.Receipt
 	}
-	if this.RefreshCode != that1.RefreshCode {
-		return fmt.Errorf("RefreshCode this(%v) Not Equal that(%v)", this.RefreshCode, that1.RefreshCode)
+	return ""
+}
+
+func (m *SubmissionStatusResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	return ""
+}
+
+func (m *SubmissionStatusResponse) GetRecords() []*RecordStatus {
+	if m != nil {
+		return m.Records
 	}
 	return nil
 }
-func (this *RenewCredentialsRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*RenewCredentialsRequest)
-	if !ok {
-		that2, ok := that.(RenewCredentialsRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.RefreshCode != that1.RefreshCode {
-		return false
-	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
+func (m *SubmissionStatusResponse) GetCreated() int64 {
+	if m != nil {
+		return m.Created
 	}
-	return true
+	return 0
 }
-func (this *CredentialsResponse) VerboseEqual(that interface{}) error {
-	if that == nil {
-		if this == nil {
-			return nil
-		}
-		return fmt.Errorf("that == nil && this != nil")
-	}
 
-	that1, ok := that.(*CredentialsResponse)
-	if !ok {
-		that2, ok := that.(CredentialsResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return fmt.Errorf("that is not of type *CredentialsResponse")
-		}
-	}
-	if that1 == nil {
-		if this == nil {
-			return nil
-		}
-		return fmt.Errorf("that is type *CredentialsResponse but is nil && this != nil")
-	} else if this == nil {
-		return fmt.Errorf("that is type *CredentialsResponse but is not nil && this == nil")
-	}
-	if this.AccessToken != that1.AccessToken {
-		return fmt.Errorf("AccessToken this(%v) Not Equal that(%v)", this.AccessToken, that1.AccessToken)
-	}
-	if this.RefreshCode != that1.RefreshCode {
-		return fmt.Errorf("RefreshCode this(%v) Not Equal that(%v)", this.RefreshCode, that1.RefreshCode)
-	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+func (m *SubmissionStatusResponse) GetProcessed() int64 {
+	if m != nil {
+		return m.Processed
 	}
-	return nil
+	return 0
 }
-func (this *CredentialsResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*CredentialsResponse)
-	if !ok {
-		that2, ok := that.(CredentialsResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.AccessToken != that1.AccessToken {
-		return false
-	}
-	if this.RefreshCode != that1.RefreshCode {
-		return false
-	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
-	}
-	return true
+type NewIdentifierRequest struct {
+	// DID method to use for the generated identifier.
+	Method string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	// Whether to automatically publish the identifier once
+	// generated.
+	AutoPublish          bool     `protobuf:"varint,2,opt,name=auto_publish,json=autoPublish,proto3" json:"auto_publish,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (this *RecordRequest) VerboseEqual(that interface{}) error {
-	if that == nil {
-		if this == nil {
-			return nil
-		}
-		return fmt.Errorf("that == nil && this != nil")
-	}
 
-	that1, ok := that.(*RecordRequest)
-	if !ok {
-		that2, ok := that.(RecordRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return fmt.Errorf("that is not of type *RecordRequest")
-		}
-	}
-	if that1 == nil {
-		if this == nil {
-			return nil
-		}
-		return fmt.Errorf("that is type *RecordRequest but is nil && this != nil")
-	} else if this == nil {
-		return fmt.Errorf("that is type *RecordRequest but is not nil && this == nil")
-	}
-	if len(this.Records) != len(that1.Records) {
-		return fmt.Errorf("Records this(%v) Not Equal that(%v)", len(this.Records), len(that1.Records))
-	}
-	for i := range this.Records {
-		if !this.Records[i].Equal(that1.Records[i]) {
-			return fmt.Errorf("Records this[%v](%v) Not Equal that[%v](%v)", i, this.Records[i], i, that1.Records[i])
+func (m *NewIdentifierRequest) Reset()      { *m = NewIdentifierRequest{} }
+func (*NewIdentifierRequest) ProtoMessage() {}
+func (*NewIdentifierRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f8a97bd56b0960, []int{20}
+}
+func (m *NewIdentifierRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NewIdentifierRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NewIdentifierRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+}
+func (m *NewIdentifierRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NewIdentifierRequest.Merge(m, src)
+}
+func (m *NewIdentifierRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *NewIdentifierRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NewIdentifierRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NewIdentifierRequest proto.InternalMessageInfo
+
+func (m *NewIdentifierRequest) GetMethod() string {
+	if m != nil {
+		return m.Method
 	}
-	return nil
+	return ""
 }
-func (this *RecordRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *NewIdentifierRequest) GetAutoPublish() bool {
+	if m != nil {
+		return m.AutoPublish
 	}
+	return false
+}
 
-	that1, ok := that.(*RecordRequest)
-	if !ok {
-		that2, ok := that.(RecordRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type NewIdentifierResponse struct {
+	// Base64-encoded DID document.
+	Document             string   `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NewIdentifierResponse) Reset()      { *m = NewIdentifierResponse{} }
+func (*NewIdentifierResponse) ProtoMessage() {}
+func (*NewIdentifierResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f8a97bd56b0960, []int{21}
+}
+func (m *NewIdentifierResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NewIdentifierResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NewIdentifierResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.Records) != len(that1.Records) {
-		return false
+}
+func (m *NewIdentifierResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NewIdentifierResponse.Merge(m, src)
+}
+func (m *NewIdentifierResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *NewIdentifierResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NewIdentifierResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NewIdentifierResponse proto.InternalMessageInfo
+
+func (m *NewIdentifierResponse) GetDocument() string {
+	if m != nil {
+		return m.Document
 	}
-	for i := range this.Records {
-		if !this.Records[i].Equal(that1.Records[i]) {
-			return false
+	return ""
+}
+
+type RegisterVenueRequest struct {
+	// Display name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Latitude.
+	Lat float32 `protobuf:"fixed32,2,opt,name=lat,proto3" json:"lat,omitempty"`
+	// Longitude.
+	Lng                  float32  `protobuf:"fixed32,3,opt,name=lng,proto3" json:"lng,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegisterVenueRequest) Reset()      { *m = RegisterVenueRequest{} }
+func (*RegisterVenueRequest) ProtoMessage() {}
+func (*RegisterVenueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f8a97bd56b0960, []int{22}
+}
+func (m *RegisterVenueRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisterVenueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisterVenueRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
+}
+func (m *RegisterVenueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterVenueRequest.Merge(m, src)
+}
+func (m *RegisterVenueRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisterVenueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterVenueRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterVenueRequest proto.InternalMessageInfo
+
+func (m *RegisterVenueRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return true
+	return ""
 }
-func (this *RecordResponse) VerboseEqual(that interface{}) error {
-	if that == nil {
-		if this == nil {
-			return nil
-		}
-		return fmt.Errorf("that == nil && this != nil")
+
+func (m *RegisterVenueRequest) GetLat() float32 {
+	if m != nil {
+		return m.Lat
 	}
+	return 0
+}
 
-	that1, ok := that.(*RecordResponse)
-	if !ok {
-		that2, ok := that.(RecordResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return fmt.Errorf("that is not of type *RecordResponse")
-		}
+func (m *RegisterVenueRequest) GetLng() float32 {
+	if m != nil {
+		return m.Lng
 	}
-	if that1 == nil {
-		if this == nil {
-			return nil
+	return 0
+}
+
+type CheckInRequest struct {
+	// New check-in records to process.
+	Records              []*CheckInRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *CheckInRequest) Reset()      { *m = CheckInRequest{} }
+func (*CheckInRequest) ProtoMessage() {}
+func (*CheckInRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f8a97bd56b0960, []int{23}
+}
+func (m *CheckInRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckInRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckInRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		return fmt.Errorf("that is type *RecordResponse but is nil && this != nil")
-	} else if this == nil {
-		return fmt.Errorf("that is type *RecordResponse but is not nil && this == nil")
-	}
-	if this.Ok != that1.Ok {
-		return fmt.Errorf("Ok this(%v) Not Equal that(%v)", this.Ok, that1.Ok)
+		return b[:n], nil
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+}
+func (m *CheckInRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckInRequest.Merge(m, src)
+}
+func (m *CheckInRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckInRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckInRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckInRequest proto.InternalMessageInfo
+
+func (m *CheckInRequest) GetRecords() []*CheckInRecord {
+	if m != nil {
+		return m.Records
 	}
 	return nil
 }
-func (this *RecordResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*RecordResponse)
-	if !ok {
-		that2, ok := that.(RecordResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Ok != that1.Ok {
-		return false
-	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
-	}
-	return true
+type CheckInResponse struct {
+	// Whether the check-in request was successfully received
+	// and handled.
+	Ok                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (this *NewIdentifierRequest) VerboseEqual(that interface{}) error {
-	if that == nil {
-		if this == nil {
-			return nil
+
+func (m *CheckInResponse) Reset()      { *m = CheckInResponse{} }
+func (*CheckInResponse) ProtoMessage() {}
+func (*CheckInResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f8a97bd56b0960, []int{24}
+}
+func (m *CheckInResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckInResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckInResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		return fmt.Errorf("that == nil && this != nil")
+		return b[:n], nil
 	}
+}
+func (m *CheckInResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckInResponse.Merge(m, src)
+}
+func (m *CheckInResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckInResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckInResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*NewIdentifierRequest)
-	if !ok {
-		that2, ok := that.(NewIdentifierRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return fmt.Errorf("that is not of type *NewIdentifierRequest")
-		}
+var xxx_messageInfo_CheckInResponse proto.InternalMessageInfo
+
+func (m *CheckInResponse) GetOk() bool {
+	if m != nil {
+		return m.Ok
 	}
-	if that1 == nil {
-		if this == nil {
-			return nil
+	return false
+}
+
+type VenueOutbreakRequest struct {
+	// Venue identifier.
+	Venue string `protobuf:"bytes,1,opt,name=venue,proto3" json:"venue,omitempty"`
+	// Beginning of the exposure window (in seconds and for UTC).
+	From int64 `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	// End of the exposure window (in seconds and for UTC).
+	To                   int64    `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VenueOutbreakRequest) Reset()      { *m = VenueOutbreakRequest{} }
+func (*VenueOutbreakRequest) ProtoMessage() {}
+func (*VenueOutbreakRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f8a97bd56b0960, []int{25}
+}
+func (m *VenueOutbreakRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VenueOutbreakRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VenueOutbreakRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		return fmt.Errorf("that is type *NewIdentifierRequest but is nil && this != nil")
-	} else if this == nil {
-		return fmt.Errorf("that is type *NewIdentifierRequest but is not nil && this == nil")
-	}
-	if this.Method != that1.Method {
-		return fmt.Errorf("Method this(%v) Not Equal that(%v)", this.Method, that1.Method)
+		return b[:n], nil
 	}
-	if this.AutoPublish != that1.AutoPublish {
-		return fmt.Errorf("AutoPublish this(%v) Not Equal that(%v)", this.AutoPublish, that1.AutoPublish)
+}
+func (m *VenueOutbreakRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VenueOutbreakRequest.Merge(m, src)
+}
+func (m *VenueOutbreakRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *VenueOutbreakRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VenueOutbreakRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VenueOutbreakRequest proto.InternalMessageInfo
+
+func (m *VenueOutbreakRequest) GetVenue() string {
+	if m != nil {
+		return m.Venue
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	return ""
+}
+
+func (m *VenueOutbreakRequest) GetFrom() int64 {
+	if m != nil {
+		return m.From
 	}
-	return nil
+	return 0
 }
-func (this *NewIdentifierRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *VenueOutbreakRequest) GetTo() int64 {
+	if m != nil {
+		return m.To
 	}
+	return 0
+}
 
-	that1, ok := that.(*NewIdentifierRequest)
-	if !ok {
-		that2, ok := that.(NewIdentifierRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type VenueOutbreakResponse struct {
+	// Whether the outbreak alert was successfully received
+	// and handled.
+	Ok                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VenueOutbreakResponse) Reset()      { *m = VenueOutbreakResponse{} }
+func (*VenueOutbreakResponse) ProtoMessage() {}
+func (*VenueOutbreakResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f8a97bd56b0960, []int{26}
+}
+func (m *VenueOutbreakResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VenueOutbreakResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VenueOutbreakResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Method != that1.Method {
-		return false
-	}
-	if this.AutoPublish != that1.AutoPublish {
-		return false
-	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
-	}
-	return true
 }
-func (this *NewIdentifierResponse) VerboseEqual(that interface{}) error {
-	if that == nil {
-		if this == nil {
-			return nil
-		}
-		return fmt.Errorf("that == nil && this != nil")
+func (m *VenueOutbreakResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VenueOutbreakResponse.Merge(m, src)
+}
+func (m *VenueOutbreakResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *VenueOutbreakResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VenueOutbreakResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VenueOutbreakResponse proto.InternalMessageInfo
+
+func (m *VenueOutbreakResponse) GetOk() bool {
+	if m != nil {
+		return m.Ok
 	}
+	return false
+}
 
-	that1, ok := that.(*NewIdentifierResponse)
-	if !ok {
-		that2, ok := that.(NewIdentifierResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return fmt.Errorf("that is not of type *NewIdentifierResponse")
+type AnalyticsRequest struct {
+	// Beginning of the period to query (in seconds and for UTC).
+	From int64 `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	// End of the period to query (in seconds and for UTC).
+	To int64 `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
+	// Fields to include on the response, i.e. "hotspots.cell" or "flows".
+	// All fields are returned if not provided.
+	Fields *types.FieldMask `protobuf:"bytes,3,opt,name=fields,proto3" json:"fields,omitempty"`
+	// Processing purpose for the data requested, either "analytics" or
+	// "research". Registered on the audit log.
+	Purpose              string   `protobuf:"bytes,4,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AnalyticsRequest) Reset()      { *m = AnalyticsRequest{} }
+func (*AnalyticsRequest) ProtoMessage() {}
+func (*AnalyticsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f8a97bd56b0960, []int{27}
+}
+func (m *AnalyticsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AnalyticsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AnalyticsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		if this == nil {
-			return nil
-		}
-		return fmt.Errorf("that is type *NewIdentifierResponse but is nil && this != nil")
-	} else if this == nil {
-		return fmt.Errorf("that is type *NewIdentifierResponse but is not nil && this == nil")
+}
+func (m *AnalyticsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnalyticsRequest.Merge(m, src)
+}
+func (m *AnalyticsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AnalyticsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnalyticsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AnalyticsRequest proto.InternalMessageInfo
+
+func (m *AnalyticsRequest) GetFrom() int64 {
+	if m != nil {
+		return m.From
 	}
-	if this.Document != that1.Document {
-		return fmt.Errorf("Document this(%v) Not Equal that(%v)", this.Document, that1.Document)
+	return 0
+}
+
+func (m *AnalyticsRequest) GetTo() int64 {
+	if m != nil {
+		return m.To
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	return 0
+}
+
+func (m *AnalyticsRequest) GetFields() *types.FieldMask {
+	if m != nil {
+		return m.Fields
 	}
 	return nil
 }
-func (this *NewIdentifierResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *AnalyticsRequest) GetPurpose() string {
+	if m != nil {
+		return m.Purpose
 	}
+	return ""
+}
 
-	that1, ok := that.(*NewIdentifierResponse)
-	if !ok {
-		that2, ok := that.(NewIdentifierResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type AnalyticsResponse struct {
+	// Areas with a high concentration of users.
+	Hotspots []*Hotspot `protobuf:"bytes,1,rep,name=hotspots,proto3" json:"hotspots,omitempty"`
+	// Aggregated movement of users between areas.
+	Flows                []*Flow  `protobuf:"bytes,2,rep,name=flows,proto3" json:"flows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AnalyticsResponse) Reset()      { *m = AnalyticsResponse{} }
+func (*AnalyticsResponse) ProtoMessage() {}
+func (*AnalyticsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f8a97bd56b0960, []int{28}
+}
+func (m *AnalyticsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AnalyticsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AnalyticsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Document != that1.Document {
-		return false
-	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
-	}
-	return true
 }
-func (this *PingResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (m *AnalyticsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnalyticsResponse.Merge(m, src)
+}
+func (m *AnalyticsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AnalyticsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnalyticsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AnalyticsResponse proto.InternalMessageInfo
+
+func (m *AnalyticsResponse) GetHotspots() []*Hotspot {
+	if m != nil {
+		return m.Hotspots
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&protov1.PingResponse{")
-	s = append(s, "Ok: "+fmt.Sprintf("%#v", this.Ok)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return nil
 }
-func (this *ActivationCodeRequest) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 6)
-	s = append(s, "&protov1.ActivationCodeRequest{")
-	s = append(s, "Did: "+fmt.Sprintf("%#v", this.Did)+",\n")
-	s = append(s, "Role: "+fmt.Sprintf("%#v", this.Role)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+
+func (m *AnalyticsResponse) GetFlows() []*Flow {
+	if m != nil {
+		return m.Flows
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return nil
 }
-func (this *ActivationCodeResponse) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&protov1.ActivationCodeResponse{")
-	s = append(s, "ActivationCode: "+fmt.Sprintf("%#v", this.ActivationCode)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+type LabResultRequest struct {
+	// JSON-encoded FHIR resource. Supported resources are "Observation",
+	// "DiagnosticReport" and "Bundle".
+	Resource             []byte   `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (this *CredentialsRequest) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 8)
-	s = append(s, "&protov1.CredentialsRequest{")
-	s = append(s, "Did: "+fmt.Sprintf("%#v", this.Did)+",\n")
-	s = append(s, "Role: "+fmt.Sprintf("%#v", this.Role)+",\n")
-	s = append(s, "ActivationCode: "+fmt.Sprintf("%#v", this.ActivationCode)+",\n")
-	s = append(s, "Proof: "+fmt.Sprintf("%#v", this.Proof)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+func (m *LabResultRequest) Reset()      { *m = LabResultRequest{} }
+func (*LabResultRequest) ProtoMessage() {}
+func (*LabResultRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f8a97bd56b0960, []int{29}
 }
-func (this *RenewCredentialsRequest) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&protov1.RenewCredentialsRequest{")
-	s = append(s, "RefreshCode: "+fmt.Sprintf("%#v", this.RefreshCode)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *LabResultRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (this *CredentialsResponse) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 6)
-	s = append(s, "&protov1.CredentialsResponse{")
-	s = append(s, "AccessToken: "+fmt.Sprintf("%#v", this.AccessToken)+",\n")
-	s = append(s, "RefreshCode: "+fmt.Sprintf("%#v", this.RefreshCode)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+func (m *LabResultRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LabResultRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
 }
-func (this *RecordRequest) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&protov1.RecordRequest{")
-	if this.Records != nil {
-		s = append(s, "Records: "+fmt.Sprintf("%#v", this.Records)+",\n")
-	}
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *LabResultRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LabResultRequest.Merge(m, src)
 }
-func (this *RecordResponse) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&protov1.RecordResponse{")
-	s = append(s, "Ok: "+fmt.Sprintf("%#v", this.Ok)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *LabResultRequest) XXX_Size() int {
+	return m.Size()
 }
-func (this *NewIdentifierRequest) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 6)
-	s = append(s, "&protov1.NewIdentifierRequest{")
-	s = append(s, "Method: "+fmt.Sprintf("%#v", this.Method)+",\n")
-	s = append(s, "AutoPublish: "+fmt.Sprintf("%#v", this.AutoPublish)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *LabResultRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LabResultRequest.DiscardUnknown(m)
 }
-func (this *NewIdentifierResponse) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&protov1.NewIdentifierResponse{")
-	s = append(s, "Document: "+fmt.Sprintf("%#v", this.Document)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+
+var xxx_messageInfo_LabResultRequest proto.InternalMessageInfo
+
+func (m *LabResultRequest) GetResource() []byte {
+	if m != nil {
+		return m.Resource
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return nil
 }
-func valueToGoStringTrackingServerApi(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
-	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+
+type LabResultResponse struct {
+	// Whether the lab result was successfully received and handled.
+	Ok                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *LabResultResponse) Reset()      { *m = LabResultResponse{} }
+func (*LabResultResponse) ProtoMessage() {}
+func (*LabResultResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f8a97bd56b0960, []int{30}
+}
+func (m *LabResultResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LabResultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LabResultResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LabResultResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LabResultResponse.Merge(m, src)
+}
+func (m *LabResultResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *LabResultResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LabResultResponse.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_LabResultResponse proto.InternalMessageInfo
 
-// TrackingServerAPIClient is the client API for TrackingServerAPI service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type TrackingServerAPIClient interface {
-	// Reachability test.
-	Ping(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*PingResponse, error)
-	// Generate a new activation code.
-	ActivationCode(ctx context.Context, in *ActivationCodeRequest, opts ...grpc.CallOption) (*ActivationCodeResponse, error)
-	// Get access credentials for the platform.
-	Credentials(ctx context.Context, in *CredentialsRequest, opts ...grpc.CallOption) (*CredentialsResponse, error)
-	// Renew a previously-issued access credential.
-	RenewCredentials(ctx context.Context, in *RenewCredentialsRequest, opts ...grpc.CallOption) (*CredentialsResponse, error)
-	// Process location record events. A maximum value of 100 record
-	// per-request is enforced.
-	Record(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*RecordResponse, error)
-	// Helper method to generate a new DID instances for clients that can't
-	// generate it locally. This is not recommended but supported for legacy
-	// and development purposes.
-	NewIdentifier(ctx context.Context, in *NewIdentifierRequest, opts ...grpc.CallOption) (*NewIdentifierResponse, error)
+func (m *LabResultResponse) GetOk() bool {
+	if m != nil {
+		return m.Ok
+	}
+	return false
 }
 
-type trackingServerAPIClient struct {
-	cc *grpc.ClientConn
+type CertificateRequest struct {
+	// Identifier of the diagnosis to certify.
+	Diagnosis string `protobuf:"bytes,1,opt,name=diagnosis,proto3" json:"diagnosis,omitempty"`
+	// Credential format, one of: "shc" (SMART Health Card) or "dcc"
+	// (EU Digital COVID Certificate).
+	Format               string   `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func NewTrackingServerAPIClient(cc *grpc.ClientConn) TrackingServerAPIClient {
-	return &trackingServerAPIClient{cc}
+func (m *CertificateRequest) Reset()      { *m = CertificateRequest{} }
+func (*CertificateRequest) ProtoMessage() {}
+func (*CertificateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f8a97bd56b0960, []int{31}
 }
-
-func (c *trackingServerAPIClient) Ping(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*PingResponse, error) {
-	out := new(PingResponse)
-	err := c.cc.Invoke(ctx, "/bryk.covid.proto.v1.TrackingServerAPI/Ping", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *CertificateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *trackingServerAPIClient) ActivationCode(ctx context.Context, in *ActivationCodeRequest, opts ...grpc.CallOption) (*ActivationCodeResponse, error) {
-	out := new(ActivationCodeResponse)
-	err := c.cc.Invoke(ctx, "/bryk.covid.proto.v1.TrackingServerAPI/ActivationCode", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CertificateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CertificateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *CertificateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CertificateRequest.Merge(m, src)
+}
+func (m *CertificateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CertificateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CertificateRequest.DiscardUnknown(m)
 }
 
-func (c *trackingServerAPIClient) Credentials(ctx context.Context, in *CredentialsRequest, opts ...grpc.CallOption) (*CredentialsResponse, error) {
-	out := new(CredentialsResponse)
-	err := c.cc.Invoke(ctx, "/bryk.covid.proto.v1.TrackingServerAPI/Credentials", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CertificateRequest proto.InternalMessageInfo
+
+func (m *CertificateRequest) GetDiagnosis() string {
+	if m != nil {
+		return m.Diagnosis
 	}
-	return out, nil
+	return ""
 }
 
-func (c *trackingServerAPIClient) RenewCredentials(ctx context.Context, in *RenewCredentialsRequest, opts ...grpc.CallOption) (*CredentialsResponse, error) {
-	out := new(CredentialsResponse)
-	err := c.cc.Invoke(ctx, "/bryk.covid.proto.v1.TrackingServerAPI/RenewCredentials", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CertificateRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
 	}
-	return out, nil
+	return ""
 }
 
-func (c *trackingServerAPIClient) Record(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*RecordResponse, error) {
-	out := new(RecordResponse)
-	err := c.cc.Invoke(ctx, "/bryk.covid.proto.v1.TrackingServerAPI/Record", in, out, opts...)
-	if err != nil {
-		return nil, err
+type CertificateResponse struct {
+	// Credential format.
+	Format string `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	// Encoded credential; a compact JWS for "shc" or a "HC1:" prefixed
+	// string for "dcc".
+	Credential string `protobuf:"bytes,2,opt,name=credential,proto3" json:"credential,omitempty"`
+	// Contents to be encoded as a QR code.
+	Qr                   string   `protobuf:"bytes,3,opt,name=qr,proto3" json:"qr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CertificateResponse) Reset()      { *m = CertificateResponse{} }
+func (*CertificateResponse) ProtoMessage() {}
+func (*CertificateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f8a97bd56b0960, []int{32}
+}
+func (m *CertificateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CertificateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CertificateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *CertificateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CertificateResponse.Merge(m, src)
+}
+func (m *CertificateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CertificateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CertificateResponse.DiscardUnknown(m)
 }
 
-func (c *trackingServerAPIClient) NewIdentifier(ctx context.Context, in *NewIdentifierRequest, opts ...grpc.CallOption) (*NewIdentifierResponse, error) {
-	out := new(NewIdentifierResponse)
-	err := c.cc.Invoke(ctx, "/bryk.covid.proto.v1.TrackingServerAPI/NewIdentifier", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CertificateResponse proto.InternalMessageInfo
+
+func (m *CertificateResponse) GetFormat() string {
+	if m != nil {
+		return m.Format
 	}
-	return out, nil
+	return ""
 }
 
-// TrackingServerAPIServer is the server API for TrackingServerAPI service.
-type TrackingServerAPIServer interface {
-	// Reachability test.
-	Ping(context.Context, *types.Empty) (*PingResponse, error)
-	// Generate a new activation code.
-	ActivationCode(context.Context, *ActivationCodeRequest) (*ActivationCodeResponse, error)
-	// Get access credentials for the platform.
-	Credentials(context.Context, *CredentialsRequest) (*CredentialsResponse, error)
-	// Renew a previously-issued access credential.
-	RenewCredentials(context.Context, *RenewCredentialsRequest) (*CredentialsResponse, error)
-	// Process location record events. A maximum value of 100 record
-	// per-request is enforced.
-	Record(context.Context, *RecordRequest) (*RecordResponse, error)
-	// Helper method to generate a new DID instances for clients that can't
-	// generate it locally. This is not recommended but supported for legacy
-	// and development purposes.
-	NewIdentifier(context.Context, *NewIdentifierRequest) (*NewIdentifierResponse, error)
+func (m *CertificateResponse) GetCredential() string {
+	if m != nil {
+		return m.Credential
+	}
+	return ""
 }
 
-// UnimplementedTrackingServerAPIServer can be embedded to have forward compatible implementations.
-type UnimplementedTrackingServerAPIServer struct {
+func (m *CertificateResponse) GetQr() string {
+	if m != nil {
+		return m.Qr
+	}
+	return ""
 }
 
-func (*UnimplementedTrackingServerAPIServer) Ping(ctx context.Context, req *types.Empty) (*PingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+type IntrospectRequest struct {
+	// Access token to validate.
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (*UnimplementedTrackingServerAPIServer) ActivationCode(ctx context.Context, req *ActivationCodeRequest) (*ActivationCodeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ActivationCode not implemented")
+
+func (m *IntrospectRequest) Reset()      { *m = IntrospectRequest{} }
+func (*IntrospectRequest) ProtoMessage() {}
+func (*IntrospectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f8a97bd56b0960, []int{33}
 }
-func (*UnimplementedTrackingServerAPIServer) Credentials(ctx context.Context, req *CredentialsRequest) (*CredentialsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Credentials not implemented")
+func (m *IntrospectRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedTrackingServerAPIServer) RenewCredentials(ctx context.Context, req *RenewCredentialsRequest) (*CredentialsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RenewCredentials not implemented")
+func (m *IntrospectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IntrospectRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedTrackingServerAPIServer) Record(ctx context.Context, req *RecordRequest) (*RecordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Record not implemented")
+func (m *IntrospectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IntrospectRequest.Merge(m, src)
 }
-func (*UnimplementedTrackingServerAPIServer) NewIdentifier(ctx context.Context, req *NewIdentifierRequest) (*NewIdentifierResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NewIdentifier not implemented")
+func (m *IntrospectRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterTrackingServerAPIServer(s *grpc.Server, srv TrackingServerAPIServer) {
-	s.RegisterService(&_TrackingServerAPI_serviceDesc, srv)
+func (m *IntrospectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IntrospectRequest.DiscardUnknown(m)
 }
 
-func _TrackingServerAPI_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(TrackingServerAPIServer).Ping(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bryk.covid.proto.v1.TrackingServerAPI/Ping",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TrackingServerAPIServer).Ping(ctx, req.(*types.Empty))
+var xxx_messageInfo_IntrospectRequest proto.InternalMessageInfo
+
+func (m *IntrospectRequest) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _TrackingServerAPI_ActivationCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ActivationCodeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(TrackingServerAPIServer).ActivationCode(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bryk.covid.proto.v1.TrackingServerAPI/ActivationCode",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TrackingServerAPIServer).ActivationCode(ctx, req.(*ActivationCodeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type IntrospectResponse struct {
+	// Whether the token was issued by the platform and is currently valid.
+	// If not set, no other field is included.
+	Active bool `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	// Token subject.
+	Sub string `protobuf:"bytes,2,opt,name=sub,proto3" json:"sub,omitempty"`
+	// DID of the credentials holder.
+	Did string `protobuf:"bytes,3,opt,name=did,proto3" json:"did,omitempty"`
+	// Role of the credentials holder.
+	Role string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	// Preferred language of the credentials holder.
+	Lang string `protobuf:"bytes,5,opt,name=lang,proto3" json:"lang,omitempty"`
+	// Token issuer.
+	Iss string `protobuf:"bytes,6,opt,name=iss,proto3" json:"iss,omitempty"`
+	// Token audience.
+	Aud []string `protobuf:"bytes,7,rep,name=aud,proto3" json:"aud,omitempty"`
+	// Expiration time, as a UNIX timestamp.
+	Exp int64 `protobuf:"varint,8,opt,name=exp,proto3" json:"exp,omitempty"`
+	// Issuance time, as a UNIX timestamp.
+	Iat int64 `protobuf:"varint,9,opt,name=iat,proto3" json:"iat,omitempty"`
+	// Time before which the token is not valid, as a UNIX timestamp.
+	Nbf int64 `protobuf:"varint,10,opt,name=nbf,proto3" json:"nbf,omitempty"`
+	// Unique token identifier.
+	Jti string `protobuf:"bytes,11,opt,name=jti,proto3" json:"jti,omitempty"`
+	// Space-separated list of permissions granted to the token. If empty,
+	// all the permissions available to the role are granted.
+	Scope                string   `protobuf:"bytes,12,opt,name=scope,proto3" json:"scope,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _TrackingServerAPI_Credentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CredentialsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(TrackingServerAPIServer).Credentials(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bryk.covid.proto.v1.TrackingServerAPI/Credentials",
+func (m *IntrospectResponse) Reset()      { *m = IntrospectResponse{} }
+func (*IntrospectResponse) ProtoMessage() {}
+func (*IntrospectResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f8a97bd56b0960, []int{34}
+}
+func (m *IntrospectResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IntrospectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IntrospectResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TrackingServerAPIServer).Credentials(ctx, req.(*CredentialsRequest))
+}
+func (m *IntrospectResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IntrospectResponse.Merge(m, src)
+}
+func (m *IntrospectResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *IntrospectResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_IntrospectResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IntrospectResponse proto.InternalMessageInfo
+
+func (m *IntrospectResponse) GetActive() bool {
+	if m != nil {
+		return m.Active
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _TrackingServerAPI_RenewCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RenewCredentialsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *IntrospectResponse) GetSub() string {
+	if m != nil {
+		return m.Sub
 	}
-	if interceptor == nil {
-		return srv.(TrackingServerAPIServer).RenewCredentials(ctx, in)
+	return ""
+}
+
+func (m *IntrospectResponse) GetDid() string {
+	if m != nil {
+		return m.Did
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bryk.covid.proto.v1.TrackingServerAPI/RenewCredentials",
+	return ""
+}
+
+func (m *IntrospectResponse) GetRole() string {
+	if m != nil {
+		return m.Role
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TrackingServerAPIServer).RenewCredentials(ctx, req.(*RenewCredentialsRequest))
+	return ""
+}
+
+func (m *IntrospectResponse) GetLang() string {
+	if m != nil {
+		return m.Lang
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _TrackingServerAPI_Record_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RecordRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *IntrospectResponse) GetIss() string {
+	if m != nil {
+		return m.Iss
 	}
-	if interceptor == nil {
-		return srv.(TrackingServerAPIServer).Record(ctx, in)
+	return ""
+}
+
+func (m *IntrospectResponse) GetAud() []string {
+	if m != nil {
+		return m.Aud
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bryk.covid.proto.v1.TrackingServerAPI/Record",
+	return nil
+}
+
+func (m *IntrospectResponse) GetExp() int64 {
+	if m != nil {
+		return m.Exp
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TrackingServerAPIServer).Record(ctx, req.(*RecordRequest))
+	return 0
+}
+
+func (m *IntrospectResponse) GetIat() int64 {
+	if m != nil {
+		return m.Iat
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _TrackingServerAPI_NewIdentifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NewIdentifierRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *IntrospectResponse) GetNbf() int64 {
+	if m != nil {
+		return m.Nbf
 	}
-	if interceptor == nil {
-		return srv.(TrackingServerAPIServer).NewIdentifier(ctx, in)
+	return 0
+}
+
+func (m *IntrospectResponse) GetJti() string {
+	if m != nil {
+		return m.Jti
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bryk.covid.proto.v1.TrackingServerAPI/NewIdentifier",
+	return ""
+}
+
+func (m *IntrospectResponse) GetScope() string {
+	if m != nil {
+		return m.Scope
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TrackingServerAPIServer).NewIdentifier(ctx, req.(*NewIdentifierRequest))
+	return ""
+}
+
+type AckRequest struct {
+	// Identifiers of the notifications acknowledged.
+	Notifications []string `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	// Notification status, either "delivered" or "read".
+	Status               string   `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AckRequest) Reset()      { *m = AckRequest{} }
+func (*AckRequest) ProtoMessage() {}
+func (*AckRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f8a97bd56b0960, []int{35}
+}
+func (m *AckRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AckRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AckRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *AckRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AckRequest.Merge(m, src)
+}
+func (m *AckRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AckRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AckRequest.DiscardUnknown(m)
 }
 
-var _TrackingServerAPI_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "bryk.covid.proto.v1.TrackingServerAPI",
-	HandlerType: (*TrackingServerAPIServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Ping",
-			Handler:    _TrackingServerAPI_Ping_Handler,
-		},
-		{
-			MethodName: "ActivationCode",
-			Handler:    _TrackingServerAPI_ActivationCode_Handler,
-		},
-		{
-			MethodName: "Credentials",
-			Handler:    _TrackingServerAPI_Credentials_Handler,
-		},
-		{
-			MethodName: "RenewCredentials",
-			Handler:    _TrackingServerAPI_RenewCredentials_Handler,
-		},
-		{
-			MethodName: "Record",
-			Handler:    _TrackingServerAPI_Record_Handler,
-		},
-		{
-			MethodName: "NewIdentifier",
-			Handler:    _TrackingServerAPI_NewIdentifier_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/v1/tracking_server_api.proto",
+var xxx_messageInfo_AckRequest proto.InternalMessageInfo
+
+func (m *AckRequest) GetNotifications() []string {
+	if m != nil {
+		return m.Notifications
+	}
+	return nil
 }
 
-func (m *PingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AckRequest) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *PingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type AckResponse struct {
+	// Number of notifications updated.
+	Updated              uint32   `protobuf:"varint,1,opt,name=updated,proto3" json:"updated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Ok {
-		i--
-		if m.Ok {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *AckResponse) Reset()      { *m = AckResponse{} }
+func (*AckResponse) ProtoMessage() {}
+func (*AckResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f8a97bd56b0960, []int{36}
+}
+func (m *AckResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AckResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AckResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *AckResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AckResponse.Merge(m, src)
+}
+func (m *AckResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AckResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AckResponse.DiscardUnknown(m)
 }
 
-func (m *ActivationCodeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AckResponse proto.InternalMessageInfo
+
+func (m *AckResponse) GetUpdated() uint32 {
+	if m != nil {
+		return m.Updated
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ActivationCodeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type NotificationStatusRequest struct {
+	// Identifier of the diagnosis or venue that originated the notifications.
+	Source               string   `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ActivationCodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Role) > 0 {
-		i -= len(m.Role)
-		copy(dAtA[i:], m.Role)
-		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Role)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Did) > 0 {
-		i -= len(m.Did)
-		copy(dAtA[i:], m.Did)
-		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Did)))
-		i--
-		dAtA[i] = 0xa
+func (m *NotificationStatusRequest) Reset()      { *m = NotificationStatusRequest{} }
+func (*NotificationStatusRequest) ProtoMessage() {}
+func (*NotificationStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f8a97bd56b0960, []int{37}
+}
+func (m *NotificationStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NotificationStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NotificationStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *NotificationStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotificationStatusRequest.Merge(m, src)
+}
+func (m *NotificationStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *NotificationStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotificationStatusRequest.DiscardUnknown(m)
 }
 
-func (m *ActivationCodeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_NotificationStatusRequest proto.InternalMessageInfo
+
+func (m *NotificationStatusRequest) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ActivationCodeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type NotificationStatusResponse struct {
+	// Total number of notifications generated.
+	Total uint32 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	// Notifications not yet dispatched.
+	Pending uint32 `protobuf:"varint,2,opt,name=pending,proto3" json:"pending,omitempty"`
+	// Notifications dispatched to the delivery channels.
+	Dispatched uint32 `protobuf:"varint,3,opt,name=dispatched,proto3" json:"dispatched,omitempty"`
+	// Notifications that couldn't be dispatched.
+	Failed uint32 `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	// Notifications received by the user's device.
+	Delivered uint32 `protobuf:"varint,5,opt,name=delivered,proto3" json:"delivered,omitempty"`
+	// Notifications read by the user.
+	Read                 uint32   `protobuf:"varint,6,opt,name=read,proto3" json:"read,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ActivationCodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.ActivationCode) > 0 {
-		i -= len(m.ActivationCode)
-		copy(dAtA[i:], m.ActivationCode)
-		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.ActivationCode)))
-		i--
-		dAtA[i] = 0xa
+func (m *NotificationStatusResponse) Reset()      { *m = NotificationStatusResponse{} }
+func (*NotificationStatusResponse) ProtoMessage() {}
+func (*NotificationStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f8a97bd56b0960, []int{38}
+}
+func (m *NotificationStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NotificationStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NotificationStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *NotificationStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotificationStatusResponse.Merge(m, src)
+}
+func (m *NotificationStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *NotificationStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotificationStatusResponse.DiscardUnknown(m)
 }
 
-func (m *CredentialsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_NotificationStatusResponse proto.InternalMessageInfo
+
+func (m *NotificationStatusResponse) GetTotal() uint32 {
+	if m != nil {
+		return m.Total
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *CredentialsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *NotificationStatusResponse) GetPending() uint32 {
+	if m != nil {
+		return m.Pending
+	}
+	return 0
 }
 
-func (m *CredentialsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Proof) > 0 {
-		i -= len(m.Proof)
-		copy(dAtA[i:], m.Proof)
-		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Proof)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ActivationCode) > 0 {
-		i -= len(m.ActivationCode)
-		copy(dAtA[i:], m.ActivationCode)
-		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.ActivationCode)))
-		i--
-		dAtA[i] = 0x1a
+func (m *NotificationStatusResponse) GetDispatched() uint32 {
+	if m != nil {
+		return m.Dispatched
 	}
-	if len(m.Role) > 0 {
-		i -= len(m.Role)
-		copy(dAtA[i:], m.Role)
-		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Role)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *NotificationStatusResponse) GetFailed() uint32 {
+	if m != nil {
+		return m.Failed
 	}
-	if len(m.Did) > 0 {
-		i -= len(m.Did)
-		copy(dAtA[i:], m.Did)
-		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Did)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *NotificationStatusResponse) GetDelivered() uint32 {
+	if m != nil {
+		return m.Delivered
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *RenewCredentialsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *NotificationStatusResponse) GetRead() uint32 {
+	if m != nil {
+		return m.Read
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *RenewCredentialsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type GeoPoint struct {
+	// Latitude.
+	Lat float32 `protobuf:"fixed32,1,opt,name=lat,proto3" json:"lat,omitempty"`
+	// Longitude.
+	Lng                  float32  `protobuf:"fixed32,2,opt,name=lng,proto3" json:"lng,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RenewCredentialsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *GeoPoint) Reset()      { *m = GeoPoint{} }
+func (*GeoPoint) ProtoMessage() {}
+func (*GeoPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f8a97bd56b0960, []int{39}
+}
+func (m *GeoPoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GeoPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GeoPoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.RefreshCode) > 0 {
-		i -= len(m.RefreshCode)
-		copy(dAtA[i:], m.RefreshCode)
-		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.RefreshCode)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *GeoPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GeoPoint.Merge(m, src)
+}
+func (m *GeoPoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *GeoPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_GeoPoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GeoPoint proto.InternalMessageInfo
+
+func (m *GeoPoint) GetLat() float32 {
+	if m != nil {
+		return m.Lat
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *CredentialsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GeoPoint) GetLng() float32 {
+	if m != nil {
+		return m.Lng
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *CredentialsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ExposureQueryRequest struct {
+	// Vertices of the polygon delimiting the area to query; at least 3
+	// points are required. The polygon is closed automatically.
+	Area []*GeoPoint `protobuf:"bytes,1,rep,name=area,proto3" json:"area,omitempty"`
+	// Beginning of the period to query (in seconds and for UTC).
+	From int64 `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	// End of the period to query (in seconds and for UTC).
+	To int64 `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
+	// Include the identifiers of the users present in the area. Requires
+	// the "record:read" permission.
+	Identifiers bool `protobuf:"varint,4,opt,name=identifiers,proto3" json:"identifiers,omitempty"`
+	// Justification for the request, required when retrieving identifiers.
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Processing purpose for the data requested, either "contact_tracing" or
+	// "analytics". Only records tagged with the purpose are included.
+	Purpose              string   `protobuf:"bytes,6,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CredentialsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.RefreshCode) > 0 {
-		i -= len(m.RefreshCode)
-		copy(dAtA[i:], m.RefreshCode)
-		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.RefreshCode)))
-		i--
-		dAtA[i] = 0x12
+func (m *ExposureQueryRequest) Reset()      { *m = ExposureQueryRequest{} }
+func (*ExposureQueryRequest) ProtoMessage() {}
+func (*ExposureQueryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f8a97bd56b0960, []int{40}
+}
+func (m *ExposureQueryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExposureQueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExposureQueryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.AccessToken) > 0 {
-		i -= len(m.AccessToken)
-		copy(dAtA[i:], m.AccessToken)
-		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.AccessToken)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ExposureQueryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExposureQueryRequest.Merge(m, src)
+}
+func (m *ExposureQueryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExposureQueryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExposureQueryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExposureQueryRequest proto.InternalMessageInfo
+
+func (m *ExposureQueryRequest) GetArea() []*GeoPoint {
+	if m != nil {
+		return m.Area
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *RecordRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ExposureQueryRequest) GetFrom() int64 {
+	if m != nil {
+		return m.From
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *RecordRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ExposureQueryRequest) GetTo() int64 {
+	if m != nil {
+		return m.To
+	}
+	return 0
 }
 
-func (m *RecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ExposureQueryRequest) GetIdentifiers() bool {
+	if m != nil {
+		return m.Identifiers
 	}
-	if len(m.Records) > 0 {
-		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTrackingServerApi(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return false
+}
+
+func (m *ExposureQueryRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *RecordResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ExposureQueryRequest) GetPurpose() string {
+	if m != nil {
+		return m.Purpose
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *RecordResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ExposureQueryResponse struct {
+	// Distinct users present on each cell and time bucket. Only entries
+	// covering a minimum number of distinct users are included.
+	Presence []*Presence `protobuf:"bytes,1,rep,name=presence,proto3" json:"presence,omitempty"`
+	// Total number of distinct users present in the area.
+	Users int64 `protobuf:"varint,2,opt,name=users,proto3" json:"users,omitempty"`
+	// Identifiers of the users present in the area, if requested.
+	Identifiers          []string `protobuf:"bytes,3,rep,name=identifiers,proto3" json:"identifiers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Ok {
-		i--
-		if m.Ok {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *ExposureQueryResponse) Reset()      { *m = ExposureQueryResponse{} }
+func (*ExposureQueryResponse) ProtoMessage() {}
+func (*ExposureQueryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f8a97bd56b0960, []int{41}
+}
+func (m *ExposureQueryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExposureQueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExposureQueryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ExposureQueryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExposureQueryResponse.Merge(m, src)
+}
+func (m *ExposureQueryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExposureQueryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExposureQueryResponse.DiscardUnknown(m)
 }
 
-func (m *NewIdentifierRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ExposureQueryResponse proto.InternalMessageInfo
+
+func (m *ExposureQueryResponse) GetPresence() []*Presence {
+	if m != nil {
+		return m.Presence
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *NewIdentifierRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ExposureQueryResponse) GetUsers() int64 {
+	if m != nil {
+		return m.Users
+	}
+	return 0
 }
 
-func (m *NewIdentifierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ExposureQueryResponse) GetIdentifiers() []string {
+	if m != nil {
+		return m.Identifiers
 	}
-	if m.AutoPublish {
-		i--
-		if m.AutoPublish {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return nil
+}
+
+// Distinct users present on a cell during a period of time.
+type Presence struct {
+	// Geohash cell identifier.
+	Cell string `protobuf:"bytes,1,opt,name=cell,proto3" json:"cell,omitempty"`
+	// Number of distinct users present in the cell.
+	Users int64 `protobuf:"varint,2,opt,name=users,proto3" json:"users,omitempty"`
+	// Beginning of the period (in seconds and for UTC).
+	From int64 `protobuf:"varint,3,opt,name=from,proto3" json:"from,omitempty"`
+	// End of the period (in seconds and for UTC).
+	To                   int64    `protobuf:"varint,4,opt,name=to,proto3" json:"to,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Presence) Reset()      { *m = Presence{} }
+func (*Presence) ProtoMessage() {}
+func (*Presence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f8a97bd56b0960, []int{42}
+}
+func (m *Presence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Presence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Presence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x10
+		return b[:n], nil
 	}
-	if len(m.Method) > 0 {
-		i -= len(m.Method)
-		copy(dAtA[i:], m.Method)
-		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Method)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *Presence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Presence.Merge(m, src)
+}
+func (m *Presence) XXX_Size() int {
+	return m.Size()
+}
+func (m *Presence) XXX_DiscardUnknown() {
+	xxx_messageInfo_Presence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Presence proto.InternalMessageInfo
+
+func (m *Presence) GetCell() string {
+	if m != nil {
+		return m.Cell
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *NewIdentifierResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Presence) GetUsers() int64 {
+	if m != nil {
+		return m.Users
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *NewIdentifierResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Presence) GetFrom() int64 {
+	if m != nil {
+		return m.From
+	}
+	return 0
 }
 
-func (m *NewIdentifierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Document) > 0 {
-		i -= len(m.Document)
-		copy(dAtA[i:], m.Document)
-		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Document)))
-		i--
-		dAtA[i] = 0xa
+func (m *Presence) GetTo() int64 {
+	if m != nil {
+		return m.To
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintTrackingServerApi(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTrackingServerApi(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+// Access credentials issued to a device. Renewing credentials keeps the
+// same session.
+type Session struct {
+	// Session identifier.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Role of the credentials holder.
+	Role string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	// Date the session was started (in seconds and for UTC).
+	Created int64 `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
+	// Issuance date of the current access token (in seconds and for UTC).
+	Issued int64 `protobuf:"varint,4,opt,name=issued,proto3" json:"issued,omitempty"`
+	// Expiration date of the current access token (in seconds and for UTC).
+	Expires int64 `protobuf:"varint,5,opt,name=expires,proto3" json:"expires,omitempty"`
+	// Whether the session corresponds to the credentials used on the request.
+	Current              bool     `protobuf:"varint,6,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func NewPopulatedPingResponse(r randyTrackingServerApi, easy bool) *PingResponse {
-	this := &PingResponse{}
-	this.Ok = bool(bool(r.Intn(2) == 0))
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 2)
+
+func (m *Session) Reset()      { *m = Session{} }
+func (*Session) ProtoMessage() {}
+func (*Session) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f8a97bd56b0960, []int{43}
+}
+func (m *Session) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Session) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Session.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return this
+}
+func (m *Session) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Session.Merge(m, src)
+}
+func (m *Session) XXX_Size() int {
+	return m.Size()
+}
+func (m *Session) XXX_DiscardUnknown() {
+	xxx_messageInfo_Session.DiscardUnknown(m)
 }
 
-func NewPopulatedActivationCodeRequest(r randyTrackingServerApi, easy bool) *ActivationCodeRequest {
-	this := &ActivationCodeRequest{}
-	this.Did = string(randStringTrackingServerApi(r))
-	this.Role = string(randStringTrackingServerApi(r))
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 3)
+var xxx_messageInfo_Session proto.InternalMessageInfo
+
+func (m *Session) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return this
+	return ""
 }
 
-func NewPopulatedActivationCodeResponse(r randyTrackingServerApi, easy bool) *ActivationCodeResponse {
-	this := &ActivationCodeResponse{}
-	this.ActivationCode = string(randStringTrackingServerApi(r))
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 2)
+func (m *Session) GetRole() string {
+	if m != nil {
+		return m.Role
 	}
-	return this
+	return ""
 }
 
-func NewPopulatedCredentialsRequest(r randyTrackingServerApi, easy bool) *CredentialsRequest {
-	this := &CredentialsRequest{}
-	this.Did = string(randStringTrackingServerApi(r))
-	this.Role = string(randStringTrackingServerApi(r))
-	this.ActivationCode = string(randStringTrackingServerApi(r))
-	v1 := r.Intn(100)
-	this.Proof = make([]byte, v1)
-	for i := 0; i < v1; i++ {
-		this.Proof[i] = byte(r.Intn(256))
+func (m *Session) GetCreated() int64 {
+	if m != nil {
+		return m.Created
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 5)
+	return 0
+}
+
+func (m *Session) GetIssued() int64 {
+	if m != nil {
+		return m.Issued
 	}
-	return this
+	return 0
 }
 
-func NewPopulatedRenewCredentialsRequest(r randyTrackingServerApi, easy bool) *RenewCredentialsRequest {
-	this := &RenewCredentialsRequest{}
-	this.RefreshCode = string(randStringTrackingServerApi(r))
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 2)
+func (m *Session) GetExpires() int64 {
+	if m != nil {
+		return m.Expires
 	}
-	return this
+	return 0
 }
 
-func NewPopulatedCredentialsResponse(r randyTrackingServerApi, easy bool) *CredentialsResponse {
-	this := &CredentialsResponse{}
-	this.AccessToken = string(randStringTrackingServerApi(r))
-	this.RefreshCode = string(randStringTrackingServerApi(r))
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 3)
+func (m *Session) GetCurrent() bool {
+	if m != nil {
+		return m.Current
 	}
-	return this
+	return false
 }
 
-func NewPopulatedRecordRequest(r randyTrackingServerApi, easy bool) *RecordRequest {
-	this := &RecordRequest{}
-	if r.Intn(5) != 0 {
-		v2 := r.Intn(5)
-		this.Records = make([]*LocationRecord, v2)
-		for i := 0; i < v2; i++ {
-			this.Records[i] = NewPopulatedLocationRecord(r, easy)
+type ListSessionsResponse struct {
+	// Active sessions.
+	Sessions             []*Session `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ListSessionsResponse) Reset()      { *m = ListSessionsResponse{} }
+func (*ListSessionsResponse) ProtoMessage() {}
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f8a97bd56b0960, []int{44}
+}
+func (m *ListSessionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListSessionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListSessionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 2)
-	}
-	return this
+}
+func (m *ListSessionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSessionsResponse.Merge(m, src)
+}
+func (m *ListSessionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListSessionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSessionsResponse.DiscardUnknown(m)
 }
 
-func NewPopulatedRecordResponse(r randyTrackingServerApi, easy bool) *RecordResponse {
-	this := &RecordResponse{}
-	this.Ok = bool(bool(r.Intn(2) == 0))
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 2)
+var xxx_messageInfo_ListSessionsResponse proto.InternalMessageInfo
+
+func (m *ListSessionsResponse) GetSessions() []*Session {
+	if m != nil {
+		return m.Sessions
 	}
-	return this
+	return nil
 }
 
-func NewPopulatedNewIdentifierRequest(r randyTrackingServerApi, easy bool) *NewIdentifierRequest {
-	this := &NewIdentifierRequest{}
-	this.Method = string(randStringTrackingServerApi(r))
-	this.AutoPublish = bool(bool(r.Intn(2) == 0))
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 3)
+type RevokeSessionRequest struct {
+	// Session identifier.
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeSessionRequest) Reset()      { *m = RevokeSessionRequest{} }
+func (*RevokeSessionRequest) ProtoMessage() {}
+func (*RevokeSessionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f8a97bd56b0960, []int{45}
+}
+func (m *RevokeSessionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevokeSessionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevokeSessionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return this
+}
+func (m *RevokeSessionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeSessionRequest.Merge(m, src)
+}
+func (m *RevokeSessionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevokeSessionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeSessionRequest.DiscardUnknown(m)
 }
 
-func NewPopulatedNewIdentifierResponse(r randyTrackingServerApi, easy bool) *NewIdentifierResponse {
-	this := &NewIdentifierResponse{}
-	this.Document = string(randStringTrackingServerApi(r))
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 2)
+var xxx_messageInfo_RevokeSessionRequest proto.InternalMessageInfo
+
+func (m *RevokeSessionRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return this
+	return ""
 }
 
-type randyTrackingServerApi interface {
-	Float32() float32
-	Float64() float64
-	Int63() int64
-	Int31() int32
-	Uint32() uint32
-	Intn(n int) int
+type SendMessageResponse struct {
+	// Message identifier.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Creation date (in seconds and for UTC).
+	Created              int64    `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func randUTF8RuneTrackingServerApi(r randyTrackingServerApi) rune {
-	ru := r.Intn(62)
-	if ru < 10 {
-		return rune(ru + 48)
-	} else if ru < 36 {
-		return rune(ru + 55)
-	}
-	return rune(ru + 61)
+func (m *SendMessageResponse) Reset()      { *m = SendMessageResponse{} }
+func (*SendMessageResponse) ProtoMessage() {}
+func (*SendMessageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f8a97bd56b0960, []int{46}
 }
-func randStringTrackingServerApi(r randyTrackingServerApi) string {
-	v3 := r.Intn(100)
-	tmps := make([]rune, v3)
-	for i := 0; i < v3; i++ {
-		tmps[i] = randUTF8RuneTrackingServerApi(r)
-	}
-	return string(tmps)
+func (m *SendMessageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func randUnrecognizedTrackingServerApi(r randyTrackingServerApi, maxFieldNumber int) (dAtA []byte) {
-	l := r.Intn(5)
-	for i := 0; i < l; i++ {
-		wire := r.Intn(4)
-		if wire == 3 {
-			wire = 5
+func (m *SendMessageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SendMessageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		fieldNumber := maxFieldNumber + r.Intn(100)
-		dAtA = randFieldTrackingServerApi(dAtA, r, fieldNumber, wire)
+		return b[:n], nil
 	}
-	return dAtA
 }
-func randFieldTrackingServerApi(dAtA []byte, r randyTrackingServerApi, fieldNumber int, wire int) []byte {
-	key := uint32(fieldNumber)<<3 | uint32(wire)
-	switch wire {
-	case 0:
-		dAtA = encodeVarintPopulateTrackingServerApi(dAtA, uint64(key))
-		v4 := r.Int63()
-		if r.Intn(2) == 0 {
-			v4 *= -1
-		}
-		dAtA = encodeVarintPopulateTrackingServerApi(dAtA, uint64(v4))
-	case 1:
-		dAtA = encodeVarintPopulateTrackingServerApi(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
-	case 2:
-		dAtA = encodeVarintPopulateTrackingServerApi(dAtA, uint64(key))
-		ll := r.Intn(100)
-		dAtA = encodeVarintPopulateTrackingServerApi(dAtA, uint64(ll))
-		for j := 0; j < ll; j++ {
-			dAtA = append(dAtA, byte(r.Intn(256)))
-		}
-	default:
-		dAtA = encodeVarintPopulateTrackingServerApi(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
-	}
-	return dAtA
+func (m *SendMessageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendMessageResponse.Merge(m, src)
 }
-func encodeVarintPopulateTrackingServerApi(dAtA []byte, v uint64) []byte {
-	for v >= 1<<7 {
-		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
-		v >>= 7
-	}
-	dAtA = append(dAtA, uint8(v))
-	return dAtA
+func (m *SendMessageResponse) XXX_Size() int {
+	return m.Size()
 }
-func (m *PingResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Ok {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *SendMessageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendMessageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendMessageResponse proto.InternalMessageInfo
+
+func (m *SendMessageResponse) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return n
+	return ""
 }
 
-func (m *ActivationCodeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SendMessageResponse) GetCreated() int64 {
+	if m != nil {
+		return m.Created
 	}
-	var l int
-	_ = l
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovTrackingServerApi(uint64(l))
-	}
-	l = len(m.Role)
-	if l > 0 {
-		n += 1 + l + sovTrackingServerApi(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return 0
 }
 
-func (m *ActivationCodeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type MessagesRequest struct {
+	// Only return messages created on or after this date (in seconds and
+	// for UTC). Clients should use the creation date of the latest message
+	// received, discarding the messages already processed by identifier.
+	Since                int64    `protobuf:"varint,1,opt,name=since,proto3" json:"since,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MessagesRequest) Reset()      { *m = MessagesRequest{} }
+func (*MessagesRequest) ProtoMessage() {}
+func (*MessagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f8a97bd56b0960, []int{47}
+}
+func (m *MessagesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessagesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.ActivationCode)
-	if l > 0 {
-		n += 1 + l + sovTrackingServerApi(uint64(l))
+}
+func (m *MessagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessagesRequest.Merge(m, src)
+}
+func (m *MessagesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessagesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessagesRequest proto.InternalMessageInfo
+
+func (m *MessagesRequest) GetSince() int64 {
+	if m != nil {
+		return m.Since
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return 0
+}
+
+type MessagesResponse struct {
+	// Messages received, oldest first. A maximum of 100 messages is returned
+	// per request.
+	Messages             []*EncryptedMessage `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *MessagesResponse) Reset()      { *m = MessagesResponse{} }
+func (*MessagesResponse) ProtoMessage() {}
+func (*MessagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f8a97bd56b0960, []int{48}
+}
+func (m *MessagesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessagesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MessagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessagesResponse.Merge(m, src)
+}
+func (m *MessagesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessagesResponse.DiscardUnknown(m)
 }
 
-func (m *CredentialsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MessagesResponse proto.InternalMessageInfo
+
+func (m *MessagesResponse) GetMessages() []*EncryptedMessage {
+	if m != nil {
+		return m.Messages
 	}
-	var l int
-	_ = l
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovTrackingServerApi(uint64(l))
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*PingResponse)(nil), "bryk.covid.proto.v1.PingResponse")
+	proto.RegisterType((*ActivationCodeRequest)(nil), "bryk.covid.proto.v1.ActivationCodeRequest")
+	proto.RegisterType((*ActivationCodeResponse)(nil), "bryk.covid.proto.v1.ActivationCodeResponse")
+	proto.RegisterType((*BulkActivationCodesRequest)(nil), "bryk.covid.proto.v1.BulkActivationCodesRequest")
+	proto.RegisterType((*BulkActivationCodesResponse)(nil), "bryk.covid.proto.v1.BulkActivationCodesResponse")
+	proto.RegisterType((*CampaignCode)(nil), "bryk.covid.proto.v1.CampaignCode")
+	proto.RegisterType((*CredentialsRequest)(nil), "bryk.covid.proto.v1.CredentialsRequest")
+	proto.RegisterType((*DeviceAttestation)(nil), "bryk.covid.proto.v1.DeviceAttestation")
+	proto.RegisterType((*RenewCredentialsRequest)(nil), "bryk.covid.proto.v1.RenewCredentialsRequest")
+	proto.RegisterType((*CredentialsResponse)(nil), "bryk.covid.proto.v1.CredentialsResponse")
+	proto.RegisterType((*RecordRequest)(nil), "bryk.covid.proto.v1.RecordRequest")
+	proto.RegisterType((*RecordResponse)(nil), "bryk.covid.proto.v1.RecordResponse")
+	proto.RegisterType((*RecordStatus)(nil), "bryk.covid.proto.v1.RecordStatus")
+	proto.RegisterType((*CheckExposureRequest)(nil), "bryk.covid.proto.v1.CheckExposureRequest")
+	proto.RegisterType((*CheckExposureResponse)(nil), "bryk.covid.proto.v1.CheckExposureResponse")
+	proto.RegisterType((*PSIRequest)(nil), "bryk.covid.proto.v1.PSIRequest")
+	proto.RegisterType((*PSIResponse)(nil), "bryk.covid.proto.v1.PSIResponse")
+	proto.RegisterType((*SamplingPolicy)(nil), "bryk.covid.proto.v1.SamplingPolicy")
+	proto.RegisterType((*SubmissionStatusRequest)(nil), "bryk.covid.proto.v1.SubmissionStatusRequest")
+	proto.RegisterType((*SubmissionStatusResponse)(nil), "bryk.covid.proto.v1.SubmissionStatusResponse")
+	proto.RegisterType((*NewIdentifierRequest)(nil), "bryk.covid.proto.v1.NewIdentifierRequest")
+	proto.RegisterType((*NewIdentifierResponse)(nil), "bryk.covid.proto.v1.NewIdentifierResponse")
+	proto.RegisterType((*RegisterVenueRequest)(nil), "bryk.covid.proto.v1.RegisterVenueRequest")
+	proto.RegisterType((*CheckInRequest)(nil), "bryk.covid.proto.v1.CheckInRequest")
+	proto.RegisterType((*CheckInResponse)(nil), "bryk.covid.proto.v1.CheckInResponse")
+	proto.RegisterType((*VenueOutbreakRequest)(nil), "bryk.covid.proto.v1.VenueOutbreakRequest")
+	proto.RegisterType((*VenueOutbreakResponse)(nil), "bryk.covid.proto.v1.VenueOutbreakResponse")
+	proto.RegisterType((*AnalyticsRequest)(nil), "bryk.covid.proto.v1.AnalyticsRequest")
+	proto.RegisterType((*AnalyticsResponse)(nil), "bryk.covid.proto.v1.AnalyticsResponse")
+	proto.RegisterType((*LabResultRequest)(nil), "bryk.covid.proto.v1.LabResultRequest")
+	proto.RegisterType((*LabResultResponse)(nil), "bryk.covid.proto.v1.LabResultResponse")
+	proto.RegisterType((*CertificateRequest)(nil), "bryk.covid.proto.v1.CertificateRequest")
+	proto.RegisterType((*CertificateResponse)(nil), "bryk.covid.proto.v1.CertificateResponse")
+	proto.RegisterType((*IntrospectRequest)(nil), "bryk.covid.proto.v1.IntrospectRequest")
+	proto.RegisterType((*IntrospectResponse)(nil), "bryk.covid.proto.v1.IntrospectResponse")
+	proto.RegisterType((*AckRequest)(nil), "bryk.covid.proto.v1.AckRequest")
+	proto.RegisterType((*AckResponse)(nil), "bryk.covid.proto.v1.AckResponse")
+	proto.RegisterType((*NotificationStatusRequest)(nil), "bryk.covid.proto.v1.NotificationStatusRequest")
+	proto.RegisterType((*NotificationStatusResponse)(nil), "bryk.covid.proto.v1.NotificationStatusResponse")
+	proto.RegisterType((*GeoPoint)(nil), "bryk.covid.proto.v1.GeoPoint")
+	proto.RegisterType((*ExposureQueryRequest)(nil), "bryk.covid.proto.v1.ExposureQueryRequest")
+	proto.RegisterType((*ExposureQueryResponse)(nil), "bryk.covid.proto.v1.ExposureQueryResponse")
+	proto.RegisterType((*Presence)(nil), "bryk.covid.proto.v1.Presence")
+	proto.RegisterType((*Session)(nil), "bryk.covid.proto.v1.Session")
+	proto.RegisterType((*ListSessionsResponse)(nil), "bryk.covid.proto.v1.ListSessionsResponse")
+	proto.RegisterType((*RevokeSessionRequest)(nil), "bryk.covid.proto.v1.RevokeSessionRequest")
+	proto.RegisterType((*SendMessageResponse)(nil), "bryk.covid.proto.v1.SendMessageResponse")
+	proto.RegisterType((*MessagesRequest)(nil), "bryk.covid.proto.v1.MessagesRequest")
+	proto.RegisterType((*MessagesResponse)(nil), "bryk.covid.proto.v1.MessagesResponse")
+}
+
+func init() { proto.RegisterFile("proto/v1/tracking_server_api.proto", fileDescriptor_f1f8a97bd56b0960) }
+
+var fileDescriptor_f1f8a97bd56b0960 = []byte{
+	// 2760 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xcb, 0x6f, 0x24, 0x57,
+	0xd5, 0xff, 0xaa, 0xdb, 0x8f, 0xee, 0xd3, 0x6e, 0x8f, 0x5d, 0x7e, 0x4c, 0xbb, 0x66, 0xd2, 0xb1,
+	0xef, 0x24, 0x63, 0xc7, 0x1f, 0xe9, 0x66, 0x66, 0x16, 0x81, 0x10, 0x84, 0x3c, 0xce, 0x24, 0x71,
+	0x94, 0x0c, 0x4e, 0x39, 0x4c, 0x10, 0x04, 0xb5, 0xaa, 0xab, 0xaf, 0xdb, 0x37, 0x5d, 0x5d, 0xb7,
+	0x5c, 0xb7, 0xaa, 0x67, 0xcc, 0x4b, 0x01, 0xc1, 0x02, 0x24, 0x24, 0xa4, 0xb0, 0x01, 0x89, 0x45,
+	0x58, 0x21, 0xc4, 0x1a, 0xb1, 0x24, 0x12, 0x0b, 0xc4, 0x0a, 0x89, 0x0d, 0xcb, 0x8c, 0xc5, 0x1f,
+	0xc0, 0x12, 0xb1, 0x42, 0xf7, 0x55, 0x5d, 0xd5, 0x5d, 0x65, 0x3b, 0xec, 0xea, 0x9c, 0xfa, 0x9d,
+	0x7b, 0x1e, 0xf7, 0xde, 0xf3, 0xb8, 0x80, 0x82, 0x90, 0x46, 0xb4, 0x3d, 0xba, 0xd3, 0x8e, 0x42,
+	0xc7, 0x1d, 0x10, 0xbf, 0xdf, 0x61, 0x38, 0x1c, 0xe1, 0xb0, 0xe3, 0x04, 0xa4, 0x25, 0x7e, 0x9a,
+	0x2b, 0xdd, 0xf0, 0x6c, 0xd0, 0x72, 0xe9, 0x88, 0xf4, 0x24, 0xa7, 0x35, 0xba, 0x63, 0xbd, 0xd4,
+	0x27, 0xd1, 0x49, 0xdc, 0x6d, 0xb9, 0x74, 0xd8, 0xee, 0xd3, 0x3e, 0x6d, 0xf7, 0x29, 0xed, 0x7b,
+	0xd8, 0x09, 0x08, 0x53, 0x9f, 0x6d, 0x27, 0x20, 0x6d, 0xc7, 0xf7, 0x69, 0xe4, 0x44, 0x84, 0xfa,
+	0x4c, 0xca, 0x5a, 0x2f, 0x4e, 0x0a, 0x0a, 0x76, 0x37, 0x3e, 0x16, 0x94, 0x34, 0x87, 0x7f, 0x29,
+	0xf8, 0x0d, 0xb5, 0x58, 0x82, 0xc2, 0xc3, 0x20, 0x3a, 0x53, 0x3f, 0x37, 0x27, 0x7f, 0x1e, 0x13,
+	0xec, 0xf5, 0x3a, 0x43, 0x87, 0x0d, 0x14, 0x62, 0x2d, 0xf1, 0x4f, 0xba, 0x25, 0xd9, 0xa8, 0x09,
+	0x0b, 0x87, 0xc4, 0xef, 0xdb, 0x98, 0x05, 0xd4, 0x67, 0xd8, 0x5c, 0x84, 0x12, 0x1d, 0x34, 0x8c,
+	0x4d, 0x63, 0xa7, 0x62, 0x97, 0xe8, 0x00, 0x0d, 0x60, 0x6d, 0xcf, 0x8d, 0xc8, 0x48, 0x58, 0xbe,
+	0x4f, 0x7b, 0xd8, 0xc6, 0xa7, 0x31, 0x66, 0x91, 0xb9, 0x04, 0xe5, 0x1e, 0xe9, 0x09, 0x64, 0xd5,
+	0xe6, 0x9f, 0xa6, 0x09, 0x33, 0x21, 0xf5, 0x70, 0xa3, 0x24, 0x58, 0xe2, 0xdb, 0x5c, 0x85, 0x59,
+	0xe6, 0xd2, 0x00, 0x37, 0xca, 0x9b, 0xe5, 0x9d, 0xaa, 0x2d, 0x09, 0x73, 0x1d, 0xe6, 0x7a, 0x78,
+	0x44, 0x5c, 0xdc, 0x98, 0x11, 0x58, 0x45, 0xa1, 0x3d, 0x58, 0x9f, 0x54, 0xa6, 0xcc, 0xda, 0x86,
+	0x6b, 0x4e, 0xf2, 0xa7, 0xe3, 0xd2, 0x1e, 0x56, 0x9a, 0x17, 0x9d, 0x8c, 0x00, 0xfa, 0x81, 0x01,
+	0xd6, 0xfd, 0xd8, 0x1b, 0x64, 0xd7, 0x61, 0xda, 0xea, 0x55, 0x98, 0x75, 0x69, 0xec, 0x47, 0x42,
+	0xba, 0x6e, 0x4b, 0xc2, 0xb4, 0xa0, 0xe2, 0x3a, 0xc3, 0xc0, 0x21, 0x7d, 0x5f, 0x59, 0x9f, 0xd0,
+	0x05, 0x1e, 0xdc, 0x80, 0xea, 0x69, 0xd8, 0x21, 0x43, 0xa7, 0x8f, 0x99, 0x70, 0xa2, 0x62, 0x57,
+	0x4e, 0xc3, 0x03, 0x41, 0xa3, 0x47, 0x70, 0x23, 0xd7, 0x04, 0xe5, 0xcb, 0x4b, 0xdc, 0x86, 0x1e,
+	0x66, 0x0d, 0x63, 0xb3, 0xbc, 0x53, 0xbb, 0xbb, 0xd5, 0xca, 0x39, 0x55, 0xad, 0x7d, 0xa5, 0x5f,
+	0x44, 0x41, 0xe2, 0xd1, 0xc7, 0x06, 0x2c, 0xa4, 0xf9, 0x57, 0x8e, 0xca, 0x85, 0x0e, 0x5e, 0x87,
+	0xf9, 0xd3, 0x50, 0x0a, 0x97, 0xe5, 0x6e, 0x9c, 0x86, 0x42, 0x68, 0x03, 0x2a, 0xda, 0x47, 0xe1,
+	0xe2, 0x82, 0x3d, 0xaf, 0x5c, 0x34, 0x1b, 0x30, 0x8f, 0x9f, 0x04, 0x24, 0xc4, 0xac, 0x31, 0xbb,
+	0x69, 0xec, 0x94, 0x6d, 0x4d, 0xa2, 0x1f, 0x97, 0xc0, 0xdc, 0x0f, 0x71, 0x0f, 0xfb, 0x11, 0x71,
+	0x3c, 0xf6, 0xd9, 0x4e, 0x4b, 0x8e, 0x3f, 0xe5, 0x5c, 0x7f, 0x56, 0x61, 0x36, 0x08, 0x29, 0x3d,
+	0x56, 0x76, 0x49, 0x82, 0x2f, 0xe9, 0x39, 0x7e, 0x5f, 0x98, 0x54, 0xb5, 0xc5, 0xf7, 0x78, 0xfb,
+	0xe6, 0xd2, 0xdb, 0xf7, 0x06, 0xd4, 0x9c, 0x28, 0xc2, 0x4c, 0x5e, 0xc8, 0xc6, 0xfc, 0xa6, 0xb1,
+	0x53, 0xbb, 0x7b, 0x3b, 0x77, 0x23, 0x5e, 0x15, 0x47, 0x73, 0x6f, 0x8c, 0xb6, 0xd3, 0xa2, 0xa9,
+	0xa3, 0x5c, 0xc9, 0x1c, 0xe5, 0x07, 0xb0, 0x3c, 0x25, 0xc9, 0xb7, 0x21, 0xf0, 0x9c, 0xe8, 0x98,
+	0x86, 0x43, 0x15, 0x8a, 0x84, 0xe6, 0x86, 0x46, 0x74, 0x80, 0xf5, 0xfe, 0x48, 0x02, 0xbd, 0x02,
+	0xd7, 0x6d, 0xec, 0xe3, 0xc7, 0x39, 0x21, 0xdd, 0x82, 0x85, 0x10, 0x1f, 0x87, 0x98, 0x9d, 0xa4,
+	0x77, 0xbe, 0xa6, 0x78, 0xe2, 0x32, 0x7c, 0x13, 0x56, 0x32, 0x82, 0xea, 0x00, 0x6e, 0xc1, 0x82,
+	0xe3, 0xba, 0x98, 0xb1, 0x8e, 0xd4, 0xa8, 0x24, 0x25, 0xef, 0x5d, 0xce, 0x9a, 0x5a, 0xbc, 0x34,
+	0xbd, 0xf8, 0xef, 0x0d, 0xa8, 0xdb, 0xd8, 0xa5, 0x61, 0x4f, 0x5b, 0xf4, 0x65, 0x98, 0x0f, 0x05,
+	0x43, 0x1f, 0xed, 0x5b, 0xb9, 0x11, 0x7d, 0x8b, 0xba, 0x32, 0x90, 0x52, 0x58, 0xcb, 0x98, 0xcf,
+	0x42, 0xcd, 0x09, 0x82, 0xce, 0x08, 0x87, 0x8c, 0x6f, 0x8a, 0x54, 0x09, 0x4e, 0x10, 0x3c, 0x92,
+	0x1c, 0x0e, 0x60, 0xbd, 0x41, 0x02, 0x90, 0x47, 0x03, 0x58, 0x6f, 0xa0, 0x01, 0xe9, 0xf8, 0xce,
+	0x64, 0xe3, 0x8b, 0x1e, 0xc3, 0xa2, 0xb6, 0x36, 0x3f, 0xd5, 0x99, 0x5f, 0x1a, 0x9b, 0x5f, 0xba,
+	0xe0, 0x66, 0xca, 0x55, 0x8e, 0x22, 0x27, 0x8a, 0xd9, 0xd8, 0xf8, 0x86, 0x10, 0xc6, 0x24, 0x88,
+	0x94, 0x5d, 0x9a, 0x44, 0x27, 0xb0, 0x90, 0x16, 0xe1, 0x1b, 0x4d, 0xfc, 0x1e, 0x7e, 0x22, 0x34,
+	0xcf, 0xda, 0x92, 0xe0, 0x67, 0xf7, 0xc4, 0x61, 0x27, 0xfa, 0x3a, 0xf0, 0x6f, 0x7e, 0xb6, 0x98,
+	0x90, 0xd1, 0x17, 0x53, 0x52, 0x9c, 0x1f, 0x62, 0x87, 0x51, 0x5f, 0xa7, 0x4f, 0x49, 0xa1, 0xbb,
+	0xb0, 0xba, 0x7f, 0x82, 0xdd, 0xc1, 0x83, 0x27, 0x01, 0x65, 0x71, 0x98, 0xa4, 0x6a, 0x1e, 0x96,
+	0x10, 0x1f, 0x93, 0x27, 0x2a, 0xe7, 0x54, 0xed, 0x84, 0x46, 0x07, 0xb0, 0x36, 0x21, 0xa3, 0xa2,
+	0xb3, 0x0e, 0x73, 0xe2, 0x74, 0x68, 0x11, 0x45, 0x71, 0x47, 0xe3, 0xa0, 0xe7, 0x44, 0xb8, 0x27,
+	0x6c, 0x2d, 0xdb, 0x9a, 0x44, 0xb7, 0x01, 0x0e, 0x8f, 0x0e, 0xb4, 0xd2, 0x06, 0xcc, 0x77, 0x3d,
+	0xee, 0x5b, 0x4f, 0x2c, 0xb0, 0x60, 0x6b, 0x12, 0xbd, 0x07, 0x35, 0x81, 0x53, 0x8a, 0x6e, 0x42,
+	0x15, 0x8f, 0x1c, 0x2f, 0x76, 0xa2, 0x04, 0x3a, 0x66, 0xf0, 0xc4, 0xc1, 0x70, 0x24, 0x36, 0xa4,
+	0x6a, 0xf3, 0xcf, 0xb4, 0x01, 0xe5, 0xac, 0x01, 0x7f, 0x36, 0x60, 0xf1, 0xc8, 0x19, 0x06, 0x1e,
+	0xf1, 0xfb, 0x87, 0xd4, 0x23, 0xee, 0x19, 0x0f, 0xeb, 0x70, 0x7c, 0x39, 0xc4, 0x37, 0xcf, 0x32,
+	0x72, 0xd7, 0x3a, 0xc4, 0x8f, 0x70, 0x38, 0x72, 0x3c, 0xe1, 0x49, 0xdd, 0x5e, 0x94, 0xec, 0x03,
+	0xc5, 0xe5, 0xc0, 0x38, 0xf0, 0xa8, 0x93, 0x02, 0x96, 0x25, 0x50, 0xb2, 0x13, 0xe0, 0x16, 0x2c,
+	0x0c, 0x89, 0xdf, 0xe9, 0x11, 0x16, 0x39, 0xbe, 0xaa, 0x6a, 0x75, 0xbb, 0x36, 0x24, 0xfe, 0xab,
+	0x8a, 0x65, 0xbe, 0x00, 0x4b, 0xfa, 0x42, 0x25, 0x8b, 0xcd, 0x0a, 0xd8, 0x35, 0xc5, 0xd7, 0xab,
+	0xa1, 0x7b, 0x70, 0xfd, 0x28, 0xee, 0x0e, 0x09, 0xe3, 0x67, 0x5a, 0x9d, 0xb3, 0x71, 0x50, 0xf5,
+	0x29, 0x33, 0xb2, 0xa7, 0xec, 0x13, 0x03, 0x1a, 0xd3, 0x52, 0x2a, 0xc4, 0x85, 0x62, 0xa9, 0x23,
+	0x56, 0xca, 0x1c, 0xb1, 0xd4, 0x5d, 0x28, 0xff, 0x2f, 0x77, 0xc1, 0x0d, 0xb1, 0xd8, 0xa1, 0x19,
+	0xb9, 0x43, 0x8a, 0xe4, 0x7b, 0x1d, 0x84, 0x94, 0xa7, 0x19, 0xdc, 0x53, 0x95, 0x63, 0xcc, 0x40,
+	0xef, 0xc0, 0xea, 0x43, 0xfc, 0xf8, 0x40, 0xe4, 0xab, 0x63, 0x82, 0x43, 0xed, 0xf5, 0x3a, 0xcc,
+	0x0d, 0x71, 0x74, 0x42, 0x75, 0xfd, 0x50, 0x94, 0xc8, 0x63, 0x71, 0x44, 0x3b, 0x41, 0xdc, 0xf5,
+	0x88, 0xba, 0x3b, 0x15, 0xbb, 0xc6, 0x79, 0x87, 0x92, 0x85, 0xee, 0xc1, 0xda, 0xc4, 0x92, 0x2a,
+	0x24, 0x16, 0x54, 0x7a, 0xd4, 0x8d, 0x87, 0xd8, 0xd7, 0x31, 0x49, 0x68, 0xf4, 0x10, 0x56, 0x6d,
+	0xdc, 0x27, 0x2c, 0xc2, 0xe1, 0x23, 0xec, 0xc7, 0xc9, 0x3d, 0x32, 0x61, 0xc6, 0x77, 0x86, 0xc9,
+	0x61, 0xe2, 0xdf, 0xfc, 0x7c, 0x7a, 0x4e, 0x24, 0x54, 0x97, 0x6c, 0xfe, 0x29, 0x38, 0x7e, 0xbf,
+	0x51, 0x56, 0x1c, 0xbf, 0x8f, 0x1e, 0xc2, 0xa2, 0xb8, 0x63, 0x07, 0xbe, 0x5e, 0xe9, 0x95, 0xc9,
+	0x4c, 0x89, 0xf2, 0x9b, 0x00, 0x2d, 0x95, 0x49, 0x94, 0x68, 0x0b, 0xae, 0x25, 0x7f, 0x0a, 0xda,
+	0xb6, 0x43, 0x58, 0x15, 0xa6, 0x7f, 0x35, 0x8e, 0xba, 0x21, 0x76, 0x06, 0xa9, 0xfe, 0x67, 0xc4,
+	0xf9, 0xca, 0x07, 0x49, 0x70, 0xc7, 0x8e, 0x43, 0x3a, 0x54, 0x17, 0x5a, 0x7c, 0xf3, 0x15, 0x23,
+	0xaa, 0x6e, 0x58, 0x29, 0xa2, 0x68, 0x1b, 0xd6, 0x26, 0x56, 0x2c, 0x50, 0xfd, 0x23, 0x03, 0x96,
+	0xf6, 0x7c, 0xc7, 0x3b, 0x8b, 0x88, 0xcb, 0x52, 0xa1, 0x13, 0x1a, 0x8c, 0x29, 0x0d, 0x25, 0xad,
+	0xc1, 0xbc, 0x0b, 0x73, 0xa2, 0x6b, 0x95, 0xe9, 0xae, 0x76, 0xd7, 0x6a, 0xc9, 0xa6, 0xb6, 0xa5,
+	0x9b, 0xda, 0xd6, 0x6b, 0xfc, 0xf7, 0xdb, 0x0e, 0x1b, 0xd8, 0x0a, 0xc9, 0x8f, 0x5a, 0x10, 0x87,
+	0x01, 0x65, 0xba, 0x95, 0xd4, 0x24, 0xfa, 0x3e, 0x2c, 0xa7, 0xac, 0x50, 0xb6, 0x7e, 0x01, 0x2a,
+	0x27, 0x34, 0x62, 0x01, 0x8d, 0x74, 0xe0, 0x6f, 0xe6, 0x06, 0xfe, 0x0d, 0x09, 0xb2, 0x13, 0xb4,
+	0xd9, 0x86, 0xd9, 0x63, 0x8f, 0x3e, 0xd6, 0xa5, 0x61, 0x23, 0x57, 0xec, 0x35, 0x8f, 0x3e, 0xb6,
+	0x25, 0x0e, 0xb5, 0x60, 0xe9, 0x2d, 0xa7, 0x6b, 0x63, 0x16, 0x7b, 0x51, 0x2a, 0x11, 0x87, 0x98,
+	0xd1, 0x38, 0x74, 0xe5, 0x06, 0x2c, 0xd8, 0x09, 0x8d, 0x6e, 0xc1, 0x72, 0x0a, 0x5f, 0x10, 0xdb,
+	0x37, 0xc1, 0xdc, 0xc7, 0x21, 0x3f, 0xca, 0xae, 0x13, 0x25, 0xe7, 0xf2, 0x26, 0x54, 0x7b, 0xc4,
+	0xe9, 0xfb, 0x94, 0x11, 0xa6, 0x36, 0x76, 0xcc, 0xe0, 0xb7, 0x87, 0x17, 0x40, 0x75, 0x48, 0xab,
+	0xb6, 0xa2, 0xd0, 0xb7, 0x60, 0x25, 0xb3, 0xd6, 0x38, 0xef, 0x2b, 0xb8, 0x91, 0x86, 0x9b, 0x4d,
+	0x00, 0x37, 0xe9, 0x25, 0x74, 0x71, 0x1e, 0x73, 0xb8, 0xa9, 0xa7, 0xa1, 0x2a, 0x54, 0xa5, 0xd3,
+	0x10, 0xbd, 0x00, 0xcb, 0x07, 0x7e, 0x14, 0x52, 0x16, 0x60, 0x37, 0x4a, 0x1d, 0xbf, 0x74, 0xcb,
+	0x21, 0x09, 0xf4, 0x1f, 0x03, 0xcc, 0x34, 0x76, 0x6c, 0x89, 0x68, 0xfb, 0xb0, 0x0a, 0x80, 0xa2,
+	0x44, 0x49, 0x88, 0xbb, 0xca, 0x04, 0xfe, 0xa9, 0xbb, 0xcb, 0xf2, 0x74, 0x77, 0x39, 0x93, 0xea,
+	0x2e, 0xf3, 0xda, 0xc3, 0x25, 0x28, 0x13, 0xc6, 0x1a, 0x73, 0x52, 0x92, 0x30, 0xc6, 0x39, 0x4e,
+	0xdc, 0x6b, 0xcc, 0xcb, 0x82, 0xe3, 0xc4, 0xa2, 0x04, 0xe1, 0x27, 0x81, 0xe8, 0xef, 0xca, 0x36,
+	0xff, 0x14, 0x52, 0x4e, 0xd4, 0xa8, 0x4a, 0x0e, 0x91, 0x97, 0xde, 0xef, 0x1e, 0x37, 0x40, 0x72,
+	0xfc, 0xee, 0x31, 0xe7, 0x7c, 0x10, 0x91, 0x46, 0x4d, 0xae, 0xfc, 0x41, 0x44, 0xc6, 0xad, 0xe8,
+	0x82, 0x74, 0x5e, 0x10, 0xe8, 0x4d, 0x80, 0x3d, 0x37, 0xb9, 0x9f, 0xcf, 0x41, 0xdd, 0xa7, 0x6a,
+	0x4f, 0xf8, 0xa8, 0xa8, 0x8a, 0x6f, 0x96, 0x59, 0x94, 0xb5, 0xd1, 0x36, 0xd4, 0xc4, 0x5a, 0xe3,
+	0xb4, 0xaf, 0x2b, 0xa5, 0x1c, 0x77, 0x34, 0x89, 0xee, 0xc1, 0xc6, 0xc3, 0xd4, 0x8a, 0xd9, 0x22,
+	0xc3, 0x57, 0x1f, 0x9f, 0xd1, 0xaa, 0xad, 0x28, 0xf4, 0x07, 0x03, 0xac, 0x3c, 0x29, 0xa5, 0x4d,
+	0xec, 0x6d, 0xe4, 0x78, 0x7a, 0xb4, 0x12, 0x84, 0xb8, 0xa0, 0xd8, 0xef, 0x11, 0xbf, 0xaf, 0x8a,
+	0xac, 0x26, 0xf9, 0x81, 0xea, 0x11, 0x16, 0x38, 0x91, 0x7b, 0xa2, 0x4a, 0x79, 0xdd, 0x4e, 0x71,
+	0xc4, 0x41, 0x74, 0x88, 0xa7, 0x8a, 0x48, 0xdd, 0x56, 0x94, 0x38, 0xed, 0xd8, 0x23, 0x23, 0x1c,
+	0xaa, 0x1a, 0x52, 0xb7, 0xc7, 0x0c, 0xb1, 0xf1, 0xd8, 0xe9, 0x89, 0x1d, 0xad, 0xdb, 0xe2, 0x1b,
+	0xb5, 0xa0, 0xf2, 0x3a, 0xa6, 0x87, 0x94, 0xf8, 0x91, 0xce, 0xd7, 0xc6, 0x54, 0xbe, 0x2e, 0x8d,
+	0xf3, 0xf5, 0x27, 0x06, 0xac, 0xea, 0x7e, 0xe8, 0x9d, 0x18, 0x87, 0x67, 0x3a, 0x32, 0x77, 0x60,
+	0xc6, 0x09, 0xb1, 0xa3, 0x52, 0xc7, 0x33, 0xb9, 0x39, 0x40, 0x6b, 0xb2, 0x05, 0xf4, 0x2a, 0xa9,
+	0xd5, 0xdc, 0x84, 0x1a, 0x49, 0x2a, 0x94, 0x1e, 0x27, 0xd3, 0xac, 0x54, 0xc7, 0x37, 0x9b, 0xee,
+	0xf8, 0xd2, 0xe9, 0x6f, 0x2e, 0x9b, 0xfe, 0x7e, 0x6a, 0xc0, 0xda, 0x84, 0x0f, 0x6a, 0x9f, 0xbe,
+	0x28, 0xba, 0x41, 0x86, 0x7d, 0x17, 0x5f, 0xe8, 0xc8, 0xa1, 0x02, 0xd9, 0x09, 0x9c, 0x6f, 0x71,
+	0xcc, 0xb8, 0x89, 0xd2, 0x1b, 0x49, 0x4c, 0x9a, 0x2f, 0xe7, 0xe4, 0x34, 0x0b, 0x7d, 0x1d, 0x2a,
+	0x7a, 0x35, 0x1e, 0x10, 0x17, 0x7b, 0x9e, 0x2e, 0xa2, 0xfc, 0xbb, 0x60, 0x5d, 0x1d, 0xba, 0xf2,
+	0x54, 0xe8, 0x66, 0x92, 0xaa, 0xf4, 0x91, 0x01, 0xf3, 0x47, 0x58, 0xf4, 0x3c, 0xfc, 0x5f, 0x32,
+	0x62, 0x96, 0x0a, 0x26, 0xcc, 0x54, 0x6b, 0x52, 0xce, 0xb6, 0x26, 0xeb, 0x30, 0x47, 0x18, 0x8b,
+	0x93, 0x9e, 0x45, 0x51, 0xc5, 0xa3, 0xae, 0x58, 0x2b, 0x0e, 0x43, 0xde, 0x41, 0xcc, 0x89, 0x2d,
+	0xd3, 0x24, 0xaf, 0xbe, 0x6f, 0x11, 0x16, 0x29, 0xc3, 0x32, 0xe5, 0x87, 0x29, 0xde, 0x85, 0xe5,
+	0x47, 0x09, 0xda, 0x09, 0x1a, 0xdd, 0xe6, 0x2d, 0xc9, 0x88, 0x0e, 0xb0, 0xfe, 0xa5, 0x4e, 0xe4,
+	0x84, 0xcf, 0xe8, 0x2b, 0xb0, 0x72, 0x84, 0xfd, 0xde, 0xdb, 0x98, 0x31, 0xa7, 0x8f, 0xd3, 0x75,
+	0x24, 0x13, 0x9a, 0x54, 0x18, 0x4a, 0x99, 0x30, 0xa0, 0x6d, 0xb8, 0xa6, 0x84, 0xd3, 0x6f, 0x26,
+	0x8c, 0xf8, 0x2a, 0x1d, 0x94, 0x6d, 0x49, 0xa0, 0xaf, 0xc1, 0xd2, 0x18, 0xa8, 0xd4, 0xec, 0x41,
+	0x65, 0xa8, 0x78, 0xca, 0xbf, 0xe7, 0x73, 0xfd, 0x7b, 0xe0, 0xbb, 0xe1, 0x59, 0x10, 0xe1, 0xc4,
+	0xce, 0x44, 0xec, 0xee, 0xaf, 0x36, 0x60, 0xf9, 0x5d, 0xf5, 0x00, 0x77, 0x24, 0x1e, 0xaa, 0xf6,
+	0x0e, 0x0f, 0xcc, 0xf7, 0x60, 0x86, 0xbf, 0x52, 0x99, 0xeb, 0x53, 0x2d, 0xc1, 0x03, 0xfe, 0x08,
+	0x66, 0xe5, 0x77, 0xa7, 0xe9, 0x87, 0x2d, 0xb4, 0xfa, 0xc3, 0xbf, 0xff, 0xf3, 0xa3, 0xd2, 0xa2,
+	0xb9, 0xc0, 0x9f, 0xc0, 0xf8, 0x83, 0x5c, 0xc0, 0x17, 0xfc, 0x99, 0x01, 0x8b, 0xd9, 0x77, 0x1a,
+	0x73, 0x37, 0x77, 0xad, 0xdc, 0x47, 0x30, 0xeb, 0xff, 0xaf, 0x84, 0x55, 0x16, 0x20, 0x61, 0xc1,
+	0x4d, 0x74, 0x5d, 0x5b, 0x30, 0xf1, 0xd6, 0xf1, 0xb2, 0xb1, 0x6b, 0x7e, 0x6c, 0xc0, 0x4a, 0xce,
+	0xdb, 0x91, 0xd9, 0xce, 0x55, 0x54, 0xfc, 0xd0, 0x65, 0x7d, 0xfe, 0xea, 0x02, 0xca, 0xbc, 0x6d,
+	0x61, 0xde, 0x16, 0xba, 0x59, 0x60, 0x5e, 0xbb, 0x1b, 0x7b, 0x03, 0x6e, 0xe3, 0x87, 0x06, 0xd4,
+	0x52, 0xcf, 0x0a, 0xe6, 0x76, 0x7e, 0xef, 0x3a, 0xf5, 0x62, 0x61, 0xed, 0x5c, 0x0e, 0x54, 0xb6,
+	0x34, 0x85, 0x2d, 0x0d, 0xb4, 0xa2, 0x6d, 0x19, 0x37, 0x1a, 0x8c, 0x9b, 0xf0, 0x73, 0x03, 0x96,
+	0x26, 0xdf, 0x45, 0xcc, 0xcf, 0x15, 0x8c, 0x28, 0xb9, 0xcf, 0x27, 0x9f, 0xc1, 0x98, 0xe7, 0x84,
+	0x31, 0x4d, 0xb4, 0x91, 0x63, 0x4c, 0x27, 0xe4, 0xcb, 0x73, 0x93, 0x3c, 0x98, 0x93, 0x7d, 0xba,
+	0x89, 0x2e, 0x18, 0x95, 0xb4, 0xf6, 0x5b, 0x17, 0x62, 0x94, 0xe2, 0x0d, 0xa1, 0x78, 0x05, 0x2d,
+	0x6a, 0xc5, 0x72, 0x00, 0xe0, 0xda, 0x7e, 0x61, 0xc0, 0xca, 0xeb, 0x38, 0x9a, 0x9c, 0xf8, 0x0a,
+	0x62, 0x50, 0x30, 0x4e, 0x5a, 0x2f, 0x5e, 0x11, 0xad, 0xec, 0xd9, 0x14, 0xf6, 0x58, 0x66, 0x23,
+	0x6b, 0x4f, 0xfb, 0x3b, 0x6a, 0x9a, 0xfc, 0x9e, 0xf9, 0x13, 0x03, 0xea, 0x99, 0xe7, 0x04, 0xf3,
+	0x85, 0xe2, 0xc1, 0x66, 0xe2, 0x99, 0xc2, 0xda, 0xbd, 0x0a, 0x54, 0x99, 0xb2, 0x25, 0x4c, 0xb9,
+	0x81, 0xd6, 0xb5, 0x29, 0x58, 0x21, 0xda, 0x2e, 0xc7, 0xf3, 0x10, 0x8d, 0x60, 0x29, 0x23, 0x7b,
+	0x78, 0x74, 0x60, 0x3e, 0x9b, 0x9f, 0x27, 0x92, 0x57, 0x0b, 0x6b, 0xb3, 0x18, 0xa0, 0x34, 0x3f,
+	0x2b, 0x34, 0x6f, 0xa0, 0xd5, 0x29, 0xcd, 0x01, 0x23, 0x5c, 0xef, 0x29, 0x2c, 0xf3, 0x9d, 0xc9,
+	0xbe, 0x43, 0x14, 0x25, 0xae, 0xfc, 0x73, 0x90, 0x15, 0xd6, 0x2a, 0xcd, 0x24, 0x71, 0x30, 0xf5,
+	0xbf, 0x13, 0xc8, 0xd5, 0x79, 0xd8, 0x33, 0x63, 0x6e, 0x41, 0xd8, 0xf3, 0xa6, 0x6b, 0x6b, 0xf7,
+	0x2a, 0xd0, 0xa2, 0xb0, 0xfb, 0xf8, 0x71, 0x67, 0x5c, 0xe9, 0xb9, 0xfb, 0x01, 0xd4, 0x33, 0xc3,
+	0x73, 0x81, 0x29, 0x79, 0x03, 0xb6, 0x65, 0xe5, 0x42, 0x05, 0x04, 0x35, 0x84, 0x6a, 0x13, 0xd5,
+	0xb5, 0x6a, 0x31, 0xba, 0xca, 0x80, 0xcf, 0xab, 0x71, 0xd8, 0xbc, 0x75, 0xf1, 0x18, 0x2d, 0xb5,
+	0x3c, 0x77, 0x31, 0x48, 0xb9, 0x7a, 0x43, 0xe8, 0x5b, 0x43, 0x4b, 0xc9, 0xad, 0xe7, 0x80, 0x0e,
+	0xf1, 0xb9, 0x4a, 0x1e, 0xf0, 0xcc, 0x34, 0x5c, 0xe0, 0x65, 0xde, 0x0c, 0x6e, 0xed, 0x5e, 0x05,
+	0x5a, 0x14, 0x70, 0xe1, 0x75, 0x87, 0x2a, 0x1c, 0xb7, 0xe5, 0x09, 0x54, 0x93, 0x41, 0xd7, 0xcc,
+	0xaf, 0xb7, 0x93, 0xe3, 0xb8, 0x75, 0xfb, 0x32, 0x98, 0x52, 0x7f, 0x53, 0xa8, 0x5f, 0x47, 0xcb,
+	0x49, 0x4d, 0xd0, 0x10, 0xae, 0xf9, 0x0c, 0xaa, 0xc9, 0xc8, 0x5a, 0xa0, 0x79, 0x72, 0x04, 0xb6,
+	0x6e, 0x5f, 0x06, 0x53, 0x9a, 0x9f, 0x11, 0x9a, 0xaf, 0x23, 0x53, 0x6b, 0xf6, 0x9c, 0x6e, 0x27,
+	0x14, 0x98, 0xa4, 0x06, 0x8d, 0xa7, 0xd7, 0xa2, 0x1a, 0x34, 0x35, 0x2b, 0x5b, 0x3b, 0x97, 0x03,
+	0x0b, 0x6b, 0xd0, 0x18, 0xc4, 0x4d, 0xf8, 0x2e, 0xc0, 0x78, 0x68, 0x35, 0xf3, 0xfd, 0x9a, 0x9a,
+	0x80, 0xad, 0xed, 0x4b, 0x71, 0x45, 0x01, 0x20, 0x09, 0x86, 0x6b, 0x1f, 0x42, 0x79, 0xcf, 0x1d,
+	0x14, 0x24, 0xb4, 0xf1, 0x40, 0x69, 0x6d, 0x16, 0x03, 0x94, 0xa2, 0x5b, 0x42, 0xd1, 0x33, 0x28,
+	0xc9, 0xea, 0xe9, 0x59, 0xb3, 0xed, 0xc8, 0x64, 0xfa, 0x6b, 0x03, 0xcc, 0xe9, 0xd9, 0xcf, 0x6c,
+	0xe5, 0xe7, 0x8e, 0xa2, 0xd1, 0xd2, 0x6a, 0x5f, 0x19, 0xaf, 0x8c, 0xbb, 0x2d, 0x8c, 0xdb, 0x44,
+	0x37, 0x72, 0x8d, 0x93, 0x63, 0xaf, 0xbe, 0x90, 0x99, 0x71, 0xa7, 0xe0, 0x42, 0xe6, 0x8d, 0x75,
+	0xd6, 0xee, 0x55, 0xa0, 0x97, 0x15, 0x9e, 0xce, 0x29, 0xc7, 0x71, 0x5b, 0x3e, 0x80, 0x85, 0x74,
+	0xf7, 0x5f, 0x98, 0xfb, 0xf3, 0x2d, 0xcc, 0x1b, 0x1c, 0xd0, 0x75, 0xa1, 0x75, 0xd9, 0xbc, 0xa6,
+	0xb5, 0xaa, 0xc1, 0xc0, 0x8c, 0xa1, 0x9e, 0x99, 0x0b, 0x0a, 0xb3, 0xed, 0xf4, 0xec, 0x60, 0x15,
+	0xd8, 0x35, 0xed, 0xa2, 0x52, 0xd6, 0x0e, 0xc5, 0x2a, 0xdc, 0xc5, 0x6f, 0x43, 0x2d, 0x35, 0x66,
+	0x98, 0x57, 0xeb, 0xf2, 0x0b, 0xee, 0x5e, 0xce, 0xbc, 0x82, 0x2c, 0x61, 0xc2, 0x2a, 0x4a, 0xfc,
+	0x55, 0xf3, 0x81, 0xcc, 0x3a, 0x15, 0x05, 0x67, 0x66, 0x7e, 0x2a, 0x9f, 0x18, 0x60, 0xac, 0xe7,
+	0x2f, 0x41, 0x65, 0xdb, 0x1b, 0xb4, 0x36, 0xa1, 0xb4, 0x7d, 0x8c, 0x23, 0xf7, 0xe4, 0x65, 0x63,
+	0xf7, 0xfe, 0x2f, 0x8d, 0x7f, 0x3c, 0x6d, 0xfe, 0xdf, 0xa7, 0x4f, 0x9b, 0xc6, 0xbf, 0x9e, 0x36,
+	0x8d, 0x7f, 0x3f, 0x6d, 0x1a, 0x1f, 0x9e, 0x37, 0x8d, 0xdf, 0x9e, 0x37, 0x8d, 0x3f, 0x9e, 0x37,
+	0x8d, 0x3f, 0x9d, 0x37, 0x8d, 0xbf, 0x9c, 0x37, 0x8d, 0xbf, 0x9d, 0x37, 0x8d, 0x4f, 0xcf, 0x9b,
+	0x06, 0xac, 0x13, 0x9a, 0xa7, 0xf9, 0xfe, 0xfa, 0xc4, 0x80, 0x13, 0x90, 0x43, 0xfe, 0xeb, 0xd0,
+	0xf8, 0xc6, 0xbc, 0xc0, 0x8c, 0xee, 0xfc, 0xa6, 0x54, 0xbe, 0xbf, 0x7f, 0xf8, 0xbb, 0xd2, 0xca,
+	0x7d, 0x2e, 0xbe, 0x2f, 0xc4, 0x05, 0xa6, 0xf5, 0xe8, 0xce, 0x5f, 0x25, 0xf7, 0x7d, 0xc1, 0x7d,
+	0x5f, 0x70, 0xdf, 0x7f, 0x74, 0xa7, 0x3b, 0x27, 0x44, 0xef, 0xfd, 0x37, 0x00, 0x00, 0xff, 0xff,
+	0xc0, 0x73, 0xf3, 0x12, 0xc8, 0x20, 0x00, 0x00,
+}
+
+func (this *PingResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	l = len(m.Role)
-	if l > 0 {
-		n += 1 + l + sovTrackingServerApi(uint64(l))
+
+	that1, ok := that.(*PingResponse)
+	if !ok {
+		that2, ok := that.(PingResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *PingResponse")
+		}
 	}
-	l = len(m.ActivationCode)
-	if l > 0 {
-		n += 1 + l + sovTrackingServerApi(uint64(l))
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *PingResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *PingResponse but is not nil && this == nil")
 	}
-	l = len(m.Proof)
-	if l > 0 {
-		n += 1 + l + sovTrackingServerApi(uint64(l))
+	if this.Ok != that1.Ok {
+		return fmt.Errorf("Ok this(%v) Not Equal that(%v)", this.Ok, that1.Ok)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
 	}
-	return n
+	return nil
 }
+func (this *PingResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *RenewCredentialsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*PingResponse)
+	if !ok {
+		that2, ok := that.(PingResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.RefreshCode)
-	if l > 0 {
-		n += 1 + l + sovTrackingServerApi(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if this.Ok != that1.Ok {
+		return false
 	}
-	return n
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
 }
+func (this *ActivationCodeRequest) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func (m *CredentialsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*ActivationCodeRequest)
+	if !ok {
+		that2, ok := that.(ActivationCodeRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *ActivationCodeRequest")
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.AccessToken)
-	if l > 0 {
-		n += 1 + l + sovTrackingServerApi(uint64(l))
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *ActivationCodeRequest but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *ActivationCodeRequest but is not nil && this == nil")
 	}
-	l = len(m.RefreshCode)
-	if l > 0 {
-		n += 1 + l + sovTrackingServerApi(uint64(l))
+	if this.Did != that1.Did {
+		return fmt.Errorf("Did this(%v) Not Equal that(%v)", this.Did, that1.Did)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if this.Role != that1.Role {
+		return fmt.Errorf("Role this(%v) Not Equal that(%v)", this.Role, that1.Role)
 	}
-	return n
-}
-
-func (m *RecordRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(this.Scope) != len(that1.Scope) {
+		return fmt.Errorf("Scope this(%v) Not Equal that(%v)", len(this.Scope), len(that1.Scope))
 	}
-	var l int
-	_ = l
-	if len(m.Records) > 0 {
-		for _, e := range m.Records {
-			l = e.Size()
-			n += 1 + l + sovTrackingServerApi(uint64(l))
+	for i := range this.Scope {
+		if this.Scope[i] != that1.Scope[i] {
+			return fmt.Errorf("Scope this[%v](%v) Not Equal that[%v](%v)", i, this.Scope[i], i, that1.Scope[i])
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if this.Device != that1.Device {
+		return fmt.Errorf("Device this(%v) Not Equal that(%v)", this.Device, that1.Device)
 	}
-	return n
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
 }
+func (this *ActivationCodeRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *RecordResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*ActivationCodeRequest)
+	if !ok {
+		that2, ok := that.(ActivationCodeRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.Ok {
-		n += 2
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if this.Did != that1.Did {
+		return false
 	}
-	return n
+	if this.Role != that1.Role {
+		return false
+	}
+	if len(this.Scope) != len(that1.Scope) {
+		return false
+	}
+	for i := range this.Scope {
+		if this.Scope[i] != that1.Scope[i] {
+			return false
+		}
+	}
+	if this.Device != that1.Device {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
 }
+func (this *ActivationCodeResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func (m *NewIdentifierRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*ActivationCodeResponse)
+	if !ok {
+		that2, ok := that.(ActivationCodeResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *ActivationCodeResponse")
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Method)
-	if l > 0 {
-		n += 1 + l + sovTrackingServerApi(uint64(l))
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *ActivationCodeResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *ActivationCodeResponse but is not nil && this == nil")
 	}
-	if m.AutoPublish {
-		n += 2
+	if this.ActivationCode != that1.ActivationCode {
+		return fmt.Errorf("ActivationCode this(%v) Not Equal that(%v)", this.ActivationCode, that1.ActivationCode)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
 	}
-	return n
+	return nil
 }
+func (this *ActivationCodeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *NewIdentifierResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*ActivationCodeResponse)
+	if !ok {
+		that2, ok := that.(ActivationCodeResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Document)
-	if l > 0 {
-		n += 1 + l + sovTrackingServerApi(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if this.ActivationCode != that1.ActivationCode {
+		return false
 	}
-	return n
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
 }
+func (this *BulkActivationCodesRequest) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func sovTrackingServerApi(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTrackingServerApi(x uint64) (n int) {
-	return sovTrackingServerApi(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *PingResponse) String() string {
-	if this == nil {
-		return "nil"
+	that1, ok := that.(*BulkActivationCodesRequest)
+	if !ok {
+		that2, ok := that.(BulkActivationCodesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *BulkActivationCodesRequest")
+		}
 	}
-	s := strings.Join([]string{`&PingResponse{`,
-		`Ok:` + fmt.Sprintf("%v", this.Ok) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ActivationCodeRequest) String() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *BulkActivationCodesRequest but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *BulkActivationCodesRequest but is not nil && this == nil")
 	}
-	s := strings.Join([]string{`&ActivationCodeRequest{`,
-		`Did:` + fmt.Sprintf("%v", this.Did) + `,`,
-		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ActivationCodeResponse) String() string {
-	if this == nil {
-		return "nil"
+	if this.Count != that1.Count {
+		return fmt.Errorf("Count this(%v) Not Equal that(%v)", this.Count, that1.Count)
 	}
-	s := strings.Join([]string{`&ActivationCodeResponse{`,
-		`ActivationCode:` + fmt.Sprintf("%v", this.ActivationCode) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *CredentialsRequest) String() string {
-	if this == nil {
-		return "nil"
+	if this.Campaign != that1.Campaign {
+		return fmt.Errorf("Campaign this(%v) Not Equal that(%v)", this.Campaign, that1.Campaign)
 	}
-	s := strings.Join([]string{`&CredentialsRequest{`,
-		`Did:` + fmt.Sprintf("%v", this.Did) + `,`,
-		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
-		`ActivationCode:` + fmt.Sprintf("%v", this.ActivationCode) + `,`,
-		`Proof:` + fmt.Sprintf("%v", this.Proof) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RenewCredentialsRequest) String() string {
-	if this == nil {
-		return "nil"
+	if len(this.Scope) != len(that1.Scope) {
+		return fmt.Errorf("Scope this(%v) Not Equal that(%v)", len(this.Scope), len(that1.Scope))
 	}
-	s := strings.Join([]string{`&RenewCredentialsRequest{`,
-		`RefreshCode:` + fmt.Sprintf("%v", this.RefreshCode) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *CredentialsResponse) String() string {
-	if this == nil {
-		return "nil"
+	for i := range this.Scope {
+		if this.Scope[i] != that1.Scope[i] {
+			return fmt.Errorf("Scope this[%v](%v) Not Equal that[%v](%v)", i, this.Scope[i], i, that1.Scope[i])
+		}
 	}
-	s := strings.Join([]string{`&CredentialsResponse{`,
-		`AccessToken:` + fmt.Sprintf("%v", this.AccessToken) + `,`,
-		`RefreshCode:` + fmt.Sprintf("%v", this.RefreshCode) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RecordRequest) String() string {
-	if this == nil {
-		return "nil"
+	if this.QrImages != that1.QrImages {
+		return fmt.Errorf("QrImages this(%v) Not Equal that(%v)", this.QrImages, that1.QrImages)
 	}
-	repeatedStringForRecords := "[]*LocationRecord{"
-	for _, f := range this.Records {
-		repeatedStringForRecords += strings.Replace(fmt.Sprintf("%v", f), "LocationRecord", "LocationRecord", 1) + ","
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
 	}
-	repeatedStringForRecords += "}"
-	s := strings.Join([]string{`&RecordRequest{`,
-		`Records:` + repeatedStringForRecords + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	return nil
 }
-func (this *RecordResponse) String() string {
-	if this == nil {
-		return "nil"
+func (this *BulkActivationCodesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := strings.Join([]string{`&RecordResponse{`,
-		`Ok:` + fmt.Sprintf("%v", this.Ok) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *NewIdentifierRequest) String() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*BulkActivationCodesRequest)
+	if !ok {
+		that2, ok := that.(BulkActivationCodesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := strings.Join([]string{`&NewIdentifierRequest{`,
-		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
-		`AutoPublish:` + fmt.Sprintf("%v", this.AutoPublish) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	if this.Campaign != that1.Campaign {
+		return false
+	}
+	if len(this.Scope) != len(that1.Scope) {
+		return false
+	}
+	for i := range this.Scope {
+		if this.Scope[i] != that1.Scope[i] {
+			return false
+		}
+	}
+	if this.QrImages != that1.QrImages {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
 }
-func (this *NewIdentifierResponse) String() string {
-	if this == nil {
-		return "nil"
+func (this *BulkActivationCodesResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*BulkActivationCodesResponse)
+	if !ok {
+		that2, ok := that.(BulkActivationCodesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *BulkActivationCodesResponse")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *BulkActivationCodesResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *BulkActivationCodesResponse but is not nil && this == nil")
+	}
+	if len(this.Codes) != len(that1.Codes) {
+		return fmt.Errorf("Codes this(%v) Not Equal that(%v)", len(this.Codes), len(that1.Codes))
+	}
+	for i := range this.Codes {
+		if !this.Codes[i].Equal(that1.Codes[i]) {
+			return fmt.Errorf("Codes this[%v](%v) Not Equal that[%v](%v)", i, this.Codes[i], i, that1.Codes[i])
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *BulkActivationCodesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BulkActivationCodesResponse)
+	if !ok {
+		that2, ok := that.(BulkActivationCodesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Codes) != len(that1.Codes) {
+		return false
+	}
+	for i := range this.Codes {
+		if !this.Codes[i].Equal(that1.Codes[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *CampaignCode) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*CampaignCode)
+	if !ok {
+		that2, ok := that.(CampaignCode)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *CampaignCode")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *CampaignCode but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *CampaignCode but is not nil && this == nil")
+	}
+	if this.ActivationCode != that1.ActivationCode {
+		return fmt.Errorf("ActivationCode this(%v) Not Equal that(%v)", this.ActivationCode, that1.ActivationCode)
+	}
+	if this.Campaign != that1.Campaign {
+		return fmt.Errorf("Campaign this(%v) Not Equal that(%v)", this.Campaign, that1.Campaign)
+	}
+	if this.QrCode != that1.QrCode {
+		return fmt.Errorf("QrCode this(%v) Not Equal that(%v)", this.QrCode, that1.QrCode)
+	}
+	if !bytes.Equal(this.QrImage, that1.QrImage) {
+		return fmt.Errorf("QrImage this(%v) Not Equal that(%v)", this.QrImage, that1.QrImage)
+	}
+	if this.Expires != that1.Expires {
+		return fmt.Errorf("Expires this(%v) Not Equal that(%v)", this.Expires, that1.Expires)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *CampaignCode) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CampaignCode)
+	if !ok {
+		that2, ok := that.(CampaignCode)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ActivationCode != that1.ActivationCode {
+		return false
+	}
+	if this.Campaign != that1.Campaign {
+		return false
+	}
+	if this.QrCode != that1.QrCode {
+		return false
+	}
+	if !bytes.Equal(this.QrImage, that1.QrImage) {
+		return false
+	}
+	if this.Expires != that1.Expires {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *CredentialsRequest) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*CredentialsRequest)
+	if !ok {
+		that2, ok := that.(CredentialsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *CredentialsRequest")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *CredentialsRequest but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *CredentialsRequest but is not nil && this == nil")
+	}
+	if this.Did != that1.Did {
+		return fmt.Errorf("Did this(%v) Not Equal that(%v)", this.Did, that1.Did)
+	}
+	if this.Role != that1.Role {
+		return fmt.Errorf("Role this(%v) Not Equal that(%v)", this.Role, that1.Role)
+	}
+	if this.ActivationCode != that1.ActivationCode {
+		return fmt.Errorf("ActivationCode this(%v) Not Equal that(%v)", this.ActivationCode, that1.ActivationCode)
+	}
+	if !bytes.Equal(this.Proof, that1.Proof) {
+		return fmt.Errorf("Proof this(%v) Not Equal that(%v)", this.Proof, that1.Proof)
+	}
+	if this.Lang != that1.Lang {
+		return fmt.Errorf("Lang this(%v) Not Equal that(%v)", this.Lang, that1.Lang)
+	}
+	if len(this.Scope) != len(that1.Scope) {
+		return fmt.Errorf("Scope this(%v) Not Equal that(%v)", len(this.Scope), len(that1.Scope))
+	}
+	for i := range this.Scope {
+		if this.Scope[i] != that1.Scope[i] {
+			return fmt.Errorf("Scope this[%v](%v) Not Equal that[%v](%v)", i, this.Scope[i], i, that1.Scope[i])
+		}
+	}
+	if !this.Attestation.Equal(that1.Attestation) {
+		return fmt.Errorf("Attestation this(%v) Not Equal that(%v)", this.Attestation, that1.Attestation)
+	}
+	if this.Device != that1.Device {
+		return fmt.Errorf("Device this(%v) Not Equal that(%v)", this.Device, that1.Device)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *CredentialsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CredentialsRequest)
+	if !ok {
+		that2, ok := that.(CredentialsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Did != that1.Did {
+		return false
+	}
+	if this.Role != that1.Role {
+		return false
+	}
+	if this.ActivationCode != that1.ActivationCode {
+		return false
+	}
+	if !bytes.Equal(this.Proof, that1.Proof) {
+		return false
+	}
+	if this.Lang != that1.Lang {
+		return false
+	}
+	if len(this.Scope) != len(that1.Scope) {
+		return false
+	}
+	for i := range this.Scope {
+		if this.Scope[i] != that1.Scope[i] {
+			return false
+		}
+	}
+	if !this.Attestation.Equal(that1.Attestation) {
+		return false
+	}
+	if this.Device != that1.Device {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *DeviceAttestation) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*DeviceAttestation)
+	if !ok {
+		that2, ok := that.(DeviceAttestation)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *DeviceAttestation")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *DeviceAttestation but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *DeviceAttestation but is not nil && this == nil")
+	}
+	if this.Platform != that1.Platform {
+		return fmt.Errorf("Platform this(%v) Not Equal that(%v)", this.Platform, that1.Platform)
+	}
+	if this.Token != that1.Token {
+		return fmt.Errorf("Token this(%v) Not Equal that(%v)", this.Token, that1.Token)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *DeviceAttestation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeviceAttestation)
+	if !ok {
+		that2, ok := that.(DeviceAttestation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Platform != that1.Platform {
+		return false
+	}
+	if this.Token != that1.Token {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *RenewCredentialsRequest) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*RenewCredentialsRequest)
+	if !ok {
+		that2, ok := that.(RenewCredentialsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *RenewCredentialsRequest")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *RenewCredentialsRequest but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *RenewCredentialsRequest but is not nil && this == nil")
+	}
+	if this.RefreshCode != that1.RefreshCode {
+		return fmt.Errorf("RefreshCode this(%v) Not Equal that(%v)", this.RefreshCode, that1.RefreshCode)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *RenewCredentialsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RenewCredentialsRequest)
+	if !ok {
+		that2, ok := that.(RenewCredentialsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RefreshCode != that1.RefreshCode {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *CredentialsResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*CredentialsResponse)
+	if !ok {
+		that2, ok := that.(CredentialsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *CredentialsResponse")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *CredentialsResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *CredentialsResponse but is not nil && this == nil")
+	}
+	if this.AccessToken != that1.AccessToken {
+		return fmt.Errorf("AccessToken this(%v) Not Equal that(%v)", this.AccessToken, that1.AccessToken)
+	}
+	if this.RefreshCode != that1.RefreshCode {
+		return fmt.Errorf("RefreshCode this(%v) Not Equal that(%v)", this.RefreshCode, that1.RefreshCode)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *CredentialsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CredentialsResponse)
+	if !ok {
+		that2, ok := that.(CredentialsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.AccessToken != that1.AccessToken {
+		return false
+	}
+	if this.RefreshCode != that1.RefreshCode {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *RecordRequest) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*RecordRequest)
+	if !ok {
+		that2, ok := that.(RecordRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *RecordRequest")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *RecordRequest but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *RecordRequest but is not nil && this == nil")
+	}
+	if len(this.Records) != len(that1.Records) {
+		return fmt.Errorf("Records this(%v) Not Equal that(%v)", len(this.Records), len(that1.Records))
+	}
+	for i := range this.Records {
+		if !this.Records[i].Equal(that1.Records[i]) {
+			return fmt.Errorf("Records this[%v](%v) Not Equal that[%v](%v)", i, this.Records[i], i, that1.Records[i])
+		}
+	}
+	if this.AppVersion != that1.AppVersion {
+		return fmt.Errorf("AppVersion this(%v) Not Equal that(%v)", this.AppVersion, that1.AppVersion)
+	}
+	if this.SdkVersion != that1.SdkVersion {
+		return fmt.Errorf("SdkVersion this(%v) Not Equal that(%v)", this.SdkVersion, that1.SdkVersion)
+	}
+	if this.Platform != that1.Platform {
+		return fmt.Errorf("Platform this(%v) Not Equal that(%v)", this.Platform, that1.Platform)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *RecordRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RecordRequest)
+	if !ok {
+		that2, ok := that.(RecordRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Records) != len(that1.Records) {
+		return false
+	}
+	for i := range this.Records {
+		if !this.Records[i].Equal(that1.Records[i]) {
+			return false
+		}
+	}
+	if this.AppVersion != that1.AppVersion {
+		return false
+	}
+	if this.SdkVersion != that1.SdkVersion {
+		return false
+	}
+	if this.Platform != that1.Platform {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *RecordResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*RecordResponse)
+	if !ok {
+		that2, ok := that.(RecordResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *RecordResponse")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *RecordResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *RecordResponse but is not nil && this == nil")
+	}
+	if this.Ok != that1.Ok {
+		return fmt.Errorf("Ok this(%v) Not Equal that(%v)", this.Ok, that1.Ok)
+	}
+	if len(this.Records) != len(that1.Records) {
+		return fmt.Errorf("Records this(%v) Not Equal that(%v)", len(this.Records), len(that1.Records))
+	}
+	for i := range this.Records {
+		if !this.Records[i].Equal(that1.Records[i]) {
+			return fmt.Errorf("Records this[%v](%v) Not Equal that[%v](%v)", i, this.Records[i], i, that1.Records[i])
+		}
+	}
+	if this.Receipt != that1.Receipt {
+		return fmt.Errorf("Receipt this(%v) Not Equal that(%v)", this.Receipt, that1.Receipt)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *RecordResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RecordResponse)
+	if !ok {
+		that2, ok := that.(RecordResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Ok != that1.Ok {
+		return false
+	}
+	if len(this.Records) != len(that1.Records) {
+		return false
+	}
+	for i := range this.Records {
+		if !this.Records[i].Equal(that1.Records[i]) {
+			return false
+		}
+	}
+	if this.Receipt != that1.Receipt {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *RecordStatus) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*RecordStatus)
+	if !ok {
+		that2, ok := that.(RecordStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *RecordStatus")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *RecordStatus but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *RecordStatus but is not nil && this == nil")
+	}
+	if this.Index != that1.Index {
+		return fmt.Errorf("Index this(%v) Not Equal that(%v)", this.Index, that1.Index)
+	}
+	if this.Hash != that1.Hash {
+		return fmt.Errorf("Hash this(%v) Not Equal that(%v)", this.Hash, that1.Hash)
+	}
+	if this.Status != that1.Status {
+		return fmt.Errorf("Status this(%v) Not Equal that(%v)", this.Status, that1.Status)
+	}
+	if this.Reason != that1.Reason {
+		return fmt.Errorf("Reason this(%v) Not Equal that(%v)", this.Reason, that1.Reason)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *RecordStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RecordStatus)
+	if !ok {
+		that2, ok := that.(RecordStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Index != that1.Index {
+		return false
+	}
+	if this.Hash != that1.Hash {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *CheckExposureRequest) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*CheckExposureRequest)
+	if !ok {
+		that2, ok := that.(CheckExposureRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *CheckExposureRequest")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *CheckExposureRequest but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *CheckExposureRequest but is not nil && this == nil")
+	}
+	if len(this.Prefixes) != len(that1.Prefixes) {
+		return fmt.Errorf("Prefixes this(%v) Not Equal that(%v)", len(this.Prefixes), len(that1.Prefixes))
+	}
+	for i := range this.Prefixes {
+		if this.Prefixes[i] != that1.Prefixes[i] {
+			return fmt.Errorf("Prefixes this[%v](%v) Not Equal that[%v](%v)", i, this.Prefixes[i], i, that1.Prefixes[i])
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *CheckExposureRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CheckExposureRequest)
+	if !ok {
+		that2, ok := that.(CheckExposureRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Prefixes) != len(that1.Prefixes) {
+		return false
+	}
+	for i := range this.Prefixes {
+		if this.Prefixes[i] != that1.Prefixes[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *CheckExposureResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*CheckExposureResponse)
+	if !ok {
+		that2, ok := that.(CheckExposureResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *CheckExposureResponse")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *CheckExposureResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *CheckExposureResponse but is not nil && this == nil")
+	}
+	if len(this.Tokens) != len(that1.Tokens) {
+		return fmt.Errorf("Tokens this(%v) Not Equal that(%v)", len(this.Tokens), len(that1.Tokens))
+	}
+	for i := range this.Tokens {
+		if this.Tokens[i] != that1.Tokens[i] {
+			return fmt.Errorf("Tokens this[%v](%v) Not Equal that[%v](%v)", i, this.Tokens[i], i, that1.Tokens[i])
+		}
+	}
+	if this.Updated != that1.Updated {
+		return fmt.Errorf("Updated this(%v) Not Equal that(%v)", this.Updated, that1.Updated)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *CheckExposureResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CheckExposureResponse)
+	if !ok {
+		that2, ok := that.(CheckExposureResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Tokens) != len(that1.Tokens) {
+		return false
+	}
+	for i := range this.Tokens {
+		if this.Tokens[i] != that1.Tokens[i] {
+			return false
+		}
+	}
+	if this.Updated != that1.Updated {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *PSIRequest) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*PSIRequest)
+	if !ok {
+		that2, ok := that.(PSIRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *PSIRequest")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *PSIRequest but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *PSIRequest but is not nil && this == nil")
+	}
+	if len(this.Blinded) != len(that1.Blinded) {
+		return fmt.Errorf("Blinded this(%v) Not Equal that(%v)", len(this.Blinded), len(that1.Blinded))
+	}
+	for i := range this.Blinded {
+		if !bytes.Equal(this.Blinded[i], that1.Blinded[i]) {
+			return fmt.Errorf("Blinded this[%v](%v) Not Equal that[%v](%v)", i, this.Blinded[i], i, that1.Blinded[i])
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *PSIRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PSIRequest)
+	if !ok {
+		that2, ok := that.(PSIRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Blinded) != len(that1.Blinded) {
+		return false
+	}
+	for i := range this.Blinded {
+		if !bytes.Equal(this.Blinded[i], that1.Blinded[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *PSIResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*PSIResponse)
+	if !ok {
+		that2, ok := that.(PSIResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *PSIResponse")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *PSIResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *PSIResponse but is not nil && this == nil")
+	}
+	if len(this.Evaluated) != len(that1.Evaluated) {
+		return fmt.Errorf("Evaluated this(%v) Not Equal that(%v)", len(this.Evaluated), len(that1.Evaluated))
+	}
+	for i := range this.Evaluated {
+		if !bytes.Equal(this.Evaluated[i], that1.Evaluated[i]) {
+			return fmt.Errorf("Evaluated this[%v](%v) Not Equal that[%v](%v)", i, this.Evaluated[i], i, that1.Evaluated[i])
+		}
+	}
+	if len(this.Set) != len(that1.Set) {
+		return fmt.Errorf("Set this(%v) Not Equal that(%v)", len(this.Set), len(that1.Set))
+	}
+	for i := range this.Set {
+		if this.Set[i] != that1.Set[i] {
+			return fmt.Errorf("Set this[%v](%v) Not Equal that[%v](%v)", i, this.Set[i], i, that1.Set[i])
+		}
+	}
+	if this.Updated != that1.Updated {
+		return fmt.Errorf("Updated this(%v) Not Equal that(%v)", this.Updated, that1.Updated)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *PSIResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PSIResponse)
+	if !ok {
+		that2, ok := that.(PSIResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Evaluated) != len(that1.Evaluated) {
+		return false
+	}
+	for i := range this.Evaluated {
+		if !bytes.Equal(this.Evaluated[i], that1.Evaluated[i]) {
+			return false
+		}
+	}
+	if len(this.Set) != len(that1.Set) {
+		return false
+	}
+	for i := range this.Set {
+		if this.Set[i] != that1.Set[i] {
+			return false
+		}
+	}
+	if this.Updated != that1.Updated {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *SamplingPolicy) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*SamplingPolicy)
+	if !ok {
+		that2, ok := that.(SamplingPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *SamplingPolicy")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *SamplingPolicy but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *SamplingPolicy but is not nil && this == nil")
+	}
+	if this.Mode != that1.Mode {
+		return fmt.Errorf("Mode this(%v) Not Equal that(%v)", this.Mode, that1.Mode)
+	}
+	if this.RecordInterval != that1.RecordInterval {
+		return fmt.Errorf("RecordInterval this(%v) Not Equal that(%v)", this.RecordInterval, that1.RecordInterval)
+	}
+	if this.UploadInterval != that1.UploadInterval {
+		return fmt.Errorf("UploadInterval this(%v) Not Equal that(%v)", this.UploadInterval, that1.UploadInterval)
+	}
+	if this.MinDistance != that1.MinDistance {
+		return fmt.Errorf("MinDistance this(%v) Not Equal that(%v)", this.MinDistance, that1.MinDistance)
+	}
+	if this.RefreshInterval != that1.RefreshInterval {
+		return fmt.Errorf("RefreshInterval this(%v) Not Equal that(%v)", this.RefreshInterval, that1.RefreshInterval)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *SamplingPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SamplingPolicy)
+	if !ok {
+		that2, ok := that.(SamplingPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Mode != that1.Mode {
+		return false
+	}
+	if this.RecordInterval != that1.RecordInterval {
+		return false
+	}
+	if this.UploadInterval != that1.UploadInterval {
+		return false
+	}
+	if this.MinDistance != that1.MinDistance {
+		return false
+	}
+	if this.RefreshInterval != that1.RefreshInterval {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *SubmissionStatusRequest) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*SubmissionStatusRequest)
+	if !ok {
+		that2, ok := that.(SubmissionStatusRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *SubmissionStatusRequest")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *SubmissionStatusRequest but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *SubmissionStatusRequest but is not nil && this == nil")
+	}
+	if this.Receipt != that1.Receipt {
+		return fmt.Errorf("Receipt this(%v) Not Equal that(%v)", this.Receipt, that1.Receipt)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *SubmissionStatusRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SubmissionStatusRequest)
+	if !ok {
+		that2, ok := that.(SubmissionStatusRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Receipt != that1.Receipt {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *SubmissionStatusResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*SubmissionStatusResponse)
+	if !ok {
+		that2, ok := that.(SubmissionStatusResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *SubmissionStatusResponse")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *SubmissionStatusResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *SubmissionStatusResponse but is not nil && this == nil")
+	}
+	if this.Receipt != that1.Receipt {
+		return fmt.Errorf("Receipt this(%v) Not Equal that(%v)", this.Receipt, that1.Receipt)
+	}
+	if this.Status != that1.Status {
+		return fmt.Errorf("Status this(%v) Not Equal that(%v)", this.Status, that1.Status)
+	}
+	if len(this.Records) != len(that1.Records) {
+		return fmt.Errorf("Records this(%v) Not Equal that(%v)", len(this.Records), len(that1.Records))
+	}
+	for i := range this.Records {
+		if !this.Records[i].Equal(that1.Records[i]) {
+			return fmt.Errorf("Records this[%v](%v) Not Equal that[%v](%v)", i, this.Records[i], i, that1.Records[i])
+		}
+	}
+	if this.Created != that1.Created {
+		return fmt.Errorf("Created this(%v) Not Equal that(%v)", this.Created, that1.Created)
+	}
+	if this.Processed != that1.Processed {
+		return fmt.Errorf("Processed this(%v) Not Equal that(%v)", this.Processed, that1.Processed)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *SubmissionStatusResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SubmissionStatusResponse)
+	if !ok {
+		that2, ok := that.(SubmissionStatusResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Receipt != that1.Receipt {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if len(this.Records) != len(that1.Records) {
+		return false
+	}
+	for i := range this.Records {
+		if !this.Records[i].Equal(that1.Records[i]) {
+			return false
+		}
+	}
+	if this.Created != that1.Created {
+		return false
+	}
+	if this.Processed != that1.Processed {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *NewIdentifierRequest) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*NewIdentifierRequest)
+	if !ok {
+		that2, ok := that.(NewIdentifierRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *NewIdentifierRequest")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *NewIdentifierRequest but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *NewIdentifierRequest but is not nil && this == nil")
+	}
+	if this.Method != that1.Method {
+		return fmt.Errorf("Method this(%v) Not Equal that(%v)", this.Method, that1.Method)
+	}
+	if this.AutoPublish != that1.AutoPublish {
+		return fmt.Errorf("AutoPublish this(%v) Not Equal that(%v)", this.AutoPublish, that1.AutoPublish)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *NewIdentifierRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NewIdentifierRequest)
+	if !ok {
+		that2, ok := that.(NewIdentifierRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Method != that1.Method {
+		return false
+	}
+	if this.AutoPublish != that1.AutoPublish {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *NewIdentifierResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*NewIdentifierResponse)
+	if !ok {
+		that2, ok := that.(NewIdentifierResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *NewIdentifierResponse")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *NewIdentifierResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *NewIdentifierResponse but is not nil && this == nil")
+	}
+	if this.Document != that1.Document {
+		return fmt.Errorf("Document this(%v) Not Equal that(%v)", this.Document, that1.Document)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *NewIdentifierResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NewIdentifierResponse)
+	if !ok {
+		that2, ok := that.(NewIdentifierResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Document != that1.Document {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *RegisterVenueRequest) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*RegisterVenueRequest)
+	if !ok {
+		that2, ok := that.(RegisterVenueRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *RegisterVenueRequest")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *RegisterVenueRequest but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *RegisterVenueRequest but is not nil && this == nil")
+	}
+	if this.Name != that1.Name {
+		return fmt.Errorf("Name this(%v) Not Equal that(%v)", this.Name, that1.Name)
+	}
+	if this.Lat != that1.Lat {
+		return fmt.Errorf("Lat this(%v) Not Equal that(%v)", this.Lat, that1.Lat)
+	}
+	if this.Lng != that1.Lng {
+		return fmt.Errorf("Lng this(%v) Not Equal that(%v)", this.Lng, that1.Lng)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *RegisterVenueRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RegisterVenueRequest)
+	if !ok {
+		that2, ok := that.(RegisterVenueRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Lat != that1.Lat {
+		return false
+	}
+	if this.Lng != that1.Lng {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *CheckInRequest) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*CheckInRequest)
+	if !ok {
+		that2, ok := that.(CheckInRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *CheckInRequest")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *CheckInRequest but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *CheckInRequest but is not nil && this == nil")
+	}
+	if len(this.Records) != len(that1.Records) {
+		return fmt.Errorf("Records this(%v) Not Equal that(%v)", len(this.Records), len(that1.Records))
+	}
+	for i := range this.Records {
+		if !this.Records[i].Equal(that1.Records[i]) {
+			return fmt.Errorf("Records this[%v](%v) Not Equal that[%v](%v)", i, this.Records[i], i, that1.Records[i])
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *CheckInRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CheckInRequest)
+	if !ok {
+		that2, ok := that.(CheckInRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Records) != len(that1.Records) {
+		return false
+	}
+	for i := range this.Records {
+		if !this.Records[i].Equal(that1.Records[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *CheckInResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*CheckInResponse)
+	if !ok {
+		that2, ok := that.(CheckInResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *CheckInResponse")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *CheckInResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *CheckInResponse but is not nil && this == nil")
+	}
+	if this.Ok != that1.Ok {
+		return fmt.Errorf("Ok this(%v) Not Equal that(%v)", this.Ok, that1.Ok)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *CheckInResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CheckInResponse)
+	if !ok {
+		that2, ok := that.(CheckInResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Ok != that1.Ok {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *VenueOutbreakRequest) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*VenueOutbreakRequest)
+	if !ok {
+		that2, ok := that.(VenueOutbreakRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *VenueOutbreakRequest")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *VenueOutbreakRequest but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *VenueOutbreakRequest but is not nil && this == nil")
+	}
+	if this.Venue != that1.Venue {
+		return fmt.Errorf("Venue this(%v) Not Equal that(%v)", this.Venue, that1.Venue)
+	}
+	if this.From != that1.From {
+		return fmt.Errorf("From this(%v) Not Equal that(%v)", this.From, that1.From)
+	}
+	if this.To != that1.To {
+		return fmt.Errorf("To this(%v) Not Equal that(%v)", this.To, that1.To)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *VenueOutbreakRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VenueOutbreakRequest)
+	if !ok {
+		that2, ok := that.(VenueOutbreakRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Venue != that1.Venue {
+		return false
+	}
+	if this.From != that1.From {
+		return false
+	}
+	if this.To != that1.To {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *VenueOutbreakResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*VenueOutbreakResponse)
+	if !ok {
+		that2, ok := that.(VenueOutbreakResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *VenueOutbreakResponse")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *VenueOutbreakResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *VenueOutbreakResponse but is not nil && this == nil")
+	}
+	if this.Ok != that1.Ok {
+		return fmt.Errorf("Ok this(%v) Not Equal that(%v)", this.Ok, that1.Ok)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *VenueOutbreakResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VenueOutbreakResponse)
+	if !ok {
+		that2, ok := that.(VenueOutbreakResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Ok != that1.Ok {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *AnalyticsRequest) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*AnalyticsRequest)
+	if !ok {
+		that2, ok := that.(AnalyticsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *AnalyticsRequest")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *AnalyticsRequest but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *AnalyticsRequest but is not nil && this == nil")
+	}
+	if this.From != that1.From {
+		return fmt.Errorf("From this(%v) Not Equal that(%v)", this.From, that1.From)
+	}
+	if this.To != that1.To {
+		return fmt.Errorf("To this(%v) Not Equal that(%v)", this.To, that1.To)
+	}
+	if !this.Fields.Equal(that1.Fields) {
+		return fmt.Errorf("Fields this(%v) Not Equal that(%v)", this.Fields, that1.Fields)
+	}
+	if this.Purpose != that1.Purpose {
+		return fmt.Errorf("Purpose this(%v) Not Equal that(%v)", this.Purpose, that1.Purpose)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *AnalyticsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AnalyticsRequest)
+	if !ok {
+		that2, ok := that.(AnalyticsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.From != that1.From {
+		return false
+	}
+	if this.To != that1.To {
+		return false
+	}
+	if !this.Fields.Equal(that1.Fields) {
+		return false
+	}
+	if this.Purpose != that1.Purpose {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *AnalyticsResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*AnalyticsResponse)
+	if !ok {
+		that2, ok := that.(AnalyticsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *AnalyticsResponse")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *AnalyticsResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *AnalyticsResponse but is not nil && this == nil")
+	}
+	if len(this.Hotspots) != len(that1.Hotspots) {
+		return fmt.Errorf("Hotspots this(%v) Not Equal that(%v)", len(this.Hotspots), len(that1.Hotspots))
+	}
+	for i := range this.Hotspots {
+		if !this.Hotspots[i].Equal(that1.Hotspots[i]) {
+			return fmt.Errorf("Hotspots this[%v](%v) Not Equal that[%v](%v)", i, this.Hotspots[i], i, that1.Hotspots[i])
+		}
+	}
+	if len(this.Flows) != len(that1.Flows) {
+		return fmt.Errorf("Flows this(%v) Not Equal that(%v)", len(this.Flows), len(that1.Flows))
+	}
+	for i := range this.Flows {
+		if !this.Flows[i].Equal(that1.Flows[i]) {
+			return fmt.Errorf("Flows this[%v](%v) Not Equal that[%v](%v)", i, this.Flows[i], i, that1.Flows[i])
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *AnalyticsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AnalyticsResponse)
+	if !ok {
+		that2, ok := that.(AnalyticsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Hotspots) != len(that1.Hotspots) {
+		return false
+	}
+	for i := range this.Hotspots {
+		if !this.Hotspots[i].Equal(that1.Hotspots[i]) {
+			return false
+		}
+	}
+	if len(this.Flows) != len(that1.Flows) {
+		return false
+	}
+	for i := range this.Flows {
+		if !this.Flows[i].Equal(that1.Flows[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *LabResultRequest) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*LabResultRequest)
+	if !ok {
+		that2, ok := that.(LabResultRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *LabResultRequest")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *LabResultRequest but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *LabResultRequest but is not nil && this == nil")
+	}
+	if !bytes.Equal(this.Resource, that1.Resource) {
+		return fmt.Errorf("Resource this(%v) Not Equal that(%v)", this.Resource, that1.Resource)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *LabResultRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LabResultRequest)
+	if !ok {
+		that2, ok := that.(LabResultRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Resource, that1.Resource) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *LabResultResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*LabResultResponse)
+	if !ok {
+		that2, ok := that.(LabResultResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *LabResultResponse")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *LabResultResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *LabResultResponse but is not nil && this == nil")
+	}
+	if this.Ok != that1.Ok {
+		return fmt.Errorf("Ok this(%v) Not Equal that(%v)", this.Ok, that1.Ok)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *LabResultResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LabResultResponse)
+	if !ok {
+		that2, ok := that.(LabResultResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Ok != that1.Ok {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *CertificateRequest) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*CertificateRequest)
+	if !ok {
+		that2, ok := that.(CertificateRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *CertificateRequest")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *CertificateRequest but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *CertificateRequest but is not nil && this == nil")
+	}
+	if this.Diagnosis != that1.Diagnosis {
+		return fmt.Errorf("Diagnosis this(%v) Not Equal that(%v)", this.Diagnosis, that1.Diagnosis)
+	}
+	if this.Format != that1.Format {
+		return fmt.Errorf("Format this(%v) Not Equal that(%v)", this.Format, that1.Format)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *CertificateRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CertificateRequest)
+	if !ok {
+		that2, ok := that.(CertificateRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Diagnosis != that1.Diagnosis {
+		return false
+	}
+	if this.Format != that1.Format {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *CertificateResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*CertificateResponse)
+	if !ok {
+		that2, ok := that.(CertificateResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *CertificateResponse")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *CertificateResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *CertificateResponse but is not nil && this == nil")
+	}
+	if this.Format != that1.Format {
+		return fmt.Errorf("Format this(%v) Not Equal that(%v)", this.Format, that1.Format)
+	}
+	if this.Credential != that1.Credential {
+		return fmt.Errorf("Credential this(%v) Not Equal that(%v)", this.Credential, that1.Credential)
+	}
+	if this.Qr != that1.Qr {
+		return fmt.Errorf("Qr this(%v) Not Equal that(%v)", this.Qr, that1.Qr)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *CertificateResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CertificateResponse)
+	if !ok {
+		that2, ok := that.(CertificateResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Format != that1.Format {
+		return false
+	}
+	if this.Credential != that1.Credential {
+		return false
+	}
+	if this.Qr != that1.Qr {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *IntrospectRequest) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*IntrospectRequest)
+	if !ok {
+		that2, ok := that.(IntrospectRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *IntrospectRequest")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *IntrospectRequest but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *IntrospectRequest but is not nil && this == nil")
+	}
+	if this.Token != that1.Token {
+		return fmt.Errorf("Token this(%v) Not Equal that(%v)", this.Token, that1.Token)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *IntrospectRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*IntrospectRequest)
+	if !ok {
+		that2, ok := that.(IntrospectRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Token != that1.Token {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *IntrospectResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*IntrospectResponse)
+	if !ok {
+		that2, ok := that.(IntrospectResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *IntrospectResponse")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *IntrospectResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *IntrospectResponse but is not nil && this == nil")
+	}
+	if this.Active != that1.Active {
+		return fmt.Errorf("Active this(%v) Not Equal that(%v)", this.Active, that1.Active)
+	}
+	if this.Sub != that1.Sub {
+		return fmt.Errorf("Sub this(%v) Not Equal that(%v)", this.Sub, that1.Sub)
+	}
+	if this.Did != that1.Did {
+		return fmt.Errorf("Did this(%v) Not Equal that(%v)", this.Did, that1.Did)
+	}
+	if this.Role != that1.Role {
+		return fmt.Errorf("Role this(%v) Not Equal that(%v)", this.Role, that1.Role)
+	}
+	if this.Lang != that1.Lang {
+		return fmt.Errorf("Lang this(%v) Not Equal that(%v)", this.Lang, that1.Lang)
+	}
+	if this.Iss != that1.Iss {
+		return fmt.Errorf("Iss this(%v) Not Equal that(%v)", this.Iss, that1.Iss)
+	}
+	if len(this.Aud) != len(that1.Aud) {
+		return fmt.Errorf("Aud this(%v) Not Equal that(%v)", len(this.Aud), len(that1.Aud))
+	}
+	for i := range this.Aud {
+		if this.Aud[i] != that1.Aud[i] {
+			return fmt.Errorf("Aud this[%v](%v) Not Equal that[%v](%v)", i, this.Aud[i], i, that1.Aud[i])
+		}
+	}
+	if this.Exp != that1.Exp {
+		return fmt.Errorf("Exp this(%v) Not Equal that(%v)", this.Exp, that1.Exp)
+	}
+	if this.Iat != that1.Iat {
+		return fmt.Errorf("Iat this(%v) Not Equal that(%v)", this.Iat, that1.Iat)
+	}
+	if this.Nbf != that1.Nbf {
+		return fmt.Errorf("Nbf this(%v) Not Equal that(%v)", this.Nbf, that1.Nbf)
+	}
+	if this.Jti != that1.Jti {
+		return fmt.Errorf("Jti this(%v) Not Equal that(%v)", this.Jti, that1.Jti)
+	}
+	if this.Scope != that1.Scope {
+		return fmt.Errorf("Scope this(%v) Not Equal that(%v)", this.Scope, that1.Scope)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *IntrospectResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*IntrospectResponse)
+	if !ok {
+		that2, ok := that.(IntrospectResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Active != that1.Active {
+		return false
+	}
+	if this.Sub != that1.Sub {
+		return false
+	}
+	if this.Did != that1.Did {
+		return false
+	}
+	if this.Role != that1.Role {
+		return false
+	}
+	if this.Lang != that1.Lang {
+		return false
+	}
+	if this.Iss != that1.Iss {
+		return false
+	}
+	if len(this.Aud) != len(that1.Aud) {
+		return false
+	}
+	for i := range this.Aud {
+		if this.Aud[i] != that1.Aud[i] {
+			return false
+		}
+	}
+	if this.Exp != that1.Exp {
+		return false
+	}
+	if this.Iat != that1.Iat {
+		return false
+	}
+	if this.Nbf != that1.Nbf {
+		return false
+	}
+	if this.Jti != that1.Jti {
+		return false
+	}
+	if this.Scope != that1.Scope {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *AckRequest) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*AckRequest)
+	if !ok {
+		that2, ok := that.(AckRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *AckRequest")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *AckRequest but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *AckRequest but is not nil && this == nil")
+	}
+	if len(this.Notifications) != len(that1.Notifications) {
+		return fmt.Errorf("Notifications this(%v) Not Equal that(%v)", len(this.Notifications), len(that1.Notifications))
+	}
+	for i := range this.Notifications {
+		if this.Notifications[i] != that1.Notifications[i] {
+			return fmt.Errorf("Notifications this[%v](%v) Not Equal that[%v](%v)", i, this.Notifications[i], i, that1.Notifications[i])
+		}
+	}
+	if this.Status != that1.Status {
+		return fmt.Errorf("Status this(%v) Not Equal that(%v)", this.Status, that1.Status)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *AckRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AckRequest)
+	if !ok {
+		that2, ok := that.(AckRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Notifications) != len(that1.Notifications) {
+		return false
+	}
+	for i := range this.Notifications {
+		if this.Notifications[i] != that1.Notifications[i] {
+			return false
+		}
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *AckResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*AckResponse)
+	if !ok {
+		that2, ok := that.(AckResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *AckResponse")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *AckResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *AckResponse but is not nil && this == nil")
+	}
+	if this.Updated != that1.Updated {
+		return fmt.Errorf("Updated this(%v) Not Equal that(%v)", this.Updated, that1.Updated)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *AckResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AckResponse)
+	if !ok {
+		that2, ok := that.(AckResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Updated != that1.Updated {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *NotificationStatusRequest) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*NotificationStatusRequest)
+	if !ok {
+		that2, ok := that.(NotificationStatusRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *NotificationStatusRequest")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *NotificationStatusRequest but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *NotificationStatusRequest but is not nil && this == nil")
+	}
+	if this.Source != that1.Source {
+		return fmt.Errorf("Source this(%v) Not Equal that(%v)", this.Source, that1.Source)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *NotificationStatusRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NotificationStatusRequest)
+	if !ok {
+		that2, ok := that.(NotificationStatusRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Source != that1.Source {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *NotificationStatusResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*NotificationStatusResponse)
+	if !ok {
+		that2, ok := that.(NotificationStatusResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *NotificationStatusResponse")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *NotificationStatusResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *NotificationStatusResponse but is not nil && this == nil")
+	}
+	if this.Total != that1.Total {
+		return fmt.Errorf("Total this(%v) Not Equal that(%v)", this.Total, that1.Total)
+	}
+	if this.Pending != that1.Pending {
+		return fmt.Errorf("Pending this(%v) Not Equal that(%v)", this.Pending, that1.Pending)
+	}
+	if this.Dispatched != that1.Dispatched {
+		return fmt.Errorf("Dispatched this(%v) Not Equal that(%v)", this.Dispatched, that1.Dispatched)
+	}
+	if this.Failed != that1.Failed {
+		return fmt.Errorf("Failed this(%v) Not Equal that(%v)", this.Failed, that1.Failed)
+	}
+	if this.Delivered != that1.Delivered {
+		return fmt.Errorf("Delivered this(%v) Not Equal that(%v)", this.Delivered, that1.Delivered)
+	}
+	if this.Read != that1.Read {
+		return fmt.Errorf("Read this(%v) Not Equal that(%v)", this.Read, that1.Read)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *NotificationStatusResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NotificationStatusResponse)
+	if !ok {
+		that2, ok := that.(NotificationStatusResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Total != that1.Total {
+		return false
+	}
+	if this.Pending != that1.Pending {
+		return false
+	}
+	if this.Dispatched != that1.Dispatched {
+		return false
+	}
+	if this.Failed != that1.Failed {
+		return false
+	}
+	if this.Delivered != that1.Delivered {
+		return false
+	}
+	if this.Read != that1.Read {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *GeoPoint) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*GeoPoint)
+	if !ok {
+		that2, ok := that.(GeoPoint)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *GeoPoint")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *GeoPoint but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *GeoPoint but is not nil && this == nil")
+	}
+	if this.Lat != that1.Lat {
+		return fmt.Errorf("Lat this(%v) Not Equal that(%v)", this.Lat, that1.Lat)
+	}
+	if this.Lng != that1.Lng {
+		return fmt.Errorf("Lng this(%v) Not Equal that(%v)", this.Lng, that1.Lng)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *GeoPoint) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GeoPoint)
+	if !ok {
+		that2, ok := that.(GeoPoint)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Lat != that1.Lat {
+		return false
+	}
+	if this.Lng != that1.Lng {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *ExposureQueryRequest) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*ExposureQueryRequest)
+	if !ok {
+		that2, ok := that.(ExposureQueryRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *ExposureQueryRequest")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *ExposureQueryRequest but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *ExposureQueryRequest but is not nil && this == nil")
+	}
+	if len(this.Area) != len(that1.Area) {
+		return fmt.Errorf("Area this(%v) Not Equal that(%v)", len(this.Area), len(that1.Area))
+	}
+	for i := range this.Area {
+		if !this.Area[i].Equal(that1.Area[i]) {
+			return fmt.Errorf("Area this[%v](%v) Not Equal that[%v](%v)", i, this.Area[i], i, that1.Area[i])
+		}
+	}
+	if this.From != that1.From {
+		return fmt.Errorf("From this(%v) Not Equal that(%v)", this.From, that1.From)
+	}
+	if this.To != that1.To {
+		return fmt.Errorf("To this(%v) Not Equal that(%v)", this.To, that1.To)
+	}
+	if this.Identifiers != that1.Identifiers {
+		return fmt.Errorf("Identifiers this(%v) Not Equal that(%v)", this.Identifiers, that1.Identifiers)
+	}
+	if this.Reason != that1.Reason {
+		return fmt.Errorf("Reason this(%v) Not Equal that(%v)", this.Reason, that1.Reason)
+	}
+	if this.Purpose != that1.Purpose {
+		return fmt.Errorf("Purpose this(%v) Not Equal that(%v)", this.Purpose, that1.Purpose)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *ExposureQueryRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ExposureQueryRequest)
+	if !ok {
+		that2, ok := that.(ExposureQueryRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Area) != len(that1.Area) {
+		return false
+	}
+	for i := range this.Area {
+		if !this.Area[i].Equal(that1.Area[i]) {
+			return false
+		}
+	}
+	if this.From != that1.From {
+		return false
+	}
+	if this.To != that1.To {
+		return false
+	}
+	if this.Identifiers != that1.Identifiers {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if this.Purpose != that1.Purpose {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *ExposureQueryResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*ExposureQueryResponse)
+	if !ok {
+		that2, ok := that.(ExposureQueryResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *ExposureQueryResponse")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *ExposureQueryResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *ExposureQueryResponse but is not nil && this == nil")
+	}
+	if len(this.Presence) != len(that1.Presence) {
+		return fmt.Errorf("Presence this(%v) Not Equal that(%v)", len(this.Presence), len(that1.Presence))
+	}
+	for i := range this.Presence {
+		if !this.Presence[i].Equal(that1.Presence[i]) {
+			return fmt.Errorf("Presence this[%v](%v) Not Equal that[%v](%v)", i, this.Presence[i], i, that1.Presence[i])
+		}
+	}
+	if this.Users != that1.Users {
+		return fmt.Errorf("Users this(%v) Not Equal that(%v)", this.Users, that1.Users)
+	}
+	if len(this.Identifiers) != len(that1.Identifiers) {
+		return fmt.Errorf("Identifiers this(%v) Not Equal that(%v)", len(this.Identifiers), len(that1.Identifiers))
+	}
+	for i := range this.Identifiers {
+		if this.Identifiers[i] != that1.Identifiers[i] {
+			return fmt.Errorf("Identifiers this[%v](%v) Not Equal that[%v](%v)", i, this.Identifiers[i], i, that1.Identifiers[i])
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *ExposureQueryResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ExposureQueryResponse)
+	if !ok {
+		that2, ok := that.(ExposureQueryResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Presence) != len(that1.Presence) {
+		return false
+	}
+	for i := range this.Presence {
+		if !this.Presence[i].Equal(that1.Presence[i]) {
+			return false
+		}
+	}
+	if this.Users != that1.Users {
+		return false
+	}
+	if len(this.Identifiers) != len(that1.Identifiers) {
+		return false
+	}
+	for i := range this.Identifiers {
+		if this.Identifiers[i] != that1.Identifiers[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *Presence) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*Presence)
+	if !ok {
+		that2, ok := that.(Presence)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *Presence")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *Presence but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *Presence but is not nil && this == nil")
+	}
+	if this.Cell != that1.Cell {
+		return fmt.Errorf("Cell this(%v) Not Equal that(%v)", this.Cell, that1.Cell)
+	}
+	if this.Users != that1.Users {
+		return fmt.Errorf("Users this(%v) Not Equal that(%v)", this.Users, that1.Users)
+	}
+	if this.From != that1.From {
+		return fmt.Errorf("From this(%v) Not Equal that(%v)", this.From, that1.From)
+	}
+	if this.To != that1.To {
+		return fmt.Errorf("To this(%v) Not Equal that(%v)", this.To, that1.To)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *Presence) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Presence)
+	if !ok {
+		that2, ok := that.(Presence)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Cell != that1.Cell {
+		return false
+	}
+	if this.Users != that1.Users {
+		return false
+	}
+	if this.From != that1.From {
+		return false
+	}
+	if this.To != that1.To {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *Session) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*Session)
+	if !ok {
+		that2, ok := that.(Session)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *Session")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *Session but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *Session but is not nil && this == nil")
+	}
+	if this.Id != that1.Id {
+		return fmt.Errorf("Id this(%v) Not Equal that(%v)", this.Id, that1.Id)
+	}
+	if this.Role != that1.Role {
+		return fmt.Errorf("Role this(%v) Not Equal that(%v)", this.Role, that1.Role)
+	}
+	if this.Created != that1.Created {
+		return fmt.Errorf("Created this(%v) Not Equal that(%v)", this.Created, that1.Created)
+	}
+	if this.Issued != that1.Issued {
+		return fmt.Errorf("Issued this(%v) Not Equal that(%v)", this.Issued, that1.Issued)
+	}
+	if this.Expires != that1.Expires {
+		return fmt.Errorf("Expires this(%v) Not Equal that(%v)", this.Expires, that1.Expires)
+	}
+	if this.Current != that1.Current {
+		return fmt.Errorf("Current this(%v) Not Equal that(%v)", this.Current, that1.Current)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *Session) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Session)
+	if !ok {
+		that2, ok := that.(Session)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Role != that1.Role {
+		return false
+	}
+	if this.Created != that1.Created {
+		return false
+	}
+	if this.Issued != that1.Issued {
+		return false
+	}
+	if this.Expires != that1.Expires {
+		return false
+	}
+	if this.Current != that1.Current {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *ListSessionsResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*ListSessionsResponse)
+	if !ok {
+		that2, ok := that.(ListSessionsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *ListSessionsResponse")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *ListSessionsResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *ListSessionsResponse but is not nil && this == nil")
+	}
+	if len(this.Sessions) != len(that1.Sessions) {
+		return fmt.Errorf("Sessions this(%v) Not Equal that(%v)", len(this.Sessions), len(that1.Sessions))
+	}
+	for i := range this.Sessions {
+		if !this.Sessions[i].Equal(that1.Sessions[i]) {
+			return fmt.Errorf("Sessions this[%v](%v) Not Equal that[%v](%v)", i, this.Sessions[i], i, that1.Sessions[i])
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *ListSessionsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ListSessionsResponse)
+	if !ok {
+		that2, ok := that.(ListSessionsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Sessions) != len(that1.Sessions) {
+		return false
+	}
+	for i := range this.Sessions {
+		if !this.Sessions[i].Equal(that1.Sessions[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *RevokeSessionRequest) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*RevokeSessionRequest)
+	if !ok {
+		that2, ok := that.(RevokeSessionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *RevokeSessionRequest")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *RevokeSessionRequest but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *RevokeSessionRequest but is not nil && this == nil")
+	}
+	if this.Id != that1.Id {
+		return fmt.Errorf("Id this(%v) Not Equal that(%v)", this.Id, that1.Id)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *RevokeSessionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RevokeSessionRequest)
+	if !ok {
+		that2, ok := that.(RevokeSessionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *SendMessageResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*SendMessageResponse)
+	if !ok {
+		that2, ok := that.(SendMessageResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *SendMessageResponse")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *SendMessageResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *SendMessageResponse but is not nil && this == nil")
+	}
+	if this.Id != that1.Id {
+		return fmt.Errorf("Id this(%v) Not Equal that(%v)", this.Id, that1.Id)
+	}
+	if this.Created != that1.Created {
+		return fmt.Errorf("Created this(%v) Not Equal that(%v)", this.Created, that1.Created)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *SendMessageResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SendMessageResponse)
+	if !ok {
+		that2, ok := that.(SendMessageResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Created != that1.Created {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *MessagesRequest) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*MessagesRequest)
+	if !ok {
+		that2, ok := that.(MessagesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *MessagesRequest")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *MessagesRequest but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *MessagesRequest but is not nil && this == nil")
+	}
+	if this.Since != that1.Since {
+		return fmt.Errorf("Since this(%v) Not Equal that(%v)", this.Since, that1.Since)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *MessagesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MessagesRequest)
+	if !ok {
+		that2, ok := that.(MessagesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Since != that1.Since {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *MessagesResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*MessagesResponse)
+	if !ok {
+		that2, ok := that.(MessagesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *MessagesResponse")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *MessagesResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *MessagesResponse but is not nil && this == nil")
+	}
+	if len(this.Messages) != len(that1.Messages) {
+		return fmt.Errorf("Messages this(%v) Not Equal that(%v)", len(this.Messages), len(that1.Messages))
+	}
+	for i := range this.Messages {
+		if !this.Messages[i].Equal(that1.Messages[i]) {
+			return fmt.Errorf("Messages this[%v](%v) Not Equal that[%v](%v)", i, this.Messages[i], i, that1.Messages[i])
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *MessagesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MessagesResponse)
+	if !ok {
+		that2, ok := that.(MessagesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Messages) != len(that1.Messages) {
+		return false
+	}
+	for i := range this.Messages {
+		if !this.Messages[i].Equal(that1.Messages[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *PingResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&protov1.PingResponse{")
+	s = append(s, "Ok: "+fmt.Sprintf("%#v", this.Ok)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ActivationCodeRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&protov1.ActivationCodeRequest{")
+	s = append(s, "Did: "+fmt.Sprintf("%#v", this.Did)+",\n")
+	s = append(s, "Role: "+fmt.Sprintf("%#v", this.Role)+",\n")
+	s = append(s, "Scope: "+fmt.Sprintf("%#v", this.Scope)+",\n")
+	s = append(s, "Device: "+fmt.Sprintf("%#v", this.Device)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ActivationCodeResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&protov1.ActivationCodeResponse{")
+	s = append(s, "ActivationCode: "+fmt.Sprintf("%#v", this.ActivationCode)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BulkActivationCodesRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&protov1.BulkActivationCodesRequest{")
+	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
+	s = append(s, "Campaign: "+fmt.Sprintf("%#v", this.Campaign)+",\n")
+	s = append(s, "Scope: "+fmt.Sprintf("%#v", this.Scope)+",\n")
+	s = append(s, "QrImages: "+fmt.Sprintf("%#v", this.QrImages)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BulkActivationCodesResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&protov1.BulkActivationCodesResponse{")
+	if this.Codes != nil {
+		s = append(s, "Codes: "+fmt.Sprintf("%#v", this.Codes)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CampaignCode) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&protov1.CampaignCode{")
+	s = append(s, "ActivationCode: "+fmt.Sprintf("%#v", this.ActivationCode)+",\n")
+	s = append(s, "Campaign: "+fmt.Sprintf("%#v", this.Campaign)+",\n")
+	s = append(s, "QrCode: "+fmt.Sprintf("%#v", this.QrCode)+",\n")
+	s = append(s, "QrImage: "+fmt.Sprintf("%#v", this.QrImage)+",\n")
+	s = append(s, "Expires: "+fmt.Sprintf("%#v", this.Expires)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CredentialsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&protov1.CredentialsRequest{")
+	s = append(s, "Did: "+fmt.Sprintf("%#v", this.Did)+",\n")
+	s = append(s, "Role: "+fmt.Sprintf("%#v", this.Role)+",\n")
+	s = append(s, "ActivationCode: "+fmt.Sprintf("%#v", this.ActivationCode)+",\n")
+	s = append(s, "Proof: "+fmt.Sprintf("%#v", this.Proof)+",\n")
+	s = append(s, "Lang: "+fmt.Sprintf("%#v", this.Lang)+",\n")
+	s = append(s, "Scope: "+fmt.Sprintf("%#v", this.Scope)+",\n")
+	if this.Attestation != nil {
+		s = append(s, "Attestation: "+fmt.Sprintf("%#v", this.Attestation)+",\n")
+	}
+	s = append(s, "Device: "+fmt.Sprintf("%#v", this.Device)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DeviceAttestation) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&protov1.DeviceAttestation{")
+	s = append(s, "Platform: "+fmt.Sprintf("%#v", this.Platform)+",\n")
+	s = append(s, "Token: "+fmt.Sprintf("%#v", this.Token)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RenewCredentialsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&protov1.RenewCredentialsRequest{")
+	s = append(s, "RefreshCode: "+fmt.Sprintf("%#v", this.RefreshCode)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CredentialsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&protov1.CredentialsResponse{")
+	s = append(s, "AccessToken: "+fmt.Sprintf("%#v", this.AccessToken)+",\n")
+	s = append(s, "RefreshCode: "+fmt.Sprintf("%#v", this.RefreshCode)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RecordRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&protov1.RecordRequest{")
+	if this.Records != nil {
+		s = append(s, "Records: "+fmt.Sprintf("%#v", this.Records)+",\n")
+	}
+	s = append(s, "AppVersion: "+fmt.Sprintf("%#v", this.AppVersion)+",\n")
+	s = append(s, "SdkVersion: "+fmt.Sprintf("%#v", this.SdkVersion)+",\n")
+	s = append(s, "Platform: "+fmt.Sprintf("%#v", this.Platform)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RecordResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&protov1.RecordResponse{")
+	s = append(s, "Ok: "+fmt.Sprintf("%#v", this.Ok)+",\n")
+	if this.Records != nil {
+		s = append(s, "Records: "+fmt.Sprintf("%#v", this.Records)+",\n")
+	}
+	s = append(s, "Receipt: "+fmt.Sprintf("%#v", this.Receipt)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RecordStatus) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&protov1.RecordStatus{")
+	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CheckExposureRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&protov1.CheckExposureRequest{")
+	s = append(s, "Prefixes: "+fmt.Sprintf("%#v", this.Prefixes)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CheckExposureResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&protov1.CheckExposureResponse{")
+	s = append(s, "Tokens: "+fmt.Sprintf("%#v", this.Tokens)+",\n")
+	s = append(s, "Updated: "+fmt.Sprintf("%#v", this.Updated)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PSIRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&protov1.PSIRequest{")
+	s = append(s, "Blinded: "+fmt.Sprintf("%#v", this.Blinded)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PSIResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&protov1.PSIResponse{")
+	s = append(s, "Evaluated: "+fmt.Sprintf("%#v", this.Evaluated)+",\n")
+	s = append(s, "Set: "+fmt.Sprintf("%#v", this.Set)+",\n")
+	s = append(s, "Updated: "+fmt.Sprintf("%#v", this.Updated)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SamplingPolicy) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&protov1.SamplingPolicy{")
+	s = append(s, "Mode: "+fmt.Sprintf("%#v", this.Mode)+",\n")
+	s = append(s, "RecordInterval: "+fmt.Sprintf("%#v", this.RecordInterval)+",\n")
+	s = append(s, "UploadInterval: "+fmt.Sprintf("%#v", this.UploadInterval)+",\n")
+	s = append(s, "MinDistance: "+fmt.Sprintf("%#v", this.MinDistance)+",\n")
+	s = append(s, "RefreshInterval: "+fmt.Sprintf("%#v", this.RefreshInterval)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SubmissionStatusRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&protov1.SubmissionStatusRequest{")
+	s = append(s, "Receipt: "+fmt.Sprintf("%#v", this.Receipt)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SubmissionStatusResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&protov1.SubmissionStatusResponse{")
+	s = append(s, "Receipt: "+fmt.Sprintf("%#v", this.Receipt)+",\n")
+	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	if this.Records != nil {
+		s = append(s, "Records: "+fmt.Sprintf("%#v", this.Records)+",\n")
+	}
+	s = append(s, "Created: "+fmt.Sprintf("%#v", this.Created)+",\n")
+	s = append(s, "Processed: "+fmt.Sprintf("%#v", this.Processed)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *NewIdentifierRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&protov1.NewIdentifierRequest{")
+	s = append(s, "Method: "+fmt.Sprintf("%#v", this.Method)+",\n")
+	s = append(s, "AutoPublish: "+fmt.Sprintf("%#v", this.AutoPublish)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *NewIdentifierResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&protov1.NewIdentifierResponse{")
+	s = append(s, "Document: "+fmt.Sprintf("%#v", this.Document)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RegisterVenueRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&protov1.RegisterVenueRequest{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Lat: "+fmt.Sprintf("%#v", this.Lat)+",\n")
+	s = append(s, "Lng: "+fmt.Sprintf("%#v", this.Lng)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CheckInRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&protov1.CheckInRequest{")
+	if this.Records != nil {
+		s = append(s, "Records: "+fmt.Sprintf("%#v", this.Records)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CheckInResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&protov1.CheckInResponse{")
+	s = append(s, "Ok: "+fmt.Sprintf("%#v", this.Ok)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *VenueOutbreakRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&protov1.VenueOutbreakRequest{")
+	s = append(s, "Venue: "+fmt.Sprintf("%#v", this.Venue)+",\n")
+	s = append(s, "From: "+fmt.Sprintf("%#v", this.From)+",\n")
+	s = append(s, "To: "+fmt.Sprintf("%#v", this.To)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *VenueOutbreakResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&protov1.VenueOutbreakResponse{")
+	s = append(s, "Ok: "+fmt.Sprintf("%#v", this.Ok)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AnalyticsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&protov1.AnalyticsRequest{")
+	s = append(s, "From: "+fmt.Sprintf("%#v", this.From)+",\n")
+	s = append(s, "To: "+fmt.Sprintf("%#v", this.To)+",\n")
+	if this.Fields != nil {
+		s = append(s, "Fields: "+fmt.Sprintf("%#v", this.Fields)+",\n")
+	}
+	s = append(s, "Purpose: "+fmt.Sprintf("%#v", this.Purpose)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AnalyticsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&protov1.AnalyticsResponse{")
+	if this.Hotspots != nil {
+		s = append(s, "Hotspots: "+fmt.Sprintf("%#v", this.Hotspots)+",\n")
+	}
+	if this.Flows != nil {
+		s = append(s, "Flows: "+fmt.Sprintf("%#v", this.Flows)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *LabResultRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&protov1.LabResultRequest{")
+	s = append(s, "Resource: "+fmt.Sprintf("%#v", this.Resource)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *LabResultResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&protov1.LabResultResponse{")
+	s = append(s, "Ok: "+fmt.Sprintf("%#v", this.Ok)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CertificateRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&protov1.CertificateRequest{")
+	s = append(s, "Diagnosis: "+fmt.Sprintf("%#v", this.Diagnosis)+",\n")
+	s = append(s, "Format: "+fmt.Sprintf("%#v", this.Format)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CertificateResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&protov1.CertificateResponse{")
+	s = append(s, "Format: "+fmt.Sprintf("%#v", this.Format)+",\n")
+	s = append(s, "Credential: "+fmt.Sprintf("%#v", this.Credential)+",\n")
+	s = append(s, "Qr: "+fmt.Sprintf("%#v", this.Qr)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *IntrospectRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&protov1.IntrospectRequest{")
+	s = append(s, "Token: "+fmt.Sprintf("%#v", this.Token)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *IntrospectResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 16)
+	s = append(s, "&protov1.IntrospectResponse{")
+	s = append(s, "Active: "+fmt.Sprintf("%#v", this.Active)+",\n")
+	s = append(s, "Sub: "+fmt.Sprintf("%#v", this.Sub)+",\n")
+	s = append(s, "Did: "+fmt.Sprintf("%#v", this.Did)+",\n")
+	s = append(s, "Role: "+fmt.Sprintf("%#v", this.Role)+",\n")
+	s = append(s, "Lang: "+fmt.Sprintf("%#v", this.Lang)+",\n")
+	s = append(s, "Iss: "+fmt.Sprintf("%#v", this.Iss)+",\n")
+	s = append(s, "Aud: "+fmt.Sprintf("%#v", this.Aud)+",\n")
+	s = append(s, "Exp: "+fmt.Sprintf("%#v", this.Exp)+",\n")
+	s = append(s, "Iat: "+fmt.Sprintf("%#v", this.Iat)+",\n")
+	s = append(s, "Nbf: "+fmt.Sprintf("%#v", this.Nbf)+",\n")
+	s = append(s, "Jti: "+fmt.Sprintf("%#v", this.Jti)+",\n")
+	s = append(s, "Scope: "+fmt.Sprintf("%#v", this.Scope)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AckRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&protov1.AckRequest{")
+	s = append(s, "Notifications: "+fmt.Sprintf("%#v", this.Notifications)+",\n")
+	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AckResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&protov1.AckResponse{")
+	s = append(s, "Updated: "+fmt.Sprintf("%#v", this.Updated)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *NotificationStatusRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&protov1.NotificationStatusRequest{")
+	s = append(s, "Source: "+fmt.Sprintf("%#v", this.Source)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *NotificationStatusResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&protov1.NotificationStatusResponse{")
+	s = append(s, "Total: "+fmt.Sprintf("%#v", this.Total)+",\n")
+	s = append(s, "Pending: "+fmt.Sprintf("%#v", this.Pending)+",\n")
+	s = append(s, "Dispatched: "+fmt.Sprintf("%#v", this.Dispatched)+",\n")
+	s = append(s, "Failed: "+fmt.Sprintf("%#v", this.Failed)+",\n")
+	s = append(s, "Delivered: "+fmt.Sprintf("%#v", this.Delivered)+",\n")
+	s = append(s, "Read: "+fmt.Sprintf("%#v", this.Read)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GeoPoint) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&protov1.GeoPoint{")
+	s = append(s, "Lat: "+fmt.Sprintf("%#v", this.Lat)+",\n")
+	s = append(s, "Lng: "+fmt.Sprintf("%#v", this.Lng)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ExposureQueryRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&protov1.ExposureQueryRequest{")
+	if this.Area != nil {
+		s = append(s, "Area: "+fmt.Sprintf("%#v", this.Area)+",\n")
+	}
+	s = append(s, "From: "+fmt.Sprintf("%#v", this.From)+",\n")
+	s = append(s, "To: "+fmt.Sprintf("%#v", this.To)+",\n")
+	s = append(s, "Identifiers: "+fmt.Sprintf("%#v", this.Identifiers)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "Purpose: "+fmt.Sprintf("%#v", this.Purpose)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ExposureQueryResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&protov1.ExposureQueryResponse{")
+	if this.Presence != nil {
+		s = append(s, "Presence: "+fmt.Sprintf("%#v", this.Presence)+",\n")
+	}
+	s = append(s, "Users: "+fmt.Sprintf("%#v", this.Users)+",\n")
+	s = append(s, "Identifiers: "+fmt.Sprintf("%#v", this.Identifiers)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Presence) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&protov1.Presence{")
+	s = append(s, "Cell: "+fmt.Sprintf("%#v", this.Cell)+",\n")
+	s = append(s, "Users: "+fmt.Sprintf("%#v", this.Users)+",\n")
+	s = append(s, "From: "+fmt.Sprintf("%#v", this.From)+",\n")
+	s = append(s, "To: "+fmt.Sprintf("%#v", this.To)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Session) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&protov1.Session{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "Role: "+fmt.Sprintf("%#v", this.Role)+",\n")
+	s = append(s, "Created: "+fmt.Sprintf("%#v", this.Created)+",\n")
+	s = append(s, "Issued: "+fmt.Sprintf("%#v", this.Issued)+",\n")
+	s = append(s, "Expires: "+fmt.Sprintf("%#v", this.Expires)+",\n")
+	s = append(s, "Current: "+fmt.Sprintf("%#v", this.Current)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ListSessionsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&protov1.ListSessionsResponse{")
+	if this.Sessions != nil {
+		s = append(s, "Sessions: "+fmt.Sprintf("%#v", this.Sessions)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RevokeSessionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&protov1.RevokeSessionRequest{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SendMessageResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&protov1.SendMessageResponse{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "Created: "+fmt.Sprintf("%#v", this.Created)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MessagesRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&protov1.MessagesRequest{")
+	s = append(s, "Since: "+fmt.Sprintf("%#v", this.Since)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MessagesResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&protov1.MessagesResponse{")
+	if this.Messages != nil {
+		s = append(s, "Messages: "+fmt.Sprintf("%#v", this.Messages)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringTrackingServerApi(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// TrackingServerAPIClient is the client API for TrackingServerAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type TrackingServerAPIClient interface {
+	// Reachability test.
+	Ping(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*PingResponse, error)
+	// Generate a new activation code.
+	ActivationCode(ctx context.Context, in *ActivationCodeRequest, opts ...grpc.CallOption) (*ActivationCodeResponse, error)
+	// Generate a batch of "user" activation codes for registration drives
+	// in areas without connectivity. Codes are not bound to a DID and can
+	// be redeemed once by any device.
+	BulkActivationCodes(ctx context.Context, in *BulkActivationCodesRequest, opts ...grpc.CallOption) (*BulkActivationCodesResponse, error)
+	// Get access credentials for the platform.
+	Credentials(ctx context.Context, in *CredentialsRequest, opts ...grpc.CallOption) (*CredentialsResponse, error)
+	// Renew a previously-issued access credential.
+	RenewCredentials(ctx context.Context, in *RenewCredentialsRequest, opts ...grpc.CallOption) (*CredentialsResponse, error)
+	// Process location record events. A maximum value of 100 record
+	// per-request is enforced.
+	Record(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*RecordResponse, error)
+	// Retrieve the processing status of location records submitted on broker
+	// ingestion mode, using the receipt returned by the "Record" method.
+	GetSubmissionStatus(ctx context.Context, in *SubmissionStatusRequest, opts ...grpc.CallOption) (*SubmissionStatusResponse, error)
+	// Privacy-preserving exposure self-check. The client submits prefixes of
+	// the tokens for the location cells and time buckets it visited, and
+	// receives the complete tokens matching them for the cells visited by
+	// diagnosed cases, to compare them locally.
+	CheckExposure(ctx context.Context, in *CheckExposureRequest, opts ...grpc.CallOption) (*CheckExposureResponse, error)
+	// Exposure self-check using private set intersection, for deployments
+	// configured with the "psi" mode. The client submits the blinded tokens
+	// for the location cells and time buckets it visited, and receives them
+	// evaluated along with the encoded set of tokens for the cells visited by
+	// diagnosed cases, to compare them locally.
+	CheckExposurePSI(ctx context.Context, in *PSIRequest, opts ...grpc.CallOption) (*PSIResponse, error)
+	// Retrieve how often the client should record and upload locations. The
+	// policy depends on the user's exposure risk, so it must be retrieved
+	// periodically.
+	GetSamplingPolicy(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*SamplingPolicy, error)
+	// Helper method to generate a new DID instances for clients that can't
+	// generate it locally. This is not recommended but supported for legacy
+	// and development purposes.
+	NewIdentifier(ctx context.Context, in *NewIdentifierRequest, opts ...grpc.CallOption) (*NewIdentifierResponse, error)
+	// Register a new venue where users can check-in.
+	RegisterVenue(ctx context.Context, in *RegisterVenueRequest, opts ...grpc.CallOption) (*Venue, error)
+	// Register a user's visit to a venue.
+	CheckIn(ctx context.Context, in *CheckInRequest, opts ...grpc.CallOption) (*CheckInResponse, error)
+	// Link a venue to a confirmed case. All users that checked-in at the
+	// venue during the exposure window will be notified.
+	VenueOutbreak(ctx context.Context, in *VenueOutbreakRequest, opts ...grpc.CallOption) (*VenueOutbreakResponse, error)
+	// Retrieve anonymized hotspots and movement flows. Only aggregates
+	// covering a minimum number of distinct users are available.
+	Analytics(ctx context.Context, in *AnalyticsRequest, opts ...grpc.CallOption) (*AnalyticsResponse, error)
+	// Submit test results generated by laboratory systems as HL7 FHIR
+	// resources.
+	LabResult(ctx context.Context, in *LabResultRequest, opts ...grpc.CallOption) (*LabResultResponse, error)
+	// Issue a verifiable health credential for a test result, using
+	// formats supported by third-party scanner applications.
+	Certificate(ctx context.Context, in *CertificateRequest, opts ...grpc.CallOption) (*CertificateResponse, error)
+	// Validate an access token and retrieve its claims, in the style of
+	// RFC 7662. Meant for internal services fronting the platform.
+	Introspect(ctx context.Context, in *IntrospectRequest, opts ...grpc.CallOption) (*IntrospectResponse, error)
+	// Acknowledge the delivery, or reading, of notifications received by
+	// the user.
+	Ack(ctx context.Context, in *AckRequest, opts ...grpc.CallOption) (*AckResponse, error)
+	// Retrieve the delivery status of the notifications originated by a
+	// diagnosis or venue outbreak.
+	NotificationStatus(ctx context.Context, in *NotificationStatusRequest, opts ...grpc.CallOption) (*NotificationStatusResponse, error)
+	// List anonymized presence counts inside an area during a period of
+	// time, to support outbreak investigations at specific venues or events.
+	// Elevated permissions are required to retrieve the identifiers of the
+	// users present, and all such requests are registered on the audit log.
+	ExposureQuery(ctx context.Context, in *ExposureQueryRequest, opts ...grpc.CallOption) (*ExposureQueryResponse, error)
+	// List the active sessions of the user, one for each device holding
+	// valid credentials.
+	ListSessions(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	// Revoke the credentials of a session, i.e. those held by a lost device.
+	// Revoked credentials can't be used or renewed.
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// Send a message encrypted end-to-end to a user, i.e. sensitive follow-up
+	// instructions after an exposure. Only the ciphertext is received and
+	// stored by the server.
+	SendMessage(ctx context.Context, in *EncryptedMessage, opts ...grpc.CallOption) (*SendMessageResponse, error)
+	// Retrieve the encrypted messages received by the user.
+	Messages(ctx context.Context, in *MessagesRequest, opts ...grpc.CallOption) (*MessagesResponse, error)
+}
+
+type trackingServerAPIClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewTrackingServerAPIClient(cc *grpc.ClientConn) TrackingServerAPIClient {
+	return &trackingServerAPIClient{cc}
+}
+
+func (c *trackingServerAPIClient) Ping(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*PingResponse, error) {
+	out := new(PingResponse)
+	err := c.cc.Invoke(ctx, "/bryk.covid.proto.v1.TrackingServerAPI/Ping", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackingServerAPIClient) ActivationCode(ctx context.Context, in *ActivationCodeRequest, opts ...grpc.CallOption) (*ActivationCodeResponse, error) {
+	out := new(ActivationCodeResponse)
+	err := c.cc.Invoke(ctx, "/bryk.covid.proto.v1.TrackingServerAPI/ActivationCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackingServerAPIClient) BulkActivationCodes(ctx context.Context, in *BulkActivationCodesRequest, opts ...grpc.CallOption) (*BulkActivationCodesResponse, error) {
+	out := new(BulkActivationCodesResponse)
+	err := c.cc.Invoke(ctx, "/bryk.covid.proto.v1.TrackingServerAPI/BulkActivationCodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackingServerAPIClient) Credentials(ctx context.Context, in *CredentialsRequest, opts ...grpc.CallOption) (*CredentialsResponse, error) {
+	out := new(CredentialsResponse)
+	err := c.cc.Invoke(ctx, "/bryk.covid.proto.v1.TrackingServerAPI/Credentials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackingServerAPIClient) RenewCredentials(ctx context.Context, in *RenewCredentialsRequest, opts ...grpc.CallOption) (*CredentialsResponse, error) {
+	out := new(CredentialsResponse)
+	err := c.cc.Invoke(ctx, "/bryk.covid.proto.v1.TrackingServerAPI/RenewCredentials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackingServerAPIClient) Record(ctx context.Context, in *RecordRequest, opts ...grpc.CallOption) (*RecordResponse, error) {
+	out := new(RecordResponse)
+	err := c.cc.Invoke(ctx, "/bryk.covid.proto.v1.TrackingServerAPI/Record", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackingServerAPIClient) GetSubmissionStatus(ctx context.Context, in *SubmissionStatusRequest, opts ...grpc.CallOption) (*SubmissionStatusResponse, error) {
+	out := new(SubmissionStatusResponse)
+	err := c.cc.Invoke(ctx, "/bryk.covid.proto.v1.TrackingServerAPI/GetSubmissionStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackingServerAPIClient) CheckExposure(ctx context.Context, in *CheckExposureRequest, opts ...grpc.CallOption) (*CheckExposureResponse, error) {
+	out := new(CheckExposureResponse)
+	err := c.cc.Invoke(ctx, "/bryk.covid.proto.v1.TrackingServerAPI/CheckExposure", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackingServerAPIClient) CheckExposurePSI(ctx context.Context, in *PSIRequest, opts ...grpc.CallOption) (*PSIResponse, error) {
+	out := new(PSIResponse)
+	err := c.cc.Invoke(ctx, "/bryk.covid.proto.v1.TrackingServerAPI/CheckExposurePSI", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackingServerAPIClient) GetSamplingPolicy(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*SamplingPolicy, error) {
+	out := new(SamplingPolicy)
+	err := c.cc.Invoke(ctx, "/bryk.covid.proto.v1.TrackingServerAPI/GetSamplingPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackingServerAPIClient) NewIdentifier(ctx context.Context, in *NewIdentifierRequest, opts ...grpc.CallOption) (*NewIdentifierResponse, error) {
+	out := new(NewIdentifierResponse)
+	err := c.cc.Invoke(ctx, "/bryk.covid.proto.v1.TrackingServerAPI/NewIdentifier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackingServerAPIClient) RegisterVenue(ctx context.Context, in *RegisterVenueRequest, opts ...grpc.CallOption) (*Venue, error) {
+	out := new(Venue)
+	err := c.cc.Invoke(ctx, "/bryk.covid.proto.v1.TrackingServerAPI/RegisterVenue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackingServerAPIClient) CheckIn(ctx context.Context, in *CheckInRequest, opts ...grpc.CallOption) (*CheckInResponse, error) {
+	out := new(CheckInResponse)
+	err := c.cc.Invoke(ctx, "/bryk.covid.proto.v1.TrackingServerAPI/CheckIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackingServerAPIClient) VenueOutbreak(ctx context.Context, in *VenueOutbreakRequest, opts ...grpc.CallOption) (*VenueOutbreakResponse, error) {
+	out := new(VenueOutbreakResponse)
+	err := c.cc.Invoke(ctx, "/bryk.covid.proto.v1.TrackingServerAPI/VenueOutbreak", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackingServerAPIClient) Analytics(ctx context.Context, in *AnalyticsRequest, opts ...grpc.CallOption) (*AnalyticsResponse, error) {
+	out := new(AnalyticsResponse)
+	err := c.cc.Invoke(ctx, "/bryk.covid.proto.v1.TrackingServerAPI/Analytics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackingServerAPIClient) LabResult(ctx context.Context, in *LabResultRequest, opts ...grpc.CallOption) (*LabResultResponse, error) {
+	out := new(LabResultResponse)
+	err := c.cc.Invoke(ctx, "/bryk.covid.proto.v1.TrackingServerAPI/LabResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackingServerAPIClient) Certificate(ctx context.Context, in *CertificateRequest, opts ...grpc.CallOption) (*CertificateResponse, error) {
+	out := new(CertificateResponse)
+	err := c.cc.Invoke(ctx, "/bryk.covid.proto.v1.TrackingServerAPI/Certificate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackingServerAPIClient) Introspect(ctx context.Context, in *IntrospectRequest, opts ...grpc.CallOption) (*IntrospectResponse, error) {
+	out := new(IntrospectResponse)
+	err := c.cc.Invoke(ctx, "/bryk.covid.proto.v1.TrackingServerAPI/Introspect", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackingServerAPIClient) Ack(ctx context.Context, in *AckRequest, opts ...grpc.CallOption) (*AckResponse, error) {
+	out := new(AckResponse)
+	err := c.cc.Invoke(ctx, "/bryk.covid.proto.v1.TrackingServerAPI/Ack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackingServerAPIClient) NotificationStatus(ctx context.Context, in *NotificationStatusRequest, opts ...grpc.CallOption) (*NotificationStatusResponse, error) {
+	out := new(NotificationStatusResponse)
+	err := c.cc.Invoke(ctx, "/bryk.covid.proto.v1.TrackingServerAPI/NotificationStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackingServerAPIClient) ExposureQuery(ctx context.Context, in *ExposureQueryRequest, opts ...grpc.CallOption) (*ExposureQueryResponse, error) {
+	out := new(ExposureQueryResponse)
+	err := c.cc.Invoke(ctx, "/bryk.covid.proto.v1.TrackingServerAPI/ExposureQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackingServerAPIClient) ListSessions(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, "/bryk.covid.proto.v1.TrackingServerAPI/ListSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackingServerAPIClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/bryk.covid.proto.v1.TrackingServerAPI/RevokeSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackingServerAPIClient) SendMessage(ctx context.Context, in *EncryptedMessage, opts ...grpc.CallOption) (*SendMessageResponse, error) {
+	out := new(SendMessageResponse)
+	err := c.cc.Invoke(ctx, "/bryk.covid.proto.v1.TrackingServerAPI/SendMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackingServerAPIClient) Messages(ctx context.Context, in *MessagesRequest, opts ...grpc.CallOption) (*MessagesResponse, error) {
+	out := new(MessagesResponse)
+	err := c.cc.Invoke(ctx, "/bryk.covid.proto.v1.TrackingServerAPI/Messages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TrackingServerAPIServer is the server API for TrackingServerAPI service.
+type TrackingServerAPIServer interface {
+	// Reachability test.
+	Ping(context.Context, *types.Empty) (*PingResponse, error)
+	// Generate a new activation code.
+	ActivationCode(context.Context, *ActivationCodeRequest) (*ActivationCodeResponse, error)
+	// Generate a batch of "user" activation codes for registration drives
+	// in areas without connectivity. Codes are not bound to a DID and can
+	// be redeemed once by any device.
+	BulkActivationCodes(context.Context, *BulkActivationCodesRequest) (*BulkActivationCodesResponse, error)
+	// Get access credentials for the platform.
+	Credentials(context.Context, *CredentialsRequest) (*CredentialsResponse, error)
+	// Renew a previously-issued access credential.
+	RenewCredentials(context.Context, *RenewCredentialsRequest) (*CredentialsResponse, error)
+	// Process location record events. A maximum value of 100 record
+	// per-request is enforced.
+	Record(context.Context, *RecordRequest) (*RecordResponse, error)
+	// Retrieve the processing status of location records submitted on broker
+	// ingestion mode, using the receipt returned by the "Record" method.
+	GetSubmissionStatus(context.Context, *SubmissionStatusRequest) (*SubmissionStatusResponse, error)
+	// Privacy-preserving exposure self-check. The client submits prefixes of
+	// the tokens for the location cells and time buckets it visited, and
+	// receives the complete tokens matching them for the cells visited by
+	// diagnosed cases, to compare them locally.
+	CheckExposure(context.Context, *CheckExposureRequest) (*CheckExposureResponse, error)
+	// Exposure self-check using private set intersection, for deployments
+	// configured with the "psi" mode. The client submits the blinded tokens
+	// for the location cells and time buckets it visited, and receives them
+	// evaluated along with the encoded set of tokens for the cells visited by
+	// diagnosed cases, to compare them locally.
+	CheckExposurePSI(context.Context, *PSIRequest) (*PSIResponse, error)
+	// Retrieve how often the client should record and upload locations. The
+	// policy depends on the user's exposure risk, so it must be retrieved
+	// periodically.
+	GetSamplingPolicy(context.Context, *types.Empty) (*SamplingPolicy, error)
+	// Helper method to generate a new DID instances for clients that can't
+	// generate it locally. This is not recommended but supported for legacy
+	// and development purposes.
+	NewIdentifier(context.Context, *NewIdentifierRequest) (*NewIdentifierResponse, error)
+	// Register a new venue where users can check-in.
+	RegisterVenue(context.Context, *RegisterVenueRequest) (*Venue, error)
+	// Register a user's visit to a venue.
+	CheckIn(context.Context, *CheckInRequest) (*CheckInResponse, error)
+	// Link a venue to a confirmed case. All users that checked-in at the
+	// venue during the exposure window will be notified.
+	VenueOutbreak(context.Context, *VenueOutbreakRequest) (*VenueOutbreakResponse, error)
+	// Retrieve anonymized hotspots and movement flows. Only aggregates
+	// covering a minimum number of distinct users are available.
+	Analytics(context.Context, *AnalyticsRequest) (*AnalyticsResponse, error)
+	// Submit test results generated by laboratory systems as HL7 FHIR
+	// resources.
+	LabResult(context.Context, *LabResultRequest) (*LabResultResponse, error)
+	// Issue a verifiable health credential for a test result, using
+	// formats supported by third-party scanner applications.
+	Certificate(context.Context, *CertificateRequest) (*CertificateResponse, error)
+	// Validate an access token and retrieve its claims, in the style of
+	// RFC 7662. Meant for internal services fronting the platform.
+	Introspect(context.Context, *IntrospectRequest) (*IntrospectResponse, error)
+	// Acknowledge the delivery, or reading, of notifications received by
+	// the user.
+	Ack(context.Context, *AckRequest) (*AckResponse, error)
+	// Retrieve the delivery status of the notifications originated by a
+	// diagnosis or venue outbreak.
+	NotificationStatus(context.Context, *NotificationStatusRequest) (*NotificationStatusResponse, error)
+	// List anonymized presence counts inside an area during a period of
+	// time, to support outbreak investigations at specific venues or events.
+	// Elevated permissions are required to retrieve the identifiers of the
+	// users present, and all such requests are registered on the audit log.
+	ExposureQuery(context.Context, *ExposureQueryRequest) (*ExposureQueryResponse, error)
+	// List the active sessions of the user, one for each device holding
+	// valid credentials.
+	ListSessions(context.Context, *types.Empty) (*ListSessionsResponse, error)
+	// Revoke the credentials of a session, i.e. those held by a lost device.
+	// Revoked credentials can't be used or renewed.
+	RevokeSession(context.Context, *RevokeSessionRequest) (*types.Empty, error)
+	// Send a message encrypted end-to-end to a user, i.e. sensitive follow-up
+	// instructions after an exposure. Only the ciphertext is received and
+	// stored by the server.
+	SendMessage(context.Context, *EncryptedMessage) (*SendMessageResponse, error)
+	// Retrieve the encrypted messages received by the user.
+	Messages(context.Context, *MessagesRequest) (*MessagesResponse, error)
+}
+
+// UnimplementedTrackingServerAPIServer can be embedded to have forward compatible implementations.
+type UnimplementedTrackingServerAPIServer struct {
+}
+
+func (*UnimplementedTrackingServerAPIServer) Ping(ctx context.Context, req *types.Empty) (*PingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+}
+func (*UnimplementedTrackingServerAPIServer) ActivationCode(ctx context.Context, req *ActivationCodeRequest) (*ActivationCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivationCode not implemented")
+}
+func (*UnimplementedTrackingServerAPIServer) BulkActivationCodes(ctx context.Context, req *BulkActivationCodesRequest) (*BulkActivationCodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkActivationCodes not implemented")
+}
+func (*UnimplementedTrackingServerAPIServer) Credentials(ctx context.Context, req *CredentialsRequest) (*CredentialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Credentials not implemented")
+}
+func (*UnimplementedTrackingServerAPIServer) RenewCredentials(ctx context.Context, req *RenewCredentialsRequest) (*CredentialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewCredentials not implemented")
+}
+func (*UnimplementedTrackingServerAPIServer) Record(ctx context.Context, req *RecordRequest) (*RecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Record not implemented")
+}
+func (*UnimplementedTrackingServerAPIServer) GetSubmissionStatus(ctx context.Context, req *SubmissionStatusRequest) (*SubmissionStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubmissionStatus not implemented")
+}
+func (*UnimplementedTrackingServerAPIServer) CheckExposure(ctx context.Context, req *CheckExposureRequest) (*CheckExposureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckExposure not implemented")
+}
+func (*UnimplementedTrackingServerAPIServer) CheckExposurePSI(ctx context.Context, req *PSIRequest) (*PSIResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckExposurePSI not implemented")
+}
+func (*UnimplementedTrackingServerAPIServer) GetSamplingPolicy(ctx context.Context, req *types.Empty) (*SamplingPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSamplingPolicy not implemented")
+}
+func (*UnimplementedTrackingServerAPIServer) NewIdentifier(ctx context.Context, req *NewIdentifierRequest) (*NewIdentifierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewIdentifier not implemented")
+}
+func (*UnimplementedTrackingServerAPIServer) RegisterVenue(ctx context.Context, req *RegisterVenueRequest) (*Venue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterVenue not implemented")
+}
+func (*UnimplementedTrackingServerAPIServer) CheckIn(ctx context.Context, req *CheckInRequest) (*CheckInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckIn not implemented")
+}
+func (*UnimplementedTrackingServerAPIServer) VenueOutbreak(ctx context.Context, req *VenueOutbreakRequest) (*VenueOutbreakResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VenueOutbreak not implemented")
+}
+func (*UnimplementedTrackingServerAPIServer) Analytics(ctx context.Context, req *AnalyticsRequest) (*AnalyticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Analytics not implemented")
+}
+func (*UnimplementedTrackingServerAPIServer) LabResult(ctx context.Context, req *LabResultRequest) (*LabResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LabResult not implemented")
+}
+func (*UnimplementedTrackingServerAPIServer) Certificate(ctx context.Context, req *CertificateRequest) (*CertificateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Certificate not implemented")
+}
+func (*UnimplementedTrackingServerAPIServer) Introspect(ctx context.Context, req *IntrospectRequest) (*IntrospectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Introspect not implemented")
+}
+func (*UnimplementedTrackingServerAPIServer) Ack(ctx context.Context, req *AckRequest) (*AckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ack not implemented")
+}
+func (*UnimplementedTrackingServerAPIServer) NotificationStatus(ctx context.Context, req *NotificationStatusRequest) (*NotificationStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NotificationStatus not implemented")
+}
+func (*UnimplementedTrackingServerAPIServer) ExposureQuery(ctx context.Context, req *ExposureQueryRequest) (*ExposureQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExposureQuery not implemented")
+}
+func (*UnimplementedTrackingServerAPIServer) ListSessions(ctx context.Context, req *types.Empty) (*ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (*UnimplementedTrackingServerAPIServer) RevokeSession(ctx context.Context, req *RevokeSessionRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (*UnimplementedTrackingServerAPIServer) SendMessage(ctx context.Context, req *EncryptedMessage) (*SendMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendMessage not implemented")
+}
+func (*UnimplementedTrackingServerAPIServer) Messages(ctx context.Context, req *MessagesRequest) (*MessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Messages not implemented")
+}
+
+func RegisterTrackingServerAPIServer(s *grpc.Server, srv TrackingServerAPIServer) {
+	s.RegisterService(&_TrackingServerAPI_serviceDesc, srv)
+}
+
+func _TrackingServerAPI_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackingServerAPIServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bryk.covid.proto.v1.TrackingServerAPI/Ping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackingServerAPIServer).Ping(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackingServerAPI_ActivationCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivationCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackingServerAPIServer).ActivationCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bryk.covid.proto.v1.TrackingServerAPI/ActivationCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackingServerAPIServer).ActivationCode(ctx, req.(*ActivationCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackingServerAPI_BulkActivationCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkActivationCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackingServerAPIServer).BulkActivationCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bryk.covid.proto.v1.TrackingServerAPI/BulkActivationCodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackingServerAPIServer).BulkActivationCodes(ctx, req.(*BulkActivationCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackingServerAPI_Credentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackingServerAPIServer).Credentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bryk.covid.proto.v1.TrackingServerAPI/Credentials",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackingServerAPIServer).Credentials(ctx, req.(*CredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackingServerAPI_RenewCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackingServerAPIServer).RenewCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bryk.covid.proto.v1.TrackingServerAPI/RenewCredentials",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackingServerAPIServer).RenewCredentials(ctx, req.(*RenewCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackingServerAPI_Record_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackingServerAPIServer).Record(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bryk.covid.proto.v1.TrackingServerAPI/Record",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackingServerAPIServer).Record(ctx, req.(*RecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackingServerAPI_GetSubmissionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmissionStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackingServerAPIServer).GetSubmissionStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bryk.covid.proto.v1.TrackingServerAPI/GetSubmissionStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackingServerAPIServer).GetSubmissionStatus(ctx, req.(*SubmissionStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackingServerAPI_CheckExposure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckExposureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackingServerAPIServer).CheckExposure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bryk.covid.proto.v1.TrackingServerAPI/CheckExposure",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackingServerAPIServer).CheckExposure(ctx, req.(*CheckExposureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackingServerAPI_CheckExposurePSI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PSIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackingServerAPIServer).CheckExposurePSI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bryk.covid.proto.v1.TrackingServerAPI/CheckExposurePSI",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackingServerAPIServer).CheckExposurePSI(ctx, req.(*PSIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackingServerAPI_GetSamplingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackingServerAPIServer).GetSamplingPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bryk.covid.proto.v1.TrackingServerAPI/GetSamplingPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackingServerAPIServer).GetSamplingPolicy(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackingServerAPI_NewIdentifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewIdentifierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackingServerAPIServer).NewIdentifier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bryk.covid.proto.v1.TrackingServerAPI/NewIdentifier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackingServerAPIServer).NewIdentifier(ctx, req.(*NewIdentifierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackingServerAPI_RegisterVenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterVenueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackingServerAPIServer).RegisterVenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bryk.covid.proto.v1.TrackingServerAPI/RegisterVenue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackingServerAPIServer).RegisterVenue(ctx, req.(*RegisterVenueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackingServerAPI_CheckIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackingServerAPIServer).CheckIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bryk.covid.proto.v1.TrackingServerAPI/CheckIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackingServerAPIServer).CheckIn(ctx, req.(*CheckInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackingServerAPI_VenueOutbreak_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VenueOutbreakRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackingServerAPIServer).VenueOutbreak(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bryk.covid.proto.v1.TrackingServerAPI/VenueOutbreak",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackingServerAPIServer).VenueOutbreak(ctx, req.(*VenueOutbreakRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackingServerAPI_Analytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackingServerAPIServer).Analytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bryk.covid.proto.v1.TrackingServerAPI/Analytics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackingServerAPIServer).Analytics(ctx, req.(*AnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackingServerAPI_LabResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LabResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackingServerAPIServer).LabResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bryk.covid.proto.v1.TrackingServerAPI/LabResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackingServerAPIServer).LabResult(ctx, req.(*LabResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackingServerAPI_Certificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackingServerAPIServer).Certificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bryk.covid.proto.v1.TrackingServerAPI/Certificate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackingServerAPIServer).Certificate(ctx, req.(*CertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackingServerAPI_Introspect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntrospectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackingServerAPIServer).Introspect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bryk.covid.proto.v1.TrackingServerAPI/Introspect",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackingServerAPIServer).Introspect(ctx, req.(*IntrospectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackingServerAPI_Ack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackingServerAPIServer).Ack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bryk.covid.proto.v1.TrackingServerAPI/Ack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackingServerAPIServer).Ack(ctx, req.(*AckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackingServerAPI_NotificationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotificationStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackingServerAPIServer).NotificationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bryk.covid.proto.v1.TrackingServerAPI/NotificationStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackingServerAPIServer).NotificationStatus(ctx, req.(*NotificationStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackingServerAPI_ExposureQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExposureQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackingServerAPIServer).ExposureQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bryk.covid.proto.v1.TrackingServerAPI/ExposureQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackingServerAPIServer).ExposureQuery(ctx, req.(*ExposureQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackingServerAPI_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackingServerAPIServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bryk.covid.proto.v1.TrackingServerAPI/ListSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackingServerAPIServer).ListSessions(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackingServerAPI_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackingServerAPIServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bryk.covid.proto.v1.TrackingServerAPI/RevokeSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackingServerAPIServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackingServerAPI_SendMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EncryptedMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackingServerAPIServer).SendMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bryk.covid.proto.v1.TrackingServerAPI/SendMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackingServerAPIServer).SendMessage(ctx, req.(*EncryptedMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackingServerAPI_Messages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackingServerAPIServer).Messages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bryk.covid.proto.v1.TrackingServerAPI/Messages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackingServerAPIServer).Messages(ctx, req.(*MessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _TrackingServerAPI_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "bryk.covid.proto.v1.TrackingServerAPI",
+	HandlerType: (*TrackingServerAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Ping",
+			Handler:    _TrackingServerAPI_Ping_Handler,
+		},
+		{
+			MethodName: "ActivationCode",
+			Handler:    _TrackingServerAPI_ActivationCode_Handler,
+		},
+		{
+			MethodName: "BulkActivationCodes",
+			Handler:    _TrackingServerAPI_BulkActivationCodes_Handler,
+		},
+		{
+			MethodName: "Credentials",
+			Handler:    _TrackingServerAPI_Credentials_Handler,
+		},
+		{
+			MethodName: "RenewCredentials",
+			Handler:    _TrackingServerAPI_RenewCredentials_Handler,
+		},
+		{
+			MethodName: "Record",
+			Handler:    _TrackingServerAPI_Record_Handler,
+		},
+		{
+			MethodName: "GetSubmissionStatus",
+			Handler:    _TrackingServerAPI_GetSubmissionStatus_Handler,
+		},
+		{
+			MethodName: "CheckExposure",
+			Handler:    _TrackingServerAPI_CheckExposure_Handler,
+		},
+		{
+			MethodName: "CheckExposurePSI",
+			Handler:    _TrackingServerAPI_CheckExposurePSI_Handler,
+		},
+		{
+			MethodName: "GetSamplingPolicy",
+			Handler:    _TrackingServerAPI_GetSamplingPolicy_Handler,
+		},
+		{
+			MethodName: "NewIdentifier",
+			Handler:    _TrackingServerAPI_NewIdentifier_Handler,
+		},
+		{
+			MethodName: "RegisterVenue",
+			Handler:    _TrackingServerAPI_RegisterVenue_Handler,
+		},
+		{
+			MethodName: "CheckIn",
+			Handler:    _TrackingServerAPI_CheckIn_Handler,
+		},
+		{
+			MethodName: "VenueOutbreak",
+			Handler:    _TrackingServerAPI_VenueOutbreak_Handler,
+		},
+		{
+			MethodName: "Analytics",
+			Handler:    _TrackingServerAPI_Analytics_Handler,
+		},
+		{
+			MethodName: "LabResult",
+			Handler:    _TrackingServerAPI_LabResult_Handler,
+		},
+		{
+			MethodName: "Certificate",
+			Handler:    _TrackingServerAPI_Certificate_Handler,
+		},
+		{
+			MethodName: "Introspect",
+			Handler:    _TrackingServerAPI_Introspect_Handler,
+		},
+		{
+			MethodName: "Ack",
+			Handler:    _TrackingServerAPI_Ack_Handler,
+		},
+		{
+			MethodName: "NotificationStatus",
+			Handler:    _TrackingServerAPI_NotificationStatus_Handler,
+		},
+		{
+			MethodName: "ExposureQuery",
+			Handler:    _TrackingServerAPI_ExposureQuery_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _TrackingServerAPI_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _TrackingServerAPI_RevokeSession_Handler,
+		},
+		{
+			MethodName: "SendMessage",
+			Handler:    _TrackingServerAPI_SendMessage_Handler,
+		},
+		{
+			MethodName: "Messages",
+			Handler:    _TrackingServerAPI_Messages_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/v1/tracking_server_api.proto",
+}
+
+func (m *PingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Ok {
+		i--
+		if m.Ok {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActivationCodeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActivationCodeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActivationCodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Device) > 0 {
+		i -= len(m.Device)
+		copy(dAtA[i:], m.Device)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Device)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Scope) > 0 {
+		for iNdEx := len(m.Scope) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Scope[iNdEx])
+			copy(dAtA[i:], m.Scope[iNdEx])
+			i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Scope[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActivationCodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActivationCodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActivationCodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ActivationCode) > 0 {
+		i -= len(m.ActivationCode)
+		copy(dAtA[i:], m.ActivationCode)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.ActivationCode)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BulkActivationCodesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BulkActivationCodesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BulkActivationCodesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.QrImages {
+		i--
+		if m.QrImages {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Scope) > 0 {
+		for iNdEx := len(m.Scope) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Scope[iNdEx])
+			copy(dAtA[i:], m.Scope[iNdEx])
+			i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Scope[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Campaign) > 0 {
+		i -= len(m.Campaign)
+		copy(dAtA[i:], m.Campaign)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Campaign)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Count != 0 {
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BulkActivationCodesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BulkActivationCodesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BulkActivationCodesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Codes) > 0 {
+		for iNdEx := len(m.Codes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Codes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTrackingServerApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CampaignCode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CampaignCode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CampaignCode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Expires != 0 {
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(m.Expires))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.QrImage) > 0 {
+		i -= len(m.QrImage)
+		copy(dAtA[i:], m.QrImage)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.QrImage)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.QrCode) > 0 {
+		i -= len(m.QrCode)
+		copy(dAtA[i:], m.QrCode)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.QrCode)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Campaign) > 0 {
+		i -= len(m.Campaign)
+		copy(dAtA[i:], m.Campaign)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Campaign)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ActivationCode) > 0 {
+		i -= len(m.ActivationCode)
+		copy(dAtA[i:], m.ActivationCode)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.ActivationCode)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CredentialsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CredentialsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CredentialsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Device) > 0 {
+		i -= len(m.Device)
+		copy(dAtA[i:], m.Device)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Device)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Attestation != nil {
+		{
+			size, err := m.Attestation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTrackingServerApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Scope) > 0 {
+		for iNdEx := len(m.Scope) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Scope[iNdEx])
+			copy(dAtA[i:], m.Scope[iNdEx])
+			i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Scope[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Lang) > 0 {
+		i -= len(m.Lang)
+		copy(dAtA[i:], m.Lang)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Lang)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ActivationCode) > 0 {
+		i -= len(m.ActivationCode)
+		copy(dAtA[i:], m.ActivationCode)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.ActivationCode)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeviceAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeviceAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeviceAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Platform) > 0 {
+		i -= len(m.Platform)
+		copy(dAtA[i:], m.Platform)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Platform)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RenewCredentialsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RenewCredentialsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RenewCredentialsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RefreshCode) > 0 {
+		i -= len(m.RefreshCode)
+		copy(dAtA[i:], m.RefreshCode)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.RefreshCode)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CredentialsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CredentialsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CredentialsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RefreshCode) > 0 {
+		i -= len(m.RefreshCode)
+		copy(dAtA[i:], m.RefreshCode)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.RefreshCode)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AccessToken) > 0 {
+		i -= len(m.AccessToken)
+		copy(dAtA[i:], m.AccessToken)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.AccessToken)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Platform) > 0 {
+		i -= len(m.Platform)
+		copy(dAtA[i:], m.Platform)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Platform)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SdkVersion) > 0 {
+		i -= len(m.SdkVersion)
+		copy(dAtA[i:], m.SdkVersion)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.SdkVersion)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AppVersion) > 0 {
+		i -= len(m.AppVersion)
+		copy(dAtA[i:], m.AppVersion)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.AppVersion)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTrackingServerApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Receipt) > 0 {
+		i -= len(m.Receipt)
+		copy(dAtA[i:], m.Receipt)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Receipt)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTrackingServerApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Ok {
+		i--
+		if m.Ok {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckExposureRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckExposureRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckExposureRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Prefixes) > 0 {
+		for iNdEx := len(m.Prefixes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Prefixes[iNdEx])
+			copy(dAtA[i:], m.Prefixes[iNdEx])
+			i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Prefixes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckExposureResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckExposureResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckExposureResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Updated != 0 {
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(m.Updated))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tokens[iNdEx])
+			copy(dAtA[i:], m.Tokens[iNdEx])
+			i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Tokens[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PSIRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PSIRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PSIRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Blinded) > 0 {
+		for iNdEx := len(m.Blinded) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Blinded[iNdEx])
+			copy(dAtA[i:], m.Blinded[iNdEx])
+			i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Blinded[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PSIResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PSIResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PSIResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Updated != 0 {
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(m.Updated))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Set) > 0 {
+		for iNdEx := len(m.Set) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Set[iNdEx])
+			copy(dAtA[i:], m.Set[iNdEx])
+			i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Set[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Evaluated) > 0 {
+		for iNdEx := len(m.Evaluated) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Evaluated[iNdEx])
+			copy(dAtA[i:], m.Evaluated[iNdEx])
+			i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Evaluated[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SamplingPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SamplingPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SamplingPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.RefreshInterval != 0 {
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(m.RefreshInterval))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MinDistance != 0 {
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(m.MinDistance))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UploadInterval != 0 {
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(m.UploadInterval))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RecordInterval != 0 {
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(m.RecordInterval))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Mode) > 0 {
+		i -= len(m.Mode)
+		copy(dAtA[i:], m.Mode)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Mode)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubmissionStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubmissionStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubmissionStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Receipt) > 0 {
+		i -= len(m.Receipt)
+		copy(dAtA[i:], m.Receipt)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Receipt)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubmissionStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubmissionStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubmissionStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Processed != 0 {
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(m.Processed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Created != 0 {
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(m.Created))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTrackingServerApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Receipt) > 0 {
+		i -= len(m.Receipt)
+		copy(dAtA[i:], m.Receipt)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Receipt)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NewIdentifierRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NewIdentifierRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NewIdentifierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.AutoPublish {
+		i--
+		if m.AutoPublish {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NewIdentifierResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NewIdentifierResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NewIdentifierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Document) > 0 {
+		i -= len(m.Document)
+		copy(dAtA[i:], m.Document)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Document)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterVenueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterVenueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterVenueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Lng != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Lng))))
+		i--
+		dAtA[i] = 0x1d
+	}
+	if m.Lat != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Lat))))
+		i--
+		dAtA[i] = 0x15
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckInRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckInRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTrackingServerApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckInResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckInResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Ok {
+		i--
+		if m.Ok {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VenueOutbreakRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VenueOutbreakRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VenueOutbreakRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.To != 0 {
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(m.To))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.From != 0 {
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(m.From))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Venue) > 0 {
+		i -= len(m.Venue)
+		copy(dAtA[i:], m.Venue)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Venue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VenueOutbreakResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VenueOutbreakResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VenueOutbreakResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Ok {
+		i--
+		if m.Ok {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AnalyticsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AnalyticsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AnalyticsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Purpose) > 0 {
+		i -= len(m.Purpose)
+		copy(dAtA[i:], m.Purpose)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Purpose)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Fields != nil {
+		{
+			size, err := m.Fields.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTrackingServerApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.To != 0 {
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(m.To))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.From != 0 {
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(m.From))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AnalyticsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AnalyticsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AnalyticsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Flows) > 0 {
+		for iNdEx := len(m.Flows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Flows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTrackingServerApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Hotspots) > 0 {
+		for iNdEx := len(m.Hotspots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hotspots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTrackingServerApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LabResultRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LabResultRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LabResultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Resource) > 0 {
+		i -= len(m.Resource)
+		copy(dAtA[i:], m.Resource)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Resource)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LabResultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LabResultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LabResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Ok {
+		i--
+		if m.Ok {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CertificateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CertificateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CertificateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Format) > 0 {
+		i -= len(m.Format)
+		copy(dAtA[i:], m.Format)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Format)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Diagnosis) > 0 {
+		i -= len(m.Diagnosis)
+		copy(dAtA[i:], m.Diagnosis)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Diagnosis)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CertificateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CertificateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CertificateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Qr) > 0 {
+		i -= len(m.Qr)
+		copy(dAtA[i:], m.Qr)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Qr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Credential) > 0 {
+		i -= len(m.Credential)
+		copy(dAtA[i:], m.Credential)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Credential)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Format) > 0 {
+		i -= len(m.Format)
+		copy(dAtA[i:], m.Format)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Format)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IntrospectRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IntrospectRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IntrospectRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IntrospectResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IntrospectResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IntrospectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Scope) > 0 {
+		i -= len(m.Scope)
+		copy(dAtA[i:], m.Scope)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Scope)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Jti) > 0 {
+		i -= len(m.Jti)
+		copy(dAtA[i:], m.Jti)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Jti)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Nbf != 0 {
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(m.Nbf))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Iat != 0 {
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(m.Iat))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Exp != 0 {
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(m.Exp))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Aud) > 0 {
+		for iNdEx := len(m.Aud) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Aud[iNdEx])
+			copy(dAtA[i:], m.Aud[iNdEx])
+			i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Aud[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Iss) > 0 {
+		i -= len(m.Iss)
+		copy(dAtA[i:], m.Iss)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Iss)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Lang) > 0 {
+		i -= len(m.Lang)
+		copy(dAtA[i:], m.Lang)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Lang)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sub) > 0 {
+		i -= len(m.Sub)
+		copy(dAtA[i:], m.Sub)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Sub)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AckRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AckRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AckRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Notifications) > 0 {
+		for iNdEx := len(m.Notifications) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Notifications[iNdEx])
+			copy(dAtA[i:], m.Notifications[iNdEx])
+			i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Notifications[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AckResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AckResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AckResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Updated != 0 {
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(m.Updated))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NotificationStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NotificationStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NotificationStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NotificationStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NotificationStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NotificationStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Read != 0 {
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(m.Read))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Delivered != 0 {
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(m.Delivered))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Failed != 0 {
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(m.Failed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Dispatched != 0 {
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(m.Dispatched))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Pending != 0 {
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(m.Pending))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Total != 0 {
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GeoPoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GeoPoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GeoPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Lng != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Lng))))
+		i--
+		dAtA[i] = 0x15
+	}
+	if m.Lat != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Lat))))
+		i--
+		dAtA[i] = 0xd
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExposureQueryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExposureQueryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExposureQueryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Purpose) > 0 {
+		i -= len(m.Purpose)
+		copy(dAtA[i:], m.Purpose)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Purpose)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Identifiers {
+		i--
+		if m.Identifiers {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.To != 0 {
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(m.To))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.From != 0 {
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(m.From))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Area) > 0 {
+		for iNdEx := len(m.Area) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Area[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTrackingServerApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExposureQueryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExposureQueryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExposureQueryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Identifiers) > 0 {
+		for iNdEx := len(m.Identifiers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Identifiers[iNdEx])
+			copy(dAtA[i:], m.Identifiers[iNdEx])
+			i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Identifiers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Users != 0 {
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(m.Users))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Presence) > 0 {
+		for iNdEx := len(m.Presence) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Presence[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTrackingServerApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Presence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Presence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Presence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.To != 0 {
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(m.To))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.From != 0 {
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(m.From))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Users != 0 {
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(m.Users))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Cell) > 0 {
+		i -= len(m.Cell)
+		copy(dAtA[i:], m.Cell)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Cell)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Session) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Session) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Session) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Current {
+		i--
+		if m.Current {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Expires != 0 {
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(m.Expires))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Issued != 0 {
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(m.Issued))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Created != 0 {
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(m.Created))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListSessionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListSessionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListSessionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Sessions) > 0 {
+		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTrackingServerApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RevokeSessionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevokeSessionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevokeSessionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SendMessageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendMessageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendMessageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Created != 0 {
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(m.Created))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Since != 0 {
+		i = encodeVarintTrackingServerApi(dAtA, i, uint64(m.Since))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTrackingServerApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTrackingServerApi(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTrackingServerApi(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedPingResponse(r randyTrackingServerApi, easy bool) *PingResponse {
+	this := &PingResponse{}
+	this.Ok = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 2)
+	}
+	return this
+}
+
+func NewPopulatedActivationCodeRequest(r randyTrackingServerApi, easy bool) *ActivationCodeRequest {
+	this := &ActivationCodeRequest{}
+	this.Did = string(randStringTrackingServerApi(r))
+	this.Role = string(randStringTrackingServerApi(r))
+	v1 := r.Intn(10)
+	this.Scope = make([]string, v1)
+	for i := 0; i < v1; i++ {
+		this.Scope[i] = string(randStringTrackingServerApi(r))
+	}
+	this.Device = string(randStringTrackingServerApi(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 5)
+	}
+	return this
+}
+
+func NewPopulatedActivationCodeResponse(r randyTrackingServerApi, easy bool) *ActivationCodeResponse {
+	this := &ActivationCodeResponse{}
+	this.ActivationCode = string(randStringTrackingServerApi(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 2)
+	}
+	return this
+}
+
+func NewPopulatedBulkActivationCodesRequest(r randyTrackingServerApi, easy bool) *BulkActivationCodesRequest {
+	this := &BulkActivationCodesRequest{}
+	this.Count = uint32(r.Uint32())
+	this.Campaign = string(randStringTrackingServerApi(r))
+	v2 := r.Intn(10)
+	this.Scope = make([]string, v2)
+	for i := 0; i < v2; i++ {
+		this.Scope[i] = string(randStringTrackingServerApi(r))
+	}
+	this.QrImages = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 5)
+	}
+	return this
+}
+
+func NewPopulatedBulkActivationCodesResponse(r randyTrackingServerApi, easy bool) *BulkActivationCodesResponse {
+	this := &BulkActivationCodesResponse{}
+	if r.Intn(5) != 0 {
+		v3 := r.Intn(5)
+		this.Codes = make([]*CampaignCode, v3)
+		for i := 0; i < v3; i++ {
+			this.Codes[i] = NewPopulatedCampaignCode(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 2)
+	}
+	return this
+}
+
+func NewPopulatedCampaignCode(r randyTrackingServerApi, easy bool) *CampaignCode {
+	this := &CampaignCode{}
+	this.ActivationCode = string(randStringTrackingServerApi(r))
+	this.Campaign = string(randStringTrackingServerApi(r))
+	this.QrCode = string(randStringTrackingServerApi(r))
+	v4 := r.Intn(100)
+	this.QrImage = make([]byte, v4)
+	for i := 0; i < v4; i++ {
+		this.QrImage[i] = byte(r.Intn(256))
+	}
+	this.Expires = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Expires *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 6)
+	}
+	return this
+}
+
+func NewPopulatedCredentialsRequest(r randyTrackingServerApi, easy bool) *CredentialsRequest {
+	this := &CredentialsRequest{}
+	this.Did = string(randStringTrackingServerApi(r))
+	this.Role = string(randStringTrackingServerApi(r))
+	this.ActivationCode = string(randStringTrackingServerApi(r))
+	v5 := r.Intn(100)
+	this.Proof = make([]byte, v5)
+	for i := 0; i < v5; i++ {
+		this.Proof[i] = byte(r.Intn(256))
+	}
+	this.Lang = string(randStringTrackingServerApi(r))
+	v6 := r.Intn(10)
+	this.Scope = make([]string, v6)
+	for i := 0; i < v6; i++ {
+		this.Scope[i] = string(randStringTrackingServerApi(r))
+	}
+	if r.Intn(5) != 0 {
+		this.Attestation = NewPopulatedDeviceAttestation(r, easy)
+	}
+	this.Device = string(randStringTrackingServerApi(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 9)
+	}
+	return this
+}
+
+func NewPopulatedDeviceAttestation(r randyTrackingServerApi, easy bool) *DeviceAttestation {
+	this := &DeviceAttestation{}
+	this.Platform = string(randStringTrackingServerApi(r))
+	this.Token = string(randStringTrackingServerApi(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 3)
+	}
+	return this
+}
+
+func NewPopulatedRenewCredentialsRequest(r randyTrackingServerApi, easy bool) *RenewCredentialsRequest {
+	this := &RenewCredentialsRequest{}
+	this.RefreshCode = string(randStringTrackingServerApi(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 2)
+	}
+	return this
+}
+
+func NewPopulatedCredentialsResponse(r randyTrackingServerApi, easy bool) *CredentialsResponse {
+	this := &CredentialsResponse{}
+	this.AccessToken = string(randStringTrackingServerApi(r))
+	this.RefreshCode = string(randStringTrackingServerApi(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 3)
+	}
+	return this
+}
+
+func NewPopulatedRecordRequest(r randyTrackingServerApi, easy bool) *RecordRequest {
+	this := &RecordRequest{}
+	if r.Intn(5) != 0 {
+		v7 := r.Intn(5)
+		this.Records = make([]*LocationRecord, v7)
+		for i := 0; i < v7; i++ {
+			this.Records[i] = NewPopulatedLocationRecord(r, easy)
+		}
+	}
+	this.AppVersion = string(randStringTrackingServerApi(r))
+	this.SdkVersion = string(randStringTrackingServerApi(r))
+	this.Platform = string(randStringTrackingServerApi(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 5)
+	}
+	return this
+}
+
+func NewPopulatedRecordResponse(r randyTrackingServerApi, easy bool) *RecordResponse {
+	this := &RecordResponse{}
+	this.Ok = bool(bool(r.Intn(2) == 0))
+	if r.Intn(5) != 0 {
+		v8 := r.Intn(5)
+		this.Records = make([]*RecordStatus, v8)
+		for i := 0; i < v8; i++ {
+			this.Records[i] = NewPopulatedRecordStatus(r, easy)
+		}
+	}
+	this.Receipt = string(randStringTrackingServerApi(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 4)
+	}
+	return this
+}
+
+func NewPopulatedRecordStatus(r randyTrackingServerApi, easy bool) *RecordStatus {
+	this := &RecordStatus{}
+	this.Index = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Index *= -1
+	}
+	this.Hash = string(randStringTrackingServerApi(r))
+	this.Status = string(randStringTrackingServerApi(r))
+	this.Reason = string(randStringTrackingServerApi(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 5)
+	}
+	return this
+}
+
+func NewPopulatedCheckExposureRequest(r randyTrackingServerApi, easy bool) *CheckExposureRequest {
+	this := &CheckExposureRequest{}
+	v9 := r.Intn(10)
+	this.Prefixes = make([]string, v9)
+	for i := 0; i < v9; i++ {
+		this.Prefixes[i] = string(randStringTrackingServerApi(r))
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 2)
+	}
+	return this
+}
+
+func NewPopulatedCheckExposureResponse(r randyTrackingServerApi, easy bool) *CheckExposureResponse {
+	this := &CheckExposureResponse{}
+	v10 := r.Intn(10)
+	this.Tokens = make([]string, v10)
+	for i := 0; i < v10; i++ {
+		this.Tokens[i] = string(randStringTrackingServerApi(r))
+	}
+	this.Updated = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Updated *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 3)
+	}
+	return this
+}
+
+func NewPopulatedPSIRequest(r randyTrackingServerApi, easy bool) *PSIRequest {
+	this := &PSIRequest{}
+	v11 := r.Intn(10)
+	this.Blinded = make([][]byte, v11)
+	for i := 0; i < v11; i++ {
+		v12 := r.Intn(100)
+		this.Blinded[i] = make([]byte, v12)
+		for j := 0; j < v12; j++ {
+			this.Blinded[i][j] = byte(r.Intn(256))
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 2)
+	}
+	return this
+}
+
+func NewPopulatedPSIResponse(r randyTrackingServerApi, easy bool) *PSIResponse {
+	this := &PSIResponse{}
+	v13 := r.Intn(10)
+	this.Evaluated = make([][]byte, v13)
+	for i := 0; i < v13; i++ {
+		v14 := r.Intn(100)
+		this.Evaluated[i] = make([]byte, v14)
+		for j := 0; j < v14; j++ {
+			this.Evaluated[i][j] = byte(r.Intn(256))
+		}
+	}
+	v15 := r.Intn(10)
+	this.Set = make([]string, v15)
+	for i := 0; i < v15; i++ {
+		this.Set[i] = string(randStringTrackingServerApi(r))
+	}
+	this.Updated = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Updated *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 4)
+	}
+	return this
+}
+
+func NewPopulatedSamplingPolicy(r randyTrackingServerApi, easy bool) *SamplingPolicy {
+	this := &SamplingPolicy{}
+	this.Mode = string(randStringTrackingServerApi(r))
+	this.RecordInterval = uint32(r.Uint32())
+	this.UploadInterval = uint32(r.Uint32())
+	this.MinDistance = uint32(r.Uint32())
+	this.RefreshInterval = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 6)
+	}
+	return this
+}
+
+func NewPopulatedSubmissionStatusRequest(r randyTrackingServerApi, easy bool) *SubmissionStatusRequest {
+	this := &SubmissionStatusRequest{}
+	this.Receipt = string(randStringTrackingServerApi(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 2)
+	}
+	return this
+}
+
+func NewPopulatedSubmissionStatusResponse(r randyTrackingServerApi, easy bool) *SubmissionStatusResponse {
+	this := &SubmissionStatusResponse{}
+	this.Receipt = string(randStringTrackingServerApi(r))
+	this.Status = string(randStringTrackingServerApi(r))
+	if r.Intn(5) != 0 {
+		v16 := r.Intn(5)
+		this.Records = make([]*RecordStatus, v16)
+		for i := 0; i < v16; i++ {
+			this.Records[i] = NewPopulatedRecordStatus(r, easy)
+		}
+	}
+	this.Created = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Created *= -1
+	}
+	this.Processed = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Processed *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 6)
+	}
+	return this
+}
+
+func NewPopulatedNewIdentifierRequest(r randyTrackingServerApi, easy bool) *NewIdentifierRequest {
+	this := &NewIdentifierRequest{}
+	this.Method = string(randStringTrackingServerApi(r))
+	this.AutoPublish = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 3)
+	}
+	return this
+}
+
+func NewPopulatedNewIdentifierResponse(r randyTrackingServerApi, easy bool) *NewIdentifierResponse {
+	this := &NewIdentifierResponse{}
+	this.Document = string(randStringTrackingServerApi(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 2)
+	}
+	return this
+}
+
+func NewPopulatedRegisterVenueRequest(r randyTrackingServerApi, easy bool) *RegisterVenueRequest {
+	this := &RegisterVenueRequest{}
+	this.Name = string(randStringTrackingServerApi(r))
+	this.Lat = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.Lat *= -1
+	}
+	this.Lng = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.Lng *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 4)
+	}
+	return this
+}
+
+func NewPopulatedCheckInRequest(r randyTrackingServerApi, easy bool) *CheckInRequest {
+	this := &CheckInRequest{}
+	if r.Intn(5) != 0 {
+		v17 := r.Intn(5)
+		this.Records = make([]*CheckInRecord, v17)
+		for i := 0; i < v17; i++ {
+			this.Records[i] = NewPopulatedCheckInRecord(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 2)
+	}
+	return this
+}
+
+func NewPopulatedCheckInResponse(r randyTrackingServerApi, easy bool) *CheckInResponse {
+	this := &CheckInResponse{}
+	this.Ok = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 2)
+	}
+	return this
+}
+
+func NewPopulatedVenueOutbreakRequest(r randyTrackingServerApi, easy bool) *VenueOutbreakRequest {
+	this := &VenueOutbreakRequest{}
+	this.Venue = string(randStringTrackingServerApi(r))
+	this.From = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.From *= -1
+	}
+	this.To = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.To *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 4)
+	}
+	return this
+}
+
+func NewPopulatedVenueOutbreakResponse(r randyTrackingServerApi, easy bool) *VenueOutbreakResponse {
+	this := &VenueOutbreakResponse{}
+	this.Ok = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 2)
+	}
+	return this
+}
+
+func NewPopulatedAnalyticsRequest(r randyTrackingServerApi, easy bool) *AnalyticsRequest {
+	this := &AnalyticsRequest{}
+	this.From = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.From *= -1
+	}
+	this.To = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.To *= -1
+	}
+	if r.Intn(5) != 0 {
+		this.Fields = types.NewPopulatedFieldMask(r, easy)
+	}
+	this.Purpose = string(randStringTrackingServerApi(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 5)
+	}
+	return this
+}
+
+func NewPopulatedAnalyticsResponse(r randyTrackingServerApi, easy bool) *AnalyticsResponse {
+	this := &AnalyticsResponse{}
+	if r.Intn(5) != 0 {
+		v18 := r.Intn(5)
+		this.Hotspots = make([]*Hotspot, v18)
+		for i := 0; i < v18; i++ {
+			this.Hotspots[i] = NewPopulatedHotspot(r, easy)
+		}
+	}
+	if r.Intn(5) != 0 {
+		v19 := r.Intn(5)
+		this.Flows = make([]*Flow, v19)
+		for i := 0; i < v19; i++ {
+			this.Flows[i] = NewPopulatedFlow(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 3)
+	}
+	return this
+}
+
+func NewPopulatedLabResultRequest(r randyTrackingServerApi, easy bool) *LabResultRequest {
+	this := &LabResultRequest{}
+	v20 := r.Intn(100)
+	this.Resource = make([]byte, v20)
+	for i := 0; i < v20; i++ {
+		this.Resource[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 2)
+	}
+	return this
+}
+
+func NewPopulatedLabResultResponse(r randyTrackingServerApi, easy bool) *LabResultResponse {
+	this := &LabResultResponse{}
+	this.Ok = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 2)
+	}
+	return this
+}
+
+func NewPopulatedCertificateRequest(r randyTrackingServerApi, easy bool) *CertificateRequest {
+	this := &CertificateRequest{}
+	this.Diagnosis = string(randStringTrackingServerApi(r))
+	this.Format = string(randStringTrackingServerApi(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 3)
+	}
+	return this
+}
+
+func NewPopulatedCertificateResponse(r randyTrackingServerApi, easy bool) *CertificateResponse {
+	this := &CertificateResponse{}
+	this.Format = string(randStringTrackingServerApi(r))
+	this.Credential = string(randStringTrackingServerApi(r))
+	this.Qr = string(randStringTrackingServerApi(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 4)
+	}
+	return this
+}
+
+func NewPopulatedIntrospectRequest(r randyTrackingServerApi, easy bool) *IntrospectRequest {
+	this := &IntrospectRequest{}
+	this.Token = string(randStringTrackingServerApi(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 2)
+	}
+	return this
+}
+
+func NewPopulatedIntrospectResponse(r randyTrackingServerApi, easy bool) *IntrospectResponse {
+	this := &IntrospectResponse{}
+	this.Active = bool(bool(r.Intn(2) == 0))
+	this.Sub = string(randStringTrackingServerApi(r))
+	this.Did = string(randStringTrackingServerApi(r))
+	this.Role = string(randStringTrackingServerApi(r))
+	this.Lang = string(randStringTrackingServerApi(r))
+	this.Iss = string(randStringTrackingServerApi(r))
+	v21 := r.Intn(10)
+	this.Aud = make([]string, v21)
+	for i := 0; i < v21; i++ {
+		this.Aud[i] = string(randStringTrackingServerApi(r))
+	}
+	this.Exp = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Exp *= -1
+	}
+	this.Iat = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Iat *= -1
+	}
+	this.Nbf = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Nbf *= -1
+	}
+	this.Jti = string(randStringTrackingServerApi(r))
+	this.Scope = string(randStringTrackingServerApi(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 13)
+	}
+	return this
+}
+
+func NewPopulatedAckRequest(r randyTrackingServerApi, easy bool) *AckRequest {
+	this := &AckRequest{}
+	v22 := r.Intn(10)
+	this.Notifications = make([]string, v22)
+	for i := 0; i < v22; i++ {
+		this.Notifications[i] = string(randStringTrackingServerApi(r))
+	}
+	this.Status = string(randStringTrackingServerApi(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 3)
+	}
+	return this
+}
+
+func NewPopulatedAckResponse(r randyTrackingServerApi, easy bool) *AckResponse {
+	this := &AckResponse{}
+	this.Updated = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 2)
+	}
+	return this
+}
+
+func NewPopulatedNotificationStatusRequest(r randyTrackingServerApi, easy bool) *NotificationStatusRequest {
+	this := &NotificationStatusRequest{}
+	this.Source = string(randStringTrackingServerApi(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 2)
+	}
+	return this
+}
+
+func NewPopulatedNotificationStatusResponse(r randyTrackingServerApi, easy bool) *NotificationStatusResponse {
+	this := &NotificationStatusResponse{}
+	this.Total = uint32(r.Uint32())
+	this.Pending = uint32(r.Uint32())
+	this.Dispatched = uint32(r.Uint32())
+	this.Failed = uint32(r.Uint32())
+	this.Delivered = uint32(r.Uint32())
+	this.Read = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 7)
+	}
+	return this
+}
+
+func NewPopulatedGeoPoint(r randyTrackingServerApi, easy bool) *GeoPoint {
+	this := &GeoPoint{}
+	this.Lat = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.Lat *= -1
+	}
+	this.Lng = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.Lng *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 3)
+	}
+	return this
+}
+
+func NewPopulatedExposureQueryRequest(r randyTrackingServerApi, easy bool) *ExposureQueryRequest {
+	this := &ExposureQueryRequest{}
+	if r.Intn(5) != 0 {
+		v23 := r.Intn(5)
+		this.Area = make([]*GeoPoint, v23)
+		for i := 0; i < v23; i++ {
+			this.Area[i] = NewPopulatedGeoPoint(r, easy)
+		}
+	}
+	this.From = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.From *= -1
+	}
+	this.To = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.To *= -1
+	}
+	this.Identifiers = bool(bool(r.Intn(2) == 0))
+	this.Reason = string(randStringTrackingServerApi(r))
+	this.Purpose = string(randStringTrackingServerApi(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 7)
+	}
+	return this
+}
+
+func NewPopulatedExposureQueryResponse(r randyTrackingServerApi, easy bool) *ExposureQueryResponse {
+	this := &ExposureQueryResponse{}
+	if r.Intn(5) != 0 {
+		v24 := r.Intn(5)
+		this.Presence = make([]*Presence, v24)
+		for i := 0; i < v24; i++ {
+			this.Presence[i] = NewPopulatedPresence(r, easy)
+		}
+	}
+	this.Users = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Users *= -1
+	}
+	v25 := r.Intn(10)
+	this.Identifiers = make([]string, v25)
+	for i := 0; i < v25; i++ {
+		this.Identifiers[i] = string(randStringTrackingServerApi(r))
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 4)
+	}
+	return this
+}
+
+func NewPopulatedPresence(r randyTrackingServerApi, easy bool) *Presence {
+	this := &Presence{}
+	this.Cell = string(randStringTrackingServerApi(r))
+	this.Users = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Users *= -1
+	}
+	this.From = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.From *= -1
+	}
+	this.To = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.To *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 5)
+	}
+	return this
+}
+
+func NewPopulatedSession(r randyTrackingServerApi, easy bool) *Session {
+	this := &Session{}
+	this.Id = string(randStringTrackingServerApi(r))
+	this.Role = string(randStringTrackingServerApi(r))
+	this.Created = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Created *= -1
+	}
+	this.Issued = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Issued *= -1
+	}
+	this.Expires = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Expires *= -1
+	}
+	this.Current = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 7)
+	}
+	return this
+}
+
+func NewPopulatedListSessionsResponse(r randyTrackingServerApi, easy bool) *ListSessionsResponse {
+	this := &ListSessionsResponse{}
+	if r.Intn(5) != 0 {
+		v26 := r.Intn(5)
+		this.Sessions = make([]*Session, v26)
+		for i := 0; i < v26; i++ {
+			this.Sessions[i] = NewPopulatedSession(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 2)
+	}
+	return this
+}
+
+func NewPopulatedRevokeSessionRequest(r randyTrackingServerApi, easy bool) *RevokeSessionRequest {
+	this := &RevokeSessionRequest{}
+	this.Id = string(randStringTrackingServerApi(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 2)
+	}
+	return this
+}
+
+func NewPopulatedSendMessageResponse(r randyTrackingServerApi, easy bool) *SendMessageResponse {
+	this := &SendMessageResponse{}
+	this.Id = string(randStringTrackingServerApi(r))
+	this.Created = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Created *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 3)
+	}
+	return this
+}
+
+func NewPopulatedMessagesRequest(r randyTrackingServerApi, easy bool) *MessagesRequest {
+	this := &MessagesRequest{}
+	this.Since = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Since *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 2)
+	}
+	return this
+}
+
+func NewPopulatedMessagesResponse(r randyTrackingServerApi, easy bool) *MessagesResponse {
+	this := &MessagesResponse{}
+	if r.Intn(5) != 0 {
+		v27 := r.Intn(5)
+		this.Messages = make([]*EncryptedMessage, v27)
+		for i := 0; i < v27; i++ {
+			this.Messages[i] = NewPopulatedEncryptedMessage(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTrackingServerApi(r, 2)
+	}
+	return this
+}
+
+type randyTrackingServerApi interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneTrackingServerApi(r randyTrackingServerApi) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringTrackingServerApi(r randyTrackingServerApi) string {
+	v28 := r.Intn(100)
+	tmps := make([]rune, v28)
+	for i := 0; i < v28; i++ {
+		tmps[i] = randUTF8RuneTrackingServerApi(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedTrackingServerApi(r randyTrackingServerApi, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldTrackingServerApi(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldTrackingServerApi(dAtA []byte, r randyTrackingServerApi, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateTrackingServerApi(dAtA, uint64(key))
+		v29 := r.Int63()
+		if r.Intn(2) == 0 {
+			v29 *= -1
+		}
+		dAtA = encodeVarintPopulateTrackingServerApi(dAtA, uint64(v29))
+	case 1:
+		dAtA = encodeVarintPopulateTrackingServerApi(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateTrackingServerApi(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateTrackingServerApi(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateTrackingServerApi(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateTrackingServerApi(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *PingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Ok {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ActivationCodeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	if len(m.Scope) > 0 {
+		for _, s := range m.Scope {
+			l = len(s)
+			n += 1 + l + sovTrackingServerApi(uint64(l))
+		}
+	}
+	l = len(m.Device)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ActivationCodeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ActivationCode)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BulkActivationCodesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovTrackingServerApi(uint64(m.Count))
+	}
+	l = len(m.Campaign)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	if len(m.Scope) > 0 {
+		for _, s := range m.Scope {
+			l = len(s)
+			n += 1 + l + sovTrackingServerApi(uint64(l))
+		}
+	}
+	if m.QrImages {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BulkActivationCodesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Codes) > 0 {
+		for _, e := range m.Codes {
+			l = e.Size()
+			n += 1 + l + sovTrackingServerApi(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CampaignCode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ActivationCode)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	l = len(m.Campaign)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	l = len(m.QrCode)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	l = len(m.QrImage)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	if m.Expires != 0 {
+		n += 1 + sovTrackingServerApi(uint64(m.Expires))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CredentialsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	l = len(m.ActivationCode)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	l = len(m.Lang)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	if len(m.Scope) > 0 {
+		for _, s := range m.Scope {
+			l = len(s)
+			n += 1 + l + sovTrackingServerApi(uint64(l))
+		}
+	}
+	if m.Attestation != nil {
+		l = m.Attestation.Size()
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	l = len(m.Device)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeviceAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Platform)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RenewCredentialsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RefreshCode)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CredentialsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AccessToken)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	l = len(m.RefreshCode)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovTrackingServerApi(uint64(l))
+		}
+	}
+	l = len(m.AppVersion)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	l = len(m.SdkVersion)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	l = len(m.Platform)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Ok {
+		n += 2
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovTrackingServerApi(uint64(l))
+		}
+	}
+	l = len(m.Receipt)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RecordStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovTrackingServerApi(uint64(m.Index))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CheckExposureRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prefixes) > 0 {
+		for _, s := range m.Prefixes {
+			l = len(s)
+			n += 1 + l + sovTrackingServerApi(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CheckExposureResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for _, s := range m.Tokens {
+			l = len(s)
+			n += 1 + l + sovTrackingServerApi(uint64(l))
+		}
+	}
+	if m.Updated != 0 {
+		n += 1 + sovTrackingServerApi(uint64(m.Updated))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PSIRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Blinded) > 0 {
+		for _, b := range m.Blinded {
+			l = len(b)
+			n += 1 + l + sovTrackingServerApi(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PSIResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Evaluated) > 0 {
+		for _, b := range m.Evaluated {
+			l = len(b)
+			n += 1 + l + sovTrackingServerApi(uint64(l))
+		}
+	}
+	if len(m.Set) > 0 {
+		for _, s := range m.Set {
+			l = len(s)
+			n += 1 + l + sovTrackingServerApi(uint64(l))
+		}
+	}
+	if m.Updated != 0 {
+		n += 1 + sovTrackingServerApi(uint64(m.Updated))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SamplingPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Mode)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	if m.RecordInterval != 0 {
+		n += 1 + sovTrackingServerApi(uint64(m.RecordInterval))
+	}
+	if m.UploadInterval != 0 {
+		n += 1 + sovTrackingServerApi(uint64(m.UploadInterval))
+	}
+	if m.MinDistance != 0 {
+		n += 1 + sovTrackingServerApi(uint64(m.MinDistance))
+	}
+	if m.RefreshInterval != 0 {
+		n += 1 + sovTrackingServerApi(uint64(m.RefreshInterval))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SubmissionStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Receipt)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SubmissionStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Receipt)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovTrackingServerApi(uint64(l))
+		}
+	}
+	if m.Created != 0 {
+		n += 1 + sovTrackingServerApi(uint64(m.Created))
+	}
+	if m.Processed != 0 {
+		n += 1 + sovTrackingServerApi(uint64(m.Processed))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NewIdentifierRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	if m.AutoPublish {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NewIdentifierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Document)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RegisterVenueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	if m.Lat != 0 {
+		n += 5
+	}
+	if m.Lng != 0 {
+		n += 5
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CheckInRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovTrackingServerApi(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CheckInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Ok {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VenueOutbreakRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Venue)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	if m.From != 0 {
+		n += 1 + sovTrackingServerApi(uint64(m.From))
+	}
+	if m.To != 0 {
+		n += 1 + sovTrackingServerApi(uint64(m.To))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VenueOutbreakResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Ok {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AnalyticsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.From != 0 {
+		n += 1 + sovTrackingServerApi(uint64(m.From))
+	}
+	if m.To != 0 {
+		n += 1 + sovTrackingServerApi(uint64(m.To))
+	}
+	if m.Fields != nil {
+		l = m.Fields.Size()
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	l = len(m.Purpose)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AnalyticsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Hotspots) > 0 {
+		for _, e := range m.Hotspots {
+			l = e.Size()
+			n += 1 + l + sovTrackingServerApi(uint64(l))
+		}
+	}
+	if len(m.Flows) > 0 {
+		for _, e := range m.Flows {
+			l = e.Size()
+			n += 1 + l + sovTrackingServerApi(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LabResultRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Resource)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LabResultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Ok {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CertificateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Diagnosis)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	l = len(m.Format)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CertificateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Format)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	l = len(m.Credential)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	l = len(m.Qr)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IntrospectRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IntrospectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Active {
+		n += 2
+	}
+	l = len(m.Sub)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	l = len(m.Lang)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	l = len(m.Iss)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	if len(m.Aud) > 0 {
+		for _, s := range m.Aud {
+			l = len(s)
+			n += 1 + l + sovTrackingServerApi(uint64(l))
+		}
+	}
+	if m.Exp != 0 {
+		n += 1 + sovTrackingServerApi(uint64(m.Exp))
+	}
+	if m.Iat != 0 {
+		n += 1 + sovTrackingServerApi(uint64(m.Iat))
+	}
+	if m.Nbf != 0 {
+		n += 1 + sovTrackingServerApi(uint64(m.Nbf))
+	}
+	l = len(m.Jti)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	l = len(m.Scope)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AckRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Notifications) > 0 {
+		for _, s := range m.Notifications {
+			l = len(s)
+			n += 1 + l + sovTrackingServerApi(uint64(l))
+		}
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AckResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Updated != 0 {
+		n += 1 + sovTrackingServerApi(uint64(m.Updated))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NotificationStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NotificationStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Total != 0 {
+		n += 1 + sovTrackingServerApi(uint64(m.Total))
+	}
+	if m.Pending != 0 {
+		n += 1 + sovTrackingServerApi(uint64(m.Pending))
+	}
+	if m.Dispatched != 0 {
+		n += 1 + sovTrackingServerApi(uint64(m.Dispatched))
+	}
+	if m.Failed != 0 {
+		n += 1 + sovTrackingServerApi(uint64(m.Failed))
+	}
+	if m.Delivered != 0 {
+		n += 1 + sovTrackingServerApi(uint64(m.Delivered))
+	}
+	if m.Read != 0 {
+		n += 1 + sovTrackingServerApi(uint64(m.Read))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GeoPoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Lat != 0 {
+		n += 5
+	}
+	if m.Lng != 0 {
+		n += 5
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExposureQueryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Area) > 0 {
+		for _, e := range m.Area {
+			l = e.Size()
+			n += 1 + l + sovTrackingServerApi(uint64(l))
+		}
+	}
+	if m.From != 0 {
+		n += 1 + sovTrackingServerApi(uint64(m.From))
+	}
+	if m.To != 0 {
+		n += 1 + sovTrackingServerApi(uint64(m.To))
+	}
+	if m.Identifiers {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	l = len(m.Purpose)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExposureQueryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Presence) > 0 {
+		for _, e := range m.Presence {
+			l = e.Size()
+			n += 1 + l + sovTrackingServerApi(uint64(l))
+		}
+	}
+	if m.Users != 0 {
+		n += 1 + sovTrackingServerApi(uint64(m.Users))
+	}
+	if len(m.Identifiers) > 0 {
+		for _, s := range m.Identifiers {
+			l = len(s)
+			n += 1 + l + sovTrackingServerApi(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Presence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Cell)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	if m.Users != 0 {
+		n += 1 + sovTrackingServerApi(uint64(m.Users))
+	}
+	if m.From != 0 {
+		n += 1 + sovTrackingServerApi(uint64(m.From))
+	}
+	if m.To != 0 {
+		n += 1 + sovTrackingServerApi(uint64(m.To))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Session) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	if m.Created != 0 {
+		n += 1 + sovTrackingServerApi(uint64(m.Created))
+	}
+	if m.Issued != 0 {
+		n += 1 + sovTrackingServerApi(uint64(m.Issued))
+	}
+	if m.Expires != 0 {
+		n += 1 + sovTrackingServerApi(uint64(m.Expires))
+	}
+	if m.Current {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListSessionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Sessions) > 0 {
+		for _, e := range m.Sessions {
+			l = e.Size()
+			n += 1 + l + sovTrackingServerApi(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RevokeSessionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SendMessageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTrackingServerApi(uint64(l))
+	}
+	if m.Created != 0 {
+		n += 1 + sovTrackingServerApi(uint64(m.Created))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Since != 0 {
+		n += 1 + sovTrackingServerApi(uint64(m.Since))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovTrackingServerApi(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovTrackingServerApi(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTrackingServerApi(x uint64) (n int) {
+	return sovTrackingServerApi(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *PingResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PingResponse{`,
+		`Ok:` + fmt.Sprintf("%v", this.Ok) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ActivationCodeRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ActivationCodeRequest{`,
+		`Did:` + fmt.Sprintf("%v", this.Did) + `,`,
+		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
+		`Scope:` + fmt.Sprintf("%v", this.Scope) + `,`,
+		`Device:` + fmt.Sprintf("%v", this.Device) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ActivationCodeResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ActivationCodeResponse{`,
+		`ActivationCode:` + fmt.Sprintf("%v", this.ActivationCode) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BulkActivationCodesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BulkActivationCodesRequest{`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`Campaign:` + fmt.Sprintf("%v", this.Campaign) + `,`,
+		`Scope:` + fmt.Sprintf("%v", this.Scope) + `,`,
+		`QrImages:` + fmt.Sprintf("%v", this.QrImages) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BulkActivationCodesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForCodes := "[]*CampaignCode{"
+	for _, f := range this.Codes {
+		repeatedStringForCodes += strings.Replace(f.String(), "CampaignCode", "CampaignCode", 1) + ","
+	}
+	repeatedStringForCodes += "}"
+	s := strings.Join([]string{`&BulkActivationCodesResponse{`,
+		`Codes:` + repeatedStringForCodes + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CampaignCode) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CampaignCode{`,
+		`ActivationCode:` + fmt.Sprintf("%v", this.ActivationCode) + `,`,
+		`Campaign:` + fmt.Sprintf("%v", this.Campaign) + `,`,
+		`QrCode:` + fmt.Sprintf("%v", this.QrCode) + `,`,
+		`QrImage:` + fmt.Sprintf("%v", this.QrImage) + `,`,
+		`Expires:` + fmt.Sprintf("%v", this.Expires) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CredentialsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CredentialsRequest{`,
+		`Did:` + fmt.Sprintf("%v", this.Did) + `,`,
+		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
+		`ActivationCode:` + fmt.Sprintf("%v", this.ActivationCode) + `,`,
+		`Proof:` + fmt.Sprintf("%v", this.Proof) + `,`,
+		`Lang:` + fmt.Sprintf("%v", this.Lang) + `,`,
+		`Scope:` + fmt.Sprintf("%v", this.Scope) + `,`,
+		`Attestation:` + strings.Replace(this.Attestation.String(), "DeviceAttestation", "DeviceAttestation", 1) + `,`,
+		`Device:` + fmt.Sprintf("%v", this.Device) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeviceAttestation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeviceAttestation{`,
+		`Platform:` + fmt.Sprintf("%v", this.Platform) + `,`,
+		`Token:` + fmt.Sprintf("%v", this.Token) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RenewCredentialsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RenewCredentialsRequest{`,
+		`RefreshCode:` + fmt.Sprintf("%v", this.RefreshCode) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CredentialsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CredentialsResponse{`,
+		`AccessToken:` + fmt.Sprintf("%v", this.AccessToken) + `,`,
+		`RefreshCode:` + fmt.Sprintf("%v", this.RefreshCode) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RecordRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRecords := "[]*LocationRecord{"
+	for _, f := range this.Records {
+		repeatedStringForRecords += strings.Replace(fmt.Sprintf("%v", f), "LocationRecord", "LocationRecord", 1) + ","
+	}
+	repeatedStringForRecords += "}"
+	s := strings.Join([]string{`&RecordRequest{`,
+		`Records:` + repeatedStringForRecords + `,`,
+		`AppVersion:` + fmt.Sprintf("%v", this.AppVersion) + `,`,
+		`SdkVersion:` + fmt.Sprintf("%v", this.SdkVersion) + `,`,
+		`Platform:` + fmt.Sprintf("%v", this.Platform) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RecordResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRecords := "[]*RecordStatus{"
+	for _, f := range this.Records {
+		repeatedStringForRecords += strings.Replace(f.String(), "RecordStatus", "RecordStatus", 1) + ","
+	}
+	repeatedStringForRecords += "}"
+	s := strings.Join([]string{`&RecordResponse{`,
+		`Ok:` + fmt.Sprintf("%v", this.Ok) + `,`,
+		`Records:` + repeatedStringForRecords + `,`,
+		`Receipt:` + fmt.Sprintf("%v", this.Receipt) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RecordStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RecordStatus{`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CheckExposureRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CheckExposureRequest{`,
+		`Prefixes:` + fmt.Sprintf("%v", this.Prefixes) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CheckExposureResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CheckExposureResponse{`,
+		`Tokens:` + fmt.Sprintf("%v", this.Tokens) + `,`,
+		`Updated:` + fmt.Sprintf("%v", this.Updated) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PSIRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PSIRequest{`,
+		`Blinded:` + fmt.Sprintf("%v", this.Blinded) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PSIResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PSIResponse{`,
+		`Evaluated:` + fmt.Sprintf("%v", this.Evaluated) + `,`,
+		`Set:` + fmt.Sprintf("%v", this.Set) + `,`,
+		`Updated:` + fmt.Sprintf("%v", this.Updated) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SamplingPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SamplingPolicy{`,
+		`Mode:` + fmt.Sprintf("%v", this.Mode) + `,`,
+		`RecordInterval:` + fmt.Sprintf("%v", this.RecordInterval) + `,`,
+		`UploadInterval:` + fmt.Sprintf("%v", this.UploadInterval) + `,`,
+		`MinDistance:` + fmt.Sprintf("%v", this.MinDistance) + `,`,
+		`RefreshInterval:` + fmt.Sprintf("%v", this.RefreshInterval) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SubmissionStatusRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SubmissionStatusRequest{`,
+		`Receipt:` + fmt.Sprintf("%v", this.Receipt) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SubmissionStatusResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRecords := "[]*RecordStatus{"
+	for _, f := range this.Records {
+		repeatedStringForRecords += strings.Replace(f.String(), "RecordStatus", "RecordStatus", 1) + ","
+	}
+	repeatedStringForRecords += "}"
+	s := strings.Join([]string{`&SubmissionStatusResponse{`,
+		`Receipt:` + fmt.Sprintf("%v", this.Receipt) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Records:` + repeatedStringForRecords + `,`,
+		`Created:` + fmt.Sprintf("%v", this.Created) + `,`,
+		`Processed:` + fmt.Sprintf("%v", this.Processed) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NewIdentifierRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NewIdentifierRequest{`,
+		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
+		`AutoPublish:` + fmt.Sprintf("%v", this.AutoPublish) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NewIdentifierResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NewIdentifierResponse{`,
+		`Document:` + fmt.Sprintf("%v", this.Document) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RegisterVenueRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RegisterVenueRequest{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Lat:` + fmt.Sprintf("%v", this.Lat) + `,`,
+		`Lng:` + fmt.Sprintf("%v", this.Lng) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CheckInRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRecords := "[]*CheckInRecord{"
+	for _, f := range this.Records {
+		repeatedStringForRecords += strings.Replace(fmt.Sprintf("%v", f), "CheckInRecord", "CheckInRecord", 1) + ","
+	}
+	repeatedStringForRecords += "}"
+	s := strings.Join([]string{`&CheckInRequest{`,
+		`Records:` + repeatedStringForRecords + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CheckInResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CheckInResponse{`,
+		`Ok:` + fmt.Sprintf("%v", this.Ok) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VenueOutbreakRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VenueOutbreakRequest{`,
+		`Venue:` + fmt.Sprintf("%v", this.Venue) + `,`,
+		`From:` + fmt.Sprintf("%v", this.From) + `,`,
+		`To:` + fmt.Sprintf("%v", this.To) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VenueOutbreakResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VenueOutbreakResponse{`,
+		`Ok:` + fmt.Sprintf("%v", this.Ok) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AnalyticsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AnalyticsRequest{`,
+		`From:` + fmt.Sprintf("%v", this.From) + `,`,
+		`To:` + fmt.Sprintf("%v", this.To) + `,`,
+		`Fields:` + strings.Replace(fmt.Sprintf("%v", this.Fields), "FieldMask", "types.FieldMask", 1) + `,`,
+		`Purpose:` + fmt.Sprintf("%v", this.Purpose) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AnalyticsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForHotspots := "[]*Hotspot{"
+	for _, f := range this.Hotspots {
+		repeatedStringForHotspots += strings.Replace(fmt.Sprintf("%v", f), "Hotspot", "Hotspot", 1) + ","
+	}
+	repeatedStringForHotspots += "}"
+	repeatedStringForFlows := "[]*Flow{"
+	for _, f := range this.Flows {
+		repeatedStringForFlows += strings.Replace(fmt.Sprintf("%v", f), "Flow", "Flow", 1) + ","
+	}
+	repeatedStringForFlows += "}"
+	s := strings.Join([]string{`&AnalyticsResponse{`,
+		`Hotspots:` + repeatedStringForHotspots + `,`,
+		`Flows:` + repeatedStringForFlows + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LabResultRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LabResultRequest{`,
+		`Resource:` + fmt.Sprintf("%v", this.Resource) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LabResultResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LabResultResponse{`,
+		`Ok:` + fmt.Sprintf("%v", this.Ok) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CertificateRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CertificateRequest{`,
+		`Diagnosis:` + fmt.Sprintf("%v", this.Diagnosis) + `,`,
+		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CertificateResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CertificateResponse{`,
+		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
+		`Credential:` + fmt.Sprintf("%v", this.Credential) + `,`,
+		`Qr:` + fmt.Sprintf("%v", this.Qr) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *IntrospectRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&IntrospectRequest{`,
+		`Token:` + fmt.Sprintf("%v", this.Token) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *IntrospectResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&IntrospectResponse{`,
+		`Active:` + fmt.Sprintf("%v", this.Active) + `,`,
+		`Sub:` + fmt.Sprintf("%v", this.Sub) + `,`,
+		`Did:` + fmt.Sprintf("%v", this.Did) + `,`,
+		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
+		`Lang:` + fmt.Sprintf("%v", this.Lang) + `,`,
+		`Iss:` + fmt.Sprintf("%v", this.Iss) + `,`,
+		`Aud:` + fmt.Sprintf("%v", this.Aud) + `,`,
+		`Exp:` + fmt.Sprintf("%v", this.Exp) + `,`,
+		`Iat:` + fmt.Sprintf("%v", this.Iat) + `,`,
+		`Nbf:` + fmt.Sprintf("%v", this.Nbf) + `,`,
+		`Jti:` + fmt.Sprintf("%v", this.Jti) + `,`,
+		`Scope:` + fmt.Sprintf("%v", this.Scope) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AckRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AckRequest{`,
+		`Notifications:` + fmt.Sprintf("%v", this.Notifications) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AckResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AckResponse{`,
+		`Updated:` + fmt.Sprintf("%v", this.Updated) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NotificationStatusRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NotificationStatusRequest{`,
+		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NotificationStatusResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NotificationStatusResponse{`,
+		`Total:` + fmt.Sprintf("%v", this.Total) + `,`,
+		`Pending:` + fmt.Sprintf("%v", this.Pending) + `,`,
+		`Dispatched:` + fmt.Sprintf("%v", this.Dispatched) + `,`,
+		`Failed:` + fmt.Sprintf("%v", this.Failed) + `,`,
+		`Delivered:` + fmt.Sprintf("%v", this.Delivered) + `,`,
+		`Read:` + fmt.Sprintf("%v", this.Read) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GeoPoint) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GeoPoint{`,
+		`Lat:` + fmt.Sprintf("%v", this.Lat) + `,`,
+		`Lng:` + fmt.Sprintf("%v", this.Lng) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ExposureQueryRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForArea := "[]*GeoPoint{"
+	for _, f := range this.Area {
+		repeatedStringForArea += strings.Replace(f.String(), "GeoPoint", "GeoPoint", 1) + ","
+	}
+	repeatedStringForArea += "}"
+	s := strings.Join([]string{`&ExposureQueryRequest{`,
+		`Area:` + repeatedStringForArea + `,`,
+		`From:` + fmt.Sprintf("%v", this.From) + `,`,
+		`To:` + fmt.Sprintf("%v", this.To) + `,`,
+		`Identifiers:` + fmt.Sprintf("%v", this.Identifiers) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`Purpose:` + fmt.Sprintf("%v", this.Purpose) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ExposureQueryResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPresence := "[]*Presence{"
+	for _, f := range this.Presence {
+		repeatedStringForPresence += strings.Replace(f.String(), "Presence", "Presence", 1) + ","
+	}
+	repeatedStringForPresence += "}"
+	s := strings.Join([]string{`&ExposureQueryResponse{`,
+		`Presence:` + repeatedStringForPresence + `,`,
+		`Users:` + fmt.Sprintf("%v", this.Users) + `,`,
+		`Identifiers:` + fmt.Sprintf("%v", this.Identifiers) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Presence) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Presence{`,
+		`Cell:` + fmt.Sprintf("%v", this.Cell) + `,`,
+		`Users:` + fmt.Sprintf("%v", this.Users) + `,`,
+		`From:` + fmt.Sprintf("%v", this.From) + `,`,
+		`To:` + fmt.Sprintf("%v", this.To) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Session) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Session{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
+		`Created:` + fmt.Sprintf("%v", this.Created) + `,`,
+		`Issued:` + fmt.Sprintf("%v", this.Issued) + `,`,
+		`Expires:` + fmt.Sprintf("%v", this.Expires) + `,`,
+		`Current:` + fmt.Sprintf("%v", this.Current) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListSessionsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSessions := "[]*Session{"
+	for _, f := range this.Sessions {
+		repeatedStringForSessions += strings.Replace(f.String(), "Session", "Session", 1) + ","
+	}
+	repeatedStringForSessions += "}"
+	s := strings.Join([]string{`&ListSessionsResponse{`,
+		`Sessions:` + repeatedStringForSessions + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RevokeSessionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RevokeSessionRequest{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SendMessageResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SendMessageResponse{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Created:` + fmt.Sprintf("%v", this.Created) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MessagesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MessagesRequest{`,
+		`Since:` + fmt.Sprintf("%v", this.Since) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MessagesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForMessages := "[]*EncryptedMessage{"
+	for _, f := range this.Messages {
+		repeatedStringForMessages += strings.Replace(fmt.Sprintf("%v", f), "EncryptedMessage", "EncryptedMessage", 1) + ","
+	}
+	repeatedStringForMessages += "}"
+	s := strings.Join([]string{`&MessagesResponse{`,
+		`Messages:` + repeatedStringForMessages + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringTrackingServerApi(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *PingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackingServerApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ok", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ok = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActivationCodeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackingServerApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActivationCodeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActivationCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scope = append(m.Scope, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Device", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Device = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActivationCodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackingServerApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActivationCodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActivationCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivationCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BulkActivationCodesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackingServerApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BulkActivationCodesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BulkActivationCodesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Campaign", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Campaign = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scope = append(m.Scope, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QrImages", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QrImages = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BulkActivationCodesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackingServerApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BulkActivationCodesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BulkActivationCodesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Codes = append(m.Codes, &CampaignCode{})
+			if err := m.Codes[len(m.Codes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CampaignCode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackingServerApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CampaignCode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CampaignCode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivationCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Campaign", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Campaign = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QrCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QrCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QrImage", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QrImage = append(m.QrImage[:0], dAtA[iNdEx:postIndex]...)
+			if m.QrImage == nil {
+				m.QrImage = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expires", wireType)
+			}
+			m.Expires = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expires |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CredentialsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackingServerApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CredentialsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CredentialsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivationCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lang", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lang = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scope = append(m.Scope, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attestation == nil {
+				m.Attestation = &DeviceAttestation{}
+			}
+			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Device", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Device = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeviceAttestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackingServerApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeviceAttestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeviceAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Platform = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RenewCredentialsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackingServerApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RenewCredentialsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RenewCredentialsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefreshCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefreshCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CredentialsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackingServerApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CredentialsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CredentialsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefreshCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefreshCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackingServerApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &LocationRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SdkVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SdkVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Platform = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackingServerApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ok", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ok = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &RecordStatus{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receipt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackingServerApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckExposureRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackingServerApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckExposureRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckExposureRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefixes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefixes = append(m.Prefixes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckExposureResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackingServerApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckExposureResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckExposureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+			}
+			m.Updated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Updated |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PSIRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackingServerApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PSIRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PSIRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blinded", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blinded = append(m.Blinded, make([]byte, postIndex-iNdEx))
+			copy(m.Blinded[len(m.Blinded)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PSIResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackingServerApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PSIResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PSIResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evaluated", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evaluated = append(m.Evaluated, make([]byte, postIndex-iNdEx))
+			copy(m.Evaluated[len(m.Evaluated)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Set", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Set = append(m.Set, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+			}
+			m.Updated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Updated |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SamplingPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackingServerApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SamplingPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SamplingPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordInterval", wireType)
+			}
+			m.RecordInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordInterval |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadInterval", wireType)
+			}
+			m.UploadInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadInterval |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDistance", wireType)
+			}
+			m.MinDistance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinDistance |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefreshInterval", wireType)
+			}
+			m.RefreshInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RefreshInterval |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubmissionStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackingServerApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubmissionStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubmissionStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receipt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubmissionStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackingServerApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubmissionStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubmissionStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receipt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &RecordStatus{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			m.Created = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Created |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Processed", wireType)
+			}
+			m.Processed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Processed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NewIdentifierRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackingServerApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NewIdentifierRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NewIdentifierRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoPublish", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoPublish = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NewIdentifierResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackingServerApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NewIdentifierResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NewIdentifierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Document", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Document = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterVenueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackingServerApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterVenueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterVenueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lat", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Lat = float32(math.Float32frombits(v))
+		case 3:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lng", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Lng = float32(math.Float32frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckInRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackingServerApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckInRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &CheckInRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckInResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackingServerApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckInResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ok", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ok = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VenueOutbreakRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackingServerApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VenueOutbreakRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VenueOutbreakRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Venue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Venue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			m.From = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.From |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			m.To = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.To |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VenueOutbreakResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackingServerApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VenueOutbreakResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VenueOutbreakResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ok", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ok = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AnalyticsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackingServerApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnalyticsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnalyticsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			m.From = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.From |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			m.To = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.To |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fields == nil {
+				m.Fields = &types.FieldMask{}
+			}
+			if err := m.Fields.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purpose", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Purpose = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AnalyticsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackingServerApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnalyticsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnalyticsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hotspots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hotspots = append(m.Hotspots, &Hotspot{})
+			if err := m.Hotspots[len(m.Hotspots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Flows = append(m.Flows, &Flow{})
+			if err := m.Flows[len(m.Flows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LabResultRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackingServerApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LabResultRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LabResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resource = append(m.Resource[:0], dAtA[iNdEx:postIndex]...)
+			if m.Resource == nil {
+				m.Resource = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LabResultResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackingServerApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LabResultResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LabResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ok", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ok = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CertificateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackingServerApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CertificateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CertificateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Diagnosis", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Diagnosis = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CertificateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackingServerApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CertificateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CertificateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credential", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Credential = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Qr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Qr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IntrospectRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackingServerApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IntrospectRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IntrospectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IntrospectResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackingServerApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IntrospectResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IntrospectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sub", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sub = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lang", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lang = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Iss", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Iss = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aud", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aud = append(m.Aud, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exp", wireType)
+			}
+			m.Exp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Iat", wireType)
+			}
+			m.Iat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Iat |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nbf", wireType)
+			}
+			m.Nbf = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nbf |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jti", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Jti = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scope = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AckRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackingServerApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AckRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AckRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Notifications", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Notifications = append(m.Notifications, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AckResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackingServerApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AckResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AckResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+			}
+			m.Updated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Updated |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NotificationStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackingServerApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NotificationStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NotificationStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NotificationStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackingServerApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NotificationStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NotificationStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			m.Pending = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pending |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dispatched", wireType)
+			}
+			m.Dispatched = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Dispatched |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failed |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delivered", wireType)
+			}
+			m.Delivered = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Delivered |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Read", wireType)
+			}
+			m.Read = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Read |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GeoPoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackingServerApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GeoPoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GeoPoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lat", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Lat = float32(math.Float32frombits(v))
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lng", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Lng = float32(math.Float32frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	s := strings.Join([]string{`&NewIdentifierResponse{`,
-		`Document:` + fmt.Sprintf("%v", this.Document) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringTrackingServerApi(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *PingResponse) Unmarshal(dAtA []byte) error {
+func (m *ExposureQueryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2626,15 +18956,87 @@ func (m *PingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExposureQueryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExposureQueryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Area", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Area = append(m.Area, &GeoPoint{})
+			if err := m.Area[len(m.Area)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ok", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			m.From = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.From |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			m.To = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.To |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifiers", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -2651,7 +19053,71 @@ func (m *PingResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Ok = bool(v != 0)
+			m.Identifiers = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purpose", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackingServerApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Purpose = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
@@ -2677,7 +19143,7 @@ func (m *PingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ActivationCodeRequest) Unmarshal(dAtA []byte) error {
+func (m *ExposureQueryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2700,17 +19166,17 @@ func (m *ActivationCodeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActivationCodeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExposureQueryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActivationCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExposureQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Presence", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTrackingServerApi
@@ -2720,27 +19186,48 @@ func (m *ActivationCodeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTrackingServerApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTrackingServerApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Did = string(dAtA[iNdEx:postIndex])
+			m.Presence = append(m.Presence, &Presence{})
+			if err := m.Presence[len(m.Presence)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Users", wireType)
+			}
+			m.Users = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Users |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifiers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2768,7 +19255,7 @@ func (m *ActivationCodeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Role = string(dAtA[iNdEx:postIndex])
+			m.Identifiers = append(m.Identifiers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2795,7 +19282,7 @@ func (m *ActivationCodeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ActivationCodeResponse) Unmarshal(dAtA []byte) error {
+func (m *Presence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2818,15 +19305,15 @@ func (m *ActivationCodeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActivationCodeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Presence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActivationCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Presence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivationCode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cell", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2854,8 +19341,65 @@ func (m *ActivationCodeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActivationCode = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Cell = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Users", wireType)
+			}
+			m.Users = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Users |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			m.From = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.From |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			m.To = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.To |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
@@ -2881,7 +19425,7 @@ func (m *ActivationCodeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CredentialsRequest) Unmarshal(dAtA []byte) error {
+func (m *Session) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2904,15 +19448,15 @@ func (m *CredentialsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CredentialsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Session: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CredentialsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Session: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2940,7 +19484,7 @@ func (m *CredentialsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Did = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -2975,10 +19519,10 @@ func (m *CredentialsRequest) Unmarshal(dAtA []byte) error {
 			m.Role = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivationCode", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
 			}
-			var stringLen uint64
+			m.Created = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTrackingServerApi
@@ -2988,29 +19532,16 @@ func (m *CredentialsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Created |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTrackingServerApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTrackingServerApi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ActivationCode = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issued", wireType)
 			}
-			var byteLen int
+			m.Issued = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTrackingServerApi
@@ -3020,26 +19551,50 @@ func (m *CredentialsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Issued |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTrackingServerApi
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expires", wireType)
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTrackingServerApi
+			m.Expires = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expires |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
 			}
-			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
-			if m.Proof == nil {
-				m.Proof = []byte{}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackingServerApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.Current = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
@@ -3065,7 +19620,7 @@ func (m *CredentialsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RenewCredentialsRequest) Unmarshal(dAtA []byte) error {
+func (m *ListSessionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3088,17 +19643,17 @@ func (m *RenewCredentialsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RenewCredentialsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListSessionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RenewCredentialsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListSessionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RefreshCode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTrackingServerApi
@@ -3108,23 +19663,25 @@ func (m *RenewCredentialsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTrackingServerApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTrackingServerApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RefreshCode = string(dAtA[iNdEx:postIndex])
+			m.Sessions = append(m.Sessions, &Session{})
+			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3151,7 +19708,7 @@ func (m *RenewCredentialsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CredentialsResponse) Unmarshal(dAtA []byte) error {
+func (m *RevokeSessionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3174,47 +19731,15 @@ func (m *CredentialsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CredentialsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RevokeSessionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CredentialsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RevokeSessionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessToken", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTrackingServerApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTrackingServerApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTrackingServerApi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AccessToken = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RefreshCode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3242,7 +19767,7 @@ func (m *CredentialsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RefreshCode = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3269,7 +19794,7 @@ func (m *CredentialsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordRequest) Unmarshal(dAtA []byte) error {
+func (m *SendMessageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3292,17 +19817,17 @@ func (m *RecordRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SendMessageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SendMessageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTrackingServerApi
@@ -3312,85 +19837,29 @@ func (m *RecordRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTrackingServerApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTrackingServerApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Records = append(m.Records, &LocationRecord{})
-			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTrackingServerApi
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthTrackingServerApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RecordResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTrackingServerApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RecordResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ok", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
 			}
-			var v int
+			m.Created = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTrackingServerApi
@@ -3400,12 +19869,11 @@ func (m *RecordResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Created |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Ok = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
@@ -3431,7 +19899,7 @@ func (m *RecordResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NewIdentifierRequest) Unmarshal(dAtA []byte) error {
+func (m *MessagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3454,49 +19922,17 @@ func (m *NewIdentifierRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NewIdentifierRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MessagesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NewIdentifierRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTrackingServerApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTrackingServerApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTrackingServerApi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Method = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoPublish", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
 			}
-			var v int
+			m.Since = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTrackingServerApi
@@ -3506,12 +19942,11 @@ func (m *NewIdentifierRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Since |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AutoPublish = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTrackingServerApi(dAtA[iNdEx:])
@@ -3537,7 +19972,7 @@ func (m *NewIdentifierRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NewIdentifierResponse) Unmarshal(dAtA []byte) error {
+func (m *MessagesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3560,17 +19995,17 @@ func (m *NewIdentifierResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NewIdentifierResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MessagesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NewIdentifierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Document", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTrackingServerApi
@@ -3580,23 +20015,25 @@ func (m *NewIdentifierResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTrackingServerApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTrackingServerApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Document = string(dAtA[iNdEx:postIndex])
+			m.Messages = append(m.Messages, &EncryptedMessage{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -27,7 +27,31 @@ func (this *ActivationCodeRequest) Validate() error {
 func (this *ActivationCodeResponse) Validate() error {
 	return nil
 }
+func (this *BulkActivationCodesRequest) Validate() error {
+	return nil
+}
+func (this *BulkActivationCodesResponse) Validate() error {
+	for _, item := range this.Codes {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Codes", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *CampaignCode) Validate() error {
+	return nil
+}
 func (this *CredentialsRequest) Validate() error {
+	if this.Attestation != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Attestation); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Attestation", err)
+		}
+	}
+	return nil
+}
+func (this *DeviceAttestation) Validate() error {
 	return nil
 }
 func (this *RenewCredentialsRequest) Validate() error {
@@ -47,6 +71,44 @@ func (this *RecordRequest) Validate() error {
 	return nil
 }
 func (this *RecordResponse) Validate() error {
+	for _, item := range this.Records {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Records", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *RecordStatus) Validate() error {
+	return nil
+}
+func (this *CheckExposureRequest) Validate() error {
+	return nil
+}
+func (this *CheckExposureResponse) Validate() error {
+	return nil
+}
+func (this *PSIRequest) Validate() error {
+	return nil
+}
+func (this *PSIResponse) Validate() error {
+	return nil
+}
+func (this *SamplingPolicy) Validate() error {
+	return nil
+}
+func (this *SubmissionStatusRequest) Validate() error {
+	return nil
+}
+func (this *SubmissionStatusResponse) Validate() error {
+	for _, item := range this.Records {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Records", err)
+			}
+		}
+	}
 	return nil
 }
 func (this *NewIdentifierRequest) Validate() error {
@@ -55,3 +117,138 @@ func (this *NewIdentifierRequest) Validate() error {
 func (this *NewIdentifierResponse) Validate() error {
 	return nil
 }
+func (this *RegisterVenueRequest) Validate() error {
+	return nil
+}
+func (this *CheckInRequest) Validate() error {
+	for _, item := range this.Records {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Records", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *CheckInResponse) Validate() error {
+	return nil
+}
+func (this *VenueOutbreakRequest) Validate() error {
+	return nil
+}
+func (this *VenueOutbreakResponse) Validate() error {
+	return nil
+}
+func (this *AnalyticsRequest) Validate() error {
+	if this.Fields != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Fields); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Fields", err)
+		}
+	}
+	return nil
+}
+func (this *AnalyticsResponse) Validate() error {
+	for _, item := range this.Hotspots {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Hotspots", err)
+			}
+		}
+	}
+	for _, item := range this.Flows {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Flows", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *LabResultRequest) Validate() error {
+	return nil
+}
+func (this *LabResultResponse) Validate() error {
+	return nil
+}
+func (this *CertificateRequest) Validate() error {
+	return nil
+}
+func (this *CertificateResponse) Validate() error {
+	return nil
+}
+func (this *IntrospectRequest) Validate() error {
+	return nil
+}
+func (this *IntrospectResponse) Validate() error {
+	return nil
+}
+func (this *AckRequest) Validate() error {
+	return nil
+}
+func (this *AckResponse) Validate() error {
+	return nil
+}
+func (this *NotificationStatusRequest) Validate() error {
+	return nil
+}
+func (this *NotificationStatusResponse) Validate() error {
+	return nil
+}
+func (this *GeoPoint) Validate() error {
+	return nil
+}
+func (this *ExposureQueryRequest) Validate() error {
+	for _, item := range this.Area {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Area", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *ExposureQueryResponse) Validate() error {
+	for _, item := range this.Presence {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Presence", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *Presence) Validate() error {
+	return nil
+}
+func (this *Session) Validate() error {
+	return nil
+}
+func (this *ListSessionsResponse) Validate() error {
+	for _, item := range this.Sessions {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Sessions", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *RevokeSessionRequest) Validate() error {
+	return nil
+}
+func (this *SendMessageResponse) Validate() error {
+	return nil
+}
+func (this *MessagesRequest) Validate() error {
+	return nil
+}
+func (this *MessagesResponse) Validate() error {
+	for _, item := range this.Messages {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Messages", err)
+			}
+		}
+	}
+	return nil
+}
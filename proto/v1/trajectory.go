@@ -0,0 +1,241 @@
+package protov1
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"strings"
+)
+
+// ContentTypeTrajectory identifies the compact format for location records
+// submitted through the HTTP gateway, see 'EncodeTrajectory'.
+const ContentTypeTrajectory = "application/vnd.ct19.trajectory+json"
+
+// Coordinate encodings supported by the compact trajectory format.
+const (
+	// Latitude and longitude delta-encoded as lists of integers.
+	TrajectoryDelta = "delta"
+
+	// Latitude and longitude as an encoded polyline with a precision of
+	// 6 decimal places.
+	TrajectoryPolyline = "polyline"
+)
+
+// Values are encoded as integers with the same precision used to calculate
+// record hashes, so the records restored produce the same hash and their
+// proofs remain valid.
+const trajectoryScale = 1e6
+
+// Compact representation for the records on a request. All records belong
+// to the same DID; timestamps, coordinates and altitudes are encoded as the
+// difference with the previous record. Hashes are not included, they are
+// calculated when restoring the records.
+type trajectory struct {
+	DID           string   `json:"did"`
+	AppVersion    string   `json:"app_version,omitempty"`
+	SdkVersion    string   `json:"sdk_version,omitempty"`
+	Platform      string   `json:"platform,omitempty"`
+	Encoding      string   `json:"encoding"`
+	AggregateOnly bool     `json:"aggregate_only,omitempty"`
+	Timestamps    []int64  `json:"timestamps"`
+	Lat           []int64  `json:"lat,omitempty"`
+	Lng           []int64  `json:"lng,omitempty"`
+	Polyline      string   `json:"polyline,omitempty"`
+	Alt           []int64  `json:"alt,omitempty"`
+	Proofs        [][]byte `json:"proofs"`
+}
+
+// EncodeTrajectory returns the compact representation of a records request,
+// using the "delta" or "polyline" coordinates encoding. All records must be
+// produced by the same DID and be either aggregate-only or regular records.
+func (req *RecordRequest) EncodeTrajectory(encoding string) ([]byte, error) {
+	if len(req.Records) == 0 {
+		return nil, errors.New("no records to encode")
+	}
+	first := req.Records[0]
+	tr := &trajectory{
+		DID:           first.Did,
+		AppVersion:    req.AppVersion,
+		SdkVersion:    req.SdkVersion,
+		Platform:      req.Platform,
+		Encoding:      encoding,
+		AggregateOnly: first.AggregateOnly,
+	}
+	var prevTs, prevAlt int64
+	var lat, lng, alt []int64
+	withAlt := false
+	for _, r := range req.Records {
+		if r.Did != first.Did || r.AggregateOnly != first.AggregateOnly {
+			return nil, errors.New("records must share the same DID and aggregate flag")
+		}
+		tr.Timestamps = append(tr.Timestamps, r.Timestamp-prevTs)
+		tr.Proofs = append(tr.Proofs, r.Proof)
+		prevTs = r.Timestamp
+		lat = append(lat, scaled(r.Lat))
+		lng = append(lng, scaled(r.Lng))
+		alt = append(alt, scaled(r.Alt)-prevAlt)
+		prevAlt = scaled(r.Alt)
+		withAlt = withAlt || r.Alt != 0
+	}
+	if withAlt {
+		tr.Alt = alt
+	}
+	switch encoding {
+	case TrajectoryDelta:
+		tr.Lat = deltas(lat)
+		tr.Lng = deltas(lng)
+	case TrajectoryPolyline:
+		tr.Polyline = encodePolyline(lat, lng)
+	default:
+		return nil, errors.New("unsupported coordinates encoding")
+	}
+	return json.Marshal(tr)
+}
+
+// DecodeTrajectory restores a records request from its compact
+// representation, as produced by 'EncodeTrajectory'.
+func DecodeTrajectory(data []byte) (*RecordRequest, error) {
+	tr := &trajectory{}
+	if err := json.Unmarshal(data, tr); err != nil {
+		return nil, errors.New("invalid trajectory contents")
+	}
+	var lat, lng []int64
+	switch tr.Encoding {
+	case TrajectoryDelta:
+		lat, lng = sums(tr.Lat), sums(tr.Lng)
+	case TrajectoryPolyline:
+		var err error
+		if lat, lng, err = decodePolyline(tr.Polyline); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.New("unsupported coordinates encoding")
+	}
+	total := len(tr.Timestamps)
+	if len(lat) != total || len(lng) != total || len(tr.Proofs) != total || (tr.Alt != nil && len(tr.Alt) != total) {
+		return nil, errors.New("inconsistent number of records")
+	}
+	timestamps := sums(tr.Timestamps)
+	alt := sums(tr.Alt)
+	req := &RecordRequest{
+		AppVersion: tr.AppVersion,
+		SdkVersion: tr.SdkVersion,
+		Platform:   tr.Platform,
+		Records:    make([]*LocationRecord, total),
+	}
+	for i := range req.Records {
+		r := &LocationRecord{
+			Did:           tr.DID,
+			Lat:           unscaled(lat[i]),
+			Lng:           unscaled(lng[i]),
+			Timestamp:     timestamps[i],
+			Proof:         tr.Proofs[i],
+			AggregateOnly: tr.AggregateOnly,
+		}
+		if alt != nil {
+			r.Alt = unscaled(alt[i])
+		}
+		r.Hash = r.GenerateHash()
+		req.Records[i] = r
+	}
+	return req, nil
+}
+
+func scaled(v float32) int64 {
+	return int64(math.Round(float64(v) * trajectoryScale))
+}
+
+func unscaled(v int64) float32 {
+	return float32(float64(v) / trajectoryScale)
+}
+
+// Difference of each value with the previous one.
+func deltas(list []int64) []int64 {
+	res := make([]int64, len(list))
+	prev := int64(0)
+	for i, v := range list {
+		res[i] = v - prev
+		prev = v
+	}
+	return res
+}
+
+// Restore delta-encoded values.
+func sums(list []int64) []int64 {
+	if list == nil {
+		return nil
+	}
+	res := make([]int64, len(list))
+	acc := int64(0)
+	for i, v := range list {
+		acc += v
+		res[i] = acc
+	}
+	return res
+}
+
+// Encode scaled coordinates using the polyline algorithm format.
+func encodePolyline(lat, lng []int64) string {
+	sb := strings.Builder{}
+	var prevLat, prevLng int64
+	for i := range lat {
+		polylineValue(&sb, lat[i]-prevLat)
+		polylineValue(&sb, lng[i]-prevLng)
+		prevLat, prevLng = lat[i], lng[i]
+	}
+	return sb.String()
+}
+
+func polylineValue(sb *strings.Builder, v int64) {
+	u := uint64(v) << 1
+	if v < 0 {
+		u = ^u
+	}
+	for u >= 0x20 {
+		sb.WriteByte(byte(0x20|(u&0x1f)) + 63)
+		u >>= 5
+	}
+	sb.WriteByte(byte(u) + 63)
+}
+
+// Decode scaled coordinates from a polyline.
+func decodePolyline(line string) ([]int64, []int64, error) {
+	var lat, lng []int64
+	var prevLat, prevLng int64
+	for pos := 0; pos < len(line); {
+		dLat, next, err := polylineRead(line, pos)
+		if err != nil {
+			return nil, nil, err
+		}
+		dLng, next, err := polylineRead(line, next)
+		if err != nil {
+			return nil, nil, err
+		}
+		prevLat += dLat
+		prevLng += dLng
+		lat = append(lat, prevLat)
+		lng = append(lng, prevLng)
+		pos = next
+	}
+	return lat, lng, nil
+}
+
+func polylineRead(line string, pos int) (int64, int, error) {
+	var u uint64
+	for shift := uint(0); shift < 64; shift += 5 {
+		if pos >= len(line) || line[pos] < 63 || line[pos] > 126 {
+			return 0, 0, errors.New("invalid polyline")
+		}
+		b := uint64(line[pos] - 63)
+		pos++
+		u |= (b & 0x1f) << shift
+		if b < 0x20 {
+			v := int64(u >> 1)
+			if u&1 != 0 {
+				v = ^v
+			}
+			return v, pos, nil
+		}
+	}
+	return 0, 0, errors.New("invalid polyline")
+}
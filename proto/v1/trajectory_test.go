@@ -0,0 +1,56 @@
+package protov1
+
+import (
+	"testing"
+)
+
+func TestTrajectory(t *testing.T) {
+	req := &RecordRequest{AppVersion: "1.4.2", Platform: "android"}
+	for i, c := range [][3]float32{
+		{19.432608, -99.133209, 2240.5},
+		{19.432701, -99.133411, 2241},
+		{19.431999, -99.130001, 0},
+		{-33.868820, 151.209296, 0},
+		{0.000001, -0.000001, 0},
+	} {
+		r := &LocationRecord{
+			Did:       "did:bryk:4d1ba4f3-7a30-4d25-9c0c-03b3d8b4c8d4",
+			Lat:       c[0],
+			Lng:       c[1],
+			Alt:       c[2],
+			Timestamp: 1617235200 + int64(i*30),
+			Proof:     []byte(`{"signatureValue":"..."}`),
+		}
+		r.Hash = r.GenerateHash()
+		req.Records = append(req.Records, r)
+	}
+	for _, encoding := range []string{TrajectoryDelta, TrajectoryPolyline} {
+		data, err := req.EncodeTrajectory(encoding)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := DecodeTrajectory(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res.Records) != len(req.Records) || res.AppVersion != req.AppVersion {
+			t.Fatalf("%s: invalid request restored", encoding)
+		}
+		for i, r := range res.Records {
+			if r.Hash != req.Records[i].Hash || r.Timestamp != req.Records[i].Timestamp {
+				t.Errorf("%s: invalid record %d restored", encoding, i)
+			}
+		}
+	}
+
+	// Malformed contents
+	for _, data := range []string{
+		`{"encoding":"polyline","polyline":"_p~iF~ps|","timestamps":[1],"proofs":[""]}`,
+		`{"encoding":"delta","lat":[1,2],"lng":[1],"timestamps":[1],"proofs":[""]}`,
+		`{"encoding":"gzip","timestamps":[]}`,
+	} {
+		if _, err := DecodeTrajectory([]byte(data)); err == nil {
+			t.Errorf("expected error for: %s", data)
+		}
+	}
+}
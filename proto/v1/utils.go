@@ -3,11 +3,14 @@ package protov1
 import (
 	"crypto/sha256"
 	"fmt"
+	"math"
 	"strings"
 )
 
 // GenerateHash returns the corresponding hash value, in hex format, for the
-// record instance calculated as: SHA256(did|lat|lng|alt|timestamp)
+// record instance calculated as: SHA256(did|lat|lng|alt|timestamp). For
+// aggregate-only records the "aggregate" segment is appended, so the flag
+// is covered by the record's proof.
 func (lr *LocationRecord) GenerateHash() string {
 	segments := []string{
 		lr.Did,
@@ -16,6 +19,41 @@ func (lr *LocationRecord) GenerateHash() string {
 		fmt.Sprintf("%f", lr.Alt),
 		fmt.Sprintf("%d", lr.Timestamp),
 	}
+	if lr.AggregateOnly {
+		segments = append(segments, "aggregate")
+	}
+	h := sha256.Sum256([]byte(strings.Join(segments, "|")))
+	return fmt.Sprintf("%x", h)
+}
+
+// Generalize marks the record as aggregate-only, rounding its coordinates to
+// 2 decimal places (about 1km), truncating its timestamp to the hour and
+// removing its altitude. Must be called before signing the record.
+func (lr *LocationRecord) Generalize() {
+	lr.Lat = float32(math.Round(float64(lr.Lat)*100) / 100)
+	lr.Lng = float32(math.Round(float64(lr.Lng)*100) / 100)
+	lr.Alt = 0
+	lr.Timestamp -= lr.Timestamp % 3600
+	lr.AggregateOnly = true
+}
+
+// IsGeneralized returns true if the record's coordinates, timestamp and
+// altitude are generalized as required for aggregate-only records.
+func (lr *LocationRecord) IsGeneralized() bool {
+	rounded := func(v float32) bool {
+		return math.Abs(float64(v)*100-math.Round(float64(v)*100)) < 0.01
+	}
+	return rounded(lr.Lat) && rounded(lr.Lng) && lr.Alt == 0 && lr.Timestamp%3600 == 0
+}
+
+// GenerateHash returns the corresponding hash value, in hex format, for the
+// check-in record calculated as: SHA256(did|venue|timestamp)
+func (cr *CheckInRecord) GenerateHash() string {
+	segments := []string{
+		cr.Did,
+		cr.Venue,
+		fmt.Sprintf("%d", cr.Timestamp),
+	}
 	h := sha256.Sum256([]byte(strings.Join(segments, "|")))
 	return fmt.Sprintf("%x", h)
 }
@@ -0,0 +1,16 @@
+/*
+Package secrets provides access to the sensitive material required by the
+platform components, like TLS keys, the hash key and the credentials signing
+key, from different backends.
+
+Secrets are retrieved by name using a Provider instance. The default provider
+reads files from the server's home directory; secrets can also be kept on a
+HashiCorp Vault KV (version 2) engine. Providers can be wrapped with a cache to
+avoid contacting the backend on every use; cached values are refreshed when
+expired, enabling the rotation of secrets without restarting the server.
+
+Signing keys can also be kept on a cloud KMS (AWS KMS or GCP Cloud KMS). In
+that case the private key never leaves the service and a crypto.Signer
+instance is used to produce signatures remotely.
+*/
+package secrets
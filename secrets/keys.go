@@ -0,0 +1,123 @@
+package secrets
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+// Size of generated hash keys, in bytes.
+const hashKeySize = 32
+
+// Supported algorithms for signing keys.
+const (
+	// ECDSA P-384 key, PEM-encoded in SEC 1 format.
+	AlgES384 = "ES384"
+
+	// Ed25519 key, PEM-encoded in PKCS #8 format.
+	AlgEdDSA = "EdDSA"
+)
+
+// NewSigningKey returns a new PEM-encoded private key, suitable to sign
+// access credentials using the provided algorithm.
+func NewSigningKey(alg string) ([]byte, error) {
+	switch alg {
+	case AlgES384:
+		key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		der, err := x509.MarshalECPrivateKey(key)
+		if err != nil {
+			return nil, err
+		}
+		return pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), nil
+	case AlgEdDSA:
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		der, err := x509.MarshalPKCS8PrivateKey(key)
+		if err != nil {
+			return nil, err
+		}
+		return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+	default:
+		return nil, errors.Errorf("unsupported algorithm: %s", alg)
+	}
+}
+
+// NewHashKey returns a new random key for authenticated hash operations.
+func NewHashKey() ([]byte, error) {
+	hk := make([]byte, hashKeySize)
+	if _, err := rand.Read(hk); err != nil {
+		return nil, err
+	}
+	return hk, nil
+}
+
+// EnsureKeys generates the hash key and, if an algorithm is provided, the
+// signing key on the home directory when not available already.
+func EnsureKeys(home string, alg string) error {
+	gen := map[string]func() ([]byte, error){HashKey: NewHashKey}
+	if alg != "" {
+		gen[SigningKey] = func() ([]byte, error) { return NewSigningKey(alg) }
+	}
+	for name, fn := range gen {
+		file := HomeFile(home, name)
+		if _, err := os.Stat(file); err == nil {
+			continue
+		}
+		value, err := fn()
+		if err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(file, value, 0400); err != nil {
+			return errors.Wrapf(err, "failed to save %s", name)
+		}
+	}
+	return nil
+}
+
+// Rotate replaces a key on the home directory with a newly generated value.
+// For hash keys the previous value is kept to validate refresh codes issued
+// before the rotation. The algorithm is only required for signing keys.
+// Running servers pick up the new key once their cached values expire.
+func Rotate(home, name, alg string) error {
+	var (
+		value []byte
+		err   error
+	)
+	switch name {
+	case SigningKey:
+		value, err = NewSigningKey(alg)
+	case HashKey:
+		value, err = NewHashKey()
+	default:
+		return errors.Errorf("unsupported key: %s", name)
+	}
+	if err != nil {
+		return err
+	}
+	file := HomeFile(home, name)
+	if name == HashKey {
+		if err := os.Rename(file, HomeFile(home, PreviousHashKey)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	// Write the new value on a temporary file first to replace the key
+	// atomically
+	tmp := file + ".tmp"
+	if err := ioutil.WriteFile(tmp, value, 0400); err != nil {
+		return errors.Wrapf(err, "failed to save %s", name)
+	}
+	return os.Rename(tmp, file)
+}
@@ -0,0 +1,76 @@
+package secrets
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestKeys(t *testing.T) {
+	home, err := ioutil.TempDir("", "ct19-keys")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(home)
+	}()
+
+	// Generate keys
+	if err := EnsureKeys(home, AlgES384); err != nil {
+		t.Fatal(err)
+	}
+	dp := NewDirProvider(home)
+	sk, err := dp.Get(SigningKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, _ := pem.Decode(sk)
+	if block == nil {
+		t.Fatal("invalid signing key")
+	}
+	if _, err := x509.ParseECPrivateKey(block.Bytes); err != nil {
+		t.Fatal(err)
+	}
+	hk, err := dp.Get(HashKey)
+	if err != nil || len(hk) != hashKeySize {
+		t.Fatal("invalid hash key")
+	}
+
+	// Existing keys are preserved
+	if err := EnsureKeys(home, AlgES384); err != nil {
+		t.Fatal(err)
+	}
+	if current, _ := dp.Get(HashKey); !bytes.Equal(current, hk) {
+		t.Error("hash key replaced")
+	}
+
+	// Rotate hash key
+	if err := Rotate(home, HashKey, ""); err != nil {
+		t.Fatal(err)
+	}
+	if prev, _ := dp.Get(PreviousHashKey); !bytes.Equal(prev, hk) {
+		t.Error("previous hash key not preserved")
+	}
+	if current, _ := dp.Get(HashKey); bytes.Equal(current, hk) {
+		t.Error("hash key not rotated")
+	}
+
+	// Rotate signing key, switching to Ed25519
+	if err := Rotate(home, SigningKey, AlgEdDSA); err != nil {
+		t.Fatal(err)
+	}
+	current, _ := dp.Get(SigningKey)
+	if bytes.Equal(current, sk) {
+		t.Error("signing key not rotated")
+	}
+	block, _ = pem.Decode(current)
+	if block == nil {
+		t.Fatal("invalid signing key")
+	}
+	if _, err := x509.ParsePKCS8PrivateKey(block.Bytes); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,224 @@
+package secrets
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.bryk.io/covid-tracking/utils"
+)
+
+// AWSKMSConfig provides the settings required to use an asymmetric key
+// stored on AWS KMS.
+type AWSKMSConfig struct {
+	// Key identifier, ARN or alias, i.e. "alias/ct19-signing".
+	KeyID string
+
+	// Service region.
+	Region string
+
+	// Optional service endpoint, by default "https://kms.<region>.amazonaws.com".
+	Endpoint string
+
+	// Access credentials.
+	AccessKey string
+	SecretKey string
+}
+
+// GCPKMSConfig provides the settings required to use an asymmetric key
+// stored on GCP Cloud KMS.
+type GCPKMSConfig struct {
+	// Full resource name of the key version, i.e.
+	// "projects/p/locations/l/keyRings/r/cryptoKeys/k/cryptoKeyVersions/1".
+	KeyVersion string
+
+	// Optional OAuth2 access token. If not provided, tokens for the default
+	// service account are obtained from the GCE metadata server.
+	Token string
+}
+
+// NewAWSKMSSigner returns a signer producing ECDSA signatures with a key
+// stored on AWS KMS.
+func NewAWSKMSSigner(conf *AWSKMSConfig) (crypto.Signer, error) {
+	if conf.Endpoint == "" {
+		conf.Endpoint = fmt.Sprintf("https://kms.%s.amazonaws.com", conf.Region)
+	}
+	ks := &awsSigner{
+		conf: conf,
+		hc:   &http.Client{Timeout: 10 * time.Second},
+	}
+	res := struct {
+		PublicKey []byte
+	}{}
+	if err := ks.call("GetPublicKey", map[string]interface{}{"KeyId": conf.KeyID}, &res); err != nil {
+		return nil, errors.Wrap(err, "failed to retrieve public key")
+	}
+	pub, err := x509.ParsePKIXPublicKey(res.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	ks.pub = pub
+	return ks, nil
+}
+
+type awsSigner struct {
+	conf *AWSKMSConfig
+	hc   *http.Client
+	pub  crypto.PublicKey
+}
+
+func (ks *awsSigner) Public() crypto.PublicKey {
+	return ks.pub
+}
+
+func (ks *awsSigner) Sign(_ io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	var alg string
+	switch opts.HashFunc() {
+	case crypto.SHA256:
+		alg = "ECDSA_SHA_256"
+	case crypto.SHA384:
+		alg = "ECDSA_SHA_384"
+	default:
+		return nil, errors.New("unsupported hash function")
+	}
+	req := map[string]interface{}{
+		"KeyId":            ks.conf.KeyID,
+		"Message":          digest,
+		"MessageType":      "DIGEST",
+		"SigningAlgorithm": alg,
+	}
+	res := struct {
+		Signature []byte
+	}{}
+	if err := ks.call("Sign", req, &res); err != nil {
+		return nil, err
+	}
+	return res.Signature, nil
+}
+
+// Execute a KMS API operation.
+func (ks *awsSigner) call(op string, input, output interface{}) error {
+	body, err := json.Marshal(input)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, ks.conf.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "TrentService."+op)
+	creds := utils.AWSCredentials{
+		AccessKey: ks.conf.AccessKey,
+		SecretKey: ks.conf.SecretKey,
+		Region:    ks.conf.Region,
+	}
+	utils.SignAWSRequest(req, body, creds, "kms", time.Now())
+	return doJSON(ks.hc, req, output)
+}
+
+// NewGCPKMSSigner returns a signer producing ECDSA signatures with a key
+// stored on GCP Cloud KMS.
+func NewGCPKMSSigner(conf *GCPKMSConfig) (crypto.Signer, error) {
+	ks := &gcpSigner{
+		conf:  conf,
+		hc:    &http.Client{Timeout: 10 * time.Second},
+		token: utils.NewGCPToken(conf.Token),
+	}
+	res := struct {
+		Pem string `json:"pem"`
+	}{}
+	if err := ks.call(http.MethodGet, "/publicKey", nil, &res); err != nil {
+		return nil, errors.Wrap(err, "failed to retrieve public key")
+	}
+	block, _ := pem.Decode([]byte(res.Pem))
+	if block == nil {
+		return nil, errors.New("invalid public key")
+	}
+	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	ks.pub = pub
+	return ks, nil
+}
+
+type gcpSigner struct {
+	conf  *GCPKMSConfig
+	hc    *http.Client
+	pub   crypto.PublicKey
+	token *utils.GCPToken
+}
+
+func (ks *gcpSigner) Public() crypto.PublicKey {
+	return ks.pub
+}
+
+func (ks *gcpSigner) Sign(_ io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	var alg string
+	switch opts.HashFunc() {
+	case crypto.SHA256:
+		alg = "sha256"
+	case crypto.SHA384:
+		alg = "sha384"
+	default:
+		return nil, errors.New("unsupported hash function")
+	}
+	req := map[string]interface{}{
+		"digest": map[string][]byte{alg: digest},
+	}
+	res := struct {
+		Signature []byte `json:"signature"`
+	}{}
+	if err := ks.call(http.MethodPost, ":asymmetricSign", req, &res); err != nil {
+		return nil, err
+	}
+	return res.Signature, nil
+}
+
+// Execute a Cloud KMS API operation on the key version.
+func (ks *gcpSigner) call(method, op string, input, output interface{}) error {
+	token, err := ks.token.Get()
+	if err != nil {
+		return err
+	}
+	var body io.Reader
+	if input != nil {
+		data, err := json.Marshal(input)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+	target := fmt.Sprintf("https://cloudkms.googleapis.com/v1/%s%s", strings.Trim(ks.conf.KeyVersion, "/"), op)
+	req, err := http.NewRequest(method, target, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+	return doJSON(ks.hc, req, output)
+}
+
+// Submit a request and decode its JSON response.
+func doJSON(hc *http.Client, req *http.Request, output interface{}) error {
+	res, err := hc.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	if res.StatusCode != http.StatusOK {
+		return errors.Errorf("request failed with status: %d", res.StatusCode)
+	}
+	return json.NewDecoder(res.Body).Decode(output)
+}
@@ -0,0 +1,119 @@
+package secrets
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Well-known secret names.
+const (
+	// PEM-encoded TLS certificate.
+	TLSCertificate = "tls.crt"
+
+	// PEM-encoded TLS private key.
+	TLSKey = "tls.key"
+
+	// Key used for authenticated hash operations, like refresh codes.
+	HashKey = "hash.key"
+
+	// Hash key in use before the last rotation.
+	PreviousHashKey = "hash.key.prev"
+
+	// PEM-encoded EC private key used to sign access credentials.
+	SigningKey = "signing.pem"
+)
+
+// Provider instances retrieve secret values by name.
+type Provider interface {
+	// Get returns the current value of a secret.
+	Get(name string) ([]byte, error)
+}
+
+// Location of secrets on the server's home directory.
+var homeFiles = map[string]string{
+	TLSCertificate:  filepath.Join("tls", "tls.crt"),
+	TLSKey:          filepath.Join("tls", "tls.key"),
+	HashKey:         "hash.key",
+	PreviousHashKey: "hash.key.prev",
+	SigningKey:      "signing.pem",
+}
+
+// HomeFile returns the location of a secret on a server's home directory.
+func HomeFile(home, name string) string {
+	file, ok := homeFiles[name]
+	if !ok {
+		file = name
+	}
+	return filepath.Clean(filepath.Join(home, file))
+}
+
+// NewDirProvider returns a provider reading secrets from the files in a
+// server's home directory.
+func NewDirProvider(home string) Provider {
+	return &dirProvider{home: home}
+}
+
+type dirProvider struct {
+	home string
+}
+
+func (dp *dirProvider) Get(name string) ([]byte, error) {
+	return ioutil.ReadFile(HomeFile(dp.home, name))
+}
+
+// Cache wraps a provider to keep retrieved values in memory for a period
+// of time. Expired values are retrieved again from the backend, if the
+// backend is not available the last known value is returned.
+type Cache struct {
+	src     Provider
+	ttl     time.Duration
+	entries map[string]*cacheEntry
+	mu      sync.Mutex
+}
+
+type cacheEntry struct {
+	value   []byte
+	expires time.Time
+}
+
+// NewCache returns a caching provider for 'src', values are kept for the
+// 'ttl' period.
+func NewCache(src Provider, ttl time.Duration) *Cache {
+	return &Cache{
+		src:     src,
+		ttl:     ttl,
+		entries: make(map[string]*cacheEntry),
+	}
+}
+
+// Get returns the value of a secret, from the cache when available.
+func (c *Cache) Get(name string) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[name]
+	if ok && time.Now().Before(entry.expires) {
+		return entry.value, nil
+	}
+	value, err := c.src.Get(name)
+	if err != nil {
+		if ok {
+			return entry.value, nil
+		}
+		return nil, err
+	}
+	c.entries[name] = &cacheEntry{value: value, expires: time.Now().Add(c.ttl)}
+	return value, nil
+}
+
+// Changed returns the new value of a secret if it's different from
+// 'current', or nil if the value hasn't changed.
+func (c *Cache) Changed(name string, current []byte) ([]byte, error) {
+	value, err := c.Get(name)
+	if err != nil || bytes.Equal(value, current) {
+		return nil, err
+	}
+	return value, nil
+}
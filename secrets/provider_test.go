@@ -0,0 +1,81 @@
+package secrets
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type sampleProvider struct {
+	values map[string]string
+	calls  int
+}
+
+func (sp *sampleProvider) Get(name string) ([]byte, error) {
+	sp.calls++
+	v, ok := sp.values[name]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return []byte(v), nil
+}
+
+func TestCache(t *testing.T) {
+	src := &sampleProvider{values: map[string]string{HashKey: "v1"}}
+	cache := NewCache(src, 50*time.Millisecond)
+
+	// Values are cached
+	for i := 0; i < 3; i++ {
+		if v, err := cache.Get(HashKey); err != nil || string(v) != "v1" {
+			t.Fatal("invalid value")
+		}
+	}
+	if src.calls != 1 {
+		t.Errorf("unexpected backend calls: %d", src.calls)
+	}
+
+	// Rotated values are detected once the entry expires
+	src.values[HashKey] = "v2"
+	if v, _ := cache.Changed(HashKey, []byte("v1")); v != nil {
+		t.Error("cached value expected")
+	}
+	time.Sleep(60 * time.Millisecond)
+	if v, _ := cache.Changed(HashKey, []byte("v1")); string(v) != "v2" {
+		t.Error("rotated value expected")
+	}
+
+	// Last known value is returned if the backend fails
+	delete(src.values, HashKey)
+	time.Sleep(60 * time.Millisecond)
+	if v, err := cache.Get(HashKey); err != nil || string(v) != "v2" {
+		t.Error("last known value expected")
+	}
+}
+
+func TestVaultProvider(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/secret/data/ct19/server" || r.Header.Get("X-Vault-Token") != "s.sample" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"data":{"hash.key":"super-secret"},"metadata":{"version":3}}}`))
+	}))
+	defer srv.Close()
+
+	vp, err := NewVaultProvider(&VaultConfig{
+		Address: srv.URL,
+		Token:   "s.sample",
+		Path:    "ct19/server",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, err := vp.Get(HashKey); err != nil || string(v) != "super-secret" {
+		t.Error("invalid secret value")
+	}
+	if _, err := vp.Get(TLSKey); err == nil {
+		t.Error("missing secret returned")
+	}
+}
@@ -0,0 +1,82 @@
+package secrets
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// VaultConfig provides the settings required to retrieve secrets from a
+// HashiCorp Vault KV (version 2) secrets engine.
+type VaultConfig struct {
+	// Vault server address, i.e. "https://vault.example.com:8200".
+	Address string
+
+	// Access token.
+	Token string
+
+	// Mount path of the KV secrets engine, "secret" by default.
+	Mount string
+
+	// Path of the secret holding the values. Each value is stored as a
+	// field using the secret name as key, i.e. "tls.key".
+	Path string
+}
+
+// NewVaultProvider returns a provider retrieving secrets from Vault.
+func NewVaultProvider(conf *VaultConfig) (Provider, error) {
+	if conf.Address == "" || conf.Path == "" {
+		return nil, errors.New("vault address and path are required")
+	}
+	if conf.Mount == "" {
+		conf.Mount = "secret"
+	}
+	return &vaultProvider{
+		conf: conf,
+		hc:   &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+type vaultProvider struct {
+	conf *VaultConfig
+	hc   *http.Client
+}
+
+func (vp *vaultProvider) Get(name string) ([]byte, error) {
+	target := fmt.Sprintf("%s/v1/%s/data/%s",
+		strings.TrimSuffix(vp.conf.Address, "/"),
+		strings.Trim(vp.conf.Mount, "/"),
+		strings.Trim(vp.conf.Path, "/"))
+	req, err := http.NewRequest(http.MethodGet, target, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Vault-Token", vp.conf.Token)
+	res, err := vp.hc.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	if res.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("vault request failed with status: %d", res.StatusCode)
+	}
+	secret := struct {
+		Data struct {
+			Data map[string]string `json:"data"`
+		} `json:"data"`
+	}{}
+	if err := json.NewDecoder(res.Body).Decode(&secret); err != nil {
+		return nil, errors.Wrap(err, "invalid vault response")
+	}
+	value, ok := secret.Data.Data[name]
+	if !ok {
+		return nil, errors.Errorf("secret not found: %s", name)
+	}
+	return []byte(value), nil
+}
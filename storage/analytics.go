@@ -0,0 +1,214 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/covid-tracking/utils"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ClusterRecords groups the location records registered during the provided
+// period of time into hotspots and movement flows, using geohash cells of the
+// requested precision. Only records tagged for analytics are used, and only
+// aggregates covering at least 'k' distinct users are returned.
+func (st *Handler) ClusterRecords(from, to time.Time, precision, k int) ([]*protov1.Hotspot, []*protov1.Flow, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 30*time.Minute)
+	defer cancel()
+
+	type transition struct{ origin, destination string }
+	cells := make(map[string]map[string]bool)
+	flows := make(map[transition]map[string]bool)
+
+	// Last cell visited by each user; partitions are traversed in chronological
+	// order, so movements spanning two months are also detected
+	last := make(map[string]string)
+	for _, name := range partitionsBetween(from, to) {
+		// Traverse records by user and date
+		pipeline := mongo.Pipeline{
+			{{Key: "$match", Value: bson.M{
+				"timestamp": bson.M{"$gte": from, "$lt": to},
+				"purposes":  PurposeAnalytics,
+			}}},
+			{{Key: "$project", Value: bson.M{"did": 1, "cell": 1, "timestamp": 1}}},
+			{{Key: "$sort", Value: bson.D{{Key: "did", Value: 1}, {Key: "timestamp", Value: 1}}}},
+		}
+		cur, err := st.db.Collection(name).Aggregate(ctx, pipeline, options.Aggregate().SetAllowDiskUse(true))
+		if err != nil {
+			return nil, nil, err
+		}
+		for cur.Next(ctx) {
+			entry := struct {
+				DID  string `bson:"did"`
+				Cell string `bson:"cell"`
+			}{}
+			if err := cur.Decode(&entry); err != nil {
+				_ = cur.Close(ctx)
+				return nil, nil, err
+			}
+			if len(entry.Cell) < precision {
+				continue
+			}
+			cell := entry.Cell[:precision]
+			if cells[cell] == nil {
+				cells[cell] = make(map[string]bool)
+			}
+			cells[cell][entry.DID] = true
+			if prev, ok := last[entry.DID]; ok && prev != cell {
+				t := transition{origin: prev, destination: cell}
+				if flows[t] == nil {
+					flows[t] = make(map[string]bool)
+				}
+				flows[t][entry.DID] = true
+			}
+			last[entry.DID] = cell
+		}
+		_ = cur.Close(ctx)
+	}
+
+	// Discard aggregates below the anonymity threshold
+	var hotspots []*protov1.Hotspot
+	for cell, users := range cells {
+		if len(users) < k {
+			continue
+		}
+		lat, lng := utils.GeoHashCenter(cell)
+		hotspots = append(hotspots, &protov1.Hotspot{
+			Cell:  cell,
+			Lat:   float32(lat),
+			Lng:   float32(lng),
+			Users: int64(len(users)),
+			From:  from.Unix(),
+			To:    to.Unix(),
+		})
+	}
+	var movements []*protov1.Flow
+	for t, users := range flows {
+		if len(users) < k {
+			continue
+		}
+		movements = append(movements, &protov1.Flow{
+			Origin:      t.origin,
+			Destination: t.destination,
+			Users:       int64(len(users)),
+			From:        from.Unix(),
+			To:          to.Unix(),
+		})
+	}
+	return hotspots, movements, nil
+}
+
+// SaveAnalytics stores the provided aggregates. Existing entries for the same
+// area and period are replaced.
+func (st *Handler) SaveAnalytics(hotspots []*protov1.Hotspot, flows []*protov1.Flow) error {
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Minute)
+	defer cancel()
+	col := st.db.Collection("analytics")
+	opts := options.Replace().SetUpsert(true)
+	for _, h := range hotspots {
+		key := bson.M{"kind": "hotspot", "cell": h.Cell, "from": time.Unix(h.From, 0), "to": time.Unix(h.To, 0)}
+		entry := bson.M{
+			"kind":  "hotspot",
+			"cell":  h.Cell,
+			"lat":   h.Lat,
+			"lng":   h.Lng,
+			"users": h.Users,
+			"from":  time.Unix(h.From, 0),
+			"to":    time.Unix(h.To, 0),
+		}
+		if _, err := col.ReplaceOne(ctx, key, entry, opts); err != nil {
+			return err
+		}
+	}
+	for _, f := range flows {
+		key := bson.M{
+			"kind":        "flow",
+			"origin":      f.Origin,
+			"destination": f.Destination,
+			"from":        time.Unix(f.From, 0),
+			"to":          time.Unix(f.To, 0),
+		}
+		entry := bson.M{
+			"kind":        "flow",
+			"origin":      f.Origin,
+			"destination": f.Destination,
+			"users":       f.Users,
+			"from":        time.Unix(f.From, 0),
+			"to":          time.Unix(f.To, 0),
+		}
+		if _, err := col.ReplaceOne(ctx, key, entry, opts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Analytics returns the stored aggregates for periods contained in the
+// provided time range.
+func (st *Handler) Analytics(from, to time.Time) ([]*protov1.Hotspot, []*protov1.Flow, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 30*time.Second)
+	defer cancel()
+	cur, err := st.db.Collection("analytics").Find(ctx, bson.M{
+		"from": bson.M{"$gte": from},
+		"to":   bson.M{"$lte": to},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() {
+		_ = cur.Close(ctx)
+	}()
+
+	var hotspots []*protov1.Hotspot
+	var flows []*protov1.Flow
+	for cur.Next(ctx) {
+		entry := struct {
+			Kind        string    `bson:"kind"`
+			Cell        string    `bson:"cell"`
+			Lat         float32   `bson:"lat"`
+			Lng         float32   `bson:"lng"`
+			Origin      string    `bson:"origin"`
+			Destination string    `bson:"destination"`
+			Users       int64     `bson:"users"`
+			From        time.Time `bson:"from"`
+			To          time.Time `bson:"to"`
+		}{}
+		if err := cur.Decode(&entry); err != nil {
+			return nil, nil, err
+		}
+		switch entry.Kind {
+		case "hotspot":
+			hotspots = append(hotspots, &protov1.Hotspot{
+				Cell:  entry.Cell,
+				Lat:   entry.Lat,
+				Lng:   entry.Lng,
+				Users: entry.Users,
+				From:  entry.From.Unix(),
+				To:    entry.To.Unix(),
+			})
+		case "flow":
+			flows = append(flows, &protov1.Flow{
+				Origin:      entry.Origin,
+				Destination: entry.Destination,
+				Users:       entry.Users,
+				From:        entry.From.Unix(),
+				To:          entry.To.Unix(),
+			})
+		}
+	}
+	return hotspots, flows, cur.Err()
+}
+
+// Indexes for analytics aggregates.
+func analyticsIndexes(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection("analytics").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "from", Value: 1},
+			{Key: "to", Value: 1},
+		},
+	})
+	return err
+}
@@ -0,0 +1,155 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// APIKeyRecord provides the details of a registered API key along with the
+// hashes of its valid secrets.
+type APIKeyRecord struct {
+	Key *protov1.APIKey
+
+	// Hash of the current secret.
+	Hash string
+
+	// Hash of the secret in use before the last rotation, accepted until
+	// 'PreviousExpires'.
+	PreviousHash    string
+	PreviousExpires time.Time
+}
+
+// Stored API key entry.
+type apiKeyEntry struct {
+	ID              string    `bson:"id"`
+	Name            string    `bson:"name"`
+	Role            string    `bson:"role"`
+	Scope           []string  `bson:"scope"`
+	RateLimit       uint32    `bson:"rate_limit"`
+	Created         time.Time `bson:"created"`
+	Rotated         time.Time `bson:"rotated"`
+	Hash            string    `bson:"hash"`
+	PreviousHash    string    `bson:"previous_hash"`
+	PreviousExpires time.Time `bson:"previous_expires"`
+}
+
+func (e *apiKeyEntry) key() *protov1.APIKey {
+	return &protov1.APIKey{
+		Id:        e.ID,
+		Name:      e.Name,
+		Role:      e.Role,
+		Scope:     e.Scope,
+		RateLimit: e.RateLimit,
+		Created:   e.Created.Unix(),
+		Rotated:   e.Rotated.Unix(),
+	}
+}
+
+// RegisterAPIKey adds a new API key entry. Only the hash of the key's secret
+// is stored.
+func (st *Handler) RegisterAPIKey(key *protov1.APIKey, hash string) error {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	_, err := st.db.Collection("api_keys").InsertOne(ctx, &apiKeyEntry{
+		ID:        key.Id,
+		Name:      key.Name,
+		Role:      key.Role,
+		Scope:     key.Scope,
+		RateLimit: key.RateLimit,
+		Created:   time.Unix(key.Created, 0),
+		Rotated:   time.Unix(key.Rotated, 0),
+		Hash:      hash,
+	})
+	return err
+}
+
+// APIKey returns the details of a registered API key.
+func (st *Handler) APIKey(id string) (*APIKeyRecord, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	entry := &apiKeyEntry{}
+	if err := st.db.Collection("api_keys").FindOne(ctx, bson.M{"id": id}).Decode(entry); err != nil {
+		return nil, err
+	}
+	return &APIKeyRecord{
+		Key:             entry.key(),
+		Hash:            entry.Hash,
+		PreviousHash:    entry.PreviousHash,
+		PreviousExpires: entry.PreviousExpires,
+	}, nil
+}
+
+// APIKeys returns the details of all registered API keys.
+func (st *Handler) APIKeys() ([]*protov1.APIKey, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+	defer cancel()
+	cur, err := st.db.Collection("api_keys").Find(ctx, bson.M{}, options.Find().SetSort(bson.M{"created": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cur.Close(ctx)
+	}()
+	var list []*protov1.APIKey
+	for cur.Next(ctx) {
+		entry := &apiKeyEntry{}
+		if err := cur.Decode(entry); err != nil {
+			return nil, err
+		}
+		list = append(list, entry.key())
+	}
+	return list, cur.Err()
+}
+
+// RotateAPIKey replaces the secret of an API key. The previous secret remains
+// valid for the provided grace period.
+func (st *Handler) RotateAPIKey(id, hash string, grace time.Duration) (*protov1.APIKey, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	col := st.db.Collection("api_keys")
+	entry := &apiKeyEntry{}
+	if err := col.FindOne(ctx, bson.M{"id": id}).Decode(entry); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	_, err := col.UpdateOne(ctx, bson.M{"id": id, "hash": entry.Hash}, bson.M{"$set": bson.M{
+		"hash":             hash,
+		"rotated":          now,
+		"previous_hash":    entry.Hash,
+		"previous_expires": now.Add(grace),
+	}})
+	if err != nil {
+		return nil, err
+	}
+	entry.Rotated = now
+	return entry.key(), nil
+}
+
+// RevokeAPIKey permanently removes an API key.
+func (st *Handler) RevokeAPIKey(id string) error {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	res, err := st.db.Collection("api_keys").DeleteOne(ctx, bson.M{"id": id})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return errors.New("unknown API key")
+	}
+	return nil
+}
+
+// Indexes for API keys.
+func apiKeyIndexes(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection("api_keys").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.M{"id": 1},
+		Options: options.Index().SetUnique(true),
+	})
+	return err
+}
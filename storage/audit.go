@@ -0,0 +1,89 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// AuditEntry describes a security-relevant event. Audit entries are not
+// subject to the retention policy.
+type AuditEntry struct {
+	// Event timestamp.
+	Timestamp time.Time `bson:"timestamp"`
+
+	// Event type, i.e. "credentials.failure".
+	Event string `bson:"event"`
+
+	// Entity responsible for the event, usually a DID.
+	Actor string `bson:"actor,omitempty"`
+
+	// Network address of the client, if available.
+	Address string `bson:"address,omitempty"`
+
+	// Additional event information.
+	Details map[string]string `bson:"details,omitempty"`
+}
+
+// Audit registers a new entry on the audit log.
+func (st *Handler) Audit(entry *AuditEntry) error {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	if entry.Timestamp.IsZero() {
+		entry.Timestamp = time.Now()
+	}
+	if _, err := st.db.Collection("audit").InsertOne(ctx, entry); err != nil {
+		return err
+	}
+	return st.appendLedger(ctx, LedgerAudit, []string{AuditDigest(entry)})
+}
+
+// AuditFilter selects the audit entries to export.
+type AuditFilter struct {
+	// Period of time covered.
+	From time.Time
+	To   time.Time
+
+	// Only include entries for a specific event type.
+	Event string
+}
+
+// ExportAudit traverses the audit entries matching the filter, in the order
+// they were registered, and passes them to 'fn'. Entries are read using a
+// cursor. The traversal stops if 'fn' returns an error or 'ctx' is done.
+func (st *Handler) ExportAudit(ctx context.Context, filter AuditFilter, fn func(*AuditEntry) error) error {
+	query := bson.M{"timestamp": bson.M{"$gte": filter.From, "$lte": filter.To}}
+	if filter.Event != "" {
+		query["event"] = filter.Event
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "timestamp", Value: 1}, {Key: "_id", Value: 1}})
+	cur, err := st.db.Collection("audit").Find(ctx, query, opts)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = cur.Close(ctx)
+	}()
+	for cur.Next(ctx) {
+		entry := &AuditEntry{}
+		if err := cur.Decode(entry); err != nil {
+			return err
+		}
+		if err := fn(entry); err != nil {
+			return err
+		}
+	}
+	return cur.Err()
+}
+
+// Indexes for the audit log.
+func auditIndexes(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection("audit").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.M{"timestamp": 1}},
+		{Keys: bson.D{{Key: "event", Value: 1}, {Key: "timestamp", Value: 1}}},
+	})
+	return err
+}
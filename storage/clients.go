@@ -0,0 +1,119 @@
+package storage
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Client application details stored with location records.
+type clientEntry struct {
+	AppVersion string `bson:"app_version,omitempty"`
+	SDKVersion string `bson:"sdk_version,omitempty"`
+	Platform   string `bson:"platform,omitempty"`
+}
+
+func (e *clientEntry) info() *protov1.ClientInfo {
+	if e == nil {
+		return nil
+	}
+	return &protov1.ClientInfo{
+		AppVersion: e.AppVersion,
+		SdkVersion: e.SDKVersion,
+		Platform:   e.Platform,
+	}
+}
+
+func newClientEntry(c *protov1.ClientInfo) *clientEntry {
+	if c == nil || (c.AppVersion == "" && c.SdkVersion == "" && c.Platform == "") {
+		return nil
+	}
+	return &clientEntry{
+		AppVersion: c.AppVersion,
+		SDKVersion: c.SdkVersion,
+		Platform:   c.Platform,
+	}
+}
+
+// ClientVersions returns the number of location records, and distinct users,
+// submitted by each client application version during the provided period
+// of time. Records submitted by clients not reporting its version are
+// grouped on a single entry without client details.
+func (st *Handler) ClientVersions(from, to time.Time) ([]*protov1.ClientVersionStats, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Minute)
+	defer cancel()
+
+	type version struct {
+		stats *protov1.ClientVersionStats
+		users map[string]bool
+	}
+	versions := make(map[clientEntry]*version)
+	for _, name := range partitionsBetween(from, to) {
+		pipeline := mongo.Pipeline{
+			{{Key: "$match", Value: bson.M{"timestamp": bson.M{"$gte": from, "$lt": to}}}},
+			{{Key: "$group", Value: bson.M{
+				"_id":     bson.M{"client": "$client", "did": "$did"},
+				"records": bson.M{"$sum": 1},
+				"last":    bson.M{"$max": "$timestamp"},
+			}}},
+		}
+		cur, err := st.db.Collection(name).Aggregate(ctx, pipeline, options.Aggregate().SetAllowDiskUse(true))
+		if err != nil {
+			return nil, err
+		}
+		for cur.Next(ctx) {
+			entry := struct {
+				ID struct {
+					Client *clientEntry `bson:"client"`
+					DID    string       `bson:"did"`
+				} `bson:"_id"`
+				Records int64     `bson:"records"`
+				Last    time.Time `bson:"last"`
+			}{}
+			if err := cur.Decode(&entry); err != nil {
+				_ = cur.Close(ctx)
+				return nil, err
+			}
+			key := clientEntry{}
+			if entry.ID.Client != nil {
+				key = *entry.ID.Client
+			}
+			v, ok := versions[key]
+			if !ok {
+				v = &version{
+					stats: &protov1.ClientVersionStats{},
+					users: make(map[string]bool),
+				}
+				if entry.ID.Client != nil {
+					v.stats.Client = key.info()
+				}
+				versions[key] = v
+			}
+			v.users[entry.ID.DID] = true
+			v.stats.Records += entry.Records
+			if last := entry.Last.Unix(); last > v.stats.LastSeen {
+				v.stats.LastSeen = last
+			}
+		}
+		err = cur.Err()
+		_ = cur.Close(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	list := make([]*protov1.ClientVersionStats, 0, len(versions))
+	for _, v := range versions {
+		v.stats.Users = int64(len(v.users))
+		list = append(list, v.stats)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Records > list[j].Records
+	})
+	return list, nil
+}
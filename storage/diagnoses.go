@@ -0,0 +1,190 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Diagnosis stores a new test result and return its complete details.
+func (st *Handler) Diagnosis(did, result, source string, date time.Time) (*protov1.Diagnosis, error) {
+	d := &protov1.Diagnosis{
+		Id:        uuid.New().String(),
+		Did:       did,
+		Result:    result,
+		Timestamp: date.Unix(),
+		Source:    source,
+	}
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	col, err := st.retained(ctx, "diagnoses", "timestamp")
+	if err != nil {
+		return nil, err
+	}
+	_, err = col.InsertOne(ctx, bson.M{
+		"id":        d.Id,
+		"did":       d.Did,
+		"result":    d.Result,
+		"timestamp": date,
+		"source":    d.Source,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// FindDiagnosis returns the details of a previously stored diagnosis.
+func (st *Handler) FindDiagnosis(id string) (*protov1.Diagnosis, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	entry := struct {
+		ID        string    `bson:"id"`
+		DID       string    `bson:"did"`
+		Result    string    `bson:"result"`
+		Timestamp time.Time `bson:"timestamp"`
+		Source    string    `bson:"source"`
+	}{}
+	if err := st.db.Collection("diagnoses").FindOne(ctx, bson.M{"id": id}).Decode(&entry); err != nil {
+		return nil, err
+	}
+	return &protov1.Diagnosis{
+		Id:        entry.ID,
+		Did:       entry.DID,
+		Result:    entry.Result,
+		Timestamp: entry.Timestamp.Unix(),
+		Source:    entry.Source,
+	}, nil
+}
+
+// Exposure registers a potential contagion risk for the user 'did' originated
+// by the provided diagnosis.
+func (st *Handler) Exposure(did string, diagnosis string) (*protov1.Exposure, error) {
+	e := &protov1.Exposure{
+		Id:        uuid.New().String(),
+		Did:       did,
+		Diagnosis: diagnosis,
+		Timestamp: time.Now().Unix(),
+	}
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	col, err := st.retained(ctx, "exposures", "timestamp")
+	if err != nil {
+		return nil, err
+	}
+	_, err = col.InsertOne(ctx, bson.M{
+		"id":        e.Id,
+		"did":       e.Did,
+		"diagnosis": e.Diagnosis,
+		"timestamp": time.Unix(e.Timestamp, 0),
+		"purposes":  exposurePurposes,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// PositiveDiagnoses returns all positive test results registered after
+// the provided date.
+func (st *Handler) PositiveDiagnoses(since time.Time) ([]*protov1.Diagnosis, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Second)
+	defer cancel()
+	cur, err := st.db.Collection("diagnoses").Find(ctx, bson.M{
+		"result":    "positive",
+		"timestamp": bson.M{"$gte": since},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cur.Close(ctx)
+	}()
+	var list []*protov1.Diagnosis
+	for cur.Next(ctx) {
+		entry := struct {
+			ID        string    `bson:"id"`
+			DID       string    `bson:"did"`
+			Result    string    `bson:"result"`
+			Timestamp time.Time `bson:"timestamp"`
+			Source    string    `bson:"source"`
+		}{}
+		if err := cur.Decode(&entry); err != nil {
+			return nil, err
+		}
+		list = append(list, &protov1.Diagnosis{
+			Id:        entry.ID,
+			Did:       entry.DID,
+			Result:    entry.Result,
+			Timestamp: entry.Timestamp.Unix(),
+			Source:    entry.Source,
+		})
+	}
+	return list, cur.Err()
+}
+
+// Exposed returns the identifiers of all users with a registered exposure
+// originated by the provided diagnosis.
+func (st *Handler) Exposed(diagnosis string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Second)
+	defer cancel()
+	list, err := st.db.Collection("exposures").Distinct(ctx, "did", bson.M{"diagnosis": diagnosis})
+	if err != nil {
+		return nil, err
+	}
+	users := make([]string, 0, len(list))
+	for _, id := range list {
+		if did, ok := id.(string); ok {
+			users = append(users, did)
+		}
+	}
+	return users, nil
+}
+
+// LastExposure returns the most recent exposure registered for the user
+// 'did', or nil if the user has no exposures.
+func (st *Handler) LastExposure(did string) (*protov1.Exposure, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	entry := struct {
+		ID        string    `bson:"id"`
+		DID       string    `bson:"did"`
+		Diagnosis string    `bson:"diagnosis"`
+		Timestamp time.Time `bson:"timestamp"`
+	}{}
+	opts := options.FindOne().SetSort(bson.M{"timestamp": -1})
+	err := st.db.Collection("exposures").FindOne(ctx, bson.M{"did": did}, opts).Decode(&entry)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &protov1.Exposure{
+		Id:        entry.ID,
+		Did:       entry.DID,
+		Diagnosis: entry.Diagnosis,
+		Timestamp: entry.Timestamp.Unix(),
+	}, nil
+}
+
+// Indexes for diagnoses and exposures.
+func diagnosisIndexes(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection("diagnoses").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.M{"id": 1}},
+		{Keys: bson.M{"did": 1}},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = db.Collection("exposures").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.M{"did": 1}},
+		{Keys: bson.M{"diagnosis": 1}},
+	})
+	return err
+}
@@ -1,4 +1,9 @@
 /*
 Package storage provides persistent-data management tools.
+
+Data access is grouped in repositories (CodesRepo, RecordsRepo, ExposuresRepo
+and NotificationsRepo) defined as interfaces, so features can be implemented
+and tested against alternative backends. The MongoDB Handler implements all
+of them.
 */
 package storage
@@ -0,0 +1,109 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// RecordsFilter selects the location records to export.
+type RecordsFilter struct {
+	// Period of time covered.
+	From time.Time
+	To   time.Time
+
+	// Only include records for a specific DID.
+	DID string
+
+	// Only include records on cells starting with the geohash prefix.
+	Cell string
+
+	// Only include records tagged with the processing purpose.
+	Purpose string
+}
+
+func (f RecordsFilter) query() bson.M {
+	query := bson.M{"timestamp": bson.M{"$gte": f.From, "$lte": f.To}}
+	if f.DID != "" {
+		query["did"] = f.DID
+	}
+	if f.Cell != "" {
+		query["cell"] = bson.M{"$regex": "^" + f.Cell}
+	}
+	if f.Purpose != "" {
+		query["purposes"] = f.Purpose
+	}
+	return query
+}
+
+// Stored location record.
+type recordEntry struct {
+	DID       string       `bson:"did"`
+	Timestamp time.Time    `bson:"timestamp"`
+	Hash      string       `bson:"hash"`
+	Proof     []byte       `bson:"proof"`
+	Aggregate bool         `bson:"aggregate"`
+	Client    *clientEntry `bson:"client"`
+	Location  struct {
+		Coordinates [2]float32 `bson:"coordinates"`
+	} `bson:"location"`
+}
+
+func (e *recordEntry) record() *protov1.LocationRecord {
+	return &protov1.LocationRecord{
+		Did:           e.DID,
+		Lat:           e.Location.Coordinates[1],
+		Lng:           e.Location.Coordinates[0],
+		Timestamp:     e.Timestamp.Unix(),
+		Hash:          e.Hash,
+		Proof:         e.Proof,
+		AggregateOnly: e.Aggregate,
+		Client:        e.Client.info(),
+	}
+}
+
+// ExportRecords traverses the location records matching the filter, in
+// chronological order, and passes them to 'fn' in batches of up to 'size'
+// records. Records are read using a cursor so only a single batch is kept in
+// memory. The traversal stops if 'fn' returns an error or 'ctx' is done.
+func (st *Handler) ExportRecords(ctx context.Context, filter RecordsFilter, size int,
+	fn func([]*protov1.LocationRecord) error) error {
+	opts := options.Find().
+		SetSort(bson.M{"timestamp": 1}).
+		SetBatchSize(int32(size))
+	batch := make([]*protov1.LocationRecord, 0, size)
+	for _, name := range partitionsBetween(filter.From, filter.To) {
+		cur, err := st.db.Collection(name).Find(ctx, filter.query(), opts)
+		if err != nil {
+			return err
+		}
+		for cur.Next(ctx) {
+			entry := &recordEntry{}
+			if err := cur.Decode(entry); err != nil {
+				_ = cur.Close(ctx)
+				return err
+			}
+			batch = append(batch, entry.record())
+			if len(batch) < size {
+				continue
+			}
+			if err := fn(batch); err != nil {
+				_ = cur.Close(ctx)
+				return err
+			}
+			batch = make([]*protov1.LocationRecord, 0, size)
+		}
+		err = cur.Err()
+		_ = cur.Close(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	if len(batch) > 0 {
+		return fn(batch)
+	}
+	return nil
+}
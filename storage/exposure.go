@@ -0,0 +1,67 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Presence describes the users with location records on a given cell and
+// time bucket.
+type Presence struct {
+	Cell   string   `bson:"cell"`
+	Bucket int64    `bson:"bucket"`
+	Users  []string `bson:"users"`
+}
+
+// PresenceWithin returns the users with location records inside the area
+// delimited by 'polygon' during the provided period, grouped by cell and
+// time bucket. The polygon is provided as a closed ring of [lng, lat] pairs.
+// Only records tagged with 'purpose' are included; aggregate-only records are
+// ignored.
+func (st *Handler) PresenceWithin(polygon [][2]float64, from, to time.Time, purpose string) ([]*Presence, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 1*time.Minute)
+	defer cancel()
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"timestamp": bson.M{"$gte": from, "$lte": to},
+			"aggregate": traceable,
+			"purposes":  purpose,
+			"location": bson.M{"$geoWithin": bson.M{"$geometry": bson.M{
+				"type":        "Polygon",
+				"coordinates": [][][2]float64{polygon},
+			}}},
+		}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":   bson.M{"cell": "$cell", "bucket": "$bucket"},
+			"users": bson.M{"$addToSet": "$did"},
+		}}},
+		{{Key: "$project", Value: bson.M{
+			"_id":    0,
+			"cell":   "$_id.cell",
+			"bucket": "$_id.bucket",
+			"users":  1,
+		}}},
+	}
+	var list []*Presence
+	for _, name := range partitionsBetween(from, to) {
+		cur, err := st.db.Collection(name).Aggregate(ctx, pipeline, options.Aggregate().SetAllowDiskUse(true))
+		if err != nil {
+			return nil, err
+		}
+		for cur.Next(ctx) {
+			p := &Presence{}
+			if err := cur.Decode(p); err != nil {
+				_ = cur.Close(ctx)
+				return nil, err
+			}
+			list = append(list, p)
+		}
+		_ = cur.Close(ctx)
+	}
+	return list, nil
+}
@@ -0,0 +1,176 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"go.bryk.io/covid-tracking/utils"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Cell represents a location cell visited during a specific time bucket.
+type Cell struct {
+	ID     string `bson:"cell"`
+	Bucket int64  `bson:"bucket"`
+}
+
+// Cells returns all the location cells visited by the user 'did' within the
+// specified period of time. Aggregate-only records are ignored.
+func (st *Handler) Cells(did string, from, to time.Time) ([]Cell, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 1*time.Minute)
+	defer cancel()
+
+	var cells []Cell
+	seen := make(map[Cell]bool)
+	for _, name := range partitionsBetween(from, to) {
+		cur, err := st.db.Collection(name).Find(ctx, bson.M{
+			"did":       did,
+			"timestamp": bson.M{"$gte": from, "$lte": to},
+			"aggregate": traceable,
+		}, options.Find().SetProjection(bson.M{"cell": 1, "bucket": 1}))
+		if err != nil {
+			return nil, err
+		}
+		for cur.Next(ctx) {
+			c := Cell{}
+			if err := cur.Decode(&c); err != nil {
+				_ = cur.Close(ctx)
+				return nil, err
+			}
+			if !seen[c] {
+				seen[c] = true
+				cells = append(cells, c)
+			}
+		}
+		_ = cur.Close(ctx)
+	}
+	return cells, nil
+}
+
+// PresentAt returns the identifiers of all users with location records on
+// any of the provided cells. Aggregate-only records are ignored.
+func (st *Handler) PresentAt(cells []Cell) ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 1*time.Minute)
+	defer cancel()
+
+	// Group cells by partition
+	groups := make(map[string][]bson.M)
+	for _, c := range cells {
+		name := partitionName(time.Unix(c.Bucket*int64(utils.BucketSize.Seconds()), 0))
+		groups[name] = append(groups[name], bson.M{"cell": c.ID, "bucket": c.Bucket})
+	}
+
+	var users []string
+	seen := make(map[string]bool)
+	for name, filter := range groups {
+		list, err := st.db.Collection(name).Distinct(ctx, "did", bson.M{"$or": filter, "aggregate": traceable})
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range list {
+			if id, ok := v.(string); ok && !seen[id] {
+				seen[id] = true
+				users = append(users, id)
+			}
+		}
+	}
+	return users, nil
+}
+
+// QueueFederationKeys registers location cells, visited by a positive case,
+// to be shared with other authorities on the next federation upload.
+func (st *Handler) QueueFederationKeys(diagnosis string, cells []Cell) error {
+	if len(cells) == 0 {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Second)
+	defer cancel()
+	col, err := st.retained(ctx, "federation_keys", "created")
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	docs := make([]interface{}, len(cells))
+	for i, c := range cells {
+		docs[i] = bson.M{
+			"diagnosis": diagnosis,
+			"cell":      c.ID,
+			"bucket":    c.Bucket,
+			"created":   now,
+			"uploaded":  false,
+		}
+	}
+	_, err = col.InsertMany(ctx, docs)
+	return err
+}
+
+// PendingFederationKeys returns all queued location cells not yet uploaded.
+func (st *Handler) PendingFederationKeys() ([]Cell, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Second)
+	defer cancel()
+	cur, err := st.db.Collection("federation_keys").Find(ctx, bson.M{"uploaded": false})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cur.Close(ctx)
+	}()
+	var cells []Cell
+	for cur.Next(ctx) {
+		c := Cell{}
+		if err := cur.Decode(&c); err != nil {
+			return nil, err
+		}
+		cells = append(cells, c)
+	}
+	return cells, cur.Err()
+}
+
+// FederationKeysUploaded marks all pending location cells, created before
+// 'until', as already uploaded.
+func (st *Handler) FederationKeysUploaded(until time.Time, tag string) error {
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Second)
+	defer cancel()
+	_, err := st.db.Collection("federation_keys").UpdateMany(ctx, bson.M{
+		"uploaded": false,
+		"created":  bson.M{"$lte": until},
+	}, bson.M{"$set": bson.M{"uploaded": true, "batch": tag}})
+	return err
+}
+
+// FederationBatchProcessed registers a downloaded batch as processed. Returns
+// false if the batch was already processed before.
+func (st *Handler) FederationBatchProcessed(origin, tag string) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+	defer cancel()
+	col, err := st.retained(ctx, "federation_batches", "processed")
+	if err != nil {
+		return false, err
+	}
+	_, err = col.InsertOne(ctx, bson.M{
+		"origin":    origin,
+		"tag":       tag,
+		"processed": time.Now(),
+	})
+	if isDuplicateKey(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// Indexes for federation keys and processed batches.
+func federationIndexes(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection("federation_keys").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.M{"uploaded": 1},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = db.Collection("federation_batches").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "origin", Value: 1}, {Key: "tag", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	return err
+}
@@ -2,13 +2,17 @@ package storage
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/covid-tracking/utils"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
@@ -18,14 +22,41 @@ import (
 // Handler provides the main interface to abstract away storage
 // operations.
 type Handler struct {
-	cl *mongo.Client
-	db *mongo.Database
+	cl        *mongo.Client
+	db        *mongo.Database
+	ready     map[string]bool
+	retention time.Duration
+	ledger    bool
+	mu        sync.Mutex
+}
+
+// Option elements allow to adjust the behavior of a storage handler.
+type Option func(st *Handler)
+
+// WithRetention sets the maximum period of time data will be kept on storage.
+// Expired entries are automatically removed. A zero value disables the retention
+// policy.
+func WithRetention(period time.Duration) Option {
+	return func(st *Handler) {
+		st.retention = period
+	}
+}
+
+// WithLedger registers the location records, check-ins and audit entries
+// stored on a tamper-evident ledger, see 'SealLedger'.
+func WithLedger() Option {
+	return func(st *Handler) {
+		st.ledger = true
+	}
 }
 
 const (
-	database     string = "ct19"       // Database name
-	userCodeTTL  int32  = 60           // User activation codes expire after 1 minute
-	agentCodeTTL int32  = 60 * 60 * 24 // Agent activation codes expire after a day
+	database        string = "ct19"            // Database name
+	archiveDatabase string = "ct19_archive"    // Cold storage database name
+	recordsPrefix   string = "records_"        // Location records partitions prefix
+	userCodeTTL     int32  = 60                // User activation codes expire after 1 minute
+	agentCodeTTL    int32  = 60 * 60 * 24      // Agent activation codes expire after a day
+	campaignCodeTTL int32  = 60 * 60 * 24 * 30 // Campaign activation codes expire after 30 days
 )
 
 // GeoJSON structure for location records.
@@ -35,7 +66,7 @@ type location struct {
 }
 
 // NewHandler returns a new storage handler.
-func NewHandler(sink string) (*Handler, error) {
+func NewHandler(sink string, opts ...Option) (*Handler, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 	if !strings.HasPrefix(sink, "mongodb://") {
@@ -57,11 +88,12 @@ func NewHandler(sink string) (*Handler, error) {
 
 	// Setup handle instance
 	st := &Handler{
-		cl: cl,
-		db: cl.Database(database),
+		cl:    cl,
+		db:    cl.Database(database),
+		ready: make(map[string]bool),
 	}
-	if err := st.setup(); err != nil {
-		return nil, err
+	for _, opt := range opts {
+		opt(st)
 	}
 	return st, nil
 }
@@ -71,6 +103,13 @@ func (st *Handler) Close() {
 	_ = st.cl.Disconnect(context.Background())
 }
 
+// Ping verifies the storage server is reachable.
+func (st *Handler) Ping() error {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	return st.cl.Ping(ctx, readpref.Primary())
+}
+
 // ActivationCode creates a new activation code. The code will expire automatically.
 func (st *Handler) ActivationCode(req *protov1.ActivationCodeRequest) (string, error) {
 	ac := uuid.New()
@@ -79,15 +118,38 @@ func (st *Handler) ActivationCode(req *protov1.ActivationCodeRequest) (string, e
 		"code":    ac.String(),
 		"created": time.Now(),
 	}
+	if len(req.Scope) > 0 {
+		record["scope"] = req.Scope
+	}
+	if req.Device != "" {
+		record["device"] = deviceHash(req.Device)
+	}
 	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
 	defer cancel()
 	_, err := st.db.Collection(fmt.Sprintf("%s_codes", req.Role)).InsertOne(ctx, record)
 	return ac.String(), err
 }
 
-// VerifyActivationCode checks if the provided registration token is valid.
-// If the token is valid it will be deleted automatically.
-func (st *Handler) VerifyActivationCode(req *protov1.CredentialsRequest) bool {
+// Device values are stored hashed, only required to compare them.
+func deviceHash(device string) string {
+	h := sha256.Sum256([]byte(device))
+	return hex.EncodeToString(h[:])
+}
+
+// RoleCodes prepares the storage of activation codes for a custom role.
+// Codes for custom roles expire after a day, like the ones for agents.
+func (st *Handler) RoleCodes(role string) error {
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+	defer cancel()
+	_, err := st.db.Collection(fmt.Sprintf("%s_codes", role)).Indexes().CreateOne(ctx, ttlIndex(agentCodeTTL))
+	return err
+}
+
+// VerifyActivationCode checks if the provided registration token is valid,
+// and returns the scope assigned to it, if any. Codes bound to a device are
+// only valid if the request includes the same device value. If the token is
+// valid it will be deleted automatically.
+func (st *Handler) VerifyActivationCode(req *protov1.CredentialsRequest) ([]string, bool) {
 	query := bson.M{
 		"did":  req.Did,
 		"code": req.ActivationCode,
@@ -95,64 +157,451 @@ func (st *Handler) VerifyActivationCode(req *protov1.CredentialsRequest) bool {
 	col := st.db.Collection(fmt.Sprintf("%s_codes", req.Role))
 	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
 	defer cancel()
-	res := col.FindOne(ctx, query)
-	valid := res.Err() == nil
-	if valid {
-		_, _ = col.DeleteMany(ctx, query)
+	record := struct {
+		Scope  []string `bson:"scope"`
+		Device string   `bson:"device"`
+	}{}
+	if err := col.FindOne(ctx, query).Decode(&record); err != nil {
+		// User codes generated for a campaign can be redeemed by any DID
+		if req.Role != "user" {
+			return nil, false
+		}
+		campaign := st.db.Collection("campaign_codes")
+		if err := campaign.FindOneAndDelete(ctx, bson.M{"code": req.ActivationCode}).Decode(&record); err != nil {
+			return nil, false
+		}
+		return record.Scope, true
+	}
+	if record.Device != "" && record.Device != deviceHash(req.Device) {
+		// Keep the code, so it can still be redeemed from the right device
+		return nil, false
 	}
-	return valid
+	_, _ = col.DeleteMany(ctx, query)
+	return record.Scope, true
+}
+
+// CampaignCodes creates a batch of "user" activation codes not bound to a
+// specific DID. The codes will expire automatically.
+func (st *Handler) CampaignCodes(campaign string, scope []string, count int) ([]string, time.Time, error) {
+	now := time.Now()
+	codes := make([]string, count)
+	entries := make([]interface{}, count)
+	for i := range codes {
+		codes[i] = uuid.New().String()
+		entry := bson.M{
+			"code":     codes[i],
+			"campaign": campaign,
+			"created":  now,
+		}
+		if len(scope) > 0 {
+			entry["scope"] = scope
+		}
+		entries[i] = entry
+	}
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Second)
+	defer cancel()
+	if _, err := st.db.Collection("campaign_codes").InsertMany(ctx, entries); err != nil {
+		return nil, now, err
+	}
+	return codes, now.Add(time.Duration(campaignCodeTTL) * time.Second), nil
 }
 
 // LocationRecords add and index location entries to persistent storage.
+// Records are partitioned in monthly collections based on their timestamp
+// to keep the working set of indexes small. Each record is annotated with
+// its geohash cell and time bucket to simplify contact matching. Aggregate-only
+// records are flagged so they are excluded from contact matching.
 func (st *Handler) LocationRecords(records []*protov1.LocationRecord) error {
 	// Prepare entries
-	entries := make([]interface{}, len(records))
-	for i, r := range records {
-		entries[i] = bson.M{
+	entries := make(map[string][]interface{})
+	for _, r := range records {
+		ts := time.Unix(r.Timestamp, 0)
+		name := partitionName(ts)
+		entry := bson.M{
 			"did":       r.Did,
-			"timestamp": time.Unix(r.Timestamp, 0),
+			"timestamp": ts,
 			"hash":      r.Hash,
 			"proof":     r.Proof,
 			"location":  getLocation(r),
+			"cell":      utils.GeoHash(float64(r.Lat), float64(r.Lng), utils.CellPrecision),
+			"bucket":    utils.TimeBucket(ts, utils.BucketSize),
+			"purposes":  RecordPurposes(r),
+		}
+		if r.AggregateOnly {
+			entry["aggregate"] = true
+		}
+		if client := newClientEntry(r.Client); client != nil {
+			entry["client"] = client
 		}
+		entries[name] = append(entries[name], entry)
 	}
 
 	// Save records
 	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
 	defer cancel()
-	_, err := st.db.Collection("records").InsertMany(ctx, entries)
-	return err
+	for name, list := range entries {
+		col, err := st.partition(ctx, name)
+		if err != nil {
+			return err
+		}
+		if _, err := col.InsertMany(ctx, list); err != nil {
+			return err
+		}
+	}
+	digests := make([]string, len(records))
+	for i, r := range records {
+		digests[i] = RecordDigest(r)
+	}
+	return st.appendLedger(ctx, LedgerRecord, digests)
 }
 
-func (st *Handler) setup() error {
-	// TTL user codes
-	userCodes := st.db.Collection("user_codes")
-	if _, err := userCodes.Indexes().CreateOne(context.Background(), ttlIndex(userCodeTTL)); err != nil {
-		return err
+// ArchiveRecords removes from the main database all location records partitions
+// containing only entries older than the provided cutoff date. If 'discard' is set
+// to true the partitions are deleted, otherwise they are moved to the archive
+// database for cold storage. The names of all processed partitions are returned.
+func (st *Handler) ArchiveRecords(cutoff time.Time, discard bool) ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Minute)
+	defer cancel()
+	names, err := st.db.ListCollectionNames(ctx, bson.M{
+		"name": bson.M{"$regex": fmt.Sprintf("^%s", recordsPrefix)},
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// TTL agent codes
-	agentCodes := st.db.Collection("agent_codes")
-	if _, err := agentCodes.Indexes().CreateOne(context.Background(), ttlIndex(agentCodeTTL)); err != nil {
-		return err
+	var archived []string
+	for _, name := range names {
+		// A partition can be archived once the month it holds is complete
+		// before the cutoff date
+		start, err := time.Parse(recordsPrefix+"2006_01", name)
+		if err != nil || start.AddDate(0, 1, 0).After(cutoff) {
+			continue
+		}
+		if discard {
+			err = st.db.Collection(name).Drop(ctx)
+		} else {
+			err = st.cl.Database("admin").RunCommand(ctx, bson.D{
+				{Key: "renameCollection", Value: fmt.Sprintf("%s.%s", database, name)},
+				{Key: "to", Value: fmt.Sprintf("%s.%s", archiveDatabase, name)},
+			}).Err()
+		}
+		if err != nil {
+			return archived, errors.Wrapf(err, "failed to archive partition %s", name)
+		}
+		st.mu.Lock()
+		delete(st.ready, name)
+		st.mu.Unlock()
+		archived = append(archived, name)
+	}
+	return archived, nil
+}
+
+// Return the records partition collection with the provided name, ensuring all
+// its required indexes are in place.
+func (st *Handler) partition(ctx context.Context, name string) (*mongo.Collection, error) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	col := st.db.Collection(name)
+	if st.ready[name] {
+		return col, nil
 	}
 
 	// GeoSpatial and timestamp indexes on record.location
-	records := st.db.Collection("records")
-	if _, err := records.Indexes().CreateOne(context.Background(), geoIndex("location")); err != nil {
-		return err
+	if _, err := col.Indexes().CreateOne(ctx, geoIndex("location")); err != nil {
+		return nil, err
+	}
+	if err := st.expireAfter(ctx, col, "timestamp"); err != nil {
+		return nil, err
+	}
+
+	// Compound index used for contact matching
+	_, err := col.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "cell", Value: 1},
+			{Key: "bucket", Value: 1},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	st.ready[name] = true
+	return col, nil
+}
+
+// Return the collection with the provided name, ensuring its entries are
+// subject to the retention policy based on the value of the date 'field'.
+func (st *Handler) retained(ctx context.Context, name string, field string) (*mongo.Collection, error) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	col := st.db.Collection(name)
+	if st.ready[name] {
+		return col, nil
+	}
+	if err := st.expireAfter(ctx, col, field); err != nil {
+		return nil, err
 	}
-	_, err := records.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+	st.ready[name] = true
+	return col, nil
+}
+
+// Filter excluding aggregate-only location records, which are never linked
+// to exposures.
+var traceable = bson.M{"$ne": true}
+
+// Contacts returns the identifiers of all users that shared a location cell,
+// during the same time bucket, with the provided DID within the specified period
+// of time.
+func (st *Handler) Contacts(id string, from, to time.Time) ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 1*time.Minute)
+	defer cancel()
+
+	var contacts []string
+	seen := make(map[string]bool)
+	for _, name := range partitionsBetween(from, to) {
+		col := st.db.Collection(name)
+		period := bson.M{"$gte": from, "$lte": to}
+
+		// Get cells visited by the user
+		cur, err := col.Find(ctx, bson.M{
+			"did":       id,
+			"timestamp": period,
+			"aggregate": traceable,
+		}, options.Find().SetProjection(bson.M{
+			"cell":   1,
+			"bucket": 1,
+		}))
+		if err != nil {
+			return nil, err
+		}
+		var cells []bson.M
+		for cur.Next(ctx) {
+			entry := struct {
+				Cell   string `bson:"cell"`
+				Bucket int64  `bson:"bucket"`
+			}{}
+			if err := cur.Decode(&entry); err != nil {
+				_ = cur.Close(ctx)
+				return nil, err
+			}
+			cells = append(cells, bson.M{"cell": entry.Cell, "bucket": entry.Bucket})
+		}
+		_ = cur.Close(ctx)
+		if len(cells) == 0 {
+			continue
+		}
+
+		// Get other users present on the same cells and buckets
+		list, err := col.Distinct(ctx, "did", bson.M{
+			"$or":       cells,
+			"did":       bson.M{"$ne": id},
+			"aggregate": traceable,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range list {
+			if c, ok := v.(string); ok && !seen[c] {
+				seen[c] = true
+				contacts = append(contacts, c)
+			}
+		}
+	}
+	return contacts, nil
+}
+
+// Collections subject to the retention policy, and the date field used
+// to calculate the expiration of its entries. Location records partitions
+// are handled independently.
+var retainedCollections = map[string]string{
+	"check_ins":            "timestamp",
+	"notifications":        "created",
+	"messages":             "created",
+	"diagnoses":            "timestamp",
+	"exposures":            "timestamp",
+	"federation_keys":      "created",
+	"federation_batches":   "processed",
+	"replication_markers":  "created",
+	"replicated_markers":   "created",
+	"undelivered_messages": "created",
+}
+
+// Purge permanently removes all data older than the retention period, including
+// location records already moved to cold storage. The data of users with a legal
+// hold in place is never removed. Returns the number of entries deleted.
+func (st *Handler) Purge() (int64, error) {
+	if st.retention == 0 {
+		return 0, nil
+	}
+	cutoff := time.Now().Add(-1 * st.retention)
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Minute)
+	defer cancel()
+	held, err := st.heldDIDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+	expired := func(field string) bson.M {
+		filter := bson.M{field: bson.M{"$lt": cutoff}}
+		if len(held) > 0 {
+			filter["did"] = bson.M{"$nin": held}
+		}
+		return filter
+	}
+
+	var total int64
+	for _, db := range []*mongo.Database{st.db, st.cl.Database(archiveDatabase)} {
+		names, err := db.ListCollectionNames(ctx, bson.M{
+			"name": bson.M{"$regex": fmt.Sprintf("^%s", recordsPrefix)},
+		})
+		if err != nil {
+			return total, err
+		}
+		for _, name := range names {
+			res, err := db.Collection(name).DeleteMany(ctx, expired("timestamp"))
+			if err != nil {
+				return total, errors.Wrapf(err, "failed to purge partition %s", name)
+			}
+			total += res.DeletedCount
+		}
+	}
+	for name, field := range retainedCollections {
+		res, err := st.db.Collection(name).DeleteMany(ctx, expired(field))
+		if err != nil {
+			return total, errors.Wrapf(err, "failed to purge collection %s", name)
+		}
+		total += res.DeletedCount
+	}
+	return total, nil
+}
+
+// Ensure entries on the collection are automatically removed once the retention
+// period, based on the value of the provided date 'field', is reached. If no retention
+// period is set, or a legal hold is in place for any user, a regular index is created
+// for the field instead; expired entries are then only removed by 'Purge', which
+// excludes the held data. An existing index for the field is adjusted when its
+// expiration doesn't match the retention period.
+func (st *Handler) expireAfter(ctx context.Context, col *mongo.Collection, field string) error {
+	index := mongo.IndexModel{
 		Keys: bson.M{
-			"timestamp": 1,
+			field: 1,
 		},
+	}
+	ttl := int32(st.retention.Seconds())
+	expire := st.retention > 0
+	if expire {
+		held, err := st.heldDIDs(ctx)
+		if err != nil {
+			return err
+		}
+		expire = len(held) == 0
+	}
+	if expire {
+		index.Options = options.Index().SetExpireAfterSeconds(ttl)
+	}
+
+	current, err := fieldIndex(ctx, col, field)
+	if err != nil {
+		return err
+	}
+	switch {
+	case current == nil:
+		// No index for the field yet
+	case current.ExpireAfterSeconds == nil && !expire:
+		return nil
+	case current.ExpireAfterSeconds != nil && expire:
+		if *current.ExpireAfterSeconds == ttl {
+			return nil
+		}
+		// Adjust the expiration value of the existing TTL index
+		return st.db.RunCommand(ctx, bson.D{
+			{Key: "collMod", Value: col.Name()},
+			{Key: "index", Value: bson.M{
+				"name":               current.Name,
+				"expireAfterSeconds": ttl,
+			}},
+		}).Err()
+	default:
+		// Switching between a TTL and a regular index is not supported by
+		// 'collMod' on all server versions, the index is replaced instead
+		if _, err := col.Indexes().DropOne(ctx, current.Name); err != nil {
+			return err
+		}
+	}
+	_, err = col.Indexes().CreateOne(ctx, index)
+	return err
+}
+
+// Adjust the expiration of the entries on all the collections subject to the
+// retention policy, see 'expireAfter'. Used when legal holds are placed or
+// released.
+func (st *Handler) refreshExpiration() error {
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Minute)
+	defer cancel()
+	names, err := st.db.ListCollectionNames(ctx, bson.M{
+		"name": bson.M{"$regex": fmt.Sprintf("^%s", recordsPrefix)},
 	})
 	if err != nil {
 		return err
 	}
+	fields := make(map[string]string, len(names)+len(retainedCollections))
+	for _, name := range names {
+		fields[name] = "timestamp"
+	}
+	for name, field := range retainedCollections {
+		fields[name] = field
+	}
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	for name, field := range fields {
+		if err := st.expireAfter(ctx, st.db.Collection(name), field); err != nil {
+			return errors.Wrapf(err, "failed to adjust expiration on %s", name)
+		}
+	}
 	return nil
 }
 
+// Existing index specification.
+type indexSpec struct {
+	Name               string `bson:"name"`
+	Key                bson.D `bson:"key"`
+	ExpireAfterSeconds *int32 `bson:"expireAfterSeconds,omitempty"`
+}
+
+// Return the existing single-field ascending index for 'field' on the
+// collection, if any.
+func fieldIndex(ctx context.Context, col *mongo.Collection, field string) (*indexSpec, error) {
+	cur, err := col.Indexes().List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cur.Close(ctx)
+	}()
+	for cur.Next(ctx) {
+		spec := &indexSpec{}
+		if err := cur.Decode(spec); err != nil {
+			return nil, err
+		}
+		if len(spec.Key) != 1 || spec.Key[0].Key != field {
+			continue
+		}
+		switch dir := spec.Key[0].Value.(type) {
+		case int32:
+			if dir == 1 {
+				return spec, nil
+			}
+		case int64:
+			if dir == 1 {
+				return spec, nil
+			}
+		case float64:
+			if dir == 1 {
+				return spec, nil
+			}
+		}
+	}
+	return nil, cur.Err()
+}
+
 func ttlIndex(ttl int32) mongo.IndexModel {
 	return mongo.IndexModel{
 		Keys: bson.M{
@@ -172,6 +621,21 @@ func geoIndex(field string) mongo.IndexModel {
 	}
 }
 
+// Location records are partitioned by month, i.e. "records_2020_05".
+func partitionName(ts time.Time) string {
+	return recordsPrefix + ts.UTC().Format("2006_01")
+}
+
+// Return the names of all records partitions covering the provided period.
+func partitionsBetween(from, to time.Time) []string {
+	var list []string
+	from = time.Date(from.UTC().Year(), from.UTC().Month(), 1, 0, 0, 0, 0, time.UTC)
+	for m := from; !m.After(to); m = m.AddDate(0, 1, 0) {
+		list = append(list, partitionName(m))
+	}
+	return list
+}
+
 func getLocation(r *protov1.LocationRecord) *location {
 	return &location{
 		Type: "Point",
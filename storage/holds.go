@@ -0,0 +1,372 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Collections holding personal data, identified by the "did" field, other
+// than the location records partitions.
+var subjectCollections = []string{
+	"check_ins",
+	"diagnoses",
+	"exposures",
+	"notifications",
+	"messages",
+}
+
+// PlaceLegalHold exempts the data of a user from the retention policy until
+// the hold is released. Expired data is no longer removed automatically while
+// holds are in place, and 'Purge' excludes the data of held users. All the
+// data currently available for the user is preserved as well; data registered
+// later is preserved by 'PreserveHeldData'.
+func (st *Handler) PlaceLegalHold(hold *protov1.LegalHold) error {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	_, err := st.db.Collection("legal_holds").UpdateOne(ctx,
+		bson.M{"did": hold.Did},
+		bson.M{"$set": bson.M{
+			"reason":  hold.Reason,
+			"actor":   hold.Actor,
+			"created": time.Unix(hold.Created, 0),
+		}},
+		options.Update().SetUpsert(true))
+	if err != nil {
+		return err
+	}
+	if err = st.refreshExpiration(); err != nil {
+		return err
+	}
+	_, err = st.preserve(hold.Did)
+	return err
+}
+
+// ReleaseLegalHold removes the hold placed on the data of a user, along with
+// the preserved entries; the data still available is once again subject to
+// the retention policy. Expired data is removed automatically again once no
+// holds remain. Returns false if the user has no hold in place.
+func (st *Handler) ReleaseLegalHold(did string) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 30*time.Second)
+	defer cancel()
+	res, err := st.db.Collection("legal_holds").DeleteOne(ctx, bson.M{"did": did})
+	if err != nil || res.DeletedCount == 0 {
+		return false, err
+	}
+	if _, err = st.db.Collection("held_data").DeleteMany(ctx, bson.M{"did": did}); err != nil {
+		return true, err
+	}
+	return true, st.refreshExpiration()
+}
+
+// LegalHold returns the hold placed on the data of a user, if any.
+func (st *Handler) LegalHold(did string) (*protov1.LegalHold, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	entry := &holdEntry{}
+	if err := st.db.Collection("legal_holds").FindOne(ctx, bson.M{"did": did}).Decode(entry); err != nil {
+		return nil, err
+	}
+	return entry.hold(), nil
+}
+
+// PreserveHeldData copies the data registered for all users with a legal hold
+// in place, so it's not removed by the retention policy. Returns the number
+// of entries preserved.
+func (st *Handler) PreserveHeldData() (int64, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Second)
+	defer cancel()
+	list, err := st.heldDIDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var total int64
+	for _, did := range list {
+		n, err := st.preserve(did)
+		total += n
+		if err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
+
+// SubjectData compiles all the data stored for a user, including the entries
+// preserved by a legal hold and the audit log entries where the user is the
+// actor.
+func (st *Handler) SubjectData(did string) (*protov1.SubjectAccessResponse, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Minute)
+	defer cancel()
+	res := &protov1.SubjectAccessResponse{
+		Did:       did,
+		Generated: time.Now().Unix(),
+	}
+	if hold, err := st.LegalHold(did); err == nil {
+		res.LegalHold = hold
+	}
+
+	// Stored and preserved entries
+	seen := make(map[string]bool)
+	add := func(source string, doc bson.Raw) error {
+		key := source + ":" + doc.Lookup("_id").String()
+		if seen[key] {
+			return nil
+		}
+		seen[key] = true
+		return addSubjectDocument(res, source, doc)
+	}
+	if err := st.subjectDocuments(ctx, did, add); err != nil {
+		return nil, err
+	}
+	cur, err := st.db.Collection("held_data").Find(ctx, bson.M{"did": did})
+	if err != nil {
+		return nil, err
+	}
+	for cur.Next(ctx) {
+		entry := struct {
+			Source string   `bson:"source"`
+			Data   bson.Raw `bson:"data"`
+		}{}
+		if err = cur.Decode(&entry); err == nil {
+			err = add(entry.Source, entry.Data)
+		}
+		if err != nil {
+			_ = cur.Close(ctx)
+			return nil, err
+		}
+	}
+	_ = cur.Close(ctx)
+
+	// Audit log
+	cur, err = st.db.Collection("audit").Find(ctx, bson.M{"actor": did}, options.Find().SetSort(bson.M{"timestamp": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cur.Close(ctx)
+	}()
+	for cur.Next(ctx) {
+		entry := &AuditEntry{}
+		if err := cur.Decode(entry); err != nil {
+			return nil, err
+		}
+		res.Audit = append(res.Audit, &protov1.AuditRecord{
+			Timestamp: entry.Timestamp.Unix(),
+			Event:     entry.Event,
+			Address:   entry.Address,
+			Details:   entry.Details,
+		})
+	}
+	return res, cur.Err()
+}
+
+// Return the DIDs of all users with a legal hold in place.
+func (st *Handler) heldDIDs(ctx context.Context) ([]string, error) {
+	list, err := st.db.Collection("legal_holds").Distinct(ctx, "did", bson.M{})
+	if err != nil {
+		return nil, err
+	}
+	held := make([]string, 0, len(list))
+	for _, v := range list {
+		if did, ok := v.(string); ok {
+			held = append(held, did)
+		}
+	}
+	return held, nil
+}
+
+// Copy all the data stored for a user to the held data collection, which is
+// not subject to the retention policy. Returns the number of entries copied.
+func (st *Handler) preserve(did string) (int64, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Minute)
+	defer cancel()
+	var total int64
+	held := st.db.Collection("held_data")
+	err := st.subjectDocuments(ctx, did, func(source string, doc bson.Raw) error {
+		id := bson.D{
+			{Key: "source", Value: source},
+			{Key: "id", Value: doc.Lookup("_id")},
+		}
+		_, err := held.ReplaceOne(ctx, bson.M{"_id": id}, bson.M{
+			"did":    did,
+			"source": source,
+			"held":   time.Now(),
+			"data":   doc,
+		}, options.Replace().SetUpsert(true))
+		if err == nil {
+			total++
+		}
+		return err
+	})
+	return total, err
+}
+
+// Traverse all the documents holding personal data for a user, on both the
+// main and the archive databases. 'fn' receives the name of the collection
+// and the document, only valid until 'fn' returns.
+func (st *Handler) subjectDocuments(ctx context.Context, did string, fn func(string, bson.Raw) error) error {
+	for _, db := range []*mongo.Database{st.db, st.cl.Database(archiveDatabase)} {
+		names, err := db.ListCollectionNames(ctx, bson.M{
+			"name": bson.M{"$regex": fmt.Sprintf("^%s", recordsPrefix)},
+		})
+		if err != nil {
+			return err
+		}
+		if db == st.db {
+			names = append(names, subjectCollections...)
+		}
+		for _, name := range names {
+			cur, err := db.Collection(name).Find(ctx, bson.M{"did": did})
+			if err != nil {
+				return err
+			}
+			for cur.Next(ctx) {
+				if err = fn(name, cur.Current); err != nil {
+					break
+				}
+			}
+			if err == nil {
+				err = cur.Err()
+			}
+			_ = cur.Close(ctx)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Stored legal hold.
+type holdEntry struct {
+	DID     string    `bson:"did"`
+	Reason  string    `bson:"reason"`
+	Actor   string    `bson:"actor"`
+	Created time.Time `bson:"created"`
+}
+
+func (e *holdEntry) hold() *protov1.LegalHold {
+	return &protov1.LegalHold{
+		Did:     e.DID,
+		Reason:  e.Reason,
+		Actor:   e.Actor,
+		Created: e.Created.Unix(),
+	}
+}
+
+// Indexes for legal holds and preserved data.
+func holdIndexes(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection("legal_holds").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.M{"did": 1},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = db.Collection("held_data").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.M{"did": 1},
+	})
+	return err
+}
+
+// Decode a stored document and add it to the subject access response,
+// based on the collection it belongs to.
+func addSubjectDocument(res *protov1.SubjectAccessResponse, source string, doc bson.Raw) error {
+	switch {
+	case strings.HasPrefix(source, recordsPrefix):
+		entry := &recordEntry{}
+		if err := bson.Unmarshal(doc, entry); err != nil {
+			return err
+		}
+		res.Records = append(res.Records, entry.record())
+	case source == "check_ins":
+		entry := struct {
+			DID       string    `bson:"did"`
+			Venue     string    `bson:"venue"`
+			Timestamp time.Time `bson:"timestamp"`
+			Hash      string    `bson:"hash"`
+			Proof     []byte    `bson:"proof"`
+		}{}
+		if err := bson.Unmarshal(doc, &entry); err != nil {
+			return err
+		}
+		res.CheckIns = append(res.CheckIns, &protov1.CheckInRecord{
+			Did:       entry.DID,
+			Venue:     entry.Venue,
+			Timestamp: entry.Timestamp.Unix(),
+			Hash:      entry.Hash,
+			Proof:     entry.Proof,
+		})
+	case source == "diagnoses":
+		entry := struct {
+			ID        string    `bson:"id"`
+			DID       string    `bson:"did"`
+			Result    string    `bson:"result"`
+			Timestamp time.Time `bson:"timestamp"`
+			Source    string    `bson:"source"`
+		}{}
+		if err := bson.Unmarshal(doc, &entry); err != nil {
+			return err
+		}
+		res.Diagnoses = append(res.Diagnoses, &protov1.Diagnosis{
+			Id:        entry.ID,
+			Did:       entry.DID,
+			Result:    entry.Result,
+			Timestamp: entry.Timestamp.Unix(),
+			Source:    entry.Source,
+		})
+	case source == "exposures":
+		entry := struct {
+			ID        string    `bson:"id"`
+			DID       string    `bson:"did"`
+			Diagnosis string    `bson:"diagnosis"`
+			Timestamp time.Time `bson:"timestamp"`
+		}{}
+		if err := bson.Unmarshal(doc, &entry); err != nil {
+			return err
+		}
+		res.Exposures = append(res.Exposures, &protov1.Exposure{
+			Id:        entry.ID,
+			Did:       entry.DID,
+			Diagnosis: entry.Diagnosis,
+			Timestamp: entry.Timestamp.Unix(),
+		})
+	case source == "notifications":
+		entry := struct {
+			ID      string            `bson:"id"`
+			DID     string            `bson:"did"`
+			Kind    string            `bson:"kind"`
+			Created time.Time         `bson:"created"`
+			Details map[string]string `bson:"details"`
+			Title   string            `bson:"title"`
+			Body    string            `bson:"body"`
+			Lang    string            `bson:"lang"`
+		}{}
+		if err := bson.Unmarshal(doc, &entry); err != nil {
+			return err
+		}
+		res.Notifications = append(res.Notifications, &protov1.Notification{
+			Id:        entry.ID,
+			Did:       entry.DID,
+			Kind:      entry.Kind,
+			Timestamp: entry.Created.Unix(),
+			Details:   entry.Details,
+			Title:     entry.Title,
+			Body:      entry.Body,
+			Lang:      entry.Lang,
+		})
+	case source == "messages":
+		entry := &messageEntry{}
+		if err := bson.Unmarshal(doc, entry); err != nil {
+			return err
+		}
+		res.Messages = append(res.Messages, entry.message())
+	}
+	return nil
+}
@@ -0,0 +1,196 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Job status values.
+const (
+	JobPending   = "pending"
+	JobRunning   = "running"
+	JobCompleted = "completed"
+	JobFailed    = "failed"
+	JobCancelled = "cancelled"
+)
+
+// Job entries are discarded 30 days after submission.
+const jobsTTL int32 = 60 * 60 * 24 * 30
+
+// Stored job entry.
+type jobEntry struct {
+	ID          string            `bson:"_id"`
+	Kind        string            `bson:"kind"`
+	Params      map[string]string `bson:"params"`
+	Status      string            `bson:"status"`
+	Progress    uint32            `bson:"progress"`
+	Worker      string            `bson:"worker,omitempty"`
+	Error       string            `bson:"error,omitempty"`
+	Result      map[string]string `bson:"result,omitempty"`
+	SubmittedBy string            `bson:"submitted_by"`
+	Created     time.Time         `bson:"created"`
+	Started     time.Time         `bson:"started,omitempty"`
+	Finished    time.Time         `bson:"finished,omitempty"`
+}
+
+func (e *jobEntry) job() *protov1.Job {
+	j := &protov1.Job{
+		Id:          e.ID,
+		Kind:        e.Kind,
+		Params:      e.Params,
+		Status:      e.Status,
+		Progress:    e.Progress,
+		Worker:      e.Worker,
+		Error:       e.Error,
+		Result:      e.Result,
+		SubmittedBy: e.SubmittedBy,
+		Created:     e.Created.Unix(),
+	}
+	if !e.Started.IsZero() {
+		j.Started = e.Started.Unix()
+	}
+	if !e.Finished.IsZero() {
+		j.Finished = e.Finished.Unix()
+	}
+	return j
+}
+
+// SubmitJob registers a new job in "pending" status and returns its
+// complete details.
+func (st *Handler) SubmitJob(kind string, params map[string]string, submittedBy string) (*protov1.Job, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	entry := &jobEntry{
+		ID:          uuid.New().String(),
+		Kind:        kind,
+		Params:      params,
+		Status:      JobPending,
+		SubmittedBy: submittedBy,
+		Created:     time.Now(),
+	}
+	if _, err := st.db.Collection("jobs").InsertOne(ctx, entry); err != nil {
+		return nil, err
+	}
+	return entry.job(), nil
+}
+
+// Job returns the current details of a job.
+func (st *Handler) Job(id string) (*protov1.Job, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	entry := &jobEntry{}
+	if err := st.db.Collection("jobs").FindOne(ctx, bson.M{"_id": id}).Decode(entry); err != nil {
+		return nil, err
+	}
+	return entry.job(), nil
+}
+
+// Jobs returns the most recent jobs, optionally filtered by status.
+func (st *Handler) Jobs(status string, limit int64) ([]*protov1.Job, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+	defer cancel()
+	filter := bson.M{}
+	if status != "" {
+		filter["status"] = status
+	}
+	opts := options.Find().SetSort(bson.M{"created": -1}).SetLimit(limit)
+	cur, err := st.db.Collection("jobs").Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cur.Close(ctx)
+	}()
+	var list []*protov1.Job
+	for cur.Next(ctx) {
+		entry := &jobEntry{}
+		if err := cur.Decode(entry); err != nil {
+			return nil, err
+		}
+		list = append(list, entry.job())
+	}
+	return list, cur.Err()
+}
+
+// CancelJob marks a pending or running job as cancelled and returns its
+// updated details. Finished jobs are not modified.
+func (st *Handler) CancelJob(id string) (*protov1.Job, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	_, err := st.db.Collection("jobs").UpdateOne(ctx,
+		bson.M{"_id": id, "status": bson.M{"$in": []string{JobPending, JobRunning}}},
+		bson.M{"$set": bson.M{"status": JobCancelled, "finished": time.Now()}})
+	if err != nil {
+		return nil, err
+	}
+	return st.Job(id)
+}
+
+// StartJob assigns a pending job to 'worker' and returns its details. A nil
+// value is returned if the job is no longer pending, i.e. it was cancelled
+// or is already handled by another worker.
+func (st *Handler) StartJob(id, worker string) (*protov1.Job, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	res := st.db.Collection("jobs").FindOneAndUpdate(ctx,
+		bson.M{"_id": id, "status": JobPending},
+		bson.M{"$set": bson.M{"status": JobRunning, "worker": worker, "started": time.Now()}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After))
+	entry := &jobEntry{}
+	if err := res.Decode(entry); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return entry.job(), nil
+}
+
+// JobProgress updates the completion percentage of a running job. Returns
+// a boolean value indicating if the job should continue; i.e. 'false' if
+// the job was cancelled.
+func (st *Handler) JobProgress(id string, progress uint32) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	res, err := st.db.Collection("jobs").UpdateOne(ctx,
+		bson.M{"_id": id, "status": JobRunning},
+		bson.M{"$set": bson.M{"progress": progress}})
+	if err != nil {
+		return false, err
+	}
+	return res.MatchedCount == 1, nil
+}
+
+// FinishJob records the outcome of a running job. The job is marked as
+// "failed" if 'jobErr' is not nil.
+func (st *Handler) FinishJob(id string, result map[string]string, jobErr error) error {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	update := bson.M{"finished": time.Now(), "result": result}
+	if jobErr != nil {
+		update["status"] = JobFailed
+		update["error"] = jobErr.Error()
+	} else {
+		update["status"] = JobCompleted
+		update["progress"] = 100
+	}
+	_, err := st.db.Collection("jobs").UpdateOne(ctx,
+		bson.M{"_id": id, "status": JobRunning},
+		bson.M{"$set": update})
+	return err
+}
+
+// Indexes for jobs.
+func jobIndexes(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection("jobs").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "created", Value: -1}}},
+		{Keys: bson.M{"created": 1}, Options: options.Index().SetExpireAfterSeconds(jobsTTL)},
+	})
+	return err
+}
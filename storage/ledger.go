@@ -0,0 +1,303 @@
+package storage
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Kinds of entries registered on the ledger.
+const (
+	LedgerRecord  = "record"
+	LedgerCheckIn = "check_in"
+	LedgerAudit   = "audit"
+)
+
+// Maximum number of entries sealed on a single ledger block.
+const ledgerBlockSize = 10000
+
+// Entry registered on the ledger for a stored item. Only the item's digest
+// is kept, so entries are not subject to the retention policy.
+type ledgerEntry struct {
+	ID      primitive.ObjectID `bson:"_id,omitempty"`
+	Kind    string             `bson:"kind"`
+	Digest  string             `bson:"digest"`
+	Created time.Time          `bson:"created"`
+	Block   int64              `bson:"block"`
+}
+
+// LedgerBlock seals, in the order they were registered, a set of ledger
+// entries. Each block includes the hash of the previous one, so altering,
+// removing or reordering any entry, or block, breaks the chain from that
+// point on.
+type LedgerBlock struct {
+	// Sequential block number, starting at 1.
+	Seq int64 `bson:"_id" json:"seq"`
+
+	// Hash of the previous block, empty for the first one.
+	Prev string `bson:"prev" json:"prev"`
+
+	// Block hash, see 'ComputeHash'.
+	Hash string `bson:"hash" json:"hash"`
+
+	// Digest of the entries sealed, see 'LedgerDigest'.
+	Digest string `bson:"digest" json:"digest"`
+
+	// Number of entries sealed.
+	Entries int `bson:"entries" json:"entries"`
+
+	// Date the block was sealed.
+	Sealed time.Time `bson:"sealed" json:"sealed"`
+
+	// Reference to the external publication of the block hash, if anchored.
+	Anchor string `bson:"anchor,omitempty" json:"anchor,omitempty"`
+
+	// Date the block hash was anchored.
+	Anchored time.Time `bson:"anchored,omitempty" json:"anchored,omitempty"`
+}
+
+// ComputeHash returns the hex-encoded SHA-256 digest of the block contents,
+// of the form 'prev | seq | digest | entries | sealed'; with the sealed date
+// as a UNIX timestamp.
+func (b *LedgerBlock) ComputeHash() string {
+	h := sha256.New()
+	_, _ = fmt.Fprintf(h, "%s|%d|%s|%d|%d", b.Prev, b.Seq, b.Digest, b.Entries, b.Sealed.Unix())
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// LedgerDigest returns the hex-encoded SHA-256 digest of a list of entry
+// digests, in order.
+func LedgerDigest(digests []string) string {
+	h := sha256.New()
+	for _, d := range digests {
+		_, _ = h.Write([]byte(d))
+		_, _ = h.Write([]byte{'\n'})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// RecordDigest returns the digest registered on the ledger for a location
+// record.
+func RecordDigest(r *protov1.LocationRecord) string {
+	return ledgerItemDigest(LedgerRecord, r.Did, r.Hash, base64.StdEncoding.EncodeToString(r.Proof))
+}
+
+// CheckInDigest returns the digest registered on the ledger for a check-in
+// record.
+func CheckInDigest(r *protov1.CheckInRecord) string {
+	return ledgerItemDigest(LedgerCheckIn, r.Did, r.Venue, r.Hash, base64.StdEncoding.EncodeToString(r.Proof))
+}
+
+// AuditDigest returns the digest registered on the ledger for an audit entry.
+// Timestamps are considered with millisecond precision, as stored.
+func AuditDigest(e *AuditEntry) string {
+	details, _ := json.Marshal(e.Details)
+	ts := e.Timestamp.UTC().Truncate(time.Millisecond).Format(time.RFC3339Nano)
+	return ledgerItemDigest(LedgerAudit, ts, e.Event, e.Actor, e.Address, string(details))
+}
+
+func ledgerItemDigest(values ...string) string {
+	h := sha256.Sum256([]byte(strings.Join(values, "|")))
+	return hex.EncodeToString(h[:])
+}
+
+// Register entries on the ledger, if enabled.
+func (st *Handler) appendLedger(ctx context.Context, kind string, digests []string) error {
+	if !st.ledger || len(digests) == 0 {
+		return nil
+	}
+	now := time.Now()
+	entries := make([]interface{}, len(digests))
+	for i, d := range digests {
+		entries[i] = &ledgerEntry{Kind: kind, Digest: d, Created: now}
+	}
+	_, err := st.db.Collection("ledger_entries").InsertMany(ctx, entries)
+	return errors.Wrap(err, "failed to register ledger entries")
+}
+
+// SealLedger seals the entries registered since the last block on a new
+// one, and returns it. A nil block is returned if there are no entries to
+// seal. Up to 10,000 entries are sealed per block; the method must be called
+// repeatedly until no block is returned to seal all pending entries. Blocks
+// must be sealed by a single process at a time.
+func (st *Handler) SealLedger() (*LedgerBlock, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 1*time.Minute)
+	defer cancel()
+	head, err := st.LedgerHead()
+	if err != nil {
+		return nil, err
+	}
+	block := &LedgerBlock{Seq: 1}
+	if head != nil {
+		block.Seq, block.Prev = head.Seq+1, head.Hash
+	}
+
+	// Entries assigned to a block that was never stored are included again
+	query := bson.M{"$or": []bson.M{{"block": 0}, {"block": bson.M{"$gte": block.Seq}}}}
+	opts := options.Find().SetSort(bson.M{"_id": 1}).SetLimit(ledgerBlockSize)
+	var ids []primitive.ObjectID
+	var digests []string
+	err = st.traverseLedger(ctx, query, opts, func(e *ledgerEntry) error {
+		ids = append(ids, e.ID)
+		digests = append(digests, e.Digest)
+		return nil
+	})
+	if err != nil || len(ids) == 0 {
+		return nil, err
+	}
+	_, err = st.db.Collection("ledger_entries").UpdateMany(ctx,
+		bson.M{"_id": bson.M{"$in": ids}},
+		bson.M{"$set": bson.M{"block": block.Seq}})
+	if err != nil {
+		return nil, err
+	}
+
+	// Store block
+	block.Digest = LedgerDigest(digests)
+	block.Entries = len(digests)
+	block.Sealed = time.Now().Truncate(time.Second)
+	block.Hash = block.ComputeHash()
+	if _, err := st.db.Collection("ledger_blocks").InsertOne(ctx, block); err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+// LedgerHead returns the most recent block on the ledger, nil if no blocks
+// were sealed yet.
+func (st *Handler) LedgerHead() (*LedgerBlock, error) {
+	return st.ledgerBlock(bson.M{}, options.FindOne().SetSort(bson.M{"_id": -1}))
+}
+
+// LastAnchor returns the most recent anchored block on the ledger, nil if
+// no blocks were anchored yet.
+func (st *Handler) LastAnchor() (*LedgerBlock, error) {
+	return st.ledgerBlock(bson.M{"anchor": bson.M{"$exists": true}}, options.FindOne().SetSort(bson.M{"_id": -1}))
+}
+
+// AnchorLedger registers the external publication of a block hash.
+func (st *Handler) AnchorLedger(seq int64, anchor string) error {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	_, err := st.db.Collection("ledger_blocks").UpdateOne(ctx,
+		bson.M{"_id": seq},
+		bson.M{"$set": bson.M{"anchor": anchor, "anchored": time.Now()}})
+	return err
+}
+
+// LedgerInclusion returns the block where the entry for an item's digest
+// was sealed. A nil block is returned if the entry is not sealed yet, and
+// 'mongo.ErrNoDocuments' if the item was never registered.
+func (st *Handler) LedgerInclusion(digest string) (*LedgerBlock, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	entry := &ledgerEntry{}
+	if err := st.db.Collection("ledger_entries").FindOne(ctx, bson.M{"digest": digest}).Decode(entry); err != nil {
+		return nil, err
+	}
+	if entry.Block == 0 {
+		return nil, nil
+	}
+	return st.ledgerBlock(bson.M{"_id": entry.Block})
+}
+
+// VerifyLedger traverses all blocks on the ledger, in order, ensuring each
+// one is properly linked to the previous block and its hash and digest match
+// the entries sealed. Valid blocks are passed to 'fn'. The first
+// inconsistency found is returned as an error.
+func (st *Handler) VerifyLedger(ctx context.Context, fn func(*LedgerBlock) error) error {
+	opts := options.Find().SetSort(bson.M{"_id": 1})
+	cur, err := st.db.Collection("ledger_blocks").Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = cur.Close(ctx)
+	}()
+	prev := &LedgerBlock{}
+	for cur.Next(ctx) {
+		block := &LedgerBlock{}
+		if err := cur.Decode(block); err != nil {
+			return err
+		}
+		if block.Seq != prev.Seq+1 || block.Prev != prev.Hash {
+			return errors.Errorf("block %d: not linked to the previous block", block.Seq)
+		}
+		if block.ComputeHash() != block.Hash {
+			return errors.Errorf("block %d: invalid hash", block.Seq)
+		}
+		var digests []string
+		err := st.traverseLedger(ctx, bson.M{"block": block.Seq}, options.Find().SetSort(bson.M{"_id": 1}),
+			func(e *ledgerEntry) error {
+				digests = append(digests, e.Digest)
+				return nil
+			})
+		if err != nil {
+			return err
+		}
+		if len(digests) != block.Entries || LedgerDigest(digests) != block.Digest {
+			return errors.Errorf("block %d: entries don't match the block digest", block.Seq)
+		}
+		if err := fn(block); err != nil {
+			return err
+		}
+		prev = block
+	}
+	return cur.Err()
+}
+
+func (st *Handler) ledgerBlock(query bson.M, opts ...*options.FindOneOptions) (*LedgerBlock, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	block := &LedgerBlock{}
+	err := st.db.Collection("ledger_blocks").FindOne(ctx, query, opts...).Decode(block)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+func (st *Handler) traverseLedger(ctx context.Context, query bson.M, opts *options.FindOptions,
+	fn func(*ledgerEntry) error) error {
+	cur, err := st.db.Collection("ledger_entries").Find(ctx, query, opts)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = cur.Close(ctx)
+	}()
+	for cur.Next(ctx) {
+		entry := &ledgerEntry{}
+		if err := cur.Decode(entry); err != nil {
+			return err
+		}
+		if err := fn(entry); err != nil {
+			return err
+		}
+	}
+	return cur.Err()
+}
+
+// Indexes for the ledger.
+func ledgerIndexes(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection("ledger_entries").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "block", Value: 1}, {Key: "_id", Value: 1}}},
+		{Keys: bson.M{"digest": 1}},
+	})
+	return err
+}
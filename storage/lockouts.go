@@ -0,0 +1,92 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Failed authentication counters are discarded 24 hours after the last
+// failure.
+const authFailuresTTL int32 = 60 * 60 * 24
+
+// AuthFailure registers a failed authentication attempt for 'key', i.e. a
+// DID or network address, and returns the number of failures registered.
+func (st *Handler) AuthFailure(key string) (int, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	res := st.db.Collection("auth_failures").FindOneAndUpdate(ctx,
+		bson.M{"_id": key},
+		bson.M{"$inc": bson.M{"failures": 1}, "$set": bson.M{"updated": time.Now()}},
+		options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After))
+	counter := struct {
+		Failures int `bson:"failures"`
+	}{}
+	if err := res.Decode(&counter); err != nil {
+		return 0, err
+	}
+	return counter.Failures, nil
+}
+
+// Lock rejects authentication attempts for 'key' until the provided time.
+func (st *Handler) Lock(key string, until time.Time) error {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	_, err := st.db.Collection("auth_failures").UpdateOne(ctx,
+		bson.M{"_id": key},
+		bson.M{"$set": bson.M{"locked_until": until}})
+	return err
+}
+
+// LockedUntil returns the time authentication attempts will be accepted
+// again for any of the provided keys. A zero value is returned if none of
+// the keys is currently locked.
+func (st *Handler) LockedUntil(keys ...string) (time.Time, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	cur, err := st.db.Collection("auth_failures").Find(ctx, bson.M{
+		"_id":          bson.M{"$in": keys},
+		"locked_until": bson.M{"$gt": time.Now()},
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer func() {
+		_ = cur.Close(ctx)
+	}()
+	until := time.Time{}
+	for cur.Next(ctx) {
+		entry := struct {
+			LockedUntil time.Time `bson:"locked_until"`
+		}{}
+		if err := cur.Decode(&entry); err != nil {
+			return time.Time{}, err
+		}
+		if entry.LockedUntil.After(until) {
+			until = entry.LockedUntil
+		}
+	}
+	return until, cur.Err()
+}
+
+// ResetAuthFailures discards the failed authentication attempts registered
+// for 'key'.
+func (st *Handler) ResetAuthFailures(key string) error {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	_, err := st.db.Collection("auth_failures").DeleteOne(ctx, bson.M{"_id": key})
+	return err
+}
+
+// Indexes for failed authentication counters.
+func lockoutIndexes(ctx context.Context, db *mongo.Database) error {
+	ttl := authFailuresTTL
+	_, err := db.Collection("auth_failures").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.M{"updated": 1},
+		Options: &options.IndexOptions{ExpireAfterSeconds: &ttl},
+	})
+	return err
+}
@@ -0,0 +1,354 @@
+package memtest
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"go.bryk.io/covid-tracking/i18n"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/covid-tracking/storage"
+)
+
+// Stored session entry, one per access token issued.
+type session struct {
+	token    *storage.SessionToken
+	session  string
+	created  time.Time
+	renewed  bool
+	revoked  bool
+	reassign string
+}
+
+// Stored failed authentication counter.
+type lockout struct {
+	failures int
+	until    time.Time
+}
+
+// OpenSession registers a newly issued access token, optionally linked to
+// the token it renews. Tokens can be renewed only once.
+func (s *Store) OpenSession(token *storage.SessionToken, previous string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entry := &session{token: token, session: token.ID, created: token.Issued}
+	if prev, ok := s.sessions[previous]; ok && previous != "" {
+		if prev.renewed {
+			return storage.ErrSessionRenewed
+		}
+		prev.renewed = true
+		prev.reassign = ""
+		entry.session = prev.session
+		entry.created = prev.created
+	}
+	s.sessions[token.ID] = entry
+	return nil
+}
+
+// Sessions returns the active sessions for a DID and their tokens.
+func (s *Store) Sessions(did string) ([]*protov1.Session, []string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var active []*session
+	for _, e := range s.sessions {
+		if e.token.DID == did && !e.renewed && !e.revoked && e.token.Expires.After(time.Now()) {
+			active = append(active, e)
+		}
+	}
+	sort.Slice(active, func(i, j int) bool { return active[i].created.Before(active[j].created) })
+	var (
+		list   []*protov1.Session
+		tokens []string
+	)
+	for _, e := range active {
+		list = append(list, &protov1.Session{
+			Id:      e.session,
+			Role:    e.token.Role,
+			Created: e.created.Unix(),
+			Issued:  e.token.Issued.Unix(),
+			Expires: e.token.Expires.Unix(),
+		})
+		tokens = append(tokens, e.token.ID)
+	}
+	return list, tokens, nil
+}
+
+// RevokeSession invalidates all the access tokens issued for a session.
+func (s *Store) RevokeSession(did, id string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	found := false
+	for _, e := range s.sessions {
+		if e.token.DID == did && e.session == id {
+			e.revoked = true
+			e.reassign = ""
+			found = true
+		}
+	}
+	return found, nil
+}
+
+// ReassignRole revokes the access tokens of a DID so they can only be
+// renewed with a new role.
+func (s *Store) ReassignRole(did, role string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var count int64
+	for _, e := range s.sessions {
+		if e.token.DID == did && !e.renewed && (!e.revoked || e.reassign != "") {
+			e.revoked = true
+			e.reassign = role
+			count++
+		}
+	}
+	return count, nil
+}
+
+// SessionRevoked returns true if an access token was revoked, along with the
+// role to issue on renewal, if any.
+func (s *Store) SessionRevoked(id string) (bool, string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.sessions[id]
+	if !ok {
+		return false, "", nil
+	}
+	if e.renewed {
+		return true, "", nil
+	}
+	return e.revoked, e.reassign, nil
+}
+
+// RevokedTokens returns the identifiers of all revoked access tokens not yet
+// expired.
+func (s *Store) RevokedTokens() ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var ids []string
+	for id, e := range s.sessions {
+		if e.revoked && e.token.Expires.After(time.Now()) {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// AuthFailure registers a failed authentication attempt for a key and
+// returns the number of failures registered.
+func (s *Store) AuthFailure(key string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	l, ok := s.lockouts[key]
+	if !ok {
+		l = &lockout{}
+		s.lockouts[key] = l
+	}
+	l.failures++
+	return l.failures, nil
+}
+
+// Lock rejects authentication attempts for a key until a given time.
+func (s *Store) Lock(key string, until time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if l, ok := s.lockouts[key]; ok {
+		l.until = until
+	}
+	return nil
+}
+
+// LockedUntil returns the time authentication attempts will be accepted
+// again for any of the keys, or a zero value.
+func (s *Store) LockedUntil(keys ...string) (time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	until := time.Time{}
+	for _, k := range keys {
+		if l, ok := s.lockouts[k]; ok && l.until.After(time.Now()) && l.until.After(until) {
+			until = l.until
+		}
+	}
+	return until, nil
+}
+
+// ResetAuthFailures discards the failed attempts registered for a key.
+func (s *Store) ResetAuthFailures(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.lockouts, key)
+	return nil
+}
+
+// UseNonce registers the nonce of a signature proof, returning false if it
+// was already used. Nonces are only removed when released.
+func (s *Store) UseNonce(did, nonce string, expires time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := did + ":" + nonce
+	if s.nonces[key] {
+		return false, nil
+	}
+	s.nonces[key] = true
+	return true, nil
+}
+
+// ReserveNonces registers the nonces of signature proofs and returns the
+// nonces already used.
+func (s *Store) ReserveNonces(did string, nonces map[string]time.Time) (map[string]bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	used := make(map[string]bool)
+	for nonce := range nonces {
+		key := did + ":" + nonce
+		if s.nonces[key] {
+			used[nonce] = true
+			continue
+		}
+		s.nonces[key] = true
+	}
+	return used, nil
+}
+
+// ReleaseNonces removes registered nonces.
+func (s *Store) ReleaseNonces(did string, nonces []string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, nonce := range nonces {
+		delete(s.nonces, did+":"+nonce)
+	}
+	return nil
+}
+
+// SetLanguage registers the preferred language for a user.
+func (s *Store) SetLanguage(did string, lang i18n.Language) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.langs[did] = lang
+	return nil
+}
+
+// Language returns the preferred language for a user, or the default
+// language if none was registered.
+func (s *Store) Language(did string) i18n.Language {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if lang, ok := s.langs[did]; ok && i18n.Supported(lang) {
+		return lang
+	}
+	return i18n.Default
+}
+
+// RegisterAPIKey adds a new API key along with the hash of its secret.
+func (s *Store) RegisterAPIKey(key *protov1.APIKey, hash string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.apiKeys[key.Id]; ok {
+		return errors.New("duplicate API key")
+	}
+	s.apiKeys[key.Id] = &storage.APIKeyRecord{Key: key, Hash: hash}
+	return nil
+}
+
+// APIKey returns the details of a registered API key.
+func (s *Store) APIKey(id string) (*storage.APIKeyRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rec, ok := s.apiKeys[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	cp := *rec
+	return &cp, nil
+}
+
+// APIKeys returns the details of all registered API keys, oldest first.
+func (s *Store) APIKeys() ([]*protov1.APIKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var list []*protov1.APIKey
+	for _, rec := range s.apiKeys {
+		list = append(list, rec.Key)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Created < list[j].Created })
+	return list, nil
+}
+
+// RotateAPIKey replaces the secret of an API key. The previous secret
+// remains valid for the provided grace period.
+func (s *Store) RotateAPIKey(id, hash string, grace time.Duration) (*protov1.APIKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rec, ok := s.apiKeys[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	now := time.Now()
+	rec.PreviousHash = rec.Hash
+	rec.PreviousExpires = now.Add(grace)
+	rec.Hash = hash
+	rec.Key.Rotated = now.Unix()
+	return rec.Key, nil
+}
+
+// RevokeAPIKey permanently removes an API key.
+func (s *Store) RevokeAPIKey(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.apiKeys[id]; !ok {
+		return errors.New("unknown API key")
+	}
+	delete(s.apiKeys, id)
+	return nil
+}
+
+// QuotaUsage returns the number of records stored for a DID during the UTC
+// day of 'date'.
+func (s *Store) QuotaUsage(did string, date time.Time) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.quotas[quotaKey(did, date)], nil
+}
+
+// AddQuotaUsage registers records stored for a DID during the UTC day of
+// 'date' and returns the updated count.
+func (s *Store) AddQuotaUsage(did string, date time.Time, n int) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := quotaKey(did, date)
+	s.quotas[key] += n
+	return s.quotas[key], nil
+}
+
+// ReserveQuota reserves up to 'n' records for a DID during the UTC day of
+// 'date', without exceeding 'limit'.
+func (s *Store) ReserveQuota(did string, date time.Time, n, limit int) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := quotaKey(did, date)
+	if left := limit - s.quotas[key]; left < n {
+		n = left
+	}
+	if n <= 0 {
+		return 0, nil
+	}
+	s.quotas[key] += n
+	return n, nil
+}
+
+// UseExposureChecks registers 'n' elements submitted by a DID for exposure
+// self-checks during the UTC day of 'date'. Returns false, with nothing
+// registered, if the elements exceed 'limit'.
+func (s *Store) UseExposureChecks(did string, date time.Time, n, limit int) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := quotaKey(did, date)
+	if s.checks[key]+n > limit {
+		return false, nil
+	}
+	s.checks[key] += n
+	return true, nil
+}
+
+func quotaKey(did string, date time.Time) string {
+	return did + ":" + date.UTC().Format("2006-01-02")
+}
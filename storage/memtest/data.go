@@ -0,0 +1,363 @@
+package memtest
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/covid-tracking/storage"
+)
+
+// Stored submission receipt.
+type submission struct {
+	did    string
+	status *protov1.SubmissionStatusResponse
+}
+
+// RegisterSubmission registers a new pending submission.
+func (s *Store) RegisterSubmission(id, did string, records []*protov1.RecordStatus) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.submissions[id] = &submission{
+		did: did,
+		status: &protov1.SubmissionStatusResponse{
+			Receipt: id,
+			Status:  storage.SubmissionPending,
+			Records: records,
+			Created: time.Now().Unix(),
+		},
+	}
+	return nil
+}
+
+// Submission returns the current status of a submission by a DID.
+func (s *Store) Submission(did, id string) (*protov1.SubmissionStatusResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sub, ok := s.submissions[id]
+	if !ok || sub.did != did {
+		return nil, ErrNotFound
+	}
+	return sub.status, nil
+}
+
+// FinishSubmission records the final status of a pending submission.
+func (s *Store) FinishSubmission(id string, records []*protov1.RecordStatus, subErr error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sub, ok := s.submissions[id]
+	if !ok || sub.status.Status != storage.SubmissionPending {
+		return nil
+	}
+	sub.status.Processed = time.Now().Unix()
+	if subErr != nil {
+		sub.status.Status = storage.SubmissionFailed
+		return nil
+	}
+	sub.status.Status = storage.SubmissionProcessed
+	sub.status.Records = records
+	return nil
+}
+
+// RegisterVenue adds a new venue and returns its details.
+func (s *Store) RegisterVenue(owner string, req *protov1.RegisterVenueRequest) (*protov1.Venue, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id := uuid.New().String()
+	venue := &protov1.Venue{
+		Id:      id,
+		Name:    req.Name,
+		Lat:     req.Lat,
+		Lng:     req.Lng,
+		QrCode:  fmt.Sprintf("ct19:venue:%s", id),
+		Owner:   owner,
+		Created: time.Now().Unix(),
+	}
+	s.venues[id] = venue
+	return venue, nil
+}
+
+// VenueExists returns true if a venue is registered.
+func (s *Store) VenueExists(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.venues[id]
+	return ok
+}
+
+// Venue returns the details of a registered venue.
+func (s *Store) Venue(id string) (*protov1.Venue, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	venue, ok := s.venues[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return venue, nil
+}
+
+// VenueName returns the display name of a venue, if registered.
+func (s *Store) VenueName(id string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if venue, ok := s.venues[id]; ok {
+		return venue.Name
+	}
+	return ""
+}
+
+// ClientVersions returns the number of location records, and distinct
+// users, submitted by each client application version.
+func (s *Store) ClientVersions(from, to time.Time) ([]*protov1.ClientVersionStats, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	type client struct{ app, sdk, platform string }
+	type version struct {
+		stats *protov1.ClientVersionStats
+		users map[string]bool
+	}
+	versions := make(map[client]*version)
+	for _, r := range s.records {
+		if r.Timestamp < from.Unix() || r.Timestamp >= to.Unix() {
+			continue
+		}
+		key := client{}
+		if r.Client != nil {
+			key = client{r.Client.AppVersion, r.Client.SdkVersion, r.Client.Platform}
+		}
+		v, ok := versions[key]
+		if !ok {
+			v = &version{stats: &protov1.ClientVersionStats{}, users: make(map[string]bool)}
+			if key != (client{}) {
+				v.stats.Client = &protov1.ClientInfo{AppVersion: key.app, SdkVersion: key.sdk, Platform: key.platform}
+			}
+			versions[key] = v
+		}
+		v.users[r.Did] = true
+		v.stats.Records++
+		if r.Timestamp > v.stats.LastSeen {
+			v.stats.LastSeen = r.Timestamp
+		}
+	}
+	list := make([]*protov1.ClientVersionStats, 0, len(versions))
+	for _, v := range versions {
+		v.stats.Users = int64(len(v.users))
+		list = append(list, v.stats)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Records > list[j].Records })
+	return list, nil
+}
+
+// SaveAnalytics stores hotspots and movement flows. Existing entries for the
+// same area and period are replaced.
+func (s *Store) SaveAnalytics(hotspots []*protov1.Hotspot, flows []*protov1.Flow) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, h := range hotspots {
+		s.hotspots[fmt.Sprintf("%s:%d:%d", h.Cell, h.From, h.To)] = h
+	}
+	for _, f := range flows {
+		s.flows[fmt.Sprintf("%s:%s:%d:%d", f.Origin, f.Destination, f.From, f.To)] = f
+	}
+	return nil
+}
+
+// Analytics returns the stored aggregates for periods contained in the time
+// range.
+func (s *Store) Analytics(from, to time.Time) ([]*protov1.Hotspot, []*protov1.Flow, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var hotspots []*protov1.Hotspot
+	for _, h := range s.hotspots {
+		if h.From >= from.Unix() && h.To <= to.Unix() {
+			hotspots = append(hotspots, h)
+		}
+	}
+	var flows []*protov1.Flow
+	for _, f := range s.flows {
+		if f.From >= from.Unix() && f.To <= to.Unix() {
+			flows = append(flows, f)
+		}
+	}
+	return hotspots, flows, nil
+}
+
+// RegisterOrganization adds a new organization.
+func (s *Store) RegisterOrganization(org *protov1.Organization) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.orgs[org.Id]; ok {
+		return errors.New("duplicate organization")
+	}
+	s.orgs[org.Id] = org
+	return nil
+}
+
+// Organization returns the details of a registered organization.
+func (s *Store) Organization(id string) (*protov1.Organization, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	org, ok := s.orgs[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return org, nil
+}
+
+// Organizations returns all registered organizations, sorted by name.
+func (s *Store) Organizations() ([]*protov1.Organization, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var list []*protov1.Organization
+	for _, org := range s.orgs {
+		list = append(list, org)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list, nil
+}
+
+// AddMember registers an agent as member of an organization, replacing any
+// previous membership.
+func (s *Store) AddMember(org, did string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.members[did] = org
+	return nil
+}
+
+// RemoveMember removes an agent from an organization.
+func (s *Store) RemoveMember(org, did string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.members[did] != org {
+		return errors.New("unknown member")
+	}
+	delete(s.members, did)
+	return nil
+}
+
+// MemberOf returns the organization an agent belongs to, if any.
+func (s *Store) MemberOf(did string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.members[did]
+}
+
+// Message stores an encrypted message for its recipient and sets its
+// identifier and creation date.
+func (s *Store) Message(m *protov1.EncryptedMessage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	m.Id = uuid.New().String()
+	m.Created = time.Now().Unix()
+	s.messages = append(s.messages, m)
+	return nil
+}
+
+// Messages returns, oldest first, up to 'limit' messages received by a user
+// since a date, inclusive.
+func (s *Store) Messages(did string, since time.Time, limit int) ([]*protov1.EncryptedMessage, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var list []*protov1.EncryptedMessage
+	for _, m := range s.messages {
+		if len(list) == limit {
+			break
+		}
+		if m.Did == did && m.Created >= since.Unix() {
+			list = append(list, m)
+		}
+	}
+	return list, nil
+}
+
+// PlaceLegalHold exempts the data of a user from the retention policy. Data
+// is never removed by the store, so only the hold is registered.
+func (s *Store) PlaceLegalHold(hold *protov1.LegalHold) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.holds[hold.Did] = hold
+	return nil
+}
+
+// ReleaseLegalHold removes the hold placed on the data of a user.
+func (s *Store) ReleaseLegalHold(did string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.holds[did]; !ok {
+		return false, nil
+	}
+	delete(s.holds, did)
+	return true, nil
+}
+
+// LegalHold returns the hold placed on the data of a user.
+func (s *Store) LegalHold(did string) (*protov1.LegalHold, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	hold, ok := s.holds[did]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return hold, nil
+}
+
+// PreserveHeldData is a no-op, data is never removed by the store.
+func (s *Store) PreserveHeldData() (int64, error) {
+	return 0, nil
+}
+
+// SubjectData compiles all the data stored for a user.
+func (s *Store) SubjectData(did string) (*protov1.SubjectAccessResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res := &protov1.SubjectAccessResponse{
+		Did:       did,
+		Generated: time.Now().Unix(),
+		LegalHold: s.holds[did],
+	}
+	for _, r := range s.records {
+		if r.Did == did {
+			res.Records = append(res.Records, r.LocationRecord)
+		}
+	}
+	for _, r := range s.checkIns {
+		if r.Did == did {
+			res.CheckIns = append(res.CheckIns, r)
+		}
+	}
+	for _, d := range s.diagnoses {
+		if d.Did == did {
+			res.Diagnoses = append(res.Diagnoses, d)
+		}
+	}
+	for _, e := range s.exposures {
+		if e.Did == did {
+			res.Exposures = append(res.Exposures, e)
+		}
+	}
+	for _, n := range s.notices {
+		if n.Did == did {
+			res.Notifications = append(res.Notifications, n.Notification)
+		}
+	}
+	for _, m := range s.messages {
+		if m.Did == did {
+			res.Messages = append(res.Messages, m)
+		}
+	}
+	for _, e := range s.audit {
+		if e.Actor == did {
+			res.Audit = append(res.Audit, &protov1.AuditRecord{
+				Timestamp: e.Timestamp.Unix(),
+				Event:     e.Event,
+				Address:   e.Address,
+				Details:   e.Details,
+			})
+		}
+	}
+	return res, nil
+}
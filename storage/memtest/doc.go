@@ -0,0 +1,18 @@
+/*
+Package memtest provides an in-memory implementation of the storage
+repositories, meant to be used as a test double and as the embedded store
+of standalone instances.
+
+The store implements all the storage repositories without requiring a
+running storage component, so the API server and worker logic built on top
+of them can be covered by regular unit tests.
+
+	store := memtest.New()
+	_ = store.Records().LocationRecords(records)
+	contacts, _ := store.Records().Contacts(did, from, to)
+
+Data is not persisted and the behavior of the storage component is only
+approximated; i.e. retention policies and notification templates are
+ignored, and the tamper-evident ledger is always disabled.
+*/
+package memtest
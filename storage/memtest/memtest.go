@@ -0,0 +1,793 @@
+package memtest
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.bryk.io/covid-tracking/i18n"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/covid-tracking/storage"
+	"go.bryk.io/covid-tracking/utils"
+)
+
+// ErrNotFound is returned when a requested entry is not available.
+var ErrNotFound = errors.New("not found")
+
+// Ensure the store implements all the repositories.
+var _ storage.Repositories = (*Store)(nil)
+
+// Stored activation code.
+type code struct {
+	did    string
+	role   string
+	scope  []string
+	device string
+}
+
+// Stored location record.
+type record struct {
+	*protov1.LocationRecord
+	cell   string
+	bucket int64
+}
+
+// Stored notification.
+type notification struct {
+	*protov1.Notification
+	source   string
+	status   string
+	attempts int
+}
+
+// Store keeps all data in memory. The zero value is not usable, use New to
+// get a store instance.
+type Store struct {
+	codes     map[string]*code
+	campaign  map[string][]string
+	records   []*record
+	checkIns  []*protov1.CheckInRecord
+	diagnoses map[string]*protov1.Diagnosis
+	exposures []*protov1.Exposure
+	notices   map[string]*notification
+	templates map[string]*protov1.NotificationTemplate
+	audit     []*storage.AuditEntry
+	sessions  map[string]*session
+	lockouts  map[string]*lockout
+	nonces    map[string]bool
+	langs     map[string]i18n.Language
+	apiKeys   map[string]*storage.APIKeyRecord
+	quotas    map[string]int
+	checks    map[string]int
+
+	submissions map[string]*submission
+	venues      map[string]*protov1.Venue
+	hotspots    map[string]*protov1.Hotspot
+	flows       map[string]*protov1.Flow
+	orgs        map[string]*protov1.Organization
+	members     map[string]string
+	messages    []*protov1.EncryptedMessage
+	holds       map[string]*protov1.LegalHold
+	jobs        []*protov1.Job
+	schedule    map[string]*scheduledRun
+	workers     map[string]*protov1.WorkerStatus
+	queues      map[string]*protov1.QueueStats
+	maintenance *protov1.MaintenanceStatus
+	undelivered map[string]*storage.UndeliveredMessage
+	tickets     map[string]*storage.PublishTicket
+	fedKeys     []*federationKey
+	batches     map[string]bool
+	markers     []storage.Marker
+	replicated  map[markerKey]time.Time
+	cursors     map[string]int64
+	mu          sync.Mutex
+}
+
+// New returns an empty store.
+func New() *Store {
+	return &Store{
+		codes:     make(map[string]*code),
+		campaign:  make(map[string][]string),
+		diagnoses: make(map[string]*protov1.Diagnosis),
+		notices:   make(map[string]*notification),
+		templates: make(map[string]*protov1.NotificationTemplate),
+		sessions:  make(map[string]*session),
+		lockouts:  make(map[string]*lockout),
+		nonces:    make(map[string]bool),
+		langs:     make(map[string]i18n.Language),
+		apiKeys:   make(map[string]*storage.APIKeyRecord),
+		quotas:    make(map[string]int),
+		checks:    make(map[string]int),
+
+		submissions: make(map[string]*submission),
+		venues:      make(map[string]*protov1.Venue),
+		hotspots:    make(map[string]*protov1.Hotspot),
+		flows:       make(map[string]*protov1.Flow),
+		orgs:        make(map[string]*protov1.Organization),
+		members:     make(map[string]string),
+		holds:       make(map[string]*protov1.LegalHold),
+		schedule:    make(map[string]*scheduledRun),
+		workers:     make(map[string]*protov1.WorkerStatus),
+		queues:      make(map[string]*protov1.QueueStats),
+		undelivered: make(map[string]*storage.UndeliveredMessage),
+		tickets:     make(map[string]*storage.PublishTicket),
+		batches:     make(map[string]bool),
+		replicated:  make(map[markerKey]time.Time),
+		cursors:     make(map[string]int64),
+	}
+}
+
+// Codes returns the activation codes repository.
+func (s *Store) Codes() storage.CodesRepo {
+	return s
+}
+
+// Records returns the location and check-in records repository.
+func (s *Store) Records() storage.RecordsRepo {
+	return s
+}
+
+// Exposures returns the diagnoses and exposures repository.
+func (s *Store) Exposures() storage.ExposuresRepo {
+	return s
+}
+
+// Notifications returns the notifications repository.
+func (s *Store) Notifications() storage.NotificationsRepo {
+	return s
+}
+
+// AuditLog returns the audit log repository.
+func (s *Store) AuditLog() storage.AuditRepo {
+	return s
+}
+
+// AccessTokens returns the sessions repository.
+func (s *Store) AccessTokens() storage.SessionsRepo {
+	return s
+}
+
+// Lockouts returns the failed authentication counters repository.
+func (s *Store) Lockouts() storage.LockoutsRepo {
+	return s
+}
+
+// Nonces returns the used signature nonces repository.
+func (s *Store) Nonces() storage.NoncesRepo {
+	return s
+}
+
+// Preferences returns the user preferences repository.
+func (s *Store) Preferences() storage.PreferencesRepo {
+	return s
+}
+
+// APIKeyRegistry returns the API keys repository.
+func (s *Store) APIKeyRegistry() storage.APIKeysRepo {
+	return s
+}
+
+// Quotas returns the ingestion quota counters repository.
+func (s *Store) Quotas() storage.QuotasRepo {
+	return s
+}
+
+// Submissions returns the submission receipts repository.
+func (s *Store) Submissions() storage.SubmissionsRepo {
+	return s
+}
+
+// Venues returns the venues repository.
+func (s *Store) Venues() storage.VenuesRepo {
+	return s
+}
+
+// Aggregates returns the analytics repository.
+func (s *Store) Aggregates() storage.AnalyticsRepo {
+	return s
+}
+
+// OrganizationRegistry returns the organizations repository.
+func (s *Store) OrganizationRegistry() storage.OrganizationsRepo {
+	return s
+}
+
+// Mailbox returns the encrypted messages repository.
+func (s *Store) Mailbox() storage.MessagesRepo {
+	return s
+}
+
+// LegalHolds returns the legal holds repository.
+func (s *Store) LegalHolds() storage.HoldsRepo {
+	return s
+}
+
+// JobQueue returns the administrative jobs repository.
+func (s *Store) JobQueue() storage.JobsRepo {
+	return s
+}
+
+// Scheduler returns the scheduled jobs repository.
+func (s *Store) Scheduler() storage.SchedulerRepo {
+	return s
+}
+
+// WorkerRegistry returns the worker heartbeats repository.
+func (s *Store) WorkerRegistry() storage.WorkersRepo {
+	return s
+}
+
+// Queues returns the broker queue statistics repository.
+func (s *Store) Queues() storage.QueuesRepo {
+	return s
+}
+
+// Settings returns the shared settings repository.
+func (s *Store) Settings() storage.SettingsRepo {
+	return s
+}
+
+// Undelivered returns the undelivered broker messages repository.
+func (s *Store) Undelivered() storage.UndeliveredRepo {
+	return s
+}
+
+// Tickets returns the DID publish tickets repository.
+func (s *Store) Tickets() storage.TicketsRepo {
+	return s
+}
+
+// Federation returns the federation keys repository.
+func (s *Store) Federation() storage.FederationRepo {
+	return s
+}
+
+// Replication returns the replication markers repository.
+func (s *Store) Replication() storage.ReplicationRepo {
+	return s
+}
+
+// Ledger returns the tamper-evident ledger repository.
+func (s *Store) Ledger() storage.LedgerRepo {
+	return s
+}
+
+// Purge is a no-op, retention policies are ignored.
+func (s *Store) Purge() (int64, error) {
+	return 0, nil
+}
+
+// Ping always succeeds.
+func (s *Store) Ping() error {
+	return nil
+}
+
+// Close is a no-op, the store can still be used after closing it.
+func (s *Store) Close() {}
+
+// Audit registers a new entry on the audit log.
+func (s *Store) Audit(entry *storage.AuditEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if entry.Timestamp.IsZero() {
+		entry.Timestamp = time.Now()
+	}
+	s.audit = append(s.audit, entry)
+	return nil
+}
+
+// AuditEntries returns the entries registered on the audit log.
+func (s *Store) AuditEntries() []*storage.AuditEntry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*storage.AuditEntry{}, s.audit...)
+}
+
+// ActivationCode creates a new activation code for a DID and role.
+func (s *Store) ActivationCode(req *protov1.ActivationCodeRequest) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ac := uuid.New().String()
+	s.codes[ac] = &code{did: req.Did, role: req.Role, scope: req.Scope, device: req.Device}
+	return ac, nil
+}
+
+// RoleCodes prepares the storage of activation codes for a custom role.
+func (s *Store) RoleCodes(_ string) error {
+	return nil
+}
+
+// VerifyActivationCode checks and consumes an activation code, returning
+// the scope assigned to it, if any. Codes bound to a device are only valid
+// for requests including the same device value.
+func (s *Store) VerifyActivationCode(req *protov1.CredentialsRequest) ([]string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if c, ok := s.codes[req.ActivationCode]; ok && c.did == req.Did && c.role == req.Role {
+		if c.device != "" && c.device != req.Device {
+			return nil, false
+		}
+		delete(s.codes, req.ActivationCode)
+		return c.scope, true
+	}
+	if scope, ok := s.campaign[req.ActivationCode]; ok && req.Role == "user" {
+		delete(s.campaign, req.ActivationCode)
+		return scope, true
+	}
+	return nil, false
+}
+
+// CampaignCodes creates a batch of activation codes not bound to a DID.
+func (s *Store) CampaignCodes(_ string, scope []string, count int) ([]string, time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	list := make([]string, count)
+	for i := range list {
+		list[i] = uuid.New().String()
+		s.campaign[list[i]] = scope
+	}
+	return list, time.Now().Add(30 * 24 * time.Hour), nil
+}
+
+// LocationRecords adds location entries.
+func (s *Store) LocationRecords(records []*protov1.LocationRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, r := range records {
+		s.records = append(s.records, &record{
+			LocationRecord: r,
+			cell:           utils.GeoHash(float64(r.Lat), float64(r.Lng), utils.CellPrecision),
+			bucket:         utils.TimeBucket(time.Unix(r.Timestamp, 0), utils.BucketSize),
+		})
+	}
+	return nil
+}
+
+// CheckIns adds venue check-in entries.
+func (s *Store) CheckIns(records []*protov1.CheckInRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.checkIns = append(s.checkIns, records...)
+	return nil
+}
+
+// ArchiveRecords removes location records older than 'cutoff'. Records are
+// always discarded.
+func (s *Store) ArchiveRecords(cutoff time.Time, _ bool) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var kept []*record
+	removed := 0
+	for _, r := range s.records {
+		if r.Timestamp < cutoff.Unix() {
+			removed++
+			continue
+		}
+		kept = append(kept, r)
+	}
+	s.records = kept
+	if removed == 0 {
+		return nil, nil
+	}
+	return []string{"records"}, nil
+}
+
+// Contacts returns the users with records on the same cells and time
+// buckets as 'id' during a period of time.
+func (s *Store) Contacts(id string, from, to time.Time) ([]string, error) {
+	cells, _ := s.Cells(id, from, to)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	visited := make(map[storage.Cell]bool)
+	for _, c := range cells {
+		visited[c] = true
+	}
+	var list []string
+	seen := make(map[string]bool)
+	for _, r := range s.records {
+		if r.Did == id || r.AggregateOnly || seen[r.Did] || !within(r.Timestamp, from, to) {
+			continue
+		}
+		if visited[storage.Cell{ID: r.cell, Bucket: r.bucket}] {
+			seen[r.Did] = true
+			list = append(list, r.Did)
+		}
+	}
+	return list, nil
+}
+
+// Cells returns the cells and time buckets visited by a user.
+func (s *Store) Cells(did string, from, to time.Time) ([]storage.Cell, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var list []storage.Cell
+	seen := make(map[storage.Cell]bool)
+	for _, r := range s.records {
+		c := storage.Cell{ID: r.cell, Bucket: r.bucket}
+		if r.Did == did && !r.AggregateOnly && within(r.Timestamp, from, to) && !seen[c] {
+			seen[c] = true
+			list = append(list, c)
+		}
+	}
+	return list, nil
+}
+
+// PresentAt returns the users with records on any of the provided cells.
+func (s *Store) PresentAt(cells []storage.Cell) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	filter := make(map[storage.Cell]bool)
+	for _, c := range cells {
+		filter[c] = true
+	}
+	var list []string
+	seen := make(map[string]bool)
+	for _, r := range s.records {
+		if !seen[r.Did] && filter[storage.Cell{ID: r.cell, Bucket: r.bucket}] {
+			seen[r.Did] = true
+			list = append(list, r.Did)
+		}
+	}
+	return list, nil
+}
+
+// PresenceWithin returns the users with records inside an area, tagged with
+// the processing purpose, grouped by cell and time bucket.
+func (s *Store) PresenceWithin(polygon [][2]float64, from, to time.Time, purpose string) ([]*storage.Presence, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	groups := make(map[storage.Cell]*storage.Presence)
+	var list []*storage.Presence
+	for _, r := range s.records {
+		if r.AggregateOnly || !contains(storage.RecordPurposes(r.LocationRecord), purpose) ||
+			!within(r.Timestamp, from, to) || !inPolygon(polygon, float64(r.Lng), float64(r.Lat)) {
+			continue
+		}
+		key := storage.Cell{ID: r.cell, Bucket: r.bucket}
+		p, ok := groups[key]
+		if !ok {
+			p = &storage.Presence{Cell: r.cell, Bucket: r.bucket}
+			groups[key] = p
+			list = append(list, p)
+		}
+		if !contains(p.Users, r.Did) {
+			p.Users = append(p.Users, r.Did)
+		}
+	}
+	return list, nil
+}
+
+// Visitors returns the users that checked-in at a venue.
+func (s *Store) Visitors(venue string, from, to time.Time) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var list []string
+	for _, r := range s.checkIns {
+		if r.Venue == venue && within(r.Timestamp, from, to) && !contains(list, r.Did) {
+			list = append(list, r.Did)
+		}
+	}
+	return list, nil
+}
+
+// ClusterRecords calculates hotspots and movement flows.
+func (s *Store) ClusterRecords(from, to time.Time, precision, k int) ([]*protov1.Hotspot, []*protov1.Flow, error) {
+	s.mu.Lock()
+	records := make([]*record, 0, len(s.records))
+	for _, r := range s.records {
+		if r.Timestamp >= from.Unix() && r.Timestamp < to.Unix() && len(r.cell) >= precision {
+			records = append(records, r)
+		}
+	}
+	s.mu.Unlock()
+	sort.SliceStable(records, func(i, j int) bool {
+		if records[i].Did != records[j].Did {
+			return records[i].Did < records[j].Did
+		}
+		return records[i].Timestamp < records[j].Timestamp
+	})
+
+	type transition struct{ origin, destination string }
+	cells := make(map[string]map[string]bool)
+	flows := make(map[transition]map[string]bool)
+	prev := struct{ did, cell string }{}
+	for _, r := range records {
+		cell := r.cell[:precision]
+		if cells[cell] == nil {
+			cells[cell] = make(map[string]bool)
+		}
+		cells[cell][r.Did] = true
+		if prev.did == r.Did && prev.cell != cell {
+			t := transition{origin: prev.cell, destination: cell}
+			if flows[t] == nil {
+				flows[t] = make(map[string]bool)
+			}
+			flows[t][r.Did] = true
+		}
+		prev.did, prev.cell = r.Did, cell
+	}
+	var hotspots []*protov1.Hotspot
+	for cell, users := range cells {
+		if len(users) >= k {
+			lat, lng := utils.GeoHashCenter(cell)
+			hotspots = append(hotspots, &protov1.Hotspot{
+				Cell:  cell,
+				Lat:   float32(lat),
+				Lng:   float32(lng),
+				Users: int64(len(users)),
+				From:  from.Unix(),
+				To:    to.Unix(),
+			})
+		}
+	}
+	var movements []*protov1.Flow
+	for t, users := range flows {
+		if len(users) >= k {
+			movements = append(movements, &protov1.Flow{
+				Origin:      t.origin,
+				Destination: t.destination,
+				Users:       int64(len(users)),
+				From:        from.Unix(),
+				To:          to.Unix(),
+			})
+		}
+	}
+	return hotspots, movements, nil
+}
+
+// ExportRecords traverses the location records matching a filter in
+// batches, in chronological order.
+func (s *Store) ExportRecords(ctx context.Context, filter storage.RecordsFilter, size int,
+	fn func([]*protov1.LocationRecord) error) error {
+	s.mu.Lock()
+	var list []*protov1.LocationRecord
+	for _, r := range s.records {
+		if !within(r.Timestamp, filter.From, filter.To) ||
+			(filter.DID != "" && r.Did != filter.DID) ||
+			(filter.Purpose != "" && !contains(storage.RecordPurposes(r.LocationRecord), filter.Purpose)) ||
+			!strings.HasPrefix(r.cell, filter.Cell) {
+			continue
+		}
+		list = append(list, r.LocationRecord)
+	}
+	s.mu.Unlock()
+	sort.SliceStable(list, func(i, j int) bool { return list[i].Timestamp < list[j].Timestamp })
+	for len(list) > 0 {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		n := size
+		if n > len(list) {
+			n = len(list)
+		}
+		if err := fn(list[:n]); err != nil {
+			return err
+		}
+		list = list[n:]
+	}
+	return nil
+}
+
+// Diagnosis registers a new diagnosis for a user.
+func (s *Store) Diagnosis(did, result, source string, date time.Time) (*protov1.Diagnosis, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	d := &protov1.Diagnosis{
+		Id:        uuid.New().String(),
+		Did:       did,
+		Result:    result,
+		Timestamp: date.Unix(),
+		Source:    source,
+	}
+	s.diagnoses[d.Id] = d
+	return d, nil
+}
+
+// FindDiagnosis returns a diagnosis by identifier.
+func (s *Store) FindDiagnosis(id string) (*protov1.Diagnosis, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	d, ok := s.diagnoses[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return d, nil
+}
+
+// Exposure registers a user as exposed to a diagnosed case.
+func (s *Store) Exposure(did string, diagnosis string) (*protov1.Exposure, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e := &protov1.Exposure{
+		Id:        uuid.New().String(),
+		Did:       did,
+		Diagnosis: diagnosis,
+		Timestamp: time.Now().Unix(),
+	}
+	s.exposures = append(s.exposures, e)
+	return e, nil
+}
+
+// PositiveDiagnoses returns the positive diagnoses registered since a date.
+func (s *Store) PositiveDiagnoses(since time.Time) ([]*protov1.Diagnosis, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var list []*protov1.Diagnosis
+	for _, d := range s.diagnoses {
+		if d.Result == "positive" && d.Timestamp >= since.Unix() {
+			list = append(list, d)
+		}
+	}
+	return list, nil
+}
+
+// Exposed returns the users registered as exposed to a diagnosis.
+func (s *Store) Exposed(diagnosis string) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var list []string
+	for _, e := range s.exposures {
+		if e.Diagnosis == diagnosis && !contains(list, e.Did) {
+			list = append(list, e.Did)
+		}
+	}
+	return list, nil
+}
+
+// LastExposure returns the most recent exposure registered for a user.
+func (s *Store) LastExposure(did string) (*protov1.Exposure, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var last *protov1.Exposure
+	for _, e := range s.exposures {
+		if e.Did == did && (last == nil || e.Timestamp >= last.Timestamp) {
+			last = e
+		}
+	}
+	return last, nil
+}
+
+// Notification registers a new notification for a user. Contents are not
+// rendered, the registered templates are ignored.
+func (s *Store) Notification(did, kind, source string, details map[string]string) (*protov1.Notification, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := &protov1.Notification{
+		Id:        uuid.New().String(),
+		Did:       did,
+		Kind:      kind,
+		Timestamp: time.Now().Unix(),
+		Details:   details,
+	}
+	s.notices[n.Id] = &notification{Notification: n, source: source, status: storage.NotificationPending}
+	return n, nil
+}
+
+// NotificationAttempt registers a delivery attempt for a notification.
+func (s *Store) NotificationAttempt(id string, err error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n, ok := s.notices[id]
+	if !ok || (n.status != storage.NotificationPending && n.status != storage.NotificationFailed) {
+		return nil
+	}
+	n.attempts++
+	n.status = storage.NotificationDispatched
+	if err != nil {
+		n.status = storage.NotificationFailed
+	}
+	return nil
+}
+
+// AckNotifications updates the status of notifications received by a user.
+func (s *Store) AckNotifications(did string, ids []string, status string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var count int64
+	for _, id := range ids {
+		n, ok := s.notices[id]
+		if !ok || n.Did != did || n.status == storage.NotificationRead {
+			continue
+		}
+		if status == storage.NotificationDelivered && n.status == storage.NotificationDelivered {
+			continue
+		}
+		n.status = status
+		count++
+	}
+	return count, nil
+}
+
+// NotificationStatus returns the number of notifications, by status,
+// originated by a diagnosis or venue outbreak.
+func (s *Store) NotificationStatus(source string) (map[string]int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	counts := make(map[string]int64)
+	for _, n := range s.notices {
+		if n.source == source {
+			counts[n.status]++
+		}
+	}
+	return counts, nil
+}
+
+// UserNotifications returns the notifications registered for a user. Not part
+// of the repository interfaces, useful to verify the results of a test.
+func (s *Store) UserNotifications(did string) []*protov1.Notification {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var list []*protov1.Notification
+	for _, n := range s.notices {
+		if n.Did == did {
+			list = append(list, n.Notification)
+		}
+	}
+	return list
+}
+
+// SaveTemplate registers, or replaces, a notification template.
+func (s *Store) SaveTemplate(t *protov1.NotificationTemplate) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t.Updated = time.Now().Unix()
+	s.templates[t.Kind+"/"+t.Lang] = t
+	return nil
+}
+
+// Templates returns the registered notification templates.
+func (s *Store) Templates(kind string) ([]*protov1.NotificationTemplate, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var list []*protov1.NotificationTemplate
+	for _, t := range s.templates {
+		if kind == "" || t.Kind == kind {
+			list = append(list, t)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Kind+"/"+list[i].Lang < list[j].Kind+"/"+list[j].Lang
+	})
+	return list, nil
+}
+
+// DeleteTemplate removes a notification template.
+func (s *Store) DeleteTemplate(kind, lang string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.templates[kind+"/"+lang]; !ok {
+		return false, nil
+	}
+	delete(s.templates, kind+"/"+lang)
+	return true, nil
+}
+
+// Verify a timestamp is within a period of time, inclusive.
+func within(ts int64, from, to time.Time) bool {
+	return ts >= from.Unix() && ts <= to.Unix()
+}
+
+func contains(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Ray casting test for a point inside a ring of [lng, lat] pairs.
+func inPolygon(ring [][2]float64, x, y float64) bool {
+	in := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		a, b := ring[i], ring[j]
+		if (a[1] > y) != (b[1] > y) && x < (b[0]-a[0])*(y-a[1])/(b[1]-a[1])+a[0] {
+			in = !in
+		}
+	}
+	return in
+}
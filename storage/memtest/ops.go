@@ -0,0 +1,459 @@
+package memtest
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.bryk.io/covid-tracking/storage"
+)
+
+// Stored claim on a scheduled job.
+type scheduledRun struct {
+	slot   time.Time
+	owner  string
+	locked time.Time
+	result error
+}
+
+// Identifier of a marker received from a peer server.
+type markerKey struct {
+	origin    string
+	diagnosis string
+	cell      string
+	bucket    int64
+}
+
+// Queued federation key.
+type federationKey struct {
+	cell     storage.Cell
+	created  time.Time
+	uploaded bool
+}
+
+// ExportAudit traverses the audit entries matching a filter, in the order
+// they were registered.
+func (s *Store) ExportAudit(ctx context.Context, filter storage.AuditFilter, fn func(*storage.AuditEntry) error) error {
+	for _, e := range s.AuditEntries() {
+		if e.Timestamp.Before(filter.From) || e.Timestamp.After(filter.To) ||
+			(filter.Event != "" && e.Event != filter.Event) {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SubmitJob registers a new pending job.
+func (s *Store) SubmitJob(kind string, params map[string]string, submittedBy string) (*protov1.Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job := &protov1.Job{
+		Id:          uuid.New().String(),
+		Kind:        kind,
+		Params:      params,
+		Status:      storage.JobPending,
+		SubmittedBy: submittedBy,
+		Created:     time.Now().Unix(),
+	}
+	s.jobs = append(s.jobs, job)
+	return job, nil
+}
+
+// Job returns the current details of a job.
+func (s *Store) Job(id string) (*protov1.Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if job := s.job(id); job != nil {
+		return job, nil
+	}
+	return nil, ErrNotFound
+}
+
+// Jobs returns the most recent jobs, optionally filtered by status.
+func (s *Store) Jobs(status string, limit int64) ([]*protov1.Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var list []*protov1.Job
+	for i := len(s.jobs) - 1; i >= 0 && int64(len(list)) < limit; i-- {
+		if status == "" || s.jobs[i].Status == status {
+			list = append(list, s.jobs[i])
+		}
+	}
+	return list, nil
+}
+
+// CancelJob marks a pending or running job as cancelled.
+func (s *Store) CancelJob(id string) (*protov1.Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job := s.job(id)
+	if job == nil {
+		return nil, ErrNotFound
+	}
+	if job.Status == storage.JobPending || job.Status == storage.JobRunning {
+		job.Status = storage.JobCancelled
+		job.Finished = time.Now().Unix()
+	}
+	return job, nil
+}
+
+// StartJob assigns a pending job to a worker. A nil value is returned if the
+// job is no longer pending.
+func (s *Store) StartJob(id, worker string) (*protov1.Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job := s.job(id)
+	if job == nil || job.Status != storage.JobPending {
+		return nil, nil
+	}
+	job.Status = storage.JobRunning
+	job.Worker = worker
+	job.Started = time.Now().Unix()
+	return job, nil
+}
+
+// JobProgress updates the completion percentage of a running job. Returns
+// false if the job is no longer running.
+func (s *Store) JobProgress(id string, progress uint32) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job := s.job(id)
+	if job == nil || job.Status != storage.JobRunning {
+		return false, nil
+	}
+	job.Progress = progress
+	return true, nil
+}
+
+// FinishJob records the outcome of a running job.
+func (s *Store) FinishJob(id string, result map[string]string, jobErr error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job := s.job(id)
+	if job == nil || job.Status != storage.JobRunning {
+		return nil
+	}
+	job.Finished = time.Now().Unix()
+	job.Result = result
+	if jobErr != nil {
+		job.Status = storage.JobFailed
+		job.Error = jobErr.Error()
+		return nil
+	}
+	job.Status = storage.JobCompleted
+	job.Progress = 100
+	return nil
+}
+
+func (s *Store) job(id string) *protov1.Job {
+	for _, job := range s.jobs {
+		if job.Id == id {
+			return job
+		}
+	}
+	return nil
+}
+
+// ClaimScheduledRun registers a worker as responsible for executing a
+// scheduled job slot.
+func (s *Store) ClaimScheduledRun(name, owner string, slot time.Time, lease time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	if run, ok := s.schedule[name]; ok && (!run.slot.Before(slot) || !run.locked.Before(now)) {
+		return false, nil
+	}
+	s.schedule[name] = &scheduledRun{slot: slot, owner: owner, locked: now.Add(lease)}
+	return true, nil
+}
+
+// ScheduledRunFinished releases a claim and records its result.
+func (s *Store) ScheduledRunFinished(name, owner string, result error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if run, ok := s.schedule[name]; ok && run.owner == owner {
+		run.locked = time.Now()
+		run.result = result
+	}
+	return nil
+}
+
+// SaveHeartbeat registers the current status of a worker.
+func (s *Store) SaveHeartbeat(status *protov1.WorkerStatus) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	status.Heartbeat = time.Now().Unix()
+	s.workers[status.Name] = status
+	return nil
+}
+
+// RemoveWorker discards the heartbeat of a worker.
+func (s *Store) RemoveWorker(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.workers, name)
+	return nil
+}
+
+// Workers returns the latest status reported by the workers, sorted by name.
+func (s *Store) Workers() ([]*protov1.WorkerStatus, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var list []*protov1.WorkerStatus
+	for _, w := range s.workers {
+		list = append(list, w)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list, nil
+}
+
+// SaveQueueStats registers the current statistics for a queue.
+func (s *Store) SaveQueueStats(stats *protov1.QueueStats) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stats.Updated = time.Now().Unix()
+	s.queues[stats.Name] = stats
+	return nil
+}
+
+// QueueStats returns the latest statistics for all queues, sorted by name.
+func (s *Store) QueueStats() ([]*protov1.QueueStats, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var list []*protov1.QueueStats
+	for _, q := range s.queues {
+		list = append(list, q)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list, nil
+}
+
+// Maintenance returns the current maintenance mode settings.
+func (s *Store) Maintenance() (*protov1.MaintenanceStatus, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.maintenance == nil {
+		return &protov1.MaintenanceStatus{}, nil
+	}
+	status := *s.maintenance
+	return &status, nil
+}
+
+// SetMaintenance updates the maintenance mode settings.
+func (s *Store) SetMaintenance(status *protov1.MaintenanceStatus) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	status.Updated = time.Now().Unix()
+	saved := *status
+	s.maintenance = &saved
+	return nil
+}
+
+// SaveUndelivered registers, or updates, a message pending delivery.
+func (s *Store) SaveUndelivered(msg *storage.UndeliveredMessage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.undelivered[msg.ID] = msg
+	return nil
+}
+
+// UndeliveredMessages returns the oldest messages pending delivery.
+func (s *Store) UndeliveredMessages(limit int64) ([]*storage.UndeliveredMessage, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var list []*storage.UndeliveredMessage
+	for _, msg := range s.undelivered {
+		list = append(list, msg)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Created.Before(list[j].Created) })
+	if int64(len(list)) > limit {
+		list = list[:limit]
+	}
+	return list, nil
+}
+
+// DeleteUndelivered removes a message once published.
+func (s *Store) DeleteUndelivered(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.undelivered, id)
+	return nil
+}
+
+// SavePublishTicket registers, or updates, a publish ticket.
+func (s *Store) SavePublishTicket(t *storage.PublishTicket) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t.Updated = time.Now()
+	if t.Created.IsZero() {
+		t.Created = t.Updated
+	}
+	if t.Status == "" {
+		t.Status = storage.PublishPending
+	}
+	saved := *t
+	s.tickets[t.ID] = &saved
+	return nil
+}
+
+// PublishTicket returns the stored progress of a publish ticket, nil if not
+// available.
+func (s *Store) PublishTicket(id string) (*storage.PublishTicket, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, ok := s.tickets[id]
+	if !ok {
+		return nil, nil
+	}
+	saved := *t
+	return &saved, nil
+}
+
+// QueueFederationKeys registers cells to share on the next upload.
+func (s *Store) QueueFederationKeys(_ string, cells []storage.Cell) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, c := range cells {
+		s.fedKeys = append(s.fedKeys, &federationKey{cell: c, created: time.Now()})
+	}
+	return nil
+}
+
+// PendingFederationKeys returns the queued cells not yet uploaded.
+func (s *Store) PendingFederationKeys() ([]storage.Cell, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var cells []storage.Cell
+	for _, k := range s.fedKeys {
+		if !k.uploaded {
+			cells = append(cells, k.cell)
+		}
+	}
+	return cells, nil
+}
+
+// FederationKeysUploaded marks the pending cells created before a date as
+// uploaded.
+func (s *Store) FederationKeysUploaded(until time.Time, _ string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, k := range s.fedKeys {
+		if !k.created.After(until) {
+			k.uploaded = true
+		}
+	}
+	return nil
+}
+
+// FederationBatchProcessed registers a downloaded batch as processed,
+// returning false if it was processed before.
+func (s *Store) FederationBatchProcessed(origin, tag string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := origin + ":" + tag
+	if s.batches[key] {
+		return false, nil
+	}
+	s.batches[key] = true
+	return true, nil
+}
+
+// RecordMarkers registers cells visited by a positive case, each one with a
+// unique, increasing, sequence number.
+func (s *Store) RecordMarkers(diagnosis string, cells []storage.Cell) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	for _, c := range cells {
+		s.markers = append(s.markers, storage.Marker{
+			Sequence:  int64(len(s.markers) + 1),
+			Diagnosis: diagnosis,
+			Cell:      c.ID,
+			Bucket:    c.Bucket,
+			Updated:   now,
+		})
+	}
+	return nil
+}
+
+// Markers returns up to 'limit' local markers after a sequence number.
+func (s *Store) Markers(cursor int64, limit int) ([]storage.Marker, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var list []storage.Marker
+	for _, m := range s.markers {
+		if m.Sequence > cursor && len(list) < limit {
+			list = append(list, m)
+		}
+	}
+	return list, nil
+}
+
+// ApplyMarker registers a marker received from a peer server, keeping the
+// most recent version. Returns true only if the marker was not known.
+func (s *Store) ApplyMarker(m storage.Marker, p storage.Provenance) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := markerKey{origin: p.Origin, diagnosis: m.Diagnosis, cell: m.Cell, bucket: m.Bucket}
+	prev, ok := s.replicated[key]
+	if !ok || prev.Before(m.Updated) {
+		s.replicated[key] = m.Updated
+	}
+	return !ok, nil
+}
+
+// ReplicationCursor returns the last sequence number received from a peer
+// server.
+func (s *Store) ReplicationCursor(peer string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.cursors[peer], nil
+}
+
+// SetReplicationCursor updates the last sequence number received from a
+// peer server.
+func (s *Store) SetReplicationCursor(peer string, cursor int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cursors[peer] = cursor
+	return nil
+}
+
+// SealLedger returns no block, the ledger is always disabled.
+func (s *Store) SealLedger() (*storage.LedgerBlock, error) {
+	return nil, nil
+}
+
+// LedgerHead returns no block, the ledger is always disabled.
+func (s *Store) LedgerHead() (*storage.LedgerBlock, error) {
+	return nil, nil
+}
+
+// LastAnchor returns no block, the ledger is always disabled.
+func (s *Store) LastAnchor() (*storage.LedgerBlock, error) {
+	return nil, nil
+}
+
+// AnchorLedger is a no-op, the ledger is always disabled.
+func (s *Store) AnchorLedger(_ int64, _ string) error {
+	return nil
+}
+
+// LedgerInclusion returns ErrNotFound, items are never registered on the
+// ledger.
+func (s *Store) LedgerInclusion(_ string) (*storage.LedgerBlock, error) {
+	return nil, ErrNotFound
+}
+
+// VerifyLedger is a no-op, the ledger is always disabled.
+func (s *Store) VerifyLedger(_ context.Context, _ func(*storage.LedgerBlock) error) error {
+	return nil
+}
@@ -0,0 +1,101 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Message stored for a user.
+type messageEntry struct {
+	ID           string    `bson:"id"`
+	Sender       string    `bson:"sender"`
+	DID          string    `bson:"did"`
+	Key          string    `bson:"key"`
+	Algorithm    string    `bson:"algorithm"`
+	EphemeralKey []byte    `bson:"ephemeral_key"`
+	Nonce        []byte    `bson:"nonce"`
+	Ciphertext   []byte    `bson:"ciphertext"`
+	Created      time.Time `bson:"created"`
+}
+
+// Message stores an encrypted message for its recipient and sets its
+// identifier and creation date. Only the ciphertext is ever received and
+// stored, the server is never able to read the message contents.
+func (st *Handler) Message(m *protov1.EncryptedMessage) error {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	col, err := st.retained(ctx, "messages", "created")
+	if err != nil {
+		return err
+	}
+	entry := &messageEntry{
+		ID:           uuid.New().String(),
+		Sender:       m.Sender,
+		DID:          m.Did,
+		Key:          m.Key,
+		Algorithm:    m.Algorithm,
+		EphemeralKey: m.EphemeralKey,
+		Nonce:        m.Nonce,
+		Ciphertext:   m.Ciphertext,
+		Created:      time.Now(),
+	}
+	if _, err := col.InsertOne(ctx, entry); err != nil {
+		return err
+	}
+	m.Id = entry.ID
+	m.Created = entry.Created.Unix()
+	return nil
+}
+
+// Messages returns, oldest first, up to 'limit' messages received by the
+// user 'did' since 'since', inclusive.
+func (st *Handler) Messages(did string, since time.Time, limit int) ([]*protov1.EncryptedMessage, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+	defer cancel()
+	opts := options.Find().SetSort(bson.M{"created": 1}).SetLimit(int64(limit))
+	filter := bson.M{"did": did, "created": bson.M{"$gte": since}}
+	cur, err := st.db.Collection("messages").Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cur.Close(ctx)
+	}()
+	var list []*protov1.EncryptedMessage
+	for cur.Next(ctx) {
+		entry := &messageEntry{}
+		if err := cur.Decode(entry); err != nil {
+			return nil, err
+		}
+		list = append(list, entry.message())
+	}
+	return list, cur.Err()
+}
+
+func (e *messageEntry) message() *protov1.EncryptedMessage {
+	return &protov1.EncryptedMessage{
+		Id:           e.ID,
+		Sender:       e.Sender,
+		Did:          e.DID,
+		Key:          e.Key,
+		Algorithm:    e.Algorithm,
+		EphemeralKey: e.EphemeralKey,
+		Nonce:        e.Nonce,
+		Ciphertext:   e.Ciphertext,
+		Created:      e.Created.Unix(),
+	}
+}
+
+// Indexes for encrypted messages.
+func messageIndexes(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection("messages").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "did", Value: 1}, {Key: "created", Value: 1}},
+	})
+	return err
+}
@@ -0,0 +1,439 @@
+package storage
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.bryk.io/covid-tracking/utils"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Collection used to keep track of the migrations applied.
+const migrationsCollection = "schema_migrations"
+
+// Migration instances represent a versioned change on the storage schema.
+// Migrations are applied in order and only once.
+type Migration struct {
+	// Sequential version number.
+	Version int
+
+	// Short description of the changes introduced.
+	Description string
+
+	// Apply the changes.
+	up func(ctx context.Context, st *Handler) error
+}
+
+// MigrationStatus provides information about a registered migration.
+type MigrationStatus struct {
+	Version     int
+	Description string
+	Applied     bool
+	AppliedAt   time.Time
+}
+
+// Registered migrations. New entries MUST be appended with the next
+// available version number. Indexes on location records partitions are
+// not handled by migrations since partitions are created dynamically.
+var migrations = []*Migration{
+	{
+		Version:     1,
+		Description: "Expiration indexes for activation codes",
+		up: func(ctx context.Context, st *Handler) error {
+			if _, err := st.db.Collection("user_codes").Indexes().CreateOne(ctx, ttlIndex(userCodeTTL)); err != nil {
+				return err
+			}
+			_, err := st.db.Collection("agent_codes").Indexes().CreateOne(ctx, ttlIndex(agentCodeTTL))
+			return err
+		},
+	},
+	{
+		Version:     2,
+		Description: "Move legacy location records to monthly partitions",
+		up: func(ctx context.Context, st *Handler) error {
+			legacy := st.db.Collection("records")
+			cur, err := legacy.Find(ctx, bson.M{})
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = cur.Close(ctx)
+			}()
+			for cur.Next(ctx) {
+				entry := bson.M{}
+				if err := cur.Decode(&entry); err != nil {
+					return err
+				}
+				ts, ok := entry["timestamp"].(time.Time)
+				if !ok {
+					continue
+				}
+				col, err := st.partition(ctx, partitionName(ts))
+				if err != nil {
+					return err
+				}
+				if _, err := col.InsertOne(ctx, entry); err != nil && !isDuplicateKey(err) {
+					return err
+				}
+			}
+			if err := cur.Err(); err != nil {
+				return err
+			}
+			return legacy.Drop(ctx)
+		},
+	},
+	{
+		Version:     3,
+		Description: "Annotate location records with geohash cell and time bucket",
+		up: func(ctx context.Context, st *Handler) error {
+			names, err := st.db.ListCollectionNames(ctx, bson.M{
+				"name": bson.M{"$regex": "^" + recordsPrefix},
+			})
+			if err != nil {
+				return err
+			}
+			for _, name := range names {
+				col, err := st.partition(ctx, name)
+				if err != nil {
+					return err
+				}
+				if err := annotateCells(ctx, col); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	},
+	{
+		Version:     4,
+		Description: "Indexes for venues, check-in records and notifications",
+		up: func(ctx context.Context, st *Handler) error {
+			if err := venueIndexes(ctx, st.db); err != nil {
+				return err
+			}
+			return notificationIndexes(ctx, st.db)
+		},
+	},
+	{
+		Version:     5,
+		Description: "Indexes for analytics aggregates",
+		up: func(ctx context.Context, st *Handler) error {
+			return analyticsIndexes(ctx, st.db)
+		},
+	},
+	{
+		Version:     6,
+		Description: "Indexes for diagnoses and exposures",
+		up: func(ctx context.Context, st *Handler) error {
+			return diagnosisIndexes(ctx, st.db)
+		},
+	},
+	{
+		Version:     7,
+		Description: "Indexes for federation keys and batches",
+		up: func(ctx context.Context, st *Handler) error {
+			return federationIndexes(ctx, st.db)
+		},
+	},
+	{
+		Version:     8,
+		Description: "Indexes for user preferences",
+		up: func(ctx context.Context, st *Handler) error {
+			return preferenceIndexes(ctx, st.db)
+		},
+	},
+	{
+		Version:     9,
+		Description: "Indexes for API keys",
+		up: func(ctx context.Context, st *Handler) error {
+			return apiKeyIndexes(ctx, st.db)
+		},
+	},
+	{
+		Version:     10,
+		Description: "Indexes for organizations and memberships",
+		up: func(ctx context.Context, st *Handler) error {
+			return organizationIndexes(ctx, st.db)
+		},
+	},
+	{
+		Version:     11,
+		Description: "Indexes for campaign activation codes",
+		up: func(ctx context.Context, st *Handler) error {
+			_, err := st.db.Collection("campaign_codes").Indexes().CreateMany(ctx, []mongo.IndexModel{
+				ttlIndex(campaignCodeTTL),
+				{Keys: bson.M{"code": 1}, Options: options.Index().SetUnique(true)},
+			})
+			return err
+		},
+	},
+	{
+		Version:     12,
+		Description: "Indexes for used signature nonces",
+		up: func(ctx context.Context, st *Handler) error {
+			return nonceIndexes(ctx, st.db)
+		},
+	},
+	{
+		Version:     13,
+		Description: "Indexes for the audit log and failed authentication counters",
+		up: func(ctx context.Context, st *Handler) error {
+			if err := auditIndexes(ctx, st.db); err != nil {
+				return err
+			}
+			return lockoutIndexes(ctx, st.db)
+		},
+	},
+	{
+		Version:     14,
+		Description: "Indexes for markers replicated with peer servers",
+		up: func(ctx context.Context, st *Handler) error {
+			return replicationIndexes(ctx, st.db)
+		},
+	},
+	{
+		Version:     15,
+		Description: "Indexes for batch jobs",
+		up: func(ctx context.Context, st *Handler) error {
+			return jobIndexes(ctx, st.db)
+		},
+	},
+	{
+		Version:     16,
+		Description: "Indexes for notification delivery status",
+		up: func(ctx context.Context, st *Handler) error {
+			return notificationStatusIndexes(ctx, st.db)
+		},
+	},
+	{
+		Version:     17,
+		Description: "Indexes for access token sessions",
+		up: func(ctx context.Context, st *Handler) error {
+			return sessionIndexes(ctx, st.db)
+		},
+	},
+	{
+		Version:     18,
+		Description: "Indexes for legal holds and preserved data",
+		up: func(ctx context.Context, st *Handler) error {
+			return holdIndexes(ctx, st.db)
+		},
+	},
+	{
+		Version:     19,
+		Description: "Indexes for ingestion quota counters",
+		up: func(ctx context.Context, st *Handler) error {
+			return quotaIndexes(ctx, st.db)
+		},
+	},
+	{
+		Version:     20,
+		Description: "Tag location records and exposures with processing purposes",
+		up: func(ctx context.Context, st *Handler) error {
+			return tagPurposes(ctx, st)
+		},
+	},
+	{
+		Version:     21,
+		Description: "Indexes for encrypted messages",
+		up: func(ctx context.Context, st *Handler) error {
+			return messageIndexes(ctx, st.db)
+		},
+	},
+	{
+		Version:     22,
+		Description: "Indexes for worker heartbeats",
+		up: func(ctx context.Context, st *Handler) error {
+			return workerIndexes(ctx, st.db)
+		},
+	},
+	{
+		Version:     23,
+		Description: "Indexes for submission receipts",
+		up: func(ctx context.Context, st *Handler) error {
+			return submissionIndexes(ctx, st.db)
+		},
+	},
+	{
+		Version:     24,
+		Description: "Indexes for the tamper-evident ledger",
+		up: func(ctx context.Context, st *Handler) error {
+			return ledgerIndexes(ctx, st.db)
+		},
+	},
+	{
+		Version:     25,
+		Description: "Indexes for DID publish tickets",
+		up: func(ctx context.Context, st *Handler) error {
+			return publishTicketIndexes(ctx, st.db)
+		},
+	},
+	{
+		Version:     26,
+		Description: "Expire used signature nonces on their own expiration date",
+		up: func(ctx context.Context, st *Handler) error {
+			return nonceExpiration(ctx, st.db)
+		},
+	},
+	{
+		Version:     27,
+		Description: "Indexes for exposure self-check quota counters",
+		up: func(ctx context.Context, st *Handler) error {
+			return exposureQuotaIndexes(ctx, st.db)
+		},
+	},
+}
+
+// Migrate applies all pending migrations and return the versions applied.
+func (st *Handler) Migrate() ([]int, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 30*time.Minute)
+	defer cancel()
+	applied, err := st.appliedMigrations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var done []int
+	for _, m := range sortedMigrations() {
+		if _, ok := applied[m.Version]; ok {
+			continue
+		}
+		if err := m.up(ctx, st); err != nil {
+			return done, errors.Wrapf(err, "migration %d failed", m.Version)
+		}
+		_, err := st.db.Collection(migrationsCollection).InsertOne(ctx, bson.M{
+			"version":     m.Version,
+			"description": m.Description,
+			"applied":     time.Now(),
+		})
+		if err != nil {
+			return done, errors.Wrapf(err, "failed to register migration %d", m.Version)
+		}
+		done = append(done, m.Version)
+	}
+	return done, nil
+}
+
+// MigrationStatus returns the current status of all registered migrations.
+func (st *Handler) MigrationStatus() ([]*MigrationStatus, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+	defer cancel()
+	applied, err := st.appliedMigrations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var list []*MigrationStatus
+	for _, m := range sortedMigrations() {
+		ms := &MigrationStatus{
+			Version:     m.Version,
+			Description: m.Description,
+		}
+		ms.AppliedAt, ms.Applied = applied[m.Version]
+		list = append(list, ms)
+	}
+	return list, nil
+}
+
+// PendingMigrations returns the number of registered migrations not yet
+// applied.
+func (st *Handler) PendingMigrations() (int, error) {
+	list, err := st.MigrationStatus()
+	if err != nil {
+		return 0, err
+	}
+	pending := 0
+	for _, ms := range list {
+		if !ms.Applied {
+			pending++
+		}
+	}
+	return pending, nil
+}
+
+// Return the versions already applied and its corresponding date.
+func (st *Handler) appliedMigrations(ctx context.Context) (map[int]time.Time, error) {
+	cur, err := st.db.Collection(migrationsCollection).Find(ctx, bson.M{}, options.Find())
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cur.Close(ctx)
+	}()
+	applied := make(map[int]time.Time)
+	for cur.Next(ctx) {
+		entry := struct {
+			Version int       `bson:"version"`
+			Applied time.Time `bson:"applied"`
+		}{}
+		if err := cur.Decode(&entry); err != nil {
+			return nil, err
+		}
+		applied[entry.Version] = entry.Applied
+	}
+	return applied, cur.Err()
+}
+
+// Add geohash cell and time bucket details to location records missing them.
+func annotateCells(ctx context.Context, col *mongo.Collection) error {
+	cur, err := col.Find(ctx, bson.M{"cell": bson.M{"$exists": false}})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = cur.Close(ctx)
+	}()
+	for cur.Next(ctx) {
+		entry := struct {
+			ID        interface{} `bson:"_id"`
+			Timestamp time.Time   `bson:"timestamp"`
+			Location  location    `bson:"location"`
+		}{}
+		if err := cur.Decode(&entry); err != nil {
+			return err
+		}
+		lng, lat := entry.Location.Coordinates[0], entry.Location.Coordinates[1]
+		_, err := col.UpdateOne(ctx, bson.M{"_id": entry.ID}, bson.M{
+			"$set": bson.M{
+				"cell":   utils.GeoHash(float64(lat), float64(lng), utils.CellPrecision),
+				"bucket": utils.TimeBucket(entry.Timestamp, utils.BucketSize),
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return cur.Err()
+}
+
+func sortedMigrations() []*Migration {
+	list := make([]*Migration, len(migrations))
+	copy(list, migrations)
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Version < list[j].Version
+	})
+	return list
+}
+
+func isDuplicateKey(err error) bool {
+	if we, ok := err.(mongo.WriteException); ok {
+		for _, e := range we.WriteErrors {
+			if e.Code == 11000 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Reports whether a command failed because the index, or its collection,
+// does not exist.
+func isIndexNotFound(err error) bool {
+	if ce, ok := err.(mongo.CommandError); ok {
+		return ce.Code == 26 || ce.Code == 27
+	}
+	return false
+}
@@ -0,0 +1,141 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Nonces of used signature proofs were originally kept for 2 days.
+const proofNonceTTL int32 = 60 * 60 * 24 * 2
+
+// UseNonce registers the nonce of a signature proof produced by 'did'; the
+// nonce is kept until 'expires', or indefinitely for a zero value. Returns
+// false if the nonce was already used.
+func (st *Handler) UseNonce(did, nonce string, expires time.Time) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	_, err := st.db.Collection("proof_nonces").InsertOne(ctx, nonceEntry(did, nonce, expires))
+	if isDuplicateKey(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// ReserveNonces registers the nonces of signature proofs produced by 'did',
+// each kept until its expiration date, or indefinitely for a zero value, and
+// returns the nonces already used. The unique index on the collection makes
+// the reservation atomic, concurrent requests can't reserve the same nonce.
+// On failure, the nonces registered by the request are released.
+func (st *Handler) ReserveNonces(did string, nonces map[string]time.Time) (map[string]bool, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	used := make(map[string]bool)
+	if len(nonces) == 0 {
+		return used, nil
+	}
+	var (
+		list []string
+		docs []interface{}
+	)
+	for nonce, expires := range nonces {
+		list = append(list, nonce)
+		docs = append(docs, nonceEntry(did, nonce, expires))
+	}
+	_, err := st.db.Collection("proof_nonces").InsertMany(ctx, docs, options.InsertMany().SetOrdered(false))
+	if err == nil {
+		return used, nil
+	}
+	be, ok := err.(mongo.BulkWriteException)
+	if !ok || be.WriteConcernError != nil {
+		return nil, err
+	}
+	failed := false
+	for _, e := range be.WriteErrors {
+		if e.Code != 11000 {
+			failed = true
+		}
+		used[list[e.Index]] = true
+	}
+	if !failed {
+		return used, nil
+	}
+
+	// Release the nonces inserted before the failure
+	var reserved []string
+	for _, nonce := range list {
+		if !used[nonce] {
+			reserved = append(reserved, nonce)
+		}
+	}
+	if re := st.ReleaseNonces(did, reserved); re != nil {
+		return nil, errors.Wrapf(err, "failed to release reserved nonces (%s)", re)
+	}
+	return nil, err
+}
+
+// ReleaseNonces removes the registered nonces of signature proofs produced
+// by 'did', so they can be used again. Used to discard the nonces reserved
+// for data that couldn't be stored.
+func (st *Handler) ReleaseNonces(did string, nonces []string) error {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	if len(nonces) == 0 {
+		return nil
+	}
+	filter := bson.M{"did": did, "nonce": bson.M{"$in": nonces}}
+	_, err := st.db.Collection("proof_nonces").DeleteMany(ctx, filter)
+	return err
+}
+
+// Nonce document; entries without an expiration date are never removed.
+func nonceEntry(did, nonce string, expires time.Time) bson.M {
+	entry := bson.M{
+		"did":     did,
+		"nonce":   nonce,
+		"created": time.Now(),
+	}
+	if !expires.IsZero() {
+		entry["expires"] = expires
+	}
+	return entry
+}
+
+// Indexes for used signature nonces.
+func nonceIndexes(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection("proof_nonces").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		ttlIndex(proofNonceTTL),
+		{
+			Keys: bson.D{
+				{Key: "did", Value: 1},
+				{Key: "nonce", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+	})
+	return err
+}
+
+// Expire used signature nonces on their own expiration date, instead of a
+// fixed period after use, so they are kept for as long as the signed data
+// is accepted. Existing entries retain their original expiration.
+func nonceExpiration(ctx context.Context, db *mongo.Database) error {
+	col := db.Collection("proof_nonces")
+	if _, err := col.Indexes().DropOne(ctx, "created_1"); err != nil && !isIndexNotFound(err) {
+		return err
+	}
+	legacy := time.Now().Add(time.Duration(proofNonceTTL) * time.Second)
+	filter := bson.M{"expires": bson.M{"$exists": false}}
+	if _, err := col.UpdateMany(ctx, filter, bson.M{"$set": bson.M{"expires": legacy}}); err != nil {
+		return err
+	}
+	_, err := col.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.M{"expires": 1},
+		Options: options.Index().SetExpireAfterSeconds(0),
+	})
+	return err
+}
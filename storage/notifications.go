@@ -0,0 +1,149 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Notification delivery status values.
+const (
+	NotificationPending    = "pending"
+	NotificationDispatched = "dispatched"
+	NotificationFailed     = "failed"
+	NotificationDelivered  = "delivered"
+	NotificationRead       = "read"
+)
+
+// Notification creates and stores a new notification for the user 'did'.
+// Notification title and contents are rendered using the template for the
+// user's preferred language, if registered, or the built-in messages.
+// 'source' is the identifier of the diagnosis or venue that originated the
+// notification, used to track the delivery status.
+func (st *Handler) Notification(did, kind, source string, details map[string]string) (*protov1.Notification, error) {
+	title, body, lang := st.renderNotification(st.Language(did), kind, details)
+	n := &protov1.Notification{
+		Id:        uuid.New().String(),
+		Did:       did,
+		Kind:      kind,
+		Timestamp: time.Now().Unix(),
+		Details:   details,
+		Title:     title,
+		Body:      body,
+		Lang:      string(lang),
+	}
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	col, err := st.retained(ctx, "notifications", "created")
+	if err != nil {
+		return nil, err
+	}
+	_, err = col.InsertOne(ctx, bson.M{
+		"id":      n.Id,
+		"did":     n.Did,
+		"kind":    n.Kind,
+		"created": time.Unix(n.Timestamp, 0),
+		"details": n.Details,
+		"title":   n.Title,
+		"body":    n.Body,
+		"lang":    n.Lang,
+		"source":  source,
+		"status":  NotificationPending,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// NotificationAttempt registers an attempt to dispatch a notification. 'err'
+// is the error returned by the broker, if any.
+func (st *Handler) NotificationAttempt(id string, err error) error {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	attempt := bson.M{"date": time.Now()}
+	status := NotificationDispatched
+	if err != nil {
+		attempt["error"] = err.Error()
+		status = NotificationFailed
+	}
+	_, e := st.db.Collection("notifications").UpdateOne(ctx,
+		bson.M{"id": id, "status": bson.M{"$in": []string{NotificationPending, NotificationFailed}}},
+		bson.M{
+			"$set":  bson.M{"status": status},
+			"$push": bson.M{"attempts": attempt},
+		})
+	return e
+}
+
+// AckNotifications registers the acknowledgement, by the user 'did', of the
+// notifications with the provided identifiers. 'status' must be "delivered"
+// or "read". Returns the number of notifications updated.
+func (st *Handler) AckNotifications(did string, ids []string, status string) (int64, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	filter := bson.M{"did": did, "id": bson.M{"$in": ids}}
+	now := time.Now()
+	update := bson.M{"status": status, status: now}
+	if status == NotificationRead {
+		filter["status"] = bson.M{"$ne": NotificationRead}
+	} else {
+		filter["status"] = bson.M{"$nin": []string{NotificationDelivered, NotificationRead}}
+	}
+	res, err := st.db.Collection("notifications").UpdateMany(ctx, filter, bson.M{"$set": update})
+	if err != nil {
+		return 0, err
+	}
+	return res.ModifiedCount, nil
+}
+
+// NotificationStatus returns the number of notifications, by delivery
+// status, originated by 'source'.
+func (st *Handler) NotificationStatus(source string) (map[string]int64, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+	defer cancel()
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"source": source}}},
+		{{Key: "$group", Value: bson.M{"_id": "$status", "count": bson.M{"$sum": 1}}}},
+	}
+	cur, err := st.db.Collection("notifications").Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cur.Close(ctx)
+	}()
+	counts := make(map[string]int64)
+	for cur.Next(ctx) {
+		entry := struct {
+			Status string `bson:"_id"`
+			Count  int64  `bson:"count"`
+		}{}
+		if err := cur.Decode(&entry); err != nil {
+			return nil, err
+		}
+		counts[entry.Status] = entry.Count
+	}
+	return counts, cur.Err()
+}
+
+// Indexes for notifications.
+func notificationIndexes(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection("notifications").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.M{"id": 1}},
+		{Keys: bson.M{"did": 1}},
+	})
+	return err
+}
+
+// Indexes used to track the delivery status of notifications.
+func notificationStatusIndexes(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection("notifications").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "source", Value: 1}, {Key: "status", Value: 1}},
+	})
+	return err
+}
@@ -0,0 +1,137 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Stored organization entry.
+type organizationEntry struct {
+	ID           string    `bson:"id"`
+	Name         string    `bson:"name"`
+	Kind         string    `bson:"kind"`
+	Jurisdiction []string  `bson:"jurisdiction"`
+	Permissions  []string  `bson:"permissions"`
+	Created      time.Time `bson:"created"`
+}
+
+func (e *organizationEntry) organization() *protov1.Organization {
+	return &protov1.Organization{
+		Id:           e.ID,
+		Name:         e.Name,
+		Kind:         e.Kind,
+		Jurisdiction: e.Jurisdiction,
+		Permissions:  e.Permissions,
+		Created:      e.Created.Unix(),
+	}
+}
+
+// RegisterOrganization adds a new organization entry.
+func (st *Handler) RegisterOrganization(org *protov1.Organization) error {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	_, err := st.db.Collection("organizations").InsertOne(ctx, &organizationEntry{
+		ID:           org.Id,
+		Name:         org.Name,
+		Kind:         org.Kind,
+		Jurisdiction: org.Jurisdiction,
+		Permissions:  org.Permissions,
+		Created:      time.Unix(org.Created, 0),
+	})
+	return err
+}
+
+// Organization returns the details of a registered organization.
+func (st *Handler) Organization(id string) (*protov1.Organization, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	entry := &organizationEntry{}
+	if err := st.db.Collection("organizations").FindOne(ctx, bson.M{"id": id}).Decode(entry); err != nil {
+		return nil, err
+	}
+	return entry.organization(), nil
+}
+
+// Organizations returns the details of all registered organizations.
+func (st *Handler) Organizations() ([]*protov1.Organization, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+	defer cancel()
+	cur, err := st.db.Collection("organizations").Find(ctx, bson.M{}, options.Find().SetSort(bson.M{"name": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cur.Close(ctx)
+	}()
+	var list []*protov1.Organization
+	for cur.Next(ctx) {
+		entry := &organizationEntry{}
+		if err := cur.Decode(entry); err != nil {
+			return nil, err
+		}
+		list = append(list, entry.organization())
+	}
+	return list, cur.Err()
+}
+
+// AddMember registers the agent 'did' as a member of the organization 'org'.
+// Agents can only be members of a single organization, any previous
+// membership is replaced.
+func (st *Handler) AddMember(org, did string) error {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	_, err := st.db.Collection("organization_members").UpdateOne(ctx,
+		bson.M{"did": did},
+		bson.M{"$set": bson.M{"organization": org, "added": time.Now()}},
+		options.Update().SetUpsert(true))
+	return err
+}
+
+// RemoveMember removes the agent 'did' from the organization 'org'.
+func (st *Handler) RemoveMember(org, did string) error {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	res, err := st.db.Collection("organization_members").DeleteOne(ctx, bson.M{"did": did, "organization": org})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return errors.New("unknown member")
+	}
+	return nil
+}
+
+// MemberOf returns the identifier of the organization the agent 'did'
+// belongs to, or an empty string if the agent is not a member of any
+// organization.
+func (st *Handler) MemberOf(did string) string {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	entry := struct {
+		Organization string `bson:"organization"`
+	}{}
+	_ = st.db.Collection("organization_members").FindOne(ctx, bson.M{"did": did}).Decode(&entry)
+	return entry.Organization
+}
+
+// Indexes for organizations and memberships.
+func organizationIndexes(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection("organizations").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.M{"id": 1},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = db.Collection("organization_members").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.M{"did": 1},
+		Options: options.Index().SetUnique(true),
+	})
+	return err
+}
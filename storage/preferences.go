@@ -0,0 +1,46 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"go.bryk.io/covid-tracking/i18n"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// SetLanguage registers the preferred language for the user 'did'.
+func (st *Handler) SetLanguage(did string, lang i18n.Language) error {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	_, err := st.db.Collection("preferences").UpdateOne(ctx,
+		bson.M{"did": did},
+		bson.M{"$set": bson.M{"lang": string(lang)}},
+		options.Update().SetUpsert(true))
+	return err
+}
+
+// Language returns the preferred language for the user 'did'. If no
+// preference was registered the default language is returned.
+func (st *Handler) Language(did string) i18n.Language {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	entry := struct {
+		Lang string `bson:"lang"`
+	}{}
+	_ = st.db.Collection("preferences").FindOne(ctx, bson.M{"did": did}).Decode(&entry)
+	if lang := i18n.Language(entry.Lang); i18n.Supported(lang) {
+		return lang
+	}
+	return i18n.Default
+}
+
+// Indexes for user preferences.
+func preferenceIndexes(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection("preferences").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.M{"did": 1},
+		Options: options.Index().SetUnique(true),
+	})
+	return err
+}
@@ -0,0 +1,73 @@
+package storage
+
+import (
+	"context"
+
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Processing purposes stored data can be used for. Location records and
+// exposures are tagged with the purposes allowed when stored, and queries
+// only return entries tagged with the purpose declared by the caller.
+const (
+	// Identify and notify users exposed to a confirmed case.
+	PurposeContactTracing = "contact_tracing"
+
+	// Produce anonymized aggregates, like hotspots and movement flows.
+	PurposeAnalytics = "analytics"
+
+	// Epidemiological research based on anonymized aggregates.
+	PurposeResearch = "research"
+)
+
+// AggregatePurposes lists the purposes anonymized aggregates can be used for.
+var AggregatePurposes = []string{PurposeAnalytics, PurposeResearch}
+
+// Purposes allowed for exposures.
+var exposurePurposes = []string{PurposeContactTracing}
+
+// ValidPurpose returns true if 'purpose' is a supported processing purpose.
+func ValidPurpose(purpose string) bool {
+	switch purpose {
+	case PurposeContactTracing, PurposeAnalytics, PurposeResearch:
+		return true
+	}
+	return false
+}
+
+// RecordPurposes returns the purposes a location record can be used for.
+// Aggregate-only records are never used for contact tracing.
+func RecordPurposes(r *protov1.LocationRecord) []string {
+	if r.AggregateOnly {
+		return []string{PurposeAnalytics}
+	}
+	return []string{PurposeContactTracing, PurposeAnalytics}
+}
+
+// Tag the location records and exposures stored before purpose tags were
+// introduced, with the purposes they were originally collected for.
+func tagPurposes(ctx context.Context, st *Handler) error {
+	untagged := bson.M{"purposes": bson.M{"$exists": false}}
+	names, err := st.db.ListCollectionNames(ctx, bson.M{"name": bson.M{"$regex": "^" + recordsPrefix}})
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		col := st.db.Collection(name)
+		_, err = col.UpdateMany(ctx,
+			bson.M{"purposes": bson.M{"$exists": false}, "aggregate": true},
+			bson.M{"$set": bson.M{"purposes": RecordPurposes(&protov1.LocationRecord{AggregateOnly: true})}})
+		if err != nil {
+			return err
+		}
+		_, err = col.UpdateMany(ctx, untagged,
+			bson.M{"$set": bson.M{"purposes": RecordPurposes(&protov1.LocationRecord{})}})
+		if err != nil {
+			return err
+		}
+	}
+	_, err = st.db.Collection("exposures").UpdateMany(ctx, untagged,
+		bson.M{"$set": bson.M{"purposes": exposurePurposes}})
+	return err
+}
@@ -0,0 +1,75 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Stored queue statistics, as last reported by a worker.
+type queueEntry struct {
+	Name           string    `bson:"_id"`
+	Messages       uint64    `bson:"messages"`
+	Unacknowledged uint64    `bson:"unacknowledged"`
+	Consumers      uint32    `bson:"consumers"`
+	Lag            float64   `bson:"lag"`
+	Worker         string    `bson:"worker"`
+	Updated        time.Time `bson:"updated"`
+}
+
+// SaveQueueStats registers the current statistics for a broker queue.
+func (st *Handler) SaveQueueStats(stats *protov1.QueueStats) error {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	now := time.Now()
+	_, err := st.db.Collection("queue_stats").ReplaceOne(ctx,
+		bson.M{"_id": stats.Name},
+		&queueEntry{
+			Name:           stats.Name,
+			Messages:       stats.Messages,
+			Unacknowledged: stats.Unacknowledged,
+			Consumers:      stats.Consumers,
+			Lag:            stats.Lag,
+			Worker:         stats.Worker,
+			Updated:        now,
+		},
+		options.Replace().SetUpsert(true))
+	if err == nil {
+		stats.Updated = now.Unix()
+	}
+	return err
+}
+
+// QueueStats returns the latest statistics reported for all broker queues.
+func (st *Handler) QueueStats() ([]*protov1.QueueStats, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	opts := options.Find().SetSort(bson.M{"_id": 1})
+	cur, err := st.db.Collection("queue_stats").Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cur.Close(ctx)
+	}()
+	var list []*protov1.QueueStats
+	for cur.Next(ctx) {
+		entry := &queueEntry{}
+		if err := cur.Decode(entry); err != nil {
+			return nil, err
+		}
+		list = append(list, &protov1.QueueStats{
+			Name:           entry.Name,
+			Messages:       entry.Messages,
+			Unacknowledged: entry.Unacknowledged,
+			Consumers:      entry.Consumers,
+			Lag:            entry.Lag,
+			Worker:         entry.Worker,
+			Updated:        entry.Updated.Unix(),
+		})
+	}
+	return list, cur.Err()
+}
@@ -0,0 +1,156 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Ingestion quota counters are discarded 2 days after the last update.
+const quotaCountersTTL int32 = 60 * 60 * 24 * 2
+
+// Attempts to reserve records on a quota counter updated concurrently.
+const quotaReserveAttempts = 5
+
+// QuotaUsage returns the number of records stored for 'did' during the UTC
+// day of 'date'.
+func (st *Handler) QuotaUsage(did string, date time.Time) (int, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	counter := struct {
+		Records int `bson:"records"`
+	}{}
+	err := st.db.Collection("ingestion_quotas").FindOne(ctx, bson.M{"_id": quotaKey(did, date)}).Decode(&counter)
+	if err == mongo.ErrNoDocuments {
+		return 0, nil
+	}
+	return counter.Records, err
+}
+
+// AddQuotaUsage registers 'n' records stored for 'did' during the UTC day of
+// 'date', and returns the updated number of records for the day.
+func (st *Handler) AddQuotaUsage(did string, date time.Time, n int) (int, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	res := st.db.Collection("ingestion_quotas").FindOneAndUpdate(ctx,
+		bson.M{"_id": quotaKey(did, date)},
+		bson.M{"$inc": bson.M{"records": n}, "$set": bson.M{"updated": time.Now()}},
+		options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After))
+	counter := struct {
+		Records int `bson:"records"`
+	}{}
+	if err := res.Decode(&counter); err != nil {
+		return 0, err
+	}
+	return counter.Records, nil
+}
+
+// ReserveQuota atomically reserves up to 'n' records for 'did' during the UTC
+// day of 'date', without exceeding 'limit', and returns the number of records
+// reserved. Reserved records not stored must be released with a negative
+// 'AddQuotaUsage' for the same date.
+func (st *Handler) ReserveQuota(did string, date time.Time, n, limit int) (int, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	col := st.db.Collection("ingestion_quotas")
+	key := quotaKey(did, date)
+	for i := 0; i < quotaReserveAttempts; i++ {
+		counter := struct {
+			Records int `bson:"records"`
+		}{}
+		err := col.FindOne(ctx, bson.M{"_id": key}).Decode(&counter)
+		if err != nil && err != mongo.ErrNoDocuments {
+			return 0, err
+		}
+		reserved := n
+		if left := limit - counter.Records; left < reserved {
+			reserved = left
+		}
+		if reserved <= 0 {
+			return 0, nil
+		}
+
+		// The counter is only updated if the records still fit the limit; if
+		// not, the upsert fails with a duplicate key and the usage is read again
+		_, err = col.UpdateOne(ctx,
+			bson.M{"_id": key, "records": bson.M{"$lte": limit - reserved}},
+			bson.M{"$inc": bson.M{"records": reserved}, "$set": bson.M{"updated": time.Now()}},
+			options.Update().SetUpsert(true))
+		if isDuplicateKey(err) {
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+		return reserved, nil
+	}
+	return 0, errors.New("quota counter updated concurrently")
+}
+
+// UseExposureChecks registers 'n' elements submitted by 'did' for exposure
+// self-checks during the UTC day of 'date'. Returns false, with nothing
+// registered, if the elements exceed 'limit'.
+func (st *Handler) UseExposureChecks(did string, date time.Time, n, limit int) (bool, error) {
+	if n > limit {
+		return false, nil
+	}
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	col := st.db.Collection("exposure_quotas")
+	key := quotaKey(did, date)
+	for i := 0; i < quotaReserveAttempts; i++ {
+		// The counter is only updated if the elements still fit the limit; if
+		// not, the upsert fails with a duplicate key
+		_, err := col.UpdateOne(ctx,
+			bson.M{"_id": key, "records": bson.M{"$lte": limit - n}},
+			bson.M{"$inc": bson.M{"records": n}, "$set": bson.M{"updated": time.Now()}},
+			options.Update().SetUpsert(true))
+		if err == nil {
+			return true, nil
+		}
+		if !isDuplicateKey(err) {
+			return false, err
+		}
+
+		// Retry if the counter was just created by a concurrent request
+		counter := struct {
+			Records int `bson:"records"`
+		}{}
+		if err := col.FindOne(ctx, bson.M{"_id": key}).Decode(&counter); err != nil {
+			return false, err
+		}
+		if counter.Records+n > limit {
+			return false, nil
+		}
+	}
+	return false, errors.New("quota counter updated concurrently")
+}
+
+// Counter identifier for a DID and UTC day.
+func quotaKey(did string, date time.Time) string {
+	return did + ":" + date.UTC().Format("2006-01-02")
+}
+
+// Indexes for ingestion quota counters.
+func quotaIndexes(ctx context.Context, db *mongo.Database) error {
+	ttl := quotaCountersTTL
+	_, err := db.Collection("ingestion_quotas").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.M{"updated": 1},
+		Options: &options.IndexOptions{ExpireAfterSeconds: &ttl},
+	})
+	return err
+}
+
+// Indexes for exposure self-check quota counters.
+func exposureQuotaIndexes(ctx context.Context, db *mongo.Database) error {
+	ttl := quotaCountersTTL
+	_, err := db.Collection("exposure_quotas").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.M{"updated": 1},
+		Options: &options.IndexOptions{ExpireAfterSeconds: &ttl},
+	})
+	return err
+}
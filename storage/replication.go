@@ -0,0 +1,185 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Marker represents the presence of a diagnosed case in a location cell
+// during a specific time bucket, as replicated with peer servers.
+type Marker struct {
+	Sequence  int64     `bson:"seq"`
+	Diagnosis string    `bson:"diagnosis"`
+	Cell      string    `bson:"cell"`
+	Bucket    int64     `bson:"bucket"`
+	Updated   time.Time `bson:"updated"`
+}
+
+// Provenance details for a marker received from a peer server.
+type Provenance struct {
+	// Server producing the marker.
+	Origin string
+
+	// Sequence number of the batch including the marker.
+	Batch int64
+
+	// Signature produced by the origin over the batch contents.
+	Signature []byte
+}
+
+// RecordMarkers registers location cells, visited by a positive case, to be
+// replicated with peer servers. Each marker is assigned a unique, increasing,
+// sequence number.
+func (st *Handler) RecordMarkers(diagnosis string, cells []Cell) error {
+	if len(cells) == 0 {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Second)
+	defer cancel()
+	col, err := st.retained(ctx, "replication_markers", "created")
+	if err != nil {
+		return err
+	}
+
+	// Reserve a range of sequence numbers
+	res := st.db.Collection("counters").FindOneAndUpdate(ctx,
+		bson.M{"_id": "replication_markers"},
+		bson.M{"$inc": bson.M{"value": int64(len(cells))}},
+		options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After))
+	counter := struct {
+		Value int64 `bson:"value"`
+	}{}
+	if err := res.Decode(&counter); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	first := counter.Value - int64(len(cells)) + 1
+	docs := make([]interface{}, len(cells))
+	for i, c := range cells {
+		docs[i] = bson.M{
+			"seq":       first + int64(i),
+			"diagnosis": diagnosis,
+			"cell":      c.ID,
+			"bucket":    c.Bucket,
+			"updated":   now,
+			"created":   now,
+		}
+	}
+	_, err = col.InsertMany(ctx, docs)
+	return err
+}
+
+// Markers returns up to 'limit' local markers with a sequence number greater
+// than 'cursor', sorted by sequence number.
+func (st *Handler) Markers(cursor int64, limit int) ([]Marker, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Second)
+	defer cancel()
+	opts := options.Find().SetSort(bson.M{"seq": 1}).SetLimit(int64(limit))
+	cur, err := st.db.Collection("replication_markers").Find(ctx, bson.M{"seq": bson.M{"$gt": cursor}}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cur.Close(ctx)
+	}()
+	var list []Marker
+	for cur.Next(ctx) {
+		m := Marker{}
+		if err := cur.Decode(&m); err != nil {
+			return nil, err
+		}
+		list = append(list, m)
+	}
+	return list, cur.Err()
+}
+
+// ApplyMarker registers a marker received from a peer server. Markers are
+// identified by their origin, diagnosis, cell and bucket; when receiving
+// several versions of the same marker the most recent one is kept. Returns
+// true only if the marker was not previously known.
+func (st *Handler) ApplyMarker(m Marker, p Provenance) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+	defer cancel()
+	col, err := st.retained(ctx, "replicated_markers", "created")
+	if err != nil {
+		return false, err
+	}
+	res, err := col.UpdateOne(ctx,
+		bson.M{
+			"origin":    p.Origin,
+			"diagnosis": m.Diagnosis,
+			"cell":      m.Cell,
+			"bucket":    m.Bucket,
+			"updated":   bson.M{"$lt": m.Updated},
+		},
+		bson.M{
+			"$set": bson.M{
+				"updated":   m.Updated,
+				"batch":     p.Batch,
+				"signature": p.Signature,
+				"received":  time.Now(),
+			},
+			"$setOnInsert": bson.M{"created": time.Now()},
+		},
+		options.Update().SetUpsert(true))
+	if isDuplicateKey(err) {
+		return false, nil // Same or more recent version already known
+	}
+	if err != nil {
+		return false, err
+	}
+	return res.UpsertedCount > 0, nil
+}
+
+// ReplicationCursor returns the sequence number of the last marker received
+// from the peer server 'peer'.
+func (st *Handler) ReplicationCursor(peer string) (int64, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	entry := struct {
+		Cursor int64 `bson:"cursor"`
+	}{}
+	err := st.db.Collection("replication_peers").FindOne(ctx, bson.M{"_id": peer}).Decode(&entry)
+	if err == mongo.ErrNoDocuments {
+		return 0, nil
+	}
+	return entry.Cursor, err
+}
+
+// SetReplicationCursor updates the sequence number of the last marker
+// received from the peer server 'peer'.
+func (st *Handler) SetReplicationCursor(peer string, cursor int64) error {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	_, err := st.db.Collection("replication_peers").UpdateOne(ctx,
+		bson.M{"_id": peer},
+		bson.M{"$set": bson.M{"cursor": cursor, "updated": time.Now()}},
+		options.Update().SetUpsert(true))
+	return err
+}
+
+// Indexes for replicated markers.
+func replicationIndexes(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection("replication_markers").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.M{"seq": 1},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = db.Collection("replicated_markers").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "origin", Value: 1},
+			{Key: "diagnosis", Value: 1},
+			{Key: "cell", Value: 1},
+			{Key: "bucket", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	return err
+}
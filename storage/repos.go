@@ -0,0 +1,720 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"go.bryk.io/covid-tracking/i18n"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+)
+
+// CodesRepo manages the activation codes used to obtain access credentials.
+type CodesRepo interface {
+	// ActivationCode creates a new activation code for a DID and role.
+	ActivationCode(req *protov1.ActivationCodeRequest) (string, error)
+
+	// RoleCodes prepares the storage of activation codes for a custom role.
+	RoleCodes(role string) error
+
+	// VerifyActivationCode checks and consumes an activation code, returning
+	// the scope assigned to it, if any.
+	VerifyActivationCode(req *protov1.CredentialsRequest) ([]string, bool)
+
+	// CampaignCodes creates a batch of activation codes not bound to a DID.
+	CampaignCodes(campaign string, scope []string, count int) ([]string, time.Time, error)
+}
+
+// RecordsRepo manages location and check-in records, and the queries used
+// for contact matching and analytics.
+type RecordsRepo interface {
+	// LocationRecords adds location entries.
+	LocationRecords(records []*protov1.LocationRecord) error
+
+	// CheckIns adds venue check-in entries.
+	CheckIns(records []*protov1.CheckInRecord) error
+
+	// ArchiveRecords removes, or archives, location records older than 'cutoff'.
+	ArchiveRecords(cutoff time.Time, discard bool) ([]string, error)
+
+	// Contacts returns the users with records on the same cells and time
+	// buckets as 'id' during a period of time.
+	Contacts(id string, from, to time.Time) ([]string, error)
+
+	// Cells returns the cells and time buckets visited by a user.
+	Cells(did string, from, to time.Time) ([]Cell, error)
+
+	// PresentAt returns the users with records on any of the provided cells.
+	PresentAt(cells []Cell) ([]string, error)
+
+	// PresenceWithin returns the users with records inside an area, tagged
+	// with the processing purpose.
+	PresenceWithin(polygon [][2]float64, from, to time.Time, purpose string) ([]*Presence, error)
+
+	// Visitors returns the users that checked-in at a venue.
+	Visitors(venue string, from, to time.Time) ([]string, error)
+
+	// ClusterRecords calculates hotspots and movement flows.
+	ClusterRecords(from, to time.Time, precision, k int) ([]*protov1.Hotspot, []*protov1.Flow, error)
+
+	// ExportRecords traverses the location records matching a filter in
+	// batches.
+	ExportRecords(ctx context.Context, filter RecordsFilter, size int, fn func([]*protov1.LocationRecord) error) error
+
+	// ClientVersions returns the number of location records, and distinct
+	// users, submitted by each client application version.
+	ClientVersions(from, to time.Time) ([]*protov1.ClientVersionStats, error)
+}
+
+// ExposuresRepo manages diagnoses and the exposures linked to them.
+type ExposuresRepo interface {
+	// Diagnosis registers a new diagnosis for a user.
+	Diagnosis(did, result, source string, date time.Time) (*protov1.Diagnosis, error)
+
+	// FindDiagnosis returns a diagnosis by identifier.
+	FindDiagnosis(id string) (*protov1.Diagnosis, error)
+
+	// Exposure registers a user as exposed to a diagnosed case.
+	Exposure(did string, diagnosis string) (*protov1.Exposure, error)
+
+	// PositiveDiagnoses returns the positive diagnoses registered since a date.
+	PositiveDiagnoses(since time.Time) ([]*protov1.Diagnosis, error)
+
+	// Exposed returns the users registered as exposed to a diagnosis.
+	Exposed(diagnosis string) ([]string, error)
+
+	// LastExposure returns the most recent exposure registered for a user,
+	// or nil if the user has no exposures.
+	LastExposure(did string) (*protov1.Exposure, error)
+}
+
+// NotificationsRepo manages user notifications, their delivery status and
+// the templates used to render them.
+type NotificationsRepo interface {
+	// Notification registers a new notification for a user.
+	Notification(did, kind, source string, details map[string]string) (*protov1.Notification, error)
+
+	// NotificationAttempt registers a delivery attempt for a notification.
+	NotificationAttempt(id string, err error) error
+
+	// AckNotifications updates the status of notifications received by a user.
+	AckNotifications(did string, ids []string, status string) (int64, error)
+
+	// NotificationStatus returns the number of notifications, by status,
+	// originated by a diagnosis or venue outbreak.
+	NotificationStatus(source string) (map[string]int64, error)
+
+	// SaveTemplate registers, or replaces, a notification template.
+	SaveTemplate(t *protov1.NotificationTemplate) error
+
+	// Templates returns the registered notification templates.
+	Templates(kind string) ([]*protov1.NotificationTemplate, error)
+
+	// DeleteTemplate removes a notification template.
+	DeleteTemplate(kind, lang string) (bool, error)
+}
+
+// AuditRepo manages the audit log.
+type AuditRepo interface {
+	// Audit registers a new entry on the audit log.
+	Audit(entry *AuditEntry) error
+
+	// ExportAudit traverses the audit entries matching a filter, in the
+	// order they were registered.
+	ExportAudit(ctx context.Context, filter AuditFilter, fn func(*AuditEntry) error) error
+}
+
+// SessionsRepo manages the access tokens issued and their revocation.
+type SessionsRepo interface {
+	// OpenSession registers a newly issued access token, optionally linked
+	// to the token it renews.
+	OpenSession(token *SessionToken, previous string) error
+
+	// Sessions returns the active sessions for a DID and their tokens.
+	Sessions(did string) ([]*protov1.Session, []string, error)
+
+	// RevokeSession invalidates all the access tokens issued for a session.
+	RevokeSession(did, id string) (bool, error)
+
+	// ReassignRole revokes the access tokens of a DID so they can only be
+	// renewed with a new role.
+	ReassignRole(did, role string) (int64, error)
+
+	// SessionRevoked returns true if an access token was revoked, along
+	// with the role to issue on renewal, if any.
+	SessionRevoked(id string) (bool, string, error)
+
+	// RevokedTokens returns the identifiers of all revoked access tokens not
+	// yet expired.
+	RevokedTokens() ([]string, error)
+}
+
+// LockoutsRepo manages failed authentication counters and lockouts.
+type LockoutsRepo interface {
+	// AuthFailure registers a failed authentication attempt for a key and
+	// returns the number of failures registered.
+	AuthFailure(key string) (int, error)
+
+	// Lock rejects authentication attempts for a key until a given time.
+	Lock(key string, until time.Time) error
+
+	// LockedUntil returns the time authentication attempts will be accepted
+	// again for any of the keys, or a zero value.
+	LockedUntil(keys ...string) (time.Time, error)
+
+	// ResetAuthFailures discards the failed attempts registered for a key.
+	ResetAuthFailures(key string) error
+}
+
+// NoncesRepo tracks the nonces of used signature proofs.
+type NoncesRepo interface {
+	// UseNonce registers the nonce of a signature proof, kept until
+	// 'expires' or indefinitely for a zero value, returning false if it was
+	// already used.
+	UseNonce(did, nonce string, expires time.Time) (bool, error)
+
+	// ReserveNonces atomically registers the nonces of signature proofs,
+	// each kept until its expiration date, and returns the nonces already
+	// used.
+	ReserveNonces(did string, nonces map[string]time.Time) (map[string]bool, error)
+
+	// ReleaseNonces removes registered nonces, so they can be used again.
+	ReleaseNonces(did string, nonces []string) error
+}
+
+// PreferencesRepo manages user preferences.
+type PreferencesRepo interface {
+	// SetLanguage registers the preferred language for a user.
+	SetLanguage(did string, lang i18n.Language) error
+
+	// Language returns the preferred language for a user.
+	Language(did string) i18n.Language
+}
+
+// APIKeysRepo manages the API keys registered for service integrations.
+type APIKeysRepo interface {
+	// RegisterAPIKey adds a new API key along with the hash of its secret.
+	RegisterAPIKey(key *protov1.APIKey, hash string) error
+
+	// APIKey returns the details of a registered API key.
+	APIKey(id string) (*APIKeyRecord, error)
+
+	// APIKeys returns the details of all registered API keys.
+	APIKeys() ([]*protov1.APIKey, error)
+
+	// RotateAPIKey replaces the secret of an API key.
+	RotateAPIKey(id, hash string, grace time.Duration) (*protov1.APIKey, error)
+
+	// RevokeAPIKey permanently removes an API key.
+	RevokeAPIKey(id string) error
+}
+
+// QuotasRepo manages the daily ingestion quota counters.
+type QuotasRepo interface {
+	// QuotaUsage returns the number of records stored for a DID during the
+	// UTC day of 'date'.
+	QuotaUsage(did string, date time.Time) (int, error)
+
+	// AddQuotaUsage registers records stored for a DID during the UTC day
+	// of 'date' and returns the updated count.
+	AddQuotaUsage(did string, date time.Time, n int) (int, error)
+
+	// ReserveQuota atomically reserves up to 'n' records for a DID during
+	// the UTC day of 'date', without exceeding 'limit', and returns the
+	// number of records reserved.
+	ReserveQuota(did string, date time.Time, n, limit int) (int, error)
+
+	// UseExposureChecks registers elements submitted by a DID for exposure
+	// self-checks during the UTC day of 'date'. Returns false, with nothing
+	// registered, if the elements exceed 'limit'.
+	UseExposureChecks(did string, date time.Time, n, limit int) (bool, error)
+}
+
+// SubmissionsRepo manages the receipts for records submitted asynchronously.
+type SubmissionsRepo interface {
+	// RegisterSubmission registers a new pending submission.
+	RegisterSubmission(id, did string, records []*protov1.RecordStatus) error
+
+	// Submission returns the current status of a submission by a DID.
+	Submission(did, id string) (*protov1.SubmissionStatusResponse, error)
+
+	// FinishSubmission records the final status of a pending submission.
+	FinishSubmission(id string, records []*protov1.RecordStatus, subErr error) error
+}
+
+// VenuesRepo manages the venues available for check-ins.
+type VenuesRepo interface {
+	// RegisterVenue adds a new venue and returns its details.
+	RegisterVenue(owner string, req *protov1.RegisterVenueRequest) (*protov1.Venue, error)
+
+	// VenueExists returns true if a venue is registered.
+	VenueExists(id string) bool
+
+	// Venue returns the details of a registered venue.
+	Venue(id string) (*protov1.Venue, error)
+
+	// VenueName returns the display name of a venue, if registered.
+	VenueName(id string) string
+}
+
+// AnalyticsRepo manages the stored analytics aggregates.
+type AnalyticsRepo interface {
+	// SaveAnalytics stores hotspots and movement flows.
+	SaveAnalytics(hotspots []*protov1.Hotspot, flows []*protov1.Flow) error
+
+	// Analytics returns the stored aggregates for a time range.
+	Analytics(from, to time.Time) ([]*protov1.Hotspot, []*protov1.Flow, error)
+}
+
+// OrganizationsRepo manages organizations and their members.
+type OrganizationsRepo interface {
+	// RegisterOrganization adds a new organization.
+	RegisterOrganization(org *protov1.Organization) error
+
+	// Organization returns the details of a registered organization.
+	Organization(id string) (*protov1.Organization, error)
+
+	// Organizations returns all registered organizations.
+	Organizations() ([]*protov1.Organization, error)
+
+	// AddMember registers an agent as member of an organization.
+	AddMember(org, did string) error
+
+	// RemoveMember removes an agent from an organization.
+	RemoveMember(org, did string) error
+
+	// MemberOf returns the organization an agent belongs to, if any.
+	MemberOf(did string) string
+}
+
+// MessagesRepo manages the encrypted messages sent to users.
+type MessagesRepo interface {
+	// Message stores an encrypted message for its recipient.
+	Message(m *protov1.EncryptedMessage) error
+
+	// Messages returns the messages received by a user since a date.
+	Messages(did string, since time.Time, limit int) ([]*protov1.EncryptedMessage, error)
+}
+
+// HoldsRepo manages the legal holds placed on user data.
+type HoldsRepo interface {
+	// PlaceLegalHold exempts the data of a user from the retention policy.
+	PlaceLegalHold(hold *protov1.LegalHold) error
+
+	// ReleaseLegalHold removes the hold placed on the data of a user.
+	ReleaseLegalHold(did string) (bool, error)
+
+	// LegalHold returns the hold placed on the data of a user.
+	LegalHold(did string) (*protov1.LegalHold, error)
+
+	// PreserveHeldData preserves the data registered for all users with a
+	// legal hold in place.
+	PreserveHeldData() (int64, error)
+
+	// SubjectData compiles all the data stored for a user.
+	SubjectData(did string) (*protov1.SubjectAccessResponse, error)
+}
+
+// JobsRepo manages the administrative jobs executed by the workers.
+type JobsRepo interface {
+	// SubmitJob registers a new pending job.
+	SubmitJob(kind string, params map[string]string, submittedBy string) (*protov1.Job, error)
+
+	// Job returns the current details of a job.
+	Job(id string) (*protov1.Job, error)
+
+	// Jobs returns the most recent jobs, optionally filtered by status.
+	Jobs(status string, limit int64) ([]*protov1.Job, error)
+
+	// CancelJob marks a pending or running job as cancelled.
+	CancelJob(id string) (*protov1.Job, error)
+
+	// StartJob assigns a pending job to a worker.
+	StartJob(id, worker string) (*protov1.Job, error)
+
+	// JobProgress updates the completion percentage of a running job.
+	JobProgress(id string, progress uint32) (bool, error)
+
+	// FinishJob records the outcome of a running job.
+	FinishJob(id string, result map[string]string, jobErr error) error
+}
+
+// SchedulerRepo coordinates the execution of scheduled jobs among workers.
+type SchedulerRepo interface {
+	// ClaimScheduledRun registers a worker as responsible for executing a
+	// scheduled job slot.
+	ClaimScheduledRun(name, owner string, slot time.Time, lease time.Duration) (bool, error)
+
+	// ScheduledRunFinished releases a claim and records its result.
+	ScheduledRunFinished(name, owner string, result error) error
+}
+
+// WorkersRepo manages the heartbeats reported by workers.
+type WorkersRepo interface {
+	// SaveHeartbeat registers the current status of a worker.
+	SaveHeartbeat(status *protov1.WorkerStatus) error
+
+	// RemoveWorker discards the heartbeat of a worker.
+	RemoveWorker(name string) error
+
+	// Workers returns the latest status reported by the workers.
+	Workers() ([]*protov1.WorkerStatus, error)
+}
+
+// QueuesRepo manages the broker queue statistics reported by workers.
+type QueuesRepo interface {
+	// SaveQueueStats registers the current statistics for a queue.
+	SaveQueueStats(stats *protov1.QueueStats) error
+
+	// QueueStats returns the latest statistics for all queues.
+	QueueStats() ([]*protov1.QueueStats, error)
+}
+
+// SettingsRepo manages the settings shared by all server instances.
+type SettingsRepo interface {
+	// Maintenance returns the current maintenance mode settings.
+	Maintenance() (*protov1.MaintenanceStatus, error)
+
+	// SetMaintenance updates the maintenance mode settings.
+	SetMaintenance(status *protov1.MaintenanceStatus) error
+}
+
+// UndeliveredRepo keeps the broker messages pending delivery.
+type UndeliveredRepo interface {
+	// SaveUndelivered registers, or updates, a message pending delivery.
+	SaveUndelivered(msg *UndeliveredMessage) error
+
+	// UndeliveredMessages returns the oldest messages pending delivery.
+	UndeliveredMessages(limit int64) ([]*UndeliveredMessage, error)
+
+	// DeleteUndelivered removes a message once published.
+	DeleteUndelivered(id string) error
+}
+
+// TicketsRepo keeps the progress of DID publish tickets.
+type TicketsRepo interface {
+	// SavePublishTicket registers, or updates, a publish ticket.
+	SavePublishTicket(t *PublishTicket) error
+
+	// PublishTicket returns the stored progress of a publish ticket, nil if
+	// not available.
+	PublishTicket(id string) (*PublishTicket, error)
+}
+
+// FederationRepo manages the keys exchanged with other authorities.
+type FederationRepo interface {
+	// QueueFederationKeys registers cells to share on the next upload.
+	QueueFederationKeys(diagnosis string, cells []Cell) error
+
+	// PendingFederationKeys returns the queued cells not yet uploaded.
+	PendingFederationKeys() ([]Cell, error)
+
+	// FederationKeysUploaded marks the pending cells created before a date
+	// as uploaded.
+	FederationKeysUploaded(until time.Time, tag string) error
+
+	// FederationBatchProcessed registers a downloaded batch as processed,
+	// returning false if it was processed before.
+	FederationBatchProcessed(origin, tag string) (bool, error)
+}
+
+// ReplicationRepo manages the markers replicated with peer servers.
+type ReplicationRepo interface {
+	// RecordMarkers registers cells visited by a positive case.
+	RecordMarkers(diagnosis string, cells []Cell) error
+
+	// Markers returns the local markers after a sequence number.
+	Markers(cursor int64, limit int) ([]Marker, error)
+
+	// ApplyMarker registers a marker received from a peer server.
+	ApplyMarker(m Marker, p Provenance) (bool, error)
+
+	// ReplicationCursor returns the last sequence number received from a
+	// peer server.
+	ReplicationCursor(peer string) (int64, error)
+
+	// SetReplicationCursor updates the last sequence number received from a
+	// peer server.
+	SetReplicationCursor(peer string, cursor int64) error
+}
+
+// LedgerRepo manages the tamper-evident ledger of stored items.
+type LedgerRepo interface {
+	// SealLedger seals the pending entries on a new block.
+	SealLedger() (*LedgerBlock, error)
+
+	// LedgerHead returns the most recent block.
+	LedgerHead() (*LedgerBlock, error)
+
+	// LastAnchor returns the most recent anchored block.
+	LastAnchor() (*LedgerBlock, error)
+
+	// AnchorLedger registers the external publication of a block hash.
+	AnchorLedger(seq int64, anchor string) error
+
+	// LedgerInclusion returns the block where an item's digest was sealed.
+	LedgerInclusion(digest string) (*LedgerBlock, error)
+
+	// VerifyLedger traverses and validates all blocks, in order.
+	VerifyLedger(ctx context.Context, fn func(*LedgerBlock) error) error
+}
+
+// Repositories provides access to all the storage repositories.
+type Repositories interface {
+	// Codes returns the activation codes repository.
+	Codes() CodesRepo
+
+	// Records returns the location and check-in records repository.
+	Records() RecordsRepo
+
+	// Exposures returns the diagnoses and exposures repository.
+	Exposures() ExposuresRepo
+
+	// Notifications returns the notifications repository.
+	Notifications() NotificationsRepo
+
+	// AuditLog returns the audit log repository.
+	AuditLog() AuditRepo
+
+	// AccessTokens returns the sessions repository.
+	AccessTokens() SessionsRepo
+
+	// Lockouts returns the failed authentication counters repository.
+	Lockouts() LockoutsRepo
+
+	// Nonces returns the used signature nonces repository.
+	Nonces() NoncesRepo
+
+	// Preferences returns the user preferences repository.
+	Preferences() PreferencesRepo
+
+	// APIKeyRegistry returns the API keys repository.
+	APIKeyRegistry() APIKeysRepo
+
+	// Quotas returns the ingestion and exposure self-check quota counters
+	// repository.
+	Quotas() QuotasRepo
+
+	// Submissions returns the submission receipts repository.
+	Submissions() SubmissionsRepo
+
+	// Venues returns the venues repository.
+	Venues() VenuesRepo
+
+	// Aggregates returns the analytics repository.
+	Aggregates() AnalyticsRepo
+
+	// OrganizationRegistry returns the organizations repository.
+	OrganizationRegistry() OrganizationsRepo
+
+	// Mailbox returns the encrypted messages repository.
+	Mailbox() MessagesRepo
+
+	// LegalHolds returns the legal holds repository.
+	LegalHolds() HoldsRepo
+
+	// JobQueue returns the administrative jobs repository.
+	JobQueue() JobsRepo
+
+	// Scheduler returns the scheduled jobs repository.
+	Scheduler() SchedulerRepo
+
+	// WorkerRegistry returns the worker heartbeats repository.
+	WorkerRegistry() WorkersRepo
+
+	// Queues returns the broker queue statistics repository.
+	Queues() QueuesRepo
+
+	// Settings returns the shared settings repository.
+	Settings() SettingsRepo
+
+	// Undelivered returns the undelivered broker messages repository.
+	Undelivered() UndeliveredRepo
+
+	// Tickets returns the DID publish tickets repository.
+	Tickets() TicketsRepo
+
+	// Federation returns the federation keys repository.
+	Federation() FederationRepo
+
+	// Replication returns the replication markers repository.
+	Replication() ReplicationRepo
+
+	// Ledger returns the tamper-evident ledger repository.
+	Ledger() LedgerRepo
+
+	// Purge permanently removes all data older than the retention period.
+	Purge() (int64, error)
+
+	// Ping verifies the storage component is reachable.
+	Ping() error
+
+	// Close releases the resources used by the repositories.
+	Close()
+}
+
+// Ensure the handler implements all the repositories.
+var (
+	_ Repositories      = (*Handler)(nil)
+	_ CodesRepo         = (*Handler)(nil)
+	_ RecordsRepo       = (*Handler)(nil)
+	_ ExposuresRepo     = (*Handler)(nil)
+	_ NotificationsRepo = (*Handler)(nil)
+	_ AuditRepo         = (*Handler)(nil)
+	_ SessionsRepo      = (*Handler)(nil)
+	_ LockoutsRepo      = (*Handler)(nil)
+	_ NoncesRepo        = (*Handler)(nil)
+	_ PreferencesRepo   = (*Handler)(nil)
+	_ APIKeysRepo       = (*Handler)(nil)
+	_ QuotasRepo        = (*Handler)(nil)
+	_ SubmissionsRepo   = (*Handler)(nil)
+	_ VenuesRepo        = (*Handler)(nil)
+	_ AnalyticsRepo     = (*Handler)(nil)
+	_ OrganizationsRepo = (*Handler)(nil)
+	_ MessagesRepo      = (*Handler)(nil)
+	_ HoldsRepo         = (*Handler)(nil)
+	_ JobsRepo          = (*Handler)(nil)
+	_ SchedulerRepo     = (*Handler)(nil)
+	_ WorkersRepo       = (*Handler)(nil)
+	_ QueuesRepo        = (*Handler)(nil)
+	_ SettingsRepo      = (*Handler)(nil)
+	_ UndeliveredRepo   = (*Handler)(nil)
+	_ TicketsRepo       = (*Handler)(nil)
+	_ FederationRepo    = (*Handler)(nil)
+	_ ReplicationRepo   = (*Handler)(nil)
+	_ LedgerRepo        = (*Handler)(nil)
+)
+
+// Codes returns the activation codes repository.
+func (st *Handler) Codes() CodesRepo {
+	return st
+}
+
+// Records returns the location and check-in records repository.
+func (st *Handler) Records() RecordsRepo {
+	return st
+}
+
+// Exposures returns the diagnoses and exposures repository.
+func (st *Handler) Exposures() ExposuresRepo {
+	return st
+}
+
+// Notifications returns the notifications repository.
+func (st *Handler) Notifications() NotificationsRepo {
+	return st
+}
+
+// AuditLog returns the audit log repository.
+func (st *Handler) AuditLog() AuditRepo {
+	return st
+}
+
+// AccessTokens returns the sessions repository.
+func (st *Handler) AccessTokens() SessionsRepo {
+	return st
+}
+
+// Lockouts returns the failed authentication counters repository.
+func (st *Handler) Lockouts() LockoutsRepo {
+	return st
+}
+
+// Nonces returns the used signature nonces repository.
+func (st *Handler) Nonces() NoncesRepo {
+	return st
+}
+
+// Preferences returns the user preferences repository.
+func (st *Handler) Preferences() PreferencesRepo {
+	return st
+}
+
+// APIKeyRegistry returns the API keys repository.
+func (st *Handler) APIKeyRegistry() APIKeysRepo {
+	return st
+}
+
+// Quotas returns the ingestion quota counters repository.
+func (st *Handler) Quotas() QuotasRepo {
+	return st
+}
+
+// Submissions returns the submission receipts repository.
+func (st *Handler) Submissions() SubmissionsRepo {
+	return st
+}
+
+// Venues returns the venues repository.
+func (st *Handler) Venues() VenuesRepo {
+	return st
+}
+
+// Aggregates returns the analytics repository.
+func (st *Handler) Aggregates() AnalyticsRepo {
+	return st
+}
+
+// OrganizationRegistry returns the organizations repository.
+func (st *Handler) OrganizationRegistry() OrganizationsRepo {
+	return st
+}
+
+// Mailbox returns the encrypted messages repository.
+func (st *Handler) Mailbox() MessagesRepo {
+	return st
+}
+
+// LegalHolds returns the legal holds repository.
+func (st *Handler) LegalHolds() HoldsRepo {
+	return st
+}
+
+// JobQueue returns the administrative jobs repository.
+func (st *Handler) JobQueue() JobsRepo {
+	return st
+}
+
+// Scheduler returns the scheduled jobs repository.
+func (st *Handler) Scheduler() SchedulerRepo {
+	return st
+}
+
+// WorkerRegistry returns the worker heartbeats repository.
+func (st *Handler) WorkerRegistry() WorkersRepo {
+	return st
+}
+
+// Queues returns the broker queue statistics repository.
+func (st *Handler) Queues() QueuesRepo {
+	return st
+}
+
+// Settings returns the shared settings repository.
+func (st *Handler) Settings() SettingsRepo {
+	return st
+}
+
+// Undelivered returns the undelivered broker messages repository.
+func (st *Handler) Undelivered() UndeliveredRepo {
+	return st
+}
+
+// Tickets returns the DID publish tickets repository.
+func (st *Handler) Tickets() TicketsRepo {
+	return st
+}
+
+// Federation returns the federation keys repository.
+func (st *Handler) Federation() FederationRepo {
+	return st
+}
+
+// Replication returns the replication markers repository.
+func (st *Handler) Replication() ReplicationRepo {
+	return st
+}
+
+// Ledger returns the tamper-evident ledger repository.
+func (st *Handler) Ledger() LedgerRepo {
+	return st
+}
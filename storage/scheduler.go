@@ -0,0 +1,57 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ClaimScheduledRun registers 'owner' as responsible for executing the job
+// 'name' scheduled at 'slot'. Only one claim per slot is accepted and a job
+// can't be claimed while a previous execution, started less than 'lease'
+// ago, is still running. Returns a boolean value indicating if the claim was
+// accepted.
+func (st *Handler) ClaimScheduledRun(name, owner string, slot time.Time, lease time.Duration) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	now := time.Now()
+	_, err := st.db.Collection("scheduled_jobs").UpdateOne(ctx,
+		bson.M{
+			"_id":          name,
+			"slot":         bson.M{"$lt": slot},
+			"locked_until": bson.M{"$lt": now},
+		},
+		bson.M{
+			"$set": bson.M{
+				"slot":         slot,
+				"owner":        owner,
+				"started":      now,
+				"locked_until": now.Add(lease),
+			},
+			"$unset": bson.M{"finished": "", "last_error": ""},
+		},
+		options.Update().SetUpsert(true))
+	if isDuplicateKey(err) {
+		// The job was already claimed by another worker
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// ScheduledRunFinished releases the claim held by 'owner' on the job 'name'
+// and records the result of its execution.
+func (st *Handler) ScheduledRunFinished(name, owner string, result error) error {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	now := time.Now()
+	update := bson.M{"finished": now, "locked_until": now}
+	if result != nil {
+		update["last_error"] = result.Error()
+	}
+	_, err := st.db.Collection("scheduled_jobs").UpdateOne(ctx,
+		bson.M{"_id": name, "owner": owner},
+		bson.M{"$set": update})
+	return err
+}
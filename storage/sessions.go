@@ -0,0 +1,231 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Session entries are kept for 90 days after their access token expires,
+// so revoked tokens can't be renewed.
+const sessionTTL int32 = 60 * 60 * 24 * 90
+
+// ErrSessionRenewed is returned when renewing an access token that was
+// already renewed, i.e. superseded by a newer token.
+var ErrSessionRenewed = errors.New("access token already renewed")
+
+// SessionToken describes an access token issued to a user.
+type SessionToken struct {
+	// Token identifier, the "jti" claim.
+	ID string
+
+	// DID of the credentials holder.
+	DID string
+
+	// Role of the credentials holder.
+	Role string
+
+	// Token validity period.
+	Issued  time.Time
+	Expires time.Time
+}
+
+// Stored session entry. A new entry is registered for each token issued; the
+// tokens obtained by renewing credentials share the session identifier.
+type sessionEntry struct {
+	ID      string    `bson:"_id"`
+	Session string    `bson:"session"`
+	DID     string    `bson:"did"`
+	Role    string    `bson:"role"`
+	Created time.Time `bson:"created"`
+	Issued  time.Time `bson:"issued"`
+	Expires time.Time `bson:"expires"`
+	Renewed bool      `bson:"renewed"`
+	Revoked bool      `bson:"revoked"`
+
+	// Role assigned to the DID when the token was revoked due to a role
+	// change; the token can still be renewed, once, to obtain the new role.
+	Reassign string `bson:"reassign,omitempty"`
+}
+
+func (e *sessionEntry) session() *protov1.Session {
+	return &protov1.Session{
+		Id:      e.Session,
+		Role:    e.Role,
+		Created: e.Created.Unix(),
+		Issued:  e.Issued.Unix(),
+		Expires: e.Expires.Unix(),
+	}
+}
+
+// OpenSession registers a newly issued access token. When 'previous' is
+// provided the token is the result of renewing it, and both are linked to
+// the same session; any pending role change is cleared from 'previous'.
+// Tokens can be renewed only once, ErrSessionRenewed is returned otherwise.
+func (st *Handler) OpenSession(token *SessionToken, previous string) error {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	col := st.db.Collection("sessions")
+	entry := &sessionEntry{
+		ID:      token.ID,
+		Session: token.ID,
+		DID:     token.DID,
+		Role:    token.Role,
+		Created: token.Issued,
+		Issued:  token.Issued,
+		Expires: token.Expires,
+	}
+	if previous != "" {
+		prev := &sessionEntry{}
+		err := col.FindOneAndUpdate(ctx,
+			bson.M{"_id": previous, "renewed": false},
+			bson.M{"$set": bson.M{"renewed": true}, "$unset": bson.M{"reassign": ""}}).Decode(prev)
+		if err != nil && err != mongo.ErrNoDocuments {
+			return err
+		}
+		if err == nil {
+			entry.Session = prev.Session
+			entry.Created = prev.Created
+		}
+		if err == mongo.ErrNoDocuments {
+			// Tokens not registered can still be renewed
+			n, err := col.CountDocuments(ctx, bson.M{"_id": previous})
+			if err != nil {
+				return err
+			}
+			if n > 0 {
+				return ErrSessionRenewed
+			}
+		}
+	}
+	_, err := col.InsertOne(ctx, entry)
+	return err
+}
+
+// Sessions returns the active sessions for a DID, i.e. those with a valid
+// access token that has not been revoked.
+func (st *Handler) Sessions(did string) ([]*protov1.Session, []string, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	filter := bson.M{
+		"did":     did,
+		"renewed": false,
+		"revoked": false,
+		"expires": bson.M{"$gt": time.Now()},
+	}
+	cur, err := st.db.Collection("sessions").Find(ctx, filter, options.Find().SetSort(bson.M{"created": 1}))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() {
+		_ = cur.Close(ctx)
+	}()
+	var (
+		list   []*protov1.Session
+		tokens []string
+	)
+	for cur.Next(ctx) {
+		entry := &sessionEntry{}
+		if err := cur.Decode(entry); err != nil {
+			return nil, nil, err
+		}
+		list = append(list, entry.session())
+		tokens = append(tokens, entry.ID)
+	}
+	return list, tokens, cur.Err()
+}
+
+// RevokeSession invalidates all the access tokens issued for a session of
+// the DID, including any pending role change. Returns false if no session
+// was found.
+func (st *Handler) RevokeSession(did, id string) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	res, err := st.db.Collection("sessions").UpdateMany(ctx,
+		bson.M{"did": did, "session": id},
+		bson.M{"$set": bson.M{"revoked": true}, "$unset": bson.M{"reassign": ""}})
+	if err != nil {
+		return false, err
+	}
+	return res.MatchedCount > 0, nil
+}
+
+// ReassignRole revokes all the access tokens of the DID that can still be
+// used or renewed, marking them so they can only be renewed with the new
+// 'role'. Tokens with a pending role change are updated to the new role, so
+// only the latest role assigned is ever issued. Returns the number of tokens
+// revoked.
+func (st *Handler) ReassignRole(did, role string) (int64, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	filter := bson.M{
+		"did":     did,
+		"renewed": false,
+		"$or": bson.A{
+			bson.M{"revoked": false},
+			bson.M{"reassign": bson.M{"$exists": true}},
+		},
+	}
+	res, err := st.db.Collection("sessions").UpdateMany(ctx, filter,
+		bson.M{"$set": bson.M{"revoked": true, "reassign": role}})
+	if err != nil {
+		return 0, err
+	}
+	return res.ModifiedCount, nil
+}
+
+// SessionRevoked returns true if the access token 'id' was revoked, along
+// with the role assigned to the DID if the token was revoked due to a role
+// change. Tokens already renewed are superseded, and reported as revoked
+// without a role. Tokens not registered are reported as valid.
+func (st *Handler) SessionRevoked(id string) (bool, string, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	entry := &sessionEntry{}
+	err := st.db.Collection("sessions").FindOne(ctx, bson.M{"_id": id}).Decode(entry)
+	if err == mongo.ErrNoDocuments {
+		return false, "", nil
+	}
+	if err != nil {
+		return false, "", err
+	}
+	if entry.Renewed {
+		return true, "", nil
+	}
+	return entry.Revoked, entry.Reassign, nil
+}
+
+// RevokedTokens returns the identifiers of all revoked access tokens that
+// are not yet expired.
+func (st *Handler) RevokedTokens() ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+	defer cancel()
+	filter := bson.M{"revoked": true, "expires": bson.M{"$gt": time.Now()}}
+	list, err := st.db.Collection("sessions").Distinct(ctx, "_id", filter)
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, id := range list {
+		if s, ok := id.(string); ok {
+			ids = append(ids, s)
+		}
+	}
+	return ids, nil
+}
+
+// Indexes for the sessions collection.
+func sessionIndexes(ctx context.Context, db *mongo.Database) error {
+	ttl := sessionTTL
+	_, err := db.Collection("sessions").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "did", Value: 1}, {Key: "session", Value: 1}}},
+		{Keys: bson.D{{Key: "revoked", Value: 1}, {Key: "expires", Value: 1}}},
+		{Keys: bson.M{"expires": 1}, Options: &options.IndexOptions{ExpireAfterSeconds: &ttl}},
+	})
+	return err
+}
@@ -0,0 +1,59 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type maintenanceEntry struct {
+	Enabled    bool      `bson:"enabled"`
+	RetryAfter uint32    `bson:"retry_after"`
+	Message    string    `bson:"message,omitempty"`
+	Updated    time.Time `bson:"updated"`
+}
+
+// Maintenance returns the current maintenance mode settings, shared by all
+// server instances.
+func (st *Handler) Maintenance() (*protov1.MaintenanceStatus, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	entry := &maintenanceEntry{}
+	err := st.db.Collection("settings").FindOne(ctx, bson.M{"_id": "maintenance"}).Decode(entry)
+	if err == mongo.ErrNoDocuments {
+		return &protov1.MaintenanceStatus{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &protov1.MaintenanceStatus{
+		Enabled:    entry.Enabled,
+		RetryAfter: entry.RetryAfter,
+		Message:    entry.Message,
+		Updated:    entry.Updated.Unix(),
+	}, nil
+}
+
+// SetMaintenance updates the maintenance mode settings.
+func (st *Handler) SetMaintenance(status *protov1.MaintenanceStatus) error {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	now := time.Now()
+	_, err := st.db.Collection("settings").ReplaceOne(ctx,
+		bson.M{"_id": "maintenance"},
+		&maintenanceEntry{
+			Enabled:    status.Enabled,
+			RetryAfter: status.RetryAfter,
+			Message:    status.Message,
+			Updated:    now,
+		},
+		options.Replace().SetUpsert(true))
+	if err == nil {
+		status.Updated = now.Unix()
+	}
+	return err
+}
@@ -0,0 +1,120 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Submission status values.
+const (
+	SubmissionPending   = "pending"
+	SubmissionProcessed = "processed"
+	SubmissionFailed    = "failed"
+)
+
+// Submission receipts are kept for 2 days.
+const submissionsTTL int32 = 60 * 60 * 24 * 2
+
+// Stored submission entry.
+type submissionEntry struct {
+	ID        string              `bson:"_id"`
+	DID       string              `bson:"did"`
+	Status    string              `bson:"status"`
+	Records   []*submissionRecord `bson:"records"`
+	Error     string              `bson:"error,omitempty"`
+	Created   time.Time           `bson:"created"`
+	Processed time.Time           `bson:"processed,omitempty"`
+}
+
+// Status of a single record on a submission, records are stored in the order
+// submitted.
+type submissionRecord struct {
+	Hash   string `bson:"hash"`
+	Status string `bson:"status"`
+	Reason string `bson:"reason,omitempty"`
+}
+
+func (e *submissionEntry) status() *protov1.SubmissionStatusResponse {
+	res := &protov1.SubmissionStatusResponse{
+		Receipt: e.ID,
+		Status:  e.Status,
+		Created: e.Created.Unix(),
+	}
+	if !e.Processed.IsZero() {
+		res.Processed = e.Processed.Unix()
+	}
+	for i, r := range e.Records {
+		res.Records = append(res.Records, &protov1.RecordStatus{
+			Index:  int32(i),
+			Hash:   r.Hash,
+			Status: r.Status,
+			Reason: r.Reason,
+		})
+	}
+	return res
+}
+
+func submissionRecords(list []*protov1.RecordStatus) []*submissionRecord {
+	records := make([]*submissionRecord, len(list))
+	for i, r := range list {
+		records[i] = &submissionRecord{Hash: r.Hash, Status: r.Status, Reason: r.Reason}
+	}
+	return records
+}
+
+// RegisterSubmission registers a new submission of records by 'did', in
+// "pending" status, along with the initial status of each record.
+func (st *Handler) RegisterSubmission(id, did string, records []*protov1.RecordStatus) error {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	_, err := st.db.Collection("submissions").InsertOne(ctx, &submissionEntry{
+		ID:      id,
+		DID:     did,
+		Status:  SubmissionPending,
+		Records: submissionRecords(records),
+		Created: time.Now(),
+	})
+	return err
+}
+
+// Submission returns the current status of a submission by 'did'.
+func (st *Handler) Submission(did, id string) (*protov1.SubmissionStatusResponse, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	entry := &submissionEntry{}
+	err := st.db.Collection("submissions").FindOne(ctx, bson.M{"_id": id, "did": did}).Decode(entry)
+	if err != nil {
+		return nil, err
+	}
+	return entry.status(), nil
+}
+
+// FinishSubmission records the final status of the records on a pending
+// submission. The submission is marked as "failed" if 'subErr' is not nil,
+// keeping the status previously registered for its records.
+func (st *Handler) FinishSubmission(id string, records []*protov1.RecordStatus, subErr error) error {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	update := bson.M{"processed": time.Now()}
+	if subErr != nil {
+		update["status"] = SubmissionFailed
+		update["error"] = subErr.Error()
+	} else {
+		update["status"] = SubmissionProcessed
+		update["records"] = submissionRecords(records)
+	}
+	_, err := st.db.Collection("submissions").UpdateOne(ctx,
+		bson.M{"_id": id, "status": SubmissionPending},
+		bson.M{"$set": update})
+	return err
+}
+
+// Indexes for submission receipts.
+func submissionIndexes(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection("submissions").Indexes().CreateOne(ctx, ttlIndex(submissionsTTL))
+	return err
+}
@@ -0,0 +1,127 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"go.bryk.io/covid-tracking/i18n"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Stored notification template.
+type templateEntry struct {
+	ID        string    `bson:"_id"`
+	Kind      string    `bson:"kind"`
+	Lang      string    `bson:"lang"`
+	Title     string    `bson:"title"`
+	Body      string    `bson:"body"`
+	Variables []string  `bson:"variables"`
+	Updated   time.Time `bson:"updated"`
+}
+
+func (e *templateEntry) template() *protov1.NotificationTemplate {
+	return &protov1.NotificationTemplate{
+		Kind:      e.Kind,
+		Lang:      e.Lang,
+		Title:     e.Title,
+		Body:      e.Body,
+		Variables: e.Variables,
+		Updated:   e.Updated.Unix(),
+	}
+}
+
+// Templates are identified by kind and language.
+func templateID(kind, lang string) string {
+	return kind + "/" + lang
+}
+
+// SaveTemplate registers, or replaces, a notification template.
+func (st *Handler) SaveTemplate(t *protov1.NotificationTemplate) error {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	now := time.Now()
+	id := templateID(t.Kind, t.Lang)
+	_, err := st.db.Collection("notification_templates").ReplaceOne(ctx,
+		bson.M{"_id": id},
+		&templateEntry{
+			ID:        id,
+			Kind:      t.Kind,
+			Lang:      t.Lang,
+			Title:     t.Title,
+			Body:      t.Body,
+			Variables: t.Variables,
+			Updated:   now,
+		},
+		options.Replace().SetUpsert(true))
+	if err == nil {
+		t.Updated = now.Unix()
+	}
+	return err
+}
+
+// Templates returns the registered notification templates, optionally
+// filtered by kind.
+func (st *Handler) Templates(kind string) ([]*protov1.NotificationTemplate, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	filter := bson.M{}
+	if kind != "" {
+		filter["kind"] = kind
+	}
+	opts := options.Find().SetSort(bson.M{"_id": 1})
+	cur, err := st.db.Collection("notification_templates").Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cur.Close(ctx)
+	}()
+	var list []*protov1.NotificationTemplate
+	for cur.Next(ctx) {
+		entry := &templateEntry{}
+		if err := cur.Decode(entry); err != nil {
+			return nil, err
+		}
+		list = append(list, entry.template())
+	}
+	return list, cur.Err()
+}
+
+// DeleteTemplate removes a notification template. Returns false if no
+// template was found.
+func (st *Handler) DeleteTemplate(kind, lang string) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	res, err := st.db.Collection("notification_templates").DeleteOne(ctx, bson.M{"_id": templateID(kind, lang)})
+	if err != nil {
+		return false, err
+	}
+	return res.DeletedCount > 0, nil
+}
+
+// Render the title and contents of a notification in the language 'lang'.
+// Registered templates are preferred, falling back to the default language
+// template and the built-in messages. Returns the language used.
+func (st *Handler) renderNotification(lang i18n.Language, kind string,
+	details map[string]string) (string, string, i18n.Language) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	for _, l := range []i18n.Language{lang, i18n.Default} {
+		entry := &templateEntry{}
+		err := st.db.Collection("notification_templates").
+			FindOne(ctx, bson.M{"_id": templateID(kind, string(l))}).Decode(entry)
+		if err == mongo.ErrNoDocuments {
+			continue
+		}
+		if err != nil {
+			break
+		}
+		return i18n.Render(entry.Title, details), i18n.Render(entry.Body, details), l
+	}
+	title := i18n.Format(lang, "notification."+kind+".title", details)
+	body := i18n.Format(lang, "notification."+kind+".body", details)
+	return title, body, lang
+}
@@ -0,0 +1,109 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Publish tickets are kept for 7 days.
+const publishTicketsTTL int32 = 60 * 60 * 24 * 7
+
+// Publish ticket status values.
+const (
+	// Not yet submitted to all networks; the last attempt may have failed
+	// with a transient error.
+	PublishPending = "pending"
+
+	// Accepted by all networks.
+	PublishCompleted = "published"
+
+	// Rejected by a network.
+	PublishFailed = "failed"
+)
+
+// PublishTicket keeps the progress of the proof-of-work for a DID publish
+// ticket, so it can be resumed after a restart, and the result of its
+// submission to each network.
+type PublishTicket struct {
+	// Ticket identifier, provided by the publisher; usually the DID.
+	ID string `bson:"_id"`
+
+	// DID being published.
+	DID string `bson:"did"`
+
+	// Ticket timestamp and contents, must be preserved for the stored
+	// nonce to remain valid.
+	Timestamp int64  `bson:"timestamp"`
+	Content   []byte `bson:"content"`
+
+	// Next nonce value to evaluate or, once solved, the solution found.
+	Nonce int64 `bson:"nonce"`
+
+	// Proof-of-work difficulty level.
+	Difficulty uint `bson:"difficulty"`
+
+	// Whether a solution was found.
+	Solved bool `bson:"solved"`
+
+	// Current status.
+	Status string `bson:"status"`
+
+	// Result of the last submission to each network, by network name;
+	// either "published" or the error returned.
+	Networks map[string]string `bson:"networks,omitempty"`
+
+	// Number of times the ticket was submitted.
+	Attempts int `bson:"attempts"`
+
+	// Error returned by the last failed submission.
+	Error string `bson:"error,omitempty"`
+
+	// Date the ticket was created.
+	Created time.Time `bson:"created"`
+
+	// Date the progress was last saved.
+	Updated time.Time `bson:"updated"`
+}
+
+// SavePublishTicket registers, or updates, the progress and status of a
+// publish ticket.
+func (st *Handler) SavePublishTicket(t *PublishTicket) error {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	t.Updated = time.Now()
+	if t.Created.IsZero() {
+		t.Created = t.Updated
+	}
+	if t.Status == "" {
+		t.Status = PublishPending
+	}
+	_, err := st.db.Collection("publish_tickets").ReplaceOne(ctx,
+		bson.M{"_id": t.ID}, t, options.Replace().SetUpsert(true))
+	return err
+}
+
+// PublishTicket returns the stored progress of a publish ticket, nil if not
+// available.
+func (st *Handler) PublishTicket(id string) (*PublishTicket, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	t := &PublishTicket{}
+	err := st.db.Collection("publish_tickets").FindOne(ctx, bson.M{"_id": id}).Decode(t)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Indexes for publish tickets.
+func publishTicketIndexes(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection("publish_tickets").Indexes().CreateOne(ctx, ttlIndex(publishTicketsTTL))
+	return err
+}
@@ -0,0 +1,67 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// UndeliveredMessage is a broker message that couldn't be delivered after
+// several attempts. Messages are kept on storage, subject to the retention
+// policy, until they are successfully published.
+type UndeliveredMessage struct {
+	ID          string            `bson:"_id"`
+	Exchange    string            `bson:"exchange"`
+	RoutingKey  string            `bson:"routing_key"`
+	Type        string            `bson:"type"`
+	ContentType string            `bson:"content_type"`
+	Body        []byte            `bson:"body"`
+	Headers     map[string]string `bson:"headers"`
+	Created     time.Time         `bson:"created"`
+	Attempts    int               `bson:"attempts"`
+}
+
+// SaveUndelivered registers, or updates, a message pending delivery.
+func (st *Handler) SaveUndelivered(msg *UndeliveredMessage) error {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	col, err := st.retained(ctx, "undelivered_messages", "created")
+	if err != nil {
+		return err
+	}
+	_, err = col.ReplaceOne(ctx, bson.M{"_id": msg.ID}, msg, options.Replace().SetUpsert(true))
+	return err
+}
+
+// UndeliveredMessages returns the oldest messages pending delivery.
+func (st *Handler) UndeliveredMessages(limit int64) ([]*UndeliveredMessage, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+	defer cancel()
+	opts := options.Find().SetSort(bson.M{"created": 1}).SetLimit(limit)
+	cur, err := st.db.Collection("undelivered_messages").Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cur.Close(ctx)
+	}()
+	var list []*UndeliveredMessage
+	for cur.Next(ctx) {
+		msg := &UndeliveredMessage{}
+		if err := cur.Decode(msg); err != nil {
+			return nil, err
+		}
+		list = append(list, msg)
+	}
+	return list, cur.Err()
+}
+
+// DeleteUndelivered removes a message after it was successfully published.
+func (st *Handler) DeleteUndelivered(id string) error {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	_, err := st.db.Collection("undelivered_messages").DeleteOne(ctx, bson.M{"_id": id})
+	return err
+}
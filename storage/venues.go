@@ -0,0 +1,152 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// RegisterVenue adds a new venue entry and return its complete details.
+func (st *Handler) RegisterVenue(owner string, req *protov1.RegisterVenueRequest) (*protov1.Venue, error) {
+	id := uuid.New().String()
+	venue := &protov1.Venue{
+		Id:      id,
+		Name:    req.Name,
+		Lat:     req.Lat,
+		Lng:     req.Lng,
+		QrCode:  fmt.Sprintf("ct19:venue:%s", id),
+		Owner:   owner,
+		Created: time.Now().Unix(),
+	}
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	_, err := st.db.Collection("venues").InsertOne(ctx, bson.M{
+		"id":       venue.Id,
+		"name":     venue.Name,
+		"qr_code":  venue.QrCode,
+		"owner":    venue.Owner,
+		"created":  time.Unix(venue.Created, 0),
+		"location": getLocation(&protov1.LocationRecord{Lat: venue.Lat, Lng: venue.Lng}),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return venue, nil
+}
+
+// VenueExists returns true if a venue with the provided identifier is registered.
+func (st *Handler) VenueExists(id string) bool {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	return st.db.Collection("venues").FindOne(ctx, bson.M{"id": id}).Err() == nil
+}
+
+// Venue returns the details of a registered venue.
+func (st *Handler) Venue(id string) (*protov1.Venue, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	entry := struct {
+		ID       string    `bson:"id"`
+		Name     string    `bson:"name"`
+		QrCode   string    `bson:"qr_code"`
+		Owner    string    `bson:"owner"`
+		Created  time.Time `bson:"created"`
+		Location location  `bson:"location"`
+	}{}
+	if err := st.db.Collection("venues").FindOne(ctx, bson.M{"id": id}).Decode(&entry); err != nil {
+		return nil, err
+	}
+	return &protov1.Venue{
+		Id:      entry.ID,
+		Name:    entry.Name,
+		Lat:     entry.Location.Coordinates[1],
+		Lng:     entry.Location.Coordinates[0],
+		QrCode:  entry.QrCode,
+		Owner:   entry.Owner,
+		Created: entry.Created.Unix(),
+	}, nil
+}
+
+// VenueName returns the display name of a registered venue, or an empty
+// string if the venue doesn't exist.
+func (st *Handler) VenueName(id string) string {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	entry := struct {
+		Name string `bson:"name"`
+	}{}
+	_ = st.db.Collection("venues").FindOne(ctx, bson.M{"id": id}).Decode(&entry)
+	return entry.Name
+}
+
+// CheckIns add venue visit records to persistent storage.
+func (st *Handler) CheckIns(records []*protov1.CheckInRecord) error {
+	entries := make([]interface{}, len(records))
+	for i, r := range records {
+		entries[i] = bson.M{
+			"did":       r.Did,
+			"venue":     r.Venue,
+			"timestamp": time.Unix(r.Timestamp, 0),
+			"hash":      r.Hash,
+			"proof":     r.Proof,
+		}
+	}
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+	defer cancel()
+	col, err := st.retained(ctx, "check_ins", "timestamp")
+	if err != nil {
+		return err
+	}
+	if _, err = col.InsertMany(ctx, entries); err != nil {
+		return err
+	}
+	digests := make([]string, len(records))
+	for i, r := range records {
+		digests[i] = CheckInDigest(r)
+	}
+	return st.appendLedger(ctx, LedgerCheckIn, digests)
+}
+
+// Visitors returns the identifiers of all users that checked-in at the venue
+// during the provided period of time.
+func (st *Handler) Visitors(venue string, from, to time.Time) ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Second)
+	defer cancel()
+	list, err := st.db.Collection("check_ins").Distinct(ctx, "did", bson.M{
+		"venue":     venue,
+		"timestamp": bson.M{"$gte": from, "$lte": to},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var visitors []string
+	for _, v := range list {
+		if id, ok := v.(string); ok {
+			visitors = append(visitors, id)
+		}
+	}
+	return visitors, nil
+}
+
+// Indexes for venues and check-in records.
+func venueIndexes(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection("venues").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.M{"id": 1}},
+		geoIndex("location"),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = db.Collection("check_ins").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "venue", Value: 1},
+			{Key: "timestamp", Value: 1},
+		},
+	})
+	return err
+}
@@ -0,0 +1,98 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	protov1 "go.bryk.io/covid-tracking/proto/v1"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Workers not reporting a heartbeat for an hour are discarded.
+const workerHeartbeatTTL int32 = 60 * 60
+
+// Stored worker heartbeat.
+type workerEntry struct {
+	Name          string    `bson:"_id"`
+	Queues        []string  `bson:"queues"`
+	Started       time.Time `bson:"started"`
+	Heartbeat     time.Time `bson:"heartbeat"`
+	LastProcessed time.Time `bson:"last_processed"`
+	Processed     uint64    `bson:"processed"`
+}
+
+// SaveHeartbeat registers the current status of a worker, and sets the date
+// of the heartbeat.
+func (st *Handler) SaveHeartbeat(status *protov1.WorkerStatus) error {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	entry := &workerEntry{
+		Name:      status.Name,
+		Queues:    status.Queues,
+		Started:   time.Unix(status.Started, 0),
+		Heartbeat: time.Now(),
+		Processed: status.Processed,
+	}
+	if status.LastProcessed > 0 {
+		entry.LastProcessed = time.Unix(status.LastProcessed, 0)
+	}
+	_, err := st.db.Collection("workers").ReplaceOne(ctx,
+		bson.M{"_id": status.Name}, entry, options.Replace().SetUpsert(true))
+	if err == nil {
+		status.Heartbeat = entry.Heartbeat.Unix()
+	}
+	return err
+}
+
+// RemoveWorker discards the heartbeat of a worker, i.e. when it's stopped.
+func (st *Handler) RemoveWorker(name string) error {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	_, err := st.db.Collection("workers").DeleteOne(ctx, bson.M{"_id": name})
+	return err
+}
+
+// Workers returns the latest status reported by the workers, sorted by name.
+func (st *Handler) Workers() ([]*protov1.WorkerStatus, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 2*time.Second)
+	defer cancel()
+	opts := options.Find().SetSort(bson.M{"_id": 1})
+	cur, err := st.db.Collection("workers").Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cur.Close(ctx)
+	}()
+	var list []*protov1.WorkerStatus
+	for cur.Next(ctx) {
+		entry := &workerEntry{}
+		if err := cur.Decode(entry); err != nil {
+			return nil, err
+		}
+		status := &protov1.WorkerStatus{
+			Name:      entry.Name,
+			Queues:    entry.Queues,
+			Started:   entry.Started.Unix(),
+			Heartbeat: entry.Heartbeat.Unix(),
+			Processed: entry.Processed,
+		}
+		if !entry.LastProcessed.IsZero() {
+			status.LastProcessed = entry.LastProcessed.Unix()
+		}
+		list = append(list, status)
+	}
+	return list, cur.Err()
+}
+
+// Indexes for worker heartbeats.
+func workerIndexes(ctx context.Context, db *mongo.Database) error {
+	ttl := workerHeartbeatTTL
+	_, err := db.Collection("workers").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.M{"heartbeat": 1},
+		Options: &options.IndexOptions{ExpireAfterSeconds: &ttl},
+	})
+	return err
+}
@@ -0,0 +1,89 @@
+package utils
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// AWSCredentials provides the settings required to sign requests for
+// AWS (and compatible) services.
+type AWSCredentials struct {
+	AccessKey string
+	SecretKey string
+	Region    string
+}
+
+// SignAWSRequest adds an AWS Signature Version 4 "Authorization" header to
+// the request, for the provided service name, i.e. "s3" or "kms".
+func SignAWSRequest(req *http.Request, body []byte, creds AWSCredentials, service string, ts time.Time) {
+	ts = ts.UTC()
+	date := ts.Format("20060102")
+	payload := sha256.Sum256(body)
+	req.Header.Set("x-amz-date", ts.Format("20060102T150405Z"))
+	req.Header.Set("x-amz-content-sha256", hex.EncodeToString(payload[:]))
+
+	// Canonical headers
+	headers := map[string]string{"host": req.URL.Host}
+	for k, v := range req.Header {
+		headers[strings.ToLower(k)] = strings.TrimSpace(strings.Join(v, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for k := range headers {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	canonicalHeaders := strings.Builder{}
+	for _, k := range names {
+		canonicalHeaders.WriteString(k + ":" + headers[k] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	// Canonical request
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	query := req.URL.Query()
+	for k := range query {
+		sort.Strings(query[k])
+	}
+	canonical := strings.Join([]string{
+		req.Method,
+		path,
+		strings.Replace(query.Encode(), "+", "%20", -1),
+		canonicalHeaders.String(),
+		signedHeaders,
+		hex.EncodeToString(payload[:]),
+	}, "\n")
+
+	// String to sign
+	scope := fmt.Sprintf("%s/%s/%s/aws4_request", date, creds.Region, service)
+	digest := sha256.Sum256([]byte(canonical))
+	toSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		ts.Format("20060102T150405Z"),
+		scope,
+		hex.EncodeToString(digest[:]),
+	}, "\n")
+
+	// Signature
+	key := hmacSHA256([]byte("AWS4"+creds.SecretKey), date)
+	key = hmacSHA256(key, creds.Region)
+	key = hmacSHA256(key, service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, toSign))
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		creds.AccessKey, scope, signedHeaders, signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	_, _ = h.Write([]byte(data))
+	return h.Sum(nil)
+}
@@ -0,0 +1,86 @@
+package utils
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/hdevalence/ed25519consensus"
+	"github.com/mr-tron/base58"
+	"go.bryk.io/x/ccg/did"
+	"golang.org/x/crypto/sha3"
+)
+
+// Ed25519 key type, as used on DID documents.
+const keyTypeEd25519 = "Ed25519VerificationKey2018"
+
+// Minimum number of Ed25519 signatures to use batch verification; for
+// smaller sets verifying each signature is faster.
+const minBatchSize = 4
+
+// BatchVerifier checks several signature LD documents produced by the same
+// DID instance, i.e. the proofs for the records on a single request. Ed25519
+// signatures are verified together, which is significantly faster than
+// verifying them one by one. When the batch fails, it's not known which of
+// the signatures is invalid, so each one is verified individually; as are
+// the signatures produced with other key types. Same as the DID library,
+// Ed25519 signatures are expected over the SHA3-256 digest of the signed
+// data; batch verification follows the ZIP-215 validation rules.
+type BatchVerifier struct {
+	id      *did.Identifier
+	entries []*batchEntry
+}
+
+type batchEntry struct {
+	data      []byte
+	proof     []byte
+	pub       ed25519.PublicKey
+	signature []byte
+}
+
+// NewBatchVerifier returns an empty batch for signatures produced by 'id'.
+func NewBatchVerifier(id *did.Identifier) *BatchVerifier {
+	return &BatchVerifier{id: id}
+}
+
+// Add a signature LD document, produced for 'data', to the batch.
+func (bv *BatchVerifier) Add(data []byte, ldSignature []byte) {
+	entry := &batchEntry{data: data, proof: ldSignature}
+	signature := &did.SignatureLD{}
+	if err := json.Unmarshal(ldSignature, signature); err == nil {
+		key := bv.id.Key(signature.Creator)
+		if key != nil && string(key.Type) == keyTypeEd25519 {
+			pub, err1 := base58.Decode(key.ValueBase58)
+			sig, err2 := base64.StdEncoding.DecodeString(signature.Value)
+			if err1 == nil && err2 == nil && len(pub) == ed25519.PublicKeySize && len(sig) == ed25519.SignatureSize {
+				entry.pub = pub
+				entry.signature = sig
+			}
+		}
+	}
+	bv.entries = append(bv.entries, entry)
+}
+
+// Verify the signatures on the batch. The result for each entry is returned,
+// in the same order they were added; nil for valid signatures.
+func (bv *BatchVerifier) Verify() []error {
+	res := make([]error, len(bv.entries))
+	batch := ed25519consensus.NewBatchVerifier()
+	var pending []int
+	for i, e := range bv.entries {
+		if e.pub == nil {
+			res[i] = VerifySignature(bv.id, e.data, e.proof)
+			continue
+		}
+		input := sha3.Sum256(e.data)
+		batch.Add(e.pub, input[:], e.signature)
+		pending = append(pending, i)
+	}
+	if len(pending) >= minBatchSize && batch.Verify() {
+		return res
+	}
+	for _, i := range pending {
+		res[i] = VerifySignature(bv.id, bv.entries[i].data, bv.entries[i].proof)
+	}
+	return res
+}
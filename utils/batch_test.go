@@ -0,0 +1,175 @@
+package utils
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/mr-tron/base58"
+	"go.bryk.io/x/ccg/did"
+	"golang.org/x/crypto/sha3"
+)
+
+// Produce 'size' signed values using a new DID instance.
+func signedBatch(t testing.TB, size int) (*did.Identifier, [][]byte, [][]byte) {
+	id, err := did.NewIdentifierWithMode("bryk", "", did.ModeUUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = id.AddNewKey("master", did.KeyTypeEd, did.EncodingBase58); err != nil {
+		t.Fatal(err)
+	}
+	data := make([][]byte, size)
+	proofs := make([][]byte, size)
+	for i := range data {
+		data[i] = []byte(fmt.Sprintf("record-hash-%d", i))
+		input := sha3.Sum256(data[i])
+		signature, err := id.Key("master").ProduceSignatureLD(input[:], "ct19.bryk.io")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if proofs[i], err = json.Marshal(signature); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return id, data, proofs
+}
+
+func TestBatchVerifier(t *testing.T) {
+	id, data, proofs := signedBatch(t, 16)
+	batch := NewBatchVerifier(id)
+	for i := range data {
+		batch.Add(data[i], proofs[i])
+	}
+	for i, err := range batch.Verify() {
+		if err != nil {
+			t.Errorf("%d: %s", i, err)
+		}
+	}
+
+	// Invalid entries are detected
+	batch = NewBatchVerifier(id)
+	for i := range data {
+		if i == 3 {
+			batch.Add([]byte("tampered"), proofs[i])
+			continue
+		}
+		batch.Add(data[i], proofs[i])
+	}
+	batch.Add(data[0], []byte("invalid-proof"))
+	for i, err := range batch.Verify() {
+		if (err != nil) != (i == 3 || i == len(data)) {
+			t.Errorf("%d: unexpected result: %v", i, err)
+		}
+	}
+}
+
+// Signatures valid under the ZIP-215 rules but rejected by "crypto/ed25519":
+// the public key is the identity point and the 'R' component is a
+// non-canonical encoding of it, with S = 0. The cofactored equation used by
+// ZIP-215 holds for any message, while "crypto/ed25519" compares the 'R'
+// encoding byte by byte.
+func zip215Batch(t *testing.T, size int) (*did.Identifier, [][]byte, [][]byte) {
+	identity := make([]byte, ed25519.PublicKeySize)
+	identity[0] = 1
+	doc := &did.Document{}
+	err := json.Unmarshal([]byte(fmt.Sprintf(`{
+  "@context": ["https://www.w3.org/ns/did/v1"],
+  "id": "did:bryk:zip215",
+  "publicKey": [{
+    "id": "did:bryk:zip215#master",
+    "type": "Ed25519VerificationKey2018",
+    "controller": "did:bryk:zip215",
+    "publicKeyBase58": "%s"
+  }]
+}`, base58.Encode(identity))), doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := did.FromDocument(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// R = 2^255 - 18, i.e. y = p + 1
+	sig := make([]byte, ed25519.SignatureSize)
+	sig[0] = 0xee
+	for i := 1; i < 31; i++ {
+		sig[i] = 0xff
+	}
+	sig[31] = 0x7f
+	data := make([][]byte, size)
+	proofs := make([][]byte, size)
+	for i := range data {
+		data[i] = []byte(fmt.Sprintf("record-hash-%d", i))
+		proofs[i], _ = json.Marshal(&did.SignatureLD{
+			Creator: "did:bryk:zip215#master",
+			Value:   base64.StdEncoding.EncodeToString(sig),
+		})
+	}
+	return id, data, proofs
+}
+
+func TestBatchVerifierZIP215(t *testing.T) {
+	id, data, proofs := zip215Batch(t, minBatchSize)
+
+	// Rejected when verified individually
+	for i := range data {
+		if err := VerifySignature(id, data[i], proofs[i]); err == nil {
+			t.Errorf("%d: signature accepted by crypto/ed25519", i)
+		}
+	}
+
+	// Accepted by the batch; the individual fallback would reject them,
+	// so this also ensures the batch path was used
+	batch := NewBatchVerifier(id)
+	for i := range data {
+		batch.Add(data[i], proofs[i])
+	}
+	for i, err := range batch.Verify() {
+		if err != nil {
+			t.Errorf("%d: %s", i, err)
+		}
+	}
+
+	// Smaller sets are verified individually
+	batch = NewBatchVerifier(id)
+	for i := range data[:minBatchSize-1] {
+		batch.Add(data[i], proofs[i])
+	}
+	for i, err := range batch.Verify() {
+		if err == nil {
+			t.Errorf("%d: individual verification not used", i)
+		}
+	}
+}
+
+func BenchmarkVerifySignature(b *testing.B) {
+	for _, size := range []int{10, 100, 500} {
+		id, data, proofs := signedBatch(b, size)
+		b.Run(fmt.Sprintf("individual-%d", size), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				for i := range data {
+					if err := VerifySignature(id, data[i], proofs[i]); err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("batch-%d", size), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				batch := NewBatchVerifier(id)
+				for i := range data {
+					batch.Add(data[i], proofs[i])
+				}
+				for _, err := range batch.Verify() {
+					if err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+		})
+	}
+}
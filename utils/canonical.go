@@ -0,0 +1,82 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+
+	"github.com/piprate/json-gold/ld"
+	"github.com/pkg/errors"
+	"go.bryk.io/x/ccg/did"
+)
+
+// CanonicalURDNA2015 identifies the "Universal RDF Dataset Normalization
+// Algorithm 2015", used to canonicalize JSON-LD documents before signing
+// them so signatures interoperate with standard JSON-LD libraries.
+const CanonicalURDNA2015 = "URDNA2015"
+
+// Remote contexts are retrieved only once.
+var ldLoader = ld.NewCachingDocumentLoader(ld.NewDefaultDocumentLoader(nil))
+
+// CanonicalizeLD returns the canonical form of a JSON-LD document, as the
+// N-Quads statements produced by the URDNA2015 algorithm. Documents that
+// don't produce any statements, i.e. without a valid "@context", are
+// rejected.
+func CanonicalizeLD(document []byte) ([]byte, error) {
+	var input interface{}
+	if err := json.Unmarshal(document, &input); err != nil {
+		return nil, errors.New("invalid JSON-LD document")
+	}
+	opts := ld.NewJsonLdOptions("")
+	opts.Algorithm = CanonicalURDNA2015
+	opts.Format = "application/n-quads"
+	opts.DocumentLoader = ldLoader
+	res, err := ld.NewJsonLdProcessor().Normalize(input, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to canonicalize document")
+	}
+	nquads, ok := res.(string)
+	if !ok || nquads == "" {
+		return nil, errors.New("document doesn't contain any linked data statements")
+	}
+	return []byte(nquads), nil
+}
+
+// SignDocumentLD returns a JSON-encoded signature LD document for a JSON-LD
+// document, produced using the key 'keyID' of the provided DID instance. The
+// SHA-256 digest of the URDNA2015 canonical form of the document is signed.
+func SignDocumentLD(id *did.Identifier, keyID string, document []byte, domain string) ([]byte, error) {
+	key := id.Key(keyID)
+	if key == nil {
+		return nil, errors.New("invalid key identifier")
+	}
+	input, err := documentDigest(document)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := key.ProduceSignatureLD(input, domain)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(signature)
+}
+
+// VerifyDocumentLD ensures the provided signature LD document was generated
+// by the provided DID instance for the URDNA2015 canonical form of a JSON-LD
+// document, as produced by SignDocumentLD.
+func VerifyDocumentLD(id *did.Identifier, document []byte, ldSignature []byte) error {
+	input, err := documentDigest(document)
+	if err != nil {
+		return err
+	}
+	return verifyDigest(id, input, ldSignature)
+}
+
+// Calculate the SHA-256 digest of the canonical form of a JSON-LD document.
+func documentDigest(document []byte) ([]byte, error) {
+	nquads, err := CanonicalizeLD(document)
+	if err != nil {
+		return nil, err
+	}
+	digest := sha256.Sum256(nquads)
+	return digest[:], nil
+}
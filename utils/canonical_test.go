@@ -0,0 +1,30 @@
+package utils
+
+import (
+	"testing"
+)
+
+func TestCanonicalizeLD(t *testing.T) {
+	// Documents with the same statements produce the same canonical form
+	docs := []string{
+		`{"@context": {"name": "http://schema.org/name"}, "@id": "did:example:123", "name": "Alice"}`,
+		`{"name": "Alice", "@id": "did:example:123", "@context": {"name": "http://schema.org/name"}}`,
+	}
+	expected := "<did:example:123> <http://schema.org/name> \"Alice\" .\n"
+	for _, doc := range docs {
+		res, err := CanonicalizeLD([]byte(doc))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(res) != expected {
+			t.Errorf("unexpected canonical form: %s", res)
+		}
+	}
+
+	// Documents without linked data statements are rejected
+	for _, doc := range []string{`{"name": "Alice"}`, `not-json`} {
+		if _, err := CanonicalizeLD([]byte(doc)); err == nil {
+			t.Errorf("invalid document accepted: %s", doc)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package utils
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// GCPToken provides OAuth2 access tokens for Google Cloud APIs. Tokens for
+// the default service account are obtained from the GCE metadata server and
+// renewed before they expire, unless a static token is provided.
+type GCPToken struct {
+	token   string
+	static  bool
+	expires time.Time
+	hc      *http.Client
+	mu      sync.Mutex
+}
+
+// NewGCPToken returns a new access token provider. If 'token' is not empty
+// it will be used for all requests.
+func NewGCPToken(token string) *GCPToken {
+	return &GCPToken{
+		token:  token,
+		static: token != "",
+		hc:     &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Get returns a valid access token.
+func (gt *GCPToken) Get() (string, error) {
+	if gt.static {
+		return gt.token, nil
+	}
+	gt.mu.Lock()
+	defer gt.mu.Unlock()
+	if gt.token != "" && time.Now().Before(gt.expires) {
+		return gt.token, nil
+	}
+	req, err := http.NewRequest(http.MethodGet,
+		"http://metadata.google.internal/computeMetadata/v1/instance/service-accounts/default/token", nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Metadata-Flavor", "Google")
+	res, err := gt.hc.Do(req)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to obtain access token")
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	if res.StatusCode != http.StatusOK {
+		return "", errors.Errorf("failed to obtain access token, status: %d", res.StatusCode)
+	}
+	data := struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}{}
+	if err := json.NewDecoder(res.Body).Decode(&data); err != nil {
+		return "", errors.Wrap(err, "failed to obtain access token")
+	}
+	gt.token = data.AccessToken
+	gt.expires = time.Now().Add(time.Duration(data.ExpiresIn)*time.Second - time.Minute)
+	return gt.token, nil
+}
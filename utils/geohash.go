@@ -0,0 +1,120 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Base32 alphabet used by the geohash encoding.
+const geohashAlphabet = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+// CellPrecision is the default geohash length used to index location
+// records; a cell of 7 characters covers an area of roughly 153m x 153m.
+const CellPrecision = 7
+
+// BucketSize is the default time window used to group location records.
+const BucketSize = 5 * time.Minute
+
+// GeoHash returns the geohash cell identifier, of the requested precision,
+// containing the provided coordinates.
+func GeoHash(lat, lng float64, precision int) string {
+	latRange := [2]float64{-90, 90}
+	lngRange := [2]float64{-180, 180}
+	hash := make([]byte, 0, precision)
+	bit, ch, even := 0, 0, true
+	for len(hash) < precision {
+		if even {
+			mid := (lngRange[0] + lngRange[1]) / 2
+			if lng >= mid {
+				ch |= 1 << uint(4-bit)
+				lngRange[0] = mid
+			} else {
+				lngRange[1] = mid
+			}
+		} else {
+			mid := (latRange[0] + latRange[1]) / 2
+			if lat >= mid {
+				ch |= 1 << uint(4-bit)
+				latRange[0] = mid
+			} else {
+				latRange[1] = mid
+			}
+		}
+		even = !even
+		if bit < 4 {
+			bit++
+			continue
+		}
+		hash = append(hash, geohashAlphabet[ch])
+		bit, ch = 0, 0
+	}
+	return string(hash)
+}
+
+// ValidGeoHash returns true if the provided value is a valid geohash cell
+// identifier, or prefix.
+func ValidGeoHash(hash string) bool {
+	if hash == "" {
+		return false
+	}
+	for _, c := range hash {
+		if !strings.ContainsRune(geohashAlphabet, c) {
+			return false
+		}
+	}
+	return true
+}
+
+// GeoHashCenter returns the coordinates at the center of the provided
+// geohash cell.
+func GeoHashCenter(hash string) (lat, lng float64) {
+	latRange := [2]float64{-90, 90}
+	lngRange := [2]float64{-180, 180}
+	even := true
+	for _, c := range hash {
+		ch := strings.IndexRune(geohashAlphabet, c)
+		if ch < 0 {
+			break
+		}
+		for bit := 4; bit >= 0; bit-- {
+			r := &latRange
+			if even {
+				r = &lngRange
+			}
+			mid := (r[0] + r[1]) / 2
+			if ch&(1<<uint(bit)) != 0 {
+				r[0] = mid
+			} else {
+				r[1] = mid
+			}
+			even = !even
+		}
+	}
+	return (latRange[0] + latRange[1]) / 2, (lngRange[0] + lngRange[1]) / 2
+}
+
+// TimeBucket returns the sequential number of the time window of the
+// provided size containing the timestamp 'ts'.
+func TimeBucket(ts time.Time, size time.Duration) int64 {
+	return ts.Unix() / int64(size.Seconds())
+}
+
+// CellTokenPrefix is the number of hex characters of a cell token submitted
+// for exposure self-checks; a 16 bits prefix matches a large number of cells
+// and time buckets, so the precise locations visited are not disclosed.
+const CellTokenPrefix = 4
+
+// MaxCheckTokens is the maximum number of prefixes, or blinded tokens,
+// supported per exposure self-check request; one for every time bucket of
+// the 14 days exposure window.
+const MaxCheckTokens = int(14 * 24 * time.Hour / BucketSize)
+
+// CellToken returns the hashed representation, in hex format, of a location
+// cell and time bucket calculated as: SHA256(cell|bucket).
+func CellToken(cell string, bucket int64) string {
+	h := sha256.Sum256([]byte(fmt.Sprintf("%s|%d", cell, bucket)))
+	return hex.EncodeToString(h[:])
+}
@@ -0,0 +1,48 @@
+package utils
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestGeoHash(t *testing.T) {
+	cases := []struct {
+		lat, lng float64
+		hash     string
+	}{
+		{57.64911, 10.40744, "u4pruydqqvj"},
+		{42.6, -5.6, "ezs42"},
+	}
+	for _, c := range cases {
+		if h := GeoHash(c.lat, c.lng, len(c.hash)); h != c.hash {
+			t.Errorf("invalid hash for (%f, %f): %s", c.lat, c.lng, h)
+		}
+	}
+}
+
+func TestValidGeoHash(t *testing.T) {
+	if !ValidGeoHash("9g3qxk") {
+		t.Error("valid geohash rejected")
+	}
+	if ValidGeoHash("") || ValidGeoHash("9g3a") {
+		t.Error("invalid geohash accepted")
+	}
+}
+
+func TestGeoHashCenter(t *testing.T) {
+	lat, lng := GeoHashCenter("u4pruydqqvj")
+	if math.Abs(lat-57.64911) > 0.0001 || math.Abs(lng-10.40744) > 0.0001 {
+		t.Errorf("invalid coordinates: (%f, %f)", lat, lng)
+	}
+}
+
+func TestTimeBucket(t *testing.T) {
+	ts := time.Unix(1588619270, 0)
+	if TimeBucket(ts, BucketSize) != TimeBucket(ts.Add(-30*time.Second), BucketSize) {
+		t.Error("timestamps should be on the same bucket")
+	}
+	if TimeBucket(ts, BucketSize) == TimeBucket(ts.Add(BucketSize), BucketSize) {
+		t.Error("timestamps should be on different buckets")
+	}
+}
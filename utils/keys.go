@@ -0,0 +1,91 @@
+package utils
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/base64"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/mr-tron/base58"
+	"github.com/pkg/errors"
+	"go.bryk.io/x/ccg/did"
+)
+
+// ECDSA key types accepted on DID documents, in addition to the Ed25519
+// keys natively supported by the DID library; used by several existing
+// wallet applications.
+const (
+	KeyTypeSecp256k1 = "EcdsaSecp256k1VerificationKey2019"
+	KeyTypeP256      = "EcdsaSecp256r1VerificationKey2019"
+)
+
+// Verify a signature LD document produced with an ECDSA key. The signature
+// value is the base64-encoded signature, either in DER or raw "r || s" form,
+// over: SHA-256(creator|created|domain|nonce|digest); so the signature
+// options, and in particular its nonce, can't be modified.
+func verifyECDSA(key *did.PublicKey, digest []byte, signature *did.SignatureLD) error {
+	pub, err := base58.Decode(key.ValueBase58)
+	if err != nil {
+		return errors.New("invalid public key")
+	}
+	sig, err := base64.StdEncoding.DecodeString(signature.Value)
+	if err != nil {
+		return errors.New("invalid signature value")
+	}
+	return verifyECDSASignature(string(key.Type), pub, ecdsaSignatureInput(signature, digest), sig)
+}
+
+// Message signed on ECDSA signature LD documents.
+func ecdsaSignatureInput(signature *did.SignatureLD, digest []byte) []byte {
+	h := sha256.New()
+	for _, v := range []string{signature.Creator, signature.Created, signature.Domain, signature.Nonce} {
+		_, _ = h.Write([]byte(v + "|"))
+	}
+	_, _ = h.Write(digest)
+	return h.Sum(nil)
+}
+
+// Verify an ECDSA signature for the SHA-256 digest 'msg'. 'pub' is the
+// compressed or uncompressed public key for the selected key type.
+func verifyECDSASignature(keyType string, pub, msg, sig []byte) error {
+	var key *ecdsa.PublicKey
+	switch keyType {
+	case KeyTypeSecp256k1:
+		pk, err := btcec.ParsePubKey(pub, btcec.S256())
+		if err != nil {
+			return errors.New("invalid public key")
+		}
+		key = pk.ToECDSA()
+	case KeyTypeP256:
+		x, y := elliptic.Unmarshal(elliptic.P256(), pub)
+		if x == nil {
+			x, y = elliptic.UnmarshalCompressed(elliptic.P256(), pub)
+		}
+		if x == nil {
+			return errors.New("invalid public key")
+		}
+		key = &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}
+	default:
+		return errors.Errorf("unsupported key type: %s", keyType)
+	}
+
+	// Signature in raw or DER form
+	r, s := new(big.Int), new(big.Int)
+	if len(sig) == 64 {
+		r.SetBytes(sig[:32])
+		s.SetBytes(sig[32:])
+	} else {
+		der := struct{ R, S *big.Int }{}
+		if rest, err := asn1.Unmarshal(sig, &der); err != nil || len(rest) > 0 {
+			return errors.New("invalid signature value")
+		}
+		r, s = der.R, der.S
+	}
+	if !ecdsa.Verify(key, msg, r, s) {
+		return errors.New("invalid signature")
+	}
+	return nil
+}
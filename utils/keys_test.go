@@ -0,0 +1,56 @@
+package utils
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+)
+
+func TestVerifyECDSASignature(t *testing.T) {
+	msg := sha256.Sum256([]byte("record-hash"))
+	other := sha256.Sum256([]byte("other-hash"))
+
+	// P-256, raw signature and uncompressed public key
+	p256, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, s, err := ecdsa.Sign(rand.Reader, p256, msg[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig := make([]byte, 64)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:])
+	pub := elliptic.Marshal(elliptic.P256(), p256.X, p256.Y)
+	if err := verifyECDSASignature(KeyTypeP256, pub, msg[:], sig); err != nil {
+		t.Error(err)
+	}
+	if err := verifyECDSASignature(KeyTypeP256, pub, other[:], sig); err == nil {
+		t.Error("invalid signature accepted")
+	}
+
+	// secp256k1, DER signature and compressed public key
+	k1, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := k1.Sign(msg[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub = k1.PubKey().SerializeCompressed()
+	if err := verifyECDSASignature(KeyTypeSecp256k1, pub, msg[:], der.Serialize()); err != nil {
+		t.Error(err)
+	}
+	if err := verifyECDSASignature(KeyTypeP256, pub, msg[:], der.Serialize()); err == nil {
+		t.Error("signature accepted with the wrong curve")
+	}
+	if err := verifyECDSASignature("Ed25519VerificationKey2018", pub, msg[:], sig); err == nil {
+		t.Error("unsupported key type accepted")
+	}
+}
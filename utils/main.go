@@ -3,29 +3,91 @@ package utils
 import (
 	"encoding/json"
 	"fmt"
+	"hash/fnv"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 
 	"github.com/pkg/errors"
 	"go.bryk.io/x/amqp"
 	"go.bryk.io/x/ccg/did"
 	"golang.org/x/crypto/sha3"
+	"golang.org/x/crypto/ssh/terminal"
+	"golang.org/x/sync/singleflight"
 )
 
-// ResolveDID fetch a published DID instance
+// Concurrent resolutions of the same DID are coalesced into a single request
+// to the upstream resolver.
+var resolutions singleflight.Group
+
+// Upstream DID resolution.
+var resolveDocument = did.Resolve
+
+// ResolveDID fetch a published DID instance. When several requests resolve the
+// same DID concurrently, i.e. during traffic spikes, a single request is sent
+// to the resolver and its result is shared. The providers used must be the
+// same for all callers.
 func ResolveDID(id string, providers []*did.Provider) (*did.Identifier, error) {
-	content, err := did.Resolve(id, providers)
+	content, err, _ := resolutions.Do(id, func() (interface{}, error) {
+		return resolveDocument(id, providers)
+	})
 	if err != nil {
 		return nil, err
 	}
+
+	// Each caller gets its own instance
 	doc := &did.Document{}
-	if err := json.Unmarshal(content, doc); err != nil {
+	if err := json.Unmarshal(content.([]byte), doc); err != nil {
 		return nil, err
 	}
 	return did.FromDocument(doc)
 }
 
+// LoadDIDDocuments reads locally cached DID documents, indexed by DID, to
+// verify signatures without network access. 'location' can be a single
+// document file or a directory; in which case all the ".json" files in it
+// are loaded.
+func LoadDIDDocuments(location string) (map[string]*did.Identifier, error) {
+	files := []string{filepath.Clean(location)}
+	info, err := os.Stat(files[0])
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		if files, err = filepath.Glob(filepath.Join(files[0], "*.json")); err != nil {
+			return nil, err
+		}
+	}
+	list := make(map[string]*did.Identifier)
+	for _, f := range files {
+		content, err := ioutil.ReadFile(filepath.Clean(f))
+		if err != nil {
+			return nil, err
+		}
+		doc := &did.Document{}
+		if err := json.Unmarshal(content, doc); err != nil {
+			return nil, errors.Wrapf(err, "invalid DID document: %s", f)
+		}
+		id, err := did.FromDocument(doc)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid DID document: %s", f)
+		}
+		list[id.DID()] = id
+	}
+	return list, nil
+}
+
 // VerifySignature ensures the provided signature LD document was generated
-// by the provided DID instance for 'data'
+// by the provided DID instance for 'data'. Ed25519, secp256k1 and P-256 keys
+// are supported.
 func VerifySignature(id *did.Identifier, data []byte, ldSignature []byte) error {
+	// Hash original signed data
+	input := sha3.Sum256(data)
+	return verifyDigest(id, input[:], ldSignature)
+}
+
+// Verify a signature LD document produced for the digest of the signed data.
+func verifyDigest(id *did.Identifier, input []byte, ldSignature []byte) error {
 	// Decode signature document
 	signature := &did.SignatureLD{}
 	if err := json.Unmarshal(ldSignature, signature); err != nil {
@@ -38,11 +100,14 @@ func VerifySignature(id *did.Identifier, data []byte, ldSignature []byte) error
 		return errors.New("invalid key identifier")
 	}
 
-	// Hash original signed data
-	input := sha3.Sum256(data)
+	// ECDSA keys are verified locally
+	switch string(key.Type) {
+	case KeyTypeSecp256k1, KeyTypeP256:
+		return verifyECDSA(key, input, signature)
+	}
 
 	// Verify signature
-	if !key.VerifySignatureLD(input[:], signature) {
+	if !key.VerifySignatureLD(input, signature) {
 		return errors.New("invalid signature")
 	}
 
@@ -50,37 +115,132 @@ func VerifySignature(id *did.Identifier, data []byte, ldSignature []byte) error
 	return nil
 }
 
+// SignatureNonce returns the nonce value included in the provided signature
+// LD document, or an empty string if the document is invalid.
+func SignatureNonce(ldSignature []byte) string {
+	signature := &did.SignatureLD{}
+	if err := json.Unmarshal(ldSignature, signature); err != nil {
+		return ""
+	}
+	return signature.Nonce
+}
+
 // ReadInput prompt the user to interactively enter information.
 func ReadInput(prompt string, val interface{}) {
 	fmt.Printf("%s: ", prompt)
 	_, _ = fmt.Scanln(val)
 }
 
+// ReadSecret prompt the user to interactively enter sensitive information,
+// like passwords, without echoing it to the terminal.
+func ReadSecret(prompt string) ([]byte, error) {
+	fmt.Printf("%s: ", prompt)
+	defer fmt.Println()
+	return terminal.ReadPassword(int(os.Stdin.Fd()))
+}
+
 // AccessPolicy returns the default RBAC style platform's access policy
 func AccessPolicy() string {
 	return `
 # Users can:
 # - Renew credentials
-# - Register location records
+# - Register location records and track their processing status
+# - Retrieve the location sampling policy
+# - Check-in at venues
+# - Check whether they were exposed
+# - Retrieve health certificates
+# - Acknowledge received notifications
+# - Retrieve encrypted messages received
+# - List and revoke their sessions
 r, user, /credentials, renew
+r, user, /session, read
+r, user, /session, revoke
 r, user, /record, create
+r, user, /submission, read
+r, user, /sampling_policy, read
+r, user, /check_in, create
+r, user, /exposure, check
+r, user, /certificate, read
+r, user, /notification, update
+r, user, /message, read
 
 # Agents can:
 # - Renew credentials
-# - Register location records
-# - Create notifications
+# - List and revoke their sessions
+# - Register location records and track their processing status
+# - Retrieve the location sampling policy
+# - Check-in at venues
+# - Check whether they were exposed
+# - Create notifications and track their delivery
+# - Send encrypted messages to users
+# - Query anonymized analytics and presence counts
+# - Register venues and report outbreaks
+# - Submit lab results
+# - Introspect access tokens
+# - Generate activation codes for registration campaigns
 r, agent, /credentials, renew
+r, agent, /session, read
+r, agent, /session, revoke
 r, agent, /record, create
+r, agent, /submission, read
+r, agent, /sampling_policy, read
+r, agent, /check_in, create
+r, agent, /exposure, check
 r, agent, /notification, create
+r, agent, /notification, read
+r, agent, /message, create
+r, agent, /analytics, read
+r, agent, /exposure, read
+r, agent, /venue, create
+r, agent, /venue/outbreak, create
+r, agent, /diagnosis, create
+r, agent, /introspect, read
+r, agent, /activation_code/bulk, create
+
+# Epidemiologists can:
+# - Renew credentials
+# - List and revoke their sessions
+# - Query anonymized analytics and presence counts
+# - Read individual location records, i.e. the users present in an area
+#   during an outbreak investigation
+r, epidemiologist, /credentials, renew
+r, epidemiologist, /session, read
+r, epidemiologist, /session, revoke
+r, epidemiologist, /analytics, read
+r, epidemiologist, /exposure, read
+r, epidemiologist, /record, read
 
 # Admins are treated as super users
 r, admin, .*, .*
 `
 }
 
+// TaskQueue returns the name of the queue used for the tasks on 'shard'.
+// A single "tasks" queue is used when sharding is disabled.
+func TaskQueue(shard, shards int) string {
+	if shards <= 1 {
+		return "tasks"
+	}
+	return fmt.Sprintf("tasks.%d", shard)
+}
+
+// TaskRoutingKey returns the routing key used to publish a task associated
+// with 'key', usually the DID of the task author. Tasks with the same key are
+// always delivered to the same shard and processed in order.
+func TaskRoutingKey(key string, shards int) string {
+	if shards <= 1 {
+		return ""
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	return TaskQueue(int(h.Sum32()%uint32(shards)), shards)
+}
+
 // BrokerTopology returns the default AMQP topology for the broker server.
-func BrokerTopology() amqp.Topology {
-	return amqp.Topology{
+// When 'shards' is greater than 1 tasks are partitioned on that number of
+// queues, see TaskRoutingKey.
+func BrokerTopology(shards int) amqp.Topology {
+	t := amqp.Topology{
 		Exchanges: []amqp.Exchange{
 			{
 				Name:    "tasks",
@@ -92,6 +252,16 @@ func BrokerTopology() amqp.Topology {
 				Kind:    "fanout",
 				Durable: true,
 			},
+			{
+				Name:    "fhir",
+				Kind:    "direct",
+				Durable: true,
+			},
+			{
+				Name:    "events",
+				Kind:    "fanout",
+				Durable: true,
+			},
 		},
 		Queues: []amqp.Queue{
 			{
@@ -102,6 +272,10 @@ func BrokerTopology() amqp.Topology {
 				Name:    "notifications",
 				Durable: true,
 			},
+			{
+				Name:    "fhir",
+				Durable: true,
+			},
 		},
 		Bindings: []amqp.Binding{
 			{
@@ -112,6 +286,30 @@ func BrokerTopology() amqp.Topology {
 				Exchange: "notifications",
 				Queue:    "notifications",
 			},
+			{
+				Exchange: "fhir",
+				Queue:    "fhir",
+			},
 		},
 	}
+	if shards <= 1 {
+		return t
+	}
+
+	// Replace the default tasks queue with one queue per shard
+	t.Queues = t.Queues[1:]
+	t.Bindings = t.Bindings[1:]
+	for i := 0; i < shards; i++ {
+		name := TaskQueue(i, shards)
+		t.Queues = append(t.Queues, amqp.Queue{
+			Name:    name,
+			Durable: true,
+		})
+		t.Bindings = append(t.Bindings, amqp.Binding{
+			Exchange:   "tasks",
+			Queue:      name,
+			RoutingKey: name,
+		})
+	}
+	return t
 }
@@ -0,0 +1,67 @@
+package utils
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.bryk.io/x/ccg/did"
+)
+
+func TestTaskRoutingKey(t *testing.T) {
+	if k := TaskRoutingKey("did:iadb:abc", 1); k != "" {
+		t.Errorf("unexpected routing key without shards: %s", k)
+	}
+	seen := make(map[string]bool)
+	for i := 0; i < 100; i++ {
+		id := fmt.Sprintf("did:iadb:%d", i)
+		k := TaskRoutingKey(id, 4)
+		if k != TaskRoutingKey(id, 4) {
+			t.Fatal("routing key is not stable")
+		}
+		seen[k] = true
+	}
+	if len(seen) != 4 {
+		t.Errorf("expected 4 shards, got %d", len(seen))
+	}
+	top := BrokerTopology(4)
+	for i := 0; i < 4; i++ {
+		if !seen[TaskQueue(i, 4)] {
+			t.Errorf("shard not used: %d", i)
+		}
+	}
+	if len(top.Queues) != 6 || len(top.Bindings) != 6 {
+		t.Error("invalid topology")
+	}
+}
+
+func TestResolveDID(t *testing.T) {
+	// Slow upstream resolver
+	var calls int32
+	resolveDocument = func(id string, _ []*did.Provider) ([]byte, error) {
+		atomic.AddInt32(&calls, 1)
+		time.Sleep(200 * time.Millisecond)
+		return nil, fmt.Errorf("not found: %s", id)
+	}
+	defer func() {
+		resolveDocument = did.Resolve
+	}()
+
+	// Concurrent resolutions of the same DID are coalesced
+	wg := sync.WaitGroup{}
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := ResolveDID("did:bryk:4d8e6a31", nil); err == nil {
+				t.Error("expected error")
+			}
+		}()
+	}
+	wg.Wait()
+	if calls != 1 {
+		t.Errorf("expected a single upstream resolution, got %d", calls)
+	}
+}
@@ -0,0 +1,162 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// Supported output formats for command results.
+const (
+	OutputTable = "table"
+	OutputJSON  = "json"
+	OutputYAML  = "yaml"
+)
+
+// Tabular results provide their own representation when rendered as a table.
+type Tabular interface {
+	// Table returns the column names and the rows of the result.
+	Table() (header []string, rows [][]string)
+}
+
+// Render writes a command result to 'w' using the selected format. JSON and
+// YAML documents use the JSON field names of the result, so both formats
+// are equivalent for scripts. Tables are generated from the Tabular
+// implementation of the result, if available; otherwise objects are
+// rendered as key/value rows and lists of objects as a row per element.
+func Render(w io.Writer, format string, result interface{}) error {
+	switch strings.ToLower(format) {
+	case OutputJSON:
+		js, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(w, "%s\n", js)
+		return err
+	case OutputYAML:
+		data, err := jsonValue(result)
+		if err != nil {
+			return err
+		}
+		doc, err := yaml.Marshal(data)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(doc)
+		return err
+	case OutputTable, "":
+		var header []string
+		var rows [][]string
+		if t, ok := result.(Tabular); ok {
+			header, rows = t.Table()
+		} else {
+			data, err := jsonValue(result)
+			if err != nil {
+				return err
+			}
+			header, rows = tableOf(data)
+		}
+		return writeTable(w, header, rows)
+	default:
+		return errors.Errorf("unsupported output format: %s", format)
+	}
+}
+
+// Decode the JSON representation of 'v', preserving numbers as is.
+func jsonValue(v interface{}) (interface{}, error) {
+	js, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var data interface{}
+	dec := json.NewDecoder(bytes.NewReader(js))
+	dec.UseNumber()
+	return data, dec.Decode(&data)
+}
+
+// Generic table for a decoded JSON value.
+func tableOf(data interface{}) ([]string, [][]string) {
+	switch v := data.(type) {
+	case map[string]interface{}:
+		var rows [][]string
+		for _, k := range sortedKeys(v) {
+			rows = append(rows, []string{k, cell(v[k])})
+		}
+		return []string{"key", "value"}, rows
+	case []interface{}:
+		// Columns are the union of the fields of all elements
+		fields := make(map[string]interface{})
+		for _, el := range v {
+			if obj, ok := el.(map[string]interface{}); ok {
+				for k := range obj {
+					fields[k] = nil
+				}
+			}
+		}
+		if len(fields) == 0 {
+			var rows [][]string
+			for _, el := range v {
+				rows = append(rows, []string{cell(el)})
+			}
+			return []string{"value"}, rows
+		}
+		header := sortedKeys(fields)
+		var rows [][]string
+		for _, el := range v {
+			obj, _ := el.(map[string]interface{})
+			row := make([]string, len(header))
+			for i, k := range header {
+				row[i] = cell(obj[k])
+			}
+			rows = append(rows, row)
+		}
+		return header, rows
+	default:
+		return []string{"value"}, [][]string{{cell(v)}}
+	}
+}
+
+// Text representation of a table cell; nested values are JSON-encoded.
+func cell(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return val
+	case json.Number:
+		return val.String()
+	case bool:
+		return fmt.Sprintf("%v", val)
+	default:
+		js, _ := json.Marshal(val)
+		return string(js)
+	}
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Write aligned columns, the header is displayed in uppercase.
+func writeTable(w io.Writer, header []string, rows [][]string) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if len(header) > 0 {
+		_, _ = fmt.Fprintln(tw, strings.ToUpper(strings.Join(header, "\t")))
+	}
+	for _, row := range rows {
+		_, _ = fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return tw.Flush()
+}
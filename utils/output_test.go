@@ -0,0 +1,60 @@
+package utils
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+type sampleResult struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+type sampleList []*sampleResult
+
+func (sl sampleList) Table() ([]string, [][]string) {
+	var rows [][]string
+	for _, s := range sl {
+		rows = append(rows, []string{s.Name})
+	}
+	return []string{"name"}, rows
+}
+
+func TestRender(t *testing.T) {
+	res := &sampleResult{Name: "signing", Count: 3}
+	cases := map[string]string{
+		OutputJSON:  "{\n  \"name\": \"signing\",\n  \"count\": 3\n}\n",
+		OutputYAML:  "count: 3\nname: signing\n",
+		OutputTable: "KEY    VALUE\ncount  3\nname   signing\n",
+	}
+	for format, expected := range cases {
+		buf := &bytes.Buffer{}
+		if err := Render(buf, format, res); err != nil {
+			t.Fatal(err)
+		}
+		if buf.String() != expected {
+			t.Errorf("%s: unexpected output:\n%s", format, buf.String())
+		}
+	}
+
+	// Lists and custom tables
+	buf := &bytes.Buffer{}
+	list := []*sampleResult{res, {Name: "hash"}}
+	if err := Render(buf, OutputTable, list); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(buf.String(), "COUNT  NAME\n3      signing\n") {
+		t.Errorf("unexpected list output:\n%s", buf.String())
+	}
+	buf.Reset()
+	if err := Render(buf, OutputTable, sampleList(list)); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != "NAME\nsigning\nhash\n" {
+		t.Errorf("unexpected table output:\n%s", buf.String())
+	}
+	if err := Render(buf, "xml", res); err == nil {
+		t.Error("unsupported format accepted")
+	}
+}
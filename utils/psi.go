@@ -0,0 +1,165 @@
+package utils
+
+import (
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"math/big"
+
+	"github.com/pkg/errors"
+)
+
+// Private set intersection (PSI) for exposure checks, based on the
+// commutative property of elliptic curve scalar multiplication. Cell tokens
+// are mapped to P-256 points; the client blinds its points with a random
+// scalar 'r' and the server evaluates them with its secret scalar 'k'. After
+// removing the blinding factor, the client compares the results with the
+// server's set, encoded with 'k', locally. The server doesn't learn the
+// client's tokens, and the client only learns which of its tokens are on the
+// server's set.
+var psiCurve = elliptic.P256()
+
+// Length, in bytes, of the encoded elements of the server's set.
+const psiDigestSize = 16
+
+// PSIClient blinds the cell tokens of a client and finds the ones included on
+// the server's set. A new instance must be used for every exchange.
+type PSIClient struct {
+	r      *big.Int
+	tokens []string
+}
+
+// NewPSIClient returns a client instance for the provided cell tokens.
+func NewPSIClient(tokens []string) (*PSIClient, error) {
+	r, err := randomScalar()
+	if err != nil {
+		return nil, err
+	}
+	return &PSIClient{r: r, tokens: tokens}, nil
+}
+
+// Blinded returns the blinded points for the client's tokens, compressed,
+// in the same order.
+func (pc *PSIClient) Blinded() ([][]byte, error) {
+	list := make([][]byte, len(pc.tokens))
+	for i, t := range pc.tokens {
+		x, y, err := psiHashToPoint(t)
+		if err != nil {
+			return nil, err
+		}
+		x, y = psiCurve.ScalarMult(x, y, pc.r.Bytes())
+		list[i] = elliptic.MarshalCompressed(psiCurve, x, y)
+	}
+	return list, nil
+}
+
+// Matches returns the client's tokens included on the server's set, using the
+// points evaluated by the server for the blinded tokens.
+func (pc *PSIClient) Matches(evaluated [][]byte, set []string) ([]string, error) {
+	if len(evaluated) != len(pc.tokens) {
+		return nil, errors.New("invalid number of evaluated points")
+	}
+	inSet := make(map[string]bool, len(set))
+	for _, e := range set {
+		inSet[e] = true
+	}
+	inv := new(big.Int).ModInverse(pc.r, psiCurve.Params().N)
+	var matches []string
+	for i, p := range evaluated {
+		x, y := elliptic.UnmarshalCompressed(psiCurve, p)
+		if x == nil {
+			return nil, errors.New("invalid evaluated point")
+		}
+		x, y = psiCurve.ScalarMult(x, y, inv.Bytes())
+		if inSet[psiDigest(x, y)] {
+			matches = append(matches, pc.tokens[i])
+		}
+	}
+	return matches, nil
+}
+
+// PSIServer evaluates the blinded tokens submitted by clients and encodes
+// the elements of the server's set.
+type PSIServer struct {
+	k *big.Int
+}
+
+// NewPSIServer returns a server instance with a secret scalar derived from
+// 'key'. All instances sharing the key produce the same results, so clients
+// can use any of them.
+func NewPSIServer(key []byte) *PSIServer {
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write([]byte("ct19-psi"))
+	n := new(big.Int).Sub(psiCurve.Params().N, big.NewInt(1))
+	k := new(big.Int).SetBytes(mac.Sum(nil))
+	k.Mod(k, n).Add(k, big.NewInt(1))
+	return &PSIServer{k: k}
+}
+
+// Evaluate the blinded points submitted by a client, in the same order.
+func (ps *PSIServer) Evaluate(blinded [][]byte) ([][]byte, error) {
+	list := make([][]byte, len(blinded))
+	for i, p := range blinded {
+		x, y := elliptic.UnmarshalCompressed(psiCurve, p)
+		if x == nil {
+			return nil, errors.Errorf("invalid point at position %d", i)
+		}
+		x, y = psiCurve.ScalarMult(x, y, ps.k.Bytes())
+		list[i] = elliptic.MarshalCompressed(psiCurve, x, y)
+	}
+	return list, nil
+}
+
+// Encode a cell token as an element of the server's set.
+func (ps *PSIServer) Encode(token string) (string, error) {
+	x, y, err := psiHashToPoint(token)
+	if err != nil {
+		return "", err
+	}
+	x, y = psiCurve.ScalarMult(x, y, ps.k.Bytes())
+	return psiDigest(x, y), nil
+}
+
+// Map a token to a curve point using the "try-and-increment" method.
+func psiHashToPoint(token string) (*big.Int, *big.Int, error) {
+	params := psiCurve.Params()
+	three := big.NewInt(3)
+	for ctr := 0; ctr < 256; ctr++ {
+		h := sha256.Sum256(append([]byte{byte(ctr)}, token...))
+		x := new(big.Int).SetBytes(h[:])
+		if x.Cmp(params.P) >= 0 {
+			continue
+		}
+
+		// y² = x³ - 3x + b
+		y2 := new(big.Int).Exp(x, three, params.P)
+		y2.Sub(y2, new(big.Int).Mul(x, three))
+		y2.Add(y2, params.B)
+		y2.Mod(y2, params.P)
+		y := new(big.Int).ModSqrt(y2, params.P)
+		if y == nil {
+			continue
+		}
+		if y.Bit(0) != uint(h[0]&1) {
+			y.Sub(params.P, y)
+		}
+		return x, y, nil
+	}
+	return nil, nil, errors.New("failed to map token to a curve point")
+}
+
+func psiDigest(x, y *big.Int) string {
+	h := sha256.Sum256(elliptic.MarshalCompressed(psiCurve, x, y))
+	return hex.EncodeToString(h[:psiDigestSize])
+}
+
+func randomScalar() (*big.Int, error) {
+	n := new(big.Int).Sub(psiCurve.Params().N, big.NewInt(1))
+	r, err := rand.Int(rand.Reader, n)
+	if err != nil {
+		return nil, err
+	}
+	return r.Add(r, big.NewInt(1)), nil
+}
@@ -0,0 +1,49 @@
+package utils
+
+import (
+	"testing"
+)
+
+func TestPSI(t *testing.T) {
+	server := NewPSIServer([]byte("hash-key"))
+	var set []string
+	for _, token := range []string{CellToken("9g3w81t", 5290000), CellToken("9g3w81w", 5290001)} {
+		e, err := server.Encode(token)
+		if err != nil {
+			t.Fatal(err)
+		}
+		set = append(set, e)
+	}
+
+	tokens := []string{CellToken("9g3w81t", 5290001), CellToken("9g3w81w", 5290001), CellToken("9g3qrsz", 5290000)}
+	client, err := NewPSIClient(tokens)
+	if err != nil {
+		t.Fatal(err)
+	}
+	blinded, err := client.Blinded()
+	if err != nil {
+		t.Fatal(err)
+	}
+	evaluated, err := server.Evaluate(blinded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	matches, err := client.Matches(evaluated, set)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(matches) != 1 || matches[0] != tokens[1] {
+		t.Errorf("invalid matches: %v", matches)
+	}
+
+	// Different server key
+	evaluated, _ = NewPSIServer([]byte("other-key")).Evaluate(blinded)
+	if matches, _ = client.Matches(evaluated, set); len(matches) != 0 {
+		t.Error("unexpected matches")
+	}
+
+	// Invalid points
+	if _, err := server.Evaluate([][]byte{[]byte("invalid")}); err == nil {
+		t.Error("invalid point accepted")
+	}
+}